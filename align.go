@@ -0,0 +1,59 @@
+package json2image
+
+import (
+	"unicode/utf8"
+
+	"github.com/fogleman/gg"
+)
+
+// alignedKey 参与冒号对齐的键所在的行及键的宽度
+type alignedKey struct {
+	line  int
+	width float64
+}
+
+// alignColons 在每个对象内为位于行首的键计算补齐宽度，使冒号对齐到同一列。
+// 超过 AlignMaxKeyLength 个字符的键不参与对齐
+func alignColons(lines []ColoredLine, family fontFamily, config *Config) {
+	dc := gg.NewContext(1, 1)
+	maxLength := config.Format.AlignMaxKeyLength
+
+	// 每个未闭合的括号对应一组，只有对象中的键会加入分组
+	var stack [][]alignedKey
+	align := func(group []alignedKey) {
+		column := 0.0
+		for _, key := range group {
+			if key.width > column {
+				column = key.width
+			}
+		}
+		for _, key := range group {
+			lines[key.line].keyPad = column - key.width
+		}
+	}
+
+	for i, line := range lines {
+		first := true
+		for _, seg := range line.segments {
+			switch seg.kind {
+			case segmentSpace:
+				continue
+			case segmentKey:
+				// 只对齐位于行首的键，同一行内的多个键保持原样
+				length := utf8.RuneCountInString(seg.text) - 2
+				if first && len(stack) > 0 && (maxLength <= 0 || length <= maxLength) {
+					width := textWidth(dc, segmentChain(seg, family, config), seg.text)
+					stack[len(stack)-1] = append(stack[len(stack)-1], alignedKey{line: i, width: width})
+				}
+			case segmentBrace:
+				if seg.text == "{" || seg.text == "[" {
+					stack = append(stack, nil)
+				} else if len(stack) > 0 {
+					align(stack[len(stack)-1])
+					stack = stack[:len(stack)-1]
+				}
+			}
+			first = false
+		}
+	}
+}
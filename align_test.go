@@ -0,0 +1,80 @@
+package json2image
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/fogleman/gg"
+)
+
+func TestAlignColons(t *testing.T) {
+	formatted, err := formatJSON(`{"a": 1, "bbb": {"x": 1, "yyyyy": 2}, "a_very_long_key_name": 3}`)
+	if err != nil {
+		t.Fatalf("formatJSON failed: %v", err)
+	}
+	family, err := loadFontFamily(DefaultConfig())
+	if err != nil {
+		t.Fatalf("loadFontFamily failed: %v", err)
+	}
+	chain := family.chain(TextStyleRegular)
+	charWidth := textWidth(gg.NewContext(1, 1), chain, "xxxxxxxxxx") / 10
+
+	// 返回各行键之后冒号的横坐标（以字符宽度计）
+	colons := func(config *Config) map[string]float64 {
+		lines := parseJSONWithColor(formatted, defaultIndentWidth)
+		alignColons(lines, family, config)
+		result := make(map[string]float64)
+		for _, line := range lines {
+			x := 0.0
+			for _, seg := range line.segments {
+				x += textWidth(gg.NewContext(1, 1), chain, seg.text)
+				if seg.kind == segmentKey {
+					result[seg.text] = math.Round((x + line.keyPad) / charWidth)
+					break
+				}
+			}
+		}
+		return result
+	}
+
+	// 不限制键长度时，同一对象内的冒号对齐到最长的键之后，嵌套对象单独对齐
+	got := colons(DefaultConfig().WithAlignColons(0))
+	for _, key := range []string{`"a"`, `"bbb"`, `"a_very_long_key_name"`} {
+		if got[key] != 26 {
+			t.Errorf("Expected colon of %s at column 26, got %v", key, got[key])
+		}
+	}
+	if got[`"x"`] != 15 || got[`"yyyyy"`] != 15 {
+		t.Errorf("Expected nested colons at column 15, got %v and %v", got[`"x"`], got[`"yyyyy"`])
+	}
+
+	// 超过最大长度的键不参与对齐
+	got = colons(DefaultConfig().WithAlignColons(8))
+	if got[`"a"`] != 9 || got[`"bbb"`] != 9 {
+		t.Errorf("Expected short keys aligned at column 9, got %v and %v", got[`"a"`], got[`"bbb"`])
+	}
+	if got[`"a_very_long_key_name"`] != 26 {
+		t.Errorf("Expected long key not padded, got %v", got[`"a_very_long_key_name"`])
+	}
+}
+
+func TestJson2ImageWithAlignColons(t *testing.T) {
+	// 测试冒号对齐
+	jsonData := `{
+		"host": "localhost",
+		"port": 8080,
+		"max_connections": 100,
+		"tls": {"enabled": true, "cert_file": "/etc/cert.pem"},
+		"a_key_that_is_far_too_long_to_align": false
+	}`
+
+	config := DefaultConfig().WithAlignColons(20)
+
+	_, err := Json2Image(jsonData, config, "output/output_align.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("冒号对齐图片生成成功：output_align.png")
+}
@@ -0,0 +1,293 @@
+package json2image
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CropReport 裁剪时每条规则的匹配情况
+type CropReport struct {
+	Rules []RuleReport // Rules 与规则列表一一对应
+}
+
+// Unmatched 返回未选中任何节点的规则
+func (r *CropReport) Unmatched() []RuleReport {
+	var unmatched []RuleReport
+	for _, rule := range r.Rules {
+		if rule.Matches == 0 {
+			unmatched = append(unmatched, rule)
+		}
+	}
+	return unmatched
+}
+
+// footer 返回在图片底部列出未匹配规则的文本行，全部规则都有匹配时返回空。
+// 页脚使用英文描述，默认的 Monaco 字体没有中文字形，也能完整绘制
+func (r *CropReport) footer() []string {
+	unmatched := r.Unmatched()
+	if len(unmatched) == 0 {
+		return nil
+	}
+	lines := []string{"Unmatched crop rules:"}
+	for _, rule := range unmatched {
+		lines = append(lines, fmt.Sprintf("  %s  (%s)", rule.Rule, rule.Miss.summary))
+	}
+	return lines
+}
+
+// RuleReport 单条规则的匹配情况
+type RuleReport struct {
+	Rule    string      // Rule 规则原文
+	Exclude bool        // Exclude 是否为排除规则
+	Matches int         // Matches 选中的节点数
+	Paths   []string    // Paths 选中节点的具体路径，格式与裁剪规则相同，根节点为空字符串
+	Miss    *MissReason // Miss 未选中任何节点的原因，有匹配时为 nil
+}
+
+// record 记录一个选中的节点
+func (r *RuleReport) record(path []pathElem) {
+	r.Matches++
+	r.Paths = append(r.Paths, formatPath(path))
+}
+
+// MissKind 规则未选中节点的原因类别
+type MissKind int
+
+const (
+	MissOther           MissKind = iota // 其他原因，未能确定规则在哪一步中断
+	MissKeyNotFound                     // 对象中不存在该键
+	MissIndexOutOfRange                 // 数组下标越界或切片为空
+	MissTypeMismatch                    // 节点类型与规则不符，如对数组取键
+	MissEmptyContainer                  // 通配符作用于空对象或空数组
+	MissFilterNoMatch                   // 没有元素满足过滤条件
+)
+
+// MissReason 规则未选中任何节点的原因
+type MissReason struct {
+	Kind    MissKind // Kind 原因类别
+	Path    string   // Path 匹配中断处节点的路径，根节点为空字符串
+	Message string   // Message 原因描述
+
+	summary string // summary 英文的原因描述，用于图片页脚
+}
+
+// newMissReason 创建未匹配原因。message 和 summary 为不含路径的中文和英文描述，生成的描述以节点路径开头
+func newMissReason(kind MissKind, path []pathElem, message, summary string) *MissReason {
+	p := formatPath(path)
+	where, whereEn := p, p
+	if p == "" {
+		where, whereEn = "根节点", "root"
+	}
+	return &MissReason{Kind: kind, Path: p, Message: where + " " + message, summary: whereEn + " " + summary}
+}
+
+// formatPath 将具体路径格式化为 data.items[1] 形式
+func formatPath(path []pathElem) string {
+	p := ""
+	for _, elem := range path {
+		if elem.isIndex {
+			p += "[" + strconv.Itoa(elem.index) + "]"
+		} else {
+			p = joinKey(p, elem.key)
+		}
+	}
+	return p
+}
+
+// joinKey 将字段名追加到点号路径末尾，无法直接写在规则中的字段名写作 ["..."]
+func joinKey(path, key string) string {
+	quote := key == "" || key == "*" || strings.ContainsAny(key, `.[]\`)
+	if path == "" && key != "" && strings.IndexByte("!$/", key[0]) >= 0 {
+		quote = true
+	}
+	if quote {
+		return path + "[" + quoteKey(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// quoteKey 返回双引号包围的字段名，转义方式与 JSONPath 的引号名称相同
+func quoteKey(key string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range key {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// jsonTypeName 返回值的JSON类型的中文和英文名称
+func jsonTypeName(value interface{}) (string, string) {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "对象", "an object"
+	case []interface{}:
+		return "数组", "an array"
+	case string:
+		return "字符串", "a string"
+	case bool:
+		return "布尔值", "a boolean"
+	case nil:
+		return "null", "null"
+	}
+	if _, ok := toFloat(value); ok {
+		return "数字", "a number"
+	}
+	return "未知类型", "an unknown type"
+}
+
+// diagnose 重新执行规则，找出匹配在哪一步中断及原因
+func (r cropRule) diagnose(input interface{}) *MissReason {
+	var reason *MissReason
+	switch {
+	case r.path != nil:
+		reason = diagnosePath(r.path, input)
+	case r.pointer != nil:
+		reason = r.pointer.miss(input)
+	default:
+		reason = diagnoseSteps(r.steps, input)
+	}
+	if reason == nil {
+		reason = &MissReason{Kind: MissOther, Message: "规则未选中任何节点", summary: "rule matched nothing"}
+	}
+	return reason
+}
+
+// diagnosePath 逐段执行 JSONPath 规则，返回第一个没有选中节点的段的原因
+func diagnosePath(jp *jsonPath, root interface{}) *MissReason {
+	nodes := []pathNode{{value: root}}
+	for _, segment := range jp.segments {
+		var next []pathNode
+		for _, node := range nodes {
+			segment.apply(root, node, func(n pathNode) { next = append(next, n) })
+		}
+		if len(next) == 0 {
+			selector := segment.selectors[0]
+			if segment.descendant && selector.kind == selectorName {
+				return newMissReason(MissKeyNotFound, nodes[0].path,
+					fmt.Sprintf("及其后代中不存在键 %q", selector.name),
+					fmt.Sprintf("has no descendant key %q", selector.name))
+			}
+			return selector.miss(nodes[0])
+		}
+		nodes = next
+	}
+	return nil
+}
+
+// diagnoseSteps 按点号规则的语义逐步执行，返回第一个没有选中节点的步骤的原因
+func diagnoseSteps(steps []PathStep, root interface{}) *MissReason {
+	nodes := []pathNode{{value: root}}
+	for i, step := range steps {
+		var next []pathNode
+		var reason *MissReason
+		add := func(n pathNode) { next = append(next, n) }
+		try := func(selector pathSelector, node pathNode, emit func(pathNode)) {
+			found := false
+			selector.apply(root, node, func(n pathNode) {
+				found = true
+				emit(n)
+			})
+			if !found && reason == nil {
+				reason = selector.miss(node)
+			}
+		}
+
+		for _, node := range nodes {
+			if step.isWildcard() {
+				try(pathSelector{kind: selectorWildcard}, node, add)
+				continue
+			}
+
+			child := []pathNode{node}
+			if !step.isArrayStep() {
+				child = nil
+				try(pathSelector{kind: selectorName, name: step.Key}, node, func(n pathNode) { child = append(child, n) })
+			}
+			for _, n := range child {
+				switch {
+				case step.filter != nil:
+					try(pathSelector{kind: selectorFilter, filter: step.filter}, n, add)
+				case step.slice != nil:
+					try(pathSelector{kind: selectorSlice, slice: *step.slice}, n, add)
+				case len(step.Indices) > 0:
+					for _, index := range step.Indices {
+						try(pathSelector{kind: selectorIndex, index: index}, n, add)
+					}
+				case step.isArrayStep():
+					if _, isArray := n.value.([]interface{}); isArray {
+						try(pathSelector{kind: selectorWildcard}, n, add)
+					} else if reason == nil {
+						kind, kindEn := jsonTypeName(n.value)
+						reason = newMissReason(MissTypeMismatch, n.path, "是"+kind+"，不是数组", "is "+kindEn+", not an array")
+					}
+				default:
+					// 中间步骤的数组自动展开为全部元素
+					if _, isArray := n.value.([]interface{}); isArray && i < len(steps)-1 {
+						try(pathSelector{kind: selectorWildcard}, n, add)
+					} else {
+						add(n)
+					}
+				}
+			}
+		}
+		if len(next) == 0 {
+			return reason
+		}
+		nodes = next
+	}
+	return nil
+}
+
+// miss 返回选择器在节点上没有选中任何子节点的原因
+func (s pathSelector) miss(node pathNode) *MissReason {
+	_, isObject := node.value.(map[string]interface{})
+	slice, isArray := node.value.([]interface{})
+	kind, kindEn := jsonTypeName(node.value)
+
+	switch s.kind {
+	case selectorName:
+		if isObject {
+			return newMissReason(MissKeyNotFound, node.path,
+				fmt.Sprintf("中不存在键 %q", s.name),
+				fmt.Sprintf("has no key %q", s.name))
+		}
+		return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是对象", "is "+kindEn+", not an object")
+	case selectorIndex:
+		if isArray {
+			return newMissReason(MissIndexOutOfRange, node.path,
+				fmt.Sprintf("的长度为 %d，下标 %d 越界", len(slice), s.index),
+				fmt.Sprintf("has length %d, index %d is out of range", len(slice), s.index))
+		}
+		return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是数组", "is "+kindEn+", not an array")
+	case selectorSlice:
+		if isArray {
+			return newMissReason(MissIndexOutOfRange, node.path,
+				fmt.Sprintf("的长度为 %d，切片为空", len(slice)),
+				fmt.Sprintf("has length %d, slice is empty", len(slice)))
+		}
+		return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是数组", "is "+kindEn+", not an array")
+	case selectorFilter:
+		if isObject || isArray {
+			return newMissReason(MissFilterNoMatch, node.path, "中没有满足过滤条件的元素", "has no element matching the filter")
+		}
+		return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是对象或数组", "is "+kindEn+", not an object or array")
+	}
+	if isObject || isArray {
+		return newMissReason(MissEmptyContainer, node.path, "是空"+kind, "is empty")
+	}
+	return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是对象或数组", "is "+kindEn+", not an object or array")
+}
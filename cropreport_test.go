@@ -0,0 +1,187 @@
+package json2image
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJsonCropWithReport(t *testing.T) {
+	var input map[string]interface{}
+	document := `{
+		"users": [
+			{"name": "Alice", "age": 25, "email": "a@example.com"},
+			{"name": "Bob", "age": 30}
+		],
+		"meta": {"total": 2, "tags": []},
+		"title": "report"
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	rules := []string{
+		"users[*].name",
+		"$..email",
+		"users[0].emial",
+		"users[5].name",
+		"title.text",
+		"$.users[?@.age > 40]",
+		"meta.tags.*",
+		"$.meta.total[0]",
+		"!users[1].age",
+	}
+	output, report, err := JsonCropWithReport(input, rules)
+	if err != nil {
+		t.Fatalf("JsonCropWithReport失败: %v", err)
+	}
+	want := `{"users":[{"email":"a@example.com","name":"Alice"},{"name":"Bob"}]}`
+	if string(output) != want {
+		t.Errorf("输出 %s, 期望 %s", output, want)
+	}
+	if len(report.Rules) != len(rules) {
+		t.Fatalf("报告包含 %d 条规则, 期望 %d", len(report.Rules), len(rules))
+	}
+
+	matched := []struct {
+		index int
+		paths []string
+	}{
+		{0, []string{"users[0].name", "users[1].name"}},
+		{1, []string{"users[0].email"}},
+		{8, []string{"users[1].age"}},
+	}
+	for _, tt := range matched {
+		rule := report.Rules[tt.index]
+		if rule.Matches != len(tt.paths) || !reflect.DeepEqual(rule.Paths, tt.paths) || rule.Miss != nil {
+			t.Errorf("规则 %s 的报告为 %+v, 期望路径 %q", rule.Rule, rule, tt.paths)
+		}
+	}
+	if !report.Rules[8].Exclude {
+		t.Errorf("规则 %s 应标记为排除规则", report.Rules[8].Rule)
+	}
+
+	misses := []struct {
+		index   int
+		kind    MissKind
+		path    string
+		message string
+	}{
+		{2, MissKeyNotFound, "users[0]", `users[0] 中不存在键 "emial"`},
+		{3, MissIndexOutOfRange, "users", "users 的长度为 2，下标 5 越界"},
+		{4, MissTypeMismatch, "title", "title 是字符串，不是对象"},
+		{5, MissFilterNoMatch, "users", "users 中没有满足过滤条件的元素"},
+		{6, MissEmptyContainer, "meta.tags", "meta.tags 是空数组"},
+		{7, MissTypeMismatch, "meta.total", "meta.total 是数字，不是数组"},
+	}
+	for _, tt := range misses {
+		rule := report.Rules[tt.index]
+		if rule.Matches != 0 || rule.Miss == nil {
+			t.Errorf("规则 %s 应未匹配, 实际为 %+v", rule.Rule, rule)
+			continue
+		}
+		if rule.Miss.Kind != tt.kind || rule.Miss.Path != tt.path || rule.Miss.Message != tt.message {
+			t.Errorf("规则 %s 的原因为 %+v, 期望 %v %q %q", rule.Rule, rule.Miss, tt.kind, tt.path, tt.message)
+		}
+	}
+
+	if unmatched := report.Unmatched(); len(unmatched) != len(misses) {
+		t.Errorf("未匹配的规则有 %d 条, 期望 %d", len(unmatched), len(misses))
+	}
+	footer := report.footer()
+	if len(footer) != len(misses)+1 || footer[1] != `  users[0].emial  (users[0] has no key "emial")` {
+		t.Errorf("页脚为 %q", footer)
+	}
+
+	// 页脚只包含默认的 Monaco 字体能绘制的字符
+	chain, err := loadFontChain(DefaultConfig())
+	if err != nil {
+		t.Fatalf("loadFontChain失败: %v", err)
+	}
+	for _, line := range footer {
+		for _, r := range line {
+			if !chain[0].hasGlyph(r) {
+				t.Errorf("页脚 %q 包含默认字体缺少字形的字符 %q", line, r)
+			}
+		}
+	}
+}
+
+func TestDiagnoseRoot(t *testing.T) {
+	_, report, err := JsonCropWithReport([]interface{}{1, 2}, []string{"name", "$..id"})
+	if err != nil {
+		t.Fatalf("JsonCropWithReport失败: %v", err)
+	}
+	if miss := report.Rules[0].Miss; miss == nil || miss.Kind != MissTypeMismatch || miss.Message != "根节点 是数组，不是对象" {
+		t.Errorf("根节点类型不符的原因为 %+v", miss)
+	}
+	if miss := report.Rules[1].Miss; miss == nil || miss.Kind != MissKeyNotFound || miss.Path != "" {
+		t.Errorf("后代段的原因为 %+v", miss)
+	}
+}
+
+func TestCropJson2ImageFooter(t *testing.T) {
+	inputData := `{"data": {"users": [{"name": "Alice"}, {"name": "Bob"}], "total": 2}}`
+	rules := []string{"data.users[*].name", "data.users[3].name", "data.count"}
+
+	base := DefaultConfig().WithCropRules(rules...)
+	plain, err := CropJson2Image(inputData, base)
+	if err != nil {
+		t.Fatalf("CropJson2Image失败: %v", err)
+	}
+
+	config := DefaultConfig().WithCropRules(rules...).WithUnmatchedRulesFooter(true)
+	withFooter, err := CropJson2Image(inputData, config)
+	if err != nil {
+		t.Fatalf("CropJson2Image失败: %v", err)
+	}
+	if plain == withFooter {
+		t.Error("开启页脚后图片应包含未匹配的规则")
+	}
+
+	if _, err := CropJson2Image(inputData, config, "output/output_crop_report.png"); err != nil {
+		t.Fatalf("保存图片失败: %v", err)
+	}
+	t.Log("裁剪报告图片生成成功：output/output_crop_report.png")
+}
+
+func TestCropReportAgreesWithCrop(t *testing.T) {
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"a": {"x": 1, "y": 2}, "n": null, "e": {}, "list": [{"v": null}]}`), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	// 末尾的 * 和值为 null 的节点在各种语法下都会被选中
+	tests := []struct {
+		rule  string
+		want  string
+		paths []string
+	}{
+		{"a.*", `{"a":{"x":1,"y":2}}`, []string{"a.x", "a.y"}},
+		{"$.a.*", `{"a":{"x":1,"y":2}}`, []string{"a.x", "a.y"}},
+		{"n", `{"n":null}`, []string{"n"}},
+		{"$.n", `{"n":null}`, []string{"n"}},
+		{"/n", `{"n":null}`, []string{"n"}},
+		{"list[*].v", `{"list":[{"v":null}]}`, []string{"list[0].v"}},
+	}
+	for _, tt := range tests {
+		output, report, err := JsonCropWithReport(input, []string{tt.rule})
+		if err != nil {
+			t.Fatalf("JsonCropWithReport(%q) 失败: %v", tt.rule, err)
+		}
+		if string(output) != tt.want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", tt.rule, output, tt.want)
+		}
+		if rule := report.Rules[0]; !reflect.DeepEqual(rule.Paths, tt.paths) || rule.Miss != nil {
+			t.Errorf("规则 %s 的报告为 %+v, 期望路径 %q", tt.rule, rule, tt.paths)
+		}
+	}
+
+	_, report, err := JsonCropWithReport(input, []string{"e.*"})
+	if err != nil {
+		t.Fatalf("JsonCropWithReport失败: %v", err)
+	}
+	if miss := report.Rules[0].Miss; miss == nil || miss.Kind != MissEmptyContainer || miss.Message != "e 是空对象" {
+		t.Errorf("空对象通配符的原因为 %+v", miss)
+	}
+}
@@ -0,0 +1,507 @@
+package json2image
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// filterContext 过滤表达式的求值上下文：root 为 $ 引用的文档根，current 为 @ 引用的当前节点
+type filterContext struct {
+	root    interface{}
+	current interface{}
+}
+
+// filterExpr 过滤表达式，用于 [?...] 选择器
+type filterExpr interface {
+	test(ctx filterContext) bool
+}
+
+// orExpr 逻辑或 a || b
+type orExpr []filterExpr
+
+func (e orExpr) test(ctx filterContext) bool {
+	for _, expr := range e {
+		if expr.test(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// andExpr 逻辑与 a && b
+type andExpr []filterExpr
+
+func (e andExpr) test(ctx filterContext) bool {
+	for _, expr := range e {
+		if !expr.test(ctx) {
+			return false
+		}
+	}
+	return true
+}
+
+// notExpr 逻辑非 !a
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e notExpr) test(ctx filterContext) bool {
+	return !e.expr.test(ctx)
+}
+
+// existsExpr 存在性测试：查询选中至少一个节点时为真
+type existsExpr struct {
+	query filterQuery
+}
+
+func (e existsExpr) test(ctx filterContext) bool {
+	return len(e.query.nodes(ctx)) > 0
+}
+
+// compareExpr 比较 left op right，op 为 ==、!=、<、<=、>、>= 或 contains
+type compareExpr struct {
+	left, right filterOperand
+	op          string
+}
+
+func (e compareExpr) test(ctx filterContext) bool {
+	left, leftOK := e.left.value(ctx)
+	right, rightOK := e.right.value(ctx)
+
+	switch e.op {
+	case "==":
+		return leftOK == rightOK && (!leftOK || jsonEqual(left, right))
+	case "!=":
+		return !(leftOK == rightOK && (!leftOK || jsonEqual(left, right)))
+	case "contains":
+		return leftOK && rightOK && jsonContains(left, right)
+	}
+	if !leftOK || !rightOK {
+		return false
+	}
+
+	// 大小比较只在数字之间或字符串之间进行
+	var cmp int
+	if a, ok := toFloat(left); ok {
+		b, ok := toFloat(right)
+		if !ok {
+			return false
+		}
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		}
+	} else if a, ok := left.(string); ok {
+		b, ok := right.(string)
+		if !ok {
+			return false
+		}
+		cmp = strings.Compare(a, b)
+	} else {
+		return false
+	}
+
+	switch e.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// matchExpr 正则匹配，match 函数的正则在解析时已锚定到整个字符串
+type matchExpr struct {
+	operand filterOperand
+	re      *regexp.Regexp
+}
+
+func (e matchExpr) test(ctx filterContext) bool {
+	value, ok := e.operand.value(ctx)
+	if !ok {
+		return false
+	}
+	s, ok := value.(string)
+	return ok && e.re.MatchString(s)
+}
+
+// filterOperand 比较的操作数，value 返回 false 表示操作数不存在
+type filterOperand interface {
+	value(ctx filterContext) (interface{}, bool)
+}
+
+// literalOperand 字面量：字符串、数字、true、false 或 null
+type literalOperand struct {
+	literal interface{}
+}
+
+func (o literalOperand) value(filterContext) (interface{}, bool) {
+	return o.literal, true
+}
+
+// filterQuery 以 @ 或 $ 开头的查询
+type filterQuery struct {
+	absolute bool
+	path     *jsonPath
+}
+
+// nodes 返回查询选中的节点
+func (q filterQuery) nodes(ctx filterContext) []pathNode {
+	if q.absolute {
+		return q.path.query(ctx.root, ctx.root)
+	}
+	return q.path.query(ctx.root, ctx.current)
+}
+
+// value 查询恰好选中一个节点时返回该节点的值
+func (q filterQuery) value(ctx filterContext) (interface{}, bool) {
+	nodes := q.nodes(ctx)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0].value, true
+}
+
+// toFloat 将JSON数字转换为 float64
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// jsonEqual 按JSON语义比较两个值，数字按数值比较，对象和数组逐项比较
+func jsonEqual(a, b interface{}) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, exists := b[key]
+			if !exists || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case string, bool, nil:
+		return a == b
+	}
+	return false
+}
+
+// jsonContains 字符串包含子串，或数组包含相等的元素
+func jsonContains(container, item interface{}) bool {
+	switch container := container.(type) {
+	case string:
+		s, ok := item.(string)
+		return ok && strings.Contains(container, s)
+	case []interface{}:
+		for _, element := range container {
+			if jsonEqual(element, item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseFilter 解析过滤表达式：
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" or ")" | function | comparison
+//	function   = ( "exists" | "match" | "search" ) "(" ... ")"
+//	comparison = operand [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" | "contains" ) operand | "=~" 正则 ]
+//
+// 单独的查询表示存在性测试
+func (p *pathParser) parseFilter() (filterExpr, error) {
+	var or orExpr
+	for {
+		p.skipSpace()
+		and, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, and)
+		p.skipSpace()
+		if !p.consume("||") {
+			break
+		}
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+// parseAnd 解析 && 连接的表达式
+func (p *pathParser) parseAnd() (filterExpr, error) {
+	var and andExpr
+	for {
+		p.skipSpace()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, expr)
+		p.skipSpace()
+		if !p.consume("&&") {
+			break
+		}
+	}
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+// parseUnary 解析取反、括号、函数或比较
+func (p *pathParser) parseUnary() (filterExpr, error) {
+	switch {
+	case p.consume("!"):
+		p.skipSpace()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	case p.consume("("):
+		expr, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("缺少 )")
+		}
+		return expr, nil
+	}
+
+	start := p.pos
+	switch p.parseWord() {
+	case "exists":
+		return p.parseExists()
+	case "match":
+		return p.parseMatchFunction(true)
+	case "search":
+		return p.parseMatchFunction(false)
+	}
+	p.pos = start
+	return p.parseComparison()
+}
+
+// parseWord 解析由小写字母组成的关键字
+func (p *pathParser) parseWord() string {
+	start := p.pos
+	for !p.eof() && p.peek() >= 'a' && p.peek() <= 'z' {
+		p.pos++
+	}
+	return p.rule[start:p.pos]
+}
+
+// parseExists 解析 exists(query)
+func (p *pathParser) parseExists() (filterExpr, error) {
+	p.skipSpace()
+	if !p.consume("(") {
+		return nil, p.errorf("exists 之后应为 (")
+	}
+	p.skipSpace()
+	query, err := p.parseQuery()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.consume(")") {
+		return nil, p.errorf("缺少 )")
+	}
+	return existsExpr{query: query}, nil
+}
+
+// parseMatchFunction 解析 match(operand, 正则) 或 search(operand, 正则)
+func (p *pathParser) parseMatchFunction(full bool) (filterExpr, error) {
+	p.skipSpace()
+	if !p.consume("(") {
+		return nil, p.errorf("函数名之后应为 (")
+	}
+	p.skipSpace()
+	operand, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.consume(",") {
+		return nil, p.errorf("应为 ,")
+	}
+	p.skipSpace()
+	re, err := p.parseRegexp(full)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.consume(")") {
+		return nil, p.errorf("缺少 )")
+	}
+	return matchExpr{operand: operand, re: re}, nil
+}
+
+// parseRegexp 解析引号包围的正则表达式，full 为真时要求整个字符串匹配
+func (p *pathParser) parseRegexp(full bool) (*regexp.Regexp, error) {
+	start := p.pos
+	if c := p.peek(); c != '\'' && c != '"' {
+		return nil, p.errorf("正则表达式应为字符串")
+	}
+	pattern, err := p.parseQuoted()
+	if err != nil {
+		return nil, err
+	}
+	if full {
+		pattern = `^(?:` + pattern + `)$`
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("无效的正则表达式: %v", err)
+	}
+	return re, nil
+}
+
+// filterOperators 比较运算符，较长的运算符在前
+var filterOperators = []string{"==", "!=", "<=", ">=", "=~", "<", ">"}
+
+// parseComparison 解析比较，没有运算符的查询表示存在性测试
+func (p *pathParser) parseComparison() (filterExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+
+	op := ""
+	for _, candidate := range filterOperators {
+		if p.consume(candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		start := p.pos
+		if p.parseWord() == "contains" {
+			op = "contains"
+		} else {
+			p.pos = start
+		}
+	}
+
+	if op == "" {
+		query, ok := left.(filterQuery)
+		if !ok {
+			return nil, p.errorf("字面量之后应为比较运算符")
+		}
+		return existsExpr{query: query}, nil
+	}
+
+	p.skipSpace()
+	if op == "=~" {
+		re, err := p.parseRegexp(false)
+		if err != nil {
+			return nil, err
+		}
+		return matchExpr{operand: left, re: re}, nil
+	}
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return compareExpr{left: left, right: right, op: op}, nil
+}
+
+// parseOperand 解析查询或字面量
+func (p *pathParser) parseOperand() (filterOperand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		return p.parseQuery()
+	case c == '\'' || c == '"':
+		s, err := p.parseQuoted()
+		return literalOperand{literal: s}, err
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case p.eof():
+		return nil, p.errorf("缺少操作数")
+	}
+
+	start := p.pos
+	switch p.parseWord() {
+	case "true":
+		return literalOperand{literal: true}, nil
+	case "false":
+		return literalOperand{literal: false}, nil
+	case "null":
+		return literalOperand{literal: nil}, nil
+	}
+	p.pos = start
+	return nil, p.errorf("无效的操作数 %q", p.peek())
+}
+
+// parseQuery 解析 @ 或 $ 开头的查询，查询在遇到非 . 和 [ 的字符时结束
+func (p *pathParser) parseQuery() (filterQuery, error) {
+	query := filterQuery{absolute: p.peek() == '$', path: &jsonPath{}}
+	if !p.consume("@") && !p.consume("$") {
+		return filterQuery{}, p.errorf("查询应以 @ 或 $ 开头")
+	}
+	for p.peek() == '.' || p.peek() == '[' {
+		segment, err := p.parseSegment()
+		if err != nil {
+			return filterQuery{}, err
+		}
+		query.path.segments = append(query.path.segments, segment)
+	}
+	return query, nil
+}
+
+// parseNumber 解析JSON数字
+func (p *pathParser) parseNumber() (filterOperand, error) {
+	start := p.pos
+	p.consume("-")
+	for !p.eof() && strings.IndexByte("0123456789.eE+-", p.peek()) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(p.rule[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("无效的数字")
+	}
+	return literalOperand{literal: n}, nil
+}
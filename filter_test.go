@@ -0,0 +1,132 @@
+package json2image
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFilterExpr(t *testing.T) {
+	var current interface{}
+	item := `{"name": "Alice", "age": 30, "status": "failed", "tags": ["x", "y"], "vip": true, "note": null}`
+	if err := json.Unmarshal([]byte(item), &current); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+	root := map[string]interface{}{"limit": 25.0}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"@.age > 25", true},
+		{"@.age>=30 && @.age<=30", true},
+		{"@.age < 25 || @.vip == true", true},
+		{"@.age > $.limit", true},
+		{"@.age == 30.0", true},
+		{`@.status == "failed"`, true},
+		{`@.status != 'failed'`, false},
+		{`@.name < "Bob"`, true},
+		{`@.tags contains "x"`, true},
+		{`@.tags contains "z"`, false},
+		{`@.name contains "lic"`, true},
+		{`@.name =~ "^A"`, true},
+		{`match(@.name, "Al")`, false},
+		{`match(@.name, "Al.*")`, true},
+		{`search(@.name, "lic")`, true},
+		{"@.note == null", true},
+		{"@.missing == null", false},
+		{"@.missing == @.other", true},
+		{"@.missing != 1", true},
+		{"@.missing > 1", false},
+		{"@.name > 1", false},
+		{"@.vip", true},
+		{"@.missing", false},
+		{"exists(@.note)", true},
+		{"!exists(@.missing)", true},
+		{"!(@.age > 25 && @.vip)", false},
+	}
+	for _, tt := range tests {
+		p := &pathParser{rule: tt.expr}
+		expr, err := p.parseFilter()
+		if err != nil {
+			t.Errorf("parseFilter(%q) 失败: %v", tt.expr, err)
+			continue
+		}
+		if !p.eof() {
+			t.Errorf("parseFilter(%q) 在第 %d 个字符处停止", tt.expr, p.pos+1)
+			continue
+		}
+		if got := expr.test(filterContext{root: root, current: current}); got != tt.want {
+			t.Errorf("%s = %v, 期望 %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestFilterCrop(t *testing.T) {
+	var input map[string]interface{}
+	document := `{
+		"users": [
+			{"name": "Alice", "age": 25},
+			{"name": "Bob", "age": 30},
+			{"name": "Carol", "age": 41}
+		],
+		"orders": [
+			{"id": 1, "status": "ok", "tags": ["a"]},
+			{"id": 2, "status": "failed", "tags": ["x"]},
+			{"id": 3, "status": "failed", "tags": ["a", "x"]}
+		]
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	tests := []struct {
+		rules []string
+		want  string
+	}{
+		{[]string{"users[?(@.age > 25)].name"}, `{"users":[{},{"name":"Bob"},{"name":"Carol"}]}`},
+		{[]string{"$.users[?@.age > 25].name"}, `{"users":[{},{"name":"Bob"},{"name":"Carol"}]}`},
+		{[]string{`orders[?(@.status == "failed")].id`}, `{"orders":[{},{"id":2},{"id":3}]}`},
+		{[]string{`orders[?(@.tags contains "a" && @.id > 1)]`}, `{"orders":[{},{},{"id":3,"status":"failed","tags":["a","x"]}]}`},
+		{[]string{`$.orders[?@.status == 'failed' && @.tags contains 'a'].id`}, `{"orders":[{},{},{"id":3}]}`},
+		{[]string{"users[?(@.name =~ 'o')].age"}, `{"users":[{},{"age":30},{"age":41}]}`},
+		{[]string{"users[?(@.age > 100)].name"}, `{}`},
+		{[]string{"$..[?@.id == 1].status"}, `{"orders":[{"status":"ok"}]}`},
+	}
+	for _, tt := range tests {
+		output, err := JsonCrop(input, tt.rules)
+		if err != nil {
+			t.Errorf("JsonCrop(%q) 失败: %v", tt.rules, err)
+			continue
+		}
+		if string(output) != tt.want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", tt.rules, output, tt.want)
+		}
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	tests := []struct {
+		rule string
+		pos  int
+	}{
+		{"users[?(@.age > )].name", 16},
+		{"users[?(@.age > 25].name", 18},
+		{"users[?(@.age 25)].name", 14},
+		{"users[?(@.name =~ '(')].name", 18},
+		{"$.users[?@.age >]", 16},
+		{"$.users[?'a']", 12},
+		{`$.users[?@.tags == ["x"]]`, 19},
+	}
+	for _, tt := range tests {
+		_, err := JsonCrop(map[string]interface{}{}, []string{tt.rule})
+		var ruleErr *RuleError
+		if !errors.As(err, &ruleErr) {
+			t.Errorf("JsonCrop(%q) 应返回语法错误, 实际为 %v", tt.rule, err)
+			continue
+		}
+		if ruleErr.Pos != tt.pos {
+			t.Errorf("JsonCrop(%q) 错误位置为 %d, 期望 %d: %v", tt.rule, ruleErr.Pos, tt.pos, err)
+		}
+	}
+}
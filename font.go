@@ -0,0 +1,358 @@
+package json2image
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/BeCrafter/json2image/fonts"
+	// Monaco 作为备选字体默认内置，其余内置字体需导入对应的子包
+	_ "github.com/BeCrafter/json2image/fonts/monaco"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+)
+
+// fallbackFontType 主字体加载失败时使用的备选字体，随库默认内置
+const fallbackFontType = FontTypeMonaco
+
+// builtinFontNames 内置字体类型与注册名称的对应关系
+var builtinFontNames = map[FontType]string{
+	FontTypeMonaco:   fonts.NameMonaco,
+	FontTypeMsyh:     fonts.NameMsyh,
+	FontTypePingFang: fonts.NamePingFang,
+	FontTypeWrjs:     fonts.NameWrjs,
+}
+
+// fontSource 已注册字体的来源，data 与 path 二选一
+type fontSource struct {
+	data  []byte
+	path  string
+	index int // index 字体集合（.ttc）中的字体序号
+}
+
+var (
+	fontRegistryMu sync.RWMutex
+	fontRegistry   = make(map[string]fontSource)
+)
+
+// FontNotRegisteredError 字体未注册
+type FontNotRegisteredError struct {
+	Name string // Name 字体注册名称
+}
+
+func (e *FontNotRegisteredError) Error() string {
+	switch {
+	case e.Name == fonts.NameMsyh || e.Name == fonts.NamePingFang:
+		// 微软雅黑、苹方受授权限制不随仓库发布，需先生成子包
+		return fmt.Sprintf("字体 %s 未注册，该字体不随仓库发布，请先执行 sh ./scripts/convert.sh %s 生成 github.com/BeCrafter/json2image/fonts/%s 子包后导入", e.Name, e.Name, e.Name)
+	case isBuiltinFontName(e.Name):
+		return fmt.Sprintf("字体 %s 未注册，请导入 github.com/BeCrafter/json2image/fonts/%s", e.Name, e.Name)
+	}
+	return fmt.Sprintf("字体 %s 未注册，请先调用 RegisterFont 或 RegisterFontFile", e.Name)
+}
+
+// isBuiltinFontName 判断是否为内置字体的注册名称
+func isBuiltinFontName(name string) bool {
+	for _, builtin := range builtinFontNames {
+		if builtin == name {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterFont 以字体数据注册字体，同名字体（包括内置字体）会被覆盖
+func RegisterFont(name string, data []byte) {
+	fontRegistryMu.Lock()
+	defer fontRegistryMu.Unlock()
+	fontRegistry[name] = fontSource{data: data}
+}
+
+// RegisterFontFile 以字体文件路径注册字体，渲染时按需读取文件。
+// 字体集合（.ttc）注册其中的第一个字体
+func RegisterFontFile(name, path string) error {
+	return RegisterFontCollection(name, path, 0)
+}
+
+// RegisterFontCollection 以字体集合（.ttc）文件路径和字体序号注册字体
+func RegisterFontCollection(name, path string, index int) error {
+	if err := checkFontFile(path); err != nil {
+		return err
+	}
+	if index < 0 {
+		return fmt.Errorf("字体序号不能为负数: %d", index)
+	}
+
+	fontRegistryMu.Lock()
+	defer fontRegistryMu.Unlock()
+	fontRegistry[name] = fontSource{path: path, index: index}
+	return nil
+}
+
+// LoadFontsFromDir 注册目录下的所有字体文件（不递归），以去掉扩展名的文件名作为字体名称，
+// 返回注册的字体名称
+func LoadFontsFromDir(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("读取字体目录失败: %v", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !isFontFileExt(filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if err := RegisterFontFile(name, filepath.Join(dir, entry.Name())); err != nil {
+			return names, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// isFontFileExt 判断是否为支持的字体文件扩展名
+func isFontFileExt(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".ttf", ".otf", ".ttc", ".otc":
+		return true
+	}
+	return false
+}
+
+// checkFontFile 检查字体文件是否存在且格式受支持
+func checkFontFile(path string) error {
+	if path == "" {
+		return fmt.Errorf("自定义字体路径不能为空")
+	}
+
+	// 检查文件是否存在
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return fmt.Errorf("字体文件不存在: %s", path)
+	}
+
+	// 检查文件扩展名
+	if ext := filepath.Ext(path); !isFontFileExt(ext) {
+		return fmt.Errorf("不支持的字体文件格式: %s", ext)
+	}
+	return nil
+}
+
+// lookupFont 按名称查找字体数据及其在字体集合中的序号，
+// 优先使用 RegisterFont 注册的字体，其次是内置字体子包
+func lookupFont(name string) ([]byte, int, error) {
+	fontRegistryMu.RLock()
+	source, ok := fontRegistry[name]
+	fontRegistryMu.RUnlock()
+
+	if ok {
+		if source.path == "" {
+			return source.data, 0, nil
+		}
+		data, err := os.ReadFile(source.path)
+		if err != nil {
+			return nil, 0, fmt.Errorf("读取字体文件失败: %v", err)
+		}
+		return data, source.index, nil
+	}
+
+	fontData, ok := fonts.Lookup(name)
+	if !ok {
+		return nil, 0, &FontNotRegisteredError{Name: name}
+	}
+	if len(fontData) == 0 {
+		return nil, 0, fmt.Errorf("字体数据为空")
+	}
+	return fontData, 0, nil
+}
+
+// loadFontData 根据配置获取字体数据及其在字体集合中的序号
+func loadFontData(config *Config) ([]byte, int, error) {
+	if config.Font.Name != "" {
+		return lookupFont(config.Font.Name)
+	}
+
+	switch config.Font.Type {
+	case FontTypeCustom:
+		if err := checkFontFile(config.Font.CustomPath); err != nil {
+			return nil, 0, err
+		}
+		data, err := os.ReadFile(config.Font.CustomPath)
+		if err != nil {
+			return nil, 0, fmt.Errorf("读取字体文件失败: %v", err)
+		}
+		return data, config.Font.FaceIndex, nil
+	case FontTypeSystem:
+		systemFont, err := findSystemFont(config.Font.SystemName)
+		if err != nil {
+			return nil, 0, err
+		}
+		data, err := os.ReadFile(systemFont.Path)
+		if err != nil {
+			return nil, 0, fmt.Errorf("读取字体文件失败: %v", err)
+		}
+		return data, systemFont.Index, nil
+	default:
+		name, ok := builtinFontNames[config.Font.Type]
+		if !ok {
+			return nil, 0, fmt.Errorf("未知的字体类型: %d", config.Font.Type)
+		}
+		return lookupFont(name)
+	}
+}
+
+// fontFace 已加载的字体
+type fontFace struct {
+	face   font.Face
+	covers func(r rune) bool // covers 判断字体是否包含字符对应的字形
+}
+
+// hasGlyph 判断字体是否包含字符对应的字形
+func (f fontFace) hasGlyph(r rune) bool {
+	return f.covers(r)
+}
+
+// fontChain 字体回退链，第一个为主字体
+type fontChain []fontFace
+
+// textRun 使用同一字体绘制的一段文本
+type textRun struct {
+	text string
+	face font.Face
+}
+
+// faceFor 返回第一个包含该字符字形的字体，都不包含时使用主字体
+func (c fontChain) faceFor(r rune) font.Face {
+	for _, f := range c {
+		if f.hasGlyph(r) {
+			return f.face
+		}
+	}
+	return c[0].face
+}
+
+// runs 按字形覆盖情况将文本拆分为使用不同字体的片段
+func (c fontChain) runs(text string) []textRun {
+	var runs []textRun
+	start := 0
+	var current font.Face
+	for i, r := range text {
+		face := c.faceFor(r)
+		if i > 0 && face != current {
+			runs = append(runs, textRun{text: text[start:i], face: current})
+			start = i
+		}
+		current = face
+	}
+	if start < len(text) {
+		runs = append(runs, textRun{text: text[start:], face: current})
+	}
+	return runs
+}
+
+// isFontCollection 判断字体数据是否为字体集合（.ttc/.otc）
+func isFontCollection(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("ttcf"))
+}
+
+// newFontFace 解析字体数据并创建指定字号的字体。
+// 普通TrueType字体使用 freetype 渲染，字体集合和CFF轮廓的OpenType字体使用 sfnt 解析
+func newFontFace(data []byte, index int, size float64) (fontFace, error) {
+	if isFontCollection(data) {
+		collection, err := sfnt.ParseCollection(data)
+		if err != nil {
+			return fontFace{}, fmt.Errorf("解析字体集合失败: %v", err)
+		}
+		if index < 0 || index >= collection.NumFonts() {
+			return fontFace{}, fmt.Errorf("字体序号超出范围: %d（共 %d 个字体）", index, collection.NumFonts())
+		}
+		f, err := collection.Font(index)
+		if err != nil {
+			return fontFace{}, fmt.Errorf("解析字体集合失败: %v", err)
+		}
+		return newSFNTFace(f, size)
+	}
+
+	if f, err := truetype.Parse(data); err == nil {
+		return fontFace{
+			face:   truetype.NewFace(f, &truetype.Options{Size: size}),
+			covers: func(r rune) bool { return f.Index(r) != 0 },
+		}, nil
+	}
+
+	// freetype 不支持CFF轮廓，改用 sfnt 解析
+	f, err := sfnt.Parse(data)
+	if err != nil {
+		return fontFace{}, fmt.Errorf("解析字体失败: %v", err)
+	}
+	return newSFNTFace(f, size)
+}
+
+// newSFNTFace 使用 sfnt 解析结果创建字体
+func newSFNTFace(f *sfnt.Font, size float64) (fontFace, error) {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72})
+	if err != nil {
+		return fontFace{}, fmt.Errorf("创建字体失败: %v", err)
+	}
+
+	var buf sfnt.Buffer
+	return fontFace{
+		face: face,
+		covers: func(r rune) bool {
+			index, err := f.GlyphIndex(&buf, r)
+			return err == nil && index != 0
+		},
+	}, nil
+}
+
+// loadFontFace 根据配置加载主字体
+func loadFontFace(config *Config) (fontFace, error) {
+	data, index, err := loadFontData(config)
+	if err != nil {
+		return fontFace{}, err
+	}
+	return newFontFace(data, index, config.Font.Size)
+}
+
+// loadFontChain 加载主字体及回退字体。主字体失败时返回错误（如未注册的字体返回 *FontNotRegisteredError），
+// 开启 FallbackToDefault 时改用备选字体；回退字体失败时跳过
+func loadFontChain(config *Config) (fontChain, error) {
+	primary, err := loadFontFace(config)
+	if err != nil {
+		if !config.Font.FallbackToDefault {
+			return nil, err
+		}
+		log.Printf("警告: 加载字体失败: %v，使用备选字体\n", err)
+		// 使用备选字体（Monaco）
+		fallbackConfig := *config
+		fallbackConfig.Font.Name = ""
+		fallbackConfig.Font.Type = fallbackFontType
+		primary, err = loadFontFace(&fallbackConfig)
+		if err != nil {
+			return nil, fmt.Errorf("加载备选字体失败: %v", err)
+		}
+	}
+
+	chain := fontChain{primary}
+	for _, name := range config.Font.Fallbacks {
+		data, index, err := lookupFont(name)
+		if err != nil {
+			log.Printf("警告: 加载回退字体 %s 失败: %v", name, err)
+			continue
+		}
+		fallback, err := newFontFace(data, index, config.Font.Size)
+		if err != nil {
+			log.Printf("警告: 加载回退字体 %s 失败: %v", name, err)
+			continue
+		}
+		chain = append(chain, fallback)
+	}
+	return chain, nil
+}
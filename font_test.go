@@ -0,0 +1,270 @@
+package json2image
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BeCrafter/json2image/fonts"
+	_ "github.com/BeCrafter/json2image/fonts/wrjs"
+)
+
+func TestLoadFontData(t *testing.T) {
+	// 测试默认字体
+	config := DefaultConfig()
+	data, _, err := loadFontData(config)
+	if err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+	t.Logf("Font data size: %d", len(data))
+}
+
+func TestLoadFontDataWithDifferentTypes(t *testing.T) {
+	// 测试不同的字体类型
+	fontTypes := []FontType{
+		FontTypeMonaco,
+		FontTypeWrjs,
+	}
+
+	for _, fontType := range fontTypes {
+		config := DefaultConfig().WithFont(fontType)
+		if _, err := loadFontFace(config); err != nil {
+			t.Errorf("Failed to load font type %v: %v", fontType, err)
+			continue
+		}
+		t.Logf("Font type %v loaded", fontType)
+	}
+}
+
+func TestLoadFontDataNotRegistered(t *testing.T) {
+	// 测试未导入子包的内置字体
+	for _, fontType := range []FontType{FontTypeMsyh, FontTypePingFang} {
+		if _, ok := fonts.Lookup(builtinFontNames[fontType]); ok {
+			continue
+		}
+
+		config := DefaultConfig().WithFont(fontType)
+		_, _, err := loadFontData(config)
+
+		var notRegistered *FontNotRegisteredError
+		if !errors.As(err, &notRegistered) {
+			t.Errorf("Expected FontNotRegisteredError for font type %v, got: %v", fontType, err)
+			continue
+		}
+		if notRegistered.Name != builtinFontNames[fontType] {
+			t.Errorf("Expected error for font %s, got %s", builtinFontNames[fontType], notRegistered.Name)
+		}
+	}
+}
+
+func TestJson2ImageFontNotRegistered(t *testing.T) {
+	// 公开接口同样返回 FontNotRegisteredError，开启 FallbackToDefault 后改用 Monaco
+	config := DefaultConfig().WithFontName("test-missing")
+	_, err := Json2Image(`{"a": 1}`, config)
+	var notRegistered *FontNotRegisteredError
+	if !errors.As(err, &notRegistered) || notRegistered.Name != "test-missing" {
+		t.Errorf("Expected FontNotRegisteredError from Json2Image, got: %v", err)
+	}
+	if _, err := CropJson2Image(`{"a": 1}`, config.WithCropRules("a")); !errors.As(err, &notRegistered) {
+		t.Errorf("Expected FontNotRegisteredError from CropJson2Image, got: %v", err)
+	}
+
+	if _, err := Json2Image(`{"a": 1}`, config.WithFallbackToDefault(true)); err != nil {
+		t.Errorf("Expected fallback to Monaco, got: %v", err)
+	}
+}
+
+func TestLoadFontDataWithCustomFont(t *testing.T) {
+	// 测试自定义字体（使用不存在的路径）
+	config := DefaultConfig().WithCustomFont("/path/to/nonexistent/font.ttf")
+	_, _, err := loadFontData(config)
+	if err == nil {
+		t.Error("Expected error for nonexistent font file, got nil")
+	}
+	t.Logf("Expected error for nonexistent font: %v", err)
+}
+
+func TestRegisterFont(t *testing.T) {
+	// 测试以字体数据注册字体
+	data, _, err := loadFontData(DefaultConfig().WithFont(FontTypeMonaco))
+	if err != nil {
+		t.Fatalf("Failed to load monaco font: %v", err)
+	}
+	RegisterFont("test-registered", data)
+
+	config := DefaultConfig().WithFontName("test-registered")
+	if _, err := loadFontFace(config); err != nil {
+		t.Errorf("Failed to load registered font: %v", err)
+	}
+
+	// 内置字体名称可作为别名使用
+	if _, err := loadFontFace(DefaultConfig().WithFontName(fonts.NameMonaco)); err != nil {
+		t.Errorf("Failed to load builtin font by name: %v", err)
+	}
+
+	// 未注册的字体返回 FontNotRegisteredError
+	_, _, err = loadFontData(DefaultConfig().WithFontName("test-missing"))
+	var notRegistered *FontNotRegisteredError
+	if !errors.As(err, &notRegistered) || notRegistered.Name != "test-missing" {
+		t.Errorf("Expected FontNotRegisteredError for test-missing, got: %v", err)
+	}
+
+	// 不随仓库发布的字体提示先生成子包
+	_, _, err = loadFontData(DefaultConfig().WithFont(FontTypeMsyh))
+	if err == nil || !strings.Contains(err.Error(), "scripts/convert.sh msyh") {
+		t.Errorf("Expected convert.sh hint for msyh, got: %v", err)
+	}
+}
+
+func TestLoadFontsFromDir(t *testing.T) {
+	// 测试从目录注册字体文件
+	data, _, err := loadFontData(DefaultConfig().WithFont(FontTypeMonaco))
+	if err != nil {
+		t.Fatalf("Failed to load monaco font: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test-dir-font.ttf"), data, 0644); err != nil {
+		t.Fatalf("Failed to write font file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "readme.txt"), []byte("not a font"), 0644); err != nil {
+		t.Fatalf("Failed to write text file: %v", err)
+	}
+
+	names, err := LoadFontsFromDir(dir)
+	if err != nil {
+		t.Fatalf("LoadFontsFromDir failed: %v", err)
+	}
+	if len(names) != 1 || names[0] != "test-dir-font" {
+		t.Fatalf("Expected [test-dir-font], got %v", names)
+	}
+
+	if _, err := loadFontFace(DefaultConfig().WithFontName("test-dir-font")); err != nil {
+		t.Errorf("Failed to load font registered from dir: %v", err)
+	}
+
+	if err := RegisterFontFile("test-bad-ext", filepath.Join(dir, "readme.txt")); err == nil {
+		t.Error("Expected error for unsupported font file extension, got nil")
+	}
+}
+
+func TestFontChainRuns(t *testing.T) {
+	// 测试按字形覆盖情况拆分文本
+	config := DefaultConfig().
+		WithFont(FontTypeMonaco).
+		WithFallbackFonts("test-missing", fonts.NameWrjs)
+
+	chain, err := loadFontChain(config)
+	if err != nil {
+		t.Fatalf("loadFontChain failed: %v", err)
+	}
+	if len(chain) != 2 {
+		t.Fatalf("Expected missing fallback to be skipped, got chain of %d fonts", len(chain))
+	}
+
+	if chain[0].hasGlyph('你') {
+		t.Skip("Monaco unexpectedly covers CJK, nothing to fall back")
+	}
+	if chain.faceFor('你') != chain[1].face {
+		t.Error("Expected CJK rune to use the fallback font")
+	}
+	if chain.faceFor('a') != chain[0].face {
+		t.Error("Expected ASCII rune to use the primary font")
+	}
+
+	runs := chain.runs(`"name": "张三",`)
+	expected := []string{`"name": "`, "张三", `",`}
+	if len(runs) != len(expected) {
+		t.Fatalf("Expected %d runs, got %d", len(expected), len(runs))
+	}
+	for i, run := range runs {
+		if run.text != expected[i] {
+			t.Errorf("Run %d: expected %q, got %q", i, expected[i], run.text)
+		}
+	}
+}
+
+// buildFontCollection 将多个字体文件拼接为字体集合（.ttc），并修正各字体的表偏移
+func buildFontCollection(fontsData ...[]byte) []byte {
+	header := make([]byte, 12+len(fontsData)*4)
+	copy(header[0:4], "ttcf")
+	binary.BigEndian.PutUint32(header[4:8], 0x00010000)
+	binary.BigEndian.PutUint32(header[8:12], uint32(len(fontsData)))
+
+	out := header
+	for i, data := range fontsData {
+		base := uint32(len(out))
+		binary.BigEndian.PutUint32(out[12+i*4:], base)
+
+		font := append([]byte(nil), data...)
+		numTables := int(binary.BigEndian.Uint16(font[4:6]))
+		for j := 0; j < numTables; j++ {
+			record := font[12+j*16 : 28+j*16]
+			binary.BigEndian.PutUint32(record[8:12], binary.BigEndian.Uint32(record[8:12])+base)
+		}
+		out = append(out, font...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	return out
+}
+
+func TestLoadFontCollection(t *testing.T) {
+	// 测试从字体集合中按序号加载字体
+	monaco, _, err := loadFontData(DefaultConfig().WithFont(FontTypeMonaco))
+	if err != nil {
+		t.Fatalf("Failed to load monaco font: %v", err)
+	}
+	wrjs, _, err := loadFontData(DefaultConfig().WithFont(FontTypeWrjs))
+	if err != nil {
+		t.Fatalf("Failed to load wrjs font: %v", err)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "collection.ttc")
+	if err := os.WriteFile(path, buildFontCollection(monaco, wrjs), 0644); err != nil {
+		t.Fatalf("Failed to write collection: %v", err)
+	}
+
+	first, err := loadFontFace(DefaultConfig().WithCustomFontCollection(path, 0))
+	if err != nil {
+		t.Fatalf("Failed to load collection index 0: %v", err)
+	}
+	second, err := loadFontFace(DefaultConfig().WithCustomFontCollection(path, 1))
+	if err != nil {
+		t.Fatalf("Failed to load collection index 1: %v", err)
+	}
+	if first.hasGlyph('你') || !second.hasGlyph('你') {
+		t.Error("Expected only the second font in the collection to cover CJK")
+	}
+
+	if _, err := loadFontFace(DefaultConfig().WithCustomFontCollection(path, 2)); err == nil {
+		t.Error("Expected error for out of range collection index, got nil")
+	}
+
+	// 系统字体扫描为集合中的每个字体建立索引
+	scanDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(scanDir, "monaco.ttc"), buildFontCollection(monaco, monaco), 0644); err != nil {
+		t.Fatalf("Failed to write collection: %v", err)
+	}
+	installed := scanSystemFonts([]string{scanDir})
+	if len(installed) != 2 || installed[1].Index != 1 {
+		t.Errorf("Expected 2 indexed fonts in collection, got %+v", installed)
+	}
+}
+
+func TestLoadCFFFont(t *testing.T) {
+	// 测试freetype无法解析的CFF轮廓OpenType字体
+	config := DefaultConfig().WithCustomFont("testdata/CFFTest.otf")
+	face, err := loadFontFace(config)
+	if err != nil {
+		t.Fatalf("Failed to load CFF font: %v", err)
+	}
+	if _, ok := face.face.GlyphAdvance('0'); !ok {
+		t.Error("Expected CFF font to provide glyph advance")
+	}
+}
@@ -0,0 +1,60 @@
+// Package fonts 管理内置字体数据的注册。
+//
+// 每个内置字体都位于独立的子包中（如 fonts/monaco、fonts/wrjs），子包通过 go:embed
+// 嵌入原始字体文件并在 init 时调用 Register 完成注册。只有被导入的字体才会编译进
+// 最终的二进制文件：
+//
+//	import _ "github.com/BeCrafter/json2image/fonts/wrjs"
+//
+// 子包由 go generate 调用 scripts/cmd 中的转换工具生成，工具会校验字体能被解析。
+// 微软雅黑、苹方等受授权限制的字体不随仓库发布，可使用 scripts/convert.sh
+// 将本地字体文件转换为同样结构的子包后再导入。
+package fonts
+
+//go:generate go run ../scripts/cmd --out . ../scripts/fonts/Monaco.ttf
+//go:generate go run ../scripts/cmd --out . ../scripts/fonts/wrjs.ttf
+
+import (
+	"sort"
+	"sync"
+)
+
+// 内置字体的注册名称
+const (
+	NameMonaco   = "monaco"   // NameMonaco Monaco字体
+	NameMsyh     = "msyh"     // NameMsyh 微软雅黑字体
+	NamePingFang = "pingfang" // NamePingFang 苹方字体
+	NameWrjs     = "wrjs"     // NameWrjs 王壬金石字体
+)
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string][]byte)
+)
+
+// Register 注册字体数据，同名字体会被覆盖
+func Register(name string, data []byte) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[name] = data
+}
+
+// Lookup 查找已注册的字体数据
+func Lookup(name string) ([]byte, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	data, ok := registry[name]
+	return data, ok
+}
+
+// Names 返回所有已注册的字体名称（按字母排序）
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
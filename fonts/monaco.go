@@ -1,4777 +0,0 @@
-package fonts
-
-const MonacoFontData0 = `AAEAAAAZAQAABAGAT1MvMs1K4VsAAAK8AAAAYFphcGaExCqTAAJOSAAAmxxiZGF0yNCo9QAAoggAAEm6YmxvYxzU3SkAAGUEAAAdpGJzbG4FfgkYAAACdAAAAEhjbWFwBa` +
-	`47LAAAgqgAAB9eY3Z0IFOwLeEAAAMcAAAD9mZkc2NAGSs1AAACDAAAADBmZWF0AAUCGgAAAawAAAAcZm9uZFkmf6YAATiYAAB+uGZwZ23RXdSbAAAQuAAABRhnYXNwABcACQAAAZwAAAAQZ2x5ZphA` +
-	`SYIABO/YAAM28GhkbXjY6JVEAAG3UAAAlvhoZWFkKXMOeAAAAjwAAAA2aGhlYQo8Bt0AAAHoAAAAJGhtdHjukp70AAAwkAAAGjhsb2NhCWQ+dAAASsgAABo8bWF4cA7XBg8AAAHIAAAAIG1ldGGy+e` +
-	`VsAAAbEAAAFYBtb3J4xr9m2gAC6WQAAgZ0bmFtZaT4SJAAAAcUAAAEkHBvc3SD2NRiAADrxAAATNRwcmVwL6SCBgAAFdAAAAU+cHJvcDRQlDAAAAukAAAFFAAAAAMACAACABAAAf//AAMAAQAAAAEA` +
-	`AAAAAAAAAQABAAAAGAAAAQAAAgEBAAEAAAaOAHAADABOAAUAAgAQABAAPAAAB+gFPgADAAEAAQAACAD+AACrBNr7H/10Bm8AAQAAAAAAAAAAAAAAAAAABo4AAQAAAAAABXdnaHQAAQAAd2R0aAABAA` +
-	`BzbG50AAAAAG9wc3oADAAAbmFsZgAAAAAAAQAAAAIAADh7iH9fDzz1AREIAAAAAABe4sWAAAAAAN7gLz37H/yiBm8JyAAAAAkAAQABAAAAAAABAAAAAAAAAAADCAMIBhACdQAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADBMwBkAAFAAACvAKKAAAAjAK8AooAAAHdADIA+gAAAAAAAAAAAAAAAKAAAv9QADn7AAAAAAAAAABweXJzAEAAAPsCCAD+AA` +
-	`CrCcgDXiAAAZdPAAAABF0GEAAAACAAAAZOAB8GEAAvBhAALgRdAB8AAP/RAAD/4QAA/9H+Tv/hBm0AAP6MAAACbQAXAL4AAAC+AAAAAAAAAAAAmwC6ALoARwB8AHwAmwDKAJsAmwCbALoAswFqAcL/` +
-	`uADKAJsEPgCRAPgBswDKAAIAdv//AEgAtACbAKsAIQAuAH4BFAAPAMIBHwCbAJwCHwAQACEAfgCbAUkBsf5EAAsAOABXAg3/cQA+AMkAyQPOACEAXQB9AI0AlQCdAKwBEQFAAUYBkAHG/0wAugC8AX` +
-	`EB0QTK/6kAAgAfAC8ARwCZAJsAugDCAUYBTwFxAAgAHwAuAEoAXQCXAbgDdASM/2P/gv+K/9AAFAAfAE8AXQBqAHwAfgCbAJsAtADTANkCCgLKA6MDyv61/uP/U/9z/37/uP/g/+D/4ABIAHQAfQCN` +
-	`AJQAmQCjALYAugC6AMkA4gD4AZgB8QNlA6sERgRS/y3/Z/+C//8AAQAgAC0APgBHAFUAVgByAHUAegCZALoA2wDjAPgA+gEfAScBLgFDAVUBegGQAdcCHwJBAk0DJwNGA7IDygQfBC8EZQR7Bm394P` +
-	`5z/w7/sAAFADMASABUAGUAbwB9AH4AfwCRAJsAqwC6APgBAAERARcBKgFVAVgBowGpAekCAAIXAiwCMAJJAk4CZQJtAvkDFANUA1UDYQSMB7L+Kf6d/vj/B/9H/5n/oQABAAIABAAIAAgAGQAeACcA` +
-	`KQAwADYAPwBIAE8AVQBWAF8AYwBmAGwAdAB2AIUAlACYAJkAvAC8AMEAwQDLAMsAzQDQANsA8ADyAPgA+QD6AQgBEQEfAScBLgE2AUMBVwFjAXUBgAGyAbsBxwHRAdUB4wHpAfgCSwKYAqsCvALwAw` +
-	`gDbAN0A4wDqwPKA9kD4QPhA/gD/AQfBB8EJwRWBVH8Bf6Y/sj+7//B/9AAKAAwAGcAbQCEAJMAqQC0ALoAxQDGANEA0gDiAOoA8ADwAPUBAwEPARABEQEYAR8BMwE0ATgBOAE/AUoBVgFZAWgBbQF1` +
-	`AXwBgQGUAZsBsgGyAcoB0QHxAgUCGAJKAowClAKqAtoC4QMIAycDPwNGA0YDjAOjA/sEHwQ+BD4EPwRlBG0EhASKBKQEygV0BewGEAeEB+EAvgDKAL0AwgAAAAAAAAAAAAAAAACrAKMHwgCEAJ0Amw` +
-	`CyANAAqwCaAHoAuwCrALoAbACrBMYA7QEfAR8BcgEcAUYCNgDbASAAbgIaAYsBxAFOAiICowGpAekBeAMIAh8AAAAAAMoAuAHNAl0FnAD6Am0BTwICAU8B0QIeAKsEhQB8AKP75/ybAyT82/6EA4L8` +
-	`UP2bBQgAIAB5AIsA3QOSBB8ArACCADAAKgAAAAAAGwFKAAEAAAAAAAAAqwAAAAEAAAAAAAEABgCrAAEAAAAAAAIABwCxAAEAAAAAAAMAHAC4AAEAAAAAAAQABgDUAAEAAAAAAAUACADaAAEAAAAAAA` +
-	`YABgDiAAEAAAAAAQAACQDoAAEAAAAAAQEAEADxAAEABAAMAAAAwwEBAAEABAAfAAAAwwHEAAMAAQQEAAIABgKHAAMAAQQGAAIADgKNAAMAAQQHAAIADAKbAAMAAQQJAAIADgKnAAMAAQQLAAIAEAK1` +
-	`AAMAAQQMAAIADALFAAMAAQQQAAIAEALRAAMAAQQRAAIACgLhAAMAAQQSAAIABgLrAAMAAQQTAAIAEALxAAMAAQQUAAIADAMBAAMAAQQWAAIADAMNAAMAAQQZAAIADgMZAAMAAQQdAAIADAMnAAMAAQ` +
-	`gEAAIABgMzAAMAAQwKAAIADAM5qSAxOTkwLTIwMDggQXBwbGUgSW5jLiCpIDE5OTAtOTcgVHlwZSBTb2x1dGlvbnMgSW5jLiCpIDE5OTAtOTcgVGhlIEZvbnQgQnVyZWF1IEluYy4gIFRydWVUeXBl` +
-	`IG91dGxpbmUgZGVzaWduIG9mIE1vbmFjbyB0eXBlZmFjZSBjcmVhdGVkIGJ5IEtyaXMgSG9sbWVzIGFuZCBDaGFybGVzIEJpZ2Vsb3cuTW9uYWNvUmVndWxhck1vbmFjbzsgMTcuMGQxZTU7IDIwMj` +
-	`AtMDktMjFNb25hY28xNy4wZDFlNU1vbmFjb0xpZ2F0dXJlc0NvbW1vbiBMaWdhdHVyZXNDb3B5cmlnaHQgMTk5MC0yMDA4IEFwcGxlIEluYy4gQ29weXJpZ2h0IDE5OTAtOTcgVHlwZSBTb2x1dGlv` +
-	`bnMgSW5jLiBDb3B5cmlnaHQgMTk5MC05NyBUaGUgRm9udCBCdXJlYXUgSW5jLiAgVHJ1ZVR5cGUgb3V0bGluZSBkZXNpZ24gb2YgTW9uYWNvIHR5cGVmYWNlIGNyZWF0ZWQgYnkgS3JpcyBIb2xtZX` +
-	`MgYW5kIENoYXJsZXMgQmlnZWxvdy5Db3B5cmlnaHQgMTk5MC0yMDA4IEFwcGxlIEluYy4gQ29weXJpZ2h0IDE5OTAtOTcgVHlwZSBTb2x1dGlvbnMgSW5jLiBDb3B5cmlnaHQgMTk5MC05NyBUaGUg` +
-	`Rm9udCBCdXJlYXUgSW5jLiAgVHJ1ZVR5cGUgb3V0bGluZSBkZXNpZ24gb2YgTW9uYWNvIHR5cGVmYWNlIGNyZWF0ZWQgYnkgS3JpcyBIb2xtZXMgYW5kIENoYXJsZXMgQmlnZWxvdy5qGW6WmtQATw` +
-	`ByAGQAaQBuAOYAcgBOAG8AcgBtAGEAbABSAGUAZwB1AGwAYQByAE4AbwByAG0AYQBhAGwAaQBOAG8AcgBtAGEAbABSAGUAZwBvAGwAYQByAGUw7DCuMOUw6TD8x3y8GMy0AFIAZQBnAHUAbABpAGUA` +
-	`cgBOAG8AcgBtAGEAbABOAG8AcgBtAGEAbAQeBDEESwRHBD0ESwQ5AE4AbwByAG0AYQBsXjiJxE9TAE4AbwByAG0AYQBsAAADAAAAAQAAAAIABgDUAwAABwH4AAAAAAAAAAIAAQALAAMAAwAKAAUABA` +
-	`ALAAgABgAFAAoACQALAAsACxELAAwADB8LAA0ADQALAA4ADgAEAA8ADwAHABAAEAAEABIAEQAHABwAEwADAB0AHQAHAB4AHgALAB8AHxILACAAIAALACEAIR4LACMAIgALAD0AJAAAAD4APhILAD8A` +
-	`PwALAEAAQB4LAEMAQQALAF0ARAAAAF4AXhILAF8AXwALAGAAYB4LAGEAYQALAIEAYgAAAIIAggALAIUAgwAFAIgAhgALAIkAiQAAAI8AigALAJEAkAAAAJIAkgALAJMAkwAFAJQAlBELAJUAlR8LAJ` +
-	`YAlgAFAJcAlwAAAJoAmAALAJsAmwAAAJwAnAALAKEAnQAAAKUAogALAKYApgAAAKgApwALAKkAqRELAKoAqh8LAKsAqwALAKwArAAHALEArQAAALkAsgALALsAugAAALwAvAAHAL0AvQAFAL4AvhEL` +
-	`AL8Avx8LAMEAwAAAAMUAwgALAMYAxgAFANcAxwAAAOEA2AALAOcA4gAAAOgA6AALAO4A6QAAAO8A7wAFAPAA8AALAPMA8QADAPYA9AALAPcA9wAFAXEA+AAAAXIBcgALAXQBcwAAAXUBdQAFAYMBdg` +
-	`ALAd8BhAAAAeAB4AALAeIB4QAAAeMB4xELAeQB5B8LAeUB5RELAeYB5h8LAecB5xELAegB6B8LAekB6RELAeoB6h8LAesB6xELAewB7B8LAe0B7RELAe4B7h8LAe8B7wAAAfIB8AALAwIB8wAAAwMD` +
-	`AwALAx8DBAAAAyYDIAALAy4DJwAAAzIDLwALA04DMwAAA08DTwALA1ADUAAAA1EDUQALA1UDUgAAA1YDVgALA2ADVwAAA2MDYQALA24DZAAKA3EDbwALA4QDcgAAA4oDhQALA40DiwAAA44DjgALA4` +
-	`8DjwAAA5ADkAALA8oDkQAAA9ADywALA+cD0QAAA+gD6AALA+oD6QAAA/wD6wALBAED/QAABAUEAgALBCYEBgAABDoEJwALBD0EOwAFBEEEPgALBEIEQgAABEMEQwABBEQERAALBFAERQAABFEEUQAL` +
-	`BFkEUgAFBF8EWgALBGIEYAAABGwEYwADBG4EbQAFBG8EbwALBHAEcBELBHEEcR8LBHgEcgADBHoEeQAFBHsEewALBHwEfBELBH0EfR8LBH4EfgAABH8EfwALBIcEgAAABIgEiAAFBIkEiQALBIsEig` +
-	`AFBIwEjAAABJEEjQALBJIEkgAABJcEkwALBJkEmAAABJsEmgAKBJwEnAAIBKMEnQALBKQEpAAKBLIEpQALBLMEsxELBLQEtB8LBLYEtQAABMYEtwALBMcExwAABOQEyAALBPQE5QAABPUE9QALBPYE` +
-	`9gAFBRwE9wAABR4FHQALBR8FHwAABSYFIAALBScFJwAABSkFKAALBSoFKgAFBTkFKwALBUIFOgAABUMFQwALBU4FRAAABU8FTwALBVUFUAAABXYFVgALBYAFdwAABZgFgQALBZkFmQAFBaMFmgALBa` +
-	`QFpBELBaUFpR8LBawFpgALBbMFrQAABfAFtAALBgcF8QAABhIGCAALBhMGEwAABjgGFAALBkYGOQAABlcGRwALBlgGWBELBlkGWR8LBloGWhELBlsGWx8LBlwGXBELBl0GXR8LBl4GXhELBl8GXx8L` +
-	`BmcGYAALBo0GaAAA/////wAAAABAMjEwLy4tLCsqKSgnJiUkIyIhIB8eHRwbGhkYFxYVFBMSERAPDg0MCwoJCAcGBQQDAgEALEUjRmAgsCZgsAQmI0hILSxFI0YjYSCwJmGwBCYjSEgtLEUjRmCwIG` +
-	`EgsEZgsAQmI0hILSxFI0YjYbAgYCCwJmGwIGGwBCYjSEgtLEUjRmCwQGEgsGZgsAQmI0hILSxFI0YjYbBAYCCwJmGwQGGwBCYjSEgtLAEQIDwAPC0sIEUjILDNRCMguAFaUVgjILCNRCNZILDtUVgj` +
-	`ILBNRCNZILAEJlFYIyCwDUQjWSEhLSwgIEUYaEQgsAFgIEWwRnZoikVgRC0sAblAAAAACi0sALkAAEAACy0sIEWwAENhfWgYsABDYEQtLEWwGiNERbAZI0QtLCBFsAMlRWFksFBRWEVEGyEhWS0ssA` +
-	`FDYyNisAAjQrAPKy0sIEWwAENgRC0sAbAHQ7AGQwotLCBpsEBhsACLILEswIqMuBAAYmArDGQjZGFcWLADYVktLEWwESuwFyNEsBd65BgtLLgBplRYsAlDuAEAVFi5ABr/gLEZgEREWVktLEWwESuw` +
-	`F0WMsBcjRLAXeuUYLSywAiVGYWWKRrBAYItILSywAiVGYIpGsEBhjEgtLAEYLy0sILADJUWwGSNERbAaI0RFZSNFILADJWBqILAJI0IjaIpqYGEgsBqKsABSeSGyGhpAuf/gABpFIIpUWCMhsD8bI1` +
-	`lhRByxFACKUnmzGUAgGUUgilRYIyGwPxsjWWFELSy5GH47IQstLLktQS1BCy0suTshGH4LLSy5OyHngwstLLktQdLACy0suRh+xOALLSxLUlhFRBshIVktLAEgsAMlI0mwQGCwIGMgsABSWCOwAiU4` +
-	`I7ACJWU4AIpjOBshISEhIVkBLSxFabAJQ2CKEDotLAGwBSUQIyCK9QCwAWAj7ewtLAGwBSUQIyCK9QCwAWEj7ewtLAGwBiUQ9QDt7C0sILABYAEQIDwAPC0sILABYQEQIDwAPC0ssCsrsCoqLSwAsA` +
-	`ZDZbAHQwstLD6wKiotLDUtLHawGyNwECCwG0UgsABQWLABYVk6LxgtLCEhDGQjZIu4QABiLSwhsIBRWAxkI2SLuCAAYhuyAEAvK1mwAmAtLCGwwFFYDGQjZIu4FVViG7IAgC8rWbACYC0sDGQjZIu4` +
-	`QABiYCMhLSy0AAEAAAAVsAgmsAgmsAgmsAgmDxAWE0VoOrABFi0stAABAAAAFbAIJrAIJrAIJrAIJg8QFhNFaGU6sAEWLbgAMixLuAAJUFixAQGOWbgB/4W4AEQduQAJAANfXi24ADMsICBFaUSwAW` +
-	`AtuAA0LLgAMyohLbgANSwgRrADJUZSWCNZIIogiklkiiBGIGhhZLAEJUYgaGFkUlgjZYpZLyCwAFNYaSCwAFRYIbBAWRtpILAAVFghsEBlWVk6LbgANiwgRrAEJUZSWCOKWSBGIGphZLAEJUYgamFk` +
-	`Ulgjilkv/S24ADcsSyCwAyZQWFFYsIBEG7BARFkbISEgRbDAUFiwwEQbIVlZLbgAOCwgIEVpRLABYCAgRX1pGESwAWAtuAA5LLgAOCotuAA6LEsgsAMmU1iwgBuwQFmKiiCwAyZTWCMhsMCKihuKI1` +
-	`kgsAMmU1gjIbgBAIqKG4ojWSCwAyZTWCMhuAFAioobiiNZILgAAyZTWLADJUW4AYBQWCMhuAGAIyEbsAMlRSMhIyFZGyFZRC24ADssS1NYRUQbISFZLbgAMitAIS9Of06vLq9nBC9rL5Uwa89rz5Xw` +
-	`awYvPTA9zz3QPfA9BbgBarItNh+9AfUB9gAhAB8B9AHksiIfUEEbAXMAAQDwAXMAAQBgAXQAcAF0AIABdADgAXQABAAgAXQAkAF0APABdAADAFABdACwAXQAAgHxQBcIMh9RCBUfDQszHwcGMx8JCD` +
-	`EfAwIxH7gBw7YkGR/AJBkfuAEvsiQZH7gBwrIkGR9BDQG/AbsAgQAfAb0BuwgBAB8BvAG7AIEAHwG7sjqBH7wBugG5AFIAHwG5sh81H7gBuLImgR+4AbeyOjgfvAG2AB8BAQAfAbWyH0MfvAGzAbQA` +
-	`UgAfAbSyJC4fuAGxsiQ1H7gBsLQngR8IQLsBpgAAAAkBarItNh+4ASqyLS0fQQsBIgBiASUAHwEhAGIBJQAfAR4AIwQBth/lHyMf4zq4BAFAGx/gI0ofvi0tH7kjPx+4IzYfpS0tH6Qtqx+jUrgEAb` +
-	`IfomK4BAGyH6FiuAQBsh+fI7gBAbIfniO4BAG2H5sjRR+HI7gEAbYfhSM7H25iuAQBsh9jYrgEAbIfU1K4BAGyH0gjuAQBth9HI0cfQyO4CAGyH0IjuAQBsh8pYrgBJbIfKGK4BAGyHyYjuAQBsh8l` +
-	`I7gEAUALHyIjQx8hI0MfHSO4BAFAOx9VGAkYCZB3B5BgB5BPB5BAB5A+B5AxB5AwB5ArB5AqB5AeBxQIEggQCA4IDAgKCAgIBggECAIIAAgUuP/gQCsAAAEAFAYQAAABAAYEAAABAAQQAAABABACAA` +
-	`ABAAIAAAABAAACAQgCAEoAshQgFEVgRBi5AAEB/4WNFnY/GD8SPhE5RkQ+ETlGRD4ROUZEPhE5RkQ+ETlGYEQ+ETlGYEQrKysrKysrKysrKxgrKysrKysrKysrAUtQebwAHwFsAAcAHwENtgcf6Qcf` +
-	`SgcrKysrS1N5vACQAWwABwCQAQ22B5DpB5BKBysrKysYHbCWS1NYsKodWbAyS1NYsP8dWUuwnlMgXFi5AagBpkVEuQGnAaZFRFlYuQOkAahFUli5AagDpERZWUuwnlMgXFi5AB8BqEVEuQAtAahFRF` +
-	`lYuQOfAB9FUli5AB8Dn0RZWUu4BAFTIFxYuQAkAadFRLkAMwGnRURZWLkZQAAkRVJYuQAkGUBEWVlLuAQBUyBcWLFiH0VEsR8fRURZWLkXQABiRVJYuQBiF0BEWVlLuAgBUyBcWLFSLUVEsS0tRURZ` +
-	`WLkygABSRVJYuQBSMoBEWVlLsGFTIFxYsSMjRUSxOiNFRFlYuQHRACNFUli5ACMB0URZWUu4AwFTIFxYsSMjRUSxJyNFRFlYuQ6IACNFUli5ACMOiERZWUu4AwFTIFxYsSMjRUSxOSNFRFlYuQ6IAC` +
-	`NFUli5ACMOiERZWSsrKysrKysrKysrKysrKysrKysrKysrKysrKysrKysrKysrZUKzPX1rlUVlI0VgI0VlYCNFYLCLdmgYsIBiICCxa31FZSNFILADJmBiY2ggsAMmYWWwfSNlRLBrI0QgsT2VRWUj` +
-	`RSCwAyZgYmNoILADJmFlsJUjZUSwPSNEsQCVRVRYsZVAZUSyPUA9RSNhRFmzLlBOZ0VlI0VgI0VlYCNFYLCJdmgYsIBiICCxTlBFZSNFILADJmBiY2ggsAMmYWWwUCNlRLBOI0QgsS5nRWUjRSCwAy` +
-	`ZgYmNoILADJmFlsGcjZUSwLiNEsQBnRVRYsWdAZUSyLkAuRSNhRFlFaVNCKysrKysrKysrKysrKysrKysrKysrKysrc3R1c3QrKytzc3MAAAAAAAEAAAAAAAAAQAAAAARkbG5nAAAAQAAAAFBzbG5n` +
-	`AAAAkAAAAixiaWxkAAACvAAAAgBhcHBsAAAEvAAAEMRjYSxjcyxjeSxkYSxkZSxlbixlcyxmaSxmcixocixodSxpZCxpcyxpdCxsdCxtcyxtdCxuYixubCxubixwbCxwdCxybyxzayxzdix0cix2aW` +
-	`FmLGFrLGFybixhc2EsYXN0LGF6LGJhLGJlLGJlbSxiZXosYmcsYm0sYnIsYnMsY2EsY2ViLGNnZyxjbyxjcyxjdixjeSxkYSxkYXYsZGUsZGplLGRzYixkeW8sZWJ1LGVuLGVvLGVzLGV0LGV1LGV3` +
-	`byxmaSxmaWwsZm8sZnIsZnVyLGZ5LGdhLGdhYSxnZCxnbCxnbixnc3csZ3V6LGd2LGhhdyxocixoc2IsaHUsaWEsaWQsaWcsaW8saXMsaXQsamJvLGptYyxqdixrYWosa2FtLGtjZyxrZGUsa2VhLG` +
-	`tocSxraSxrayxrbCxrbG4sa3NiLGtzZixrc2gsa3Usa3csa3ksbGIsbGcsbGt0LGxuLGx0LGx1LGx1byxsdXksbHYsbWVyLG1mZSxtZyxtZ2gsbWdvLG1pLG1rLG1uLG1vaCxtcyxtdCxteXYsbmFx` +
-	`LG5iLG5kLG5kcyxubCxubWcsbm4sbm5oLG5yLG5zbyxueSxueW4sb2Msb20sb3MscGwscHQscXUscm0scm4scm8scm9mLHJ1LHJ3LHJ3ayxzYXEsc2JwLHNjLHNjbixzZSxzZWgsc2VzLHNnLHNrLH` +
-	`NsLHNtbixzbixzbyxzcSxzcixzcyxzdCxzdixzdyx0ZW8sdGcsdGssdG4sdG8sdHIsdHJ2LHRzLHR0LHR3cSx1ayx1eix2ZSx2aSx2dW4sd2Esd2FlLHdvLHhoLHhvZyx5YXYseW8senWr+R0lfakW` +
-	`B8MrmEB1j0s301kClrfvao+zsiRUNhB0ynm0GBvhh2p6+hBhae3ADwsjS00Cox5q65DaiYKp20+1yBapNXnAqKXHgMXnKGDqILb4Fb1aDDe4754VbJ6ecdt0tKK0FlPEW0nGDosLF1fKBsw8akFKbZ` +
-	`5G4Ykz/apZ3pgpkgn9A7jLRi9RzmlRDyznzc/2HCRohi5w582Cb5gnXkF2wv2vWkr5kWSIFoMXMwEgRe3sRSVFSKC3oXDJr9q2Mp4+cedYW6qpu0k+NDF63oEszhRadiJN+OYB1Ck1n0qLv6ls9i/e` +
-	`BOGHDGxhV75/kQCoNpp/rZmP8Buz8zZo3knV4C+xeUqdzqa8hjhqka7wIGcpy62T5CcD1y+TyjqViBLMsau3a6OGQA7OF0aqugkmavaT8WLpGUp1aBmxSy7M31mvf1fZlMePnGZSnHcMyrnCLj4Y0D` +
-	`L95tmzx3na+Ucymd9exTfI9TxOb38VJ9OmD7+AYCDZgNYFBY6ag7Tipd3Y70arTcwIWIxB0NgenuMJkkR5Dhy4EW5PRcI//jBGvIV0lBOewYHotE1KFEUO/Vg5OadaLAQTb2g2GNRIKUVsfhjhssxI` +
-	`jx+Jl+UH+MVADsyNu3saapgp+WHtPLb4SRWfSFWl6W97jTWMGxHAbwPn8WmTXLmKaJvimEzWVQAAAAEAAAAAGAAAAACcAMoAOwBSAAAAAFpSUStQangrWW54bGEyZ3pJVlJ3SWlabERuWWFjSEJSWH` +
-	`lablVIOXplOWtlQUJvWmNTMVVVbEZkSGhnNGVCWVFKSEI3YTNkR0VUWkZBVDArZURWQkxrVVBOZ2RpUFVFOFBWUjZNVlVuU2pRWFVDd1hkeDBPZjM1TVVEOFFUU0JnMndkd0VHNTNFa3NtSmxFcFhB` +
-	`d1VDUjlnZVU0SVZnZ0VTVklsSkNjUFZ6OEJMa0krZDJGSFlSZDlBMkZzWjF3UUZCZDhJa2xMSWhaR1J4WUJibUYxSTBhZVhqVUdMQnM0S2lRcURXMS9WUkVjS0NrdUJneERNUXBGV1JVYWZIOXFCeW` +
-	`9QT1MwZlNHTVhkRXA0WVNSdGNUSXpJekEvUGlWOEN5bGNMRG96TURnK1RKUlNXMlZFSlVFa01DWkxBQVIvU2d4WWZSVUlTUWczV1FNc2JnWmxVa0Y5UmtwaFhnZzFjQXdGUlRBc0swSW5FRXgyU3dk` +
-	`K0tBZ2VlbXBvR0RVQUx5WTVuVk0vTUF4c1BqSS9JaG9LQ3pSNFpsa3JPeWdhSFdJVWVpODNURUJORUFNU1NWaExmMmxKSHdOdE5uRU5kVjg1V1NBQ01VUTZjVU1pUHk5ZGFHd3VWbENCTnlneE9YMH` +
-	`pPeklzZUFWbVNrcHVkMjEzRjBGREFYMTNhbjhhWjFwRlFqd2dOaVI0S21jNGMya3dOMmRHV0RoRUUzMS9Cd2NvT3lJRVZ3Y1ZUUVZMU2JZWEhUNDFYRlF6UENrTERqUTNNMHdIR1JSU01ob3lIbmxW` +
-	`RFE0ME9SRWFQRkpNT2o5cUJsUTFKMXhRSURSSEJnRUNIVTVJQmdKRVFDTkZaeDVnY2tVRDIzZ2lGbmw3UEI0ZlFHUnJNRlYrRjF3SEFuZEtlVDgyYWdoaEZHUnpRMHNoUnd0dlhYY0FhQUYyRERoRl` +
-	`IydGhkeDE2U2d4UUhSZytRR2Q0QlVZdE4ySFhTbUozWXg1Z0xUZ0RCRjkzUzNVWEZBb3hCVW84ZUI0RVJnWlNXeFpvUUFsbUh4OTNLak1MRHpKK0hSaG1FQXBFZm5Vd1FuY2JFbUkwSjB0bFhHb1la` +
-	`ZHNJTmlZYlNWOTJIV1lVUVZwVmNVcHZZV0p6Q1J4RVhFSVVUSEorU0JrVVdsc0dlMUlCUmhJWlIxVTBFUTQzQXdaN0ZBRlBXUm9hWEFoMGYzOGZNRDE2MTFka0gyVi9TVEFZZUdjOVp4NEdBd29xUE` +
-	`g5SExRY1NIRWw2TVFsRE5rb2RkM2xzSG1vY0ZHZDdhVEVyRkFWYU9pRkJOQmR0QXdRMGNRTTBXMEVjUlZHSld6SkllbE0xVXhCQmZBcEdjaWRJQndVbmUzVlVTQVpGWkFFQU9BMC9OeTgrQVVJZ0F3` +
-	`NFBOVG9VYlNZN0owTWdQVlpkZEQ4bFZpbE9BeHRIWDJoMVc4dGplUkVwQjNJRkdna2JDZzQ5S1NjdUtRY2hER0FoSVFzdkYzZERXRDR6Sm5WVVZWdEdleFp6QWdNT2VndDRFVElXQ0IwTGFDNXNhaV` +
-	`EzVVZza0J6RjFzaUU2QzBsV0JrNStFejFTSnpKVmVVbDZDUWxHZmk0dVBFY3Vaa2tyZEJ0RkRHcHdZUjA0SmxOU0pFbFBDVG9QR1ZaQkYxaGJGU040QlRFNlV6UXhPZ1dhVDBVV1BFQkFCWFZUWm5R` +
-	`aEQwQU9heDBHUkdjeEl6NFFVVlV1SVFBQUFqNFREbDVPQ0ZzWlp4NDFFbDB3VndJYlVVZEFRU0JUQVZGMVIxNFZRengwUGI1alNrMEJFbjhPYnhZM1psRTRQbm8xUVFZVU1WdEtYUzlHZmpRL1NSNT` +
-	`ZKaVkyZEE4OEp5TjRaMllhZkJ0emUzMFVMVUZuUWlsbUQyQS9SaTlIQWlCL25qcDBOVEV0UVFnQkR4WWZVbDh0VDBCY1R3b3ZURVpOQVJjZmN4MU5WeThFYldsemRYeDRDdzk1WVZ4Sk5sSW9NVkFk` +
-	`YUNjeFAxZ1JOeTFLTUFOeUdTbVdVMGxhTno4elRSbEJSWHBqZGhFbkppeGVEQVF3YkVvRUZHUmlKRFlOTGlaWmVpc2dMZ2NZRFVnQUVrTUxJREpCQzJsbld6QWxUUlVyVXdNbEJCVTdXSjR4VmljTE` +
-	`RVSklZWFJqSW1VV1dncDJjeFFQVjNWVUdRNFpJblpSRFdnRGFXVU9VeElzY1Zoa2NXd0lja3RoQm1JeE1SSlRMQVpHV1gwZWN6VUlIVUZPbmk5Vk4wUStZUUE4YVdnSE9Fc0pYMWg3Q1ROQlJVWUdI` +
-	`eHNmTVZwUUlBQUpYajV0WXlWY0RqOGFDUkFVQkNwUFV5VmZLMWs5ZUJkbUtTd1dDemN3UENLVExSRmlCVUYvRGdWdUZ4eEJDalViZjBzT2RSazNjVTVZRFVFNUNBRjZGMFpIVWlGU05EeEdJUnhxU0` +
-	`NOUU5CQlRMd2NIS0RZekVqbEZQMDRZQXdKNWJQRkhGd2Q2YWpNL0FYQS9GaE1UQ0FNL0xSZDNQMllFZkhNVVFsVVdZM0JPZUgxMWFUNUdMVDFKQ2w1S0JFWWFZQVlxU1JRWVVuZ1dFeEppQ2lVL2ZH` +
-	`WksyeGtBWUVGWFFqNFBhMnA1UldSOVduMStIaXBxYVdvWUF6cEFFRXNxR25nZWZINVlRMGNHWkdwcVpHUmxDbDBCSno1MlAyWUFIR1FhU3lSNGR5SmlIQW5iZEFaWEFSWldZV0J5SEhkSE5XdEdVVU` +
-	`4wUkg5QU5WZEdHa3QwUzMxblp6UkNZQlZPQUJOTFNtZzVXRVZoWml3ZFpuUVlaVEV0TDJWb2JIdEpIM3A1WEpSM1pGeHJlQjU0Y1NOR0NrMFdFbjhBQ2dKWlNFVnpLd1J6ZW10aUNFdEdhV2xqWWtk` +
-	`eVJRTUlIRVZnZGh0QVJIUXRXazFxZWxnVGRoc0dhZ1lGUTNwTDJ3RVRTMGMzUDBVSWVUSjhIaFYxQjBJMk54NGFWMEVJU3hZVkpsTlFCRTB3ZGgxMllnUTRQakpyRDJwMEhUd1VZQ0k0U0VrZ0V4ZD` +
-	`RaejVEVldKSlhBZmJZSFZFUlVaQlN6SWNBVHhBU3c0eVN3Z3RTZ0pFUWtOTkVCcDFJQWdiUjN3RFFTcGFHR3BaYUVJY2ZoUkNUR0o0TlJ0OEJuMUJVRDFVUkJCQWVYaElCdGw2QkVjVlRIOEtRWEox` +
-	`VUVCMWNsWVVlSGdXRVZSS0pHTVJVZ2wwSEdzZUJSOTFiVFZsZW4wYUJVRWdRaHBlSFJkTFlIWTRTek1MYzBBSllubG9RVTRpMlc0L2F4MFllQjlESmtWMVltSjJTaDlFT1UwRmUyeGtZd1VKYVNReV` +
-	`BVVnNNaE1LU2hKbElVOUZhQk13U2tFYkdoRTRJV0IwTVdON0ZRVkNBVVVpSEhIeGNoOXFGQjlYSjJnSmFIOUxhVUpsQVJRL0drYy9RR0FHSHdwUkZBQmxYeFFWQkFsbUN6UklNRzRDR1JaaUh3cy9m` +
-	`VWswZGdFVmVBc3pWRWNMVmg4ZVk5c2VEQ2xBR3pGQVFoaG9aRmNtUlc0alJHb1hIaGMxVm5RWUxVbG9FaGxxUGxoZEFHUlNFMGQrSFdSVk9Dc2ZmMjVLRldKeWF3QW9TbWdXZndablp4c3dtbDRIQ0` +
-	`dCQ1JXSnBlaWhZSjJWK0xHQnFGZ1JISTFVemRVUnZBUnBLU2trNElWdDhTbFJ5UkJ3SEVUZ25TRU4rWUdJVmQyaERBRFJaUUhFOVJXQjFla0tRT2hzU1gwcDBTV2h4U1hjSWNTc0lSa2gySDFnRkYz` +
-	`cGdkR284RWtkVlVuSUFFVmR5ZUJNQmFpbElYQlowVWhZU1lYZERYanRaZVI5T0JXUkRHUlJMR1pNZUdHTmlmbnhCUGpoV2NVVnVHa0laRlhVOVJXZ1lVUnNJRmhvQk5TcHdkVDBZQVdKaUdUbFhZUT` +
-	`l0UzJsS2ZYVk9VUVZFTm1jZWR3TUxNVk16ZERCUzIyQkJHSDlFVWg4SVRuOUxRbXAwVnhJR2V5b1hZMGxIQ2xKR1JoNVhIQnQvWWtrNkhGa0daMnRJZDMxa1p3dFdIR1lmTHdKa2F3Qm9TazRGVFM0` +
-	`SUduZmJmQ1EzTEVrZlhodExhQUFRTFZRQVpBdHRmSE1lUVVGVUxVWkdLSFZxQndnWExDNDBRekJxZFFKRFNoRUtCbHgrYW1CN1BFWjZHbEJlUm41QlkyWUhZdGxUQWt0dVRSd1dTUUlGY1MxQWZpZ0` +
-	`VTeGNkUWk0a0NSZ1BJQWxLRzBCa1VrcDVUa0VFR0VsL1NRWk9GQVpwY3dkS1NCazJPeHgyZnowYUV3cHBmQVFnaWdNWFluUUZDQnRsV0ZVQ0VGRVNmRUpoQUU4aGZCNVpjMGRCSFVSWlBTQUxTR2tH` +
-	`UlVOcWFETUhaaFZTY2hNSlJuUTlMM3dNWVdFSlFSdENKRVlDWkN6YkdVTWFQaVF3SGhnNFloWVNTSEF4S2dvS0ZFMUNGUlI0QjFZb1owY3ZZQUVhU2dRNkVFQVpiWFZEQmtjQ0xsc3dSeElnRkVZWW` +
-	`ZRUXNRZ2RHUEJsNFN0dDJCMVpDYkRrSmRXa0VlZ2twWmtZeGRRZGdGMEJESzE1L1R5Sm9kQVVJUmlrdWNYUVdHa1lLWWo0b05YMXFQWHBGSG1wb0tENUdaRmRrQ25jWWV4RmUyWFpjQ1hVWWFuWTdP` +
-	`MzRHYWtaR1BFVWFLQXdLYzFCK0NFaCtDMFpTSVJSellId0hHUU5tSmlwNFRFQjhleGdaWlZRU1FnaElBMEJCRm1VUVhWQUxhR25iRlJNRmZCc25KaG9TVkdKNmRXZ1FTRnRPU25OVmFoeC9aR1V3Qm` +
-	`tzUU5Cb1RCQVJxVEFOU0ZXVThRV2NmWXdzVVVtQi9iMEFjWTNORk53eFNaM0JQWWR0RVpYZ1FEU1VGVEZjQ1FuMEtaRWNpQTNRd1pub1ZlVDRhVm1CblFnWWJTQWw2VzFVRUYyTVRGM1ovRkRWS2R4` +
-	`VkRkaFVXRno1TlN6VVhFaTRZZlFjZjJSY25jSEJjZkdvWkJ3cEpEWDhHRmdjYlN4OEtUVzBMRERjQmVIY0Jla2tXRUc0MkZCSmlFM1JCVEdwR0trVjNCUWg5V1N4MERUOElGV2RwWlVZdkgwbnhSd1` +
-	`JoUTM1QU1IcEZLUVpuQ2hKMVRURmtGQzRKUUVNQUEwY01KMzF5VG54RFNYOUdTQ054YlZGb0ZBRm9CenhXZHhRamFVTkRGUUpCUEFOc2IzZ0ZIZHNWUUZNREJHeCtIUjlwZUJGUEdoYytDSGtkWkhn` +
-	`ZUZDaDJiQ3QxWW1sN1ptZ3ZaQVpaUEFnVVFINHdGRVY0VGg0YlJ3RmpQa0ltSDNBeUduc0ZhWFVna0dSNlgzTVZGMnA5SHlVY2UxQjRBWFVkQkNvMmFoWTVCMFpvRjM5RE5IaC9haGhuUlgxSVZ5MW` +
-	`RhSGxQUEFGQkJIRkZOQnBIVkR3R1FRSkdUMWN2RVFYeEd4dHlaRWtUTHhGNVR3ZC9FbWNZWHlnZGJUaEZQR1Z6WXg0bkF4MXhJMmwyRnhkTFdRay9jVEJNWWhZVlJYNGZQQVp3S2dGSlJodDBMRVpL` +
-	`WVJkRGY5ZHRld0VlRVdnTmFnTkNNeUpmT2loUk54TU1KMUl0T2hJYmV4SUtCRlJHSWo0dkRRSkpTVXRsRmtBT2NSVWhlMVVvBM0AkwAAAAAEzQAABM0AAATNAdcEzQERBM0ARwTNAKEEzQACBM0AAg` +
-	`TNAbwEzQC8BM0AnQTNAKQEzQBHBM0BqATNAOMEzQHDBM0AIQTNAEcEzQC8BM0AlQTNAJEEzQAwBM0AnQTNAGYEzQCkBM0AhQTNAEcEzQHDBM0BqATNAEcEzQBHBM0ARwTNAM8EzQACBM0AIQTNAKwE` +
-	`zQB2BM0AmwTNAMsEzQDjBM0AdgTNAH4EzQCsBM0AbgTNAJ0EzQDbBM0AOATNAH4EzQBHBM0AsATNAEcEzQCVBM0AnQTNADAEzQB+BM0AIQTNABwEzQAwBM0ANATNAHoEzQFjBM0AIQTNAEMEzQBHBM` +
-	`0ARwTNAUAEzQBmBM0AjQTNAKQEzQByBM0AggTNAKcEzQByBM0AmwTNAJ4EzQCsBM0AzQTNAHkEzQBHBM0AmwTNAFcEzQCNBM0AcgTNAPoEzQCoBM0AYATNAI8EzQAhBM0AFATNADgEzQAgBM0AhQTN` +
-	`ANMEzQIVBM0AfgTNADgEzQAhBM0AIQTNAHYEzQDLBM0AfgTNAEcEzQB+BM0AZgTNAGYEzQBmBM0AZgTNAGYEzQBmBM0ApATNAIIEzQCCBM0AggTNAIIEzQCdBM0AnQTNAJ0EzQCdBM0AnQTNAFcEzQ` +
-	`BXBM0AVwTNAFcEzQBXBM0AjwTNAI8EzQCPBM0AjwTNALQEzQFXBM0AdgTNAI0EzQCVBM0AYgTNAFcEzQCCBM0AAgTNAAIEzQACBM0BQATNAREEzQBHBM0AAgTNAEcEzQBHBM0ARwTNAEcEzQBHBM0A` +
-	`NATNAHoEzQBABM0AWwTNACEEzQAwBM0AnQTNANMEzQC0BM0AIQTNAEAEzQBXBM0AsATNAdcEzQBHBM0ACQTNAI0EzQA4BM0AIQTNAJkEzQBqBM0APQTNAAAEzQAhBM0AIQTNAEcEzQAkBM0AQwTNAE` +
-	`cEzQBHBM0AtATNALQEzQGoBM0BqATNAEcEzQBHBM0AMQTNADQEzQACBM3/sgTNAUsEzQEdBM0AGQTNAAIEzQC0BM0BwwTNAagEzQC0BM0AAgTNACEEzQDLBM0AIQTNAMsEzQDLBM0ArATNAKwEzQCs` +
-	`BM0ArATNAEcEzQBHBM0AVQTNAEcEzQB+BM0AfgTNAH4EzQCdBM0BQATNASEEzQFPBM0BQATNAewEzQGsBM0BvATNAZ0EzQG8BM0BQATNAAIEzQB2BM0AnQTNAKgEzQB6BM0AhQTNAhUEzQACBM0AVw` +
-	`TNADQEzQAxBM0AsATNAIkEzQBHBM0ARwTNAQ0EzQDuBM0BkATNAAIEzQACBM0AAgTNAAkEzQB2BM0AdgTNAKwEzQCdBM0AqATNAHYEzQCkBM0AdgTNAKQEzQB2BM0AIQTNAGYEzQAhBM0AZgTNACEE` +
-	`zQBmBM0AdgTNAKQEzQB2BM0ApATNAJsEzQByBM0AywTNAIIEzQDLBM0AggTNAMsEzQCCBM0AywTNAIIEzQDLBM0AggTNAHYEzQByBM0AdgTNAHIEzQB2BM0AcgTNAHIEzQB+BM0AmwTNAEcEzQAqBM` +
-	`0ArATNAJ0EzQCsBM0AnQTNAKwEzQCeBM0AcwTNAJkEzQDbBM0AeQTNANsEzQB5BM0A2wTNAHkEzQDbBM0AeQTNAH4EzQCbBM0ASgTNAF0EzQB+BM0AmwTNAJsEzQBHBM0AVwTNAEcEzQBXBM0ARwTN` +
-	`AFcEzQCVBM0A+gTNAHUEzQDQBM0AlQTNAPoEzQCdBM0AqATNAJ0EzQCoBM0AMATNAGAEzQAwBM0AYATNADAEzQBgBM0AfgTNAI8EzQB+BM0AjwTNAH4EzQCPBM0AfgTNAI8EzQB+BM0AjwTNAH4EzQ` +
-	`CPBM0AHATNABQEzQA0BM0AIATNAHoEzQCFBM0AegTNAIUEzQCdBM0AqATNADAEzQBgBM0AdgTNAHIEzQDbBM0AeQTNAJ0EzQDNBM0AfgTNAJUEzQD6BM0AAgAA/QMEzQBuBM0ArATNAEcAAPybAAD8` +
-	`mwAA/HQAAPxVAAD8hAAA/HQAAP0hAAD8RgAA/OEAAPyMAAD8dAAA/PEAAPzvAAD7UwTNACEEzQCwBM0ArATNARsEzQAYBM0AywTN//4EzQB9BM0AfgTNAH4EzQCdBM0AAgTNADgEzQB+BM0ARwTNAH` +
-	`4EzQCwBM0AdgTNADAEzQAoBM0ARATNADAEzQBsBM0AfgTNADsEzQA7BM0AAATNAEgEzQCwBM0AjQTNAFYEzQAZBM0ArATNAAgEzQAKBM0BGwTNASkEzQCeBM0AbgTNAHYEzQCkBM0ArATNAJ0EzQAG` +
-	`BM0ABwTNADgEzQBCBM0ArATNAJ0EzQAIBM0ACgTNAJ0EzQDNBM0AqATNACgEzQAgBM0AfgTNAJsEzQDLBM0AggTNAFYEzQBkBM0AZgTNAK4EzQEpBM0ALATNAIIEzf/+BM0AqQTNAJsEzQCbBM0AzQ` +
-	`TNAAYEzQA4BM0AmwTNAFcEzQCbBM0AjQTNAKQEzQBYBM0AIATNADkEzQA4BM0AkQTNAJsEzQA7BM0AOwTNAAgEzQBQBM0AxgTNAIYEzQA8BM0ANwTNARsEzQEpBM0ASATNAEcEzQA/BM0AQATNAGoE` +
-	`zQBbBM0AnQTNAJ0EzQACBM0ACQTNADgEzQA4BM0CFQAAAAAAAAAAAAAAAATNAJ0EzQAhBM0ArATNAJ0EzQCsBM0B1wTNACEEzQBmBM0ArATNAJ0EzQBHBM0AVwTNAH4EzQCPBM0AfgTNAI8EzQB+BM` +
-	`0AjwTNAH4EzQCPBM0AfgTNAI8EzQAhBM0AZgTNACEEzQBmBM0AAgTNAEAEzQB2BM0AcgTNAJ0EzQDNBM0ARwTNAFcEzQBHBM0AVwTNAKwEzQB2BM0AfgTNAJsEzQAhBM0AZgTNAAIEzQBABM0ARwTN` +
-	`AFcEzQB+BM0AmwTNACEEzQBmBM0AywTNAIIEzQBHBM0AVwTNAEcEzQBXBM0ARwTNAFcEzQBHBM0AVwTNADQEzQAgBM0BIATNAKwEzQAhBM0AZgTNAKwEzQCNBM0ArATNAI0EzQCsBM0AjQTNAHYEzQ` +
-	`CkBM0AmwTNAHIEzQCbBM0AcgTNAJsEzQByBM0AmwTNAHIEzQCbBM0AcgTNAMsEzQCCBM0AywTNAIIEzQDLBM0AggTNAMsEzQCCBM0AywTNAIIEzQDjBM0ApwTNAHYEzQByBM0AfgTNAJsEzQB+BM0A` +
-	`mwTNAH4EzQCbBM0AfgTNAJsEzQB+BM0AmwTNAKwEzQCeBM0ArATNAJ0EzQCdBM0AzQTNAJ0EzQDNBM0AnQTNAM0EzQDbBM0AeQTNANsEzQB5BM0A2wTNAHkEzQDbBM0AeQTNADgEzQBHBM0AOATNAE` +
-	`cEzQA4BM0ARwTNAH4EzQCbBM0AfgTNAJsEzQB+BM0AmwTNAH4EzQCbBM0ARwTNAFcEzQBHBM0AVwTNAEcEzQBXBM0ARwTNAFcEzQCwBM0AjQTNALAEzQCNBM0AlQTNAPoEzQCVBM0A+gTNAJUEzQD6` +
-	`BM0AlQTNAPoEzQCdBM0AqATNAJ0EzQCoBM0AnQTNAKgEzQCdBM0AqATNAJ0EzQCoBM0AMATNAGAEzQAwBM0AYATNADAEzQBgBM0AMATNAGAEzQB+BM0AjwTNAH4EzQCPBM0AfgTNAI8EzQB+BM0Ajw` +
-	`TNAH4EzQCPBM0AIQTNACEEzQAhBM0AIQTNABwEzQAUBM0AHATNABQEzQAcBM0AFATNABwEzQAUBM0AHATNABQEzQAwBM0AOATNADAEzQA4BM0ANATNACAEzQB6BM0AhQTNAHoEzQCFBM0AegTNAIUE` +
-	`zQCbBM0AYATNABQEzQAgBM0AIQTNAGYEzQAhBM0AZgTNACEEzQBmBM0AIQTNAGYEzQAhBM0AZgTNACEEzQBmBM0AIQTNAGYEzQAhBM0AZgTNACEEzQBmBM0AywTNAIIEzQDLBM0AggTNAMsEzQCCBM` +
-	`0AywTNAIIEzQDLBM0AggTNAMsEzQCCBM0ArATNAJ4EzQBHBM0AVwTNAEcEzQBXBM0ARwTNAFcEzQBHBM0AVwTNAEcEzQBXBM0AfgTNAI8EzQA0BM0AIATNADQEzQAgBM0ANATNACAEzQDLBM0AggTN` +
-	`AMsEzQCCBM0ArATNAIIEzQCbBM3//gTNACEEzQBkBM0AIQTNAGQEzQCCBM3//gTNAKkEzQCbBM0AmwTNAEcEzQBXBM0AjQTNAIYEzQAoBM0AIATNACgEzQAgBM0AKATNACAEzQCbBM0AUATNAAIEzQ` +
-	`BABM0AOATNAEcAAP0hAAD8RgAA/OEAAPx0AAD8dAAA/FUAAPyEBM0ApwTNAM0EzQB+BM0AmwTNAMsEzQCCBM0AggTNAIIAAPsfAAD8igAA+yQAAPxyBM0AnQTNAKgEzQARBM0ALwTNAJ0EzQDNBM0A` +
-	`IwTNADcEzQB+BM0AmwTNADQEzQA0BM0ANATNADQEzQAwBM0AOATNAH4EzQCbBM0AfgTNAJsEzQB+BM0AmwTNAIIEzQCCBM0ARwTNAFcEzf/+BM3//gTNACEEzQAhAAD8fATNAH4EzQCPBM0ARwTNAF` +
-	`cEzQDjBM0ApATNAIIEzQBmBM0AZgTNAGAEzQBHBM0AIQTNABQEzQBXBM0ARwTNAFcEzQA9BM0B1wTNAAAEzQAABM0AAATNAAAEzQAABM0AAATNAAAEzQAABM0AAATNAAAEzQAABM0AtATNAagEzQAC` +
-	`BM0A2wTNAHYEzQCbBM0AOATNADAEzQAhBM0ArATNAJ4EzQAhBM0AOATNAHkEzQCkBM0AcgTNAEcEzQAcBM0AzwTNAM8EzQDPBM0A+gAA/FUAAPyEAAD7HwAA/HIAAPybAAD8mwTNATkEzQBHBM0AIQ` +
-	`TNAOMEzQBjAAAAAATNAGQEzQAhBM0AZgTNACEEzQBmBM0AywTNAIIEzQDLBM0AggTNAKwEzQCdBM0ArATNAJ0EzQBHBM0AVwTNAEcEzQBXBM0AjwTNAPoEzQCVBM0A+gTNAH4EzQCPBM0AfgTNAI8E` +
-	`zQCnBM0ARwTNAFcEzQBHBM0AVwTNAEcEzQBXBM0ARwTNAFcEzQB+BM0AjwTNAH4EzQCPBM0AfgTNAI8EzQB+BM0AjwTNAIIEzQCCBM0AywTNAH4Ezf/+BM0AywTNAIIEzQCCBM3//gTNAH0EzQB+BM` +
-	`0AfgTNAEcEzQBXBM0AfgTNAEgAAPybAAD8mwAA/EYEzQGoBM0BEQTNAcMEzQAhBM0AZgTNAGYEzQCsBM0AnQTNAJ4EzQB+BM0AjwTNADgEzQCVBM0AfgTNAPoEzQCPBM0AMATNAFgEzQB2BM0ApATN` +
-	`AH0EzQCpBM3/6QTNAAcEzQA5BM0AOQAA/HIEzQHYBM0B2ATNAM8EzQDPBM0AzwTNAM8AAP0NAAD9DQAA/Q0AAP0LAAD9DQAA/OwAAP1bAAD8tgAA/IoAAPyKAAD8igAA/IoAAPyKAAD8cgTNAFsEzQ` +
-	`ACBM0AmwTNACEEzQA7BM0A4wTNAOMEzQBHBM0ARwTNAPoEzQBHBM0ARwTNAPoEzQAhBM0AZgTNACEEzQBmBM0AIQTNAGYEzQDLBM0AggTNAMsEzQCCBM0ArATNAJ0EzQBHBM0AVwTNAEcEzQBXBM0A` +
-	`RwTNAFcEzQB+BM0AjwTNAH4EzQCPBM0ANATNACAEzQGjBM0BowTNAdwEzQCkBM0B3ATNAigEzQFPBM0BQATNAUAEzQFABM0BQATNAU8EzQIoBM0B3ATNAdwEzQFXBM0BVwTNAVcEzQFAAAD9DwAA/Q` +
-	`8AAPsfAAD7JATNAUAEzQCeBM0BQATNAJ4Ezf/XBM0BQATNAJ4Ezf/XBM0B1wAAAAAAAAAABM0BQATNAGYEzQB6BM0AhQTNALAEzQCNBM0AsATNAI0EzQBgBM0ApwTNAKwEzQB5BM0AdgTNACEEzQB2` +
-	`BM0AdgTNAI0EzQAbBM0AMATNACEEzQAVBM0AFQTNAHYEzQAbBM0ARwTNAHYAAPwlAAD8JQTN/rQEzf5PBM3/0wTNALQEzQDuBM0A7wTNAOkEzQChBM0A7ATNAMAEzQDcBM0A3wTNAMAEzQC0BM0BMA` +
-	`TNALQEzQEEBM0BBQTNALQEzQChBM0A7ATNAMAEzQDcBM0A3wTNAMAEzQC0BM0BMATNALQEzQEEBM0BBQTNAPIEzQEjBM3+bATNAJsEzQB5BM0A+gTNAPoEzQBHBM0AcgTNAKwEzQBLBM0AIATNABUE` +
-	`zQAhBM0AMAAA/VsAAPwbBM0ANATNAHYEzQDbBM0AAgTNANsEzQA1BM0AKgTNADMEzQCVBM0AmwTNAJsEzQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAATNAM8EzQCrBM0AAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEzQA+BM0AqgTNAKoEzQACBM0BYwTNAWMEzQCVBM0ARwAA/IoAAPwmAAD8JgAA/CYAAPyEAAD8cgAA+4sAAP0ZAAD8cgAA+yQAAPyKAAD8igAA` +
-	`/NgAAPxTAAD8UwAA/FMEzQCsAAD83wAA/IoAAPvEAAD7jQAA/IoAAP0PAAD8cgAA/IoAAPyKAAD8igAA+5AAAP0PAAD/BgAA/LgAAPxUAAD8mwAA/KcAAPzPAAD8kgAA/LEAAPywAAD8pgAA/LEAAP` +
-	`yKAAD8yAAA/KEAAPx2AAD8gATNAEcEzQCsBM0ArATNACQEzQCbBM0AIATNAHIEzQCVBM0AlQTNAKgEzQB2BM0AfgTNACEEzQA0BM0AfgTNANsEzQCsBM0BQATNABsEzQAHBM0AHATNABwEzQDNBM0A` +
-	`ngTNAKwEzQCNBM0AcgTNAJsEzQCbBM0AfwTNAH8EzQByBM0AYATNAGAEzQDNBM0AjQTNALAEzQDVBM0AdgTNAHIEzQB5BM0AVwTNAHIEzQA/BM0APwTNAE4EzQBuBM0AbgTNAD0EzQBOBM0ATgTNAD` +
-	`kEzQBOBM0ATgTNADkEzQB6BM0AhQTNAKwEzQE5BM0AdgTNACEEzQAhBM0AlgTNAAcEzQAhBM0AIQTNACEEzQCRBM0AaATNAOMEzQBDBM0AUATNAJgEzQCkBM0BqATNAKQEzQBVBM0AiwTNABoEzQAj` +
-	`BM0AOATNACIEzQAiBM0AIgTNACIEzQDjBM0BHATNARwEzQFTBM0BPQTNAT0EzQB3BM0A8ATNAMAEzQDJBM0AeATNACYEzQBJBM0AWgTNAH4EzQB/BM0AqQTNAEwEzQBHBM3/ewTNABQEzQBqBM0A6w` +
-	`TNATIEzQEkBM0A1wTNAToEzQGkBM0BpATNAWIEzQEMBM0BDATNAQwEzQEMBM0BDATNAVgEzQFYBM0BVwTNAVcEzQGsBM0BVwTNAVcEzQBqBM0AagTNAR4EzQEeBM0BHgTNAR4EzQHDBM0A8ATNASEE` +
-	`zQGdBM0BnQTNAUAAAP3RAAD9sgAA/dEAAP2yAAD9dAAA/bIAAP3RAAD8OATNABEEzQARBM0AIQTNACEEzQBHBM0AVwTNAAIEzQAHBM0AAgTNAAcEzQA9BM0APQTNAdcEzQALBM0AGQTNAAcEzQCmBM` +
-	`0AUgTNAdcEzQHXBM0AUQTNAAEEzQA3BM0ArATNAAIEzQACBM0AAgTNAAIEzQB5BM0AAwTNACEEzQAhBM0AvQTNAhUEzQBHBM0AIQTNAEcEzQAhBM0AIQTNAcMEzQAzBM0ApATNAVcEzQFXBM3/owTN` +
-	`ADgEzQA4BM0APQTNAD0EzQGsBM0ARwTNADgEzQA4BM0AOATNAJEEzQCbBM0AkQTNAJEEzQCRBM0AkQTNAJcEzQAhBM0AAgTNAEAEzQB2BM0AmwTNAAIEzQDLBM0AngTNAG4EzQCdBM0AAgTNADgEzQ` +
-	`B+BM0ARwTNAEMEzQCwBM0AlQTNAJUEzQB2BM0AMATNAH4EzQAhBM0AHATNAHoEzQCRBM0AIQTNANsEzQCwBM0AIQTNAAIEzQAbBM0AGwTNAJsEzQDLBM0AywTNAKwEzQB2BM0AfgTNAKwEzQBuBM0A` +
-	`nQTNANsEzQA4BM0AfgTNAH4EzQBHBM0AsATNAJUEzQAwBM0AfgTNABwEzQARBM0ALwTN/6UEzQByBM0ApwTNABMEzQB9BM0AYATNADgEzQByBM0AIQTNAKwEzQDbBM0AIQTNAMsEzQB6BM0AfgTNAE` +
-	`cEzQCsBM0AnQTNACEEzQA4BM0AfgTNAE0EzQBHBM0AIQTNALAEzQBbBM0AMATNADQEzQBXBM0AMATNAH4EzQA4BM0AOATN/6AEzQB+BM0AIQTNACgEzQAjBM0AAgTNABkEzQAoBM0AHATNAFcEzQBX` +
-	`BM0AVwTNADEEzQBXBM0AVwTNANAEzQCtBM0A4QTNAOEEzQDiBM0A4gTNAOEEzQEjBM0A3ATNALQEzQDyBM0AwATNAPIEzQDABM0AwATNAOEEzQDZBM0A8gTNAJsEzQC0BM0AlATNASMEzQEXBM0A8g` +
-	`TNAJQEzQA4BM0A9wTNABsEzQAbBM0ArATNAGgEzQAtBM0AfgTNAH4EzQA4BM0AQgTNAAIEzQAGBM0AfgTNAJsEzQAhBM0AwwTNAH0EzQCpBM0AqQTNAEIEzQEMBM0BDATNAQwEzQEMBM0BDATNATwE` +
-	`zQE8BM0BPATNATwEzQE8BM0BPATNATwEzQE8BM0BPATNATwEzQB+BM0AfgTNAIQEzQCEBM0AhATNAIQEzQAhBM0AIQTNAIQEzQCEBM0BdQTNAXUEzQF1BM0BdQTNAXUEzQF1BM0BdQTNAXUEzQACBN` +
-	`oAAgTNAAIEzQBaBM0AvATNAH4EzQBDBM0AAgTNAOQEzQAzBM0ATATNAAIEzQACBM0AAgTNAAIEzQACBM0AAgTNAAIEzQACBM0AAgTNAAIEzQACBM0AAgTNAAIEzQIUBM0CFATNAAAEzQIUBM0AAATN` +
-	`AhIEzQAABM0AAATNAAAEzQAABM0AAQTNAAEEAAABBAAAAAAAAAAAAABwAAAAcAAAAHAAAABwAAABAAAAAWAAAALIAAAD8AAABXQAAAbwAAAHJAAAB7QAAAhEAAAJPAAACZQAAAn4AAAKLAAACnQAAA` +
-	`rQAAAMYAAADRgAAA5AAAAPhAAAECgAABEMAAASUAAAE0gAABTsAAAWNAAAFqgAABc8AAAXsAAAGAQAABh4AAAZiAAAG2AAABzQAAAeNAAAHxgAAB/YAAAgbAAAINwAACHcAAAiTAAAIsQAACNgAAAk` +
-	`PAAAJIwAACXsAAAmsAAAJ7wAACh0AAAqTAAAK5wAACzgAAAtQAAALfgAAC7sAAAwwAAAMbgAADKUAAAzJAAAM4wAADPkAAA0RAAANJgAADTQAAA1MAAANnwAADecAAA4jAAAOaQAADrAAAA7cAAAPP` +
-	`gAAD3cAAA+YAAAPzwAAEAoAABAkAAAQeQAAEK8AABDuAAAROAAAEX4AABGqAAASHwAAElMAABKKAAASvgAAEwUAABNGAAATjgAAE7EAABP7AAAUCgAAFFMAABR9AAAUgwAAFNAAABUiAAAVKAAAFS4` +
-	`AABU0AAAVOgAAFUAAABVGAAAVTAAAFVIAABVYAAAVXgAAFa0AABWzAAAVuQAAFb8AABXFAAAVywAAFdEAABXXAAAV3QAAFeMAABXpAAAV7wAAFfUAABX7AAAWAQAAFgcAABYNAAAWEwAAFhkAABZIA` +
-	`AAWdAAAFq8AABbqAAAXdAAAF4YAABehAAAX5AAAGGwAABjjAAAZIQAAGTkAABlXAAAZkwAAGc8AABo4AAAadwAAGpYAABq/AAAa6gAAGyQAABtZAAAbnwAAG8oAABvmAAAcFQAAHGMAABypAAAc1QA` +
-	`AHQ0AAB17AAAd0AAAHg0AAB4xAAAeQwAAHnAAAB60AAAe9wAAHyEAAB9LAAAfdgAAH6QAAB+kAAAfqgAAH7AAAB+2AAAgAAAAIHAAACB9AAAgigAAILQAACDdAAAg9QAAIQ0AACE0AAAhagAAIXAAA` +
-	`CF2AAAhjAAAIcYAACHpAAAiDQAAIjgAACJmAAAipgAAIrgAACLRAAAi/wAAI2YAACNsAAAjcgAAI3gAACN+AAAjhAAAI4oAACOQAAAjlgAAI5wAACOiAAAjqAAAI+sAACPxAAAj9wAAI/0AACQDAAA` +
-	`kGwAAJD4AACRlAAAkcgAAJIsAACSuAAAkzgAAJPUAACURAAAlNAAAJVcAACWJAAAlvQAAJcMAACXJAAAlzwAAJdUAACXsAAAmIQAAJocAACaNAAAmkwAAJsQAACcIAAAnFQAAJ00AACdrAAAnnQAAJ` +
-	`+sAAChTAAAorQAAKRIAACk4AAApPgAAKUQAAClKAAAptgAAKikAACovAAAqNQAAKjsAACpBAAAqiwAAKpEAACqXAAAqnQAAKqMAACqpAAAqrwAAKrUAACq7AAAqwQAAKscAACrNAAAq0wAAKtkAACr` +
-	`fAAAq5QAAKusAACrxAAAq9wAAKv0AACsDAAArCQAAKw8AACsVAAArGwAAKyEAACsnAAArLQAAKzMAACs5AAArPwAAK0UAACt/AAArtwAAK70AACvDAAAryQAAK88AACvVAAAr2wAAK+EAACvnAAAr7` +
-	`QAAK/MAACv5AAAr/wAALAYAACwNAAAsEwAALBkAACwfAAAsJQAALCsAACwxAAAsNwAALD0AACxEAAAsSgAALFAAACxWAAAsXAAALGIAACxoAAAsbgAALHQAACx6AAAsgAAALIYAACyMAAAskgAALJg` +
-	`AACyeAAAspAAALKoAACywAAAstgAALL0AACzDAAAsyQAALM8AACzVAAAs2wAALOEAACznAAAs7QAALPMAACz5AAAs/wAALQUAAC0LAAAtEQAALRcAAC0dAAAtIwAALSkAAC0vAAAtNQAALTsAAC1BA` +
-	`AAtSAAALU8AAC1WAAAtXQAALWQAAC1rAAAtcgAALXkAAC2AAAAthwAALY4AAC2VAAAtnAAALaAAAC2lAAAtqwAALcYAAC4kAAAuKQAALi4AAC4zAAAuOAAALj0AAC5CAAAuRwAALkwAAC5RAAAuVgA` +
-	`ALlsAAC5gAAAuigAALpUAAC6qAAAu4wAALzwAAC9LAAAvdwAAL48AAC+6AAAwAgAAMCYAADBcAAAweAAAMJMAADDDAAAw4gAAMTEAADFKAAAxgwAAMbIAADHEAAAx3AAAMjIAADJMAAAybAAAMpEAA` +
-	`DKuAAAy0wAAMwsAADNFAAAzfAAAM6MAADPnAAA0IQAANDgAADRpAAA0uQAANNIAADTrAAA1HwAANTkAADVhAAA1gAAANcoAADX9AAA2NgAANnMAADa1AAA2+QAANzQAADeEAAA3rgAAN+sAADgPAAA` +
-	`4NAAAOIYAADiwAAA42gAAOPcAADkUAAA5XwAAOb0AADn7AAA6PwAAOqAAADrmAAA69QAAOyQAADtWAAA7gQAAO9MAADv2AAA8JgAAPEIAADxcAAA8jAAAPKsAADz3AAA9DwAAPVEAAD1/AAA9kQAAP` +
-	`aoAAD4DAAA+HQAAPjwAAD5fAAA+fAAAPqUAAD7bAAA/FwAAP0wAAD9rAAA/rgAAQAkAAEAiAABAPAAAQEIAAEBGAABATAAAQFAAAEBWAABAWgAAQGAAAEBkAABAagAAQG4AAEB0AABAsQAAQLUAAEC` +
-	`1AABAtQAAQLUAAEC5AABAvQAAQMMAAEDJAABAzwAAQNMAAEDZAABA3wAAQOUAAEDrAABA8QAAQPcAAED9AABBAwAAQQsAAEETAABBGwAAQSMAAEErAABBMwAAQTsAAEFDAABBSwAAQVMAAEFbAABBY` +
-	`wAAQWkAAEFvAABBdQAAQXsAAEGBAABBhwAAQY0AAEGTAABBmwAAQaIAAEGoAABBrgAAQbQAAEG6AABBwgAAQcoAAEHQAABB1gAAQdwAAEHiAABB6AAAQe4AAEH0AABB+gAAQgAAAEIGAABCDgAAQhY` +
-	`AAEIeAABCJgAAQiwAAEIyAABCOgAAQkIAAEJIAABCTgAAQlMAAEJXAABCXQAAQmMAAEJpAABCbwAAQnUAAEJ7AABCgQAAQocAAEKNAABCkwAAQpkAAEKfAABCpQAAQqsAAEKxAABCtwAAQr4AAELFA` +
-	`ABCywAAQtEAAELZAABC4QAAQukAAELxAABC9wAAQv0AAEMDAABDCQAAQxEAAEMYAABDHgAAQyQAAEMqAABDMAAAQzYAAEM8AABDQgAAQ0gAAENOAABDVAAAQ1oAAENgAABDZgAAQ2wAAENyAABDeAA` +
-	`AQ4AAAEOIAABDjgAAQ5QAAEOaAABDoAAAQ6YAAEOsAABDsgAAQ7gAAEPAAABDyAAAQ84AAEPUAABD2gAAQ+AAAEPmAABD7AAAQ/IAAEP4AABD/gAARAQAAEQKAABEEAAARBYAAEQcAABEIgAARCgAA` +
-	`EQuAABENAAARDwAAEREAABETAAARFQAAERcAABEZAAARGwAAER0AABEegAARIAAAESGAABEjAAARJIAAESYAABEngAARKQAAESsAABEtAAARLoAAETAAABExgAARMwAAETSAABE2AAAROAAAEToAAB` +
-	`E8AAARPgAAEUAAABFCAAARQ4AAEUUAABFGgAARSAAAEUmAABFLAAARTIAAEU4AABFPgAARUQAAEVKAABFUAAARVYAAEVcAABFZAAARWwAAEV0AABFfAAARYIAAEWIAABFjgAARZQAAEWaAABFoAAAR` +
-	`aYAAEWsAABFsgAARbgAAEW+AABFxAAARcoAAEXQAABF1gAARdwAAEXiAABF6AAARe4AAEX0AABF+gAARgAAAEYGAABGDAAARhIAAEYYAABGHgAARiQAAEYqAABGMAAARjYAAEY8AABGRAAARkwAAEZ` +
-	`UAABGXAAARmQAAEZsAABGdAAARnwAAEaEAABGjAAARpQAAEacAABGpAAARqwAAEa0AABGvAAARsIAAEbIAABGzgAARtQAAEbcAABG5AAARuwAAEb0AABG/AAARwQAAEcMAABHFAAARxoAAEcgAABHJ` +
-	`gAARywAAEc0AABHPAAAR0QAAEdMAABHVAAAR1wAAEdkAABHbAAAR3IAAEd4AABHfgAAR4QAAEeKAABHkAAAR5YAAEecAABHpAAAR6wAAEe0AABHvAAAR8IAAEfIAABHzgAAR9QAAEfaAABH4AAAR+Y` +
-	`AAEfsAABH8gAAR/gAAEf+AABIBAAASAoAAEgQAABIFgAASBwAAEgiAABIKAAASC4AAEg0AABIOgAASEAAAEhGAABITAAASFIAAEhWAABIWgAASGAAAEhmAABIawAASHAAAEh1AABIegAASH8AAEiEA` +
-	`ABIiQAASKUAAEjBAABI+QAASSsAAElFAABJdAAASZkAAEm+AABJyQAASdoAAEnlAABJ/AAASlAAAEqlAABKwwAASuEAAEsMAABLNgAAS2UAAEuSAABLtgAAS9oAAEvyAABMCgAATDEAAExYAABMdgA` +
-	`ATJQAAEy+AABM6AAATRsAAE1JAABNbwAATZIAAE3CAABN5wAATh0AAE5BAABOegAATrEAAE77AABPRQAAT1UAAE+BAABPrgAAUAgAAFBeAABQaAAAUJgAAFC+AABRAgAAUUYAAFFrAABRsgAAUcQAA` +
-	`FHmAABSCQAAUjwAAFJbAABSogAAUsUAAFLFAABSxQAAUsUAAFLFAABSxQAAUsUAAFLFAABSxQAAUsUAAFLFAABSxQAAUxsAAFNFAABTbQAAU3wAAFOrAABT4AAAVBEAAFQrAABUPgAAVFUAAFSIAAB` +
-	`UmgAAVLQAAFTFAABU8wAAVTQAAFV9AABVmgAAVdoAAFYaAABWXAAAVn8AAFaEAABWiQAAVpQAAFarAABWsAAAVrUAAFbNAABW5QAAVzQAAFdMAABXrgAAV64AAFe2AABXvAAAV8IAAFfIAABXzgAAV` +
-	`9QAAFfaAABX4AAAV+YAAFfsAABX8gAAV/gAAFf+AABYBAAAWAoAAFgQAABYFgAAWBwAAFgiAABYKAAAWC4AAFg0AABYOgAAWEAAAFhGAABYTAAAWFIAAFhYAABYXgAAWGQAAFhqAABYcAAAWHYAAFh` +
-	`8AABYggAAWIgAAFiOAABYlAAAWJoAAFigAABYpgAAWKwAAFi0AABYvAAAWMIAAFjIAABYzgAAWNQAAFjaAABY4AAAWOYAAFjsAABY8gAAWPgAAFj+AABZBAAAWQoAAFkQAABZFQAAWRoAAFkhAABZJ` +
-	`QAAWSsAAFkvAABZNwAAWT8AAFlGAABZTgAAWVYAAFlcAABZZAAAWWwAAFlyAABZeAAAWX4AAFmEAABZigAAWaUAAFnAAABaFgAAWmsAAFrPAABbMQAAW1sAAFuHAABbowAAW8AAAFvXAABb3AAAW+E` +
-	`AAFvzAABcBgAAXBcAAFwoAABcLQAAXDIAAFw3AABcYQAAXGYAAFycAABcqQAAXMQAAFzeAABc7wAAXQEAAF0TAABdJgAAXTQAAF1KAABdTgAAXXEAAF2VAABd9gAAXgAAAF4KAABeFAAAXh4AAF5DA` +
-	`ABekAAAXtIAAF71AABe+wAAXwEAAF8JAABfEQAAXxkAAF8hAABfJwAAXy0AAF81AABfPQAAX0MAAF9JAABfTwAAX1UAAF9dAABfZQAAX2sAAF9xAABfdwAAX30AAF+DAABfiQAAX48AAF+VAABf2AA` +
-	`AYBkAAGBAAABgawAAYJIAAGCfAABgowAAYKcAAGCrAABgsAAAYLUAAGC6AABgxgAAYO0AAGEUAABhJgAAYTgAAGFSAABhYAAAYYcAAGGuAABhvgAAYc4AAGHaAABh7AAAYfgAAGIKAABiIgAAYi4AA` +
-	`GJAAABiWAAAYnsAAGJ7AABiewAAYocAAGLnAABjBwAAYycAAGNgAABjowAAY94AAGQhAABkRwAAZGsAAGSSAABkswAAZOQAAGT3AABlSwAAZZ4AAGXiAABmDQAAZjQAAGaSAABnEAAAZ3IAAGfdAAB` +
-	`oGAAAaI0AAGjfAABo9AAAaQkAAGklAABpXQAAaa4AAGoAAABqHQAAalQAAGqkAABqwwAAavsAAGs8AABrVwAAa8wAAGwhAABsPQAAbEgAAGxYAABsggAAbK8AAG0BAABtIAAAbVgAAG2ZAABttQAAb` +
-	`ioAAG5/AABumwAAbqYAAG62AABu4QAAbw4AAG8zAABvTQAAb3kAAG+rAABvygAAb/QAAHAlAABwfAAAcM8AAHDmAABxaQAAcbIAAHIBAAByWQAAcnQAAHKAAAByiwAAcqMAAHLlAABy9gAAc1gAAHN` +
-	`pAABz1wAAdBEAAHRJAAB0lwAAdMAAAHTfAAB03wAAdN8AAHTfAAB03wAAdN8AAHTfAAB03wAAdN8AAHU2AAB1jAAAdYwAAHWMAAB1jAAAdYwAAHWMAAB1jAAAdYwAAHWMAAB1jAAAdYwAAHWMAAB1y` +
-	`AAAdd4AAHX2AAB2PQAAdlUAAHZvAAB2sgAAdvkAAHcIAAB3IwAAdz0AAHdZAAB3XgAAd5EAAHe6AAB32wAAd/YAAHgGAAB4IgAAeDEAAHg+AAB4hAAAeM0AAHjwAAB5BgAAeR0AAHkwAAB5VQAAeXo` +
-	`AAHmMAAB5swAAeeUAAHn4AAB6CgAAehwAAHo5AAB6YAAAemUAAHqJAAB60wAAexkAAHtJAAB7YwAAe6kAAHvLAAB7+wAAfD4AAHxoAAB8ngAAfMEAAHznAAB8+AAAfRAAAH0nAAB9PAAAfVEAAH2iA` +
-	`AB9ygAAfeMAAH4wAAB+cQAAfrIAAH8aAAB/YgAAf48AAH/iAAB/9QAAgBQAAIAjAACAfAAAgIoAAIDBAACBAQAAgR0AAIFKAACBagAAgbIAAIHIAACCFAAAgmQAAIKYAACC2QAAgy4AAIODAACD4QA` +
-	`AhA8AAIRCAACEYQAAhLAAAITmAACFHAAAhWwAAIXMAACF+gAAhjwAAIaGAACGvwAAhv0AAIc8AACHZAAAh5QAAIfHAACIJAAAiIMAAIjtAACJOgAAiYcAAIniAACKGAAAijYAAIp8AACKlAAAisMAA` +
-	`IrwAACLMAAAi3QAAIuSAACLugAAjCYAAIyVAACMzgAAjTIAAI09AACOIwAAji0AAI5AAACOZwAAjqAAAI7nAACO9wAAjwwAAI+DAACP/AAAkBkAAJAvAACQRQAAkFsAAJB/AACQiQAAkLMAAJDnAAC` +
-	`RCAAAkS4AAJFRAACRewAAkbwAAJHeAACR9gAAkhAAAJJSAACSnwAAkwoAAJNdAACTqwAAlAkAAJQoAACUXQAAlJ8AAJTdAACVBgAAlRYAAJUnAACVeQAAlZMAAJXVAACV9gAAlgoAAJYiAACWMwAAl` +
-	`kgAAJZdAACWcgAAloMAAJaSAACWpQAAlrcAAJbJAACXDQAAlx0AAJctAACXRQAAl1QAAJdjAACXcgAAl4EAAJeQAACXwQAAl9IAAJfwAACYAAAAmBEAAJgcAACYJwAAmDwAAJhHAACYXAAAmHwAAJi` +
-	`QAACYpQAAmLcAAJj8AACZQQAAmWMAAJmEAACZxgAAmhsAAJo4AACaUgAAmoMAAJqwAACbIAAAm5AAAJvBAACcTQAAnLsAAJzXAACdKAAAnVwAAJ2aAACd5gAAnkkAAJ6/AACfEQAAnyYAAJ+hAACgP` +
-	`gAAoLgAAKERAACheQAAocwAAKHeAACh8AAAojgAAKJFAACiZgAAooUAAKKqAACitQAAosAAAKLxAACjVQAAo3wAAKPCAACj0QAApCsAAKRIAACkZQAApKoAAKTvAAClOgAApVAAAKVyAACllAAApbw` +
-	`AAKXzAACmFgAApk8AAKaGAACmyQAApwwAAKdcAACndAAAp5kAAKf1AACoJAAAqFkAAKicAACotAAAqOgAAKkBAACpHQAAqTUAAKlmAACpjAAAqdYAAKo0AACqbAAAqqwAAKrsAACrHQAAqy8AAKtQA` +
-	`ACrYwAAq4YAAKubAACr1AAAq+YAAKv1AACsLQAArEUAAKxqAACs0wAArT0AAK1yAACtigAAraQAAK3+AACuRgAArmUAAK59AACulgAArrIAAK7BAACu8wAAryEAAK9HAACvkQAAr8kAALAKAACwHQA` +
-	`AsD4AALBhAACw0wAAsSAAALGFAACx5QAAsisAALJsAACynwAAsuMAALMPAACzYQAAs3kAALPSAACz4QAAs/IAALQKAAC0HwAAtD4AALSPAAC0pgAAtMIAALTVAAC1BgAAtTMAALVIAAC1lAAAtbMAA` +
-	`LXrAAC2AQAAthMAALYrAAC2awAAtoUAALazAAC3NwAAt3oAALfZAAC4BAAAuCMAALhlAAC4lQAAuLIAALj6AAC5RgAAuaEAALn7AAC6VQAAuq8AALsOAAC7KAAAu1gAALufAAC79wAAvDoAALx+AAC` +
-	`8rgAAvNUAAL0qAAC9RAAAvWQAAL2cAAC9zAAAvhIAAL5BAAC+YgAAvn4AAL7CAAC+5wAAvwkAAL85AAC/bAAAv34AAL+YAAC/wAAAv+IAAL/0AADAKQAAwEgAAMCDAADA1gAAwP0AAMEjAADBcwAAw` +
-	`aEAAMHQAADB8gAAwhQAAMI1AADCVgAAwn0AAMKkAADC1AAAwwMAAMNCAADDlAAAw9UAAMQrAADEOgAAxE0AAMRgAADEcwAAxIIAAMSrAADE0wAAxPsAAMUkAADFTgAAxXcAAMWfAADFxwAAxfAAAMY` +
-	`aAADGOwAAxl0AAMaJAADGtgAAxugAAMcdAADHbAAAx78AAMgIAADIVQAAyIMAAMi5AADI5wAAyR0AAMk6AADJYAAAyX0AAMmkAADJ3gAAyiAAAMpEAADKVQAAymsAAMqXAADKvAAAyt0AAMsEAADLZ` +
-	`wAAy40AAMuYAADLowAAy64AAMu5AADLxAAAy88AAMvaAADL5AAAy+8AAMv6AADMBQAAzBAAAMwaAADMJwAAzDYAAMxFAADMVAAAzGMAAMx3AADMiwAAzJ0AAMywAADMzQAAzNgAAMzkAADNvAAAzbw` +
-	`AAIAAAAAAAQAAADIAAAIuAAAACEAAAAACf0GAQAA/wAJ/gAAAAAAAAAAAAAAAAAAAAADjQkJAQEAAAmAAAAGzAAAABIAAAAACv0GAQAAAAAK/QAAAAAAAAAAAAAAAAAAAAAGjAoKAQEAABBMAAAIuA` +
-	`AAACEAAAAADPwHAQAAAP8M/QAAAAAAAAAAAAAAAAAAAAADjQwMAQEAABkEAAAEoAAAAA4AAAAADvsIAQAAAP8O/QAAAAAAAAAAAAAAAAAAAAADjQ4OAQEAAACOAAABCACQAJcAAANQAJoAmwAAA3wA` +
-	`nQCeAAADkACgAKQAAAOkAKYApgAAA8QAqADnAAAD1ADrAOwAAATgAP0BAAAABPQBAgEDAAAFEAEGAQcAAAUkAQwBDwAABTgBEgEXAAAFVAElASgAAAV4ASsBLAAABZQBLwEwAAAFqAEzATQAAAW8AT` +
-	`cBOwAABdABPgFBAAAF8AFEAUcAAAYMAUwBTQAABigBUgFTAAAGPAFWAVsAAAZQAWABYwAABnQBaAFwAAAGkAGEAeIAAAbAAeQB5AAACEgB5gHmAAAIWAHoAegAAAhoAeoB6gAACHgB7AHsAAAIiAHu` +
-	`Ae4AAAiYA40DjQAACKgAAQABAAAABAAAAAAAAAANAAAAEwAAABkAAAAfAAAALAAAADUAAABAAAAATwAAAFwAAABpAAAAcgAAAIEAAACQAAAAmwAAAKYAAACwAAAAtwAAAL8AAADPAAAA3AAAAOkAAA` +
-	`D2AAABAwAAARAAAAEdAAABKgAAATcAAAFEAAABUQAAAV0AAAFrAAABeAAAAYEAAAGOAAABmwAAAaoAAAG3AAABxAAAAdEAAAHeAAAB6wAAAfgAAAIFAAACEgAAAh8AAAIsAAACOQAAAkYAAAJTAAAC` +
-	`YAAAAm0AAAJ6AAACiAAAApUAAAKiAAACrwAAArwAAALJAAAC1gAAAuMAAALwAAAC/QAAAwwAAAMcAAADKwAAAzQAAAM7AAADRAAAA08AAANcAAADZwAAA3QAAAN/AAADjAAAA5kAAAOmAAADswAAA8` +
-	`IAAAPPAAAD3AAAA+cAAAPyAAAD/QAABAoAAAQXAAAEIgAABC0AAAQ6AAAERQAABFAAAARbAAAEZgAABHMAAAR+AAAEjQAABJ4AAAStAAAEtQAABMQAAATTAAAE4gAABPEAAAUAAAAFDwAABR4AAAUs` +
-	`AAAFOgAABUgAAAVVAAAFYwAABXEAAAV+AAAFjAAABZoAAAWoAAAFtQAABcMAAAXRAAAF3wAABewAAAX6AAAGCAAABhYAAAYkAAAGMQAABj8AAAZNAAAGWwAABmkAAAZ2AAAGggAABowAAAaZAAAGpw` +
-	`AABrgAAAbDAAAG0QAABuAAAAbuAAAG/AAABwUAAAcOAAAHFQABAAEAAAcZAAAAAAAAAA0AAAAaAAAAIwAAADAAAAA9AAAASgAAAFgAAABlAAEAAQAAB34AAAAAAAAADQAAABgAAQABAAAHlgAAAAAA` +
-	`AAAMAAAAGAABAAEAAAeuAAAAAAAAAAsAAAAYAAAAJQAAADIAAAA7AAEAAQAAB+kAAAAAAAAAEAABAAEAAAf5AAAAAAAAAAwAAAAWAAAAIAAAACcAAAAtAAAAPAAAAEsAAABaAAAAZwAAAHIAAAB5AA` +
-	`AAgAAAAIkAAACSAAAAmwAAAKQAAACvAAAAugAAAMkAAADYAAAA5wAAAPQAAAD+AAABCAAAARUAAAEiAAABMQAAATkAAAFDAAABTAAAAVkAAAFoAAABdwAAAYYAAAGVAAABpAAAAbMAAAHCAAAB0QAA` +
-	`AeAAAAHvAAAB/gAAAgsAAAIaAAACKQAAAjgAAAJHAAACUgAAAlsAAAJjAAACagAAAnIAAAJ6AAACgwAAAowAAAKVAAACngAAAqcAAAK0AAACwQAAAtAAAALeAAAC7QAAAvsAAQABAAAK9AAAAAAAAA` +
-	`APAAAAHwABAAEAAAsTAAAAAAAAAA8AAAAdAAAALAAAADoAAQABAAALTQAAAAAAAAAPAAAAHAABAAEAAAtpAAAAAAAAAA4AAAAaAAEAAQAAC4MAAAAAAAAADwAAAB0AAAAsAAAAOQABAAEAAAu8AAAA` +
-	`AAAAAA8AAAAcAAAAKgAAADcAAABGAAAAVAABAAEAAAwQAAAAAAAAAA8AAAAcAAAAKwAAADoAAQABAAAMSgAAAAAAAAAPAAAAHgABAAEAAAxoAAAAAAAAAA4AAAAcAAEAAQAADIQAAAAAAAAADwAAAB` +
-	`0AAQABAAAMoQAAAAAAAAAPAAAAHQAAACoAAAA5AAAARgABAAEAAAznAAAAAAAAAA8AAAAdAAAALAAAADoAAQABAAANIQAAAAAAAAAPAAAAHQAAACwAAAA6AAEAAQAADVsAAAAAAAAADwAAAB4AAQAB` +
-	`AAANeQAAAAAAAAAPAAAAHAABAAEAAA2VAAAAAAAAAA8AAAAdAAAALAAAADoAAABJAAAAVgABAAEAAA3rAAAAAAAAAA8AAAAdAAAALAAAADkAAQABAAAOJAAAAAAAAAAPAAAAHwAAAC4AAAA9AAAATA` +
-	`AAAFsAAABqAAAAeQAAAIYAAQABAAAOqgAAAAAAAAANAAAAGgAAACcAAAA0AAAAQwAAAFAAAABdAAAAagAAAHcAAACGAAAAkwAAAKAAAACtAAAAugAAAMcAAADUAAAA4QAAAO4AAAD7AAABCAAAARUA` +
-	`AAEiAAABMQAAAT4AAAFLAAABWgAAAWcAAAF0AAABgQAAAY4AAAGbAAABqAAAAbUAAAHDAAAB0QAAAeAAAAHuAAAB+wAAAggAAAIVAAACIAAAAi8AAAI8AAACSQAAAlQAAAJhAAACbAAAAnsAAAKIAA` +
-	`AClQAAAqIAAAKxAAACvwAAAsoAAALZAAAC6AAAAvcAAAMEAAADEwAAAyAAAAMrAAADNgAAA0QAAANPAAADWgAAA2cAAANyAAADfQAAA4gAAAOTAAADoAAAA6sAAAO2AAADwQAAA8wAAAPXAAAD4gAA` +
-	`A+8AAAP6AAAEBQAABBIAAAQhAAAELAAABDkAAAREAAAETwAABFwAAARnAAAEcgAABH0AAASIAAAEkwAABKAAAASvAAAEvAABAAEAABNmAAAAAAAAAAsAAQABAAATcQAAAAAAAAANAAEAAQAAE34AAA` +
-	`AAAAAADQABAAEAABOLAAAAAAAAABEAAQABAAATnAAAAAAAAAAMAAEAAQAAE6gAAAAAAAAACwABAAEAABOzAAAAAAAAAA0AAACOAAAAkACQAJcAAALYAJoAmwAAAwQAnQCeAAADGACgAKQAAAMsAKYA` +
-	`pgAAA0wAqADhAAADXAGEAeIAAARQAeQB5AAABdgB5gHmAAAF6AHoAegAAAX4AeoB6gAABggB7AHsAAAGGAHuAe4AAAYoA40DjwAABjgGawZyAAAGUAZ9Bn0AAAZ8BoAGjAAABowAAQABAAATwAAAAA` +
-	`AAAAAOAAAAFAAAABoAAAAgAAAALgAAADcAAABFAAAAVQAAAGMAAABxAAAAegAAAIwAAACeAAAAqgAAALUAAAC/AAAAxgAAAM4AAADdAAAA6wAAAPkAAAEHAAABFQAAASMAAAExAAABPwAAAU0AAAFb` +
-	`AAABaQAAAXUAAAGDAAABjgAAAZcAAAGiAAABsAAAAb4AAAHMAAAB2gAAAegAAAH2AAACBAAAAhIAAAIgAAACLgAAAjwAAAJKAAACWAAAAmYAAAJ0AAACggAAApAAAAKeAAACrgAAArwAAALKAAAC2A` +
-	`AAAuYAAAL0AAADAgAAAxAAAAMeAAADLAAAAz0AAANLAAADXAAAA2cAAANuAAADdgAAA4IAAAOQAAADnAAAA6oAAAO2AAADxAAAA9IAAAPgAAAD7gAAA/4AAAQMAAAEGgAABCYAAAQyAAAEPgAABEwA` +
-	`AARaAAAEZgAABHIAAASAAAAEjAAABJgAAASkAAAEsAAABL4AAATKAAAE2wAABOsAAAT8AAAFBAAABRQAAAUkAAAFNQAABUUAAAVVAAAFZQAABXUAAAWEAAAFkwAABaIAAAWwAAAFvwAABc8AAAXeAA` +
-	`AF7QAABfwAAAYLAAAGGQAABikAAAY5AAAGSAAABlYAAAZlAAAGdAAABoMAAAaSAAAGoAAABq8AAAa+AAAGzQAABtwAAAbqAAAG+gAABwMAAAcTAAAHIQAABzEAAAc7AAAHSgAAB1gAAAdmAAAHdAAA` +
-	`B34AAAeGAAAHjQABAAEAABtNAAAAAAAAAA4AAAAeAAAAJwAAADQAAABBAAAATgAAAFwAAABqAAEAAQAAG7cAAAAAAAAAEAAAABwAAQABAAAb0wAAAAAAAAAMAAAAGAABAAEAABvrAAAAAAAAAAwAAA` +
-	`AaAAAAKAAAADYAAAA/AAEAAQAAHCoAAAAAAAAAEQABAAEAABw7AAAAAAAAAA4AAAAZAAAAJAAAACsAAAAxAAAAQQAAAFEAAABhAAAAbwAAAHsAAACCAAAAiQAAAJMAAACdAAAApwAAALEAAAC8AAAA` +
-	`ywAAANsAAADrAAAA+wAAAQgAAAETAAABHgAAASwAAAE6AAABSgAAAVIAAAFcAAABZgAAAXMAAAGDAAABkwAAAaMAAAGzAAABwwAAAdMAAAHjAAAB8wAAAgMAAAITAAACIwAAAjEAAAJBAAACUQAAAm` +
-	`EAAAJxAAACfQAAAoUAAAKNAAAClAAAApwAAAKkAAACrQAAArYAAAK/AAACyAAAAtAAAQABAAAfCwAAAAAAAAAOAAAAHAAAACoAAAA4AAAASAAAAFYAAABkAAAAcgAAAIAAAACQAAAAngAAAKwAAAC6` +
-	`AAAAyAAAANYAAADkAAAA8gAAAQAAAAEOAAABHAAAASoAAAE4AAABSAAAAVYAAAFkAAABdAAAAYIAAAGQAAABngAAAawAAAG6AAAByAAAAdYAAAHmAAAB9gAAAgYAAAIVAAACIwAAAjEAAAI/AAACSw` +
-	`AAAlsAAAJpAAACdwAAAoMAAAKRAAACnQAAAq0AAAK7AAACyQAAAtcAAALnAAAC9gAAAwIAAAMSAAADIwAAAzMAAANBAAADUQAAA18AAANrAAADdwAAA4YAAAOSAAADngAAA6wAAAO4AAADxAAAA9AA` +
-	`AAPcAAAD6wAAA/cAAAQDAAAEDwAABBsAAAQnAAAEMwAABEEAAARNAAAEWQAABGcAAAR3AAAEgwAABJEAAASdAAAEqQAABLcAAATDAAAEzwAABNsAAATnAAAE8wAABQEAAAURAAAFHwABAAEAACQqAA` +
-	`AAAAAAAAsAAQABAAAkNQAAAAAAAAAOAAEAAQAAJEMAAAAAAAAAEAABAAEAACRTAAAAAAAAABEAAQABAAAkZAAAAAAAAAAQAAEAAQAAJHQAAAAAAAAACgABAAEAACR+AAAAAAAAAA4AAAAfAAAALQAB` +
-	`AAEAACSrAAAAAAAAAAsAAAAXAAAAJgAAADUAAABEAAAAUgAAAGAAAABvAAEAAQAAJRoAAAAAAAAABwABAAEAACUhAAAAAAAAAA8AAAAaAAAAJQAAADAAAAA7AAAASgAAAFkAAABkAAAAbwAAAH4AAA` +
-	`CPAAAAnAAAAKoAAACOAAABCACQAJcAAANQAJoAmwAAA3wAnQCeAAADkACgAKQAAAOkAKYApgAAA8QAqADnAAAD1ADrAOwAAATgAP0BAAAABPQBAgEDAAAFEAEGAQcAAAUkAQwBDwAABTgBEgEXAAAF` +
-	`VAElASgAAAV4ASsBLAAABZQBLwEwAAAFqAEzATQAAAW8ATcBOwAABdABPgFBAAAF8AFEAUcAAAYMAUwBTQAABigBUgFTAAAGPAFWAVsAAAZQAWABYwAABnQBaAFwAAAGkAGEAeIAAAbAAeQB5AAACE` +
-	`gB5gHmAAAIWAHoAegAAAhoAeoB6gAACHgB7AHsAAAIiAHuAe4AAAiYA40DjQAACKgAAQABAAAlywAAAAAAAAAPAAAAFQAAABsAAAAhAAAAMAAAADkAAABGAAAAVwAAAGYAAAB1AAAAfgAAAI8AAACg` +
-	`AAAArAAAALcAAADBAAAAyAAAANAAAADiAAAA8QAAAQAAAAEPAAABHgAAAS0AAAE8AAABSwAAAVoAAAFpAAABeAAAAYQAAAGSAAABnwAAAagAAAG1AAABxAAAAdIAAAHhAAAB8AAAAf8AAAIOAAACHQ` +
-	`AAAiwAAAI7AAACSgAAAlkAAAJoAAACdwAAAoYAAAKVAAACpAAAArMAAALCAAAC0wAAAuIAAALxAAADAAAAAw8AAAMeAAADLQAAAzwAAANLAAADWgAAA2sAAAN9AAADjgAAA5cAAAOeAAADpwAAA7MA` +
-	`AAPCAAADzgAAA90AAAPpAAAD+AAABAcAAAQWAAAEJQAABDcAAARGAAAEVQAABGEAAARtAAAEeQAABIcAAASVAAAEoQAABK0AAAS7AAAExwAABNMAAATfAAAE6wAABPoAAAUGAAAFFwAABSwAAAU9AA` +
-	`AFRQAABVYAAAVoAAAFegAABYsAAAWdAAAFrgAABb8AAAXOAAAF3QAABewAAAX6AAAGCQAABhkAAAYoAAAGNwAABkYAAAZVAAAGYwAABnIAAAaBAAAGkAAABp4AAAatAAAGvAAABssAAAbaAAAG6AAA` +
-	`BvcAAAcGAAAHFQAAByQAAAcyAAAHPwAAB0kAAAdXAAAHZgAAB3YAAAeBAAAHkAAAB6EAAAexAAAHwQAAB8sAAAfUAAAH3AABAAEAAC2nAAAAAAAAAA8AAAAeAAAAKAAAADUAAABEAAAAUwAAAGMAAA` +
-	`ByAAEAAQAALhkAAAAAAAAADwAAABsAAQABAAAuNAAAAAAAAAANAAAAGgABAAEAAC5OAAAAAAAAAAwAAAAZAAAAKAAAADcAAABAAAEAAQAALo4AAAAAAAAAEwABAAEAAC6hAAAAAAAAAA4AAAAZAAAA` +
-	`JAAAACsAAAAxAAAAQgAAAFQAAABmAAAAdQAAAIEAAACIAAAAjwAAAJgAAAChAAAAqgAAALMAAADAAAAAzQAAAN4AAADwAAABAAAAAQ8AAAEaAAABJQAAATQAAAFDAAABVAAAAVwAAAFmAAABcAAAAX` +
-	`8AAAGRAAABowAAAbUAAAHHAAAB2QAAAesAAAH9AAACDwAAAiEAAAIzAAACRQAAAlQAAAJmAAACeAAAAooAAAKcAAACqAAAArEAAAK5AAACwAAAAsgAAALQAAAC2QAAAuIAAALrAAAC9AAAAv0AAAMM` +
-	`AAADGwAAAy0AAAM8AAADTgAAA10AAQABAAAx/gAAAAAAAAASAAAAJAABAAEAADIiAAAAAAAAABIAAAAhAAAAMwAAAEIAAQABAAAyZAAAAAAAAAARAAAAHwABAAEAADKDAAAAAAAAABEAAAAfAAEAAQ` +
-	`AAMqIAAAAAAAAAEgAAACMAAAA0AAAAQgABAAEAADLkAAAAAAAAABIAAAAhAAAAMgAAAEAAAABSAAAAYQABAAEAADNFAAAAAAAAABEAAAAfAAAAMAAAAEEAAQABAAAzhgAAAAAAAAASAAAAJAABAAEA` +
-	`ADOqAAAAAAAAABEAAAAiAAEAAQAAM8wAAAAAAAAAEgAAACEAAQABAAAz7QAAAAAAAAASAAAAIQAAAC8AAABAAAAATgABAAEAADQ7AAAAAAAAABIAAAAhAAAAMwAAAEIAAQABAAA0fQAAAAAAAAASAA` +
-	`AAIQAAADMAAABCAAEAAQAANL8AAAAAAAAAEgAAACMAAQABAAA04gAAAAAAAAARAAAAHwABAAEAADUBAAAAAAAAABIAAAAhAAAAMwAAAEIAAABTAAAAYQABAAEAADViAAAAAAAAABIAAAAhAAAAMgAA` +
-	`AEAAAQABAAA1ogAAAAAAAAARAAAAIwAAADQAAABFAAAAVgAAAGcAAAB4AAAAiQAAAJcAAQABAAA2OQAAAAAAAAAPAAAAHgAAAC0AAAA8AAAATgAAAF0AAABsAAAAewAAAIoAAACcAAAAqwAAALoAAA` +
-	`DJAAAA2AAAAOcAAAD2AAABBQAAARQAAAEjAAABMgAAAUEAAAFQAAABYgAAAXEAAAGAAAABkgAAAaEAAAGwAAABvwAAAc4AAAHdAAAB7AAAAfsAAAIMAAACHQAAAi8AAAI+AAACTQAAAlwAAAJrAAAC` +
-	`dwAAAokAAAKYAAACpwAAArMAAALCAAACzgAAAuAAAALvAAAC/gAAAw0AAAMfAAADLgAAAzoAAANMAAADXgAAA3AAAAN+AAADkAAAA58AAAOrAAADtwAAA8YAAAPSAAAD3gAAA+wAAAP4AAAEBAAABB` +
-	`AAAAQcAAAEKwAABDcAAARDAAAETwAABFsAAARnAAAEcwAABIEAAASNAAAEmQAABKgAAAS6AAAExgAABNQAAATgAAAE7AAABPoAAAUGAAAFEgAABR4AAAUqAAAFNgAABUUAAAVWAAAFZAABAAEAADud` +
-	`AAAAAAAAAAsAAQABAAA7qAAAAAAAAAAOAAEAAQAAO7YAAAAAAAAADwABAAEAADvFAAAAAAAAABMAAQABAAA72AAAAAAAAAASAAEAAQAAO+oAAAAAAAAACgABAAEAADv0AAAAAAAAAA8AAACOAAAAcA` +
-	`CQAJcAAAK4AJoAmwAAAuQAnQCeAAAC+ACgAKQAAAMMAKYApgAAAywAqADhAAADPAHkAeQAAAQwAeYB5gAABEAB6AHoAAAEUAHqAeoAAARgAewB7AAABHAB7gHuAAAEgAONA40AAASQAAEAAQAAPAMA` +
-	`AAAAAAAAEQAAABcAAAAdAAAAIwAAADQAAAA+AAAATwAAAGQAAAB1AAAAhgAAAI8AAACjAAAAtwAAAMMAAADQAAAA2wAAAOIAAADqAAAA+wAAAQwAAAEdAAABLgAAAT8AAAFQAAABYQAAAXIAAAGDAA` +
-	`ABlAAAAaUAAAGzAAABxAAAAdEAAAHaAAAB5wAAAfkAAAIKAAACGwAAAiwAAAI9AAACTgAAAl8AAAJwAAACgQAAApIAAAKjAAACtAAAAsUAAALWAAAC5wAAAvgAAAMJAAADGgAAAy4AAAM/AAADUAAA` +
-	`A2EAAANyAAADgwAAA5QAAAOlAAADtgAAA8cAAAPbAAAD7AAABAAAAAQNAAAEFAAABBwAAAQqAAAEOwAABEkAAARaAAAEaAAABHkAAASKAAAEmwAABKwAAATAAAAE0QAABOIAAATwAAAE/gAABQwAAA` +
-	`UdAAAFLgAABTwAAAVKAAAFWgAABWgAAAV2AAAFhAAABZIAAAWjAAAFsQAABcUAAAXWAAAF6gAABfMAAAYGAAAGGQAABi0AAAZBAAAGVQAABmgAAAZ7AAAGjAAABp0AAAauAAAGvgAABs8AAAbgAAAG` +
-	`8QAABwIAAAcTAAAHJAAABzQAAAdFAAAHVgAAB2cAAAd3AAAHiAAAB5kAAAeqAAAHuwAAB8sAAAfcAAAH7QAAB/4AAAgPAAAIHwAACDMAAAg9AAAITwAACGAAAAhzAAAIfwAACJIAAAijAAAItAAACM` +
-	`UAAAjQAAAI2AAACN8AAQABAABE4gAAAAAAAAARAAAAIgAAAC0AAAA8AAAASwAAAFoAAABrAAAAewABAAEAAEVdAAAAAAAAABMAAAAhAAEAAQAARX4AAAAAAAAADQAAABoAAQABAABFmAAAAAAAAAAO` +
-	`AAAAHAAAAC4AAAA/AAAASAABAAEAAEXgAAAAAAAAABQAAQABAABF9AAAAAAAAAAQAAAAHQAAACoAAAAxAAAANwAAAEsAAABfAAAAcwAAAIQAAACSAAAAmQAAAKAAAACrAAAAtgAAAMEAAADMAAAA1w` +
-	`AAAOgAAAD7AAABDgAAAR8AAAE3AAABRAAAAVEAAAFiAAABcwAAAYcAAAGPAAABmgAAAaUAAAG3AAABywAAAd8AAAHzAAACBgAAAhoAAAIuAAACQgAAAlUAAAJpAAACfQAAApEAAAKgAAACtAAAAsgA` +
-	`AALcAAAC8AAAAv4AAAMGAAADDgAAAxUAAAMdAAADJQAAAy4AAAM3AAADPwAAA0gAAANQAAEAAQAASUQAAAAAAAAADQABAAEAAElRAAAAAAAAABEAAQABAABJYgAAAAAAAAAUAAEAAQAASXYAAAAAAA` +
-	`AAEwABAAEAAEmJAAAAAAAAABQAAQABAABJnQAAAAAAAAAMAAEAAQAASakAAAAAAAAAEQAAAAoAAAADAAAAVAABAAAAAA0GAAEAAAAADxAAAQAAAAARGgABAAAAABMkAAEAAAAAFS4AAQAAAAAXNgAB` +
-	`AAAAABlAAAEABwAAG0oAAQAdAAAdVAAEDLIAAAFYAQAABwBYAAAACgANAB0AfgERAR0BYQF3AYABhgGJAYwBkgGXAZoBoQGlAakBqwGxAbkBuwHMAdwB9QH/AhcCGwIgAiICJQIzAjkCPgJBAlQCXQ` +
-	`JiAmsCdgKFAooCjwKSApkCnAKjAqcCrwK4At8DTwNaA2IDbwN0A34DhQOHA6EDqAOpA8AEDwQvBE4EXwRjBGcEawRxBHcEkwSlBK0EswS7BMIExgTKBN8E9QT5HQcdDx0jHTIdPB09HUIdSh1bHWUd` +
-	`eB1/Hpkemx75IAogXiBjIG8gcSB/II4gqSCuILUhASEDIQYhCSEXIR4hIiEnISshLyEyITshRCFLIWAhZCFpIXAhdCF5IX8hkyICIgciGiIeIiMiLCI3Ij0iQCJDIkUiSCJhImUiiSO9JA0kJCUAJQ` +
-	`IlDCUQJRQlGCUcJSQlLCU0JTwliCWSJcYlyqcHpwqnEacW+H/4//sC//8AAAAAAAgADQAdACAAoAESAR4BYgF4AYIBiQGLAY4BlgGZAZwBpQGnAasBrQG1AbsBwAHNAd0B+AIAAhgCHgIiAiQCJgI3` +
-	`AjoCQQJRAlYCXwJlAm0CeAKHAowCkgKUApwCngKmAqkCsAK5AuEDUANbA2MDdAN+A4UDhwORA6MDqQPABAAEEAQwBE8EYgRmBGoEcARyBJAElgSqBK4EtgTABMUEyQTQBOIE+B0AHQkdEB0mHTMdPR` +
-	`0+HUUdTR1iHWsdeh4AHpoeoCAAIAsgXyBqIHAgdCCAIKAgqyCxIQAhAyEFIQkhFiEeISAhJiEpIS8hMiE6IUEhSyFfIWQhaSFsIXQheSF8IZIiAiIGIg8iHSIjIiciNCI8IkAiQiJFIkgiYCJkIoIj` +
-	`uiQJJCQlACUCJQwlECUUJRglHCUkJSwlNCU8JYglkiXGJcqnAKcIpwunEvh/+P/7Af//AAEAAP/1/+T/4wAA//wAAP/oAAAAAAJ1Ar8AAAAAAAAAAANiAAADWQAAAAAC+gAAACwAAAAhAZL/TAAAA/` +
-	`EC9//9AAAC5gLcAAAAAAAAAAAAAAAAAAAAAAMdAAACVgAAAAAAAAKKAAAAAAF8AAABdADQAFAASgBJAmACX//k/NsAAP10/ZEAAAEVARMB1wHJAQkAAAAAAAD+j/6NAAABeAF2AAAAAAAAAADosgAA` +
-	`AADopejY6KTo1OjS6MwAAAAA5DMAAAAA42QAAORF5D8AAOP/4+MAAAAAAADkj+SQ5IzkiwAA43kAAAAAAADiYOJcAAAAAONHAADiE+INAADiBuIC4gDk2d/kAAAAAAAA43UAAAAA42jjaAAA42ffpg` +
-	`AA3jAAAAAA4mTiTuF94X7hdeFy4W/hbOFp4WLhW+FU4U3hAuD64MXa719gAABfRF81Dg4H0wW/AAEAAAFWAAAAAAAAAVQAAAI0AAACuALIAAAAAALMAtQC1gLYAAAC4AAAAuIC6gAAAvAAAAMGAAAA` +
-	`AAAAAzAAAAAAAAADLgAAAAADLgM0A0IDSANUA2YDgAOGAAADigAAA5IDnAOeAAADqAP0AAAEzgAAAAAAAAAAAAAAAAAAAAAAAATKAAAAAATkAAAAAAAAAAAAAAT6BQAFHgAAAAAFIAAAAAAFIAU+BW` +
-	`QFZgAABXIFmAAAAAAAAAAAAAAAAAWkBb4AAAXGBcgAAAZ4AAAAAAcaAAAAAAcYByoHMAAAAAAAAAAABzAAAAcwBzQHNgAAAAAHNgc4AAAHPAAAAAAHOgAAAAAAAAAAAAAHOAc6B1AAAAdQB1oAAAAA` +
-	`B1wAAAAAB1oAAAdaB2gAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHSgAAAAAAAAAAAAAAAAABAAIAAgCsAKMAhACFAXUAlgDoAIYAjgCLAJ0AqQCkA1YAigDaAIMAkwDyAPMAjQ` +
-	`CXAIgAwwDeAPEAngCqAPUA9AD2AKIArQDJAMcArgBiAGMAkABkAMsAZQDIAMoAzwDMAM0AzgDpAGYA0wDQANEArwBnAPAAkQDWANQA1QBoAOsA7QCJAGoAaQBrAG0AbABuAKAAbwBxAHAAcgBzAHUA` +
-	`dAB2AHcA6gB4AHoAeQB7AH0AfAC4AKEAfwB+AIAAgQDsAO4AugECAQMBBAEFAQYBBwD9AP4BCAEJAQoBCwD/AQABDAENAXEBAQD4APkBGgEbAWgBaQEfASABIQEiASMBJAElASYB9QH2AScBKAD6AN` +
-	`cEAAQBAXMB9wFsAW0DKAErASwBagFrAS8BMAExATIA4gDjATMBNAFuATkBNwE4A5EDKQMqAToBOwE8AT0BPgE/ALAAsQFAAUEBbwFwAUQBRQFGAUcBSAFJAPsA/ADkAOUAuwFgAWEBYgFjAOYA5wMn` +
-	`BGIESARJBQgFCQRQAysDLAZDBGAApgT8BE4FBgRPBLYEYQP/A2ADVANVAzMDNAP9BQUEjANSA1MDUARGBEcFJwWtBbAB7wOLA4wB+AUVBRYFFwUSBRMFFAUPBRAFEQMtAgkCCgILAgwCDQIOBQoFCw` +
-	`IPAhACEQISAhMCFAIVAhYFsgWxAhcFGAUZBRoCGAEeAiECIgWuAXQFJQUmA1oDWQT9A1cE6gT+A1gDLgVEBkUGRAZGBE0FAwSGBO4E6AT/BQAE+wTHBIcFDASCBbMDXAQHBIUEgASBBO8DXwTnBQ0D` +
-	`hAQJBIMEBgSEBOUE5gTrBOwE7QOABPkE+ANbBEwE9wTwA10DXgTpBPEDgQOCA4MFHwQIBPQE+gTzBQ4FAQUCBUUFRgVIBUcFSQVKBU0FTgVLBUwEOQQ6A+kD6gQlBCQEJgQiBCMD6wPsA+0D7gDYAO` +
-	`EEJwQoBCkEKgQuBC0EKwQsBVQFVQQvBDAEMQQyBDMFOQDbANwA3QDgANkA3wVDBOQFUAVRBVIFUwVXBVgFWQVaBVsFXAVdBDQFTwIxBWIFYQVeBV8FYAVuBW0FbAVrBWkFZQVmBWcFaAVjBWQFagF2` +
-	`AXcBeAF5AXoDLwF7AXwBfQP0AX4BfwGAA/UD9gMwBLwDMgPvA/AD8gPxA4kDigP7A/oEtwNRBDYD+QP4A/cEuwReBF8DIAMhAyIBcgGBAYIEjQS4BL0D/AMjAyQDiAMlAyYDMQQ4A4UDhgOHBI4Bgw` +
-	`Q1BLkEwQTKBMkEvgQ3A8sDzAPoA/MDzQTIBLoEwATDBMIExgTEBMUEywS/A5AFdgV0BXAFcQVvBXMFcgV1A70BvgGlAacBqwG0AaQBrQGqAa8BsQG1AbcDvgG6AbwBwAMEAb8BpgGoAawBuQGpAa4B` +
-	`swGwAbIBtgG4AwUBuwG9AeEB4gM1AzYDTQNOA+ID4wM3AzgDOQM6A+QD5QPmA+cDOwM8BjsGPAPgA+ED3gPfAjIDvwMGAwcDCAMJAwoDHAMdA8ADCwNJA0oDwQPCA8MDDAPEAw0DxQMOA8YDDwMQAx` +
-	`EDSwNMA8cDyAMSAxMDFAMVAxYDFwMYAxkDyQMaA8oDGwW0BbUFtgXSBbcFuAW5BboFxgYQBhEGEgXCBhQGFwYYBcMFxAXFBccFyAYOBhYGDQXJBcoFywXMBc4FzQYMBc8GCwYPBdAF0QXXBdMF1AXV` +
-	`BdYF6AXpBeoF6wYIBgkGCgXsBe0GMgXuBe8F8AYzBecGNgY3BjgGNAY1BEUDqgLNAs4ECgQLAs8C0ALRAtIEDAQNAtMC1ALVAtYC1wLYAtkC2gQOBA8C2wLcAt0C3gLfAuAEEAQRAuEC4gLjAuQC5Q` +
-	`LmBBIEEwLnAugC6QLqBBQEFQLrAuwC7QLuBBYEFwLvAvAC8QLyBBgEGQLzAvQC9QL2A6sDrAOtA64EGgQbA68DsAOxA7IC9wL4BBwEHQOzA7QDtQO2BB4EHwO3A7gDuQO6AvkC+gL7AvwEIAQhAv0C` +
-	`/gHwAfEB8gRCBEMEAgQDBAQAsgCzBAUFHgUwALYAtwDEA3AAtAC1AMUDbwCCAMIAhwVWA2MDYgCrBEEEmwScBJ0EngSfBKAEoQSaAMYFKgQ7BDwEPQQ+BD8EQAT1AL4AvwWEBKMEogUrBTYFNwUsBY` +
-	`sFKQC8BLMEtAUoBTIFMwUxA2EEsQSwBS0FLgU4BS8EsgU0BTUErwWHBYYFgQWIBYMFggWFBYkFigRyBH8EiQSLBFMA9wRUBFcEWAT2BIgEWQRSAL0EVQRWBIoEWgRbBFwEXQHgBYwElASVAIwAnwNP` +
-	`BY4B8wH0BY0ElgSQBJEEkwSPA3EDeANyA3MDdAN1A3kAqARRAJoFmgHoAO8FmQWbBZwFnQWgBaEFogHsBZcAkgWWBZUGVwZWAeoFowWmBacFngWfBasFqgHkBakGWAZZBl4GXwZaBlsGXAZdBnsGfA` +
-	`Z+Bn8GTAZOBk0ABgIKAAAAAAEAAAEAAAAAAAAAAAAAAAAAAAABAAIAAAAAAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAADAAQABQAGAAcACAAJAAoACwAMAA0ADgAPABAA` +
-	`EQASABMAFAAVABYAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8AD0APgA/AEAAQQBCAEMARABFAEYARwBIAE` +
-	`kASgBLAEwATQBOAE8AUABRAFIAUwBUAFUAVgBXAFgAWQBaAFsAXABdAF4AXwBgAGEAAABiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG8AcABxAHIAcwB0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCA` +
-	`AIEAggCDAIQAhQCGAIcAiACJAIoAiwCMAI0AjgHkAJAAkQCSAJMAlACVAJYAlwHmAegAmgCbAeoAnQCeAJ8AoAChAKIAowCkAewApgHuAKgAqQCqAKsArACtAK4ArwCwALEAsgCzALQAtQC2ALcAuA` +
-	`C5ALoAuwC8AL0AvgC/AMAAwQDCAMMAxADFAMYAxwDIAMkAygDLAMwAzQDOAM8A0ADRANIA0wDUANUA1gDXANgA2QDaANsA3ADdAN4A3wDgAOEABgIKAAMAAAEAAAEAAAAAAAAAAAAAAAAAAAABAAMA` +
-	`AAAAAAAAAgAAAAAAAAAAAAAAAADSAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAQABQAGAAcACAAJAAoACwAMAA0ADgAPABAAEQASABMAFAAVABYAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkAC` +
-	`UAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8AD0APgA/`
-
-const MonacoFontData1 = `AEAAQQBCAEMARABFAEYARwBIAEkASgBLAEwATQBOAE8AUABRAFIAUwBUAFUAVgBXAFgAWQBaAFsAXABdAF4AXwBgAGEAAABiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG` +
-	`8AcABxAHIAcwB0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCAAIEAggCDAIQAhQCGAIcAiACJAIoAiwCMAI0AjgHkAJAAkQCSAJMAlACVAJYAlwHmAegAmgCbAeoAnQCeAJ8AoAChAKIAowCkAewApgHu` +
-	`AKgAqQCqAKsArACtAK4ArwCwALEAsgCzALQAtQC2ALcAuAC5ALoAuwC8AL0AvgC/AMAAwQDCAMMAxADFAMYAxwDIAMkAygDLAMwAzQDOAM8A0ADRANIA0wDUANUA1gDXANgA2QDaANsA3ADdAN4A3w` +
-	`DgAOEABgIKABAAAAEAAAEAAAAAAAAAAAAAAAAAAAABAAMAAAAAAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAADAAQABQAGAAcACAAJAAoACwAMAA0ADgAPABAAEQASABMA` +
-	`FAAVABYAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8AD0APgA/AEAAQQBCAEMARABFAEYARwBIAEkASgBLAE` +
-	`wATQBOAE8AUABRAFIAUwBUAFUAVgBXAFgAWQBaAFsAXABdAF4AXwBgAGEAYgBiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG8AcABxAHIAcwB0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCAAIEA6wCD` +
-	`AIQAhQCGAIcAiACJAIoAiwCMAI0AjgHkAJAAkQCSAJMAlACVAJYAlwHmAegAmgCbAeoAnQCeAJ8AoAChAKIAowCkAewApgHuAKgAqQCqAKsArACtAK4ArwCwALEAsgCzALQAtQC2ALcAuAC5ALoAuw` +
-	`C8AL0A6QDqAO0A7gDsAMMAxADFAMYAxwDIAMkAygDLAMwAzQDOAM8A0ADRANIA0wDUANUA1gDXANgA2QDaANsA3ADdAN4A3wDgAOEABgIKABIAAAEAAAEAAAAAAAAAAAAAAAAAAAABAAMAAAAAAAAA` +
-	`AgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAADAAQABQAGAAcACAAJAAoACwAMAA0ADgAPABAAEQASABMAFAAVABYAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnAC` +
-	`gAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8AD0APgA/AEAAQQBCAEMARABFAEYARwBIAEkASgBLAEwATQBOAE8AUABRAFIAUwBUAFUAVgBXAFgAWQBaAFsAXABdAF4AXwBg` +
-	`AGEAYgBiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG8AcABxAHIAcwB0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCAAIEAggCDAIQAhQCGAIcAiACJAIoAiwCMAI0AjgHkAJAAkQCSAJMAlACVAJYAlw` +
-	`HmAegAmgCbAeoAnQCeAJ8AoAChAKIAowCkAewApgHuAKgAqQCqAKsArACtAK4ArwCwALEAsgCzALQAtQC2ALcAuAC5ALoAuwD4APkA+gDXAPsA/ADCAMMAxADFAMYAxwDIAMkAygDLAMwAzQDOAM8A` +
-	`0ADRANIA0wDUANUA1gAAANgA2QDaANsA3ADdAN4A3wDgAOEABgIIABMAAAD/AAEAAAAAAAAAAAAAAAAAAAABAAMAAAAAAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAADAA` +
-	`QABQAGAAcACAAJAAoACwAMAA0ADgAPABAAEQASABMAFAAVABYAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8` +
-	`AD0APgA/AEAAQQBCAEMARABFAEYARwBIAEkASgBLAEwATQBOAE8AUABRAFIAUwBUAFUAVgBXAFgAWQBaAFsAXABdAF4AXwBgAGEAYgBiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG8AcABxAHIAcw` +
-	`B0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCAAIEAggCDAIQAhQCGAIcAiACJAIoA+wCMAI0AjgHkAOYAkQCSAJMAlACVAJYAlwHmAegAmgD8AeoAnQCeAJ8A5wChAKIAowCkAewApgHuAP0AqQD/AKsA` +
-	`rACtAK4ArwCwALEA6QCzALQAtQC2ALcAuAC5ANIAiwAAAL0AAAAAAJAAqgCyAAAAAAAAAAAAAAD+AAABAAAAAAAAAAAAAAAAAAAAAQEAAAAAAAAAAAAAAAAAAAAAAJsAAAAAAAAAAACgAAYCCgAfAA` +
-	`ABAAABAAAAAAAAAAAAAAAAAAAAAQADAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAwAEAAUABgAHAAgACQAKAAsADAANAA4ADwAQABEAEgATABQAFQAWABcAGAAZ` +
-	`ABoAGwAcAB0AHgAfACAAIQAiACMAJAAlACYAJwAoACkAKgArACwALQAuAC8AMAAxADIAMwA0ADUANgA3ADgAOQA6ADsAPAA9AD4APwBAAEEAQgBDAEQARQBGAEcASABJAEoASwBMAE0ATgBPAFAAUQ` +
-	`BSAFMAVABVAFYAVwBYAFkAWgBbAFwAXQBeAF8AYABhAGIAYgBjAGQAZQBmAGcAaABpAGoAawBsAG0AbgBvAHAAcQByAHMAdAB1AHYAdwB4AHkAegB7AHwAfQB+AH8AgACBAOsAgwCEAIUAhgCHAIgA` +
-	`iQCKAIsAjACNAI4B5ACQAJEAkgCTAJQAlQCWAJcB5gHoAJoAmwHqAJ0AngCfAKAAoQCiAKMApAHsAKYB7gCoAKkAqgCrAKwArQCuAK8AsACxALIAswC0ALUAtgC3ALgAuQC6ALsAvAC9AOkA6gDtAO` +
-	`4A7ADDAMQAxQDGAMcAyADJAMoAywDMAM0AzgDPANAA0QDSANMA1ADVANYA1wDYANkA2gDbANwA3QDeAN8A4ADhAAYCCgAmAAABAAABAAAAAAAAAAAAAAAAAAAAAQADAAAAAAAAAAIAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAwAEAAUABgAHAAgACQAKAAsADAANAA4ADwAQABEAEgATABQAFQAWABcAGAAZABoAGwAcAB0AHgAfACAAIQAiACMAJAAlACYAJwAoACkAKgArACwALQ` +
-	`AuAC8AMAAxADIAMwA0ADUANgA3ADgAOQA6ADsAPAA9AD4APwBAAEEAQgBDAEQARQBGAEcASABJAEoASwBMAE0ATgBPAFAAUQBSAFMAVABVAFYAVwBYAFkAWgBbAFwAXQBeAF8AYABhAAAAYgBjAGQA` +
-	`ZQBmAGcAaABpAGoAawBsAG0AbgBvAHAAcQByAHMAdAB1AHYAdwB4AHkAegB7AHwAfQB+AH8AgACBAIIAgwCEAIUAhgCHAIgAiQCKAIsAjACNAI4B5AEEAWQAkgCTAJQAlQCWAJcB5gHoAJoAmwHqAJ` +
-	`0AngCfAQUBZQCiAKMApAHsAKYB7gCoAKkAqgCrAKwArQCuAK8AsACxALIAswC0ALUAtgC3ALgAuQC6ALsAvAC9AL4AvwFmAWcAwgDDAMQAxQDGAMcAyADJAMoAywDMAM0AzgDPANAA0QDSANMA1ADV` +
-	`ANYA1wDYANkA2gDbANwA3QDeAN8A4ADhAAYCCgAuAAABAAABAAAAAAAAAAAAAAAAAAAAAQACAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAwAEAAUABgAHAAgACQ` +
-	`AKAAsADAANAA4ADwAQABEAEgATABQAFQAWABcAGAAZABoAGwAcAB0AHgAfACAAIQAiACMAJAAlACYAJwAoACkAKgArACwALQAuAC8AMAAxADIAMwA0ADUANgA3ADgAOQA6ADsAPAA9AD4APwBAAEEA` +
-	`QgBDAEQARQBGAEcASABJAEoASwBMAE0ATgBPAFAAUQBSAFMAVABVAFYAVwBYAFkAWgBbAFwAXQBeAF8AYABhAAABhAGFAYYBhwGIAYkBigGLAYwBjQGOAY8BkAGRAZIBkwGUAZUBlgGXAZgBmQGaAZ` +
-	`sBnAGdAZ4BnwGgAaEBogGjAYQBhQHhAIUAhgCHAIgBpACKAIsAjAGlAaYB5AGnAagAkgCTAJQAlQGpAJcB4gGqAasBrAGtAa4BrwGwAbEBsgGzAbQApAHsAKYB7gCoAKkAqgCrAKwBtQG2AbcBuAG5` +
-	`ALIAswC0ALUAtgC3ALgAxQG6AbsBvAG9AeABvgG/AcABwQHCAcMBxAHFAcYBxwHIAckBygHLAcwBzQHOAc8B0AHRAdIB0wHUAdUB1gHXAdgB2QHaAdsB3AHdAd4B3wC9AAYCCgAAAAABAAABAAAAAA` +
-	`AAAAAAAAAAAAAAAQADAAAAAAAAAAIAAAAAAAAAAAAAAAAA0gAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAwAEAAUABgAHAAgACQAKAAsADAANAA4ADwAQABEAEgATABQAFQAWABcAGAAZABoAGwAcAB0A` +
-	`HgAfACAAIQAiACMAJAAlACYAJwAoACkAKgArACwALQAuAC8AMAAxADIAMwA0ADUANgA3ADgAOQA6ADsAPAA9AD4APwBAAEEAQgBDAEQARQBGAEcASABJAEoASwBMAE0ATgBPAFAAUQBSAFMAVABVAF` +
-	`YAVwBYAFkAWgBbAFwAXQBeAF8AYABhAAAAYgECAQMAZQEGAGcAaABpAQcA/wBsAQAA/QD+AHABYAFhAQwAdAENAQ4BDwESAHkBEwB7AHwAfQB+ARYBFwCBAIIAgwEUAIUAhgCHAIgAiQCKAIsAjAEV` +
-	`AI4B5AFpAScBKAElAJQAlQEmAWwB5gHoAOMBagFrAS8BMAErASwBbgE5ATMApAHsATQBNwCoAKkAqgCrAKwBOAE+AK8BPwE6ALIAswC0ALUAtgC3ALgAuQE7AUABQQFEAL4AvwFFAW8BcADkAMQAxQ` +
-	`DlAUYBRwDJAUwBTQDMAOYA5wFSANAA0QFTAVYA1AFXAVgBWQFaAVsA6wDsAW0BYgDiAWMBaADhAAAAAgAABwQBBwbwkJCQkJDwBAAAAAAA8AAAAAAG8AAAAAAG8AcBAgcGgICAgIAAgAQDAwEHBqCg` +
-	`oIAFBQAHBlD4UPhQAAkFAAgGIHCooHAoqHAgBgcFAAcGSKhQIFCokHAHBQAHBmCQoECokGhwAwECCAaAgIBACQMBCAYgQECAgIBAQCAGCQMCCAaAQEAgICBAQIAGBQUABwYgqHCoICAFBQAGBiAg+C` +
-	`AgIAQCAQIGwMBAgCABBQAEBvjAAgICAgbAwEAKBQEJBggIEBAgIEBAgIAABwUABwZwiJioyIhwQAcCAQcGQMBAQEBAQEAHBQAHBnCICBAgQPhABwUABwZwiAgwCIhwQAcFAAcGEDBQkPgQEEAHBQAH` +
-	`BviA8AgIiHBABwUABwZwgPCIiIhwQAcFAAcG+AgIECAgIEAHBQAHBnCIiHCIiHBABwUABwZwiIiIeAhwQAYCAgYGwMAAAMDAcAgCAQYGwMAAAMDAQICABwQBBwYQIECAQCAQgAMFAAUG+AD4gAcEAQ` +
-	`cGgEAgECBAgIAHBQAHBnCICBAgACCACQUACAZwiIio6LCAiHCABwUABwZwiIj4iIiIiAcFAAcG8IiI8IiI8IgHBQAHBnCIgICAiHCIBwUABwbwiIiIiIjwiAcFAAcG+ICA8ICA+IgHBQAHBviAgPCA` +
-	`gICIBwUABwZwiICYiIhwiAcFAAcGiIiI+IiIiIgHAQIHBoCAgICAgICIBwUABwYICAgIiIhwiAcFAAcGiJCgwKCQiIgHBQAHBoCAgICAgPiIBwUABwaI2KiIiIiIiAcFAAcGiMiomIiIiIgHBQAHBn` +
-	`CIiIiIiHCIBwUABwbwiIjwgICAiAgFAAcGcIiIiIiIcAhwBwUABwbwiIjwiIiICAcFAAcGcIiAcAiIcAgHBQAHBvggICAgICAIBwUABwaIiIiIiIhwCAcFAAcGiIiIUFAgIAgHBQAHBoiIiIio2IgI` +
-	`BwUABwaIUCAgIFCICAcFAAcGiIiIUCAgIAgHBQAHBvgIECBAgPgICQICCAbAgICAgICAgMCACgUACQaAgEBAICAQEAgIAAkCAggGwEBAQEBAQEDACAMFAQgGIFCIQAEGAAEG/FADAwEIBoBAIEAFBQ` +
-	`AFBniIiJhoQAcFAAcGgIDwiIiI8EAFBQAFBnCIgIB4iAcFAAcGCAh4iIiIeEAFBQAFBnCI+IB4iAcEAQcGMEDgQEBAQEAHBQAFBniIiIh4CHBABwUABwaAgPCIiIiIQAcBAgcGgACAgICAgEAJAwAH` +
-	`BiAAICAgICAgwAgHBQAHBoCAkKDgkIggBwICBwaAgICAgIDAIAUFAAUG8KioqKiABQUABQawyIiIiIAFBQAFBnCIiIhwgAcFAAUG8IiIiPCAgCAHBQAFBniIiIh4CAggBQUABQawyICAgAgFBQAFBn` +
-	`iAcAjwCAcEAQcGQEDwQEBAMCAFBQAFBoiIiJhoQAUFAAUGiIhQUCBABQUABQaoqKioUEAFBQAFBohQIFCIQAcFAAUGiIiIiHgIcCAFBQAFBvgQIED4CAkFAAgGGCAgIMAgICAYCAsBAgkGgICAgICA` +
-	`gICAgIAACQUACAbAICAgGCAgIMCAAgUABwZosCAJBQAJBlAAcIiI+IiIiIAJBQAJBiBQIHCIiPiIiIAJBQAHBnCIgICAiHAgQIAJBQAJBhAg+ICA8ICA+IAJBQAJBmiwiMiomIiIiIAJBQAJBlAAcI` +
-	`iIiIiIcIAJBQAJBlAAiIiIiIiIcIAIBQAIBhAgAHiIiJhocAgFAAgGIBAAeIiImGhwCAUACAYgUAB4iIiYaHAHBQAHBlAAeIiImGhoCAUACAZosAB4iIiYaHAIBQAIBiBQIHiIiJhocAcFAAUGcIiA` +
-	`gHggQGgIBQAIBhAgAHCI+IB4cAgFAAgGQCAAcIj4gHhwCAUACAYgUABwiPiAeHAHBQAHBlAAcIj4gHh4CAICCAZAgACAgICAgHAIAgEIBoBAAEBAQEBAcAgDAQgGQKAAQEBAQEBwBwMBBwagAEBAQE` +
-	`BAQAgFAAgGaLAAsMiIiIhwCAUACAYQIABwiIiIcHAIBQAIBkAgAHCIiIhwcAgFAAgGIFAAcIiIiHBwBwUABwZQAHCIiIhwcAgFAAgGaLAAcIiIiHBwCAUACAYQIACIiIiYaHAIBQAIBkAgAIiIiJho` +
-	`cAgFAAgGIFAAiIiImGhwBwUABwZQAIiIiJhoaAYDAQcGQOBAQEBAaAQEAQgGYJCQYEAHBQAGBiBwqKCocCBoCAUACAYwQEDgQEBIsHALBAEJBmCQgECgkFAgEJBgAAUFAAYGcPj4+HCQCAYACAZ86O` +
-	`joeCgoLBAJBQAIBjBIiJCQiIiwgJAIBQAHBvAIyKjIqAjwgAgFAAcG8AhoiIhoCPCAAwYABwb0XFyIAwMCCAYgQICIAQMBBwagQAcFAAcGeKCg8KCguPAHB/8HBjpETFRkRLjwAwUABQbYqNhUBwUA` +
-	`BwYgIPggIAD48AcEAQcGIECAQCAA8PAHBAEHBkAgECBAAPDwCAUABwaIiFD4IPggIIAHBQAFBpCQkJDogIAgBwYABwb8SEhISEhIIAUFAAUG+FBQUFBIBgQBBwZwkLBQAPBIBgQBBwZgkJBgAPBIBQ` +
-	`UABQZwqLigePAHB/8GBgI8TFRkeIAgBwUABwYgACBAgIhwIAcBAwcGgACAgICAgCADBQAEBvgICIAKBQAJBhggICBwICAgIMBgBgUABgYgIFBQiPggBAUABQZIkJBIiAQFAAUGkEhIkIgBBQABBqhI` +
-	`AAAAAAaoCQUACQZAIHCIiPiIiIjACQUACQZosHCIiPiIiIjACQUACQZosHCIiIiIiHDABwUABwZ4oKCwoKB4iAUFAAUGcKi4oFigAQMBBAbgqAEGAAQG/KgDBAEHBlCg8KADBAEHBvBQoKADAgIHBk` +
-	`CAwKADAgIHBsBAgKAFBQAGBiAA+AAgoAUFAAYGIFCIUCCgCQUABwZQAIiIiIh4CHDACQUACQZQAIiIiFAgICDACQUACAYICBAQIEBAgIDABwYABwY4RPBA8EQ4gAQCAQUGQICAQPAEAgIFBoBAQIDw` +
-	`BwUABwYwQPhISEhIgAcFAAcGOEjoSEhISIAJAwEHBkBA4EBAQOBAQMACAgIFBsDA4AQCAgIGwMBAgEADBAECBvBQoIAHBgAHBkCoUCBItEhACQUACQYgUHCIiPiIiIjACQUBCQYgUPiAgPCAgPjACQ` +
-	`UACQYQIHCIiPiIiIjACQUACQZQAPiAgPCAgPjACQUACQZAIPiAgPCAgPjACQIBCQZAgABAQEBAQEDACQMBCQZAoABAQEBAQEDACQMBCQagAEBAQEBAQEDACQICCQaAQACAgICAgIDACQUACQYQIHCI` +
-	`iIiIiHDACQUACQYgUHCIiIiIiHDABwYABwYIEGz4+Px4iAkFAAkGQCBwiIiIiIhwwAkFAAkGECCIiIiIiIhwwAkFAAkGIFAAiIiIiIhwwAkFAAkGQCCIiIiIiIhwwAUBAgUGgICAgICIAwUACAYgUI` +
-	`iAAgUACAZosIgBBAEIBvCwAgUBCAaIcIgCAgIJBsDAiAMDAQgGQKBAgAMCAgEGgECAgAMEAQkGUFCggAMCAgEGQIBAgAMFAAkGiFAggAcFAAcGgICgwICA+IgHAwEHBkBAYEDAQECICQUACQZQIHCI` +
-	`gHAIiHDACAUACAZQIAB4gHAI8HAJBQAJBlAg+AgQIECA+MAIBQAIBlAgAPgQIED4+AkFAAkGECCIiIhQICAgwAoFAAgGECAAiIiIiHgIcGAJBQAJBhAgcIiAgICIcHAIBQAIBhAgAHCIgIB4cAkFAA` +
-	`kGUCBwiICAgIhwcAgFAAgGUCAAcIiAgHhwCQUACQb4AHCIiPiIiIhwBwUABwb4AHiIiJhoiAgGAAcGcIiI+IiIiASIBgYABQZ4iIiYaASICQUACQZQIPCIiIiIiPBwCAYACAYEFBhwkJCQcPAJBQAJ` +
-	`BvgA+ICA8ICA+HAHBQAHBvgAcIj4gHiACQUACQYgAPiAgPCAgPhwBwUABwYgAHCI+IB4gAgGAAcG+ICA8ICA+AT4BwUABQZwiPiAeBAIBAkFAAkGUCD4gIDwgID4cAgFAAgGUCAAcIj4gHj4CQMBCQ` +
-	`bgAEBAQEBAQEBwBwMBBwbgAEBAQEBAQAkCAQcGQEBAQEBAQIBAcAkCAQcGQABAQEBAQIBAcAkFAAkGIECAgICAgID4cAkDAgkGIECAgICAgICAcAgFAAgGEJCggICAgPiACAMCCAYgoMCAgICAgIAJ` +
-	`BQAJBhAgiMiomIiIiHAIBQAIBhAgALDIiIiIiAkFAAkGUCCIyKiYiIiIcAgFAAgGUCAAsMiIiIiIBwUABQawyIiIqCBAiAkFAAkG+ABwiIiIiIhwcAcFAAcG+ABwiIiIcIgJBQAJBihQcIiIiIiIcH` +
-	`AIBQAIBihQAHCIiIhwcAkFAAkGECDwiIjwiIiIcAgFAAgGECAAsMiAgICICQUACQZQIPCIiPCIiIhwCAUACAZQIACwyICAgIgJBQAJBhAgcIiAcAiIcHAIBQAIBhAgAHiAcAjwcAkFAAkGUCD4ICAg` +
-	`ICAgcAkFAQkGCAhQQOBAQEAwcAkFAAkG+ACIiIiIiIhwcAcFAAcG+ACIiIiYaIgJBQAJBiBQqIiIiIiIcHAIBQAIBiBQIIiIiJhocAkFAAkGKFCIiIiIiIhwcAgFAAgGKFAAiIiImGhwCQUABwaIiI` +
-	`iIiIhwIBBwBwUABQaIiIiYaCAQIAkFAAkGECD4CBAgQID4cAgFAAgGECAA+BAgQPj4CQUACQYgAPgIECBAgPhwBwUABwYgAPgQIED4gAkFAAcGcIiAmIiIcCBAcAoFAAgGECAAeIiIiHgIcGAJBQAH` +
-	`BoCAgICAgPggQHAJAgIHBoCAgICAgIBAgHAJBQAHBoiQoMCgkKggQHAJBQAHBoCAkKDgkKggQHAJBQAHBojIqJiIiKggQHAJBQAHBvCIiPCIiKggQHAHBQAFBrDIgICgIEAgBwUABwZwiIj4iIiIIA` +
-	`cFAAcG8ICA8IiI8CAHBQAHBvCIiPCIiPAgBwQBBwbwgICAgICAIAkGAAcGOEhISEhI/ISEcAcFAAcG+ICA8ICA+IQHBQAHBqioqHCoqKiEBwUABwZwiAgwCIhwhAcFAAcGiIiYqMiIiIQJBQAJBlAg` +
-	`iIiYqMiIiHAHBQAHBoiQoMCgkIiIBwUABwY4SEhISEiIiAcFAAcGiNioiIiIiIgHBQAHBoiIiPiIiIiIBwUABwZwiIiIiIhwiAcFAAcG+IiIiIiIiIgHBQAHBvCIiPCAgICIBwUABwZwiICAgIhwiA` +
-	`cFAAcG+CAgICAgIIgHBQAHBoiIiHgIiHCIBwUABwYgcKioqHAgiAcFAAcGiFAgICBQiIgJBgAHBoiIiIiIiPwEBHAHBQAHBoiIiHgICAgEBwUABwaoqKioqKj4BAkGAAcGqKioqKio/AQEcAcFAAcG` +
-	`wEBAcEhIcAQHBQAHBoiIiOiYmOgEBwUABwaAgIDwiIjwBAcFAAcGcIgIeAiIcAQHBQAHBpCoqOioqJAEBwUABwY4SEg4KEiIBAcBAgcGgICAgICAgAQIBQAHBvBAcEhISEgQBAgFAAcGQEDwQHBISB` +
-	`AECQQBCQYgQPCAgICAgIBwCAQBCAYgQADwgICAgIAHAQIHBoAAgICAgICABwUABwYICAgIiIhwgAcFAAcGcIiA4ICIcIAFBQAFBnCI4IB4iAkDAQkGoABAQEBAQEBAcAcDAQcGoABAQEBAQEAHBgAH` +
-	`BjBQUFhUVJhABQYABQYwUFhUmFQHBQAHBqCgoPCoqLBABQUABQagoPCosKgJAwAHBiAAICAgICAgwHAHBQAHBnCIgHAIiHAgBwUABwbwQHBISEhIIAcFAAcGQEDwQHBISCAJBQAJBhAgiJCgwKCQiH` +
-	`AIBQAIBhAgAIiQ4JCIiAUFAAUGeIBwCPDgCQUACQZQIIiIiHgIiHBwCQUABwZQIIiIiIh4CHBwCQUABwaIiIiIiIj4ICBwBwUABQaIiIiI+CAgIAkFAAkGUAD4gIDwgID4cAcFAAcGUABwiPiAeIAF` +
-	`BQAFBniIeEiIgAUFAAUGeIiImGiACAUACAYIcIDwiIiIcPgFBQAFBvCI8IjwiAUEAQUG8ICAgICIBwYABQY4SEhI/ISEcAUFAAUGcIj4gHiEBQUABQaoqHCoqIQFBQAFBnCIMIhwhAUFAAUGiJioyI` +
-	`iEBwUABwZQIIiYqMiIcAUFAAUGiJDgkIjIBQUABQY4SEhIiMgFBQAFBojYqIiIyAUFAAUGiIj4iIjIBQUABQZwiIiIcMgFBQAFBviIiIiIyAcFAAUG8IiIiPCAgHAFBQAFBnCIgIhwgAUFAAUG+CAg` +
-	`ICCABwUABQaIiIiIeAhwcAkFAAcGICBwqKiocCAgcAUFAAUGiFAgUIioBwYABQaIiIiI/AQEIAUFAAUGiIh4CAgEBQUABQaoqKio+AQHBgAFBqioqKj8BAQgBQUABQbAQHBIcAQFBQAFBoiI6JjoBA` +
-	`UFAAUGgIDwiPAEBQUABQbwCHgI8AQFBQAFBpCo6KiQBAcGAAcGoKDg6PSovCAJBAEJBhAQ8ICAgICAgHAHBAEHBhAQ8ICAgICABQUABgYQ+CD4QIAHBAEHBkAgMFCQkGCABwUABwb4SCAQIEj4gAsF` +
-	`AAkGGCAgICAgICAgIMAABgUABgYYECCgQEAgBQUABgYIcIhwgEAHBQAHBnCIiIiIUNggCAQBCAbwkJCQkJCQ8AAAAAAAAPAAAAAABvAAAAAABvAIAQIIBoCAgICAgACAAAMDAQgGoKCggAgGAAgGKC` +
-	`j8KCj8UFAACgUACQYgcKigYDAoqHAgBggGAAgGRKSoUChUlIhwCAYACAZgkIBAoJSIdHADAQMIBoCAgEAMBAEJBhAgQECAgICAQEAgEP8MBAEJBoBAICAQEBAQICBAgP8GBQAIBiCocHCoIBAFBQAF` +
-	`BiAg+CAgIAQCAgIGwMBAgCABBQADBvjAAgICAgbAwEAJBQAIBggIEBAgQECAgCAIBQAIBnCImKjIiIhwgAgFAAgGIGCgICAgIPiACAUACAZwiAgQIECA+IAIBQAIBnCICDAICIhwgAgFAAgGEDBQkP` +
-	`gQEBCACAUACAb4gIDwiAgI8IAIBQAIBjBAgPCIiIhwgAgFAAgG+IgIEBAgICCACAUACAZwiIhwiIiIcIAIBQAIBnCIiIh4CBBggAYCAgYGwMAAAMDAEAgCAgYGwMAAAMDAQICABQYABQYMMMAwDMAD` +
-	`BQAEBvgA+DAFBgAFBsAwDDDAwAgFAAgGcIgIECAgACCACAUACAYwSJioqJhAOIAIBQAIBiAgIFBQ+IiIgAgFAAgG8IiI8IiIiPCACAUACAZwiICAgICIcIAIBQAIBuCQiIiIiJDggAgFAAgG+ICA8I` +
-	`CAgPiACAUACAb4gIDwgICAgIAIBQAIBnCIgICYiIhwgAgFAAgGiIiI+IiIiIiACAMBCAbgQEBAQEBA4IAIBQAIBngICAgIiIhwgAgFAAgGiJCgwMCgkIiACAUACAaAgICAgICA+IAIBQAIBojY2KiI` +
-	`iIiIgAgFAAgGiMjIqKiYmIiACAUACAZwiIiIiIiIcIAIBQAIBvCIiIjwgICAgAoGAAgGcIiIiIiIiHAQDEAIBQAIBvCIiIjwoJCIEAgFAAgGcIiAcAgIiHAQCAUACAb4ICAgICAgIBAIBQAIBoiIiI` +
-	`iIiIhwEAgFAAgGiIiIiFBQICAQCAUACAaIiKioqFBQUBAIBQAIBoiIUCAgUIiIEAgFAAgGiIhQUCAgICAQCAUACAb4CBAgIECA+BALAwIIBuCAgICAgICAgIDggAgEAQgGgIBAQCAgEBCACwMBCAbg` +
-	`ICAgICAgICAg4IAFBQAIBiAgUFCIIAEFAAAG+CACAgIHBoBAUAYFAAYGeIiIiJhoIAgFAAgGgICwyIiIiPAgBgUABgZwiICAiHCICAUACAYICHiIiIiYaCAGBQAGBnCI+ICIcJgIBAAIBjBA8EBAQE` +
-	`BAIAgFAAYGeIiIiJhoCHAgCAUACAaAgLDIiIiIiCAIAwEIBkAAwEBAQEBgIAoEAAgGEABwEBAQEBAQ4OAIBQAIBoCAkKDAoJCIEAgCAggGgICAgICAgMAQBgUABgbQqKioqKiABgUABgawyIiIiIiA` +
-	`BgUABgZwiIiIiHCACAUABgawyIiIiPCAgBAIBQAGBniIiIiYaAgIEAYFAAYG2GBAQEDgCAYFAAYGcIhgEIhwCAgFAAgGQEDwQEBASDAQBgUABgaIiIiImGhIBgUABgaIiFBQICBIBgUABgaIiKioUF` +
-	`BIBgUABgaIUCAgUIhICAUABgaIiFBQICBAgBAGBQAGBvgQIECA+EALBQAIBhggICAgwCAgICAYgAoBAgkGgICAgICAgICAgBgLBQAIBsAgICAgGCAgICDAgAIGAAUGZJggCgUACgZQACAgIFBQ+IiI` +
-	`wAoFAAoGIFAgICBQUPiIiMALBQAIBnCIgICAgIhwIBAggAoFAAoGECD4gIDwgICA+CAKBQAKBihQiMjIqKiYmIggCgUACgZQAHCIiIiIiIhwIAoFAAoGUACIiIiIiIiIcCAJBQAJBhAgAHiIiIiYaH` +
-	`AJBQAJBiAQAHiIiIiYaHAJBQAJBiBQAHiIiIiYaHAIBQAIBlAAeIiIiJhoaAkFAAkGKFAAeIiIiJhocAoFAAoGIFAgAHiIiIiYaCAJBQAGBnCIgICIcCAQIGgJBQAJBhAgAHCI+ICIcGgJBQAJBkAg` +
-	`AHCI+ICIcGgJBQAJBiBQAHCI+ICIcGgIBQAIBlAAcIj4gIhwcAoEAQoGECBAAMBAQEBAYCAKBAAKBoBAIABgICAgIDAgCQMBCQZAoADAQEBAQGAwCAMBCAagAMBAQEBAYGAJBQAJBihQALDIiIiIiD` +
-	`AJBQAJBhAgAHCIiIiIcDAJBQAJBkAgAHCIiIiIcDAJBQAJBiBQAHCIiIiIcDAIBQAIBlAAcIiIiIhwcAkFAAkGKFAAcIiIiIhwMAkFAAkGECAAiIiIiJhoMAkFAAkGQCAAiIiIiJhoMAkFAAkGIFAA` +
-	`iIiIiJhoMAgFAAgGUACIiIiImGhoCgUACAYgIPggICAgICAgIAMDAQgGQKBAIAoFAAkGICBwqKCgqHAgICAIBQAIBjBAQPBAQIj4IAoFAAkGeICAcIiIcAgI8CAEBAEGBmDw8GCICQYABwZ86OhoKC` +
-	`goKCjwCAYACAZwiJCgmISEuCgIBgAIBjBItLSktEgwKAgGAAgGMEi0pKS0SDAoBAYACAb0VFxUpAICAggGQIBcAQMBBwaggAgFAAgGeGBgeOCgoLgoCgYACQYEeJiYqKjIyPCAIAMFAAQG2KjYmAcF` +
-	`AAcGICD4ICAA+MgHBQAHBggwwDAIAPjIBwUABwaAYBhggAD4yAgFAAgGiIhQIPgg+CDwCAUABgaIiIiI2KiAgPAKBgAIBvxISEhISEhISEggBgUABgb4UFBQUFBIBgMBCAZgoKBgAOBIBgMBCAZAoK` +
-	`BAAOBIBgUABgZwqLigoHhICAYABwYEeJioqMjwgEgIBQAGBiAAICBAgIhwSAgBAwYGgACAgICAgIBIAwUAAwb4CAiACwUACAYYIHggICAgICCgQIAIBgAIBiAgUFBIiIT8IAUFAAUGKFCgUCiIBQUA` +
-	`BQagUChQoIgBBQABBqhQAAAAAAaoCgUACgZAIAAgIFBQ+IiIQAoFAAoGKFAAICBQUPiIiEAKBQAKBlCgcIiIiIiIiHBACAUACAZ4oKC4oKCgeIgGBQAGBnCouKCocKABAwADBuCoAQUAAwb4qAQFAA` +
-	`gGSJDY2KgEBQAIBtjYSJCoBAICCAZAgMDAqAQCAggGwMBAgKgFBQAFBiAA+AAgcAkFAAgGICBQUIhQUCAgcAoFAAgGUACIiFBQICBAgEAKBQAKBlAAiIhQUCAgICBACgYACQYEBAgIECBAQICAQAcF` +
-	`AAcGOEDwQPBAOEAFAwIFBiBAgEAgQAUDAQUGgEAgQIBACAUACAY4QED4SEhISIAIBQAIBjhISOhISEhIgAoFAAgGICD4ICAgIPggIEACAgIEBsDA+AQCAgIGwMBAgCAEBQACBtjYSJAgBwYABwbgqP` +
-	`AgfNR8+AoFAAoGIFAAICBQUPiIiEAKBQAKBiBQ+ICA8ICAgPhACgUACgYQIAAgIFBQ+IiIQAoFAAoGUAD4gIDwgICA+EAKBQAKBkAg+ICA8ICAgPhACgMBCgYgQOBAQEBAQEDgQAoDAQoGQKDgQEBA` +
-	`QEBA4EAKAwEKBqAA4EBAQEBAQOBACgMBCgaAQOBAQEBAQEDgQAoFAAoGECBwiIiIiIiIcEAKBQAKBiBQcIiIiIiIiHBACAUACAYQIFD48PD4cIgKBQAKBkAgcIiIiIiIiHBACgUACgYQIIiIiIiIiI` +
-	`hwQAoFAAoGIFCIiIiIiIiIcEAKBQAKBkAgiIiIiIiIiHBABgMBBgbAQEBAQGCIAgMBCAZAoEACBAEIBlCgQAEDAQcG4KACAwEIBqBAQAICAggGwMBAAwMBCQZAoEBAAwIBAAaAQIBAAwMCCQagoKBA` +
-	`AwIBAAZAgEBAAgMACAagQEAIBQAIBnCIiIj4iIiIiAgFAAgG+ICA8IiIiPCICAUACAbwiIjwiIiI8IgIBQAIBviAgICAgICAiAoGAAgGOEhISEhISPyEhEAIBQAIBviAgPCAgID4hAgFAAgGqKiocK` +
-	`ioqKiECAUACAZwiAgwCAiIcIQIBQAIBoiIiJioyIiIhAoFAAoGUCCIiIiYqMiIiEAIBQAIBoiQoMDAoJCIiAgFAAgGOEhISEhISIiICAUACAaI2KiIiIiIiIgIBQAIBoiIiPiIiIiIiAgFAAgGcIiI` +
-	`iIiIiHCICAUACAb4iIiIiIiIiIgIBQAIBvCIiIjwgICAiAgFAAgGcIiAgICAiHCICAUACAb4ICAgICAgIIgIBQAIBoiIiHgICIhwiAgFAAgGIHCoqKiocCCICAUACAaIiFAgIFCIiIgKBgAIBoiIiI` +
-	`iIiIj8BARACAUACAaIiIiIeAgICAQIBQAIBqioqKioqKj4BAoGAAgGqKioqKioqPwEBEAIBQAIBsBAQHBISEhwBAgFAAgGiIiIyKioqMgECAUACAaAgIDwiIiI8AQIBQAIBnCICDgICIhwBAgFAAgG` +
-	`kKio6KioqJAECAUACAZ4iIiIeChIiAQIAQIIBoCAgICAgICABAoFAAgG+EBAcEhISEgIMEAKBQAIBkBA+EBwSEhICDBACgUACgYQIPiAgICAgICAQAkEAQkGIEAA8ICAgICAgAgBAggGgACAgICAgI` +
-	`CACAUACAYICAgICAiIcIAIBQAIBnCIgPCAgIhwgAYFAAYGcIjggIhwiAoDAQoGoABAQEBAQEBAQEAIAwEIBqAAQEBAQEBAQAgGAAgGMFBQUFhUVJhABgYABgYwUFhUVJhUCAUACAagoKDwqKiosEAG` +
-	`BQAGBqCg8KiosKgKBAAIBhAAEBAQEBAQkGBACAUACAZwiIBwCAiIcJAIBQAIBvhAQHBISEhIkAgFAAgGQEDwQHBISEiQCgUACgYQIIiQoMDAoJCIQAkFAAkGECAAiJCg4JCIiAYFAAYGcIBwCIhw4A` +
-	`oFAAoGUCCIiIh4CAiIcEALBQAJBlAgAIiIiIiIeAjwgAoFAAgGiIiIiIiIiPggIPAIBQAGBoiIiIiI+CAgIAoFAAoGUAD4gIDwgICA+PAIBQAIBlAAcIj4gIhwgAYFAAYGeIiIeEiIiAYFAAYGeIiI` +
-	`iJhoiAkFAAkGCHCA8IiIiIhw+AYFAAYG8IjwiIjwiAYEAQYG8ICAgICAiAgGAAYGOEhISEj8hIRwBgUABgZwiPiAiHCEBgUABgaoqHCoqKiEBgUABgZwiDAIiHCEBgUABgaIiJioyIiECQUACQZQIA` +
-	`CIiJioyIj4BgUABgaIkKDgkIioBgUABgY4SEhISIioBgUABgaI2KiIiIioBgUABgaIiPiIiIioBgUABgZwiIiIiHCoBgUABgb4iIiIiIioCAUABgbwiIiIiPCAgIgGBQAGBnCIgICIcIAGBQAGBvgg` +
-	`ICAgIIAIBQAGBoiIiIiIeAjwiAoFAAgGICBwqKioqHAgIPAGBQAGBohQICBQiKgIBgAGBoiIiIiI/AQEIAYFAAYGiIiIeAgIBAYFAAYGqKioqKj4BAgGAAYGqKioqKj8BAQgBgUABgbAQHBISHAEBg` +
-	`UABgaIiMioqMgEBgUABgaAgPCIiPAEBgUABgZwiDgIiHAEBgUABgaQqOioqJAECAYACAagoKDg6PSovCAKBQAKBggI+ICAgICAgIDwCAQBCAYQEPCAgICAgIAFBQAFBgj4IPiAgAgFAAgGYBA4SIiI` +
-	`kGCACgUACAb4gEBAICBAQID48AsFAAkGECggICAgICAgoECACgYACAYMCAgIENBQUCAgQAQFAAUGYJhgmBAIBQAIBnDYiIiIiFDYIAsFAAkGUCAAiIiIiIh4CPCACAUABgaIiIiIiPggIHgFBgAFBh` +
-	`AI/AgQ+AYFAQYGICAgqHAgIAkGAAgGoKDgoKAcCAgICAkGAAgGgICAgNwQHBAQCAkGAAgGoKBAQAAcCAgICAgFAQgG4IDAgLggMCAICAYACAZggIBgGBQYFAgJBgAHBsiomIgAICAgPAgBBgAEBvyo` +
-	`CQEDCAaAgICAgICAgIAIBQQCBAbwgICAgIAFBAAEBvAQEBAQgAUEAggGgICAgPCABQMACAYgICAg4IAJBAIIBoCAgIDwgICAgAgJBAAIBhAQEBDwEBAQEAgFBgAEBvwQEBAQEAUGAAgGEBAQEPwQCQ` +
-	`YACAYQEBAQ/BAQEBAICwYACAb8/Pz8/Pz8/Pz8/IAHB/8HBhA4fP58OBD8CAUABwVQqFCoUKhQqPwJBQEJB/iIiIiIiIiI+OQAAAAAAPgAAAAAB/gAAAAAB/gJAQMJB4CAgICAgACAgOQDAwIJB6Cg` +
-	`oIAHBwAKBxQUfij8UFCACwUBCgcgcKioYDAoqKhwIOYJBwAJB0SkqEgQJCpKRHAJBgAJB2CQkKBAoJSIdHADAQMJB4CAgKALAwIKByBAQICAgICAQEAg5gsDAgoHgEBAICAgICBAQIDmBgUBCQcgqH` +
-	`BwqCAgBQUBBwcgIPggICAEAgICB8DAQIAgAQUBBQf4wAICAgIHwMBADAYACgcEBAgIEBAgIEBAgIABCQUBCQdwiIiYqMiIiHBACQMCCQdAwEBAQEBAQOBACQUBCQdwiAgIECBAgPhACQUBCQdwiAgw` +
-	`CAgIiHBACQYBCQcQMFCQ/BAQEBBACQUBCQf4gIDwCAgIiHBACQUBCQcwQIDwiIiIiHBACQUBCQf4CAgQICAgICBACQUBCQdwiIhwiIiIiHBACQUBCQdwiIiIiHgIEGBABgICBgfAwAAAwMAICAICBg` +
-	`fAwAAAwMBAgGAHBAEIBxAgQIBAIBCAAwUBBgf4APiABwQCCAeAQCAQIECAgAkFAQkHcIgIECAgACAgQAgGAAgHeIS01NS4gHggCQUBCQdwiIiI+IiIiIhACQUBCQfwiIjwiIiIiPBACQUBCQdwiIiA` +
-	`gICAiHBACQUBCQfwiIiIiIiIiPBACQUBCQf4gICA8ICAgPhACQUBCQf4gICA8ICAgIBACQUBCQdwiICAmIiIiHBACQUBCQeIiIiI+IiIiIhACQEDCQeAgICAgICAgIBACQUBCQcICAgICAiIiHBACQ` +
-	`UBCQeIkKDAgMCgkIhACQUBCQeAgICAgICAgPhACQUBCQeI2KiIiIiIiIhACQUBCQeIiMiomIiIiIhACQUBCQdwiIiIiIiIiHBACQUBCQfwiIiI8ICAgIBACwUBCQdwiIiIiIiIiHAgEIAJBQEJB/CI` +
-	`iIjwiIiIiCAJBQEJB3CIgIBwCAiIcCAJBQEJB/ggICAgICAgICAJBQEJB4iIiIiIiIiIcCAJBwAJB4KCREREKCgQECAJBQEJB4iIiIiIqNiIiCAJBQEJB4iIiFAgUIiIiCAJBQEJB4iIiIhQICAgIC` +
-	`AJBQEJB/gICBAgQICA+CALAwIKB+CAgICAgICAgIDggAwGAQoHgIBAQCAgEBAICAQEAQsDAgoH4CAgICAgICAgIOAEAwUBCQcgUIggAQcAAQf+UAMDAgoHgEAgIAYFAQYHeIiIiJhoIAkFAQkHgICA` +
-	`8IiIiIjwIAYFAQYHcIiAgIhwiAkFAQkHCAgIeIiIiIh4IAYFAQYHcIj4gIhwiAkEAgkHMEBA4EBAQEBAIAkFAQYHeIiIiIh4CIhwIAkFAQkHgICA8IiIiIiIIAkBAwkHgIAAgICAgICAIAwDAQkHIC` +
-	`AAICAgICAgICDAAQkFAQkHgICAkKDAoJCIIAkCAwkHgICAgICAgIDAIAYFAQYH+KioqKiogAYFAQYH8IiIiIiIgAYFAQYHcIiIiIhwgAgFAQYH8IiIiIjwgIDACAUBBgd4iIiIiHgICMAGBQEGB7DI` +
-	`iICAgAgGBQEGB3CIcAiIcAgIBAEIB0BA4EBAQEAwwAYFAQYHiIiIiJhoQAYFAQYHiIhQUCAgQAYFAQYHqKioqKhQQAYFAQYHiFAgIFCIQAkFAQYHiIiIiIh4CIhwIAYFAQYH+BAgQID4CAsDAgoHIE` +
-	`BAQECAQEBAQCDADwEDDAeAgICAgICAgICAgICAgIB0CwMCCgeAQEBAQCBAQEBAgMACBQEJB2iwQAsFAQsHUABwiIiIiPiIiIjADAUBDAcgUCAAcIiIiPiIiIgADAUBCQdwiIiAgICAiHAgIEAACwUB` +
-	`CwcQIPiAgIDwgICA+EAMBQEMB2iwAIiIyKiYiIiIiAALBQELB1AAcIiIiIiIiIhwiAsFAQsHUACIiIiIiIiIiHCICQUBCQcQIAB4iIiImGiICQUBCQcgEAB4iIiImGiICQUBCQcgUAB4iIiImGiICA` +
-	`UBCAdQAHiIiIiYaGgJBQEJB2iwAHiIiIiYaIgKBQEKByBQIAB4iIiImGhwCQUBBgdwiICAiHAgIEBoCQUBCQcQIABwiPiAiHBoCQUBCQdAIABwiPiAiHBoCQUBCQcgUABwiPiAiHBoCAUBCAdQAHCI` +
-	`+ICIcHAJAgMJB0CAAICAgICAgGgJAgIJB4BAAEBAQEBAQGgJAwIJB0CgAEBAQEBAQGgIAwIIB6AAQEBAQEBAQAkFAQkHaLAAsMiIiIiIaAkFAQkHECAAcIiIiIhwaAkFAQkHQCAAcIiIiIhwaAkFAQ` +
-	`kHIFAAcIiIiIhwaAgFAQgHUABwiIiIiHBwCQUBCQdosABwiIiIiHBoCQUBCQcQIACIiIiImGhoCQUBCQdAIACIiIiImGhoCQUBCQcgUACIiIiImGhoCAUBCAdQAIiIiIiYaGgHBQEJByAg+CAgICBo` +
-	`BAQBCQdgkJBgIAgFAQcHIHCooKCocCBoCQUBCQcwQEBA4EBISLBoCgUBCgdwiEigkEgokIhwcAUFAQYHcPj4+HBICQYBCQd86Ojo6HgoKCxwCwUBCQdwiIiQkIiIiLCAgIgKBwAKBzhEsqqqsqqqRD` +
-	`iACgcACgc4RJKqoqKqkkQ4gAQGAAkH9FxcVKIDAwMKByBAgFQCBQEKB9jYgAkGAAkHfJCQkPyQkJCcOAkHAAkHOEZETFRkRMQ4OAQHAAUHbJKSbFQHBQEHByAg+CAgAPjECQUBCQcQIECAQCAQAPg4` +
-	`CQUBCQdAIBAIECBAAPg4CgUBCgeIiFBQIPgg+CAggAkFAQYHiIiIiJjogICAIAkHAAkH/kREREREREREIAYFAQYH+FBQUFBQRAcFAQkHeIiImGgA+EQHBQEJB3CIiIhwAPhEBgcABgd8kp6Qslz4Bw` +
-	`cABwcCPExUZES4RAkFAQkHICAAICBAgIhwIAkBAwkHgIAAgICAgICAIAMFAQUH+AgIgA0FAQsHGCAgICBwICAgICAgwAIIBwAIBxAQKChERIL+IAUGAQUHJEiQSCREBQYABQeQSCRIkEQBBQEBB6hI` +
-	`AAAAAAeoCwUBCwdAIHCIiIiI+IiIiCAMBQEMB2iwAHCIiIiI+IiIiMAMBQEMB2iwAHCIiIiIiIiIcMAJBgAJB3yQkJCckJCQfIgGBwAGB2ySnpCSbJABAwIEB+CSAQUBBAf4kgMFAQkHSJDYkAMFAQ` +
-	`kH2EiQkAMCAwkHQIDAkAMCAwkHwECAkAcGAQgHMDAA/AAwMJAHBQEHByAgUIhQICCQCwUBCAdQAIiIiIiIeAiIcHAMBQEMB1AAAIiIiIhQICAgIMAKBQEJBwgIEBAgIEBAgIAgCQcACQc8QkD8QPxA` +
-	`QjyABQMCBQcgQIBAIPwFAwIFB4BAIECA/AkFAQkHMEBA+EhISEhIgAkHAAkHPERE9ERERERGgAsFAQkHICD4ICAgICD4ICAgAgICBQfAwPgEAgICB8DAQIAgBAUBAgfY2EiQIAkHAAkHQKSoUCBUqi` +
-	`oUIAwFAQwHIFAAcIiIiPiIiIiIwAwFAQwHIFAA+ICAgPCAgID4wAwFAQwHECAAcIiIiPiIiIiIwAwFAQwHUAAA+ICAgPCAgID4wAwFAQwHQCAA+ICAgPCAgID4wAwCAgwHQIAAQEBAQEBAQEBAwAwF` +
-	`AQwHIFCIICAgICAgICAgwAwDAgwHoAAAQEBAQEBAQEBAwAwCAgwHgEAAQEBAQEBAQEBAwAwFAQwHECAAcIiIiIiIiIhwwAwFAQwHIFAAcIiIiIiIiIhwwAkGAAkHCBAQbPj4+PxoiAwFAQwHQCAAcI` +
-	`iIiIiIiIhwwAwFAQwHECAAiIiIiIiIiIhwwAwFAQwHIFAAiIiIiIiIiIhwwAwFAQwHQCAAiIiIiIiIiIhwwAYBAwYHgICAgICAiAMFAQoHIFCIgAIFAQoHaLCIAQUBCQf4sAIFAQoHiHCIAgICCgfA` +
-	`wIgDBAEKB2CQYIADAgIAB4BAgIADBAILB1BQoIADAgIAB0CAQIADBQELB4hQIIAJBQEJB4CAgJCgwICA+IgJAwIJB0BAQGBAwEBAQIgMBQEMB1AgAHCIgIBwCAiIcMAJBQEJB1AgAHCIcAiIcAgMBQ` +
-	`EMB1AgAPgICBAgQICA+MAJBQEJB1AgAPgQIECA+IAMBQEMBxAgAIiIiIhQICAgIMAMBQEJBxAgAIiIiIiIeAiIcMAMBQEMBxAgAHCIiICAgICIcMAJBQEJBxAgAHCIgICIcIAMBQEMB1AgAHCIiICA` +
-	`gICIcMAJBQEJB1AgAHCIgICIcIALBQELB/gAcIiIiPiIiIiIcAgFAQgH+AB4iIiImGiICwYBCQdwiIiI+IiIiIgEBHAIBgEGB3iIiIiYaAQEiAwFAQwHUCAA8IiIiIiIiIjwwAsHAQsHAgIKDAh4iI` +
-	`iIiHjwCwUBCwf4APiAgIDwgICA+PAIBQEIB/gAcIj4gIhwgAwFAQwHICAA+ICAgPCAgID4wAkFAQkHICAAcIj4gIhwgAsGAQkH+ICAgPCAgID4BAT4CAUBBgdwiPiAiHAICPgMBQEMB1AgAPiAgIDw` +
-	`gICA+MAJBQEJB1AgAHCI+ICIcIALAwILB+AAQEBAQEBAQEBA+AgDAggH4ABAQEBAQEBACwICCQdAQEBAQEBAQECAQPgLAgIJB0BAAEBAQEBAQIBA+AwFAQwHIEAAgICAgICAgID4wAwCAwwHQIAAgI` +
-	`CAgICAgICAwAsFAQsHEBCQoICAgICAgPiACwMDCwcgIKDAgICAgICAgIAMBQEMBxAgAIiIyKiYiIiIiMAJBQEJBxAgAPCIiIiIiIgMBQEMB1AgAIiIyKiYiIiIiMAJBQEJB1AgAPCIiIiIiIgIBQEG` +
-	`B/CIiIiIqCBAiAsFAQsH+ABwiIiIiIiIiHCICAUBCAf4AHCIiIiIcIgMBQEMByhQAHCIiIiIiIiIcMAJBQEJByhQAHCIiIiIcIgMBQEMBxAgAPCIiIjwiIiIiMAJBQEJBxAgALDIiICAgIgMBQEMB1` +
-	`AgAPCIiIjwiIiIiMAJBQEJB1AgALDIiICAgIgMBQEMBxAgAHCIgIBwCAiIcMAJBQEJBxAgAHCIcAiIcAgMBQEMB1AgAPggICAgICAgIMALBQELBwgICFBA4EBAQEAwIAsFAQsH+ACIiIiIiIiIiHAg` +
-	`CAUBCAf4AIiIiIiYaIgMBQEMByBQIIiIiIiIiIiIcMAJBQEJByBQIIiIiIiYaIgMBQEMByhQAIiIiIiIiIiIcMAJBQEJByhQAIiIiIiYaIgLBQEJB4iIiIiIiIiIcEAgcAgFAQYHiIiIiLhoIBBwDA` +
-	`UBDAcQIAD4CAgQIECAgPjACQUBCQcQIAD4ECBAgPiACwUBCwcgAPgICBAgQICA+PgIBQEIByAA+BAgQID4gAsFAQkHcIiAgJiIiKhwIED4DAUBCQcQIAB4iIiIiHgIiHDACwUBCQeAgICAgICAgPgg` +
-	`QHALAgMJB4CAgICAgICAAECAcAsFAQkHiJCgwIDAoNDIQIBwCwUBCQeAgICQoMCg0MhAgHALBQEJB4iIyKiYiIioqCBAcAsFAQkH8IiIiPCIiKioIEBwCAUBBgewyIiAoKAgQKgJBQEJB3CIiIj4iI` +
-	`iIiCAJBQEJB/iAgPCIiIiI8CAJBQEJB/CIiPCIiIiI8CAJBQEJB/iAgICAgICAgCAMBwAJBxwkJCQkJCRE/oKCgsAJBQEJB/iAgIDwgICA+IIJBQEJB6ioqKhwqKioqIIJBQEJB3CICAgwCAiIcIIJ` +
-	`BQEJB4iYmKioqMjIiIIMBQEMB1AgAIiYmKioqMjIiMAJBQEJB4iQoMCAwKCQiMgJBgAJBxwkJCQkJCQkxMgJBQEJB4jYqIiIiIiIiMgJBQEJB4iIiIj4iIiIiMgJBQEJB3CIiIiIiIiIcMgJBQEJB/` +
-	`iIiIiIiIiIiMgJBQEJB/CIiIjwgICAgMgJBQEJB3CIgICAgICIcMgJBQEJB/ggICAgICAgIMgJBQEJB4iIiIh4CAiIcMgJBQEJByBwqKioqKhwIMgJBQEJB4iIiFAgUIiIiMgMBgEJB4iIiIiIiIiI` +
-	`/AQEBMAJBQEJB4iIiIh4CAgICAQJBQEJB6ioqKioqKio+AQMBgEJB6ioqKioqKio/AQEBMAJBgAJB+AgICA4JCQkOAQJBQEJB4iIiIjIqKioyAQJBQEJB4CAgIDwiIiI8AQJBQEJB3CICAh4CAiIcA` +
-	`QJBgAJB5ikpKTkpKSkmAQJBQEJB3iIiIh4GChIiAQJAQMJB4CAgICAgICAgAQLBgAJB/hAQFhkRERERAQYBAsGAAkHQED8QFhkREREBBgEDAUBDAcQIAD4gICAgICAgIDACQUBCQcQIAD4gICAgICA` +
-	`CQEDCQeAgACAgICAgICACQUBCQcICAgICAiIiHCACQUBCQdwiICA8ICAiHCABgUBBgdwiOCAiHCADAMCDAegoABAQEBAQEBAQEDACQMCCQegoABAQEBAQEBACQcACQcwUFBQXFJSUpxABgcABgcwUF` +
-	`xSUpxSCQcACQeQkJCQ/JKSkpxABgcABgeQkPySkpySDAMBCQcgIAAgICAgICAgIMDACQUBCQdwiICAcAgIiHAgCQYACQf4QEBYZEREREQgCQYACQdAQPxAWGREREQgDAUBDAcQIACIkKDAgMCgkIjA` +
-	`CQUBCQcQIACIkKDgkIigBgUBBgdwiHAIiHDgDAUBDAdQIACIiIiIeAgIiHDADAUBCQdQIACIiIiIiHgIiHDADAUBCQeIiIiIiIiIiPggICDACAUBBgeIiIiIiPggIPgMBQEMB1BQAPiAgIDwgICA+M` +
-	`AJBQEJB1BQAHCI+ICAeIAGBQEGB3iIiHhIiIAGBQEGB3iIiIiYaIAJBQEJBwhwgPCIiIiIcIAGBQEGB/CI8IiI8IgGBQEGB/iAgICAgIgIBwAGBxwkJCRE/oKCcAYFAQYHcIj4gIhwggYFAQYHqKhw` +
-	`qKioggYFAQYHcIgwCIhwggYFAQYHiJioyIiIggkFAQkHUCAAiJioyIiIgAYFAQYHiJCg4JCIyAYGAAYHHCQkJCTEyAYFAQYHiNioiIiIyAYFAQYHiIj4iIiIyAYFAQYHcIiIiIhwyAYFAQYH+IiIiI` +
-	`iIyAgFAQYH8IiIiIjwgICIBgUBBgdwiICAiHCABgUBBgf4ICAgICCACQUBBgeIiIiIiHgIiHCADAUBCQcgICBwqKioqHAgICDABgUBBgeIUCAgUIioCAYBBgeIiIiIiPwEBHAGBQEGB4iIiHgICAQG` +
-	`BQEGB6ioqKio+AQIBgEGB6ioqKio/AQEcAYGAAYH4CA4JCQ4BAYFAQYHiIjIqKjIBAYFAQYHgIDwiIjwBAYFAQYHcIg4CIhwBAYFAQYHkKjoqKiQBAkHAAkHkJDQsJSalJCeIAsFAQsHCAj4gICAgI` +
-	`CAgIAgCAUBCAcICPiAgICAgIAFBQEGBxD4IPhAgAgFAQgHYBA4SIiIkGCACQUBCQf4iEAgECBAiPiADQUBCwcYICAgICAgICAgICDAAgwHAAoHBgQEBAQIyEgoKBAQwAQHAAUHYpxinAQJBQEJB3CI` +
-	`iIiIiFBQ2CgLBQELCPiIiIiIiIiIiIj4/wAAAAAA+AAAAAAI+AAAAAAI+AsBAwsIgICAgICAgICAAID/BAMDCwigoKCggAsIAAsIEhIS/yQkJP9ISEj/DwUBDQggIHCoqKBgMCgoqKhwICAJCwgACw` +
-	`hhkpKUaAgWKSlJhv8LCAALCDBISEhQIlKKhIZ5/wMBBAsIgICASA4FAQwICBAgQECAgICAQEAgEAjADgUCDAiAQCAQEAgICAgQECBAgMAGBQELCCCocHCoIAgHBwAJCBAQEP4QEBAIBQIEAgjAwEBA` +
-	`gBABBwEGCP7AAgIDAgjAwEALBgELCAQECAgQICBAQICAIAsHAAsIOESChoqSosKCRDggCwcBCwgwUJAQEBAQEBAQ/iALBgELCHiEBAQIECBAgID8IAsGAQsI8AgICBBwCAQECPAgCwgACwgEDBQkJE` +
-	`SE/wQEBCALBgELCPyAgIDwCAQEBAjwIAsHAAsIPECAgLzCgoKCRDggCwYBCwj8BAgQECAgQEBAQCALBgELCHiEhIRIMEiEhIR4IAsHAAsIOESCgoKGegIEBHggCAIDCAjAwAAAAADAwAQLAgMICMDA` +
-	`AAAAAMDAQECAIAcIAAkIAwwwwDAMA8ADBgEHCPwA/MAHCAAJCMAwDAMMMMDADAYBCwhwiIQECBAgICAAICBACwgACwg8QoGdpaWlnoBAPCALCAALCBgYGCQkJEJCfoGBIAsGAQsI+ISEhIT4hISEhP` +
-	`ggCwYBCwg8QICAgICAgIBAPCALBgELCPCIhISEhISEhIjwIAsGAQsI/ICAgID8gICAgPwgCwYBCwj8gICAgPyAgICAgCALBgELCDxAgICAgIyEhEQ8IAsGAQsIhISEhIT8hISEhIQgCwUBCwj4ICAg` +
-	`ICAgICAg+CALBQELCDgICAgICAgICIjwIAsHAQsIhIiQkKDAoJCIhIIgCwUCCwiAgICAgICAgICA+CALBwELCILGxsaqqqqSkoKCIAsGAQsIhMTEpKSklJSMjIQgCwYBCwgwSISEhISEhIRIMCALBg` +
-	`ELCPiEhISEiPCAgICAIA4HAQsIMEiEhISEhISESDAQEA7ACwUBCwjwiIiIkOCgkJCIiBALBgELCHiAgIBAMAgEBAT4EAsHAAsI/hAQEBAQEBAQEBAQCwYBCwiEhISEhISEhISEeBALBwELCIKCgkRE` +
-	`RCgoKBAQEAsHAAsIkpKSqqqqbEREREQQCwYBCwiESEgwMDAwSEiEhBALBwELCIKCRCgoEBAQEBAQEAsGAQsI/AQICBAQIEBAgPwQDgQCDAjwgICAgICAgICAgICA8MALBwELCICAQCAgEBAICAQCgA` +
-	`4EAgwI8BAQEBAQEBAQEBAQEPDABwcACwgQECgoRESCEAEHAAAI/hACAgILCIBAKAgGAQgIPESEhISEjHQQCwYBCwiAgIC4xISEhISI8BAIBgEICDxAgICAgEA8hAsGAQsIBAQEPESEhISEjHQQCAYB` +
-	`CAg4RIT8gIBAPIQLBwELCB4gICD8ICAgICAgEAsGAQgIPESEhISEjHQEiHAQCwYBCwiAgIC4xISEhISEhBALBQELCCAAAOAgICAgICA4EA4FAQsICAAAeAgICAgICAgIEODACwUBCwiAgICIkKDAwK` +
-	`CQiAgLBQELCOAgICAgICAgICA4CAgHAAgIttqSkpKSkpIgCAYBCAi4xISEhISEhCAIBgEICDBIhISEhEgwIAsGAQgIuMSEhISEiPCAgIAICwYBCAg8RISEhISMdAQEBAgIBQIICLjIiICAgICABAgG` +
-	`AQgIeISAYBgEhHgECgYBCgggICD8ICAgICAcBAgGAQgIhISEhISEjHQgCAcBCAiCgkRERCgoECAIBwAICJKSqqqqqkREIAgFAQgIiFBQICBQiIggCwcACAhCQiIkFBgICBAQ4AgIBgEICPwECBAgQI` +
-	`D8EA4GAgwIHCAgICAgwCAgICAgIBzACwEDCwiAgICAgICAgICAgCAOBgEMCOAQEBAQEAwQEBAQEBDgwAMGAQYIZLSYEA0IAA0IJAAYGBgkJCRCQn6BgeANBwENCBAoEBAQKChERET+goLgDgYBCwg8` +
-	`QICAgICAgIBAPBAIEMAOBgEOCBAgAPyAgICA/ICAgID8wA4GAQ4IaFgAhMTEpKSklJSMjITADQYBDQhIADBIhISEhISEhEgwhA0GAQ0ISACEhISEhISEhISEeIQLBgELCAgQADxEhISEhIx0hAsGAQ` +
-	`sIQCAAPESEhISEjHSECwYBCwgIFAA8RISEhISMdIQKBgEKCEgAPESEhISEjHR0CwYBCwhoWAA8RISEhISMdIQLBgELCDgoODxEhISEhIx0hAsGAQgIOESAgICARDggECCECwYBCwgIEAA4RIT8gIBA` +
-	`PIQLBgELCCAQADhEhPyAgEA8hAsGAQsIECgAOESE/ICAQDyECgYBCghIADhEhPyAgEA8PAsFAQsIECAA4CAgICAgIDiECwUBCwhAIADgICAgICAgOIQLBQELCBAoAOAgICAgICA4hAoFAQoISADgIC` +
-	`AgICAgODgLBgELCGhYALjEhISEhISEhAsGAQsIECAAMEiEhISESDCECwYBCwhAIAAwSISEhIRIMIQLBgELCBAoADBIhISEhEgwhAoGAQoISAAwSISEhIRIMDALBgELCGhYADBIhISEhEgwhAsGAQsI` +
-	`CBAAhISEhISEjHSECwYBCwhAIACEhISEhISMdIQLBgELCBAoAISEhISEhIx0hAoGAQoISACEhISEhISMdHQOBQELCCAgIPggICAgICAgICAgwAQEAgsIYJCQYCAMBgEMCBAQPFSQkJCQUDwQECALBg` +
-	`ELCDxAQEBA+EBAQED8EA0GAQsIeICAwLCIhEQ0DAQE+CAGBgEICHj8/Pz8eIQNBwAKCH709PR0NBQUFBQUFBQgCwYBCwhwyIiQoKCYjISEuBQLCAALCDxCeqmpsbGpakY8FAsIAAsIPEJeoaGhoaFe` +
-	`QjwUBQcACwjyXl5SUqECAgMLCECAXgEEAgoIkIALBwELCA4IGBgoLkh4SIiOFAsGAQsINEiMlJSkpKTESLAUBQgABghmmZmZZqQJBwAJCBAQEP4QEBAA/kgJBwAJCAIMMMAwDAIA/kgJBwEJCIBgGA` +
-	`YYYIAA/kgLBwELCIKCRCgoEHwQfBAQFAoGAQgIhISEhISEzLSAgBANBwAKCP5EREREREREREREREQgCAgACAj/IiIiIiIiIkQHBQELCHiIiJhoAPgiBwUBCwhwiIiIcAD4IggIAAgINkmJj4iIWC9E` +
-	`CAcBCAg6RIqSkqJEuEQMBgAJCBAQABAQECBAgIREOEQLAQQICIAAgICAgICAgICAOAMHAAQI/gICgA4HAQsIDhAgIPggICAgICAgIMDACggACggYGDgsLEZGRoP/IAcGAQcIbEjYkNhIbEYHBgEHCN` +
-	`hIbCRsSNhGAQUBAQioSAAAAAAIqA4IAA4IIBAAGBgYJCQkQkJ+gYHADggADgg0LAAYGBgkJCRCQn6BgcAOBgEOCGhYADBIhISEhISEhEgwwAsHAAsIPlCQkJCekJCQUD6ECAgACAh2iYmPiIiId5AB` +
-	`BwAGCP6JAQcABgj+iQUHAQsIIkSI7u6IBQcACwju7iJEiIgFAwILCCBAgODgiAUDAgsI4OAgQICIBQcACAgQAP4AEIgLBwALCBAQKEREgkREKBAQhA0HAAoIJABCQiIkFBQICBAQ4DANBwENCEgAgo` +
-	`JEKCgQEBAQEBAwCwcBCwgCAgQICBAgIECAgBAJCQAJCB8AIIBAAP8AQAD+AEAAIIAfAAgHBAIHCBAwYIBgMBAgBwQCBwiAwGAQYMCAIAsHAAsIHCAgIP4iIiIiIiIQCwcACwgeIiIi+iIiIiIiIhAO` +
-	`BQELCCAgIPggICAgICAg+CAgwAICAwUIwMAgBQMDAgjg4CBAgCAFBgECCGxsJEiQIAwIAAwIYJCSlGgQIHbJSUk2IA4IAA4ICBQAGBgYJCQkQkJ+gYHADgYBDggQKAD8gICAgPyAgICA/MAOCAAOCA` +
-	`gQABgYGCQkJEJCfoGBwA0GAQ0ISAD8gICAgPyAgICA/IEOBgEOCEAgAPyAgICA/ICAgID8wA4FAQ4IECAA+CAgICAgICAgIPjADgUBDggQKAD4ICAgICAgICAg+MANBQENCEgA+CAgICAgICAgIPj4` +
-	`DgUBDghAIAD4ICAgICAgICAg+MAOBgEOCBAgADBIhISEhISEhEgwwA4GAQ4IECgAMEiEhISEhISESDDACQcACQgIEGz+/Pz8/nyEDgYBDghAIAAwSISEhISEhIRIMMAOBgEOCBAgAISEhISEhISEhI` +
-	`R4wA4GAQ4IECgAhISEhISEhISEhHjADgYBDghAIACEhISEhISEhISEeMAIBQEICOAgICAgICA4hAIDAwsIQKAgAgQCCwjQsCABAwIKCOCwAgQCCwiQYCACAgMLCMDAIAMDAwsI4KDgIAMCAwAIgECA` +
-	`IAIEAwsIUKCAAwIDAAiAgEAgAgMDCwigQEAHBwAJCAIE/hD+QIA4CwcACwg4DAY+QoKCgoKEeIQOBgELCPyAQEAgIBAgICBAQID8wA0FAgsIGCggICAgICAgICCgQPwOCAALCAMCAgQEBMTIKCgoEB` +
-	`AQwAYIAAcIYZmGYZmGxAsHAAsIOETGgoKCgoLGRO4QAAAAAgAAAAAAAP+zAJsAAAAAAAAAAAAAAAAAAAAAAAAAAAaOAAAAAQACAAMABAAFAAYABwAIAAkACgALAAwADQAOAA8AEAARABIAEwAUABUA` +
-	`FgAXABgAGQAaABsAHAAdAB4AHwAgACEAIgAjACQAJQAmACcAKAApACoAKwAsAC0ALgAvADAAMQAyADMANAA1ADYANwA4ADkAOgA7ADwAPQA+AD8AQABBAEIAQwBEAEUARgBHAEgASQBKAEsATABNAE` +
-	`4ATwBQAFEAUgBTAFQAVQBWAFcAWABZAFoAWwBcAF0AXgBfAGAAYQBiAGMAZABlAGYAZwBoAGkAagBrAGwAbQBuAG8AcABxAHIAcwB0AHUAdgB3AHgAeQB6AHsAfAB9AH4AfwCAAIEAggCDAIQAhQCG` +
-	`AIcAiACJAIoAiwCMAI0AjgECAJAAkQCSAJMAlACVAJYAlwEDAQQAmgCbAQUAnQCeAJ8AoAChAKIAowCkAQYApgEHAKgAqQCqAKsArACtAK4ArwCwALEAsgCzALQAtQC2ALcAuAC5ALoAuwC8AQgAvg` +
-	`C/AMAAwQDCAMMAxADFAMYAxwDIAMkAygDLAMwAzQDOAM8A0ADRANIA0wDUANUA1gDXANgA2QDaANsA3ADdAN4A3wDgAOEA4gDjAOQA5QDmAOcA6ADpAOoA6wDsAO0A7gDvAPAA8QDyAPMA9AD1APYA` +
-	`9wD4APkA+gD7APwA/QD+AP8BAAEBAQkBCgELAQwBDQEOAQ8BEAERARIBEwEUARUBFgEXARgBGQEaARsBHAEdAR4BHwEgASEBIgEjASQBJQEmAScBKAEpASoBKwEsAS0BLgEvATABMQEyATMBNAE1AT` +
-	`YBNwE4ATkBOgE7ATwBPQE+AT8BQAFBAUIBQwFEAUUBRgFHAUgBSQFKAUsBTAFNAU4BTwFQAVEBUgFTAVQBVQFWAVcBWAFZAVoBWwFcAV0BXgFfAWABYQFiAWMBZAFlAWYBZwFoAWkBagFrAWwBbQFu` +
-	`AW8BcAFxAXIBcwF0AXUBdgF3AXgBeQF6AXsAvQF8AX0BfgF/AYABgQGCAYMBhAGFAYYBhwGIAYkBigGLAYwBjQGOAY8BkAGRAZIBkwGUAZUBlgGXAZgBmQGaAZsBnAGdAZ4BnwGgAaEBogGjAaQBpQ` +
-	`GmAacBqAGpAaoBqwGsAa0BrgGvAbABsQGyAbMBtAG1AbYBtwG4AbkBugG7AbwBvQG+Ab8BwAHBAcIBwwHEAcUBxgHHAcgByQHKAcsBzAHNAc4BzwHQAdEB0gHTAdQB1QHWAdcB2AHZAdoB2wHcAd0B` +
-	`3gHfAeAB4QHiAeMB5AHlAeYB5wHoAekAjwHqAJgB6wCZAewAnAHtAKUB7gCnAe8B8AHxAfIB8wH0AfUB9gH3AfgB+QH6AfsB/AH9Af4B/wIAAgECAgIDAgQCBQIGAgcCCAIJAgoCCwIMAg0CDgIPAh` +
-	`ACEQISAhMCFAIVAhYCFwIYAhkCGgIbAhwCHQIeAh8CIAIhAiICIwIkAiUCJgInAigCKQIqAisCLAItAi4CLwIwAjECMgIzAjQCNQI2AjcCOAI5AjoCOwI8Aj0CPgI/AkACQQJCAkMCRAJFAkYCRwJI` +
-	`AkkCSgJLAkwCTQJOAk8CUAJRAlICUwJUAlUCVgJXAlgCWQJaAlsCXAJdAl4CXwJgAmECYgJjAmQCZQJmAmcCaAJpAmoCawJsAm0CbgJvAnACcQJyAnMCdAJ1AnYCdwJ4AnkCegJ7AnwCfQJ+An8CgA` +
-	`KBAoICgwKEAoUChgKHAogCiQKKAosCjAKNAo4CjwKQApECkgKTApQClQKWApcCmAKZApoCmwKcAp0CngKfAqACoQKiAqMCpAKlAqYCpwKoAqkCqgKrAqwCrQKuAq8CsAKxArICswK0ArUCtgK3ArgC` +
-	`uQK6ArsCvAK9Ar4CvwLAAsECwgLDAsQCxQLGAscCyALJAsoCywLMAs0CzgLPAtAC0QLSAtMC1ALVAtYC1wLYAtkC2gLbAtwC3QLeAt8C4ALhAuIC4wLkAuUC5gLnAugC6QLqAusC7ALtAu4C7wLwAv` +
-	`EC8gLzAvQC9QL2AvcC+AL5AvoC+wL8Av0C/gL/AwADAQMCAwMDBAMFAwYDBwMIAwkDCgMLAwwDDQMOAw8DEAMRAxIDEwMUAxUDFgMXAxgDGQMaAxsDHAMdAx4DHwMgAyEDIgMjAyQDJQMmAycDKAMp` +
-	`AyoDKwMsAy0DLgMvAzADMQMyAzMDNAM1AzYDNwM4AzkDOgM7AzwDPQM+Az8DQANBA0IDQwNEA0UDRgNHA0gDSQNKA0sDTANNA04DTwNQA1EDUgNTA1QDVQNWA1cDWANZA1oDWwNcA10DXgNfA2ADYQ` +
-	`NiA2MDZANlA2YDZwNoA2kDagNrA2wDbQNuA28DcANxA3IDcwN0A3UDdgN3A3gDeQN6A3sDfAN9A34DfwOAA4EDggODA4QDhQOGA4cDiAOJA4oDiwOMA40DjgOPA5ADkQOSA5MDlAOVA5YDlwOYA5kD` +
-	`mgObA5wDnQOeA58DoAOhA6IDowOkA6UDpgOnA6gDqQOqA6sDrAOtA64DrwOwA7EDsgOzA7QDtQO2A7cDuAO5A7oDuwO8A70DvgO/A8ADwQPCA8MDxAPFA8YDxwPIA8kDygPLA8wDzQPOA88D0APRA9` +
-	`ID0wPUA9UD1gPXA9gD2QPaA9sD3APdA94D3wPgA+ED4gPjA+QD5QPmA+cD6APpA+oD6wPsA+0D7gPvA/AD8QPyA/MD9AP1A/YD9wP4A/kD+gP7A/wD/QP+A/8EAAQBBAIEAwQEBAUEBgQHBAgECQQK` +
-	`BAsEDAQNBA4EDwQQBBEEEgQTBBQEFQQWBBcEGAQZBBoEGwQcBB0EHgQfBCAEIQQiBCMEJAQlBCYEJwQoBCkEKgQrBCwELQQuBC8EMAQxBDIEMwQ0BDUENgQ3BDgEOQQ6BDsEPAQ9BD4EPwRABEEEQg` +
-	`RDBEQERQRGBEcESARJBEoESwRMBE0ETgRPBFAEUQRSBFMEVARVBFYEVwRYBFkEWgRbBFwEXQReBF8EYARhBGIEYwRkBGUEZgRnBGgEaQRqBGsEbARtBG4EbwRwBHEEcgRzBHQEdQR2BHcEeAR5BHoE` +
-	`ewR8BH0EfgR/BIAEgQSCBIMEhASFBIYEhwSIBIkEigSLBIwEjQSOBI8EkASRBJIEkwSUBJUElgSXBJgEmQSaBJsEnASdBJ4EnwSgBKEEogSjBKQEpQSmBKcEqASpBKoEqwSsBK0ErgSvBLAEsQSyBL` +
-	`MEtAS1BLYEtwS4BLkEugS7BLwEvQS+BL8EwATBBMIEwwTEBMUExgTHBMgEyQTKBMsEzATNBM4EzwTQBNEE0gTTBNQE1QTWBNcE2ATZBNoE2wTcBN0E3gTfBOAE4QTiBOME5ATlBOYE5wToBOkE6gTr` +
-	`BOwE7QTuBO8E8ATxBPIE8wT0BPUE9gT3BPgE+QT6BPsE/AT9BP4E/wUABQEFAgUDBQQFBQUGBQcFCAUJBQoFCwUMBQ0FDgUPBRAFEQUSBRMFFAUVBRYFFwUYBRkFGgUbBRwFHQUeBR8FIAUhBSIFIw` +
-	`UkBSUFJgUnBSgFKQUqBSsFLAUtBS4FLwUwBTEFMgUzBTQFNQU2BTcFOAU5BToFOwU8BT0FPgU/BUAFQQVCBUMFRAVFBUYFRwVIBUkFSgVLBUwFTQVOBU8FUAVRBVIFUwVUBVUFVgVXBVgFWQVaBVsF` +
-	`XAVdBV4FXwVgBWEFYgVjBWQFZQVmBWcFaAVpBWoFawVsBW0FbgVvBXAFcQVyBXMFdAV1BXYFdwV4BXkFegV7BXwFfQV+BX8FgAWBBYIFgwWEBYUFhgWHBYgFiQWKBYsFjAWNBY4FjwWQBZEFkgWTBZ` +
-	`QFlQWWBZcFmAWZBZoFmwWcBZ0FngWfBaAFoQWiBaMFpAWlBaYFpwWoBakFqgWrBawFrQWuBa8FsAWxBbIFswW0BbUFtgW3BbgFuQW6BbsFvAW9Bb4FvwXABcEFwgXDBcQFxQXGBccFyAXJBcoFywXM` +
-	`Bc0FzgXPBdAF0QXSBdMF1AXVBdYF1wXYBdkF2gXbBdwF3QXeBd8F4AXhBeIF4wXkBeUF5gXnBegF6QXqBesF7AXtBe4F7wXwBfEF8gXzBfQF9QX2BfcF+AX5BfoF+wX8Bf0F/gX/BgAGAQYCBgMGBA` +
-	`YFBgYGBwYIBgkGCgYLBgwGDQYOBg8GEAYRBhIGEwYUBhUGFgYXBhgGGQYaBhsGHAYdBh4GHwYgBiEGIgYjBiQGJQYmBicGKAYpBioGKwYsBi0GLgYvBjAGMQYyBjMGNAY1BjYGNwY4BjkGOgY7BjwG` +
-	`PQY+Bj8GQAZBBkIGQwZEBkUGRgZHBkgGSQZKBksGTAZNBk4GTwZQBlEGUgZTBlQGVQZWBlcGWAZZBloGWwZcBl0GXgZfBmAGYQZiBmMGZAZlBmYGZwZoBmkGagZrBmwGbQZuBm8GcAZxBnIGcwZ0Bn` +
-	`UGdgZ3BngGeQZ6BnsGfAZ9Bn4GfwaABoEGggaDBoQGhQaGBocGiAaJBooGiwaMBo0Mbm90ZXF1YWxfb2xkD3BhcnRpYWxkaWZmX29sZA1zdW1tYXRpb25fb2xkDGludGVncmFsX29sZAtyYWRpY2Fs` +
-	`X29sZA9hcHByb3hlcXVhbF9vbGQERXVybwdBbWFjcm9uB2FtYWNyb24GQWJyZXZlBmFicmV2ZQdBb2dvbmVrB2FvZ29uZWsLQ2NpcmN1bWZsZXgLY2NpcmN1bWZsZXgKQ2RvdGFjY2VudApjZG90YW` +
-	`NjZW50BkRjYXJvbgZkY2Fyb24HRW1hY3JvbgdlbWFjcm9uBkVicmV2ZQZlYnJldmUKRWRvdGFjY2VudAplZG90YWNjZW50B0VvZ29uZWsHZW9nb25lawZFY2Fyb24GZWNhcm9uC0djaXJjdW1mbGV4` +
-	`C2djaXJjdW1mbGV4Ckdkb3RhY2NlbnQKZ2RvdGFjY2VudAhHY2VkaWxsYQhnY2VkaWxsYQZnYWN1dGULSGNpcmN1bWZsZXgLaGNpcmN1bWZsZXgESGJhcgRoYmFyBkl0aWxkZQZpdGlsZGUHSW1hY3` +
-	`JvbgdpbWFjcm9uB0lvZ29uZWsHaW9nb25lawhLY2VkaWxsYQhrY2VkaWxsYQZMYWN1dGUGbGFjdXRlCExjZWRpbGxhCGxjZWRpbGxhBkxjYXJvbgZsY2Fyb24KTGRvdGFjY2VudApsZG90YWNjZW50` +
-	`Bk5hY3V0ZQZuYWN1dGUITmNlZGlsbGEIbmNlZGlsbGEGTmNhcm9uBm5jYXJvbgxuY29tbWFhY2NlbnQHT21hY3JvbgdvbWFjcm9uBk9icmV2ZQZvYnJldmUNT2h1bmdhcnVtbGF1dA1vaHVuZ2FydW` +
-	`1sYXV0BlJhY3V0ZQZyYWN1dGUIUmNlZGlsbGEIcmNlZGlsbGEGUmNhcm9uBnJjYXJvbgZTYWN1dGUGc2FjdXRlC1NjaXJjdW1mbGV4C3NjaXJjdW1mbGV4CFRjZWRpbGxhCHRjZWRpbGxhBlRjYXJv` +
-	`bgZ0Y2Fyb24EVGJhcgR0YmFyBlV0aWxkZQZ1dGlsZGUHVW1hY3Jvbgd1bWFjcm9uBlVicmV2ZQZ1YnJldmUFVXJpbmcFdXJpbmcNVWh1bmdhcnVtbGF1dA11aHVuZ2FydW1sYXV0B1VvZ29uZWsHdW` +
-	`9nb25lawtXY2lyY3VtZmxleAt3Y2lyY3VtZmxleAtZY2lyY3VtZmxleAt5Y2lyY3VtZmxleAZaYWN1dGUGemFjdXRlClpkb3RhY2NlbnQKemRvdGFjY2VudAxTY29tbWFhY2NlbnQMc2NvbW1hYWNj` +
-	`ZW50DFRjb21tYWFjY2VudAx0Y29tbWFhY2NlbnQMR2NvbW1hYWNjZW50DGdjb21tYWFjY2VudAxMY29tbWFhY2NlbnQMbGNvbW1hYWNjZW50DEtjb21tYWFjY2VudAxrY29tbWFhY2NlbnQMTmNvbW` +
-	`1hYWNjZW50DFJjb21tYWFjY2VudAxyY29tbWFhY2NlbnQGRGNyb2F0C2NvbW1hYWNjZW50C0pjaXJjdW1mbGV4CGRvdGxlc3NqCGdyYXZlY21iCGFjdXRlY21iDWNpcmN1bWZsZXhjbWIIdGlsZGVj` +
-	`bWIJbWFjcm9uY21iCGJyZXZlY21iDGRvdGFjY2VudGNtYgtkaWVyZXNpc2NtYgdyaW5nY21iD2h1bmdhcnVtbGF1dGNtYghjYXJvbmNtYgpjZWRpbGxhY21iCW9nb25la2NtYhVzb2xpZHVzbG9uZ2` +
-	`92ZXJsYXljbWIJQWN5cmlsbGljCkJlY3lyaWxsaWMKVmVjeXJpbGxpYwpHZWN5cmlsbGljCkRlY3lyaWxsaWMKSWVjeXJpbGxpYwtaaGVjeXJpbGxpYwpaZWN5cmlsbGljCklpY3lyaWxsaWMPSWlz` +
-	`aG9ydGN5cmlsbGljCkthY3lyaWxsaWMKRWxjeXJpbGxpYwpFbWN5cmlsbGljCkVuY3lyaWxsaWMJT2N5cmlsbGljClBlY3lyaWxsaWMKRXJjeXJpbGxpYwpFc2N5cmlsbGljClRlY3lyaWxsaWMJVW` +
-	`N5cmlsbGljCkVmY3lyaWxsaWMLS2hhY3lyaWxsaWMLVHNlY3lyaWxsaWMLQ2hlY3lyaWxsaWMLU2hhY3lyaWxsaWMNU2hjaGFjeXJpbGxpYxBIYXJkc2lnbmN5cmlsbGljDFllcmljeXJpbGxpYxBT` +
-	`b2Z0c2lnbmN5cmlsbGljEUVyZXZlcnNlZGN5cmlsbGljCklVY3lyaWxsaWMKSUFjeXJpbGxpYwlJY3lyaWxsaWMLRGplY3lyaWxsaWMLZGplY3lyaWxsaWMLR2plY3lyaWxsaWMLZ2plY3lyaWxsaW` +
-	`MJaWN5cmlsbGljCkplY3lyaWxsaWMJRWN5cmlsbGljCWVjeXJpbGxpYwpZaWN5cmlsbGljCnlpY3lyaWxsaWMLTGplY3lyaWxsaWMLbGplY3lyaWxsaWMLTmplY3lyaWxsaWMLbmplY3lyaWxsaWMK` +
-	`amVjeXJpbGxpYwtEemVjeXJpbGxpYwxUc2hlY3lyaWxsaWMMdHNoZWN5cmlsbGljC0tqZWN5cmlsbGljC2tqZWN5cmlsbGljC2R6ZWN5cmlsbGljDlVzaG9ydGN5cmlsbGljDnVzaG9ydGN5cmlsbG` +
-	`ljDER6aGVjeXJpbGxpYwxkemhlY3lyaWxsaWMKSW9jeXJpbGxpYwppb2N5cmlsbGljCmlhY3lyaWxsaWMJYWN5cmlsbGljCmJlY3lyaWxsaWMKdmVjeXJpbGxpYwpnZWN5cmlsbGljCmRlY3lyaWxs` +
-	`aWMKaWVjeXJpbGxpYwt6aGVjeXJpbGxpYwp6ZWN5cmlsbGljCmlpY3lyaWxsaWMPaWlzaG9ydGN5cmlsbGljCmthY3lyaWxsaWMKZWxjeXJpbGxpYwplbWN5cmlsbGljCmVuY3lyaWxsaWMJb2N5cm` +
-	`lsbGljCnBlY3lyaWxsaWMKZXJjeXJpbGxpYwplc2N5cmlsbGljCnRlY3lyaWxsaWMJdWN5cmlsbGljCmVmY3lyaWxsaWMLa2hhY3lyaWxsaWMLdHNlY3lyaWxsaWMLY2hlY3lyaWxsaWMLc2hhY3ly` +
-	`aWxsaWMNc2hjaGFjeXJpbGxpYxBoYXJkc2lnbmN5cmlsbGljDHllcmljeXJpbGxpYxBzb2Z0c2lnbmN5cmlsbGljEWVyZXZlcnNlZGN5cmlsbGljCml1Y3lyaWxsaWMGbnVtZXJvEUdoZXVwdHVybm` +
-	`N5cmlsbGljEWdoZXVwdHVybmN5cmlsbGljD25vdGVxdWFsX21pcnJvchJwYXJ0aWFsZGlmZl9taXJyb3IQc3VtbWF0aW9uX21pcnJvcg9pbnRlZ3JhbF9taXJyb3IOcmFkaWNhbF9taXJyb3ISYXBw` +
-	`cm94ZXF1YWxfbWlycm9yC2NsaWNrZGVudGFsDnplcm93aWR0aHNwYWNlEnplcm93aWR0aG5vbmpvaW5lcg96ZXJvd2lkdGhqb2luZXIGa2VsdmluCGFuZ3N0cm9tBklicmV2ZQZpYnJldmULamNpcm` +
-	`N1bWZsZXgOY2xpY2tyZXRyb2ZsZXgGQWNhcm9uBmFjYXJvbgZJY2Fyb24GaWNhcm9uBk9jYXJvbgZvY2Fyb24GVWNhcm9uBnVjYXJvbg9VZGllcmVzaXNtYWNyb24PdWRpZXJlc2lzbWFjcm9uDlVk` +
-	`aWVyZXNpc2FjdXRlDnVkaWVyZXNpc2FjdXRlDlVkaWVyZXNpc2Nhcm9uDnVkaWVyZXNpc2Nhcm9uDlVkaWVyZXNpc2dyYXZlDnVkaWVyZXNpc2dyYXZlD0FkaWVyZXNpc21hY3Jvbg9hZGllcmVzaX` +
-	`NtYWNyb24KQWRvdG1hY3JvbgphZG90bWFjcm9uCEFFbWFjcm9uCGFlbWFjcm9uBkdjYXJvbgZnY2Fyb24GS2Nhcm9uBmtjYXJvbgdPb2dvbmVrB29vZ29uZWsNT29nb25la21hY3Jvbg1vb2dvbmVr` +
-	`bWFjcm9uBmpjYXJvbgZHYWN1dGUGTmdyYXZlBm5ncmF2ZQpBcmluZ2FjdXRlCmFyaW5nYWN1dGUHQUVhY3V0ZQdhZWFjdXRlC09zbGFzaGFjdXRlC29zbGFzaGFjdXRlBkhjYXJvbgZoY2Fyb24KQW` +
-	`RvdGFjY2VudAphZG90YWNjZW50CEVjZWRpbGxhCGVjZWRpbGxhD09kaWVyZXNpc21hY3Jvbg9vZGllcmVzaXNtYWNyb24MT3RpbGRlbWFjcm9uDG90aWxkZW1hY3JvbgpPZG90YWNjZW50Cm9kb3Rh` +
-	`Y2NlbnQQT2RvdGFjY2VudG1hY3JvbhBvZG90YWNjZW50bWFjcm9uB1ltYWNyb24HeW1hY3Jvbgd1bmkwMkVFEHBhbG9jaGthY3lyaWxsaWMKQXJpbmdiZWxvdwphcmluZ2JlbG93CkJkb3RhY2Nlbn` +
-	`QKYmRvdGFjY2VudAlCZG90YmVsb3cJYmRvdGJlbG93CkJsaW5lYmVsb3cKYmxpbmViZWxvdw1DY2VkaWxsYWFjdXRlDWNjZWRpbGxhYWN1dGUKRGRvdGFjY2VudApkZG90YWNjZW50CURkb3RiZWxv` +
-	`dwlkZG90YmVsb3cKRGxpbmViZWxvdwpkbGluZWJlbG93CERjZWRpbGxhCGRjZWRpbGxhEERjaXJjdW1mbGV4YmVsb3cQZGNpcmN1bWZsZXhiZWxvdwxFbWFjcm9uZ3JhdmUMZW1hY3JvbmdyYXZlDE` +
-	`VtYWNyb25hY3V0ZQxlbWFjcm9uYWN1dGUQRWNpcmN1bWZsZXhiZWxvdxBlY2lyY3VtZmxleGJlbG93C0V0aWxkZWJlbG93C2V0aWxkZWJlbG93DUVjZWRpbGxhYnJldmUNZWNlZGlsbGFicmV2ZQpG` +
-	`ZG90YWNjZW50CmZkb3RhY2NlbnQHR21hY3JvbgdnbWFjcm9uCkhkb3RhY2NlbnQKaGRvdGFjY2VudAlIZG90YmVsb3cJaGRvdGJlbG93CUhkaWVyZXNpcwloZGllcmVzaXMISGNlZGlsbGEIaGNlZG` +
-	`lsbGELSGJyZXZlYmVsb3cLaGJyZXZlYmVsb3cLSXRpbGRlYmVsb3cLaXRpbGRlYmVsb3cOSWRpZXJlc2lzYWN1dGUOaWRpZXJlc2lzYWN1dGUGS2FjdXRlBmthY3V0ZQlLZG90YmVsb3cJa2RvdGJl` +
-	`bG93CktsaW5lYmVsb3cKa2xpbmViZWxvdwlMZG90YmVsb3cJbGRvdGJlbG93D0xkb3RiZWxvd21hY3Jvbg9sZG90YmVsb3dtYWNyb24KTGxpbmViZWxvdwpsbGluZWJlbG93EExjaXJjdW1mbGV4Ym` +
-	`Vsb3cQbGNpcmN1bWZsZXhiZWxvdwZNYWN1dGUGbWFjdXRlCk1kb3RhY2NlbnQKbWRvdGFjY2VudAlNZG90YmVsb3cJbWRvdGJlbG93Ck5kb3RhY2NlbnQKbmRvdGFjY2VudAlOZG90YmVsb3cJbmRv` +
-	`dGJlbG93Ck5saW5lYmVsb3cKbmxpbmViZWxvdxBOY2lyY3VtZmxleGJlbG93EG5jaXJjdW1mbGV4YmVsb3cLT3RpbGRlYWN1dGULb3RpbGRlYWN1dGUOT3RpbGRlZGllcmVzaXMOb3RpbGRlZGllcm` +
-	`VzaXMMT21hY3JvbmdyYXZlDG9tYWNyb25ncmF2ZQxPbWFjcm9uYWN1dGUMb21hY3JvbmFjdXRlBlBhY3V0ZQZwYWN1dGUKUGRvdGFjY2VudApwZG90YWNjZW50ClJkb3RhY2NlbnQKcmRvdGFjY2Vu` +
-	`dAlSZG90YmVsb3cJcmRvdGJlbG93D1Jkb3RiZWxvd21hY3Jvbg9yZG90YmVsb3dtYWNyb24KUmxpbmViZWxvdwpybGluZWJlbG93ClNkb3RhY2NlbnQKc2RvdGFjY2VudAlTZG90YmVsb3cJc2RvdG` +
-	`JlbG93D1NhY3V0ZWRvdGFjY2VudA9zYWN1dGVkb3RhY2NlbnQPU2Nhcm9uZG90YWNjZW50D3NjYXJvbmRvdGFjY2VudBJTZG90YmVsb3dkb3RhY2NlbnQSc2RvdGJlbG93ZG90YWNjZW50ClRkb3Rh` +
-	`Y2NlbnQKdGRvdGFjY2VudAlUZG90YmVsb3cJdGRvdGJlbG93ClRsaW5lYmVsb3cKdGxpbmViZWxvdxBUY2lyY3VtZmxleGJlbG93EHRjaXJjdW1mbGV4YmVsb3cOVWRpZXJlc2lzYmVsb3cOdWRpZX` +
-	`Jlc2lzYmVsb3cLVXRpbGRlYmVsb3cLdXRpbGRlYmVsb3cQVWNpcmN1bWZsZXhiZWxvdxB1Y2lyY3VtZmxleGJlbG93C1V0aWxkZWFjdXRlC3V0aWxkZWFjdXRlD1VtYWNyb25kaWVyZXNpcw91bWFj` +
-	`cm9uZGllcmVzaXMGVnRpbGRlBnZ0aWxkZQlWZG90YmVsb3cJdmRvdGJlbG93BldncmF2ZQZ3Z3JhdmUGV2FjdXRlBndhY3V0ZQlXZGllcmVzaXMJd2RpZXJlc2lzCldkb3RhY2NlbnQKd2RvdGFjY2` +
-	`VudAlXZG90YmVsb3cJd2RvdGJlbG93Clhkb3RhY2NlbnQKeGRvdGFjY2VudAlYZGllcmVzaXMJeGRpZXJlc2lzCllkb3RhY2NlbnQKeWRvdGFjY2VudAtaY2lyY3VtZmxleAt6Y2lyY3VtZmxleAla` +
-	`ZG90YmVsb3cJemRvdGJlbG93ClpsaW5lYmVsb3cKemxpbmViZWxvdwpobGluZWJlbG93CXRkaWVyZXNpcwV3cmluZwV5cmluZwlBZG90YmVsb3cJYWRvdGJlbG93EEFjaXJjdW1mbGV4YWN1dGUQYW` +
-	`NpcmN1bWZsZXhhY3V0ZRBBY2lyY3VtZmxleGdyYXZlEGFjaXJjdW1mbGV4Z3JhdmUQQWNpcmN1bWZsZXh0aWxkZRBhY2lyY3VtZmxleHRpbGRlE0FjaXJjdW1mbGV4ZG90YmVsb3cTYWNpcmN1bWZs` +
-	`ZXhkb3RiZWxvdwtBYnJldmVhY3V0ZQthYnJldmVhY3V0ZQtBYnJldmVncmF2ZQthYnJldmVncmF2ZQtBYnJldmV0aWxkZQthYnJldmV0aWxkZQ5BYnJldmVkb3RiZWxvdw5hYnJldmVkb3RiZWxvdw` +
-	`lFZG90YmVsb3cJZWRvdGJlbG93BkV0aWxkZQZldGlsZGUQRWNpcmN1bWZsZXhhY3V0ZRBlY2lyY3VtZmxleGFjdXRlEEVjaXJjdW1mbGV4Z3JhdmUQZWNpcmN1bWZsZXhncmF2ZRBFY2lyY3VtZmxl` +
-	`eHRpbGRlEGVjaXJjdW1mbGV4dGlsZGUTRWNpcmN1bWZsZXhkb3RiZWxvdxNlY2lyY3VtZmxleGRvdGJlbG93CUlkb3RiZWxvdwlpZG90YmVsb3cJT2RvdGJlbG93CW9kb3RiZWxvdxBPY2lyY3VtZm` +
-	`xleGFjdXRlEG9jaXJjdW1mbGV4YWN1dGUQT2NpcmN1bWZsZXhncmF2ZRBvY2lyY3VtZmxleGdyYXZlEE9jaXJjdW1mbGV4dGlsZGUQb2NpcmN1bWZsZXh0aWxkZRNPY2lyY3VtZmxleGRvdGJlbG93` +
-	`E29jaXJjdW1mbGV4ZG90YmVsb3cJVWRvdGJlbG93CXVkb3RiZWxvdwZZZ3JhdmUGeWdyYXZlCVlkb3RiZWxvdwl5ZG90YmVsb3cGWXRpbGRlBnl0aWxkZRNFX2NpcmN1bWZsZXhfbWFjcm9uE2VfY2` +
-	`lyY3VtZmxleF9tYWNyb24SRV9jaXJjdW1mbGV4X2Nhcm9uEmVfY2lyY3VtZmxleF9jYXJvbhJZaWRpZXJlc2lzY3lyaWxsaWMHdW5pMDQ1MAd1bmkwNDVEEHpoZWJyZXZlY3lyaWxsaWMOQWJyZXZl` +
-	`Y3lyaWxsaWMOYWJyZXZlY3lyaWxsaWMRQWRpZXJlc2lzY3lyaWxsaWMRYWRpZXJlc2lzY3lyaWxsaWMPaWVicmV2ZWN5cmlsbGljE3poZWRpZXJlc2lzY3lyaWxsaWMSemVkaWVyZXNpc2N5cmlsbG` +
-	`ljD2ltYWNyb25jeXJpbGxpYxFpZGllcmVzaXNjeXJpbGxpYxFPZGllcmVzaXNjeXJpbGxpYxFvZGllcmVzaXNjeXJpbGxpYwd1bmkwNEVDB3VuaTA0RUQPVW1hY3JvbmN5cmlsbGljD3VtYWNyb25j` +
-	`eXJpbGxpYxFVZGllcmVzaXNjeXJpbGxpYxF1ZGllcmVzaXNjeXJpbGxpYxVVaHVuZ2FydW1sYXV0Y3lyaWxsaWMVdWh1bmdhcnVtbGF1dGN5cmlsbGljE2NoZWRpZXJlc2lzY3lyaWxsaWMUeWVydW` +
-	`RpZXJlc2lzY3lyaWxsaWMLQWllY3lyaWxsaWMLYWllY3lyaWxsaWMGTWdyYXZlBm1ncmF2ZQtkb3RiZWxvd2NtYhBkaWVyZXNpc2JlbG93Y21iDHJpbmdiZWxvd2NtYhJjaXJjdW1mbGV4YmVsb3dj` +
-	`bWINYnJldmViZWxvd2NtYg10aWxkZWJlbG93Y21iDm1hY3JvbmJlbG93Y21iBXNsb25nDGtncmVlbmxhbmRpYwNFbmcDZW5nCUVyZXZlcnNlZAVTY2h3YQdldHVybmVkBXNjaHdhC292ZXJsaW5lY2` +
-	`1iC2RibGdyYXZlY21iCmxvd2xpbmVjbWIQYnJldmVpbnZlcnRlZGNtYgdUb25ldHdvB3RvbmV0d28RR2hlc3Ryb2tlY3lyaWxsaWMRZ2hlc3Ryb2tlY3lyaWxsaWMTS2FkZXNjZW5kZXJjeXJpbGxp` +
-	`YxNrYWRlc2NlbmRlcmN5cmlsbGljGEthdmVydGljYWxzdHJva2VjeXJpbGxpYxhrYXZlcnRpY2Fsc3Ryb2tlY3lyaWxsaWMTRW5kZXNjZW5kZXJjeXJpbGxpYxNlbmRlc2NlbmRlcmN5cmlsbGljEV` +
-	`VzdHJhaWdodGN5cmlsbGljEXVzdHJhaWdodGN5cmlsbGljF1VzdHJhaWdodHN0cm9rZWN5cmlsbGljF3VzdHJhaWdodHN0cm9rZWN5cmlsbGljE0hhZGVzY2VuZGVyY3lyaWxsaWMTaGFkZXNjZW5k` +
-	`ZXJjeXJpbGxpYxRDaGVkZXNjZW5kZXJjeXJpbGxpYxRjaGVkZXNjZW5kZXJjeXJpbGxpYxlDaGV2ZXJ0aWNhbHN0cm9rZWN5cmlsbGljGWNoZXZlcnRpY2Fsc3Ryb2tlY3lyaWxsaWMMU2hoYWN5cm` +
-	`lsbGljDHNoaGFjeXJpbGxpYw1TY2h3YWN5cmlsbGljDXNjaHdhY3lyaWxsaWMPT2JhcnJlZGN5cmlsbGljD29iYXJyZWRjeXJpbGxpYxVHaGVtaWRkbGVob29rY3lyaWxsaWMVZ2hlbWlkZGxlaG9v` +
-	`a2N5cmlsbGljC29obWludmVydGVkDlVwc2lsb25hZnJpY2FuB2hvcm5jbWIFVWhvcm4FdWhvcm4FT2hvcm4Fb2hvcm4Kc29mdGh5cGhlbgVvb3BlbgllcmV2ZXJzZWQNYXNjcmlwdHR1cm5lZAdhc2` +
-	`NyaXB0B3R0dXJuZWQHbXR1cm5lZAd2dHVybmVkB3d0dXJuZWQHb2JhcnJlZA5PY2VudGVyZWR0aWxkZQ9yZXZlcnNlZHBpbGNyb3cMdHdvZG90bGVhZGVyDG9uZWRvdGxlYWRlcgd1bmkyMDAwB3Vu` +
-	`aTIwMDEHZW5zcGFjZQdlbXNwYWNlB3VuaTIwMDQHdW5pMjAwNQd1bmkyMDA2B3VuaTIwMDcHdW5pMjAwOAd1bmkyMDA5B3VuaTIwMEEScXVvdGVkYmxsZWZ0YWx0b25lEXF1b3RlbGVmdHJldmVyc2` +
-	`VkB3VuaTIxNUYHdW5pMjE2Qwd1bmkyMTZEB3VuaTIxNkUHdW5pMjE2RghUZW5yb21hbglGaXZlcm9tYW4IT25lcm9tYW4Ib25lcm9tYW4JZml2ZXJvbWFuCHNpeHJvbWFuB3VuaTIxN0MHdW5pMjE3` +
-	`RAd1bmkyMTdFDXRob3VzYW5kcm9tYW4DZXNoC2dsb3R0YWxzdG9wE2dsb3R0YWxzdG9wcmV2ZXJzZWQTZ2xvdHRhbHN0b3BpbnZlcnRlZAdydHVybmVkD3RpbGRlb3ZlcmxheWNtYhVzdHJva2VzaG` +
-	`9ydG92ZXJsYXljbWIUc3Ryb2tlbG9uZ292ZXJsYXljbWIVYnJldmVpbnZlcnRlZGJlbG93Y21iDWdyYXZlYmVsb3djbWINYWN1dGViZWxvd2NtYgxjbGlja2xhdGVyYWwNY2xpY2thbHZlb2xhcgpP` +
-	`bWVnYWdyZWVrB0Z0dXJuZWQHdW5pMjEyRhdjb21iaW5pbmdncmFwaGVtZWpvaW5lcgtuYXBvc3Ryb3BoZQlBZGJsZ3JhdmUJYWRibGdyYXZlDkFpbnZlcnRlZGJyZXZlDmFpbnZlcnRlZGJyZXZlCU` +
-	`VkYmxncmF2ZQllZGJsZ3JhdmUORWludmVydGVkYnJldmUOZWludmVydGVkYnJldmUJSWRibGdyYXZlCWlkYmxncmF2ZQ5JaW52ZXJ0ZWRicmV2ZQ5paW52ZXJ0ZWRicmV2ZQlPZGJsZ3JhdmUJb2Ri` +
-	`bGdyYXZlDk9pbnZlcnRlZGJyZXZlDm9pbnZlcnRlZGJyZXZlCVJkYmxncmF2ZQlyZGJsZ3JhdmUOUmludmVydGVkYnJldmUOcmludmVydGVkYnJldmUJVWRibGdyYXZlCXVkYmxncmF2ZQ5VaW52ZX` +
-	`J0ZWRicmV2ZQ51aW52ZXJ0ZWRicmV2ZQ5zbG9uZ2RvdGFjY2VudApPaG9ybmFjdXRlCm9ob3JuYWN1dGUKT2hvcm5ncmF2ZQpvaG9ybmdyYXZlCk9ob3JudGlsZGUKb2hvcm50aWxkZQ1PaG9ybmRv` +
-	`dGJlbG93DW9ob3JuZG90YmVsb3cKVWhvcm5hY3V0ZQp1aG9ybmFjdXRlClVob3JuZ3JhdmUKdWhvcm5ncmF2ZQpVaG9ybnRpbGRlCnVob3JudGlsZGUNVWhvcm5kb3RiZWxvdw11aG9ybmRvdGJlbG` +
-	`93EWVjaXJjdW1mbGV4bWFjcm9uEGVjaXJjdW1mbGV4Y2Fyb24HdW5pMDQwMAd1bmkwNDBEEFpoZWJyZXZlY3lyaWxsaWMPSWVicmV2ZWN5cmlsbGljFVNjaHdhZGllcmVzaXNjeXJpbGxpYxVzY2h3` +
-	`YWRpZXJlc2lzY3lyaWxsaWMTWmhlZGllcmVzaXNjeXJpbGxpYxJaZWRpZXJlc2lzY3lyaWxsaWMPSW1hY3JvbmN5cmlsbGljEUlkaWVyZXNpc2N5cmlsbGljF09iYXJyZWRkaWVyZXNpc2N5cmlsbG` +
-	`ljF29iYXJyZWRkaWVyZXNpc2N5cmlsbGljE0NoZWRpZXJlc2lzY3lyaWxsaWMUWWVydWRpZXJlc2lzY3lyaWxsaWMMZ3JhdmV0b25lY21iDGFjdXRldG9uZWNtYhFkaWFseXRpa2F0b25vc2NtYg1x` +
-	`dWVzdGlvbmdyZWVrDmRpYWx5dGlrYXRvbm9zCWFub3RlbGVpYQxBbWFjcm9uZ3JhdmUMYW1hY3JvbmdyYXZlDGFhY3V0ZW9nb25lawxJbWFjcm9uZ3JhdmUMaW1hY3JvbmdyYXZlDmlkb3RhYm92ZW` +
-	`FjdXRlDFVtYWNyb25ncmF2ZQx1bWFjcm9uZ3JhdmUJeGRvdGJlbG93BlJicmV2ZQpVaG9ybmJyZXZlBnJicmV2ZQp1aG9ybmJyZXZlE1RlZGVzY2VuZGVyY3lyaWxsaWMTdGVkZXNjZW5kZXJjeXJp` +
-	`bGxpYxNFc2Rlc2NlbmRlcmN5cmlsbGljE2VzZGVzY2VuZGVyY3lyaWxsaWMTWmVkZXNjZW5kZXJjeXJpbGxpYxN6ZWRlc2NlbmRlcmN5cmlsbGljEEthc3Ryb2tlY3lyaWxsaWMQa2FzdHJva2VjeX` +
-	`JpbGxpYxFLYWJhc2hraXJjeXJpbGxpYxFrYWJhc2hraXJjeXJpbGxpYxNwZXJpc3BvbWVuaWdyZWVrY21iDmNvbW1hdHVybmVkbW9kDWFwb3N0cm9waGVtb2QQYXJyb3doZWFkbGVmdG1vZBFhcnJv` +
-	`d2hlYWRyaWdodG1vZA5hcnJvd2hlYWR1cG1vZBBhcnJvd2hlYWRkb3dubW9kE2NvbW1hdHVybmVkYWJvdmVjbWINY29tbWFhYm92ZWNtYhJjb21tYWFib3ZlcmlnaHRjbWIVY29tbWFyZXZlcnNlZG` +
-	`Fib3ZlY21iCmtvcm9uaXNjbWIHaG9va2NtYhR2ZXJ0aWNhbGxpbmVhYm92ZWNtYhdkYmx2ZXJ0aWNhbGxpbmVhYm92ZWNtYgxwbHVzYmVsb3djbWIQZG93bnRhY2tiZWxvd2NtYg51cHRhY2tiZWxv` +
-	`d2NtYhFyaWdodHRhY2tiZWxvd2NtYhBsZWZ0dGFja2JlbG93Y21iDWNhcm9uYmVsb3djbWIDRXNoCERhZnJpY2FuDW5sZWdyaWdodGxvbmcCSUoCaWoJaHlwaGVudHdvEW5vbmJyZWFraW5naHlwaG` +
-	`VuCmZpZ3VyZWRhc2gNaG9yaXpvbnRhbGJhcghybG9uZ2xlZw5tbG9uZ2xlZ3R1cm5lZA1iaWxhYmlhbGNsaWNrDnJsb25nbGVndHVybmVkCkFob29rYWJvdmUKYWhvb2thYm92ZRRBY2lyY3VtZmxl` +
-	`eGhvb2thYm92ZRRhY2lyY3VtZmxleGhvb2thYm92ZQ9BYnJldmVob29rYWJvdmUPYWJyZXZlaG9va2Fib3ZlCkVob29rYWJvdmUKZWhvb2thYm92ZRRFY2lyY3VtZmxleGhvb2thYm92ZRRlY2lyY3` +
-	`VtZmxleGhvb2thYm92ZQpJaG9va2Fib3ZlCmlob29rYWJvdmUKT2hvb2thYm92ZQpvaG9va2Fib3ZlFE9jaXJjdW1mbGV4aG9va2Fib3ZlFG9jaXJjdW1mbGV4aG9va2Fib3ZlDk9ob3JuaG9va2Fi` +
-	`b3ZlDm9ob3JuaG9va2Fib3ZlClVob29rYWJvdmUKdWhvb2thYm92ZQ5VaG9ybmhvb2thYm92ZQ51aG9ybmhvb2thYm92ZQpZaG9va2Fib3ZlCnlob29rYWJvdmUOZ2xvdHRhbHN0b3Btb2QWZ2xvdH` +
-	`RhbHN0b3ByZXZlcnNlZG1vZA1yaW5naGFsZnJpZ2h0EGNvbW1hcmV2ZXJzZWRtb2QMcmluZ2hhbGZsZWZ0D3ZlcnRpY2FsbGluZW1vZBBmaXJzdHRvbmVjaGluZXNlEXNlY29uZHRvbmVjaGluZXNl` +
-	`EWZvdXJ0aHRvbmVjaGluZXNlC2dyYXZlbG93bW9kC2FjdXRlbG93bW9kDG1hY3Jvbmxvd21vZBJ2ZXJ0aWNhbGxpbmVsb3dtb2QVcmluZ2hhbGZyaWdodGNlbnRlcmVkFHJpbmdoYWxmbGVmdGNlbn` +
-	`RlcmVkCXVwdGFja21vZAtkb3dudGFja21vZAdwbHVzbW9kB3VuaTAyRUMVcmluZ2hhbGZyaWdodGJlbG93Y21iFHJpbmdoYWxmbGVmdGJlbG93Y21iDmRibG92ZXJsaW5lY21iDWRibGxvd2xpbmVj` +
-	`bWIIcHJpbWVtb2QLZGJscHJpbWVtb2QGbWludXRlBnNlY29uZAtwcmltZXRyaXBsZQ1wcmltZXJldmVyc2VkDnNlY29uZHJldmVyc2VkB3VuaTIwMzcHdW5pMjAyNw9sZWZ0dG9yaWdodG1hcmsPcm` +
-	`lnaHR0b2xlZnRtYXJrEG51bWVyYWxzaWduZ3JlZWsOYXJpZ2h0aGFsZnJpbmcHWnN0cm9rZQd6c3Ryb2tlB0J0b3BiYXIHYnRvcGJhcgdEdG9wYmFyB2R0b3BiYXIOdHJldHJvZmxleGhvb2sOamRv` +
-	`dGxlc3NzdHJva2UHSXN0cm9rZQRsYmFyBU9vcGVuBW5hYmxhBGRvbmcIY3J1emVpcm8EbGlyYQd1bmkyMEFEB3VuaTIwQUUHdW5pMjBBNQd1bmkyMEE2A3dvbgd1bmkyMEIyB3VuaTIwQjMHdW5pMj` +
-	`BCNAd1bmkyMEI1F2hvb2twYWxhdGFsaXplZGJlbG93Y21iFWhvb2tyZXRyb2ZsZXhiZWxvd2NtYgVGaG9vawlOaG9va2xlZnQHYnN0cm9rZQx6ZXJvaW5mZXJpb3ILb25laW5mZXJpb3ILdHdvaW5m` +
-	`ZXJpb3INdGhyZWVpbmZlcmlvcgxmb3VyaW5mZXJpb3IMZml2ZWluZmVyaW9yC3NpeGluZmVyaW9yDXNldmVuaW5mZXJpb3INZWlnaHRpbmZlcmlvcgxuaW5laW5mZXJpb3IHdW5pMjA4QQ5oeXBoZW` +
-	`5pbmZlcmlvcgd1bmkyMDhDEXBhcmVubGVmdGluZmVyaW9yEnBhcmVucmlnaHRpbmZlcmlvcgx6ZXJvc3VwZXJpb3IMZm91cnN1cGVyaW9yDGZpdmVzdXBlcmlvcgtzaXhzdXBlcmlvcg1zZXZlbnN1` +
-	`cGVyaW9yDWVpZ2h0c3VwZXJpb3IMbmluZXN1cGVyaW9yDHBsdXNzdXBlcmlvcg5oeXBoZW5zdXBlcmlvcg1lcXVhbHN1cGVyaW9yEXBhcmVubGVmdHN1cGVyaW9yEnBhcmVucmlnaHRzdXBlcmlvcg` +
-	`luc3VwZXJpb3IHdW5pMjA5NAluaG9va2xlZnQObmhvb2tyZXRyb2ZsZXgObGhvb2tyZXRyb2ZsZXgLcmhvb2t0dXJuZWQFcmhvb2sFbWhvb2sHZ3NjcmlwdAlJc21hbGxpcGEGcnVwaWFoB3VuaTIw` +
-	`QzgHdW5pMjBCMQljb2xvbnNpZ24OVHJldHJvZmxleGhvb2sUdmVydGljYWxsaW5lYmVsb3djbWIWc29saWR1c3Nob3J0b3ZlcmxheWNtYgd1bmkyMTQ0B3VuaTIxNDEHdW5pMjE0Mgd1bmkyMTRCB3` +
-	`VuaTIxNDMHdW5pMjEyMAl0ZWxlcGhvbmUHdW5pMjEzQgxwcmVzY3JpcHRpb24HdW5pMjEw`
-
-const MonacoFontData2 = `Rgd1bmkyMTBFB3VuaTIwMkYHdW5pMjAyOAd1bmkyMDI5B3VuaTIwMkEHdW5pMjAyQglhZmlpNjE1NzMJYWZpaTYxNTc0CWFmaWk2MTU3NQtpbnRlcnJvYmFuZwlleGNsYW` +
-	`1kYmwHdW5pMjA1Rgd1bmkyMDYwB3VuaTIwNjEHdW5pMjA2Mgd1bmkyMDYzB3VuaTIwNkEHdW5pMjA2Qgd1bmkyMDZDB3VuaTIwNkQHdW5pMjA2RQd1bmkyMDZGB3VuaTIwNTUHdW5pMjA0RAd1bmky` +
-	`MDRDB3VuaTIwNTIHdW5pMjA0NQd1bmkyMDQ2CXR3b3N0cm9rZQdNdHVybmVkEWxlZnRhbmdsZWFib3ZlY21iDmJyaWRnZWJlbG93Y21iFmJyaWRnZWludmVydGVkYmVsb3djbWIHdW5pMDM0Ng1taW` +
-	`51c2JlbG93Y21iDmNhbmRyYWJpbmR1Y21iF2RibGFyY2hpbnZlcnRlZGJlbG93Y21iEHRpbGRldmVydGljYWxjbWIHdW5pMDM0RQd1bmkwMzQ3DnNxdWFyZWJlbG93Y21iB3VuaTAzNDkHdW5pMDM0` +
-	`OAd1bmkwMzRCB3VuaTAzNEMHdW5pMDM0QQd1bmkwMjY5B3VuaTAzNDUHdW5pMDMzRAd1bmkwMzNDB3VuaTAzNEQHdW5pMDM1MAd1bmkwMzUxB3VuaTAzNTIHdW5pMDM1Mwd1bmkwMzU0B3VuaTAzNT` +
-	`UHdW5pMDM1Ngd1bmkwMzU3B3VuaTAzNTgHdW5pMDM1OQd1bmkwMzVBB3VuaTAzNjMHdW5pMDM2NAd1bmkwMzY1B3VuaTAzNjYHdW5pMDM2Nwd1bmkwMzY4B3VuaTAzNjkHdW5pMDM2QQd1bmkwMzZC` +
-	`B3VuaTAzNkMHdW5pMDM2RAd1bmkwMzZFB3VuaTAzNkYHdW5pMDJERgd1bmkwMjdFB3VuaTAyN0YHdW5pMDI3Ngd1bmkwMjY1B3VuaTAyOEUHdW5pMDI1Ngd1bmkwMjgwB3VuaTAyODEHdW5pMDI4Mg` +
-	`d1bmkwMjYyB3VuaTAyNzQHdW5pMDI4QQd1bmkwMjhGB3VuaTAyOUMHdW5pMDI5Rgd1bmkwMjk5B3VuaTIwMzgGcGVzZXRhBHViYXIQZXNoc3F1YXRyZXZlcnNlZBJkb3RsZXNzanN0cm9rZWhvb2sH` +
-	`a3R1cm5lZAdpc3Ryb2tlC0lvdGFhZnJpY2FuBWJob29rBWRob29rBWhob29rCGhlbmdob29rEWdsb3R0YWxzdG9wc3Ryb2tlGWdsb3R0YWxzdG9wc3Ryb2tlcmV2ZXJzZWQFZ2hvb2sMdHBhbGF0YW` +
-	`xob29rBXRob29rBWtob29rBXBob29rB1RvbmVzaXgHdG9uZXNpeAdHc3Ryb2tlB2dzdHJva2UMbG1pZGRsZXRpbGRlCHBoaWxhdGluBXFob29rAk5KAk5qAm5qAkxKAkxqAmxqB0RaY2Fyb24HRHpj` +
-	`YXJvbgdkemNhcm9uAkRaAkR6AmR6BVpob29rBXpob29rB3VuaTAyNDEOZGJsdmVydGljYWxiYXIKY3N0cmV0Y2hlZAd1bmkwMjNBB3VuaTAyM0IHdW5pMDIzQwd1bmkwMjNEB3VuaTAyM0UHdW5pMD` +
-	`IzOAd1bmkwMjM5A0V6aAd1bmkyMDQ3B3VuaTIwNDMHdW5pMjAzMQhvdmVybGluZQd1bmkyMDQxB3VuaTIwNEUHdW5pMjA0Rgd1bmkyMDUxCmRibGxvd2xpbmUHdW5pMjA0QQd1bmkyMDQ4B3VuaTIw` +
-	`NDkHdW5pMjA1Mwd1bmkyMDU0B3VuaTIwM0YHdW5pMjA0MAd1bmkyMDUwCG1pbnVzbW9kCWhzdXBlcmlvcg1oaG9va3N1cGVyaW9yCWpzdXBlcmlvcglyc3VwZXJpb3IPcnR1cm5lZHN1cGVyaW9yE3` +
-	`Job29rdHVybmVkc3VwZXJpb3IWUnNtYWxsaW52ZXJ0ZWRzdXBlcmlvcgl3c3VwZXJpb3IJeXN1cGVyaW9yDXJob3RpY2hvb2ttb2QJc2Nod2Fob29rCGR6YWx0b25lAnRzB3VuaTAyQTkEdGVzaAd1` +
-	`bmkwMkFBB3VuaTAyQUIHdW5pMDJBRQd1bmkwMkFGB3VuaTAyQUMHdW5pMDJBRAd1bmkwMkVECWxzdXBlcmlvcglzc3VwZXJpb3IJeHN1cGVyaW9yG2dsb3R0YWxzdG9wcmV2ZXJzZWRzdXBlcmlvch` +
-	`Jjb2xvbnRyaWFuZ3VsYXJtb2QWY29sb250cmlhbmd1bGFyaGFsZm1vZAd1bmkyMDIzE3RvbmViYXJleHRyYWhpZ2htb2QOdG9uZWJhcmhpZ2htb2QNdG9uZWJhcm1pZG1vZA10b25lYmFybG93bW9k` +
-	`EnRvbmViYXJleHRyYWxvd21vZAd1bmkwMkVBB3VuaTAyRUIHdW5pMDJGMQd1bmkwMkYyB3VuaTAyRjMHdW5pMDJGMAd1bmkwMkVGB3VuaTAyRkQHdW5pMDJGRQd1bmkwMkY5B3VuaTAyRkEHdW5pMD` +
-	`JGQgd1bmkwMkZDB3VuaTAyRjgHdW5pMDJGRgd1bmkwMkY3B3VuaTAyRjYHdW5pMDJGNQd1bmkwMkY0B3VuaTAzNUYHdW5pMDM1RAd1bmkwMzVFFmJyZXZlaW52ZXJ0ZWRkb3VibGVjbWIOdGlsZGVk` +
-	`b3VibGVjbWIHdW5pMDM1Qwd1bmkwMzYyB3VuaTAzNUILWWF0Y3lyaWxsaWMLeWF0Y3lyaWxsaWMRWXVzbGl0dGxlY3lyaWxsaWMReXVzbGl0dGxlY3lyaWxsaWMMRml0YWN5cmlsbGljDGZpdGFjeX` +
-	`JpbGxpYw9JemhpdHNhY3lyaWxsaWMPaXpoaXRzYWN5cmlsbGljF0l6aGl0c2FkYmxncmF2ZWN5cmlsbGljF2l6aGl0c2FkYmxncmF2ZWN5cmlsbGljB3VuaTIwNTgHdW5pMjA1Qgd1bmkyMDVBDXJl` +
-	`ZmVyZW5jZW1hcmsHdW5pMjA1Qwd1bmkyMDU3B3VuaTIwNTYHdW5pMjA1OQd1bmkyMDVEB3VuaTIwNUUIYXN0ZXJpc20HdW5pMjExNwd1bmkyMTNBB3VuaTIxMjkHdW5pMjEwMAd1bmkyMTAxBmNhcm` +
-	`VvZgd1bmkyMTA2CmNlbnRpZ3JhZGUKZmFocmVuaGVpdAlsb2dpY2Fsb3IKbG9naWNhbGFuZAxwcm9wb3J0aW9uYWwHZGl2aWRlcwltaW51c3BsdXMJY29wcm9kdWN0B3VuaTIyMTQNZGl2aXNpb25z` +
-	`bGFzaAd1bmkyMjE2BXJhdGlvCnByb3BvcnRpb24MYXN0ZXJpc2ttYXRoB3VuaTIyMTgOYnVsbGV0b3BlcmF0b3ILZGJsaW50ZWdyYWwNdGlsZGVvcGVyYXRvcg1yZXZlcnNlZHRpbGRlCXRoZXJlZm` +
-	`9yZQdiZWNhdXNlB3VuaTIyNDALZXF1aXZhbGVuY2UTYXN5bXB0b3RpY2FsbHllcXVhbAd1bmkyMjQyEmFwcHJveGltYXRlbHllcXVhbAtFemhyZXZlcnNlZAd1bmkwMjIwA2V6aAtlemhyZXZlcnNl` +
-	`ZAhlemhjYXJvbghFemhjYXJvbgRsZXpoB3VuaTFEMDAHdW5pMUQwMQd1bmkxRDAyB3VuaTFEMDQHdW5pMUQwNQd1bmkxRDA2B3VuaTFEMDcHdW5pMUQwOQd1bmkxRDBBB3VuaTFEMEIHdW5pMUQwQw` +
-	`d1bmkxRDBEB3VuaTFEMEUHdW5pMUQwRgd1bmkxRDE0B3VuaTFEMTgHdW5pMUQxOQd1bmkxRDFBB3VuaTFEMTAHdW5pMUQxQgd1bmkxRDFDB3VuaTFEMjAHdW5pMUQyMQd1bmkxRDIyB3VuaTFEMjMH` +
-	`dW5pMUQyNwd1bmkxRDI2B3VuaTFEMjkHdW5pMUQyQwd1bmkxRDJEB3VuaTFEMDMHdW5pMUQyRgd1bmkxRDMwB3VuaTFEMzEHdW5pMUQzMgd1bmkxRDJFB3VuaTFEMzMHdW5pMUQzNAd1bmkxRDM1B3` +
-	`VuaTFEMzYHdW5pMUQzNwd1bmkxRDM4B3VuaTFEMzkHdW5pMUQzQQd1bmkxRDNCB3VuaTFEM0MHdW5pMUQzRQd1bmkxRDNGB3VuaTFENDAHdW5pMUQ0MQd1bmkxRDQyB3VuaTFEN0EHdW5pMUQ2Qgd1` +
-	`bmkxRDZDB3VuaTFENkQHdW5pMUQ2RQd1bmkxRDcyB3VuaTFENzMHdW5pMUQ3NQd1bmkxRDc2B3VuaTFENzcFQWxwaGEEQmV0YQVHYW1tYQpEZWx0YWdyZWVrB0Vwc2lsb24EWmV0YQNFdGEFVGhldG` +
-	`EESW90YQVLYXBwYQZMYW1iZGECTXUCTnUCWGkHT21pY3JvbgJQaQNSaG8FU2lnbWEDVGF1B1Vwc2lsb24DUGhpA0NoaQNQc2kHdW5pMUQ2Rgd1bmkxRDcwB3VuaTFENzEHdW5pMUQyQQd1bmkxRDI4` +
-	`B3VuaTFEMUYHdW5pMUQxRAd1bmkxRDJCB3VuaTFEMTEHdW5pMUQxMgd1bmkxRDEzB3VuaTAyMjIHdW5pMUQxNQd1bmkxRDNEB3VuaTFEMUUHdW5pMUQxNgd1bmkxRDE3B3VuaTFENDUHdW5pMUQ0Ng` +
-	`d1bmkxRDQ3B3VuaTFENDgHdW5pMUQ0OQd1bmkxRDRBB3VuaTFENEQHdW5pMUQ0RQd1bmkxRDRGB3VuaTFENTAHdW5pMUQ1MQd1bmkxRDUyB3VuaTFENTMHdW5pMUQ1NAd1bmkxRDU1B3VuaTFENTYH` +
-	`dW5pMUQ1Nwd1bmkxRDU4B3VuaTFENTkHdW5pMUQ1QQd1bmkxRDVCB3VuaTFENjIHdW5pMUQ2Mwd1bmkxRDY0B3VuaTFENjUHdW5pMUQ3NAd1bmkxRDc4B3VuaTFEN0UHdW5pMUQ3Rgd1bmkxRDdCB3` +
-	`VuaTFEN0MHdW5pMUQ3RAtQc2ljeXJpbGxpYwtwc2ljeXJpbGxpYw1FbmdoZWN5cmlsbGljDWVuZ2hlY3lyaWxsaWMHdW5pMDRDNQd1bmkwNEM2B3VuaTA0QzkHdW5pMDRDQQ5ZdXNiaWdjeXJpbGxp` +
-	`Yw55dXNiaWdjeXJpbGxpYwVFb3Blbg1lb3BlbnJldmVyc2VkBWVvcGVuEWVvcGVucmV2ZXJzZWRob29rB3VuaUE3MTIHdW5pQTcxMwd1bmlBNzE0B3VuaUE3MTUHdW5pQTcxNgd1bmlBNzA4B3VuaU` +
-	`E3MEEHdW5pQTcwOQd1bmlBNzBCB3VuaUE3MEMHdW5pQTcwRAd1bmlBNzBFB3VuaUE3MEYHdW5pQTcxMAd1bmlBNzExBXVuaW9uDGludGVyc2VjdGlvbgZzdWJzZXQIc3VwZXJzZXQNc3Vic2V0b3Jl` +
-	`cXVhbA9zdXBlcnNldG9yZXF1YWwHdW5pMjI4OAd1bmkyMjg5CW5vdHN1YnNldAtub3RzdXBlcnNldAd1bmlBNzAwB3VuaUE3MDEHdW5pQTcwMgd1bmlBNzAzB3VuaUE3MDQHdW5pQTcwNQd1bmlBNz` +
-	`A2B3VuaUE3MDcXdGhyZWVfc2xhc2gudGVybWluYWwuNTIWZml2ZV9zbGFzaC50ZXJtaW5hbC41MhdzZXZlbl9zbGFzaC50ZXJtaW5hbC41MhZyaWdodGFycm93LnRlcm1pbmFsLjUyFWRvd25hcnJv` +
-	`dy50ZXJtaW5hbC41MhBIX1QudGVybWluYWwuMTAwEExfRi50ZXJtaW5hbC4xMDAQVl9ULnRlcm1pbmFsLjEwMBBGX0YudGVybWluYWwuMTAwEENfUi50ZXJtaW5hbC4xMDAQTl9MLnRlcm1pbmFsLj` +
-	`EwMBVzY2FubGluZTAudGVybWluYWwuNTIVc2NhbmxpbmUxLnRlcm1pbmFsLjUyFXNjYW5saW5lMi50ZXJtaW5hbC41MhVzY2FubGluZTMudGVybWluYWwuNTIVc2NhbmxpbmU0LnRlcm1pbmFsLjUy` +
-	`FXNjYW5saW5lNS50ZXJtaW5hbC41MhVzY2FubGluZTYudGVybWluYWwuNTIVc2NhbmxpbmU3LnRlcm1pbmFsLjUyFnNjYW5saW5lMS50ZXJtaW5hbC4xMDAWc2NhbmxpbmUzLnRlcm1pbmFsLjEwMB` +
-	`ZzY2FubGluZTUudGVybWluYWwuMTAwFnNjYW5saW5lNy50ZXJtaW5hbC4xMDAWc2NhbmxpbmU5LnRlcm1pbmFsLjEwMBxib3hlbGVtZW50LnZlcnQudGVybWluYWwuMTAwGmJveGVsZW1lbnQudWwu` +
-	`dGVybWluYWwuMTAwGmJveGVsZW1lbnQudXIudGVybWluYWwuMTAwGmJveGVsZW1lbnQubGwudGVybWluYWwuMTAwGmJveGVsZW1lbnQubHIudGVybWluYWwuMTAwGmJveGVsZW1lbnQubWwudGVybW` +
-	`luYWwuMTAwGmJveGVsZW1lbnQubXIudGVybWluYWwuMTAwGmJveGVsZW1lbnQubXQudGVybWluYWwuMTAwGmJveGVsZW1lbnQubWIudGVybWluYWwuMTAwHWJveGVsZW1lbnQuY3Jvc3MudGVybWlu` +
-	`YWwuMTAwF2Jsb2NrLmJsYWNrLnRlcm1pbmFsLjUyFGxvemVuZ2UudGVybWluYWwuMTAwGWNoZWNrZXJib2FyZC50ZXJtaW5hbC4xMDALVmFyaWFudFRhZzEAAgADAAgAAAAEAAQAYAAAAAD/gAAAC+` +
-	`QAAAfgBk1vbmFjbwD/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////////////////////////////////0wETAQAYAAAAAf/gAAAE8QAAAksCU1vbmFjbyBDWQD/////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////////////////////////////////////////////////////////////////////////////////////////////////////3gEeAQAYAAAAB3/gAAAHPAAAAgACU1vbmFj` +
-	`byBDRQD///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`/////////////////////////////////wH1AGAAAAAd/4AACQAAJPAAAAmMAAD///////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`/////////////////////////////////////////////////////////////////////////////////////////////////////wWDAGAAAAAA/4AADgAALnwAAA9MCU1vbmFjbyAxNAD///////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////xP4AGAAAAAH/4AACQAAPcgAAAmkAAD/////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////////////////////////////////////////////////////////////////////////xwIAGAAAAAd/4AADAAAR2wAAAvAAAD/////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`/////zO2AGAAAAAH/4AADAAAUywAAAvgAAD///////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`/////////////////////////////////////////////////////////////////////////z6wAGAAAAAA/4AADAAAXwwAAAvgCU1vbmFjbyAxMgD///////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////2pIAGAAAA` +
-	`AH/4AACgAAauwAAApUAAD/////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////////////////////////////////////////////3BXAGAAAAAA/4AACQAAdUAAAAl4CE1vbmFjbyA5AP//////////////////////////////////////////////////` +
-	`//////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////` +
-	`///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////wAAAAEAADC0TW9uYWNvAAAA` +
-	`YangAGGsQAECOgC//8ggJASAQgAG+6iT1kF6AAAAAAAAAA8AAAAAAAAAAAAAAAAAAAAPk9ZwAKGxAAAA/xAA/AABVgwgAAAFkAAAAAAAAABgAAAAAAAAAAAAAAAAAAAAAMHTAAAAAAADAAMAAAAAML` +
-	`QACQAAcFcADAAAPrAADgAABYMAAAAAAAAAAAAAAAD8Hgn8EAAABwAAAEYAAABGAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAAEGTW9uYWNvAAAAAICACUFk` +
-	`aWVyZXNpc4EFQXJpbmeCCENjZWRpbGxhgwZFYWN1dGWEBk50aWxkZYUJT2RpZXJlc2lzhglVZGllcmVzaXOHBmFhY3V0ZYgGYWdyYXZliQthY2lyY3VtZmxleIoJYWRpZXJlc2lziwZhdGlsZGWMBW` +
-	`FyaW5njQhjY2VkaWxsYY4GZWFjdXRljwZlZ3JhdmWQC2VjaXJjdW1mbGV4kQllZGllcmVzaXOSBmlhY3V0ZZMGaWdyYXZllAtpY2lyY3VtZmxleJUJaWRpZXJlc2lzlgZudGlsZGWXBm9hY3V0ZZgG` +
-	`b2dyYXZlmQtvY2lyY3VtZmxleJoJb2RpZXJlc2lzmwZvdGlsZGWcBnVhY3V0ZZ0GdWdyYXZlngt1Y2lyY3VtZmxleJ8JdWRpZXJlc2lzoAZkYWdnZXKhBmRlZ3JlZaIEY2VudKMIc3RlcmxpbmekB3` +
-	`NlY3Rpb26lBmJ1bGxldKYJcGFyYWdyYXBopwpnZXJtYW5kYmxzqApyZWdpc3RlcmVkqQljb3B5cmlnaHSqCXRyYWRlbWFya6sFYWN1dGWsCGRpZXJlc2lzrQhub3RlcXVhbK4CQUWvBk9zbGFzaLAI` +
-	`aW5maW5pdHmxCXBsdXNtaW51c7IJbGVzc2VxdWFsswxncmVhdGVyZXF1YWy0A3llbrUCbXW2C3BhcnRpYWxkaWZmtwlzdW1tYXRpb264B3Byb2R1Y3S5AnBpughpbnRlZ3JhbLsLb3JkZmVtaW5pbm` +
-	`W8DG9yZG1hc2N1bGluZb0FT21lZ2G+AmFlvwZvc2xhc2jADHF1ZXN0aW9uZG93bsEKZXhjbGFtZG93bsIKbG9naWNhbG5vdMMHcmFkaWNhbMQGZmxvcmluxQthcHByb3hlcXVhbMYFRGVsdGHHDWd1` +
-	`aWxsZW1vdGxlZnTIDmd1aWxsZW1vdHJpZ2h0yQhlbGxpcHNpc8oMbm9icmVha3NwYWNlywZBZ3JhdmXMBkF0aWxkZc0GT3RpbGRlzgJPRc8Cb2XQBmVuZGFzaNEGZW1kYXNo0gxxdW90ZWRibGxlZn` +
-	`TTDXF1b3RlZGJscmlnaHTUCXF1b3RlbGVmdNUKcXVvdGVyaWdodNYGZGl2aWRl1wdsb3plbmdl2Al5ZGllcmVzaXPZCVlkaWVyZXNpc9oIZnJhY3Rpb27bBEV1cm/cDWd1aWxzaW5nbGxlZnTdDmd1` +
-	`aWxzaW5nbHJpZ2h03gJmad8CZmzgCWRhZ2dlcmRibOEOcGVyaW9kY2VudGVyZWTiDnF1b3Rlc2luZ2xiYXNl4wxxdW90ZWRibGJhc2XkC3BlcnRob3VzYW5k5QtBY2lyY3VtZmxleOYLRWNpcmN1bW` +
-	`ZsZXjnBkFhY3V0ZegJRWRpZXJlc2lz6QZFZ3JhdmXqBklhY3V0ZesLSWNpcmN1bWZsZXjsCUlkaWVyZXNpc+0GSWdyYXZl7gZPYWN1dGXvC09jaXJjdW1mbGV48AVhcHBsZfEGT2dyYXZl8gZVYWN1` +
-	`dGXzC1VjaXJjdW1mbGV49AZVZ3JhdmX1CGRvdGxlc3Np9gpjaXJjdW1mbGV49wV0aWxkZfgGbWFjcm9u+QVicmV2ZfoJZG90YWNjZW50+wRyaW5n/AdjZWRpbGxh/QxodW5nYXJ1bWxhdXT+Bm9nb2` +
-	`5la/8FY2Fyb24AAAAAAAAAAAAAAAmaCZoJmgmaCZoJmgAACZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaAAAJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgma` +
-	`CZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmg` +
-	`maCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJ` +
-	`mgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZ` +
-	`oJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaAAAAAAAAAAEAADC0TW9uYWNvAAAAYangAGGsQAEC` +
-	`OgC//8ggJASAQgAG+6iT1kF6AAAAAAAAAA8AAAAAAAAAAAAAAAAAAAAPk9ZwAKGxAAAA/xAA/AABVgmaAAAG3AAAAAAAAABgAAAAAAAAAAAAAAAAAAAAAMHTAAAAAAADAAMAAAAAMLQACQAAE/gACg` +
-	`AAakgADAAAM7YAAAAAAAAAAAAA//z8XAm4EAACBQAAAEYAAABGAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAAEITW9uYWNvQ1kAAICACUFjeXJpbGxpY4EK` +
-	`QmVjeXJpbGxpY4IKVmVjeXJpbGxpY4MKR2VjeXJpbGxpY4QKRGVjeXJpbGxpY4UKSWVjeXJpbGxpY4YLWmhlY3lyaWxsaWOHClplY3lyaWxsaWOICklpY3lyaWxsaWOJD0lpc2hvcnRjeXJpbGxpY4` +
-	`oKS2FjeXJpbGxpY4sKRWxjeXJpbGxpY4wKRW1jeXJpbGxpY40KRW5jeXJpbGxpY44JT2N5cmlsbGljjwpQZWN5cmlsbGljkApFcmN5cmlsbGljkQpFc2N5cmlsbGljkgpUZWN5cmlsbGljkwlVY3ly` +
-	`aWxsaWOUCkVmY3lyaWxsaWOVC0toYWN5cmlsbGljlgtUc2VjeXJpbGxpY5cLQ2hlY3lyaWxsaWOYC1NoYWN5cmlsbGljmQ1TaGNoYWN5cmlsbGljmhBIYXJkc2lnbmN5cmlsbGljmwxZZXJpY3lyaW` +
-	`xsaWOcEFNvZnRzaWduY3lyaWxsaWOdEUVyZXZlcnNlZGN5cmlsbGljngpJVWN5cmlsbGljnwpJQWN5cmlsbGljoAZkYWdnZXKhBmRlZ3JlZaIRR2hldXB0dXJuY3lyaWxsaWOjCHN0ZXJsaW5npAdz` +
-	`ZWN0aW9upQZidWxsZXSmCXBhcmFncmFwaKcJSWN5cmlsbGljqApyZWdpc3RlcmVkqQljb3B5cmlnaHSqCXRyYWRlbWFya6sLRGplY3lyaWxsaWOsC2RqZWN5cmlsbGljrQhub3RlcXVhbK4LR2plY3` +
-	`lyaWxsaWOvC2dqZWN5cmlsbGljsAhpbmZpbml0ebEJcGx1c21pbnVzsglsZXNzZXF1YWyzDGdyZWF0ZXJlcXVhbLQJaWN5cmlsbGljtQJtdbYRZ2hldXB0dXJuY3lyaWxsaWO3CkplY3lyaWxsaWO4` +
-	`CUVjeXJpbGxpY7kJZWN5cmlsbGljugpZaWN5cmlsbGljuwp5aWN5cmlsbGljvAtMamVjeXJpbGxpY70LbGplY3lyaWxsaWO+C05qZWN5cmlsbGljvwtuamVjeXJpbGxpY8AKamVjeXJpbGxpY8ELRH` +
-	`plY3lyaWxsaWPCCmxvZ2ljYWxub3TDB3JhZGljYWzEBmZsb3JpbsULYXBwcm94ZXF1YWzGBURlbHRhxw1ndWlsbGVtb3RsZWZ0yA5ndWlsbGVtb3RyaWdodMkIZWxsaXBzaXPKDG5vYnJlYWtzcGFj` +
-	`ZcsMVHNoZWN5cmlsbGljzAx0c2hlY3lyaWxsaWPNC0tqZWN5cmlsbGljzgtramVjeXJpbGxpY88LZHplY3lyaWxsaWPQBmVuZGFzaNEGZW1kYXNo0gxxdW90ZWRibGxlZnTTDXF1b3RlZGJscmlnaH` +
-	`TUCXF1b3RlbGVmdNUKcXVvdGVyaWdodNYGZGl2aWRl1wxxdW90ZWRibGJhc2XYDlVzaG9ydGN5cmlsbGlj2Q51c2hvcnRjeXJpbGxpY9oMRHpoZWN5cmlsbGlj2wxkemhlY3lyaWxsaWPcBm51bWVy` +
-	`b90KSW9jeXJpbGxpY94KaW9jeXJpbGxpY98KaWFjeXJpbGxpY+AJYWN5cmlsbGlj4QpiZWN5cmlsbGlj4gp2ZWN5cmlsbGlj4wpnZWN5cmlsbGlj5ApkZWN5cmlsbGlj5QppZWN5cmlsbGlj5gt6aG` +
-	`VjeXJpbGxpY+cKemVjeXJpbGxpY+gKaWljeXJpbGxpY+kPaWlzaG9ydGN5cmlsbGlj6gprYWN5cmlsbGlj6wplbGN5cmlsbGlj7AplbWN5cmlsbGlj7QplbmN5cmlsbGlj7glvY3lyaWxsaWPvCnBl` +
-	`Y3lyaWxsaWPwCmVyY3lyaWxsaWPxCmVzY3lyaWxsaWPyCnRlY3lyaWxsaWPzCXVjeXJpbGxpY/QKZWZjeXJpbGxpY/ULa2hhY3lyaWxsaWP2C3RzZWN5cmlsbGlj9wtjaGVjeXJpbGxpY/gLc2hhY3` +
-	`lyaWxsaWP5DXNoY2hhY3lyaWxsaWP6EGhhcmRzaWduY3lyaWxsaWP7DHllcmljeXJpbGxpY/wQc29mdHNpZ25jeXJpbGxpY/0RZXJldmVyc2VkY3lyaWxsaWP+Cml1Y3lyaWxsaWP/BEV1cm8AAAAA` +
-	`AAAAAAAACZoJmgmaCZoJmgmaAAAJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoAAAmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmg` +
-	`maCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJ` +
-	`mgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZ` +
-	`oJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgma` +
-	`CZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoAAAAAAAAAAQAAMLRNb25hY28AAABhqeAAYaxAAQI6AL//yCAkBIBCAAb7qJ` +
-	`PWQXoAAAAAAAAADwAAAAAAAAAAAAAAAAAAAA+T1nAAobEAAAD/EAD8AAFWDCAAAAWyAAAAAAAAAFoAAAAAAAAAAAAAAAAAAAAAwdMAAAAAAAMAAgAAAAAwtAAJAAAB9QAMAAAcCAAAAAAAAAAAAAAA` +
-	`APweCfwQAAIFAAAARgAAAEYBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEAAQhNb25hY29DRQAAgIAJQWRpZXJlc2lzgQdBbWFjcm9uggdhbWFjcm9ugwZFYW` +
-	`N1dGWEB0FvZ29uZWuFCU9kaWVyZXNpc4YJVWRpZXJlc2lzhwZhYWN1dGWIB2FvZ29uZWuJBkNjYXJvbooJYWRpZXJlc2lziwZjY2Fyb26MBkNhY3V0ZY0GY2FjdXRljgZlYWN1dGWPBlphY3V0ZZAG` +
-	`emFjdXRlkQZEY2Fyb26SBmlhY3V0ZZMGZGNhcm9ulAdFbWFjcm9ulQdlbWFjcm9ulgpFZG90YWNjZW50lwZvYWN1dGWYCmVkb3RhY2NlbnSZC29jaXJjdW1mbGV4mglvZGllcmVzaXObBm90aWxkZZ` +
-	`wGdWFjdXRlnQZFY2Fyb26eBmVjYXJvbp8JdWRpZXJlc2lzoAZkYWdnZXKhBmRlZ3JlZaIHRW9nb25la6MIc3RlcmxpbmekB3NlY3Rpb26lBmJ1bGxldKYJcGFyYWdyYXBopwpnZXJtYW5kYmxzqApy` +
-	`ZWdpc3RlcmVkqQljb3B5cmlnaHSqCXRyYWRlbWFya6sHZW9nb25la6wIZGllcmVzaXOtCG5vdGVxdWFsrgxnY29tbWFhY2NlbnSvB0lvZ29uZWuwB2lvZ29uZWuxB0ltYWNyb26yCWxlc3NlcXVhbL` +
-	`MMZ3JlYXRlcmVxdWFstAdpbWFjcm9utQxLY29tbWFhY2NlbnS2C3BhcnRpYWxkaWZmtwlzdW1tYXRpb264BmxzbGFzaLkMTGNvbW1hYWNjZW50ugxsY29tbWFhY2NlbnS7BkxjYXJvbrwGbGNhcm9u` +
-	`vQZMYWN1dGW+BmxhY3V0Zb8MTmNvbW1hYWNjZW50wAxuY29tbWFhY2NlbnTBBk5hY3V0ZcIKbG9naWNhbG5vdMMHcmFkaWNhbMQGbmFjdXRlxQZOY2Fyb27GBURlbHRhxw1ndWlsbGVtb3RsZWZ0yA` +
-	`5ndWlsbGVtb3RyaWdodMkIZWxsaXBzaXPKEG5vbmJyZWFraW5nc3BhY2XLBm5jYXJvbswNT2h1bmdhcnVtbGF1dM0GT3RpbGRlzg1vaHVuZ2FydW1sYXV0zwdPbWFjcm9u0AZlbmRhc2jRBmVtZGFz` +
-	`aNIMcXVvdGVkYmxsZWZ00w1xdW90ZWRibHJpZ2h01AlxdW90ZWxlZnTVCnF1b3RlcmlnaHTWBmRpdmlkZdcHbG96ZW5nZdgHb21hY3JvbtkGUmFjdXRl2gZyYWN1dGXbBlJjYXJvbtwNZ3VpbHNpbm` +
-	`dsbGVmdN0OZ3VpbHNpbmdscmlnaHTeBnJjYXJvbt8MUmNvbW1hYWNjZW504AxyY29tbWFhY2NlbnThBlNjYXJvbuIOcXVvdGVzaW5nbGJhc2XjDHF1b3RlZGJsYmFzZeQGc2Nhcm9u5QZTYWN1dGXm` +
-	`BnNhY3V0ZecGQWFjdXRl6AZUY2Fyb27pBnRjYXJvbuoGSWFjdXRl6wZaY2Fyb27sBnpjYXJvbu0HVW1hY3Jvbu4GT2FjdXRl7wtPY2lyY3VtZmxlePAHdW1hY3JvbvEFVXJpbmfyBlVhY3V0ZfMFdX` +
-	`Jpbmf0DVVodW5nYXJ1bWxhdXT1DXVodW5nYXJ1bWxhdXT2B1VvZ29uZWv3B3VvZ29uZWv4BllhY3V0ZfkGeWFjdXRl+gxrY29tbWFhY2NlbnT7Clpkb3RhY2NlbnT8BkxzbGFzaP0KemRvdGFjY2Vu` +
-	`dP4MR2NvbW1hYWNjZW50/wVjYXJvbgAAAAAAAAAAAAmaCZoJmgmaCZoJmgmaAAAJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaAAAJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZ` +
-	`oJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgma` +
-	`CZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmg` +
-	`maCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJ` +
-	`mgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaCZoJmgmaAACQAAAAAP8ABv////cABgALA7` +
-	`wACQACAAAAPwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAK+AQClAAUAAgAEBQB8BAAAAAFAAAAAcAAAAAASAAcAAAAAABCAAgAAoAAAAVoPgAAAAACAo` +
-	`AAACgACAJQVQPiIAggFAAAgAIAAKAAAQAAAAAAAAAAAAAAAAABwAAAAAAAAAAAAAAAAAAAAHDIBAAAAAAAAAAAAAAAAAAAAHAAAAgAACAIBRBCIIiCAABAIBoiKAGAMiB5ngAAAAQAAAAAACKEABAA` +
-	`EQAAAUqxQAAAAAAEEQAUABAFEEQgYigEUBRCKKABBAAAAEANU57JhAAAuXOF933OAQQ6LvO9/7owxhGLvO878YxjH8hUAgQAEBghMIAAAAACAAAAAKgOd/7jokAcojiE+T0L//yEUqxPkUp+SSCqYT` +
-	`PQAAiozxT6hSsJEEQAJEAAAI5ynABVUAP+J4YJ4DgCOI76r5Iud+sVRVEESQ/Ajg8N/WsqVSAAx4xNCAYx8gJGMYxjCEYwyhvMYxjEkYxVEMhiAQQAECAgEIAAAAACAAAAAKgRiCEUYgAiAEQACCIN` +
-	`AEAAAAAIAB5gQo6sbUlwAICJISJKhU0JkGQDBkgAABGIIgClkhARBEkBEEQARBEiIIIxiCMSRBEEQQDAEQkIVS1ELiABTQhV7wYx9PkGsYwjCEIw0hWsYxjAkYxJEURAAH+c93P9Mr1nefZ/xjWMfq` +
-	`gRi+EUYveg90HOF+M5B0HOc50YOimg8l+sq7VXB96qFUMjhQkJW1QCtUgAAtGLogCqoCnRtFCtG0AHwfEnIX4xjGMYxjGMYyFT8AkI+5SENfnxVRGkGInRCACLv/Qj70/w4hGcfR84kVRIokRAAIxj` +
-	`GKRjNKuYxjmCRjVURSW//H30YxihGMIxIKNJ6PoxjGMfRig8QT98rcQIgSKpJYUShQkJPM/kzNSZAzGMY/8AD8Y+z6Bz7LgIOh8iIgoxjGMYxjGKo0JgUwkIUVtUJCACZSB+GJIvBPkS0YwjCEY40h` +
-	`GMYRiEkVVIREJAAIxhH6RjPKsYxjByRVUkSKrRjGEUYxihGEIfQSNJD8I/jGMYfiggQL8sa7QPh+KoxUkmhQkJGMQ0jFUkgjGMYgAAACoxhFChGAQHBdEiJBIxjGMYxjGJI8RAkQkABWsiQCACRURF` +
-	`GJIh8gICEYxjCEY4yhGMYRjEkSbUSEJAAJxhGCRjMqsYxjAKTVVUUKgRjOEUYzmjOEYQgiNJCEIwjGM4QmAgRk4sYUgIAiKoBSlKhQkJGMQojGMkgjGMYgAAAhIxhEkhGEQAxDEiKCIxjOMZxnGZIy` +
-	`hBEQkIA6TRgAIMOfuE5xHO8QQSMfO9+Do3R/GLoOi4jiRiT8FB+G+e96PjMesXefDxtIqL/qgRi38TnNac17nv//059/3Pc5zfvaA++iAtnjAHgB6r9Vb6/fn5WsQIjH6ZVi5znAAAAAHRhEYhWjlf` +
-	`O9Ehr/3Oc1zmua5pH1//7g8AAQAAAAIEAAAAAAAAEAABwAAAAAAAAAAAABAAAAAAAGHAAAAAAABAIAAAQCAAAAAAQHAAAAAIAABAAAAAAAAAAAAAAAAAAAABAcAEAAABAANAAEAAIgAAQgAAAAAAAA` +
-	`AAAAAAAAAAAAAAQgFQAAAAAAAAAAAAAAQgAkAABAAAAAAAAAQAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAOAwAAAQCAAAAADgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAgByg` +
-	`AIAARAAAhAAAAAAAAAAAAAAAAAAAAAAAhAKgAAAAAAAAAAAAAAIQHIAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAEABAAJAA4AEwAYABkAHAAfACQAKQArADAAMQA1ADoAPABBAEYASwBQAFUAWgBfAGQAZgBoAGwAcQB1AHoAfwCEAIkAjgCTAJgAnQCiAKcAqACtALIAtwC8AMEAxgDLANAA1QDaAN8A5ADpAO4A8w` +
-	`D4AP0A/wEEAQYBCwERARQBGQEeASMBKAEtATEBNgE7ATwBPwFEAUYBSwFQAVUBWgFfAWQBaQFtAXIBdwF8AYEBhgGLAY4BjwGSAZcBlwGcAaEBpgGrAbEBtgG7AcABxgHLAdAB1QHaAd8B5AHpAe4B` +
-	`8wH1AfsCAAIFAgoCDwIUAhkCHgIjAigCLQIyAjcCOgI+AkQCSgJPAlQCWQJeAmQCagJwAnUCeQJ+AoMChQKHAooCjQKQApMCmAKcAqECpAKpAqsCsAKzArgCuwLAAsUCygLPAtQC2QLeAuMC6ALtAv` +
-	`IC8gL3AvwDAQMGAwsDDgMUAxgDHAMeAyADJQMqAy8DNAM5Az4DQgNGA0sDUANVA1oDXANgA2UDagNvA3QDeQN+A4ADhQOKA48DlAOZA54DowOoA60DsgO3A7wDwQPGA8sD0APVA9oD3wPkA+cD7QEA` +
-	`AQABAAEAAQABAP///////wcG////////AQD///////////////////////////////////////////////8HBgMGAgYBBgEGAQYBBgMGAgYDBgEGAQYCBgEGAwYCBgEGAgYBBgEGAQYBBgEGAQYBBg` +
-	`EGAwYCBgIGAQYCBgEGAQYBBgEGAQYBBgEGAQYBBgEGAwYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAwYBBgMGAgYBBgIGAQYBBgEGAQYBBgIGAQYBBgMGAQYBBgMGAQYBBgEGAQYB` +
-	`BgEGAQYCBgEGAQYBBgEGAQYBBgMGAwYCBgEGAQABBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYDBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAgYCBgEGAQYBBgEGAQYBBgEGAQ` +
-	`YBBgEGAgYBBgEGAgYCBgIGAgYDBgIGAQYCBgEGAgYBBgMGAQYDBgEGAwYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAAYBBgEGAQYBBgEGAgYBBgIGAgYDBgMGAQYBBgEGAQYBBgEGAgYCBgEGAQYBBgEG` +
-	`AgYCBgEGAQYBBgEGAQYCBgMGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgIFAQb//5AAAAAA/wAIAAD//QAJABEGmwAOAAMAAABUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACNAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQGjQAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAQIEAICEAgQQAgIIgAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAkEAELJJAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIFiwAAAAAAAAAAACQAAAAAAAAAAAoUIJEEKSQgoAQQUQAAAAAAAAAAAIAAAGAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAeA8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAA7gAAKAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAA0ScwjCJBAAAAThh7wE/PfvHAAAABw8GPj/P/z4fnhIQYTPjHj3+FBSUJBfxAEQAQCAAEAeBAQMHAAAAAAAAAAAAAAABEQAYEH/wmQhIBAGjg` +
-	`AiBABIEA0IgIA0EgIARhD3gADh4eeSAADmgAAAAQQBz8AADPccAAAAABhwAAAAAMDBh8AAACPc8AIAEEAgAADh5AASAx+Mf//+/8wwAZDDCAmifiiv4A0SrJEiQlQAAAUSiEIMgQAwogAAACJCGIUI` +
-	`oIEISBiIY8UoUkUAiFBSSRBAxAEQAICAAEAgBAAEBAAAAAAACAAAAAAABEQAYEIQYpQiECkligBBCkiEKSsQQUksIQUkST0IAH+SEhL0kACJAAAAAQQAaD+AFRiiAAAAABCAAYAAAMDCSgAAAEXdcA` +
-	`IJEEAgAAECJAASQxAMQQEIIRJIAlDDCBV9ulBI4A0SrJEigThAAAoMgEIUggBQwQAwMCGBGIYIYIIISBkIY8YYYUUAiFBSSQiBQgEoAACAAEAgBAAEBAAAAAAACAAAAAAABEQAYEQQYwwgAAAADgAA` +
-	`AAAAAAAAAAAAAAAASVUIAPUT0vLwACGRgAgDAIgANBEAERjjAAAgABEAAYAAAMDEMgAAAIhGEAUACIBB8AECJAASgxAMQQEIISGEJDDDCAAADgAI4A3/pKEggThAAAoYgEIkggCQwfDADAGdJIYIYI` +
-	`IISBkIY6YYYUUAiEiVRgUBQQEoAA+4884gfdx8RLdxlx93iIUFJRIX5EQAkKQQUwwnnnjzzzjjjjuc45cYYYYZDDDD9pEMHvUlTQqQAEGSgAgMwFEJ9BF/kTjBNjokACEAA4AAASEkMh2AAO6LoQiQ` +
-	`hQCCCAECPwANBJASQQEIISGERDDDDwAAAAAI4AgkY0FBANRAABIogIQk8vCJQfMPwwKlJIYIYIIISBoIVaYYYUiAiEiVRgUCQQFEABHFBFH8jiQMhNuKWKOYf4UFJKIQJEQAkKQQUwwoookUUUUUUU` +
-	`SEIJikkkklDDDCQJELP/VFTQqQD+KSgAgwMFEKEhERENdBSUQAACfGEsb2ASEkMiJAAO8TwAiQhQCEAMf/pAACBJASQQEIISGFtDDDCQAAAAAI4AgkMECJAJP5/CJIgRxECwkGQwwAAMSlJPoIf/4P` +
-	`yBwIVaYYoXBgiEiVRgICQIFEACGGCGEhDCQNBJMMMMMYCISJVKEQWENkkRQf0wwwwwoYYYIYYYSEIJDDDDDDDDDCQJPov3VFjQgAAQL0s3/ADCEMEhEREABBiYokACEJksSSASEkM+J//wAAD/BIgg` +
-	`EP8eESJAAEBJ+Sf/kIISGH/DDDCQAAAAAI4AgkKLFJAIBAACKIggKEBgkJPQMPwwilQoYIYII4SBoIVZYfIVAQiEUNhgIEQIGCACGGCH8hDCQOBJMMMMMGCISJVEEghES1CRQQSwwwwwoYYYP///yE` +
-	`IJDDDDDDDDDCQJEIfzU1jQgAD+SUzIgwMPkMEREREf/Bj5IkBiEIZG2bAhIUMiPAAAAAAAiJAgIEAzESJMAOyFAhQQEIISGH9DDDCQAAAAAI4Aj/KUopAIBAAEMIhAH/BgoQgQDADAieQoYIYIIYSB` +
-	`kISZYYIUgIiEUIiQIIQEEAACGGCGAhDCQOBJMMMMMBiISJVEChBESZCRQQSwwwwwoYYYIIIICEIJDDDDDDDDDCQFEEfxUdTQgABAeUzIgMwCEMEhEREABBiJIn/kEGFGkJAhIUMiIAAAAAAQiFAgIP` +
-	`5AkSJMAZKFAhQQEIISGH9DDDCQAAAAAI4AhIqUoQgQBAAEIIiAEEBgoQggAwMAiAfoYIYIIYSBiISY4YIUgIiEUIiQIIQEEAACGGCGAhDCQNBJMMMMMASIRRVKDCBEQB+/wQRwwwwwoYYYIIIICEIJ` +
-	`DDDDDDDDDCQD0DXhULUvAACASYzIgDAPkMEhEREABjiKJECUEJlG2bA/P0MiIAAAAAAAUFAgQEAzESJAAJL9A/QQEIISGH9DDDCQAAAAAI4ABIqkoYgQAGA4EQiAIECRIQgvAAAABAgYUIoIEYSRhI` +
-	`QY0oEkQIiEIIkIIQQCEAACOJCNAhHCQMhJMKUUcISIxQiRBEBEQCBgoQRpQxxxo440UEEECEIJCkkkklHHHGQBEAwBUKMhAAAAiJMwAAACGcIhEREAAiWESECUEIaDSSBAwKSiIAAAAAAAICAggCCe` +
-	`ESJDtpMDBAwQEIIRJL+lDDCQAAAAAI4AhIdDHkQgAGA4Dn//wE8OIPPPAAAAg8gfj/P4D4f+gvwYTIDEXwh4IIkIIfwBEAAB3w908g7CcMR5MJnjsHh3QgiRBH5EQCBgn/wmPOuunXXTjzzzznOPCY` +
-	`YYYY6666QBPwQBVx4eAAAAj2AH///CFrxBEREAB3L7kEAUEAD/b2rAwJh93AAAAAAAICAggB8MESJDtm0D/A///+/8wx8Y888cAAAAAP4AAAIAAAJAACAAAAAAAAAAAAABAAAAgAAAAAAAAAAAAAAA` +
-	`AABAAAAAAAAAAAQAEB/AAAAAAACAAIAAAAEAIAAAAAAACABAQAAACAAAAAAAAAACAAAAAAAAAAAAAAAAAAQAAAQBQAAAAAAAAAAAAAAAEABBEAUAAAAAEMAIEAAAAAAAAAAAAAAAAAAAAEAAAAAAAA` +
-	`HwkgAAAAAAAAAAAAAAAAAAAAAQQAAAAAIAAAGAACAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAeA8AAAAAAAABEAAQAAAAEAIAAAAAAACAA7gAAABAAAAAAAAAABAAAAAAAAAAAA` +
-	`AAAAAAQAAPgBQAAAAAAAAAAAAAAAEACBEAIAAAAACMAIEAAAAAAAAAAAAAAAAAAAAEAAAAAAAABBJAAAAAAAAAAAAAAAAAAAAAAIQAAAAAAAAAAAAEAAAAAAAAAAAAACAAAAAAAAAAAAAAAAAAAAAA` +
-	`A4AAAAAAAAAAAAAAAAAAAAAA4ADgAAAAEAIAAAAAAAcAAAAAAACAAAAAAAAAACAAAAAAAAAAAAAAAAAAQAAAABQAAAAAAAAAAAAAAAAAD9EAAAAAAAB0AIYAAAAAAAAAAAAAAAAAAAA4AAAAAAAABC` +
-	`SAAAAAAAAAAAAAAAAAAAAAAQIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAEAAwAEQAaACIAIwAoAC0AMgA5AD` +
-	`sAQgBEAEoAUQBYAF4AZABsAHIAeQB/AIUAjACOAJAAmACeAKYArAC0ALwAwgDIAM4A1ADaAOAA5gDrAPAA+AD9AQQBCgEQARYBHQEjASkBMAE2AT8BRgFOAVcBXQFhAWgBbAFzAXoBfgGEAYoBkAGW` +
-	`AZwBowGpAa8BtAG5Ab8BxAHLAdEB1wHdAeMB6AHuAfQB+gIDAgoCEQIZAh8CJQImAiwCMgIyAjoCQQJHAk0CUwJZAl8CZQJrAnICeAJ+AoQCigKQApYCnAKiAqcCrAKyArcCvQLDAskCzwLVAtsC4Q` +
-	`LnAu0C8wL4AvwDAgMIAw4DFAMbAyEDKQMxAzgDPANAA0cDTwNVA10DZANrA3IDewOBA4gDjgOVA50DowOoA60DtAO8A8MDyQPKA9ED2QPgA+gD8AP2A/wEAQQBBAkEEQQXBB4EJgQtBDQEOwRCBEUE` +
-	`SARPBFYEXgRnBHAEeQR9BIEEiASPBJQElgSZBJ8EpwSvBLUEvQTDBMkEzgTUBNkE3gTkBOoE8QT3BP0FAwUJBQ4FEwUXBRoFHgUgBSMFJQUpBSsFMAU1AAD/////////////////////AAj///////` +
-	`8ACP///////////////////////////////////////////////wAIAwgDCAAIAQgACAAIBAgBCAIIAQgACAQIAQgDCAEIAAgBCAEIAQgACAEIAAgBCAEIAAgDCAMIAAgBCAAIAQgACAAIAQgBCAEI` +
-	`AQgBCAEIAQgBCAEIAQgCCAEIAQgBCAEIAQgBCAEIAAgBCAAIAAgACAAIAQgCCAEIAggACAAIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgACAEIAQgBCAEIAggBCAEIAQgACAAIAAgACAEIAggDCA` +
-	`EIAQgAAAAIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAEIAQgBCAIIAQgBCAEIAQgACAEIAAgACAAIAggCCAAIAAgBCAAIAAgACAEIAAgB` +
-	`CAAIAQgACAAIAggBCAEIAAgACAEIAAgECAAIAAgBCAAIAAgBCAEIAQgACAAIAAgBCAAIAAgACAAIAQgACAIIAggACAAIAAgACAAIAAgCCAIIAAgACAEIAwgDCAEIAAgACAEIAAgBCAEIAQgBCAEIAQ` +
-	`gBCAEIAAgBCAEIAQgBCAEIAggCCAIIAggDCAMIAwgDCAMIAggBCP//AACQAAAAAP8ABgAA//4ABgALA8cACQACAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAFAAAAAAAAAAAAAAAAAAAAEDgAAAAAACAAAAAAAFAAAAAAADAAAAAAIAAAAAAFAAABQAAAAAAAAAAAAAAAAAAAAAAAAAAAAOAQABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAABwAAAAAAAAIAAAAAAAAAAAAAAAAAAAYWRA8AAAAAQgAAAAAAAAAAAAAAQAAAAABBAAAAAAIAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAA5JjVOeyYQAALlzhfd9zg` +
-	`ABzned7/3RhjCMXed534xjGP+NAQIACAwQmEAAAAACAAAAAKmu97zv1dGMTxi7/nfiSMUa1YjB0jqfkkFfOe+QD0AAyAQuAqYBQBcABAAAAHkRIAAKrgCKogo+oAHAAAAAAUAAAAAAAAEAAAAAAAAA` +
-	`7iAN/WsqVSAAx4xNCAYxBBIxjGMYQjGGUN5jGMYkjGKohoaAggAIEBAIQAAAAAIAAAAAqtGEYShWMYyTuMYxiSLlRRrUiMI1T5iFHViEuhAoABEgBDECCgFACIBkCQAAIRIAABSyQIkiCiAAAgAAAA` +
-	`AAgAAAAAAAAQAAAAAAAAEeRQhVLUQuIAFFCFXvBjGr6D2MYRhCEYaQrWMYxgSMYkiKRAA/znu5/pl9Z3n2f0Y1jH6oEYRhKFQznRNYxjGBI1JFGtSIwDVKaOS/Xlq7vfj9khy8MHJKGUoYAE5xAAA7` +
-	`1IvAFVwAjGMeIOe/3vOdV0YxPGLv+d+LoxRrViMPS+4kGPuUhDX58UURpBiJ0RAEVf+hH3p/hxCM4+j5xIxiRRJEAEYxjFIxmmuYxjmCRjVURSQf96EvONWuEx/GPoEfUkT61O75/ToIgn79YgJQSI` +
-	`r9LKA8iksp6hd8hIqTICUYlD/gAfB8Yx69GMYxhKNYznJO4xjGJI1VFGtSIwDVDhIoUVtUJCACRSB+GJIvC+iejGEYQjHGkIxjCMQkjGpCIiQARjCP0jGeaxjGMHJGNSRIqBGMYShUOc0TGMYwgQNS` +
-	`RBrUmcQ1KgiBfl5aAl34jZDMojDiSq1fEIaEcqSQJdTjgAAAAAxjH2H3xj6EvuNWvE1/GMYEjUkT61O75/fOBBAFayJAIAJFREUYkiEEEBCMYxhCMcZQjGMIxiSKtqJCJABOMIwSMZlrGMYwCk1VVF` +
-	`CoEYxhKFYxjJMYxjCJIuVEGtSZxjVICIycXYQCUoiAEAyiMYJKq1qYhQSEZJAlMpBAAABAjGMaoQTOMYShWOcyTGMYxiSNVRBrUmcQ1R4OiAOk0YACDDn7hOcRzqAAjo+d78HRuj+MXQdFxHEjEn8c` +
-	`fjfPe9HxmOsXefDxtIqL/qgR96P/1dGMYxi6MHEcSP4f/u750ogI9EBYOAJSCIB//2HOelNNaxcBBAfTKqUxj4AAAAVz//v++LXej99XRjGMYuj5xHuj+H/7u+9I7gAAAQAAAAIEAAAAAAAAIAAAAA` +
-	`AAAAAAAAAAAIAAAAAAABAAAAAAACAQAABAIAAAAABAcAAAAhAAAAAAAAAAAAAAAgACAAAAAAADgAAAAIQAAAAEAAAAAAAAEAAEAAAAAAAAAAAABQBIQAAAAAAIQAAAAAAAAEAACQAIAAgAAAAOAAAA` +
-	`AAAABAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABwGAAAEAgAAAAA4AAAAACEAAAAAAAAAAAAAACAAIAAAAAAAAAAAAAAAAAAAQAAAAAAABgABgAAAAAAAAAAAAKA4hAAAAAAAhAAAAAAA` +
-	`AAQABxAAgACAAAAA4AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAQACQAOABMAGAAZABwAHwAkACkAKwAwADEANQ` +
-	`A6ADwAQQBGAEsAUABVAFoAXwBkAGUAZwBqAG8AcgB3AHwAgQCGAIsAkACVAJoAnwCkAKUAqgCvALQAuQC+AMMAyADNANIA1wDcAOEA5gDrAPAA9QD6APwBAAECAQUBCwENARIBFwEcASEBJgEqAS8B` +
-	`NAE1ATgBPQE+AUMBSAFNAVIBVwFcAWEBZQFqAW8BdAF5AX4BgwGGAYcBigGPAY8BlAGZAZ4BogGoAa0BsgG3AbwBwQHGAcsB0AHVAdoB3wHkAekB7gHzAfgB/QIDAggCDQITAhgCHQIiAicCLAIxAj` +
-	`QCOAI8AkECRgJLAlACUQJWAlsCYQJmAmsCcAJ0AngCfQKCAoUCiAKJAo4CkgKXApwCoQKkAqcCrQKzArgCvQLAAsUCygLPAtQC2QLeAuMC6ALtAu0C8gL3AvwDAQMGAwkDDwMTAxcDGQMbAyADJAMp` +
-	`Ay4DMwM4Az4DQwNIA00DUgNXA1wDYANmA2sDcAN1A3oDfwOEA4kDjgOTA5gDnQOiA6cDrAOxA7YDuwPBA8YDywPRA9YD2wPgA+UD6gPwA/MAAP////////////////////8BBP///////wAA//////` +
-	`//////////////////////////////////////////AAYCBgEGAAYABgAGAAYCBgEGAgYABgAGAQYABgIGAQYABgIGAAYABgAGAAYABgAGAAYABgIGAQYBBgAGAQYABgAGAAYABgAGAAYABgAGAAYA` +
-	`BgIGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgIGAQYCBgEGAAYCBgAGAAYABgAGAAYBBgAGAAYCBgAGAAYCBgAGAAYABgAGAAYABgAGAQYABgAGAAYABgAGAAYCBgIGAQYABv//AA` +
-	`YABgAGAQYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgEGAQYBBgAGAAYABgAGAgYABgAGAAYABgAGAAYBBgEGAAYABgEGAgYCBgAGAQYABgAG` +
-	`AAYBBgEGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgEGAAYBBgEGAgYCBgAGAQYABgAGAAYABgAGAAYABgAGAAYABgAGAQYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABg` +
-	`AGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgEF//8AAJAAAAAA/wAHAAD//QAHAA8E1gAMAAMAAQBBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAgAAAAoABAAICgAABAAAAAFAAAAAAAAAAAAAAAAAAAAAAABEABAABQAAAArQAAAAAAAAgKAAAAKAAEASgKgAEwBBAKAABAAAAAUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAFfAQBSgAEAAgAEAQA/AQAAAAAgAAAAAAAAAAACQADgAAAAAAiiAAgAAIAAAAVYHwAAAAAAQBAAAABAACAIQMQHySAogFAAAgAEAACAAACkAAMAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAA8HAAgAAAAAAAAAAAAAAAAAAADgAAAAAAAAAAAAAAAAAIAAAAAAAAAAAADgAAAAAAAAAAAAAAAAIgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAAAAAEAAAAAAAAAAAA` +
-	`AA0rksUiAAAuTnCfN9zgAAOAd53v/dGGMIxd53nfjBjGP5ASAECAAgMEJhAAAAAAAAAAABUAc4H3HREA4CnCF8XkL8HxEYBovqAm+NED3AAHoAAJUAAiD6hSsKIIgGKIAAApziuABO3AAHheDBXgOA` +
-	`KcJ3w31Rc4EYkiWIIih+AHB8AN/WslKoAAMdGJkEBjEBBEXoxjGEIxhlDcYxjGJIwYxiGIFQAggAIEBCYQAAAAAEAAAAAVAIx/CKMSARUSJCBJGDQ/AhJKsSBFeYEoBWJw4uAAEVCR5MioVNCiCIBE` +
-	`iAAAEjFUQAkmQA/EkRIJEEQBIkiRUEkYx/GKoqiCJIBgSIRADVUtJhcQAFFBCpCAYxAgIGGMYxhCEYaQrmMYxgSKKMYhiBiAAIACBAQCEAAAAABAAAAAFQCMQQijEAEQAiAAQRAkAQAAAAAgACmBFA` +
-	`ViiRLgAQERCCgkKFCQsgyAQMgAAAIxBEANy4gARBEhARBAACAIkRBBGMQRiSIIgiCAYAgEQAj7HUIXEABTQTSe8J0fT5Ctj6EYQhGHEI1jGMYEiiiqIoQQAA/znu5/plf87z7O6Maxj9UAjF8Ioxe9` +
-	`B7oOcL8Z5B0HOc50YOimgRJ1ZWZoDg+9UgSwcjhQkKvqgF6pC0B6MXRAAAAAR0bRQK0bQAOg6JOQvxjGMYxjGMYxkKX4BEAIoYiCGvwAlUIP4YkjH4AJNfxhH3p/hhCM4+j5xIookUSEEAARjGMUjG` +
-	`aVYxjHMUjGqoikgI/j76MYxQjGEYkFGiej6MYxjH0YoPOJ/WVWiBECRVQCoJEoUJCnGfxRmRSgRjGMQAAAPqj7PoBz7LgEXR+REQUYxjGMYxjFUaEoKYRACKFVQhIQ+JlECIGJIvBPkTWMYRhCMYcQ` +
-	`jGMIxCSJSqiIghAAEYwj9IxnFWMYxi5IqqSJFTH8YwijGMUIwhD6CRokPwj+MYxh+ICBBfvjZogfD8VSBLESaFCQoxiEUYqoUEYxjH/wAAEYxjFAoxiEA4LokRIJGMYxjGMYxJHCMEiEQAABWyoQEA` +
-	`ERSAiBiSIQICAujGEYQjHGkIxjCMQkiU3EkIIQABGMIwSMZpVjGMYBSKqkihVOjGMIoxjFCMIQhBEaJCEIwjGMYQiAgSL5Y1ZoEARFUQipFChQkKMYh1GKqFBGMYxAAAAIqMYRISEYBABCGJEUERjG` +
-	`MYxjGMSRpCCIhEAIBWsRIAEFEVCIkYkiLxBBIIxjGEIxxlCMYwjGJIiIxJCBEAATjGMUjGZVYxjGEUmSqowVAIxnCKMZzRnGMYwhGiQjCMYxjOEZgIExcWKJEBEARVCQtSioUJCrGIDRjFSgRjGMQA` +
-	`AAAEjGERIhWkfcYxiRFCEYxnGM4zjckdQhCoRACAOk6SABBg7/cI5xHM8AAR6Pne/B0bo/jF0HRcRwiMSfgRB/DfOe5HxmPWLvPg42kVF/1QCMW/ic5rTmuc53/+nn3fc5znN+5oD7zgFscOAOAD1X` +
-	`/rLPr5+fq1iAUY/LSsXOc4AAAAAHRhEMIVo5S5zokNf+5zmuc1zXNI/P//cHwAABAADAAQIAAAAAAAABAAAAAAAAAAAAAAAAAgAAAAAAAODwAAAAAAAIBAAACAQAAAAACA4AAAAAQAACAAAAAAAAAA` +
-	`AAAAAAAAAABAAAIAAAAQAGgAAQAAIgAAhAAAAAAAAAAAAAAAAAAAAAAABCApAAAAAAAAAAAAAABBABQAACAAAAAAAAAAIAAAAAAAAAAgAAAAAAAAAAAAAAAAEAAAAAAAAAgAAAAAAAiAQAAAgEAAAA` +
-	`AIgAAAAAAEAAAgAAAAAAAAAAAAAAAAAAAAQAAAAAAAEARUAAIAAEQAAQgAAAAAAAAAAAAAAAAAAAAAAAhAAAAAAAAAAAAAAAAAIIEYAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAHAYAAAAAAAAAABwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA4AAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAEAAkADgATABkAGgAdACAAJQAqACwAMQAyADcAPAA/AEQASQBPAFQAWQBeAGMAaABqAGwAcAB1` +
-	`AHkAfgCEAIkAjgCTAJgAnQCiAKcArACtALIAtwC8AMEAxgDLANAA1QDaAN8A5ADpAPAA9QD6AP8BBAEHAQ0BEAEVARwBHwEkASkBLgEzATgBPAFBAUYBRwFKAU8BUQFWAVsBYAFlAWoBbwF0AXgBfQ` +
-	`GCAYcBjAGRAZYBmQGaAZ0BpAGkAakBrgGzAbgBvgHDAcgBzQHTAdgB3QHiAecB7AHxAfYB+wIAAgICCQIOAhMCGAIdAiICJwIsAjECNgI7AkACRQJIAkwCUgJXAlwCYQJmAmsCcgJ5An8ChAKIAo0C` +
-	`kgKUApYCmQKeAqMCpgKrArACtQK4Ar0CvwLEAscCzALOAtMC2ALdAuIC5wLsAvEC9gL7AwADBQMFAwoDDwMUAxkDHgMhAygDLQMyAzQDNgM7A0ADRQNKA08DVANZA14DYwNoA20DcgN0A3kDfgODA4` +
-	`gDjQOSA5cDmQOeA6MDqAOtA7IDtwO8A8EDxgPLA9AD1QPaA98D5APpA+4D8wP4A/0EAAQHAAAAAAAAAAAAAAAA////////Bwf///////8AAP//////////////////////////////////////////` +
-	`/////wcHAwcCBwEHAQcBBwAHAwcCBwIHAQcBBwIHAQcDBwEHAQcCBwEHAQcBBwEHAQcBBwEHAQcCBwIHAQcBBwIHAQcABwEHAQcBBwEHAQcBBwEHAQcDBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBw` +
-	`AHAQcBBwEHAQcCBwEHAgcBBwAHAgcBBwEHAQcBBwEHAgcBBwEHAwcBBwEHAwcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAgcDBwIHAAcAAAEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcB` +
-	`BwEHAQcBBwMHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcCBwEHAQcBBwEHAQcBBwEHAAcABwAHAQcBBgEHAQcCBwIHAgcBBwEHAgcBBwEHAQcCBwEHAwcBBwMHAQcDBwEHAQcBBwEHAQcBBwEHAQ` +
-	`cBBwEHAQcABwEHAQcBBwEHAQcCBwAHAQcBBwMHAwcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcCBwEHAQcBBwEHAQcBBwEHAwcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEH` +
-	`AQUAB///kAAAAAD/AAcAAP/9AAcADwTlAAwAAwABAEIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAoAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`IAAAAAAAAUAAAAAAAAAAAAAAAIAAAAAABQAAAKAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAA` +
-	`AAAAAAAAAAAAAAAACAAAAAAAAAQAAAAAAAAUAAAAAAAAMAAAAAAQAAAAAAAgAAAKAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHAAEAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAcHAAAAAAAAAAA` +
-	`AAAAAAAAAAAOAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACBwAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHDVOSxSIAAC5c4Xzfc4AAHAO873/ujD` +
-	`GEYu87zvxjGMfyBIAgQAEBghMIAAAAAAAAAAAVNd/3x36ujGI8Yu/534kjFGtXEYOmem+aIHwA9+QB8QAABAALgKmAEgAXAGQAAAB8hEQABO3ACKogkfUAAQAAAAACgAAAAAAAAQAAAAAAAAB3Lf1r` +
-	`JSqAADHjE0EBjECCIgRjGMIRjDKG4xjGMSRjGMQyBUAQQAECAhMIAAAAAEAAAAAVWjCMCUKxnORO4xjGJIujFGtREYRpj5ghAK+cXQQBAgACRAEMQKqAEgAYgEQAAAAghIgACSZACJIgkQUADgAAAA` +
-	`ABAAAAAAAAAQAAAAAAAACPDVUtJhcQAFFCFUIBjEEBAvRjGMIQjDSFcxjGMCRjGMQxBiAAQAECAgEIAAAAAEAAAAAVAjCMCUKhnOhNYxjGBI1jFGtREYBpipgigKxCXQ/FAACEIAEMAIKAEgAIAEQI` +
-	`AAAh/QAADcuICIIg0QAAEAAAAAAAAAAAAAAAAQAAAAAAAACHOFMdQhcQAFFCaXvCdGp8hQx9CMIQjDiEaxjGMCRjFURRBAAH+c93P9MvvO8+zujGsY/VAj70CUKhrXBMYxjGBI1VFGtREYBpimgiTq` +
-	`8tAWQ/D4CIFj8MHJKDEiQYAE5xC0AshiLgAAAACMYxsQc9/vfHOq6MYjxi7/nfi6MUa1cRg6X/CPmIghr8AJFEH4YkjGwASW/jCPvT/DCEZx9HziRjEiiQhAAIxjGKRjNNYxjHMUjGqoikg/GMCXnG` +
-	`rWBMfxj6BH1JE+tR2fP5eghxP66xAZWJCG/wDjAPopLlH6QXfESJFKAytCUQAAA+B8YxlejGMYwJRrGc5E7jGMYkjVUUa1ERhGqHKFFVQhIQ+JFIEQYki8J8iWxjCMIRjDiEYxhGISRjFREQhAAIxh` +
-	`H6RjONYxjGLkjGpIkVAjGMCUKhrXBMYxjCBA1VEGtRNYhpGAggv38xARZ/CFSIFjAMOJKV0n8QhERyqFAizijv8AAAAMYxmw/GMfQJfcataE1/GMYEjUkUa1HZ8fv3AAFbKhAQARFQEQYkiEEBAXxj` +
-	`CMIRjjSEYxhGISRjWJIQRAAIxhGCRjNNYxjGAUjGpIoVAjGMCUKhzmhMYxjCBA1jEGtRNYhpKAgkXy6tARRRCGyEJjCMIJKVMmSQh0SCqFAijTgQAAAIAMYxlQg+MYwJQqHOeExjGMYEjUkT61E1iG` +
-	`qHGAVrESABBRFhEUYkiKiCCQRjGMIRjjKEYxhGMSRVuJIQRAAJxjGKRjMtYxjGEUmqqowVAjGMEUKxzmRMYxjCJIujEGtRNYxpSAgmLixCARRBCAACRnCMYpKVMmSYgMQEVKAijKUQAAAACMYxkQgm` +
-	`cYwRRrGMZExjGMYkjVUQa1E1jGqPGAOk6SABBg5+4TnEcygACPR8734OjdH8Yug6LiOJGJPwJB/G+c9yPjMdYu8+DjaRUX/VAj70P/6ujGOMYujBxHEj+H/52fOmiAh5wC+cARRBCAP//7BznJTp06` +
-	`cXAEQHy0qijGLgAAABtz//n/fFrvQ/uq6MY4xi6PnEe6P4f/nZ86R3AAEAAMABAgAAAAAAAAgAAAAAAAAAAAAAAAAQAAAAAAAcPAAAAAAABAIAAAgEAAAAAAgOAAAAIIAAAAAAAAAAAAAACAAIAAAA` +
-	`AAAAAAAAABBAAAAAAgAAAAAAAAAQAAQAAAAAAAAAAAAAkASEAAAAAAAggAAAAAAAAEAACQAIAAgAAAAHAAAAAAACAAAAAAAAABAAAAAAAAAAAAAAAAAIAAAAAAAAAAAAAAAARAIAAAgEAAAAAIgAAA` +
-	`AAIIAAAAAAAAAAAAAACAAIAAAAAAAAAAAAAGGAAAAAAgAAAAAAAAAQABgAAAAAAAAAAAABIESEAAAAAAAggAAAAAAAAEAAiQAIAAgAAAAHAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAOAwAAAAAAAAAAHAAAAAAIIAAAAAAAAAAAAAACAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABgAAAAAAAAAAAAAAAAADiAAAAAAAAAAAAAAAAAAAAAcQAAAAAAAAAHAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAQACQAOABMAGQAaAB0AIAAlACoALAAxADIANwA8AD4AQwBIAE0AUgBXAFwAYQBmAGcAaQBtAH` +
-	`IAdgB7AIEAhgCLAJAAlQCaAJ8ApACpAKoArwC0ALkAvgDDAMgAzQDSANcA3ADhAOYA6wDwAPUA+gD/AQIBBwEKAQ8BFgEYAR0BIgEnASwBMQE1AToBPwFAAUMBSAFJAU4BUwFYAV0BYgFnAWwBcAF1` +
-	`AXoBfwGEAYkBjgGRAZIBlQGaAZoBnwGkAakBrgG1AboBvwHEAckBzgHTAdkB3gHjAegB7QHyAfcB/AIBAgYCCwIRAhYCGwIhAicCLAIxAjYCPAJBAkQCSAJNAlICVwJcAmECYgJnAmwCcgJ4An4Cgw` +
-	`KIAo0CkgKXApwCoQKiAqcCrAKxArYCuwK+AsECyALPAtYC3QLgAuUC6gLvAvQC+QL+AwMDCAMNAw0DEwMZAx4DIwMoAysDMAM1AzoDPAM+A0MDSANNA1IDVwNcA2MDaANtA3IDdwN8A4EDhgONA5ID` +
-	`lwOcA6EDpgOrA7EDtgO7A8ADxQPKA88D1APZA94D4wPpA+4D8wP5A/8EBAQJBA4EEwQZBBwAAP////////////////////8BBP///////wAA//////////////////////////////////////////` +
-	`//////AAcDBwIHAQcBBwEHAAcDBwIHAgcBBwEHAgcBBwMHAQcBBwIHAQcBBwEHAQcBBwEHAQcBBwMHAgcBBwEHAgcBBwAHAQcBBwEHAQcBBwEHAQcBBwMHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEH` +
-	`AQcBBwEHAQcBBwIHAQcCBwEHAAcDBwEHAQcBBwEHAQcCBwEHAQcDBwEHAQcDBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcCBwMHAgcBB///AQcBBwEHAQcABwEHAQcBBwEHAQcBBwAHAQcBBw` +
-	`EHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwAHAQcBBwEHAAcBBwIHAQcBBwEHAQcBBwEHAwcABgAGAAcABwAHAQcBBwEHAQcBBwEHAQcDBwEHAQcBBwEHAQcCBwIHAAcABwAHAAcBBwEHAQcBBwEHAQcB` +
-	`BwEHAQcBBwAHAAcABwEHAQcBBwIHAQcBBwEHAwcDBwEHAQcBBwEHAQcBBwAHAQcBBwEHAQcBBwEHAQcABwEHAQcBBwEHAQcBBwAHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwAHAQcBBwEHAQ` +
-	`cABwEG//8AAJAAAAAA/wAHAAD/9AAHAA8E5QAMAAMAAQBCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQABAAaAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAADWgAAAAAAABQAAAAAAAAIQSkJLCIAgREAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQBSgCspQA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGAAAAAAAAYAAAABFrAAAAAAAAAAAAAAAAAAUogCSgkUAQiiAAAAAUiAAAAUQAAMAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAPBwAIAAA` +
-	`AAAAAAAAAAAAAAAAA4ABAEAAAAAAAgAAAAAAAAAAAAAAAAA4AADhwA7AAAAAACIAAAAIAAAAAAAMgAAAAAgAAAAAAAAAAAAAAAAAAAAAAAEQAAAAAACNBHYUUAIA0U5ExSIAAAuTnCfN9zgAAOAd53` +
-	`v/dGGMIxd53nfjBjGP5ASAECAAgMEJhAAAAAAAAAAABU1wHfi6IiEA1QBIIBkGiQQDRIIAjANED7kSL1bA+cAAEiIAf/gI9zgAAkAIgAAAABznPgABO3AACITwBjxAAgd93/pEucE6MYlW+7koJ8AU` +
-	`1/WlJSqAABR0YmQQGMQEERejGMYQjGGUNxjGMYkjBjGIYgVACCAAgQEJhAAAAAAQAAAABVaLowjGJBKVYgCEUqbbEIpViEUokgQkHUbMleABIjAAIRQGRRAJGMQAAkAIgAQAACMYyAACSZMAqIUICE` +
-	`RABSjCMIJExiJGMYogAAYABFBC0pWpJhcQABRQQqQgGMQICBhjGMYQhGGkK5jGMYEiijGIYgYgACAAgQEAhAAAAAAQAAAABUCMYwzGIAAAAAAAAAAAAAAAAAAD8kRQHUatVcABIiAEQJQBIRAJGMQA` +
-	`EAAIgAQAACMYyAADcuMICIkACEXwBUjCMIJExiJGMYgAAAAABEe8j8xJQhcQACTQTSe8J0fT5Ctj6EYQhGHEI1jGMYEiiiqIoQQAA/znu5/plf87z7O6Maxj9UCMYQrGL3ve97nOc6UrOc5zoxjEjO` +
-	`RJ3UqtFUAFImAEgEkTkRfJOMXx4kAJwAoAACMYyNgAAAAJGIvwH/RAAojCMIJExi3GMYwAAAAABEjHhQYQghr8+CVQg/hiSMfgAk1/GEfen+GEIzj6PnEiiiRRIQQABGMYxSMZpVjGMcxSMaqiKSCM` +
-	`YenGMYxjGMYxjGUsxjGMYxjEgV4n/UrNEAA/+qbfQL8UiRKI10ZKYn4QjEoTICMYzyQAAA/VFREBiURMAQ/7/vJExj9GMYwAAAAABFPkhQUlQhIQAEZRAiBiSLwT5E1jGEYQjGHEIxjCMQkiUqoiII` +
-	`QABGMI/SMZxVjGMYuSKqkiRUD/4QjGMYxjGMP//+UoxjGMYxjEgUQX70atUAAJIykkIQkYkRKIAEZ6pEOQk5EkkD/4yT/8AAAjEhPySURMAqjCMIJExj9GMYwAAAAABEujABUrKhAQAERSAiBiSIQI` +
-	`CAujGEYQjHGkIxjCMQkiU3EkIIQABGMIwSMZpVjGMYBSKqkihUCMYQjGMYxjGMIQhCUoxjGMYxjEgUTL5UaskAA/IikkEj8YoRKJ/6pDKEKQjFFISCMYySAAAAMVEiEEKURABVjCMIJExj9GMYwAAA` +
-	`AABFjGgBVLESADBoRUIiRiSIvEEEgjGMYQjHGUIxjCMYkiIjEkIEQABOMYxSMZlVjGMYRSZKqjBUCMYwjGM5znOcYxjGUoxjGMZznMAVTFxUUSIAARJibAAAk5RRKIACrKKMBQk6CkkCMYySQAAAMJ` +
-	`EiEKSURD2VjCMIJExj/GMYwAAAAABFB0gA5E6SADBoO/3COcRzPAAEej53vwdG6P4xdB0XEcIjEn4EQfw3znuR8Zj1i7z4ONpFRf9UCMXfi5zWta1rnOc6Uouc5zmta0AOs4BdjhwAABOcAf/8nWfR` +
-	`KIAG11x0BQgD+TJWMXPtgAAAAI8kDxiUfz2Kj+P/pEuc05znQAAAAAB8AAAAQAAMABAQAAAAAAAABAAAAAAAAAAAAAAAAAgAAAAAAAODwAAAAAAAIBAAACAQAAAAACA4AACAAAAAAAABAAAAAAAAAA` +
-	`AAAAAEAAAEAAAAAAAAAAAAEAAAAIAAAAAAAggAAAAAAAAAAAAAAAEEAAAABBSAAAAAAAAAAAAAAAAACBAAAAAAAAAAACAQAAAAAAAACAAAAAAAAAAAAAAAAAQAAAAAAAACAAAAAAACIBAAACAQAAAA` +
-	`AiAQAACAAAAAAAABAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAAAEAAAAwAAAAAAAjAAAAAAAAAAAAAAABEAAAAABCkAAAAAAAAAAAAAAAAABCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAABwGAAAAAAAAAAAcAQAAEAAAAAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAA4AAAAAAAAAAAAAAAAAAAAAAAAACBAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAEAAsAEAAXAB0AHgAhACQAKQAuADAANQA3AD0AQgBFAEoATwBVAFoAXwBkAGkAbgBwAHIA` +
-	`dgB7AH8AhACKAI8AlACZAJ4AowCoAK0AsgCzALgAvQDCAMcAzADRANYA2wDgAOUA6gDvAPYA+wEAAQUBCgENARMBFgEbASIBJQEqAS8BNAE5AT4BQgFHAUwBTQFQAVUBVwFcAWEBZgFrAXABdQF6AX` +
-	`4BgwGIAY0BkgGXAZwBnwGgAaMBqAGoAa0BsgG3AbwBwQHGAcsB0AHVAdoB3wHkAekB7gHzAfgB/QICAgQCBgIJAgwCEQIWAhsCIAIlAioCLwI0AjkCPgJDAkcCTAJRAlYCWwJhAmYCbQJ0AnoCfQKC` +
-	`AocCjQKUApsCoAKlAqoCrwK0ArkCvgLFAsoCzwLUAtkC3gLlAuwC8QLyAvcC/gMDAwoDEQMXAx0DIgMiAycDLAMxAzcDPgNBA0YDSwNQA1IDVANaA18DZANpA24DdQN4A3sDgAOHA4wDjgOQA5UDnA` +
-	`OhA6YDqwOwA7UDtwO8A78DwQPGA8sD0QPWA9sD4APlA+YD6wPwA/UD+gP8BAAEAgQGBAgEDQQSAAD/////////////////////AAf///////8AB///////////////////////////////////////` +
-	`/////////wcHAwcCBwAHAQcABwAHAwcCBwIHAQcBBwIHAQcCBwAHAQcCBwEHAQcBBwEHAQcBBwEHAQcCBwIHAQcBBwIHAQcABwEHAQcBBwEHAQcBBwEHAQcDBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQ` +
-	`cBBwAHAQcBBwEHAQcCBwEHAgcBBwAHAgcBBwEHAQcBBwEHAgcBBwEHAwcBBwEHAwcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAgcDBwIHAQcAAAEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEH` +
-	`AQcBBwEHAQcBBwMHAgcCBwIHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAQcBBwEHAAcABwAHAwcBBwEHAAcABwAHAQcBBwEHAQcBBwEHAQcABwEHAQcBBwEHAQcABwAHAQcDBwEHAAcBBw` +
-	`AHAAcBBwAHAQcHBwEHAQcBBwAHAAcCBwEHAQcBBwMHAwcBBwEHAQcBBwEHAAcCBwIHAQcABwEHAgcCBwEHAAcBBwEHAQcBBwEHAgcBBwIHAgcBBwEHAAcBBwEHAQcBBwMHAQcBBwEHAQcCBwEHAgcC` +
-	`BwIHAQcBB///AACQAAAAAP8ABgAA//4ABgAMBB8ACgACAAEAQgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFAAAAAAAAAAAAAAAAAAAAEA` +
-	`AAAAAAABAAAAAAAAFAAAAAAAAAAAAAAAQAAAAAACgAAAoAAAAAAAAAAAAAAAAAAAAAAAAAAAAHCAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAACAAAAAAAAAAAAAAAAAAAAEAAAAAAAACEAAAAAAAAAAAAAAAAAAAAAAAggAAAAABFAAAAAABAAAAAAoAAAAAAAAAAAAAAAAAAHE9AciRMIAAFxHOF933OAAADjHed7/3RhjCMXec878YxjH/h0BA` +
-	`gAIBghGEAAAAAAAAAAADgB3/fO/V0YxPGLv+d+JIxRrViMHSel82A/Yz36APoAAAIBC4CpgFAC4AYwCAAA+iJAAAX3AESRBR9QAOAAAAAAQAAAAAAAAIAAAAAAAAB3ANKrUpSqAAKLIxFCIYxAAAEU` +
-	`oxjGEIxhlDcYxijEkYxjEMgUAggAICAgCEAAAAACAAAAAFQCMIwShWMYyTuMYxiSLoxRrUiMI1irBIB1EJVCAIAAQeABDECCgFABEARACAAAQiQAAApZAEQRBRAAAQAAAAAAAAAAAAAAAIAAAAAAAA` +
-	`CPDdKpYpScAAKNAhNCAYxAAAAbYxhGEIRhpCuYxijAkYxUoUQaAP857iP9GP3nefbiRjGMflQCMIwShUMY0TWMYxgSNVRRrUiMA1j1BId1y1dHwIeARhsfDByShlKDAARAFAAAR6kXAA+uAEYxjRBz` +
-	`3+95zqujGJ4xd/zvxdGKNasRg6X/JYfYoQhqQASZBGV7wnRwYGAtY+hH3ofhxCNYxii4kVRIQkIaARjGMfxjGWsYxjkPxjFUQlQCPvQS84054TH8YxgR9SRRrU7Pj9ihDm/1rFVyAoQB+AcYD6KSin` +
-	`qC4AjmVEyAcjEoAAAAID4xjx6MYxjCUaxjGSdxjGMSRqqKNakRhGqHIoKMU0hIQ8SpCBkGIovBn5hNfxhGEJxhxCM4+i8EkVRIQkIQARjCPyRjGmsYxjDiRVEkRZRn8YwShUNa4TGMY+gQNSRPrUms` +
-	`Q1ehBJ+1zEBLv4QkRhsYDDiSy1fCEAhJlJJAS7FHf/AAAAYxj1D8Yx9CX3GnOia/jGMCRqSKNanZ8fv3Bo/KbMhB8AjJEB+GJIhGAAZLoxhGEIxhpCMYwioEkVVUREEQARjCMCRjHmsYxjASRVUkSF` +
-	`OaMYwShUOc0TGMYwgQNVRBrUmsQ1KhBJcVq0BKSIRaQeMYDCCSqtaiH8hGYyEgSmnAgAAA+AYxj7CD4xjCUKhrXiYxjGMCRqSJ9ak1iGqHGAUqrEhAQAiJIRFGJIxBn5gEIxjGEIxxlCMYwilEkSbi` +
-	`SECQATjGMSRjGWsYxjESRVtUUFQCMYwShWMYyTGMYwiSLoxBrUmsY1ShBGAVEIBKf4QkAAM4jGKSqtajEKiJopJASmUogAAAAEYxjVCKZxjCUaxzmSYxjGMSRqqINak1jGqPI4UdE6SATDB3/uE5xH` +
-	`O4YGBDI+d78Hxuj+MXQci4jiRiT8CQAN857iPjGOsXefDhvJGL/lQCPvQ//V0YxjGLowcRxI/h/+7PnSiBDxAVYwBKUIQB///Yc5yU01rC4LCAPkyVSmMXAAAAJ7n//f90Wu9H7qujGMYxdHziPdH8` +
-	`P/3Z86R3AAAIAASABAAAAAAAAAAIAAAAAAAAAAAAAAAAAEAAAAAAAEAR+AAAAABAkAAAQCAAAAAAQEQAAAAhAAAAAAAAAAAAAAAgACAAAAAAAJAUAAAIQAAAAAEAAAAAAAASABCAAAAAAAAAAAAAAo` +
-	`AkIAAAAAAEIAAAAAAAACAABIAEAAQAAAAHAAAAAAMACAAAAAAAAAAQAAAAAAAAAAAAAAAAADAAAAAAAHBwAAAAAAOAYAAAQCAAAAAHgCgAAAAhAAAAAAAAAAAAAAAgACAAAAAAAGAUAAAxgAAAAAEA` +
-	`AAAAAAAMABEAAAAAAAAAAAAABQPEIAAAAAAEIAAAAAAAACAAeIAEAAQAAAAHAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAEABAAKAA8AFQAbABwAHwAiACcALAAuADIAMwA4AD0AQgBHAEwAUQBWAFsAYABlAGoAawBtAHMAeQB/AIQAigCPAJQAmQCeAKMAqACtALIAswC4AL0AwgDHAMwA0QDWANwA4QDmAOsA8AD1APoA` +
-	`/wEEAQkBDAERARQBFwEdAR8BJAEpAS4BMwE4AT0BQgFHAUgBTAFRAVIBVwFcAWEBZgFrAW8BdAF5AX4BgwGIAY0BkgGXAZsBnAGgAaYBpgGrAbABtQG6AcABxQHKAc8B1AHZAd4B4wHoAe0B8gH3Af` +
-	`wCAQIGAgsCEAIVAhsCIAIlAisCMAI1AjoCPwJEAkkCTAJPAlQCWAJcAmECZwJoAm0CcgJ4An0CggKIAo0CkQKXApwCoQKmAqcCrAKwArUCugK/AsICxQLLAtEC1gLbAt8C5ALpAu8C9AL6Av8DBQML` +
-	`AxADEAMVAxoDHwMkAykDLgM0AzgDPAM+A0ADRQNJA04DUwNYA10DYwNoA20DcgN3A3wDgQOFA4sDkAOVA5oDnwOkA6kDrgOzA7gDvQPCA8cDzAPRA9YD2wPgA+YD6wPwA/YD+wQABAUECgQPBBUEGA` +
-	`AA/////////////////////wEE////////AAD///////////////////////////////////////////////8ABgIGAQYABgAGAAYABgIGAQYCBgAGAAYCBgEGAgYABgAGAAYABgAGAAYABgAGAAYA` +
-	`BgAGAgYCBgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAgYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAgYBBgEGAgYABgIGAAYABgAGAAYABgAGAAYABgIGAAYABgIGAAYABgAGAA` +
-	`YABgEGAAYABgAGAAYABgAGAAYABgEGAwYBBgAG//8ABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAQYCBgAGAQYBBgAGAAYCBgAG` +
-	`AAYABgAGAAYABgAGAQYABgEGAAYABgIGAAYBBgAGAAYABgEGAQYABgAGAAYABgAGAAYABgEGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgEGAQYCBgIGAAYBBgAGAAYABgAGAAYABgAGAAYABg` +
-	`AGAAYBBgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAAYABgAGAQX//wAAkAAAAAD/AAb////3AAcACwOxAAkAAgAAAD4AAAAAAAACAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAACACiAE1SgAAAAAAAAAAAAAAAAAAAAAGAAAAAAAAAAAAAAAAADAAAAAAAMAAAAhrQAAAAAAAUAAAAAAAQglIVYRAECIgAAAwKRAAABAAmAAAAg` +
-	`AAAAAAAAAABwAAAAAAAAAAAAAAAAAAAAHDIBAAAAAAAAAAAAAAAAAAAAD4ABQCWAARCAaQAkEAyDRIIBokEAGAaQPmAAAgAAAAAAAAAABAAAAAAAEAAAAEtYAAAAAAAABAAAAAAKUQBKhIoAhFEEb8` +
-	`dCigANU5LKRAAATlzhfd9zgEEOi7zvf+6MMYRi7zvO/GMYx/IVAIEABAYITCAAAAAAgAAAABJGuI78XRIJSrKAQilTbYhFKsQilKQIgHSfe9VA86AhJECf/AR2cAASABAAAADnOeAAL7gAqITgMdAC` +
-	`Dvu/8CHOCdEESrA4pQR4Df1rKlUgAFHjE0IBjHyAkYxjGMIRjDKG8xjGMSRjFUQyGIBBAAQICAQgAAAAAIAAAAASS0XRhmMQAAABAAAAAAAAAAAAAAHkiEd1EIV4BURAIhRASUgEmYgAgAGQJAABGM` +
-	`aAAFLJCAiJEEJQBVGEYQUqMRIxjFEAAEAASA1VFUQuIACTQhV7wYx9PkGsYwjCEIw0hWsYxjAkYxJEURAAH+c93P9Mr1nefZ/xjWMfkkBGMIVjF73ve9znOdKVnOc50Yximdyv9Sy1cD9E2/wKpGRL` +
-	`5LmLjxIBOcRMgRjGjgB9cBUYi8b/fgKRhGEFKjFuMYxgAAAAAEgI+4iENfnwlURpBiJ0QgAi7/0I+9P8OIRnH0fOJFUSKJEQACMYxikYzSrmMY5gkY1VEWIwfjD04xjGMYxjGMYylmMYxjGMYoKon7` +
-	`0qxAAJ5VUiF+SiSURWjVMj8hIqkkH/xtX/gAfjFREJSlYBH/f95Sox+jGMYAAAAABICFFVVCQgARlIH4Yki8E+RLRjCMIRjjSEYxhGISRVUhEQkAAjGEfpGM8qxjGMHJFVSRISQEfwhGMYxjGMYf//` +
-	`8pRjGMYxjGKCiF+VHMQAP0ZbISElJElEAI3VQhoRypJBGMaXAAAAFRIjyUpQAlGEYQUqMfoxjGAAAAAASAAFayJAJg0RURFGJIh8gICEYxjCEY4yhGMYRjEkSbUSEJAAJxhGCRjMqsYxjAKTVVUUEk` +
-	`BGMYRjGc5znOEIQhKUYxjGM5zmgqknFRq0ABFEQAAH5SlJRP9VGUYUEhFMgRjGlAAAAQkSIRZKUf2xhGEFKjH+MYxgAAAAAEgIA6TSQAYODn7hOcRzvEEEjHzvfg6N0fxi6DouI4kYk/BQfhvnvej4` +
-	`zHrF3nw8bSKi/5JARi78XOa1rWte973ylFznOc1rWgHWEBdghAABe4D/+TrfSUQA2946BBAfACsYuesAAAAA8kDgSnmqUfx/9SnOec5zoAAAECB4AAEAAYACAgAAAAAAAAQAAHAAAAAAAAAAAAAEAA` +
-	`AAAAAYcAAAAAAAEAgAABAIAAAAABAPgAAEAAAAAAAACAAAAAAAAAAAAAAAgJABD3gAAAAAAAEgAAAEAAAgAABgAAAAAAAAAAAAABBAAAAQ0AAAAAAAAAAAAAAAAAhAAAAAAAAABAAAAAAAAAAIAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAOAwAAAQCAAAAADgAgAACAAAAAAAABAAAAAAAAAAAAAAAABgAAAAAAAAAAAAIAAAGAAAAAAAAAAAAAAAAAAAAADgAAAAEQAAAAAAAAAAAAAAAAAQIAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEABAAJAA4AEwAYABkAHAAfACQAKQArADAAMgA3ADwAPgBDAEgATQBSAFcAXABhAG` +
-	`YAaABqAG4AcwB3AHwAgQCGAIsAkACVAJoAnwCkAKkAqgCvALQAuQC+AMMAyADNANIA1wDcAOEA5gDrAPAA9QD6AP8BAQEGAQgBDQETARYBGwEgASUBKgEvATMBOAE9AT4BQQFGAUgBTQFSAVcBXAFh` +
-	`AWYBawFvAXQBeQF+AYMBiAGNAZIBkwGYAZ0BnQGiAacBrAGxAbYBuwHAAcUBygHPAdQB2QHeAeMB6AHtAfIB9wH5AfsB/gIBAgYCCwIQAhUCGgIfAiQCKQIuAjMCNgI6Aj8CRAJIAk0CUwJYAl0CYg` +
-	`JoAmsCbgJzAngCfwKEAokCjQKRApYCmwKfAqQCqgKvArQCuAK8AsECxgLNAtIC0wLYAt0C4gLnAuwC8QL2AvsC+wMAAwUDCgMPAxQDFwMdAyEDJQMnAykDLgMzAzgDPQNCA0gDSgNMA1EDVgNZA1sD` +
-	`XQNhA2cDbANxA3YDewOAA4IDhQOIA4oDjwOUA5oDnwOkA6kDrgOvA7QDuQO9A8IDxAPHA8kDzQPPA9QD2gEA/////////////////////wEG////////AQb///////////////////////////////` +
-	`////////////////8HBgMGAgYBBgEGAQYBBgMGAgYDBgEGAQYCBgEGAwYCBgEGAgYBBgEGAQYBBgEGAQYBBgEGAwYCBgIGAQYCBgEGAQYBBgEGAQYBBgEGAQYBBgEGAwYBBgEGAQYBBgEGAQYBBgEG` +
-	`AQYBBgEGAQYBBgEGAQYBBgEGAwYBBgMGAgYBBgIGAQYBBgEGAQYBBgIGAQYBBgMGAQYBBgMGAQYBBgEGAQYBBgEGAQYCBgEGAQYBBgEGAQYBBgEGAwYBBgEGAQABBgEGAQYBBgEGAQYBBgEGAQYBBg` +
-	`EGAQYBBgEGAQYBBgEGAQYDBgIGAgYCBgEGAQYBBgEGAQYBBgEGAQYBBgEGAgYCBgEGAQYCBgEGAQYBBgEGAQYBBgMGAgYBBgEGAAYBBgEGAgYCBgEGAQYCBgEGAQYBBgEGAgYCBgEGAQYABgEGBAYB` +
-	`BgEGAQYBBgEGAQYBBgEGAQYBBgEGAQYBBgEGAgYBBgIGAgYDBgMGAQYBBgEGAQYBBgEGAgYDBgEGAQYCBgMGAwYCBgEGAQYCBgEGAQYBBgIGAgYCBgMGAQYBBgEGAQYBBgEGAQYDBgEGAQYCBgIGAw` +
-	`YCBgMGAgYDBgEGAQb//wAAAAAAFwAABpAJBwUABQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUF`
-
-const MonacoFontData3 = `BQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQ` +
-	`UFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUF` +
-	`BQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQ` +
-	`UFBQUFBQUFBQUFBQUFBQUFBQUFBQUABQUFAAAAAAAAAAAAAAAAAAAFBQYFBQUFBgUFBQUFBQUFBQUFBQYFBgUGBQUGBQUGBQUFBQUFBQUFBQUFBQUGBQUFBQUFBQUFBQYGBQYFBQUGBQUFBQUFBQUF` +
-	`BQUFBQUFBQUGBQYFBgYFBgUFBgYFBQUFBQUFBQUFBQUFBgUAAAAFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQ` +
-	`UFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUF` +
-	`BQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQYFBQ` +
-	`UFAAAAAAAAAAUFBQUGBQUFAAAAAAUFBQUFBQUFBQUFBQUFBQUGBgYGBQUFBQUFBQUGBgAFBQUFBQYFBQUFBgUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUGBQUFBQUAAAAAAAAFBQYG` +
-	`BQAFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUGBQUFBQUGAAAABQUFBQUFBQUFBQUFBQUFBQUFBQUGBQUFBQUABQUFBQUFAAAAAAAAAAAAAAAAAAAFBQ` +
-	`UFBgUFBQUFBgYFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQAAAAAFBQUFBQUFBQUAAAUGBQUFBQUFBQUFBQYFBQYFBQUGBQUGBQUFAAAFBQUFBQUGBQUGBQUGBQUF` +
-	`BQUFBQUGBQUGBQUFBQUFBQUFBQUFBgUFBgUFBQUAAAUGBgYGBgYFBQUFBQAAAAAAAAAGBQUAAAAAAAAAAAAABQUGBQUGBgYAAAAAAAAAAAAAAAAAAAAABQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAFBQUFBQUFBQUFBQUFBQUFBgUFBQUFBgUFBQUFBQUFBQUFBQUFBQYFBQYFBgYGBgYGBgYGBgYGBgUFBQUFBQUFBQYGBgYFBgUFBQUFBQUGBgUFBQUFBQUFBQUFBQUFBQUFBQYGBQYFBQUFBQUF` +
-	`BQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFAAAAAAAAAAAFBQUFBQUFBQUFBgYFBgUFBQUFBQUHBgUFBgYGBQUFBQUFBQUFBQUFBQUFBQUFBQYGBQUFBQUFBQYFBgYGBQUGBQUFBQUFBQUFBQ` +
-	`UGBQUFBgUFBQUFBgUFBQUFBgYFBQYGBQUFBQUFBQUFBQUFBQUFBgYGBgUGBQUFBQUGBQUFBQUFBQUFBQUFBQUFBQUFBgUGBgYGBgUGBgUFBQUGBgYFBQUFBgUFBQUFBQUGBQUFBQUFBQUGBgUFBQUF` +
-	`BQUFBQUFBQYGBQUFBgUFBgYFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBgUGBQYFBgUFBQUFBQUFBQUFBQUFBQUFBgUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUKCAYABgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYG` +
-	`BgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYG` +
-	`BgYGBgYGBgYGBgYGBgYABgYGAAAAAAAAAAAAAAAAAAAGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYHBgYHBgYHBgYGBgYGBgYGBgYGBgYHBgYGBgYGBgYGBgYGBgcGBgYGBgYGBgYGBgYGBgYGBgYGBg` +
-	`YHBgYGBwcGBwYGBwcGBgYGBgYGBgYGBgYGBwYAAAAGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYG` +
-	`BgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgcGBgYGAAAAAAAA` +
-	`AAYGBgYGBgYGAAAAAAYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYHBwAGBgYGBgYGBgYGCAYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYIBgYGBgYAAAAAAAAGBgcGBgAGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYHAAAABgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYABgYGBgYGAAAAAAAAAAAAAAAAAAAGBgYGBwYGBgYG` +
-	`CAYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgAAAAAGBgYGBgYGBgYAAAYGBgYGBgYGBgYGBgYGBgcGBgYIBgYGBgYGAAAGBgYGBgYHBgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGCAYGBwYGBgYAAAYGBgcGBwYGBgYGBgAAAAAAAAAGBgYAAAAAAAAAAAAABgYHBgYHBggAAAAAAAAAAAAAAAAAAAAABgAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAGBgYG` +
-	`BgYGBgYGBgYGBgYGBgYGBgYGBwYGBgYGBgYGBgYGBgYGBgYGBgYGBwcHBwcHBgYGBgYGBwYGBgYGBgYGBgcHBgcGBwYGBgYGBgYHBwYGBgYGBgYGBgYGBgYGBgYGBgcHBgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBgYGBgYGBgYGBgYGBgYGAAAAAAAAAAAGBgYGBgYGBgYGBgYGBwYGBgYGBgYHBwYGBwcHBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYHBgYGBgYGBgYGBgYHBgYGBgYG` +
-	`BgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBwcGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGCAYGBgYHBwYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBg` +
-	`YGBgYGBgYGBwYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBwYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBgYGBQULCAcABwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcABwcHAAAAAAAAAAAAAAAAAAAHBwYHBwcHBwcHBwcHBwcHBwcHBwgHBwcHBwcHBwcHBwcHBwcHBwYHBwcHBwcHBwcHBwcHBwcHBwcHBgcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcAAAAHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHAAAAAAAAAAcHBwcHBw` +
-	`cHAAAAAAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcICAAHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcAAAAAAAAHBwgHBwAHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHAAAABwcHBwcHBwcHBwcHBwcHBwcHBwcGBwcHBwcABwcHBwcHAAAAAAAAAAAAAAAAAAAHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwAAAAAHBwcHBwcHBwcAAAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHAAAHBwcHBwYHBwcHBwYGBwcHBwYHBwcHBwYGBwcHBwYH` +
-	`BwcHBwcHBwcHBwcHBwcAAAcHBwgHCAcHBwcHBwAAAAAAAAAHBwcAAAAAAAAAAAAABwcHBwcHBwcAAAAAAAAAAAAAAAAAAAAABwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHBwcHBwcHBwcHBw` +
-	`cHBwcHBgcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwgHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwgIBwcHCAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBgYGBgYHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHAAAAAAAAAAAHBwcHBwcHBwcHCAgHCAcHBwcHBwcICAcHBwgHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBgYHBwcGBwcHBgcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGBwcHBwcHBwcHBwcHBwcHBwcHCAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGBgcH` +
-	`BwYHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBgYMCQcABwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcA` +
-	`BwcHAAAAAAAAAAAAAAAAAAAHBwcHBwcHBwcHBwcHBwcHBwcHBwgHBwcIBwcIBwcIBwcHBwcHBwgHBwcHBwcIBwcHBwcHBwcHBwcHBwgHBwcHBwcHBwcHBwcHBwcHBwcHBwcIBwcHCAgHCAcHCAgHBw` +
-	`cHBwcHBwcHBwcHCAcAAAAHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwgHBwcHAAAAAAAAAAcHBwcHBwcHAAAAAAcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcICAAHBwcHBwcHBwcHCAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcIBwcHBwcAAAAAAAAHBwgHBwAHBwcHBwcHBwcHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcIAAAABwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcABwcHBwcHAAAAAAAAAAAAAAAAAAAHBwcHCAcHBwcHCAcHBwcHBwcHBwcHBwcH` +
-	`BwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwAAAAAHBwcHBwcHBwcAAAcHBwcHBwcHBwcHBwcHBwgHBwcIBwcHBwcHAAAHBwcHBwgIBwcHBwcHBwcHBwgHBwcHBwcHBwcHBwgHBwcHBwcHCA` +
-	`cHCAcHBwcAAAcHBwgHCAcHBwcHBwAAAAAAAAAHBwcAAAAAAAAAAAAABwcIBwcIBwgAAAAAAAAAAAAAAAAAAAAABwAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHCAcHBwcHBwcHBwcHBwcHBwcHBwgHCAgICAgICAgICAgICAcHBwcHBwcHBwgIBwcHCAcHBwcHBwcICAcHBwcHBwcHBwcHBwcHBwcHBwgIBwcHBwcHBwcHBwcHBwcHCAgICAgHBwcHBwcHBwcHBw` +
-	`cHBwcHBwcHAAAAAAAAAAAHBwcHBwcHBwcHCAgHCAcHBwcHBwcICAcHCAgIBwcHBwcHBwcHBwcHBwcHBwcHBwkJBwcHBwcHBwcHBwcHBwcIBwcHBwcIBwcHBwcIBwcHBwcHBwcHBwcHBwcHBwcHBwcH` +
-	`BwcHCAcHBwcHBwcHBwcHCAgHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHCAcHCAcHBwcICAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHCAcHBwcHBw` +
-	`cHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcIBwgHCAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBgYNCQgACAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI` +
-	`CAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA` +
-	`gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI` +
-	`CAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgACAgIAAAAAA` +
-	`AAAAAAAAAAAAAICAcICAgICAgICAgICAgICAgICAgIBwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgHCAgICAgICAgICAgICAgICAgICAcICAgICAgICAkICAgICAgICAgI` +
-	`CAgICQgAAAAICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICA` +
-	`gICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI` +
-	`CAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAAAAAAAAAAgICAgHCAgIAAAAAAgICAgICAgICA` +
-	`gICAgICAgICAgICAgICAgICAgJCQAICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgAAAAAAAAICAkICAAICAgICAgICAgICAgICAgICAgICAgICAgICAgI` +
-	`CAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAAAACAgICAgICAgICAgICAgICAgICAgHCAgICAgACAgICAgIAAAAAAAAAAAAAAAAAAAICAgICQgICAgICAkICAgICAgICAgICAgICAgICAgICA` +
-	`gICAgICAgICAgICAgICAgICAgICAgICAAAAAAICAgICAgICAgAAAgICAgICAgICAgICAgICAkICAgICAgICAgIAAAICAgICAgICAgICAcHCAgICAgICAgICAcHCAgICAgICAgICAgICAgICQgICAgA` +
-	`AAgICAkICQgICAgICAAAAAAAAAAICAgAAAAAAAAAAAAACAgICAgICAgAAAAAAAAAAAAAAAAAAAAACAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAICAgICAgICAgICAgICAgIBwgICAgICAgICA` +
-	`gICAgICAgICAgICAgICAcICAgICAgICAgICAgICAgICAgICAgICAkJCAgICQgICAgICAgJCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI` +
-	`AAAAAAAAAAAICAgICAgICAgICQkICQgICAgICAgJCQgICAkICAgICAgICAgICAgICAgICAgICAkJCAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIBwcICAcHCAgICAgICA` +
-	`gICAgICAgICQkICAgICAgICAgHCAgICAgICAgICAgICAgICAgIBwgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgHBwgICAcICAgICAgICAgICAgI` +
-	`CAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIBwcPCgkACQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQ` +
-	`kJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJ` +
-	`CQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQ` +
-	`kJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkACQkJAAAAAAAAAAAAAAAA` +
-	`AAAJCQgJCQkJCQkJCQkJCQkJCQkJCQkJCAkJCQkJCQkJCQkJCQkJCQkJCQkJCQkKCQkJCQkJCQkJCQkJCQgJCQkICQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQoJCQkJCQkJCQkJCQkJCgkAAA` +
-	`AJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJ` +
-	`CQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQ` +
-	`kJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJAAAAAAAAAAkJCQkICQkJAAAAAAkJCQkJCQkJCQkJCQkJCQkJ` +
-	`CQkJCQkJCQkJCQkKCgAJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkAAAAAAAAJCQoJCQAJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQ` +
-	`kJCQkJCQkJCQkJCQkJCQkJCQkJCQkJAAAACQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkACQkJCQkJAAAAAAAAAAAAAAAAAAAJCQkJCgkJCQkJCQgJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJ` +
-	`CQkJCQkJCQkJCQkJCQkJCQAAAAAJCQkJCQkJCQkAAAkJCQkJCQkJCQkJCQkJCQoJCQkJCQkJCQkJAAAJCQkJCQkKCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCgkJCQkAAAkJCAoICg` +
-	`kJCQkJCQAAAAAAAAAICQkAAAAAAAAAAAAACQkJCQkJCQkAAAAAAAAAAAAAAAAAAAAACQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAJCQkJCQkJCQkJCQkJCQkJCAkJCQkJCgkJCQkJCQkJCQkJ` +
-	`CQkJCQkJCQkJCQkJCQkJCgoKCgoKCQkJCQkJCQkJCQoKCQkJCgkJCQkJCQkKCgkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJAAAAAAAAAA` +
-	`AJCQkJCQkJCQkJCQkJCgkJCQkJCQkKCgkJCQoKCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCAgJCQgICQkJCQkJCQkJCQkJCQkJ` +
-	`CgoJCQkJCQkJCQkICQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkKCgkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQ` +
-	`kJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCAgQCwoACgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoACgoKAAAAAAAAAAAAAAAAAAAKCgoKCg` +
-	`oKCQoKCgoKCgoKCgoKCgsKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgkKCgoKCgoKCgoJCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoAAAAKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKAAAAAAAAAAoKCgoJCgoKAAAAAAoKCgoKCgoKCgoKCgoKCgoKCQoJCgoKCg` +
-	`oKCgoKCgAKCgoKCgoKCgoKCQoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoJCgoKCgoAAAAAAAAKCgoKCgAKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoJCgoKCgoKAAAACgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoACgoKCgoKAAAAAAAAAAAAAAAAAAAKCgoKCgoKCgoKCQsKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgAAAAAKCgoKCgoKCgoAAAoJCgoKCgoKCgoKCgoKCgoKCgoJCgoKCgoKAAAKCgoKCgkKCgoKCgkKCgoKCgkKCgoKCgkKCgoKCgkKCgoKCgoKCQoKCgoKCgoAAAoKCQoJCgoKCgoKCgAA` +
-	`AAAAAAAJCgoAAAAAAAAAAAAACgoJCgoKCgkAAAAAAAAAAAAAAAAAAAAACgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgkKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKAAAAAAAAAAAKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoLCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgsLCgoKCgoKCgoKCgoJCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgkKCgoKCgoKCgoKCgoKCgoKCgoKCQoJCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoJCQkKCQoKCgoKCgoJCQkKCgoKCQoKCgoKCgoJCgoKCgoKCgoJCQoKCgoKCgoKCgoKCgkJCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCQoJCgkK` +
-	`CQoKCgoKCgoKCgoKCgoJCgkKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCAgRDAoACgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoACgoKAAAAAAAAAAAAAAAAAAAKCgsKCgoKCwoKCgoK` +
-	`CgoKCgoKCgoKCwoLCgoLCgoLCgoKCgoKCgoKCgoKCgoLCgoKCgoKCgoKCgoKCgoKCgoLCgoKCgoKCgoKCgoKCgoKCgoLCgsKCwwKCwoKCwsKCgoKCgoKCgoKCgoKCwoAAAAKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgsKCgoKAAAAAAAAAAoKCgoLCgoKAAAAAAoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoLCwAK` +
-	`CgoKCgoKCgoKCwoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoLCgoKCgoAAAAAAAAKCgsKCgAKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oLCgoKCgoLAAAACgoKCgoKCgoKCgoKCgoKCgoKCgoLCgoKCgoACgoKCgoKAAAAAAAAAAAAAAAAAAAKCgoKCwoKCgoKCwsKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoK` +
-	`CgAAAAAKCgoKCgoKCgoAAAoKCgoKCgoKCgoKCgoKCgsKCgoLCgoKCgoKAAAKCgoKCgoLCgoKCgoLCgoKCgoKCgoKCgoLCgoKCgoKCgoKCgoKCwoKCwoKCgoAAAoKCgsKCwoKCgoKCgAAAAAAAAAKCg` +
-	`oAAAAAAAAAAAAACgoKCgoLCgsAAAAAAAAAAAAAAAAAAAAACgAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAKCgoKCgoKCgoKCgoKCgoKCwoKCgoKCwoKCgoKCgoKCgoKCgoKCgoKCgsKCgoKCgoK` +
-	`CwsLCwsLCgoKCgoKCgoKCgsLCgsKCwoKCgoKCgoLCwoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKAAAAAAAAAAAKCgoKCgoKCgoKCgoKCw` +
-	`oKCgoKCgoLCwoKCwsLCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoLCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCwsKCgsLCgoKCgoKCgoKCgoKCgoKCwsKCgoKCgoKCgoLCgoK` +
-	`CgoKCgoKCgoKCgoKCgoKCwoKCwoKCgoLCwoKCgoKCgoKCgoKCgoKCgoKCgoLCgoKCgoKCgoKCwoKCgoKCgoKCgoKCgoKCgoKCwoKCwoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCg` +
-	`oKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCQkTDQsACwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsL` +
-	`CwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCw` +
-	`sLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsL` +
-	`CwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsACwsLAAAAAAAAAAAAAAAAAAALCwwLCwsLDAsLCwsLCwsLCwsLCw` +
-	`wLDAsMCwsMCwsMCwsLCwsLCwwLCwsLCwsMCwwLCwsLCwsLCwsLCwsLCwsMCwsLCwsLCwsLCwsLCwsLCwsMCwwLDA0LDAsLDAwLCwsLCwsLCwsLCwsLDAsAAAALCwsLCwsLCwsLCwsLCwsLCwsLCwsL` +
-	`CwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCw` +
-	`sLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsL` +
-	`CwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwwLCwsLAAAAAAAAAAsLCwsMCwsLAAAAAAsLCwsLCwsLCwsLCwsLCwsLDAsMCwsLCwsLCwsMDAALCwsLCwsLCw` +
-	`sLDAsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsMCwsLCwsAAAAAAAALCwwLCwALCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsMCwsLCwsM` +
-	`AAAACwsLCwsLCwsLCwsLCwsLCwsLCwsMCwsLCwsACwsLCwsLAAAAAAAAAAAAAAAAAAALCwsLDAsLCwsLDAsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwAAAAALCw` +
-	`sLCwsLCwsAAAsLCwsLCwsLCwsLCwsLCwwLCwsMCwsLCwsLAAALCwsLCwsLCwsLCwwMCwsLCwsLCwsLCwwMCwsLCwsLCwsLCwsLDAsLDAsLCwsAAAsLDA0MDAsLCwsLCwAAAAAAAAAMCwsAAAAAAAAA` +
-	`AAAACwsLCwsMDAwAAAAAAAAAAAAAAAAAAAAACwAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAALCwsLCwsLCwsLCwsLCwsLDAsLCwsLDAsLCwsLCwsLCwsLCwsLCwsLCwwLCwsLCwsLDAwMDAwMCw` +
-	`sLCwsLCwsLCwwMCwwLDAsLCwsLCwsMDAsLCwsLCwsLCwsLCwsLCwsLCwwLCwwLCwsLCwsLCwsLCwsLDAwMDAwLCwsLCwsLCwsLCwsLCwsLCwsLAAAAAAAAAAALCwsLCwsLCwsLDAwLDAsLCwsLCwsM` +
-	`DAsLDAwMCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsMCwsLCwsMCwsLCwsMCwsLCwsLCwsLCwsLCwsLDAwLCwwMCwsLDAsLCwsLCwsLCwsLDAwLCwsLCwsLCwsMCwsLCwsLCwsLCw` +
-	`sLCwsLCwsLDAsLDAsLCwsMDAsLCwsLCwsLCwsLDAsLCwsLCwsMCwsLCwsLCwsLDAsLCwsLCwsLCwsLCwsLCwsLDAsLDAwLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwwLCwsM` +
-	`CwwLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCwsLCgoVDg0ADQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0N` +
-	`DQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0ADQ0NAAAAAAAAAAAAAAAAAAANDQ4NDQ0NDA0NDQ0NDQ0NDQ0NDQwNDQ0NDQ0N` +
-	`DQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQwNDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ4NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0AAAANDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0N` +
-	`DQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NAAAAAAAAAA0NDQ0NDQ0NAAAAAA0NDQ0NDQ0NDQ0NDQ0NDQ0MDQwNDQ0NDQ0NDQ0NDQANDQ0NDQwNDQ0NDA0NDQ0N` +
-	`DQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0MDQ0NDQ0AAAAAAAANDQ0NDQANDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0MDQ0NDQ0NAAAADQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0MDQ0NDQ0ADQ0NDQ0NAAAAAAAAAAAAAAAAAAANDQ0NDQ0NDQ0NDA0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQAAAAANDQ0NDQ0NDQ0A` +
-	`AA0NDQ0NDQ0NDQ0NDQwNDQ0NDQ0MDQ0MDQ0NAAANDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDA0NDQ0NDQ0AAA0MDQ4NDQ0NDQ0NDQAAAAAAAAANDQ0AAAAAAAAAAAAADQ0NDQ` +
-	`0NDQwAAAAAAAAAAAAAAAAAAAAADQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANDQ0NDQ0NDQ0NDQ0NDQ0NDg0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQwNDQ0NDA0NDA0NDQ0NDQ0NDA0NDQ0NDQ0N` +
-	`DQ0NDA0NDQ0NDQ0NDQ0ODQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0MDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NAAAAAAAAAAANDQ0NDQ0NDQ0NDQ0NDg0NDQ0NDQ0NDQ0NDQ0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQwNDAwNDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDA0NDQ0NDA0NDQ0NDg4NDQ0ODQ0NDQ0NDQ0NDQ0NDQ0NDQ0MDA0MDQ0NDQ0ODQ0NDQ0NDQ0NDQ0NDQ0NDQ0N` +
-	`DQ0MDAwMDA0NDQ0NDQ0MDAwNDQ0NDQ0NDQ0NDQ0NDQ0MDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQwMDQ0MDA0NDQwNDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDA0MDQwNDA0NDQ0NDQ0NDQ0NDQ0MDQwNDA0NDQ` +
-	`0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NDQ0NCwsY/w4ADg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg` +
-	`4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ADg4OAAAAAAAAAAAAAAAAAAAODg8ODg4ODg4ODg4ODg4ODg4ODg4ODg4PDg4ODg4PDg4ODg` +
-	`4ODg4ODg4ODg4PDg4ODg4ODg4ODg4ODg8ODg4ODg4ODg4ODg4ODg4ODg4ODg4PDg4ODw8ODg4ODw8ODg4ODg4ODg4ODg4ODw4AAAAODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg` +
-	`4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4OAAAAAAAAAA4ODg4ODg4OAAAAAA4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4PDwAODg4ODg8ODg4ODg4ODg4ODg4ODg4ODg` +
-	`4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4AAAAAAAAODg8ODgAODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4OAAAADg4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ADg4ODg4OAAAAAAAAAAAAAAAAAAAODg4ODw4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODgAAAAAODg4ODg4ODg4AAA4PDg4ODg` +
-	`4ODg4ODg8ODg4ODg4ODg4PDg4OAAAODg4ODg4PDg4ODg8ODg4ODg4ODg4ODg8ODg4ODg4ODg4ODg4ODg4ODg4ODg4AAA4PDg8ODw8ODg4ODgAAAAAAAAAODg4AAAAAAAAAAAAADg4PDg4PDg4AAAAA` +
-	`AAAAAAAAAAAAAAAADgAAAAAAAAAAAAAAAAAA/wAAAAAAAAAAAAAAAAAODg4ODg4ODg4ODg4ODg4ODw4ODg4ODw4ODg4ODg4ODg4ODg4ODg8ODg4ODw8PDw8PDw8PDw8PDg4ODg4ODg4ODg8PDw4ODw` +
-	`4ODg4ODg4PDw4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODg4OAAAAAAAAAAAODg4ODg4ODg4ODg4ODw4ODg4ODg4PDw4ODg8ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg8PDg4ODg4ODg8ODw8PDg4PDg4ODg4ODg4ODg4PDg4ODw4ODg4ODw4ODg4ODw8ODg4PDg4ODg4ODg4ODg4ODg4ODw8ODw4PDg4ODg4PDg4ODg4ODg4ODg4ODg4ODg4ODg4ODg8ODg` +
-	`4PDw4ODg4ODg4ODg4ODg4ODg4ODg4PDg4PDg4ODg4PDw4ODg4ODg4ODg4ODg4ODg4PDw4ODg8ODg4ODg4ODg4ODg4ODg4ODg4ODg4ODw4PDg8ODw4ODg4ODg4ODg8ODg4ODg4ODw4ODg4ODg4ODg4O` +
-	`Dg4ODg4ODg4ODg4ODg4ODAwbERAAEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQ` +
-	`EBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAAEBAQAAAAAAAAAAAAAAAAAAAQEBEQEBAQERAQEBAQEBAQEBAQEBEQERAQEBAQEBAREBAQEBAQEBEQEBAQ` +
-	`EBAQEBAQEBAQEBAQEBEQEREQEBAREBAQEBAQEBAQEBAQEBAQEBAREBAQEBEQEBAQEREQEBAQEBAQEBAQEBAQERAAAAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQ` +
-	`EBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQAAAAAAAAABAQEBAQEBAQAAAAABAQEBAQEBAQEBAQEBAQEBAREBEQEBAQEBAQEBAREQAQEBAQEBEQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQ` +
-	`EBAQEBAQEBAQEBAQEBAQEBAQEBAAAAAAAAAQEBEQEAAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAREBAQEBAQAAAAEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AREBAQEBAAEBAQEBAQAAAAAAAAAAAAAAAAAAAQEBAQEBAQEBAQEBEQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEAAAAAAQEBAQEBAQEBAAABAQEBAQEBAQEBAQEBAQ` +
-	`EBEQEBAQEBAQEBAQAAAQEBAQEBAQEBAREBAREBAQEBAQEBAREBAREBAQEBAQEBAQEBAQEBAQEBAQEBAAABAQEBEQEBAQEBAQEAAAAAAAAAAQEBAAAAAAAAAAAAAAEBAQEBAQEBAAAAAAAAAAAAAAAA` +
-	`AAAAAAEAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAQEBAQEBAQEBAQEBAQEBAQERAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQERAQERAQERAQEBAQEBAQEBERERAQEBAQEBAQEBAR` +
-	`ERAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEREREREQEBAQEBAQEBAQEBAQEBAQEBAQAAAAAAAAAAAQEBAQEBAQEBAQEREQERAQEBAQEBAREBAQEBAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQEBAQEBEQEREQEBAQEBAQEBAREBAQEBAQEBAQEBAQEBAQERAQEBAQEREQEBAREBAQERAQEBAQEBAQEBAQEBERERAQEBAQEBAREBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAREBAQEBAQEBAQ` +
-	`EBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQERAQEBAQEBAQEBAQEBAQEBAQEB` +
-	`AQEBAQEBAQDg4dExEAERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`ERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`ERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`EREREREREREREREREREREREREREREREREREREREREREREREREREREREREREAERERAAAAAAAAAAAAAAAAAAARERIREREREhEREREREREREREREREREhESERESERESERERERERERIRERERERESERIRER` +
-	`ERERERERIREhIRERESERERERERERERERERERERERESERIREhIREREREhIREREREREREREREREREhEAAAARERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`ERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`ERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERER` +
-	`ERERERERERERERERERERERERERERERERAAAAAAAAABERERESERERAAAAABERERERERERERERERERERESERIRERERERERERESEgARERERERIRERERERERERERERERERERERERERERERERERERERERER` +
-	`EREREREREREREREREAAAAAAAARERIREQARERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERESERERERESAAAAERERERERERERERERERERERERERESEREREREA` +
-	`ERERERERAAAAAAAAAAAAAAAAAAAREREREhEREREREREREREREREREREREREREREREREREREREREREREREREREREREREREREREREREQAAAAAREREREREREREAABERERERERERERERERERERIRERERER` +
-	`ERERERAAARERERERIRERESERESERERERIRERESERESERERERIREREREREREREREREREREAABERERMRERIREREREQAAAAAAAAASEREAAAAAAAAAAAAAERERERESEREAAAAAAAAAAAAAAAAAAAAAEQAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAREREREREREREREREREREREhEREREREhEREREREREREREREREREREREREREREREREREhISEhISEhERERERERERERISEhERERERERERERESEhERERERER` +
-	`ERERERERERERERERESEREREREREREREREREREREhISEhIRERERERERERERERERERERERERAAAAAAAAAAAREREREREREREREREREhERERERERETERERERERERERERERERERERERERERERERERISERER` +
-	`ERERERIREhIRERESERERERESERERERESEREREREREREREhEREREREhIRERISEREREhERERERERERERERERISERESERERERESERERERERERERERERERERERERERESERISEhESEhERERERERERERERER` +
-	`ERERERERESEREREREREREREhERERERERERERERERISERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERERIREREREREREhERERERERERERERERERERERERERERERERER` +
-	`Dw8g/xMAExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEx` +
-	`MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMT` +
-	`ExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEx` +
-	`MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMAExMTAAAAAAAAAAAAAAAAAAATExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMUExMTExMTExMTExMT` +
-	`ExMTExMTExMTExMTExMTExMTExMTExMTExMTExQTExMTExMTExMTExMTExMTExMTExMAAAATExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEx` +
-	`MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMT` +
-	`ExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEx` +
-	`MTExMTExMTExMTExMTExMTAAAAAAAAABMTExMTExMTAAAAABMTExMTExMTExMTExMTExMTFBMUExMTExMTExMUFAATExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMT` +
-	`ExMTExMAAAAAAAATExQTEwATExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTAAAAExMTExMTExMTExMTExMTExMTExMTExMTExMAExMTExMTAA` +
-	`AAAAAAAAAAAAAAAAATExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEwAAAAATExMTExMTExMAABMTExMTExMTExMTExMTExMTExMTExMTExMTAAAT` +
-	`ExMTExMUExMTExUTExMTExMTExMTExUTExMTExMTExMTExMTExMTExMTExMAABMTExQTExMTExMTEwAAAAAAAAATExMAAAAAAAAAAAAAExMTExMTFBMAAAAAAAAAAAAAAAAAAAAAEwAAAAAAAAAAAA` +
-	`AAAAAA/wAAAAAAAAAAAAAAAAATExMTExMTExMTExMTExMTExMTExMTFBMTExMTExMTExMTExMTExMTExITExMTExMTExMTExMTExMTExMTExMTExQUExMTExMTExMTExMUFBMTExMTExMTExMTExMT` +
-	`ExMTExMTExQTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTAAAAAAAAAAATExMTExMTExMTExMTFBMTExMTExMUExMTExQTExMTExMTExMTExMTExMTExMTExISExMTExMTExMTEx` +
-	`MTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTEhMTExMTExMUFBMTExMTExMTExMTExMTExMTExMT` +
-	`ExMUExMTExMTExMTExMTExMTExMTExMTExMUExMTExQTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMTExMUExQTExMTExMTExMTExMTExMTExMTExMTExMTExMTEBAhFRQAFB` +
-	`QUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQU` +
-	`FBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFB` +
-	`QUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQU` +
-	`FBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQAFBQUAAAAAAAAAAAAAAAAAAAUFBMUFBQUExQUFBQUFBQUFBQUFBMUFBQUFBQUFBQUFBQUFBQUFBMUFBQUFBQUFBQUFBQUFBQUFBQTFBMUFBQUFB` +
-	`QUFBQUFBQUFBQUFBQUFBQUFBQUFBUUFBQUFBQUFBQUFBQUFBQUFBQUFBQAAAAUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQU` +
-	`FBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFB` +
-	`QUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQU` +
-	`FBQUFBQUFBQUAAAAAAAAABQUFBQUFBQUAAAAABQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFAAUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQAAA` +
-	`AAAAAUFBQTFAAUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQTFBQUFBQUAAAAFBQUFBQUFBQUFBQUFBQUFBQUFBQTFBQUFBQAFBQUFBQUAAAAAAAAAAAA` +
-	`AAAAAAAUFBQUFBQUFBQUFBMUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFAAAAAAUFBQUFBQUFBQAABQTFBQUFBQUFBQUFBMUFBQUFBQUFBQUFBQUAAAUFBQUFBQUFB` +
-	`QTFBUTFBQUFBQUFBQTFBUTFBQUFBQUFBQUFBQUFBQUFBQUFBQAABQTFBUUFBQUFBQUFAAAAAAAAAAUFBQAAAAAAAAAAAAAFBQTFBQUFBQAAAAAAAAAAAAAAAAAAAAAFAAAAAAAAAAAAAAAAAAAAQAA` +
-	`AAAAAAAAAAAAAAAUFBQUFBQUFBQUFBQUFBQUExQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBMUFBUVFBUVFBQUFBQUFBQUFBQUFBQUFBQUFBMUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBMUFB` +
-	`QUFBQUFBQUFBQUFBQUExMTExMUFBQUFBQUFBQUFBQUFBQUFBQUAAAAAAAAAAAUFBQUFBQUFBQUFBQUFRQUFBQUFBQVFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBUVFBQUFBQUFBQUFBQTFBQUFBQU` +
-	`FBQTFBQUFBQUFBQUExQUFBQUFBQUFBQUExMUFBQTFBQUExQUFBQUFBQUFBQUFBQUFBQUFBQUFBQTFBQUFBQUFBQUFBQUFBQUFBQUExQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFB` +
-	`QUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUFBQUERElFxYAFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFh` +
-	`YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFh` +
-	`YWFhYWFhYWFhYWFhYWFhYWFhYWFhYAFhYWAAAAAAAAAAAAAAAAAAAWFhcWFhYWFhYWFhYWFhYWFhYWFhYWFxYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFxcWFhYXFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhcWFhYWFhYWFhYWFhYWFhYWFhYWFhYAAAAWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFh` +
-	`YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFh` +
-	`YWAAAAAAAAABYWFhYWFhYWAAAAABYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYXFwAWFhYWFhYWFhYWFRYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYVFhYWFhYAAAAAAAAWFhcX` +
-	`FgAWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWAAAAFhYWFhYWFhYWFhYWFhYWFhYWFhYXFhYWFhYAFhYWFhYWAAAAAAAAAAAAAAAAAAAWFh` +
-	`YWFhYWFhYWFRYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFgAAAAAWFhYWFhYWFhYAABYWFhYWFhYWFhYWFhcWFhYWFhYVFhYWFhYWAAAWFhYWFhcWFhYWFhYWFhYW` +
-	`FhcWFhYWFhYWFhYWFhcWFhYWFhYWFRYWFhYWFhYAABYXFhcWFhcWFhYWFgAAAAAAAAAWFhYAAAAAAAAAAAAAFhYWFhYWFhUAAAAAAAAAAAAAAAAAAAAAFgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAWFhYWFhYWFhYWFhYWFhYWFxYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhcWFhcXFhcXFhYWFhYWFhYWFhYWFhYWFhYWFhcWFhYWFhYWFhYXFhYWFhYWFhYWFhYWFhYWFhYWFhcWFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWAAAAAAAAAAAWFhYWFhYWFhYWFhYWFxYWFhYWFhYXFhYWFxYWFhYWFhYWFhYWFhYWFhYWFhYWFhUVFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFh` +
-	`YWFhYWFxYWFhYWFhYWFhYWFxcWFhYXFhYWFhYWFhYWFhYWFhYWFhYWFxYXFhYWFhYXFhYWFhYWFhYWFhYWFhYWFhYWFxYWFRYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYW` +
-	`FhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFxYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWExMqGxkAGRkZGRkZGRkZGRkZGRkZGR` +
-	`kZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZ` +
-	`GRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGR` +
-	`kZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZ` +
-	`GRkZGRkZGRkZGRkZGRkAGRkZAAAAAAAAAAAAAAAAAAAZGRkZGRkZGhkZGRkZGRkZGRkZGRgZGRkZGRkZGRkZGRkZGRkZGRoZGRkZGRkZGRkZGRkZGRkZGRoZGRoZGRkZGRkZGRkZGRkZGRkZGRkZGR` +
-	`kZGRkZGRsZGhkZGRkZGRkZGRkZGRkZGRkZGRkAAAAZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZ` +
-	`GRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGR` +
-	`kZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRoZGRkZAAAAAAAA` +
-	`ABkZGRkZGRkZAAAAABkZGRkZGRkZGRkZGRkZGRkaGRoZGRkZGRkZGRkZGQAZGRkZGRkZGRkZGBkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkYGRkZGRkAAAAAAAAZGRkZGQAZGRkZGR` +
-	`kZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkaGRkZGRkZAAAAGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkAGRkZGRkZAAAAAAAAAAAAAAAAAAAZGRkZGRkZGRkZ` +
-	`GBgZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGQAAAAAZGRkZGRkZGRkAABkZGRkZGRkZGRkZGRkZGRkZGRkYGRkZGRkZAAAZGRkZGRkZGRkZGRoZGRkZGRkZGRkZGR` +
-	`oZGRkZGRkZGRkZGRkZGBkZGhkZGRkAABkZGhoaGRkZGRkZGQAAAAAAAAAaGRkAAAAAAAAAAAAAGRkZGRkZGRgAAAAAAAAAAAAAAAAAAAAAGQAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAZGRkZ` +
-	`GRkZGRkZGRkZGRkZGRkZGRkZGhkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRoaGRoZGRkZGRkZGRkaGhkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGR` +
-	`kZGRkZGRkZGRkZGRkZGRkZGRkZGRkZAAAAAAAAAAAZGRkZGRkZGRkZGRkZGhkZGRkZGRkaGRkZGRkaGRkZGRkZGRkZGRkZGRkZGRkZGRgYGRkZGRkZGRkZGRkZGRkZGRkZGRkaGRkZGRkZGRkZGRkZ` +
-	`GRkZGRkZGRkZGRkZGRkZGRkZGhkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkaGBkZGhkZGhkZGRkZGRkZGRkZGhkZGRkZGRkaGRkZGRkZGRkZGhkZGRkZGRkZGRkZGR` +
-	`oaGRkaGhkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGhkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZGRkZFRUu/xwAHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHB` +
-	`wcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHB` +
-	`wcHBwcHBwAHBwcAAAAAAAAAAAAAAAAAAAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGxwbHBwcHBwbHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwbHBwcHBwbHBwcHBwcHBwcHBwcHBwcHBwcHBwcGx0c` +
-	`HBwcGxwcHBwcHBwcHBwcHBwcGxwAAAAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHB` +
-	`wcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcAAAAAAAAABwcHBwcHB` +
-	`wcAAAAABwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHAAcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwAAAAAAAAcHBwbHAAcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcAAAAHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwAHBwcHBwcAAAAAAAAAAAAAAAAAAAcHBwcGxwcHBwcHB0cHBwcHB` +
-	`wcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHAAAAAAcHBwcHBwcHBwAABwbHBwcHBwcHBwcHBwcHBscHBwcHBwbHBwcAAAcHBwcHBwcHBwbHBwbHBwcHBwcHBwbHBwbHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwAABwcHB0cGxwcHBwcHAAAAAAAAAAcHBwAAAAAAAAAAAAAHBwbHBwcHBwAAAAAAAAAAAAAAAAAAAAAHAAAAAAAAAAAAAAAAAAA/wAAAAAAAAAAAAAAAAAcHBwcHBwcHBwcHB` +
-	`wcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBscHBwcGxwcGxwcGxwcGxwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcAAAAAAAAAAAcHBwcHBwcHBwcGxscHBwcHBwcHBwdGxwcGxscHBwcHBwcHBwcHBwcHBwcHBwcHBoaHBwcHBwcHBwcHBwbHBwbHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHB` +
-	`wcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwbHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBscHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwbHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwc` +
-	`HBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcGxwbHBscGxwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcHBwcFxcyHx4AHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4A` +
-	`Hh4eAAAAAAAAAAAAAAAAAAAeHh4eHh4eHh4eHh4eHh4eHh4eHh8eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHR4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4AAAAeHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eAAAAAAAAAB4eHh4eHh4eAAAAAB4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHgAeHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4AAAAAAAAeHh4eHgAeHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eAAAAHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4AHh4eHh4eAAAAAAAAAAAAAAAAAAAeHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHgAAAAAeHh4eHh4eHh4AAB4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eAAAeHh4eHh4fHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4AAB4eHh8eHh4eHh4eHgAAAAAAAAAeHh4AAAAAAAAAAAAAHh4eHh4fHh4AAAAAAAAAAAAAAAAAAAAAHgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAeHh4eHh4eHh4eHh4eHh4eHh4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHR4eHR4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh` +
-	`4eHh4eHh4eAAAAAAAAAAAeHh4eHh4eHh4eHh4eHx4eHh4eHh4fHh4eHh0eHh4eHh4eHh4eHh4eHh4eHh4eHh0dHh4eHh4eHh4eHh4eHh4fHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4e` +
-	`Hh4eHh4eHh4eHh4eHh4eHh4eHR4dHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4fHh4eHh0eHh4eHh` +
-	`4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eHh4eGRk2/yAAICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg` +
-	`ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIC` +
-	`AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg` +
-	`ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAAICAgAAAAAA` +
-	`AAAAAAAAAAAAAgICAgICAgICAgICAgICAgICAgICEgICAhICAgICAhICAgICAgICAgICAgICAgICAgICAgICAgICAgISAgICAgICAgICAgICAgICAgICAgICAhICAgISEgICAgISAgICAgICAgICAg` +
-	`ICAgICAAAAAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIC` +
-	`AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg` +
-	`ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgAAAAAAAAACAgICAgICAgAAAAACAgICAgICAgIC` +
-	`AgICAgICAgICAgICAgICAgICAhIQAgICAgICEgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAAAAAAAAAgICEgIAAgICAgICAgICAgICAgICAgICAgICAgICAgICAg` +
-	`ICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgAAAAICAgICAgICAgICAgICAgICAgICAgICAgICAAICAgICAgAAAAAAAAAAAAAAAAAAAgICAgISAgICAgICAgICAgICAgICAgICAgICAgICAgIC` +
-	`AgICAgICAgICAgICAgICAgICAgICAgIAAAAAAgICAgICAgICAAACAgICAgICAgICAgICEgICEgICAgICAgICAgAAAgICAgICAhICAgICAhICAgICAgICAgICAhICAgICAgICAgICAgICAgISAgICAA` +
-	`ACAhISEhICEgICAgIAAAAAAAAAAhICAAAAAAAAAAAAAAICAgICAhICAAAAAAAAAAAAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAA/wAAAAAAAAAAAAAAAAAgICAgICAgICAgICAgICAgICAgICAgICAgIC` +
-	`AgICAgICAgICAgICAgICAgISAgISAgISEhISEhISAgICAgICAgICEhISAgISAgICAgICAhISAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAg` +
-	`AAAAAAAAAAAgICAgICAgICAgISEgISAgICAgICAhICAgICEgICAgICAgICAgICAgICAgICAgICIiICAgICAgICEgISEhICAhICAgICAgICAgICAhICAgISAgICAgISAgICAgICAgICAgICAgICAgIC` +
-	`AgICAgICAgICAgISAhICAgICAgICAgICAgICAgICAgICAgICAgICAgICEgICAgICAgICAgICAgICAgISAgICAgICAgICAgICAgICAhICAgICAgICAgICAgICAgICAgISAgICAgICAgICAgICAgICAg` +
-	`ICAgICAgICAgISAhICAgICAgICAgICAgICEgICAhICEgISAgICAgICAgICAgICAgICAgICAgICAgICAgGxs6JCMAIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy` +
-	`MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy` +
-	`MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMAIyMjAAAAAAAAAAAAAAAA` +
-	`AAAjIyIjIyMjIyMjIyMjIyMjIyMjIyQjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMiIyQjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIiMjIyIjIyMjIyMjIyMjIyMjIyMAAA` +
-	`AjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy` +
-	`MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyIjIyMjAAAAAAAAACMjIyMjIyMjAAAAACMjIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMjIwAjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMAAAAAAAAjIyMjIwAjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy` +
-	`MjIyMjIyMjIyMjIyMjIyMjIyMjIyMjAAAAIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMAIyMjIyMjAAAAAAAAAAAAAAAAAAAjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMjIyMjIwAAAAAjIyMjIyMjIyMAACMiIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjAAAjIyMjIyMkIyMiIyMiIyMjIyMjIyMiIyMiIyMjIyMjIyMjIyMjIyMjIyMjIyMAACMjIyQjIy` +
-	`MjIyMjIwAAAAAAAAAjIyMAAAAAAAAAAAAAIyMjIyMjIyMAAAAAAAAAAAAAAAAAAAAAIwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAjIyMjIyMjIyMjIyMjIyMjIiMjIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjAAAAAAAAAA` +
-	`AjIyMjIyMjIyMjIyMjIyMjIyMjIyMkIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyQkIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIiIjIyMiIyMjIyMjIyMjIyMjIyMj` +
-	`IyMjIyMjIyMjIyMiIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMiIyMjIyMjIyMjIyMjIyMjIyMjIiMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIy` +
-	`MjIyMjIyMjIyMjIyMjIyMjIyMjIyMiIyIjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjIyMjHR1D/ygAKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo` +
-	`KCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKC` +
-	`goKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo` +
-	`KCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgAKCgoAAAAAAAAAAAAAAAAAAAoKCgoKC` +
-	`goKSgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgpKCgoKCooKSgoKCkoKCgoKCgoKCgoKCgoKCgAAAAoKCgoKCgo` +
-	`KCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKC` +
-	`goKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo` +
-	`KCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCkoKCgoAAAAAAAAACgoKCgoKCgoAAAAACgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKC` +
-	`goKCgoKAAoKCgoKCgoKCgoJygoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgnKCgoKCgAAAAAAAAoKCgpKAAoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo` +
-	`KCgoKCgoKCgpKCgoKCgoAAAAKCgoKCgoKCgoKCgoKCgoKCgoKCgpKCgoKCgAKCgoKCgoAAAAAAAAAAAAAAAAAAAoKCgoKCgoKCgoJygoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKC` +
-	`goKCgoKCgoKAAAAAAoKCgoKCgoKCgAACgoKCgoKCgoKCgoKCkoKCgoKCgnKCgpKCgoAAAoKCgoKCgoKCgoKCcoKCgoKCkoKCgoKCcoKCgoKCkoKCgoKCgoJygoKCgoKCgAACgpKSkpKCgoKCgoKAAA` +
-	`AAAAAAApKCgAAAAAAAAAAAAAKCgoKCgoKCcAAAAAAAAAAAAAAAAAAAAAKAAAAAAAAAAAAAAAAAAA/wAAAAAAAAAAAAAAAAAoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCkoKC` +
-	`koKCgoKCgoKCgoKCgoKSgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCkoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoAAAAAAAAAAAoKCgoKCgo` +
-	`KCgoKCgoKSgoKCgoKCgpKCgoKCgpKCgoKCgoKCgoKCgoKCgoKCgoKCcnKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKSgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgpKSgpKC` +
-	`goKCgoKCgoKCgoKCgoKCgoKCgoKCgoKSgoJygpKCgoKSgoKCgoKCgoKCgoKSgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgo` +
-	`KCgoKCgoKCgoKCkoKCgpKCkoKSgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoKCgoIiJLLi0ALS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0t` +
-	`LS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0ALS0tAAAAAAAAAAAAAAAAAAAtLSwtLS0tLS0tLS0t` +
-	`LS0tLS0tLSwtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS4tLS0tLS0tLS0tLS0tLS0tLS0tLS0AAAAtLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0t`
-
-const MonacoFontData4 = `LS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0t` +
-	`LS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tAAAAAAAAAC0tLS0tLS0tAAAAAC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLQAtLS0tLS0tLS0tLi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0uLS0tLS0AAAAAAAAtLS0sLQAtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tAAAALS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0A` +
-	`LS0tLS0tAAAAAAAAAAAAAAAAAAAtLS0tLS0tLS0tLi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLQAAAAAtLS0tLS0tLS0AAC0tLS0tLS0tLS0tLS0tLS0tLS0uLS` +
-	`0tLS0tAAAtLS0tLS0tLS0tLS0tLS0tLS4tLS0tLS0tLS0tLS4tLS0tLS0tLi0tLS0tLS0AAC0tLS4tLS0tLS0tLQAAAAAAAAAsLS0AAAAAAAAAAAAALS0tLS0tLS4AAAAAAAAAAAAAAAAAAAAALQAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAtLS0tLS0tLS0tLS0tLS0tLC0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSwtLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS` +
-	`0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tAAAAAAAAAAAtLS0tLS0tLS0tLS0tLi0tLS0tLS0uLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0t` +
-	`LS0tLS0tLS0uLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLCwtLS0sLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0sLS0tLS0tLS0tLS0tLS0tLS0tLC0tLi0tLS0uLS0tLS0tLS0tLS0tLC` +
-	`0tLS0tLS0uLS0tLS0tLS0tLi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0t` +
-	`JiYAAQAAAACbHAAAGkAAABpMAAAaYAAAGnQAABqIAAAanAAAGrAAABrEAAAa2AAAGuwAABsAAAAbFAAAGygAABs8AAAbUAAAG2QAABt4AAAbjAAAG6AAABu0AAAbyAAAG9wAABvwAAAcBAAAHBgAAB` +
-	`wsAAAcQAAAHFQAABxoAAAcfAAAHJAAABykAAAcuAAAHMwAABzgAAAc9AAAHQgAAB0cAAAdMAAAHUQAAB1YAAAdbAAAHYAAAB2UAAAdqAAAHbwAAB3QAAAd5AAAHfgAAB4MAAAeIAAAHjQAAB5IAAAe` +
-	`XAAAHnAAAB6EAAAemAAAHqwAAB7AAAAe1AAAHugAAB78AAAfEAAAHyQAAB84AAAfTAAAH2AAAB90AAAfiAAAH5wAAB+wAAAfxAAAH9gAAB/sAAAgAAAAIBQAACAoAAAgPAAAIFAAACBkAAAgeAAAII` +
-	`wAACCgAAAgtAAAIMgAACDcAAAg8AAAIQQAACEYAAAhLAAAIUAAACFUAAAhaAAAIXwAACGQAAAhpAAAIbgAACHMAAAh4AAAIfQAACIIAAAiHAAAIjAAACJEAAAiWAAAImwAACKAAAAilAAAIqgAACK8` +
-	`AAAi0AAAIuQAACL4AAAjDAAAIyAAACM0AAAjSAAAI1wAACNwAAAjhAAAI5gAACOsAAAjwAAAI9QAACPoAAAj/AAAJBAAACQkAAAkOAAAJEwAACRgAAAkdAAAJIgAACScAAAksAAAJMQAACTYAAAk7A` +
-	`AAJQAAACUUAAAlKAAAJTwAACVQAAAlZAAAJXAAACWEAAAlmAAAJawAACXAAAAl1AAAJegAACX8AAAmEAAAJhwAACYoAAAmPAAAJlAAACZcAAAmcAAAJoQAACaYAAAmrAAAJsAAACbUAAAm6AAAJvwA` +
-	`ACcIAAAnHAAAJygAACc8AAAnUAAAJ2QAACd4AAAnjAAAJ6AAACe0AAAnyAAAJ9wAACfwAAAoBAAAKBgAACgsAAAoQAAAKFQAAChoAAAofAAAKJAAACikAAAouAAAKMwAACjgAAAo9AAAKQgAACkcAA` +
-	`ApMAAAKUQAAClYAAApbAAAKYAAACmUAAApqAAAKbwAACnQAAAp5AAAKfgAACoMAAAqIAAAKjQAACpIAAAqXAAAKnAAACqEAAAqmAAAKqwAACrAAAAq1AAAKugAACr8AAArEAAAKyQAACs4AAArTAAA` +
-	`K2AAACt0AAAriAAAK5wAACuwAAArxAAAK9gAACvsAAAsAAAALBQAACwoAAAsPAAALFAAACxkAAAseAAALIwAACygAAAstAAALMgAACzcAAAs8AAALQQAAC0YAAAtLAAALUAAAC1UAAAtaAAALXwAAC` +
-	`2QAAAtpAAALbgAAC3MAAAt4AAALfQAAC4IAAAuHAAALjAAAC5EAAAuWAAALmwAAC6AAAAulAAALqgAAC68AAAu0AAALuQAAC74AAAvDAAALyAAAC80AAAvSAAAL1wAAC9wAAAvhAAAL5gAAC+sAAAv` +
-	`wAAAL9QAAC/oAAAv/AAAMBAAADAkAAAwOAAAMEQAADBQAAAwZAAAMHgAADCMAAAwoAAAMLQAADDIAAAw3AAAMPAAADEEAAAxGAAAMSwAADE4AAAxRAAAMVgAADFsAAAxeAAAMYQAADGYAAAxrAAAMc` +
-	`AAADHUAAAx6AAAMfwAADIIAAAyFAAAMigAADI8AAAyUAAAMmQAADJ4AAAyjAAAMqAAADK0AAAyyAAAMtwAADLwAAAy/AAAMwgAADMcAAAzMAAAM0QAADNYAAAzbAAAM4AAADOUAAAzqAAAM7wAADPQ` +
-	`AAAz5AAAM/gAADQMAAA0IAAANDQAADRIAAA0XAAANHAAADSEAAA0mAAANKwAADTAAAA01AAANOgAADT8AAA1EAAANSQAADU4AAA1TAAANWAAADV0AAA1iAAANZwAADWwAAA1xAAANdgAADXsAAA2AA` +
-	`AANhQAADYoAAA2PAAANlAAADZkAAA2eAAANowAADagAAA2tAAANsgAADbcAAA28AAANwQAADcYAAA3LAAAN0AAADdUAAA3aAAAN3wAADeQAAA3pAAAN7gAADfMAAA34AAAN/QAADgIAAA4HAAAODAA` +
-	`ADhEAAA4WAAAOGwAADiAAAA4lAAAOKgAADi8AAA40AAAOOQAADj4AAA5DAAAOSAAADk0AAA5SAAAOVwAADlwAAA5hAAAOZgAADmsAAA5wAAAOdQAADnoAAA5/AAAOhAAADokAAA6OAAAOkwAADpgAA` +
-	`A6dAAAOogAADqcAAA6sAAAOsQAADrYAAA67AAAOwAAADsUAAA7KAAAOzwAADtQAAA7ZAAAO3gAADuMAAA7oAAAO7QAADvIAAA73AAAO/AAADwEAAA8GAAAPCwAADxAAAA8VAAAPGgAADx8AAA8kAAA` +
-	`PKQAADy4AAA8zAAAPOAAADz0AAA9CAAAPRwAAD0wAAA9RAAAPVgAAD1sAAA9gAAAPZQAAD2oAAA9vAAAPdAAAD3kAAA9+AAAPgwAAD4gAAA+NAAAPkgAAD5cAAA+cAAAPoQAAD6YAAA+rAAAPsAAAD` +
-	`7UAAA+6AAAPvwAAD8QAAA/JAAAPzgAAD9MAAA/YAAAP3QAAD+AAAA/lAAAP6AAAD+0AAA/wAAAP9QAAD/gAAA/9AAAQAAAAEAUAABAIAAAQDQAAEBIAABAXAAAQHAAAECEAABAmAAAQKwAAEDAAABA` +
-	`1AAAQOgAAED8AABBEAAAQSQAAEE4AABBTAAAQWAAAEF0AABBiAAAQZwAAEGwAABBxAAAQdgAAEHsAABCAAAAQhQAAEIoAABCPAAAQlAAAEJkAABCeAAAQowAAEKgAABCtAAAQsgAAELcAABC8AAAQw` +
-	`QAAEMYAABDLAAAQ0AAAENUAABDaAAAQ3wAAEOQAABDpAAAQ7gAAEPMAABD4AAAQ/QAAEQIAABEHAAARDAAAEREAABEWAAARGwAAESAAABElAAARKgAAES8AABE0AAAROQAAET4AABFDAAARSAAAEU0` +
-	`AABFSAAARVwAAEVwAABFhAAARZgAAEWsAABFwAAARdQAAEXoAABF/AAARhAAAEYkAABGOAAARkwAAEZgAABGdAAARogAAEacAABGsAAARsQAAEbYAABG7AAARwAAAEcUAABHKAAARzwAAEdQAABHZA` +
-	`AAR3gAAEeMAABHoAAAR7QAAEfIAABH3AAAR/AAAEgEAABIGAAASCwAAEhAAABIVAAASGgAAEh8AABIkAAASKQAAEi4AABIzAAASOAAAEj0AABJCAAASRwAAEkwAABJRAAASVgAAElsAABJgAAASZQA` +
-	`AEmoAABJvAAASdAAAEnkAABJ+AAASgwAAEogAABKNAAASkgAAEpcAABKcAAASoQAAEqYAABKrAAASsAAAErUAABK6AAASvwAAEsQAABLJAAASzgAAEtMAABLYAAAS3QAAEuIAABLnAAAS7AAAEvEAA` +
-	`BL2AAAS+wAAEwAAABMFAAATCgAAEw8AABMUAAATGQAAEx4AABMjAAATKAAAEy0AABMyAAATNwAAEzwAABNBAAATRgAAE0sAABNQAAATVQAAE1oAABNfAAATZAAAE2kAABNuAAATcwAAE3gAABN9AAA` +
-	`TggAAE4cAABOMAAATkQAAE5YAABObAAAToAAAE6UAABOqAAATrwAAE7QAABO5AAATvgAAE8MAABPIAAATzQAAE9IAABPXAAAT3AAAE+EAABPmAAAT6wAAE/AAABP1AAAT+gAAE/8AABQEAAAUCQAAF` +
-	`A4AABQTAAAUGAAAFB0AABQiAAAUJwAAFCwAABQxAAAUNgAAFDsAABRAAAAURQAAFEoAABRPAAAUVAAAFFkAABReAAAUYwAAFGgAABRtAAAUcgAAFHcAABR8AAAUgQAAFIYAABSLAAAUkAAAFJUAABS` +
-	`aAAAUnwAAFKQAABSpAAAUrgAAFLMAABS4AAAUvQAAFMIAABTHAAAUzAAAFNEAABTWAAAU2wAAFOAAABTlAAAU6gAAFO8AABT0AAAU+QAAFP4AABUDAAAVCAAAFQ0AABUSAAAVFwAAFRwAABUhAAAVJ` +
-	`gAAFSsAABUwAAAVNQAAFToAABU/AAAVRAAAFUkAABVOAAAVUwAAFVgAABVdAAAVYAAAFWMAABVmAAAVaQAAFWwAABVxAAAVdgAAFXsAABWAAAAVhQAAFYoAABWPAAAVlAAAFZkAABWeAAAVowAAFag` +
-	`AABWtAAAVsgAAFbcAABW8AAAVwQAAFcYAABXLAAAV0AAAFdUAABXaAAAV3wAAFeQAABXpAAAV7gAAFfEAABX0AAAV+QAAFf4AABYDAAAWCAAAFg0AABYSAAAWFwAAFhwAABYhAAAWJgAAFisAABYwA` +
-	`AAWNQAAFjoAABY/AAAWRAAAFkkAABZOAAAWUwAAFlgAABZdAAAWYgAAFmcAABZsAAAWcQAAFnYAABZ7AAAWgAAAFoUAABaKAAAWjwAAFpQAABaZAAAWngAAFqMAABaoAAAWrQAAFrIAABa3AAAWvAA` +
-	`AFsEAABbGAAAWywAAFtAAABbVAAAW2gAAFt8AABbkAAAW6QAAFu4AABbzAAAW+AAAFv0AABcCAAAXBwAAFwwAABcRAAAXFgAAFxsAABcgAAAXJQAAFyoAABcvAAAXNAAAFzkAABc+AAAXQwAAF0gAA` +
-	`BdNAAAXUgAAF1cAABdcAAAXYQAAF2YAABdrAAAXcAAAF3UAABd6AAAXfwAAF4QAABeJAAAXjgAAF5MAABeYAAAXnQAAF6IAABenAAAXrAAAF7EAABe2AAAXuwAAF8AAABfFAAAXygAAF88AABfUAAA` +
-	`X2QAAF94AABfjAAAX6AAAF+0AABfyAAAX9wAAF/wAABgBAAAYBgAAGAsAABgQAAAYFQAAGBoAABgfAAAYJAAAGCkAABguAAAYMwAAGDgAABg9AAAYQgAAGEcAABhMAAAYUQAAGFYAABhbAAAYYAAAG` +
-	`GUAABhqAAAYbwAAGHQAABh5AAAYfgAAGIMAABiIAAAYjQAAGJIAABiXAAAYnAAAGKEAABimAAAYqwAAGLAAABi1AAAYugAAGL8AABjEAAAYyQAAGM4AABjTAAAY2AAAGN0AABjiAAAY5wAAGOwAABj` +
-	`xAAAY9gAAGPsAABj+AAAZAQAAGQYAABkLAAAZEAAAGRUAABkaAAAZHwAAGSQAABkpAAAZLgAAGTMAABk4AAAZPQAAGUIAABlHAAAZTAAAGVEAABlWAAAZWwAAGWAAABllAAAZaAAAGWsAABluAAAZc` +
-	`QAAGXQAABl3AAAZegAAGX0AABmAAAAZgwAAGYYAABmJAAAZjAAAGZEAABmWAAAZmwAAGaAAABmlAAAZqgAAGa8AABm0AAAZuQAAGb4AABnDAAAZyAAAGc0AABnSAAAZ1wAAGdwAABnhAAAZ5gAAGes` +
-	`AABnwAAAZ9QAAGfoAABn/AAAaBAAAGgkAABoOAAAaEwAAGhgAABodAAAaIgAAGicAABosAAAaMQAAGjYAABo7AAAaQAAAGkUAABpKAAAaTwAAGlQAABpZAAAaXgAAGmMAABpoAAAabQAAGnIAABp3A` +
-	`AAafAAAGoEAABqGAAAaiwAAGpAAABqVAAAamgAAGp8AABqkAAAaqQAAGq4AABqzAAAauAAAGr0AABrCAAAaxwAAGswAABrRAAAa1gAAGtsAABrgAAAa5QAAGuoAABrvAAAa9AAAGvkAABr+AAAbAwA` +
-	`AGwgAABsNAAAbEgAAGxcAABscAAAbIQAAGyYAABsrAAAbMAAAGzUAABs6AAAbPwAAG0QAABtJAAAbTgAAG1MAABtYAAAbXQAAG2IAABtnAAAbbAAAG3EAABt2AAAbewAAG4AAABuFAAAbigAAG48AA` +
-	`BuUAAAbmQAAG54AABujAAAbqAAAG60AABuyAAAbtwAAG7wAABvBAAAbxgAAG8sAABvQAAAb1QAAG9oAABvfAAAb5AAAG+kAABvuAAAb8wAAG/gAABv9AAAcAgAAHAcAABwMAAAcEQAAHBYAABwbAAA` +
-	`cIAAAHCUAABwqAAAcLwAAHDQAABw5AAAcPgAAHEMAABxIAAAcTQAAHFIAABxXAAAcXAAAHGEAABxmAAAcawAAHHAAABx1AAAcegAAHH8AAByEAAAciQAAHI4AAByTAAAcmAAAHJ0AAByiAAAcpwAAH` +
-	`KwAAByxAAActgAAHLsAABzAAAAcxQAAHMoAABzPAAAc1AAAHNkAABzeAAAc4wAAHOgAABztAAAc8gAAHPcAABz8AAAdAQAAHQYAAB0LAAAdEAAAHRUAAB0aAAAdHwAAHSQAAB0pAAAdLgAAHTEAAB0` +
-	`0AAAdOQAAHT4AAB1DAAAdSAAAHU0AAB1SAAAdVwAAHVwAAB1hAAAdZgAAHWsAAB1wAAAddQAAHXoAAB1/AAAdhAAAHYkAAB2OAAAdkwAAHZgAAB2dAAAdogAAHacAAB2sAAAdsQAAHbYAAB27AAAdw` +
-	`AAAHcUAAB3KAAAdzwAAHdQAAB3ZAAAd3gAAHeMAAB3oAAAd7QAAHfIAAB33AAAd/AAAHgEAAB4GAAAeCwAAHhAAAB4VAAAeGgAAHh8AAB4kAAAeKQAAHi4AAB4zAAAeOAAAHj0AAB5CAAAeRwAAHkw` +
-	`AAB5RAAAeVgAAHlsAAB5gAAAeZQAAHmoAAB5vAAAedAAAHnkAAB5+AAAegwAAHogAAB6NAAAekgAAHpcAAB6cAAAeoQAAHqYAAB6rAAAesAAAHrUAAB66AAAevwAAHsQAAB7JAAAezgAAHtMAAB7YA` +
-	`AAe3QAAHuIAAB7nAAAe7AAAHvEAAB72AAAe+wAAHwAAAB8FAAAfCgAAHw8AAB8UAAAfGQAAHx4AAB8jAAAfKAAAHy0AAB8yAAAfNwAAHzwAAB9BAAAfRgAAH0sAAB9QAAAfVQAAH1oAAB9fAAAfZAA` +
-	`AH2kAAB9uAAAfcwAAH3gAAB99AAAfggAAH4cAAB+MAAAfkQAAH5YAAB+bAAAfoAAAH6UAAB+qAAAfrwAAH7QAAB+5AAAfvgAAH8MAAB/IAAAfzQAAH9IAAB/XAAAf3AAAH+EAAB/mAAAf6wAAH/AAA` +
-	`B/1AAAf+gAAH/8AACAEAAAgCQAAIA4AACATAAAgGAAAIB0AACAiAAAgJwAAICwAACAxAAAgNgAAIDsAACBAAAAgRQAAIEoAACBPAAAgVAAAIFkAACBeAAAgYwAAIGgAACBtAAAgcgAAIHcAACB8AAA` +
-	`ggQAAIIYAACCLAAAgkAAAIJUAACCaAAAgnwAAIKQAACCpAAAgrgAAILMAACC4AAAgvQAAIMIAACDHAAAgzAAAINEAACDWAAAg2wAAIOAAACDlAAAg6gAAIO8AACD0AAAg+QAAIP4AACEDAAAhCAAAI` +
-	`Q0AACESAAAhFwAAIRwAACEhAAAhJgAAISsAACEwAAAhNQAAIToAACE/AAAhRAAAIUkAACFOAAAhUwAAIVgAACFdAAAhYgAAIWcAACFsAAAhcQAAIXYAACF7AAAhgAAAIYUAACGKAAAhjwAAIZQAACG` +
-	`ZAAAhngAAIaMAACGoAAAhrQAAIbIAACG3AAAhvAAAIcEAACHGAAAhywAAIdAAACHVAAAh2gAAId8AACHkAAAh6QAAIe4AACHzAAAh+AAAIf0AACICAAAiBwAAIgwAACIRAAAiFgAAIhsAACIgAAAiJ` +
-	`QAAIioAACIvAAAiNAAAIjkAACI+AAAiQwAAIkgAACJNAAAiUgAAIlcAACJcAAAiYQAAImYAACJrAAAicAAAInUAACJ6AAAifwAAIoQAACKJAAAijgAAIpMAACKYAAAinQAAIqIAACKnAAAirAAAIrE` +
-	`AACK2AAAiuwAAIsAAACLFAAAiygAAIs8AACLUAAAi2QAAIt4AACLjAAAi6AAAIu0AACLyAAAi9wAAIvwAACMBAAAjBgAAIwsAACMQAAAjFQAAIxoAACMfAAAjJAAAIykAACMuAAAjMwAAIzgAACM9A` +
-	`AAjQgAAI0cAACNMAAAjUQAAI1YAACNbAAAjYAAAI2UAACNqAAAjbwAAI3QAACN5AAAjfgAAI4MAACOIAAAjjQAAI5IAACOXAAAjnAAAI6EAACOmAAAjqwAAI7AAACO1AAAjugAAI78AACPEAAAjyQA` +
-	`AI84AACPTAAAj2AAAI90AACPiAAAj5wAAI+wAACPxAAAj9gAAI/sAACQAAAAkBQAAJAoAACQPAAAkFAAAJBkAACQeAAAkIwAAJCgAACQtAAAkMgAAJDcAACQ8AAAkQQAAJEYAACRLAAAkUAAAJFUAA` +
-	`CRaAAAkXwAAJGQAACRpAAAkbgAAJHMAACR4AAAkfQAAJIIAACSHAAAkjAAAJJEAACSWAAAkmwAAJKAAACSlAAAkqgAAJK8AACS0AAAkuQAAJL4AACTDAAAkyAAAJM0AACTSAAAk1wAAJNwAACThAAA` +
-	`k5gAAJOsAACTwAAAk9QAAJPoAACT/AAAlBAAAJQkAACUOAAAlEwAAJRgAACUdAAAlIgAAJScAACUsAAAlMQAAJTYAACU7AAAlQAAAJUUAACVKAAAlTwAAJVQAACVZAAAlXgAAJWMAACVoAAAlbQAAJ` +
-	`XIAACV3AAAlfAAAJYEAACWGAAAliwAAJZAAACWVAAAlmgAAJZ8AACWkAAAlqQAAJa4AACWzAAAluAAAJb0AACXCAAAlxwAAJcwAACXRAAAl1gAAJdsAACXgAAAl5QAAJeoAACXvAAAl9AAAJfkAACX` +
-	`+AAAmAwAAJggAACYNAAAmEgAAJhcAACYcAAAmIQAAJiYAACYrAAAmMAAAJjUAACY6AAAmPQAAJkAAACZDAAAmRwAAJksAACZPAAAmUwAAJlcAACZbAAAmXwAAJmMAACZmAAAmaQAAJmwAACZvAAAmc` +
-	`gAAJnUAACZ4AAAmewAAJn8AACaDAAAmhwAAJosAACaPAAAmkwAAJpcAACabAAAmnwAAJqMAACanAAAmqwAAJq8AACazAAAmtwAAJrsAACa/AAAmwz//////////wAAAAD//////////wABAAAAAX+A` +
-	`AAAAAP//////////AAEACQABf4AAAAAA//////////8AAQAgAAF/gAAAAAD//////////wABACEAAX+AAAAAAP//////////AAEAIgABf4AAAAAA//////////8AAQAjAAF/gAAAAAD//////////w` +
-	`ABACQAAX+AAAAAAP//////////AAEAJQABf4AAAAAA//////////8AAQAmAAF/gAAAAAD//////////wABACcAAX+AAAAAAP//////////AAEAKAABf4AAAAAA//////////8AAQApAAF/gAAAAAD/` +
-	`/////////wABACoAAX+AAAAAAP//////////AAEAKwABf4AAAAAA//////////8AAQAsAAF/gAAAAAD//////////wABAC0AAX+AAAAAAP//////////AAEALgABf4AAAAAA//////////8AAQAvAA` +
-	`F/gAAAAAD//////////wABADAAAX+AAAAAAP//////////AAEAMQABf4AAAAAA//////////8AAQAyAAF/gAAAAAD//////////wABADMAAX+AAAAAAP//////////AAEANAABf4AAAAAA////////` +
-	`//8AAQA1AAF/gAAAAAD//////////wABADYAAX+AAAAAAP//////////AAEANwABf4AAAAAA//////////8AAQA4AAF/gAAAAAD//////////wABADkAAX+AAAAAAP//////////AAEAOgABf4AAAA` +
-	`AA//////////8AAQA7AAF/gAAAAAD//////////wABADwAAX+AAAAAAP//////////AAEAPQABf4AAAAAA//////////8AAQA+AAF/gAAAAAD//////////wABAD8AAX+AAAAAAP//////////AAEA` +
-	`QAABf4AAAAAA//////////8AAQBBAAF/gAAAAAD//////////wABAEIAAX+AAAAAAP//////////AAEAQwABf4AAAAAA//////////8AAQBEAAF/gAAAAAD//////////wABAEUAAX+AAAAAAP////` +
-	`//////AAEARgABf4AAAAAA//////////8AAQBHAAF/gAAAAAD//////////wABAEgAAX+AAAAAAP//////////AAEASQABf4AAAAAA//////////8AAQBKAAF/gAAAAAD//////////wABAEsAAX+A` +
-	`AAAAAP//////////AAEATAABf4AAAAAA//////////8AAQBNAAF/gAAAAAD//////////wABAE4AAX+AAAAAAP//////////AAEATwABf4AAAAAA//////////8AAQBQAAF/gAAAAAD//////////w` +
-	`ABAFEAAX+AAAAAAP//////////AAEAUgABf4AAAAAA//////////8AAQBTAAF/gAAAAAD//////////wABAFQAAX+AAAAAAP//////////AAEAVQABf4AAAAAA//////////8AAQBWAAF/gAAAAAD/` +
-	`/////////wABAFcAAX+AAAAAAP//////////AAEAWAABf4AAAAAA//////////8AAQBZAAF/gAAAAAD//////////wABAFoAAX+AAAAAAP//////////AAEAWwABf4AAAAAA//////////8AAQBcAA` +
-	`F/gAAAAAD//////////wABAF0AAX+AAAAAAP//////////AAEAXgABf4AAAAAA//////////8AAQBfAAF/gAAAAAD//////////wABAGAAAX+AAAAAAP//////////AAEAYQABf4AAAAAA////////` +
-	`//8AAQBiAAF/gAAAAAD//////////wABAGMAAX+AAAAAAP//////////AAEAZAABf4AAAAAA//////////8AAQBlAAF/gAAAAAD//////////wABAGYAAX+AAAAAAP//////////AAEAZwABf4AAAA` +
-	`AA//////////8AAQBoAAF/gAAAAAD//////////wABAGkAAX+AAAAAAP//////////AAEAagABf4AAAAAA//////////8AAQBrAAF/gAAAAAD//////////wABAGwAAX+AAAAAAP//////////AAEA` +
-	`bQABf4AAAAAA//////////8AAQBuAAF/gAAAAAD//////////wABAG8AAX+AAAAAAP//////////AAEAcAABf4AAAAAA//////////8AAQBxAAF/gAAAAAD//////////wABAHIAAX+AAAAAAP////` +
-	`//////AAEAcwABf4AAAAAA//////////8AAQB0AAF/gAAAAAD//////////wABAHUAAX+AAAAAAP//////////AAEAdgABf4AAAAAA//////////8AAQB3AAF/gAAAAAD//////////wABAHgAAX+A` +
-	`AAAAAP//////////AAEAeQABf4AAAAAA//////////8AAQB6AAF/gAAAAAD//////////wABAHsAAX+AAAAAAP//////////AAEAfAABf4AAAAAA//////////8AAQB9AAF/gAAAAAD//////////w` +
-	`ABAH4AAX+AAAAAAP//////////AAEAxAABf4AAAAAA//////////8AAQDFAAF/gAAAAAD//////////wABAMcAAX+AAAAAAP//////////AAEAyQABf4AAAAAA//////////8AAQDRAAF/gAAAAAD/` +
-	`/////////wABANYAAX+AAAAAAP//////////AAEA3AABf4AAAAAA//////////8AAQDhAAF/gAAAAAD//////////wABAOAAAX+AAAAAAP//////////AAEA4gABf4AAAAAA//////////8AAQDkAA` +
-	`F/gAAAAAD//////////wABAOMAAX+AAAAAAP//////////AAEA5QABf4AAAAAA//////////8AAQDnAAF/gAAAAAD//////////wABAOkAAX+AAAAAAP//////////AAEA6AABf4AAAAAA////////` +
-	`//8AAQDqAAF/gAAAAAD//////////wABAOsAAX+AAAAAAP//////////AAEA7QABf4AAAAAA//////////8AAQDsAAF/gAAAAAD//////////wABAO4AAX+AAAAAAP//////////AAEA7wABf4AAAA` +
-	`AA//////////8AAQDxAAF/gAAAAAD//////////wABAPMAAX+AAAAAAP//////////AAEA8gABf4AAAAAA//////////8AAQD0AAF/gAAAAAD//////////wABAPYAAX+AAAAAAP//////////AAEA` +
-	`9QABf4AAAAAA//////////8AAQD6AAF/gAAAAAD//////////wABAPkAAX+AAAAAAP//////////AAEA+wABf4AAAAAA//////////8AAQD8AAF/gAAAAAD//////////wABICAAAX+AAAAAAP////` +
-	`//////AAEAsAABf4AAAAAA//////////8AAQCiAAF/gAAAAAD//////////wABAKMAAX+AAAAAAP//////////AAEApwABf4AAAAAA//////////8AASAiAAF/gAAAAAD//////////wABALYAAX+A` +
-	`AAAAAP//////////AAEA3wABf4AAAAAA//////////8AAQCuAAF/gAAAAAD//////////wABAKkAAX+AAAAAAP//////////AAEhIgABf4AAAAAA//////////8AAQC0AAF/gAAAAAD//////////w` +
-	`ABAKgAAX+AAAAAAP//////////AAAAAP//////////AAEAxgABf4AAAAAA//////////8AAQDYAAF/gAAAAAD//////////wABIh4AAX+AAAAAAP//////////AAEAsQABf4AAAAAA//////////8A` +
-	`ASJkAAF/gAAAAAD//////////wABImUAAX+AAAAAAP//////////AAEApQABf4AAAAAA//////////8AAQC1AAF/gAAAAAD//////////wAAAAD//////////wAAAAD//////////wABIg8AAX+AAA` +
-	`AAAP//////////AAEDwAABf4AAAAAA//////////8AAAAA//////////8AAQCqAAF/gAAAAAD//////////wABALoAAX+AAAAAAP//////////AAEhJgABf4AAAAAA//////////8AAQDmAAF/gAAA` +
-	`AAD//////////wABAPgAAX+AAAAAAP//////////AAEAvwABf4AAAAAA//////////8AAQChAAF/gAAAAAD//////////wABAKwAAX+AAAAAAP//////////AAAAAP//////////AAEBkgABf4AAAA` +
-	`AA//////////8AAAAA//////////8AASIGAAF/gAAAAAD//////////wABAKsAAX+AAAAAAP//////////AAEAuwABf4AAAAAA//////////8AASAmAAF/gAAAAAD//////////wABAKAAAX+AAAAA` +
-	`AP//////////AAEAwAABf4AAAAAA//////////8AAQDDAAF/gAAAAAD//////////wABANUAAX+AAAAAAP//////////AAEBUgABf4AAAAAA//////////8AAQFTAAF/gAAAAAD//////////wABIB` +
-	`MAAX+AAAAAAP//////////AAEgFAABf4AAAAAA//////////8AASAcAAF/gAAAAAD//////////wABIB0AAX+AAAAAAP//////////AAEgGAABf4AAAAAA//////////8AASAZAAF/gAAAAAD/////` +
-	`/////wABAPcAAX+AAAAAAP//////////AAElygABf4AAAAAA//////////8AAQD/AAF/gAAAAAD//////////wABAXgAAX+AAAAAAP//////////AAEgRAABf4AAAAAA//////////8AASCsAAF/gA` +
-	`AAAAD//////////wABIDkAAX+AAAAAAP//////////AAEgOgABf4AAAAAA//////////8AAfsBAAF/gAAAAAD//////////wAB+wIAAX+AAAAAAP//////////AAEgIQABf4AAAAAA//////////8A` +
-	`AQC3AAF/gAAAAAD//////////wABIBoAAX+AAAAAAP//////////AAEgHgABf4AAAAAA//////////8AASAwAAF/gAAAAAD//////////wABAMIAAX+AAAAAAP//////////AAEAygABf4AAAAAA//` +
-	`////////8AAQDBAAF/gAAAAAD//////////wABAMsAAX+AAAAAAP//////////AAEAyAABf4AAAAAA//////////8AAQDNAAF/gAAAAAD//////////wABAM4AAX+AAAAAAP//////////AAEAzwAB` +
-	`f4AAAAAA//////////8AAQDMAAF/gAAAAAD//////////wABANMAAX+AAAAAAP//////////AAEA1AABf4AAAAAA//////////8AAfj/AAF/gAAAAAD//////////wABANIAAX+AAAAAAP////////` +
-	`//AAEA2gABf4AAAAAA//////////8AAQDbAAF/gAAAAAD//////////wABANkAAX+AAAAAAP//////////AAEBMQABf4AAAAAA//////////8AAQLGAAF/gAAAAAD//////////wABAtwAAX+AAAAA` +
-	`AP//////////AAEArwABf4AAAAAA//////////8AAQLYAAF/gAAAAAD//////////wABAtkAAX+AAAAAAP//////////AAEC2gABf4AAAAAA//////////8AAQC4AAF/gAAAAAD//////////wABAt` +
-	`0AAX+AAAAAAP//////////AAEC2wABf4AAAAAA//////////8AAQLHAAF/gAAAAAD//////////wABAUEAAX+AAAAAAP//////////AAEBQgABf4AAAAAA//////////8AAQFgAAF/gAAAAAD/////` +
-	`/////wABAWEAAX+AAAAAAP//////////AAEBfQABf4AAAAAA//////////8AAQF+AAF/gAAAAAD//////////wABAKYAAX+AAAAAAP//////////AAEA0AABf4AAAAAA//////////8AAQDwAAF/gA` +
-	`AAAAD//////////wABAN0AAX+AAAAAAP//////////AAEA/QABf4AAAAAA//////////8AAQDeAAF/gAAAAAD//////////wABAP4AAX+AAAAAAP//////////AAEiEgABf4AAAAAA//////////8A` +
-	`AQDXAAF/gAAAAAD//////////wABALkAAX+AAAAAAP//////////AAEAsgABf4AAAAAA//////////8AAQCzAAF/gAAAAAD//////////wABAL0AAX+AAAAAAP//////////AAEAvAABf4AAAAAA//` +
-	`////////8AAQC+AAF/gAAAAAD//////////wABIKMAAX+AAAAAAP//////////AAEBHgABf4AAAAAA//////////8AAQEfAAF/gAAAAAD//////////wABATAAAX+AAAAAAP//////////AAEBXgAB` +
-	`f4AAAAAA//////////8AAQFfAAF/gAAAAAD//////////wABAQYAAX+AAAAAAP//////////AAEBBwABf4AAAAAA//////////8AAQEMAAF/gAAAAAD//////////wABAQ0AAX+AAAAAAP////////` +
-	`//AAEBEQABf4AAAAAA//////////8AAQEAAAF/gAAAAAD//////////wABAQEAAX+AAAAAAP//////////AAEBAgABf4AAAAAA//////////8AAQEDAAF/gAAAAAD//////////wABAQQAAX+AAAAA` +
-	`AP//////////AAEBBQABf4AAAAAA//////////8AAQEIAAF/gAAAAAD//////////wABAQkAAX+AAAAAAP//////////AAEBCgABf4AAAAAA//////////8AAQELAAF/gAAAAAD//////////wABAQ` +
-	`4AAX+AAAAAAP//////////AAEBDwABf4AAAAAA//////////8AAQESAAF/gAAAAAD//////////wABARMAAX+AAAAAAP//////////AAEBFAABf4AAAAAA//////////8AAQEVAAF/gAAAAAD/////` +
-	`/////wABARYAAX+AAAAAAP//////////AAEBFwABf4AAAAAA//////////8AAQEYAAF/gAAAAAD//////////wABARkAAX+AAAAAAP//////////AAEBGgABf4AAAAAA//////////8AAQEbAAF/gA` +
-	`AAAAD//////////wABARwAAX+AAAAAAP//////////AAEBHQABf4AAAAAA//////////8AAQEgAAF/gAAAAAD//////////wABASEAAX+AAAAAAP//////////AAAAAP//////////AAAAAP//////` +
-	`////AAEB9QABf4AAAAAA//////////8AAQEkAAF/gAAAAAD//////////wABASUAAX+AAAAAAP//////////AAEBJgABf4AAAAAA//////////8AAQEnAAF/gAAAAAD//////////wABASgAAX+AAA` +
-	`AAAP//////////AAEBKQABf4AAAAAA//////////8AAQEqAAF/gAAAAAD//////////wABASsAAX+AAAAAAP//////////AAEBLgABf4AAAAAA//////////8AAQEvAAF/gAAAAAD//////////wAA` +
-	`AAD//////////wAAAAD//////////wABATkAAX+AAAAAAP//////////AAEBOgABf4AAAAAA//////////8AAAAA//////////8AAAAA//////////8AAQE9AAF/gAAAAAD//////////wABAT4AAX` +
-	`+AAAAAAP//////////AAEBPwABf4AAAAAA//////////8AAQFAAAF/gAAAAAD//////////wABAUMAAX+AAAAAAP//////////AAEBRAABf4AAAAAA//////////8AAAAA//////////8AAAAA////` +
-	`//////8AAQFHAAF/gAAAAAD//////////wABAUgAAX+AAAAAAP//////////AAEBRgABf4AAAAAA//////////8AAQFMAAF/gAAAAAD//////////wABAU0AAX+AAAAAAP//////////AAEBTgABf4` +
-	`AAAAAA//////////8AAQFPAAF/gAAAAAD//////////wABAVAAAX+AAAAAAP//////////AAEBUQABf4AAAAAA//////////8AAQFUAAF/gAAAAAD//////////wABAVUAAX+AAAAAAP//////////` +
-	`AAAAAP//////////AAAAAP//////////AAEBWAABf4AAAAAA//////////8AAQFZAAF/gAAAAAD//////////wABAVoAAX+AAAAAAP//////////AAEBWwABf4AAAAAA//////////8AAQFcAAF/gA` +
-	`AAAAD//////////wABAV0AAX+AAAAAAP//////////AAEBYgABf4AAAAAA//////////8AAQFjAAF/gAAAAAD//////////wABAWQAAX+AAAAAAP//////////AAEBZQABf4AAAAAA//////////8A` +
-	`AQFmAAF/gAAAAAD//////////wABAWcAAX+AAAAAAP//////////AAEBaAABf4AAAAAA//////////8AAQFpAAF/gAAAAAD//////////wABAWoAAX+AAAAAAP//////////AAEBawABf4AAAAAA//` +
-	`////////8AAQFsAAF/gAAAAAD//////////wABAW0AAX+AAAAAAP//////////AAEBbgABf4AAAAAA//////////8AAQFvAAF/gAAAAAD//////////wABAXAAAX+AAAAAAP//////////AAEBcQAB` +
-	`f4AAAAAA//////////8AAQFyAAF/gAAAAAD//////////wABAXMAAX+AAAAAAP//////////AAEBdAABf4AAAAAA//////////8AAQF1AAF/gAAAAAD//////////wABAXYAAX+AAAAAAP////////` +
-	`//AAEBdwABf4AAAAAA//////////8AAQF5AAF/gAAAAAD//////////wABAXoAAX+AAAAAAP//////////AAEBewABf4AAAAAA//////////8AAQF8AAF/gAAAAAD//////////wABAhgAAX+AAAAA` +
-	`AP//////////AAECGQABf4AAAAAA//////////8AAQIaAAF/gAAAAAD//////////wABAhsAAX+AAAAAAP//////////AAEBIgABf4AAAAAA//////////8AAQEjAAF/gAAAAAD//////////wABAT` +
-	`sAAX+AAAAAAP//////////AAEBPAABf4AAAAAA//////////8AAQE2AAF/gAAAAAD//////////wABATcAAX+AAAAAAP//////////AAEBRQABf4AAAAAA//////////8AAQFWAAF/gAAAAAD/////` +
-	`/////wABAVcAAX+AAAAAAP//////////AAEBEAABf4AAAAAA//////////8AAQMmAAF/gAAAAAD//////////wABATQAAX+AAAAAAP//////////AAECNwABf4AAAAAA//////////8AAQCkAAF/gA` +
-	`AAAAD//////////wABAwAAAX+AAAAAAP//////////AAEDAQABf4AAAAAA//////////8AAQMCAAF/gAAAAAD//////////wABAwMAAX+AAAAAAP//////////AAEDBAABf4AAAAAA//////////8A` +
-	`AQMGAAF/gAAAAAD//////////wABAwcAAX+AAAAAAP//////////AAEDCAABf4AAAAAA//////////8AAQMKAAF/gAAAAAD//////////wABAwsAAX+AAAAAAP//////////AAEDDAABf4AAAAAA//` +
-	`////////8AAQMnAAF/gAAAAAD//////////wABAygAAX+AAAAAAP//////////AAEDOAABf4AAAAAA//////////8AAQQQAAF/gAAAAAD//////////wABBBEAAX+AAAAAAP//////////AAEEEgAB` +
-	`f4AAAAAA//////////8AAQQTAAF/gAAAAAD//////////wABBBQAAX+AAAAAAP//////////AAEEFQABf4AAAAAA//////////8AAQQWAAF/gAAAAAD//////////wABBBcAAX+AAAAAAP////////` +
-	`//AAEEGAABf4AAAAAA//////////8AAQQZAAF/gAAAAAD//////////wABBBoAAX+AAAAAAP//////////AAEEGwABf4AAAAAA//////////8AAQQcAAF/gAAAAAD//////////wABBB0AAX+AAAAA` +
-	`AP//////////AAEEHgABf4AAAAAA//////////8AAQQfAAF/gAAAAAD//////////wABBCAAAX+AAAAAAP//////////AAEEIQABf4AAAAAA//////////8AAQQiAAF/gAAAAAD//////////wABBC` +
-	`MAAX+AAAAAAP//////////AAEEJAABf4AAAAAA//////////8AAQQlAAF/gAAAAAD//////////wABBCYAAX+AAAAAAP//////////AAEEJwABf4AAAAAA//////////8AAQQoAAF/gAAAAAD/////` +
-	`/////wABBCkAAX+AAAAAAP//////////AAEEKgABf4AAAAAA//////////8AAQQrAAF/gAAAAAD//////////wABBCwAAX+AAAAAAP//////////AAEELQABf4AAAAAA//////////8AAQQuAAF/gA` +
-	`AAAAD//////////wABBC8AAX+AAAAAAP//////////AAEEBgABf4AAAAAA//////////8AAQQCAAF/gAAAAAD//////////wABBFIAAX+AAAAAAP//////////AAEEAwABf4AAAAAA//////////8A` +
-	`AQRTAAF/gAAAAAD//////////wABBFYAAX+AAAAAAP//////////AAEECAABf4AAAAAA//////////8AAQQEAAF/gAAAAAD//////////wABBFQAAX+AAAAAAP//////////AAEEBwABf4AAAAAA//` +
-	`////////8AAQRXAAF/gAAAAAD//////////wABBAkAAX+AAAAAAP//////////AAEEWQABf4AAAAAA//////////8AAQQKAAF/gAAAAAD//////////wABBFoAAX+AAAAAAP//////////AAEEWAAB` +
-	`f4AAAAAA//////////8AAQQFAAF/gAAAAAD//////////wABBAsAAX+AAAAAAP//////////AAEEWwABf4AAAAAA//////////8AAQQMAAF/gAAAAAD//////////wABBFwAAX+AAAAAAP////////` +
-	`//AAEEVQABf4AAAAAA//////////8AAQQOAAF/gAAAAAD//////////wABBF4AAX+AAAAAAP//////////AAEEDwABf4AAAAAA//////////8AAQRfAAF/gAAAAAD//////////wABBAEAAX+AAAAA` +
-	`AP//////////AAEEUQABf4AAAAAA//////////8AAQRPAAF/gAAAAAD//////////wABBDAAAX+AAAAAAP//////////AAEEMQABf4AAAAAA//////////8AAQQyAAF/gAAAAAD//////////wABBD` +
-	`MAAX+AAAAAAP//////////AAEENAABf4AAAAAA//////////8AAQQ1AAF/gAAAAAD//////////wABBDYAAX+AAAAAAP//////////AAEENwABf4AAAAAA//////////8AAQQ4AAF/gAAAAAD/////` +
-	`/////wABBDkAAX+AAAAAAP//////////AAEEOgABf4AAAAAA//////////8AAQQ7AAF/gAAAAAD//////////wABBDwAAX+AAAAAAP//////////AAEEPQABf4AAAAAA//////////8AAQQ+AAF/gA` +
-	`AAAAD//////////wABBD8AAX+AAAAAAP//////////AAEEQAABf4AAAAAA//////////8AAQRBAAF/gAAAAAD//////////wABBEIAAX+AAAAAAP//////////AAEEQwABf4AAAAAA//////////8A` +
-	`AQREAAF/gAAAAAD//////////wABBEUAAX+AAAAAAP//////////AAEERgABf4AAAAAA//////////8AAQRHAAF/gAAAAAD//////////wABBEgAAX+AAAAAAP//////////AAEESQABf4AAAAAA//` +
-	`////////8AAQRKAAF/gAAAAAD//////////wABBEsAAX+AAAAAAP//////////AAEETAABf4AAAAAA//////////8AAQRNAAF/gAAAAAD//////////wABBE4AAX+AAAAAAP//////////AAEhFgAB` +
-	`f4AAAAAA//////////8AAQSQAAF/gAAAAAD//////////wABBJEAAX+AAAAAAP//////////AAAAAP//////////AAEiYAABf4AAAAAA//////////8AAAAA//////////8AASICAAF/gAAAAAD///` +
-	`///////wAAAAD//////////wABIhEAAX+AAAAAAP//////////AAAAAP//////////AAEiKwABf4AAAAAA//////////8AAAAA//////////8AASIaAAF/gAAAAAD//////////wAAAAD/////////` +
-	`/wABIkgAAX+AAAAAAP//////////AAEBwAABf4AAAAAA//////////8AASALAAF/gAAAAAD//////////wABIAwAAX+AAAAAAP//////////AAEgDQABf4AAAAAA//////////8AASEqAAF/gAAAAA` +
-	`D//////////wABISsAAX+AAAAAAP//////////AAEBLAABf4AAAAAA//////////8AAQEtAAF/gAAAAAD//////////wABATUAAX+AAAAAAP//////////AAEBwwABf4AAAAAA//////////8AAQHN` +
-	`AAF/gAAAAAD//////////wABAc4AAX+AAAAAAP//////////AAEBzwABf4AAAAAA//////////8AAQHQAAF/gAAAAAD//////////wABAdEAAX+AAAAAAP//////////AAEB0gABf4AAAAAA//////` +
-	`////8AAQHTAAF/gAAAAAD//////////wABAdQAAX+AAAAAAP//////////AAEB1QABf4AAAAAA//////////8AAQHWAAF/gAAAAAD//////////wABAdcAAX+AAAAAAP//////////AAEB2AABf4AA` +
-	`AAAA//////////8AAQHZAAF/gAAAAAD//////////wABAdoAAX+AAAAAAP//////////AAEB2wABf4AAAAAA//////////8AAQHcAAF/gAAAAAD//////////wABAd4AAX+AAAAAAP//////////AA` +
-	`EB3wABf4AAAAAA//////////8AAQHgAAF/gAAAAAD//////////wABAeEAAX+AAAAAAP//////////AAEB4gABf4AAAAAA//////////8AAQHjAAF/gAAAAAD//////////wABAeYAAX+AAAAAAP//` +
-	`////////AAEB5wABf4AAAAAA//////////8AAQHoAAF/gAAAAAD//////////wABAekAAX+AAAAAAP//////////AAEB6gABf4AAAAAA//////////8AAQHrAAF/gAAAAAD//////////wABAewAAX` +
-	`+AAAAAAP//////////AAEB7QABf4AAAAAA//////////8AAQHwAAF/gAAAAAD//////////wABAfQAAX+AAAAAAP//////////AAEB+AABf4AAAAAA//////////8AAQH5AAF/gAAAAAD/////////` +
-	`/wABAfoAAX+AAAAAAP//////////AAEB+wABf4AAAAAA//////////8AAQH8AAF/gAAAAAD//////////wABAf0AAX+AAAAAAP//////////AAEB/gABf4AAAAAA//////////8AAQH/AAF/gAAAAA` +
-	`D//////////wABAh4AAX+AAAAAAP//////////AAECHwABf4AAAAAA//////////8AAQImAAF/gAAAAAD//////////wABAicAAX+AAAAAAP//////////AAECKAABf4AAAAAA//////////8AAQIp` +
-	`AAF/gAAAAAD//////////wABAioAAX+AAAAAAP//////////AAECKwABf4AAAAAA//////////8AAQIsAAF/gAAAAAD//////////wABAi0AAX+AAAAAAP//////////AAECLgABf4AAAAAA//////` +
-	`////8AAQIvAAF/gAAAAAD//////////wABAjAAAX+AAAAAAP//////////AAECMQABf4AAAAAA//////////8AAQIyAAF/gAAAAAD//////////wABAjMAAX+AAAAAAP//////////AAEC7gABf4AA` +
-	`AAAA//////////8AAQTAAAF/gAAAAAD//////////wABHgAAAX+AAAAAAP//////////AAEeAQABf4AAAAAA//////////8AAR4CAAF/gAAAAAD//////////wABHgMAAX+AAAAAAP//////////AA` +
-	`EeBAABf4AAAAAA//////////8AAR4FAAF/gAAAAAD//////////wABHgYAAX+AAAAAAP//////////AAEeBwABf4AAAAAA//////////8AAR4IAAF/gAAAAAD//////////wABHgkAAX+AAAAAAP//` +
-	`////////AAEeCgABf4AAAAAA//////////8AAR4LAAF/gAAAAAD//////////wABHgwAAX+AAAAAAP//////////AAEeDQABf4AAAAAA//////////8AAR4OAAF/gAAAAAD//////////wABHg8AAX` +
-	`+AAAAAAP//////////AAEeEAABf4AAAAAA//////////8AAR4RAAF/gAAAAAD//////////wABHhIAAX+AAAAAAP//////////AAEeEwABf4AAAAAA//////////8AAR4UAAF/gAAAAAD/////////` +
-	`/wABHhUAAX+AAAAAAP//////////AAEeFgABf4AAAAAA//////////8AAR4XAAF/gAAAAAD//////////wABHhgAAX+AAAAAAP//////////AAEeGQABf4AAAAAA//////////8AAR4aAAF/gAAAAA` +
-	`D//////////wABHhsAAX+AAAAAAP//////////AAEeHAABf4AAAAAA//////////8AAR4dAAF/gAAAAAD//////////wABHh4AAX+AAAAAAP//////////AAEeHwABf4AAAAAA//////////8AAR4g` +
-	`AAF/gAAAAAD//////////wABHiEAAX+AAAAAAP//////////AAEeIgABf4AAAAAA//////////8AAR4jAAF/gAAAAAD//////////wABHiQAAX+AAAAAAP//////////AAEeJQABf4AAAAAA//////` +
-	`////8AAR4mAAF/gAAAAAD//////////wABHicAAX+AAAAAAP//////////AAEeKAABf4AAAAAA//////////8AAR4pAAF/gAAAAAD//////////wABHioAAX+AAAAAAP//////////AAEeKwABf4AA` +
-	`AAAA//////////8AAR4sAAF/gAAAAAD//////////wABHi0AAX+AAAAAAP//////////AAEeLgABf4AAAAAA//////////8AAR4vAAF/gAAAAAD//////////wABHjAAAX+AAAAAAP//////////AA` +
-	`EeMQABf4AAAAAA//////////8AAR4yAAF/gAAAAAD//////////wABHjMAAX+AAAAAAP//////////AAEeNAABf4AAAAAA//////////8AAR41AAF/gAAAAAD//////////wABHjYAAX+AAAAAAP//` +
-	`////////AAEeNwABf4AAAAAA//////////8AAR44AAF/gAAAAAD//////////wABHjkAAX+AAAAAAP//////////AAEeOgABf4AAAAAA//////////8AAR47AAF/gAAAAAD//////////wABHjwAAX` +
-	`+AAAAAAP//////////AAEePQABf4AAAAAA//////////8AAR4+AAF/gAAAAAD//////////wABHj8AAX+AAAAAAP//////////AAEeQAABf4AAAAAA//////////8AAR5BAAF/gAAAAAD/////////` +
-	`/wABHkIAAX+AAAAAAP//////////AAEeQwABf4AAAAAA//////////8AAR5EAAF/gAAAAAD//////////wABHkUAAX+AAAAAAP//////////AAEeRgABf4AAAAAA//////////8AAR5HAAF/gAAAAA` +
-	`D//////////wABHkgAAX+AAAAAAP//////////AAEeSQABf4AAAAAA//////////8AAR5KAAF/gAAAAAD//////////wABHksAAX+AAAAAAP//////////AAEeTAABf4AAAAAA//////////8AAR5N` +
-	`AAF/gAAAAAD//////////wABHk4AAX+AAAAAAP//////////AAEeTwABf4AAAAAA//////////8AAR5QAAF/gAAAAAD//////////wABHlEAAX+AAAAAAP//////////AAEeUgABf4AAAAAA//////` +
-	`////8AAR5TAAF/gAAAAAD//////////wABHlQAAX+AAAAAAP//////////AAEeVQABf4AAAAAA//////////8AAR5WAAF/gAAAAAD//////////wABHlcAAX+AAAAAAP//////////AAEeWAABf4AA` +
-	`AAAA//////////8AAR5ZAAF/gAAAAAD//////////wABHloAAX+AAAAAAP//////////AAEeWwABf4AAAAAA//////////8AAR5cAAF/gAAAAAD//////////wABHl0AAX+AAAAAAP//////////AA` +
-	`EeXgABf4AAAAAA//////////8AAR5fAAF/gAAAAAD//////////wABHmAAAX+AAAAAAP//////////AAEeYQABf4AAAAAA//////////8AAR5iAAF/gAAAAAD//////////wABHmMAAX+AAAAAAP//` +
-	`////////AAEeZAABf4AAAAAA//////////8AAR5lAAF/gAAAAAD//////////wABHmYAAX+AAAAAAP//////////AAEeZwABf4AAAAAA//////////8AAR5oAAF/gAAAAAD//////////wABHmkAAX` +
-	`+AAAAAAP//////////AAEeagABf4AAAAAA//////////8AAR5rAAF/gAAAAAD//////////wABHmwAAX+AAAAAAP//////////AAEebQABf4AAAAAA//////////8AAR5uAAF/gAAAAAD/////////` +
-	`/wABHm8AAX+AAAAAAP//////////AAEecAABf4AAAAAA//////////8AAR5xAAF/gAAAAAD//////////wABHnIAAX+AAAAAAP//////////AAEecwABf4AAAAAA//////////8AAR50AAF/gAAAAA` +
-	`D//////////wABHnUAAX+AAAAAAP//////////AAEedgABf4AAAAAA//////////8AAR53AAF/gAAAAAD//////////wABHngAAX+AAAAAAP//////////AAEeeQABf4AAAAAA//////////8AAR56` +
-	`AAF/gAAAAAD//////////wABHnsAAX+AAAAAAP//////////AAEefAABf4AAAAAA//////////8AAR59AAF/gAAAAAD//////////wABHn4AAX+AAAAAAP//////////AAEefwABf4AAAAAA//////` +
-	`////8AAR6AAAF/gAAAAAD//////////wABHoEAAX+AAAAAAP//////////AAEeggABf4AAAAAA//////////8AAR6DAAF/gAAAAAD//////////wABHoQAAX+AAAAAAP//////////AAEehQABf4AA` +
-	`AAAA//////////8AAR6GAAF/gAAAAAD//////////wABHocAAX+AAAAAAP//////////AAEeiAABf4AAAAAA//////////8AAR6JAAF/gAAAAAD//////////wABHooAAX+AAAAAAP//////////AA` +
-	`EeiwABf4AAAAAA//////////8AAR6MAAF/gAAAAAD//////////wABHo0AAX+AAAAAAP//////////AAEejgABf4AAAAAA//////////8AAR6PAAF/gAAAAAD//////////wABHpAAAX+AAAAAAP//` +
-	`////////AAEekQABf4AAAAAA//////////8AAR6SAAF/gAAAAAD//////////wABHpMAAX+AAAAAAP//////////AAEelAABf4AAAAAA//////////8AAR6VAAF/gAAAAAD//////////wABHpYAAX` +
-	`+AAAAAAP//////////AAEelwABf4AAAAAA//////////8AAR6YAAF/gAAAAAD//////////wABHpkAAX+AAAAAAP//////////AAEeoAABf4AAAAAA//////////8AAR6hAAF/gAAAAAD/////////` +
-	`/wABHqQAAX+AAAAAAP//////////AAEepQABf4AAAAAA//////////8AAR6mAAF/gAAAAAD//////////wABHqcAAX+AAAAAAP//////////AAEeqgABf4AAAAAA//////////8AAR6rAAF/gAAAAA` +
-	`D//////////wABHqwAAX+AAAAAAP//////////AAEerQABf4AAAAAA//////////8AAR6uAAF/gAAAAAD//////////wABHq8AAX+AAAAAAP//////////AAEesAABf4AAAAAA//////////8AAR6x` +
-	`AAF/gAAAAAD//////////wABHrQAAX+AAAAAAP//////////AAEetQABf4AAAAAA//////////8AAR62AAF/gAAAAAD//////////wABHrcAAX+AAAAAAP//////////AAEeuAABf4AAAAAA//////` +
-	`////8AAR65AAF/gAAAAAD//////////wABHrwAAX+AAAAAAP//////////AAEevQABf4AAAAAA//////////8AAR6+AAF/gAAAAAD//////////wABHr8AAX+AAAAAAP//////////AAEewAABf4AA` +
-	`AAAA//////////8AAR7BAAF/gAAAAAD//////////wABHsQAAX+AAAAAAP//////////AAEexQABf4AAAAAA//////////8AAR7GAAF/gAAAAAD//////////wABHscAAX+AAAAAAP//////////AA` +
-	`EeygABf4AAAAAA//////////8AAR7LAAF/gAAAAAD//////////wABHswAAX+AAAAAAP//////////AAEezQABf4AAAAAA//////////8AAR7QAAF/gAAAAAD//////////wABHtEAAX+AAAAAAP//` +
-	`////////AAEe0gABf4AAAAAA//////////8AAR7TAAF/gAAAAAD//////////wABHtYAAX+AAAAAAP//////////AAEe1wABf4AAAAAA//////////8AAR7YAAF/gAAAAAD//////////wABHtkAAX` +
-	`+AAAAAAP//////////AAEe5AABf4AAAAAA//////////8AAR7lAAF/gAAAAAD//////////wABHvIAAX+AAAAAAP//////////AAEe8wABf4AAAAAA//////////8AAR70AAF/gAAAAAD/////////` +
-	`/wABHvUAAX+AAAAAAP//////////AAEe+AABf4AAAAAA//////////8AAR75AAF/gAAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAA` +
-	`D//////////wABBFAAAX+AAAAAAP//////////AAEEXQABf4AAAAAA//////////8AAQTCAAF/gAAAAAD//////////wABBNAAAX+AAAAAAP//////////AAEE0QABf4AAAAAA//////////8AAQTS` +
-	`AAF/gAAAAAD//////////wABBNMAAX+AAAAAAP//////////AAEE1wABf4AAAAAA//////////8AAQTdAAF/gAAAAAD//////////wABBN8AAX+AAAAAAP//////////AAEE4wABf4AAAAAA//////` +
-	`////8AAQTlAAF/gAAAAAD//////////wABBOYAAX+AAAAAAP//////////AAEE5wABf4AAAAAA//////////8AAQTsAAF/gAAAAAD//////////wABBO0AAX+AAAAAAP//////////AAEE7gABf4AA` +
-	`AAAA//////////8AAQTvAAF/gAAAAAD//////////wABBPAAAX+AAAAAAP//////////AAEE8QABf4AAAAAA//////////8AAQTyAAF/gAAAAAD//////////wABBPMAAX+AAAAAAP//////////AA` +
-	`EE9QABf4AAAAAA//////////8AAQT5AAF/gAAAAAD//////////wABBNQAAX+AAAAAAP//////////AAEE1QABf4AAAAAA//////////8AAAAA//////////8AAAAA//////////8AAQMjAAF/gAAA` +
-	`AAD//////////wABAyQAAX+AAAAAAP//////////AAEDJQABf4AAAAAA//////////8AAQMtAAF/gAAAAAD//////////wABAy4AAX+AAAAAAP//////////AAEDMAABf4AAAAAA//////////8AAQ` +
-	`MxAAF/gAAAAAD//////////wABAX8AAX+AAAAAAP//////////AAEBOAABf4AAAAAA//////////8AAQFKAAF/gAAAAAD//////////wABAUsAAX+AAAAAAP//////////AAEBjgABf4AAAAAA////` +
-	`//////8AAQGPAAF/gAAAAAD//////////wABAd0AAX+AAAAAAP//////////AAECWQABf4AAAAAA//////////8AAQMFAAF/gAAAAAD//////////wABAw8AAX+AAAAAAP//////////AAEDMgABf4` +
-	`AAAAAA//////////8AAQMRAAF/gAAAAAD//////////wABAacAAX+AAAAAAP//////////AAEBqAABf4AAAAAA//////////8AAQSSAAF/gAAAAAD//////////wABBJMAAX+AAAAAAP//////////` +
-	`AAEEmgABf4AAAAAA//////////8AAQSbAAF/gAAAAAD//////////wABBJwAAX+AAAAAAP//////////AAEEnQABf4AAAAAA//////////8AAQSiAAF/gAAAAAD//////////wABBKMAAX+AAAAAAP` +
-	`//////////AAEErgABf4AAAAAA//////////8AAQSvAAF/gAAAAAD//////////wABBLAAAX+AAAAAAP//////////AAEEsQABf4AAAAAA//////////8AAQSyAAF/gAAAAAD//////////wABBLMA` +
-	`AX+AAAAAAP//////////AAEEtgABf4AAAAAA//////////8AAQS3AAF/gAAAAAD//////////wABBLgAAX+AAAAAAP//////////AAEEuQABf4AAAAAA//////////8AAQS6AAF/gAAAAAD///////` +
-	`///wABBLsAAX+AAAAAAP//////////AAEE2AABf4AAAAAA//////////8AAQTZAAF/gAAAAAD//////////wABBOgAAX+AAAAAAP//////////AAEE6QABf4AAAAAA//////////8AAQSWAAF/gAAA` +
-	`AAD//////////wABBJcAAX+AAAAAAP//////////AAEhJwABf4AAAAAA//////////8AAQGxAAF/gAAAAAD//////////wABAxsAAX+AAAAAAP//////////AAEBrwABf4AAAAAA//////////8AAQ` +
-	`GwAAF/gAAAAAD//////////wABAaAAAX+AAAAAAP//////////AAEBoQABf4AAAAAA//////////8AAQCtAAF/gAAAAAD//////////wABAlQAAX+AAAAAAP//////////AAECWAABf4AAAAAA////` +
-	`//////8AAQJSAAF/gAAAAAD//////////wABAlEAAX+AAAAAAP//////////AAEChwABf4AAAAAA//////////8AAQJvAAF/gAAAAAD//////////wABAowAAX+AAAAAAP//////////AAECjQABf4` +
-	`AAAAAA//////////8AAQJ1AAF/gAAAAAD//////////wABAZ8AAX+AAAAAAP//////////AAEgSwABf4AAAAAA//////////8AASAlAAF/gAAAAAD//////////wABICQAAX+AAAAAAP//////////` +
-	`AAEgAAABf4AAAAAA//////////8AASABAAF/gAAAAAD//////////wABIAIAAX+AAAAAAP//////////AAEgAwABf4AAAAAA//////////8AASAEAAF/gAAAAAD//////////wABIAUAAX+AAAAAAP` +
-	`//////////AAEgBgABf4AAAAAA//////////8AASAHAAF/gAAAAAD//////////wABIAgAAX+AAAAAAP//////////AAEgCQABf4AAAAAA//////////8AASAKAAF/gAAAAAD//////////wABIB8A` +
-	`AX+AAAAAAP//////////AAEgGwABf4AAAAAA//////////8AASFfAAF/gAAAAAD//////////wABIWwAAX+AAAAAAP//////////AAEhbQABf4AAAAAA//////////8AASFuAAF/gAAAAAD///////` +
-	`///wABIW8AAX+AAAAAAP//////////AAEhaQABf4AAAAAA//////////8AASFkAAF/gAAAAAD//////////wABIWAAAX+AAAAAAP//////////AAEhcAABf4AAAAAA//////////8AASF0AAF/gAAA` +
-	`AAD//////////wABIXkAAX+AAAAAAP//////////AAEhfAABf4AAAAAA//////////8AASF9AAF/gAAAAAD//////////wABIX4AAX+AAAAAAP//////////AAEhfwABf4AAAAAA//////////8AAQ` +
-	`KDAAF/gAAAAAD//////////wABApQAAX+AAAAAAP//////////AAEClQABf4AAAAAA//////////8AAQKWAAF/gAAAAAD//////////wABAnkAAX+AAAAAAP//////////AAEDNAABf4AAAAAA////` +
-	`//////8AAQM1AAF/gAAAAAD//////////wABAzYAAX+AAAAAAP//////////AAEDLwABf4AAAAAA//////////8AAQMWAAF/gAAAAAD//////////wABAxcAAX+AAAAAAP//////////AAEBwQABf4` +
-	`AAAAAA//////////8AAQHCAAF/gAAAAAD//////////wABA6kAAX+AAAAAAP//////////AAEhMgABf4AAAAAA//////////8AASEvAAF/gAAAAAD//////////wABA08AAX+AAAAAAP//////////` +
-	`AAEBSQABf4AAAAAA//////////8AAQIAAAF/gAAAAAD//////////wABAgEAAX+AAAAAAP//////////AAECAgABf4AAAAAA//////////8AAQIDAAF/gAAAAAD//////////wABAgQAAX+AAAAAAP` +
-	`//////////AAECBQABf4AAAAAA//////////8AAQIGAAF/gAAAAAD//////////wABAgcAAX+AAAAAAP//////////AAECCAABf4AAAAAA//////////8AAQIJAAF/gAAAAAD//////////wABAgoA` +
-	`AX+AAAAAAP//////////AAECCwABf4AAAAAA//////////8AAQIMAAF/gAAAAAD//////////wABAg0AAX+AAAAAAP//////////AAECDgABf4AAAAAA//////////8AAQIPAAF/gAAAAAD///////` +
-	`///wABAhAAAX+AAAAAAP//////////AAECEQABf4AAAAAA//////////8AAQISAAF/gAAAAAD//////////wABAhMAAX+AAAAAAP//////////AAECFAABf4AAAAAA//////////8AAQIVAAF/gAAA` +
-	`AAD//////////wABAhYAAX+AAAAAAP//////////AAECFwABf4AAAAAA//////////8AAR6bAAF/gAAAAAD//////////wABHtoAAX+AAAAAAP//////////AAEe2wABf4AAAAAA//////////8AAR` +
-	`7cAAF/gAAAAAD//////////wABHt0AAX+AAAAAAP//////////AAEe4AABf4AAAAAA//////////8AAR7hAAF/gAAAAAD//////////wABHuIAAX+AAAAAAP//////////AAEe4wABf4AAAAAA////` +
-	`//////8AAR7oAAF/gAAAAAD//////////wABHukAAX+AAAAAAP//////////AAEe6gABf4AAAAAA//////////8AAR7rAAF/gAAAAAD//////////wABHu4AAX+AAAAAAP//////////AAEe7wABf4` +
-	`AAAAAA//////////8AAR7wAAF/gAAAAAD//////////wABHvEAAX+AAAAAAP//////////AAAAAP//////////AAAAAP//////////AAEEAAABf4AAAAAA//////////8AAQQNAAF/gAAAAAD/////` +
-	`/////wABBMEAAX+AAAAAAP//////////AAEE1gABf4AAAAAA//////////8AAQTaAAF/gAAAAAD//////////wABBNsAAX+AAAAAAP//////////AAEE3AABf4AAAAAA//////////8AAQTeAAF/gA` +
-	`AAAAD//////////wABBOIAAX+AAAAAAP//////////AAEE5AABf4AAAAAA//////////8AAQTqAAF/gAAAAAD//////////wABBOsAAX+AAAAAAP//////////AAEE9AABf4AAAAAA//////////8A` +
-	`AQT4AAF/gAAAAAD//////////wABA0AAAX+AAAAAAP//////////AAEDQQABf4AAAAAA//////////8AAQNEAAF/gAAAAAD//////////wABA34AAX+AAAAAAP//////////AAEDhQABf4AAAAAA//` +
-	`////////8AAQOHAAF/gAAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAA` +
-	`AAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD//////////wABBKwAAX+AAAAAAP//////////AAEErQABf4AAAAAA//////////8AAQ` +
-	`SqAAF/gAAAAAD//////////wABBKsAAX+AAAAAAP//////////AAEEmAABf4AAAAAA//////////8AAQSZAAF/gAAAAAD//////////wABBJ4AAX+AAAAAAP//////////AAEEnwABf4AAAAAA////` +
-	`//////8AAQSgAAF/gAAAAAD//////////wABBKEAAX+AAAAAAP//////////AAEDQgABf4AAAAAA//////////8AAQK7AAF/gAAAAAD//////////wABArwAAX+AAAAAAP//////////AAECwgABf4` +
-	`AAAAAA//////////8AAQLDAAF/gAAAAAD//////////wABAsQAAX+AAAAAAP//////////AAECxQABf4AAAAAA//////////8AAQMSAAF/gAAAAAD//////////wABAxMAAX+AAAAAAP//////////` +
-	`AAEDFQABf4AAAAAA//////////8AAQMUAAF/gAAAAAD//////////wABA0MAAX+AAAAAAP//////////AAEDCQABf4AAAAAA//////////8AAQMNAAF/gAAAAAD//////////wABAw4AAX+AAAAAAP` +
-	`//////////AAEDHwABf4AAAAAA//////////8AAQMeAAF/gAAAAAD//////////wABAx0AAX+AAAAAAP//////////AAEDGQABf4AAAAAA//////////8AAQMYAAF/gAAAAAD//////////wABAywA` +
-	`AX+AAAAAAP//////////AAEBqQABf4AAAAAA//////////8AAQGJAAF/gAAAAAD//////////wABAZ4AAX+AAAAAAP//////////AAEBMgABf4AAAAAA//////////8AAQEzAAF/gAAAAAD///////` +
-	`///wABIBAAAX+AAAAAAP//////////AAEgEQABf4AAAAAA//////////8AASASAAF/gAAAAAD//////////wABIBUAAX+AAAAAAP//////////AAECfAABf4AAAAAA//////////8AAQJwAAF/gAAA` +
-	`AAD//////////wABApgAAX+AAAAAAP//////////AAECegABf4AAAAAA//////////8AAR6iAAF/gAAAAAD//////////wABHqMAAX+AAAAAAP//////////AAEeqAABf4AAAAAA//////////8AAR` +
-	`6pAAF/gAAAAAD//////////wABHrIAAX+AAAAAAP//////////AAEeswABf4AAAAAA//////////8AAR66AAF/gAAAAAD//////////wABHrsAAX+AAAAAAP//////////AAEewgABf4AAAAAA////` +
-	`//////8AAR7DAAF/gAAAAAD//////////wABHsgAAX+AAAAAAP//////////AAEeyQABf4AAAAAA//////////8AAR7OAAF/gAAAAAD//////////wABHs8AAX+AAAAAAP//////////AAEe1AABf4` +
-	`AAAAAA//////////8AAR7VAAF/gAAAAAD//////////wABHt4AAX+AAAAAAP//////////AAEe3wABf4AAAAAA//////////8AAR7mAAF/gAAAAAD//////////wABHucAAX+AAAAAAP//////////` +
-	`AAEe7AABf4AAAAAA//////////8AAR7tAAF/gAAAAAD//////////wABHvYAAX+AAAAAAP//////////AAEe9wABf4AAAAAA//////////8AAQLAAAF/gAAAAAD//////////wABAsEAAX+AAAAAAP` +
-	`//////////AAECvgABf4AAAAAA//////////8AAQK9AAF/gAAAAAD//////////wABAr8AAX+AAAAAAP//////////AAECyAABf4AAAAAA//////////8AAQLJAAF/gAAAAAD//////////wABAsoA` +
-	`AX+AAAAAAP//////////AAECywABf4AAAAAA//////////8AAQLOAAF/gAAAAAD//////////wABAs8AAX+AAAAAAP//////////AAECzQABf4AAAAAA//////////8AAQLMAAF/gAAAAAD///////` +
-	`///wABAtIAAX+AAAAAAP//////////AAEC0wABf4AAAAAA//////////8AAQLUAAF/gAAAAAD//////////wABAtUAAX+AAAAAAP//////////AAEC1gABf4AAAAAA//////////8AAQLsAAF/gAAA` +
-	`AAD//////////wABAzkAAX+AAAAAAP//////////AAEDHAABf4AAAAAA//////////8AAQM/AAF/gAAAAAD//////////wABAzMAAX+AAAAAAP//////////AAECuQABf4AAAAAA//////////8AAQ` +
-	`K6AAF/gAAAAAD//////////wABIDIAAX+AAAAAAP//////////AAEgMwABf4AAAAAA//////////8AASA0AAF/gAAAAAD//////////wABIDUAAX+AAAAAAP//////////AAEgNgABf4AAAAAA////` +
-	`//////8AASA3AAF/gAAAAAD//////////wABICcAAX+AAAAAAP//////////AAEgDgABf4AAAAAA//////////8AASAPAAF/gAAAAAD//////////wABA3QAAX+AAAAAAP//////////AAEemgABf4` +
-	`AAAAAA//////////8AAQG1AAF/gAAAAAD//////////wABAbYAAX+AAAAAAP//////////AAEBggABf4AAAAAA//////////8AAQGDAAF/gAAAAAD//////////wABAYsAAX+AAAAAAP//////////` +
-	`AAEBjAABf4AAAAAA//////////8AAQKIAAF/gAAAAAD//////////wABAl8AAX+AAAAAAP//////////AAEBlwABf4AAAAAA//////////8AAQGaAAF/gAAAAAD//////////wABAYYAAX+AAAAAAP` +
-	`//////////AAEiBwABf4AAAAAA//////////8AASCrAAF/gAAAAAD//////////wABIKIAAX+AAAAAAP//////////AAEgpAABf4AAAAAA//////////8AASCtAAF/gAAAAAD//////////wABIK4A` +
-	`AX+AAAAAAP//////////AAEgpQABf4AAAAAA//////////8AASCmAAF/gAAAAAD//////////wABIKkAAX+AAAAAAP//////////AAEgsgABf4AAAAAA//////////8AASCzAAF/gAAAAAD///////` +
-	`///wABILQAAX+AAAAAAP//////////AAEgtQABf4AAAAAA//////////8AAQMhAAF/gAAAAAD//////////wABAyIAAX+AAAAAAP//////////AAEBkQABf4AAAAAA//////////8AAQGdAAF/gAAA` +
-	`AAD//////////wABAYAAAX+AAAAAAP//////////AAEggAABf4AAAAAA//////////8AASCBAAF/gAAAAAD//////////wABIIIAAX+AAAAAAP//////////AAEggwABf4AAAAAA//////////8AAS` +
-	`CEAAF/gAAAAAD//////////wABIIUAAX+AAAAAAP//////////AAEghgABf4AAAAAA//////////8AASCHAAF/gAAAAAD//////////wABIIgAAX+AAAAAAP//////////AAEgiQABf4AAAAAA////` +
-	`//////8AASCKAAF/gAAAAAD//////////wABIIsAAX+AAAAAAP//////////AAEgjAABf4AAAAAA//////////8AASCNAAF/gAAAAAD//////////wABII4AAX+AAAAAAP//////////AAEgcAABf4` +
-	`AAAAAA//////////8AASB0AAF/gAAAAAD//////////wABIHUAAX+AAAAAAP//////////AAEgdgABf4AAAAAA//////////8AASB3AAF/gAAAAAD//////////wABIHgAAX+AAAAAAP//////////` +
-	`AAEgeQABf4AAAAAA//////////8AASB6AAF/gAAAAAD//////////wABIHsAAX+AAAAAAP//////////AAEgfAABf4AAAAAA//////////8AASB9AAF/gAAAAAD//////////wABIH4AAX+AAAAAAP` +
-	`//////////AAEgfwABf4AAAAAA//////////8AASBxAAF/gAAAAAD//////////wABAnIAAX+AAAAAAP//////////AAECcwABf4AAAAAA//////////8AAQJtAAF/gAAAAAD//////////wABAnsA` +
-	`AX+AAAAAAP//////////AAECfQABf4AAAAAA//////////8AAQJxAAF/gAAAAAD//////////wABAmEAAX+AAAAAAP//////////AAECagABf4AAAAAA//////////8AASCoAAF/gAAAAAD///////` +
-	`///wABIKAAAX+AAAAAAP//////////AAEgsQABf4AAAAAA//////////8AASChAAF/gAAAAAD//////////wABAa4AAX+AAAAAAP//////////AAEDKQABf4AAAAAA//////////8AAQM3AAF/gAAA` +
-	`AAD//////////wABIUQAAX+AAAAAAP//////////AAEhQQABf4AAAAAA//////////8AASFCAAF/gAAAAAD//////////wABIUsAAX+AAAAAAP//////////AAEhQwABf4AAAAAA//////////8AAS` +
-	`EgAAF/gAAAAAD//////////wABISEAAX+AAAAAAP//////////AAEhOwABf4AAAAAA//////////8AASEeAAF/gAAAAAD//////////wAAAAD//////////wAAAAD//////////wABIC8AAX+AAAAA` +
-	`AP//////////AAEgKAABf4AAAAAA//////////8AASApAAF/gAAAAAD//////////wABICoAAX+AAAAAAP//////////AAEgKwABf4AAAAAA//////////8AASAsAAF/gAAAAAD//////////wABIC` +
-	`0AAX+AAAAAAP//////////AAEgLgABf4AAAAAA//////////8AASA9AAF/gAAAAAD//////////wABIDwAAX+AAAAAAP//////////AAEgXwABf4AAAAAA//////////8AASBgAAF/gAAAAAD/////` +
-	`/////wABIGEAAX+AAAAAAP//////////AAEgYgABf4AAAAAA//////////8AASBjAAF/gAAAAAD//////////wABIGoAAX+AAAAAAP//////////AAEgawABf4AAAAAA//////////8AASBsAAF/gA` +
-	`AAAAD//////////wABIG0AAX+AAAAAAP//////////AAEgbgABf4AAAAAA//////////8AASBvAAF/gAAAAAD//////////wABIFUAAX+AAAAAAP//////////AAEgTQABf4AAAAAA//////////8A` +
-	`ASBMAAF/gAAAAAD//////////wABIFIAAX+AAAAAAP//////////AAEgRQABf4AAAAAA//////////8AASBGAAF/gAAAAAD//////////wABAbsAAX+AAAAAAP//////////AAEBnAABf4AAAAAA//` +
-	`////////8AAQMaAAF/gAAAAAD//////////wABAyoAAX+AAAAAAP//////////AAEDOgABf4AAAAAA//////////8AAQNGAAF/gAAAAAD//////////wABAyAAAX+AAAAAAP//////////AAEDEAAB` +
-	`f4AAAAAA//////////8AAQMrAAF/gAAAAAD//////////wABAz4AAX+AAAAAAP//////////AAEDTgABf4AAAAAA//////////8AAQNHAAF/gAAAAAD//////////wABAzsAAX+AAAAAAP////////` +
-	`//AAEDSQABf4AAAAAA//////////8AAQNIAAF/gAAAAAD//////////wABA0sAAX+AAAAAAP//////////AAEDTAABf4AAAAAA//////////8AAQNKAAF/gAAAAAD//////////wABAmkAAX+AAAAA` +
-	`AP//////////AAEDRQABf4AAAAAA//////////8AAQM9AAF/gAAAAAD//////////wABAzwAAX+AAAAAAP//////////AAEDTQABf4AAAAAA//////////8AAQNQAAF/gAAAAAD//////////wABA1` +
-	`EAAX+AAAAAAP//////////AAEDUgABf4AAAAAA//////////8AAQNTAAF/gAAAAAD//////////wABA1QAAX+AAAAAAP//////////AAEDVQABf4AAAAAA//////////8AAQNWAAF/gAAAAAD/////` +
-	`/////wABA1cAAX+AAAAAAP//////////AAEDWAABf4AAAAAA//////////8AAQNZAAF/gAAAAAD//////////wABA1oAAX+AAAAAAP//////////AAEDYwABf4AAAAAA//////////8AAQNkAAF/gA` +
-	`AAAAD//////////wABA2UAAX+AAAAAAP//////////AAEDZgABf4AAAAAA//////////8AAQNnAAF/gAAAAAD//////////wABA2gAAX+AAAAAAP//////////AAEDaQABf4AAAAAA//////////8A` +
-	`AQNqAAF/gAAAAAD//////////wABA2sAAX+AAAAAAP//////////AAEDbAABf4AAAAAA//////////8AAQNtAAF/gAAAAAD//////////wABA24AAX+AAAAAAP//////////AAEDbwABf4AAAAAA//` +
-	`////////8AAQLfAAF/gAAAAAD//////////wABAn4AAX+AAAAAAP//////////AAECfwABf4AAAAAA//////////8AAQJ2AAF/gAAAAAD//////////wABAmUAAX+AAAAAAP//////////AAECjgAB` +
-	`f4AAAAAA//////////8AAQJWAAF/gAAAAAD//////////wABAoAAAX+AAAAAAP//////////AAECgQABf4AAAAAA//////////8AAQKCAAF/gAAAAAD//////////wABAmIAAX+AAAAAAP////////` +
-	`//AAECdAABf4AAAAAA//////////8AAQKKAAF/gAAAAAD//////////wABAo8AAX+AAAAAAP//////////AAECnAABf4AAAAAA//////////8AAQKfAAF/gAAAAAD//////////wABApkAAX+AAAAA` +
-	`AP//////////AAEgOAABf4AAAAAA//////////8AASCnAAF/gAAAAAD//////////wABAokAAX+AAAAAAP//////////AAEChQABf4AAAAAA//////////8AAQKEAAF/gAAAAAD//////////wABAp` +
-	`4AAX+AAAAAAP//////////AAECaAABf4AAAAAA//////////8AAQGWAAF/gAAAAAD//////////wABAlMAAX+AAAAAAP//////////AAECVwABf4AAAAAA//////////8AAQJmAAF/gAAAAAD/////` +
-	`/////wABAmcAAX+AAAAAAP//////////AAECoQABf4AAAAAA//////////8AAQKiAAF/gAAAAAD//////////wABAmAAAX+AAAAAAP//////////AAEBqwABf4AAAAAA//////////8AAQGtAAF/gA` +
-	`AAAAD//////////wABAZkAAX+AAAAAAP//////////AAEBpQABf4AAAAAA//////////8AAQGEAAF/gAAAAAD//////////wABAYUAAX+AAAAAAP//////////AAEB5AABf4AAAAAA//////////8A` +
-	`AQHlAAF/gAAAAAD//////////wABAmsAAX+AAAAAAP//////////AAECeAABf4AAAAAA//////////8AAQKgAAF/gAAAAAD//////////wABAcoAAX+AAAAAAP//////////AAEBywABf4AAAAAA//` +
-	`////////8AAQHMAAF/gAAAAAD//////////wABAccAAX+AAAAAAP//////////AAEByAABf4AAAAAA//////////8AAQHJAAF/gAAAAAD//////////wABAcQAAX+AAAAAAP//////////AAEBxQAB` +
-	`f4AAAAAA//////////8AAQHGAAF/gAAAAAD//////////wABAfEAAX+AAAAAAP//////////AAEB8gABf4AAAAAA//////////8AAQHzAAF/gAAAAAD//////////wABAiQAAX+AAAAAAP////////` +
-	`//AAECJQABf4AAAAAA//////////8AAQJBAAF/gAAAAAD//////////wABIBYAAX+AAAAAAP//////////AAEClwABf4AAAAAA//////////8AAQI6AAF/gAAAAAD//////////wABAjsAAX+AAAAA` +
-	`AP//////////AAECPAABf4AAAAAA//////////8AAQI9AAF/gAAAAAD//////////wABAj4AAX+AAAAAAP//////////AAECOAABf4AAAAAA//////////8AAQI5AAF/gAAAAAD//////////wABAb` +
-	`cAAX+AAAAAAP//////////AAEgRwABf4AAAAAA//////////8AASBDAAF/gAAAAAD//////////wABIDEAAX+AAAAAAP//////////AAEgPgABf4AAAAAA//////////8AASBBAAF/gAAAAAD/////` +
-	`/////wABIE4AAX+AAAAAAP//////////AAEgTwABf4AAAAAA//////////8AASBRAAF/gAAAAAD//////////wABIBcAAX+AAAAAAP//////////AAEgSgABf4AAAAAA//////////8AASBIAAF/gA` +
-	`AAAAD//////////wABIEkAAX+AAAAAAP//////////AAEgUwABf4AAAAAA//////////8AASBUAAF/gAAAAAD//////////wABID8AAX+AAAAAAP//////////AAEgQAABf4AAAAAA//////////8A` +
-	`ASBQAAF/gAAAAAD//////////wABAtcAAX+AAAAAAP//////////AAECsAABf4AAAAAA//////////8AAQKxAAF/gAAAAAD//////////wABArIAAX+AAAAAAP//////////AAECswABf4AAAAAA//` +
-	`////////8AAQK0AAF/gAAAAAD//////////wABArUAAX+AAAAAAP//////////AAECtgABf4AAAAAA//////////8AAQK3AAF/gAAAAAD//////////wABArgAAX+AAAAAAP//////////AAEC3gAB` +
-	`f4AAAAAA//////////8AAQJaAAF/gAAAAAD//////////wABAqMAAX+AAAAAAP//////////AAECpgABf4AAAAAA//////////8AAQKpAAF/gAAAAAD//////////wABAqcAAX+AAAAAAP////////` +
-	`//AAECqgABf4AAAAAA//////////8AAQKrAAF/gAAAAAD//////////wABAq4AAX+AAAAAAP//////////AAECrwABf4AAAAAA//////////8AAQKsAAF/gAAAAAD//////////wABAq0AAX+AAAAA` +
-	`AP//////////AAEC7QABf4AAAAAA//////////8AAQLhAAF/gAAAAAD//////////wABAuIAAX+AAAAAAP//////////AAEC4wABf4AAAAAA//////////8AAQLkAAF/gAAAAAD//////////wABAt` +
-	`AAAX+AAAAAAP//////////AAEC0QABf4AAAAAA//////////8AASAjAAF/gAAAAAD//////////wABAuUAAX+AAAAAAP//////////AAEC5gABf4AAAAAA//////////8AAQLnAAF/gAAAAAD/////` +
-	`/////wABAugAAX+AAAAAAP//////////AAEC6QABf4AAAAAA//////////8AAQLqAAF/gAAAAAD//////////wABAusAAX+AAAAAAP//////////AAEC8QABf4AAAAAA//////////8AAQLyAAF/gA` +
-	`AAAAD//////////wABAvMAAX+AAAAAAP//////////AAEC8AABf4AAAAAA//////////8AAQLvAAF/gAAAAAD//////////wABAv0AAX+AAAAAAP//////////AAEC/gABf4AAAAAA//////////8A` +
-	`AQL5AAF/gAAAAAD//////////wABAvoAAX+AAAAAAP//////////AAEC+wABf4AAAAAA//////////8AAQL8AAF/gAAAAAD//////////wABAvgAAX+AAAAAAP//////////AAEC/wABf4AAAAAA//` +
-	`////////8AAQL3AAF/gAAAAAD//////////wABAvYAAX+AAAAAAP//////////AAEC9QABf4AAAAAA//////////8AAQL0AAF/gAAAAAD//////////wABA18AAX+AAAAAAP//////////AAEDXQAB` +
-	`f4AAAAAA//////////8AAQNeAAF/gAAAAAD//////////wABA2EAAX+AAAAAAP//////////AAEDYAABf4AAAAAA//////////8AAQNcAAF/gAAAAAD//////////wABA2IAAX+AAAAAAP////////` +
-	`//AAEDWwABf4AAAAAA//////////8AAQRiAAF/gAAAAAD//////////wABBGMAAX+AAAAAAP//////////AAEEZgABf4AAAAAA//////////8AAQRnAAF/gAAAAAD//////////wABBHIAAX+AAAAA` +
-	`AP//////////AAEEcwABf4AAAAAA//////////8AAQR0AAF/gAAAAAD//////////wABBHUAAX+AAAAAAP//////////AAEEdgABf4AAAAAA//////////8AAQR3AAF/gAAAAAD//////////wABIF` +
-	`gAAX+AAAAAAP//////////AAEgWwABf4AAAAAA//////////8AASBaAAF/gAAAAAD//////////wABIDsAAX+AAAAAAP//////////AAEgXAABf4AAAAAA//////////8AASBXAAF/gAAAAAD/////` +
-	`/////wABIFYAAX+AAAAAAP//////////AAEgWQABf4AAAAAA//////////8AASBdAAF/gAAAAAD//////////wABIF4AAX+AAAAAAP//////////AAEgQgABf4AAAAAA//////////8AASEXAAF/gA` +
-	`AAAAD//////////wABIToAAX+AAAAAAP//////////AAEhKQABf4AAAAAA//////////8AASEAAAF/gAAAAAD//////////wABIQEAAX+AAAAAAP//////////AAEhBQABf4AAAAAA//////////8A` +
-	`ASEGAAF/gAAAAAD//////////wABIQMAAX+AAAAAAP//////////AAEhCQABf4AAAAAA//////////8AASIoAAF/gAAAAAD//////////wABIicAAX+AAAAAAP//////////AAEiHQABf4AAAAAA//` +
-	`////////8AASIjAAF/gAAAAAD//////////wABIhMAAX+AAAAAAP//////////AAEiEAABf4AAAAAA//////////8AASIUAAF/gAAAAAD//////////wABIhUAAX+AAAAAAP//////////AAEiFgAB` +
-	`f4AAAAAA//////////8AASI2AAF/gAAAAAD//////////wABIjcAAX+AAAAAAP//////////AAEiFwABf4AAAAAA//////////8AASIYAAF/gAAAAAD//////////wABIhkAAX+AAAAAAP////////` +
-	`//AAEiLAABf4AAAAAA//////////8AASI8AAF/gAAAAAD//////////wABIj0AAX+AAAAAAP//////////AAEiNAABf4AAAAAA//////////8AASI1AAF/gAAAAAD//////////wABIkAAAX+AAAAA` +
-	`AP//////////AAEiYQABf4AAAAAA//////////8AASJDAAF/gAAAAAD//////////wABIkIAAX+AAAAAAP//////////AAEiRQABf4AAAAAA//////////8AAQG4AAF/gAAAAAD//////////wABAi` +
-	`AAAX+AAAAAAP//////////AAECkgABf4AAAAAA//////////8AAQG5AAF/gAAAAAD//////////wABAe8AAX+AAAAAAP//////////AAEB7gABf4AAAAAA//////////8AAQJuAAF/gAAAAAD/////` +
-	`/////wABHQAAAX+AAAAAAP//////////AAEdAQABf4AAAAAA//////////8AAR0CAAF/gAAAAAD//////////wABHQQAAX+AAAAAAP//////////AAEdBQABf4AAAAAA//////////8AAR0GAAF/gA` +
-	`AAAAD//////////wABHQcAAX+AAAAAAP//////////AAEdCQABf4AAAAAA//////////8AAR0KAAF/gAAAAAD//////////wABHQsAAX+AAAAAAP//////////AAEdDAABf4AAAAAA//////////8A` +
-	`AR0NAAF/gAAAAAD//////////wABHQ4AAX+AAAAAAP//////////AAEdDwABf4AAAAAA//////////8AAR0UAAF/gAAAAAD//////////wABHRgAAX+AAAAAAP//////////AAEdGQABf4AAAAAA//` +
-	`////////8AAR0aAAF/gAAAAAD//////////wABHRAAAX+AAAAAAP//////////AAEdGwABf4AAAAAA//////////8AAR0cAAF/gAAAAAD//////////wABHSAAAX+AAAAAAP//////////AAEdIQAB` +
-	`f4AAAAAA//////////8AAR0iAAF/gAAAAAD//////////wABHSMAAX+AAAAAAP//////////AAEdJwABf4AAAAAA//////////8AAR0mAAF/gAAAAAD//////////wABHSkAAX+AAAAAAP////////` +
-	`//AAEdLAABf4AAAAAA//////////8AAR0tAAF/gAAAAAD//////////wABHQMAAX+AAAAAAP//////////AAEdLwABf4AAAAAA//////////8AAR0wAAF/gAAAAAD//////////wABHTEAAX+AAAAA` +
-	`AP//////////AAEdMgABf4AAAAAA//////////8AAR0uAAF/gAAAAAD//////////wABHTMAAX+AAAAAAP//////////AAEdNAABf4AAAAAA//////////8AAR01AAF/gAAAAAD//////////wABHT` +
-	`YAAX+AAAAAAP//////////AAEdNwABf4AAAAAA//////////8AAR04AAF/gAAAAAD/////`
-
-const MonacoFontData5 = `/////wABHTkAAX+AAAAAAP//////////AAEdOgABf4AAAAAA//////////8AAR07AAF/gAAAAAD//////////wABHTwAAX+AAAAAAP//////////AAEdPgABf4AAAAAA//` +
-	`////////8AAR0/AAF/gAAAAAD//////////wABHUAAAX+AAAAAAP//////////AAEdQQABf4AAAAAA//////////8AAR1CAAF/gAAAAAD//////////wABHXoAAX+AAAAAAP//////////AAEdawAB` +
-	`f4AAAAAA//////////8AAR1sAAF/gAAAAAD//////////wABHW0AAX+AAAAAAP//////////AAEdbgABf4AAAAAA//////////8AAR1yAAF/gAAAAAD//////////wABHXMAAX+AAAAAAP////////` +
-	`//AAEddQABf4AAAAAA//////////8AAR12AAF/gAAAAAD//////////wABHXcAAX+AAAAAAP//////////AAEDkQABf4AAAAAA//////////8AAQOSAAF/gAAAAAD//////////wABA5MAAX+AAAAA` +
-	`AP//////////AAEDlAABf4AAAAAA//////////8AAQOVAAF/gAAAAAD//////////wABA5YAAX+AAAAAAP//////////AAEDlwABf4AAAAAA//////////8AAQOYAAF/gAAAAAD//////////wABA5` +
-	`kAAX+AAAAAAP//////////AAEDmgABf4AAAAAA//////////8AAQObAAF/gAAAAAD//////////wABA5wAAX+AAAAAAP//////////AAEDnQABf4AAAAAA//////////8AAQOeAAF/gAAAAAD/////` +
-	`/////wABA58AAX+AAAAAAP//////////AAEDoAABf4AAAAAA//////////8AAQOhAAF/gAAAAAD//////////wABA6MAAX+AAAAAAP//////////AAEDpAABf4AAAAAA//////////8AAQOlAAF/gA` +
-	`AAAAD//////////wABA6YAAX+AAAAAAP//////////AAEDpwABf4AAAAAA//////////8AAQOoAAF/gAAAAAD//////////wABHW8AAX+AAAAAAP//////////AAEdcAABf4AAAAAA//////////8A` +
-	`AR1xAAF/gAAAAAD//////////wABHSoAAX+AAAAAAP//////////AAEdKAABf4AAAAAA//////////8AAR0fAAF/gAAAAAD//////////wABHR0AAX+AAAAAAP//////////AAEdKwABf4AAAAAA//` +
-	`////////8AAR0RAAF/gAAAAAD//////////wABHRIAAX+AAAAAAP//////////AAEdEwABf4AAAAAA//////////8AAQIiAAF/gAAAAAD//////////wABHRUAAX+AAAAAAP//////////AAEdPQAB` +
-	`f4AAAAAA//////////8AAR0eAAF/gAAAAAD//////////wABHRYAAX+AAAAAAP//////////AAEdFwABf4AAAAAA//////////8AAR1FAAF/gAAAAAD//////////wABHUYAAX+AAAAAAP////////` +
-	`//AAEdRwABf4AAAAAA//////////8AAR1IAAF/gAAAAAD//////////wABHUkAAX+AAAAAAP//////////AAEdSgABf4AAAAAA//////////8AAR1NAAF/gAAAAAD//////////wABHU4AAX+AAAAA` +
-	`AP//////////AAEdTwABf4AAAAAA//////////8AAR1QAAF/gAAAAAD//////////wABHVEAAX+AAAAAAP//////////AAEdUgABf4AAAAAA//////////8AAR1TAAF/gAAAAAD//////////wABHV` +
-	`QAAX+AAAAAAP//////////AAEdVQABf4AAAAAA//////////8AAR1WAAF/gAAAAAD//////////wABHVcAAX+AAAAAAP//////////AAEdWAABf4AAAAAA//////////8AAR1ZAAF/gAAAAAD/////` +
-	`/////wABHVoAAX+AAAAAAP//////////AAEdWwABf4AAAAAA//////////8AAR1iAAF/gAAAAAD//////////wABHWMAAX+AAAAAAP//////////AAEdZAABf4AAAAAA//////////8AAR1lAAF/gA` +
-	`AAAAD//////////wABHXQAAX+AAAAAAP//////////AAEdeAABf4AAAAAA//////////8AAR1+AAF/gAAAAAD//////////wABHX8AAX+AAAAAAP//////////AAEdewABf4AAAAAA//////////8A` +
-	`AR18AAF/gAAAAAD//////////wABHX0AAX+AAAAAAP//////////AAEEcAABf4AAAAAA//////////8AAQRxAAF/gAAAAAD//////////wABBKQAAX+AAAAAAP//////////AAEEpQABf4AAAAAA//` +
-	`////////8AAQTFAAF/gAAAAAD//////////wABBMYAAX+AAAAAAP//////////AAEEyQABf4AAAAAA//////////8AAQTKAAF/gAAAAAD//////////wABBGoAAX+AAAAAAP//////////AAEEawAB` +
-	`f4AAAAAA//////////8AAQGQAAF/gAAAAAD//////////wABAlwAAX+AAAAAAP//////////AAECWwABf4AAAAAA//////////8AAQJdAAF/gAAAAAD//////////wABpxIAAX+AAAAAAP////////` +
-	`//AAGnEwABf4AAAAAA//////////8AAacUAAF/gAAAAAD//////////wABpxUAAX+AAAAAAP//////////AAGnFgABf4AAAAAA//////////8AAacIAAF/gAAAAAD//////////wABpwoAAX+AAAAA` +
-	`AP//////////AAGnCQABf4AAAAAA//////////8AAacLAAF/gAAAAAD//////////wABpwwAAX+AAAAAAP//////////AAGnDQABf4AAAAAA//////////8AAacOAAF/gAAAAAD//////////wABpw` +
-	`8AAX+AAAAAAP//////////AAGnEAABf4AAAAAA//////////8AAacRAAF/gAAAAAD//////////wABIioAAX+AAAAAAP//////////AAEiKQABf4AAAAAA//////////8AASKCAAF/gAAAAAD/////` +
-	`/////wABIoMAAX+AAAAAAP//////////AAEihgABf4AAAAAA//////////8AASKHAAF/gAAAAAD//////////wABIogAAX+AAAAAAP//////////AAEiiQABf4AAAAAA//////////8AASKEAAF/gA` +
-	`AAAAD//////////wABIoUAAX+AAAAAAP//////////AAGnAAABf4AAAAAA//////////8AAacBAAF/gAAAAAD//////////wABpwIAAX+AAAAAAP//////////AAGnAwABf4AAAAAA//////////8A` +
-	`AacEAAF/gAAAAAD//////////wABpwUAAX+AAAAAAP//////////AAGnBgABf4AAAAAA//////////8AAacHAAF/gAAAAAD//////////wAAAAD//////////wAAAAD//////////wAAAAD///////` +
-	`///wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA////////` +
-	`//8AAAABf4AAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP//////////AAAAAP////////` +
-	`//AAAAAX+AAAD//////////wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD/////////` +
-	`/wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA//////////` +
-	`8AAAABf4AAAP//////////AAAAAX+AAAD//////////wAAAAF/gAAA//////////8AAAABf4AAAP//////////AAAAAX+AAAAAAgAAAAAAAQAAAAUAAgZsAAAABwAAAAcAAQACAAAAAv////8AAQAD` +
-	`AAAAAP////0AGwAAAAAAAf////8AGwABAAAAAP////4AGwACAAAABP////8AGwADAAAAAP////sAAAABAAAAAAAAAAAAAIGaIAAAAgAAAAEAAAAlAAAAHAAAALAAACAyAAAndgAAK6IAAIBAAAYABA` +
-	`AhAIAABQAEACQABAAmABAAKAASACwAFAAvABUAMgAWADUAGQA2ABoAOAAbAEQAHABGAB0ASAAeAEwAHwBPACAAUgAhAFUAIgBWACMAWAAkAXYABgF3AAcBeAAFAXkACAF6AAsBewAMAXwADQF9AA4B` +
-	`fgAPAYAAEwGBABEBggAYAyAACQNRABcD9AAK/////wAAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAAEAAAABQAGAAcAAAAAAAgACQAKAAsADAANAA4ADwAQABEAEgATAAAAAAAAAA` +
-	`AAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAQAAAAFAAYABwAAAAAACAAJAAoACwAMAA0ADgAPABAAEQASABMAAQABABQAAQABAAEAFQAVABUAFQAVAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAFgABAAEAFwABAAEAAQAdAAEAGAAZABoAGwAcAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAeAAEAAQ` +
-	`ABAB8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABACAAAQABAAEAIQAhACEAIQAhAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAIgABAAEAAQABAAEAAQABAAEAIwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAkAAEAAQABAA` +
-	`EAAQABAAEAAQAlAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABACYAAQABAAEAAQAnAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAKAABAAEAKQABAAEAAQABAAEAAQApAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAqAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQArAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABACwAAQABAAEAAQAtAAEAAQABAAEAAQABAAEAAQABAC4AAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEALwABAAEAAQABADAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAxAAEAAQABADIAMgAyAD` +
-	`IAMgAyAAEAAQABAAEAAQABAAEAMgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABADMAAQABADQAAQABAAEAOAABADUANgABAAEAAQABADcAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAOQABAAEAAQA6ADoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQA7AAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQA8AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAD0AAQABAAEAAQABAAEAAQABAAEAPgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAPwABAAEAQAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBBAAEAAQABAEIAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAEMAAQABAAEAAQABAAEAAQABAEQAAQABAEUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEARgABAAEAAQABAEcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBIAAEAAQABAAEAAQABAEkAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAEoAAQABAAEAAQABAAEATAABAEsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEATQABAAEAAQABAAEAAQABAAEATgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBPAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQBQAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAFEAAQABAFQAUgBTAFUAWgBcAFYAAQBXAFgAAQABAAEAAQABAAEAAQABAFsAWQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAXQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAXgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBfAAEAAQABAGAAYABgAGAAYAABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGEAAQABAAEAAQBiAAEAAQABAGIAAQABAGIAAQABAAEAAQABAAEAAQABAGIAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAYwABAAEAAQBkAGQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBkAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBlAAEAAQABAAEAAQABAAEAAQBmAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGcAAQABAAEAAQABAAEAAQABAGgAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAaQABAAEAAQABAAEAAQABAAEAagABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBrAAEAAQBsAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQBsAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAG0AAQABAAEAbgBuAG4AbgBuAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`bwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAcAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBxAAEAAQABAAEAAQABAHIAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHMAAQABAAEAAQABAAEAdQABAHQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAdgAB` +
-	`AAEAAQABAAEAAQABAAEAdwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQB4AAEAAQABAAEAAQABAAEAAQABAAEAeQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHoAAQABAAEAAQB7AAEAfgABAAEAAQB8AAEAAQABAAEAAQB9AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAfwABAAEA` +
-	`AQABAAEAAQCAAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCBAAEAAQABAAEAAQABAAEAAQABAAEAggABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIMAAQABAAEAAQABAAEAAQABAAEAAQCEAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAhQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAhgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCHAAEAAQABAIgAiQCKAI0AjwCLAAEAAQCMAAEAAQABAAEAAQABAAEAAQCOAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAkgABAAEAAQABAJMA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAkwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCUAAEAAQABAJUAAQABAAEAAQABAAEAAQCVAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAJYAAQABAAEAlwCXAAEAAQABAJcAAQABAAEAAQABAAEAAQCXAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAmAABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAmQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCaAAEAAQABAJsAmwCbAJsAmwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAJwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJ0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAngABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCfAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCgAAEAAQCiAAEAoQABAKkAAQCjAKQApQCmAKcAAQABAAEAAQABAAEAAQABAKgAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAKoAAQABAAEAqwCrAKsAqwCrAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEArAABAAEAAQCtAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCuAAEAAQABAK8ArwCvAK8ArwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABALAAAQABAAEAAQABAAEAAQABALEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAsgABAAEAAQABAAEAAQABAAEAswABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQC0AAEAAQABAAEAtQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABALYAAQABAAEAAQC3AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAuAABAAEAuQABAAEAAQABAAEAAQC5AAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQC6AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQC7AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABALwAAQABAAEAAQC9AAEAAQABAAEAAQABAAEAAQABAL4AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAvwABAAEAAQABAMAAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDBAAEAAQABAMIAwgDCAMIAwgDDAAEAAQABAAEAAQABAAEAwwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAMQAAQABAMUAAQABAAEAyQABAMYAxwABAAEAAQABAMgAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAygABAAEAAQDLAMsAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDMAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDNAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAM4AAQABAAEAAQABAAEAAQABAAEAzwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA0AABAAEA0QABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDSAAEAAQABANMAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAN` +
-	`QAAQABAAEAAQABAAEAAQABANUAAQDWANcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA2AABAAEAAQABANkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDaAAEAAQABAAEA2wABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANwAAQ` +
-	`ABAAEAAQABAAEA3QABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA3gABAAEAAQABAAEAAQDgAAEA3wABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDhAAEAAQABAAEAAQABAAEAAQDiAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOMAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA5QABAAEA6ADmAOcA6QDuAPAA6gABAOsA7AABAAEAAQABAAEAAQABAAEA7wDt` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDxAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDyAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPMAAQABAAEA9A` +
-	`D0APQA9AD0AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA9QABAAEAAQABAPYAAQABAAEA9wABAAEA9gABAAEAAQABAAEAAQABAAEA9gABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQD4AAEAAQABAPkA+QABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPsAAQABAAEAAQABAA` +
-	`EAAQABAPwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA/QABAAEAAQABAAEAAQABAAEA/gABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQD/AAEAAQABAAEAAQABAAEAAQEAAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQEAAQABAQIAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBAwABAAEAAQEEAQQBBAEEAQQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQEFAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQEGAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQcAAQABAAEAAQABAAEBCAABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBCQABAAEAAQABAAEAAQELAAEBCgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQEMAAEAAQABAAEAAQABAAEAAQENAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQ4AAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`EPAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBEAABAAEAAQABAREAAQEUAAEAAQABARIAAQABAAEAAQABARMAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQEVAAEAAQABAAEAAQABARYAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARcAAQABAAEAAQABAAEAAQABAAEAAQEYAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBGQABAAEAAQABAAEAAQABAAEAAQABARoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQEbAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQEcAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAR0AAQABAAEBHgEfASABIwElASEAAQABASIAAQ` +
-	`ABAAEAAQABAAEAAQABASQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBJgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBJwABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQEoAAEAAQABAAEBKQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQEpAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASoAAQABAAEBKwABAAEAAQABAAEAAQABASwAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBLQABAAEAAQEuAS4AAQABAAEBLgABAAEAAQABAAEAAQABAS4AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQEvAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQEwAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABATEAAQABAAEBMgEzATMBMwEzAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBNAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBNQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAAA` +
-	`AAAAAABAAAAAAAOAAAAAAAuAAAAAAA6AAAAAABSAAAAAABeAAAAAABqAAAAAACaAAAAAACmAAAAAAC6AAAAAADeAAAAAAEGAAAAAAESAAAAAAEqAAAAAAE6AAAAAAFGAAAAAAF2AAAAAAGCAAAAAAG` +
-	`WAAAAAAAIAAAAAAACgAAAAAAMAAAAAAAKAAAAAAASAAAAAAAWAAAAAAAaAAAAAAAeAAAAAAAiAAAAAAAmAAAAAAAQAAAAAAACgAAADAAUAAAAAAACgAAAGAAYAAAAAAACgAAAJAAcAAAAAAACgAAAM` +
-	`AAgAAAAAAACgAAAPAAkAAAAAAACgAAASAAoAAAAAAACgAAAVAAsAAAAAAAqAAAAAAAyAAAAAAAwAAAAAAACgAAAYAA0AAAAAAACgAAAbAA4AAAAAAA2AAAAAAA+AAAAAABCAAAAAABGAAAAAABKAAA` +
-	`AAAA8AAAAAAACgAAAeABAAAAAAAACgAAAhABEAAAAAAACgAAAkABIAAAAAAACgAAAnABMAAAAAAACgAAAqABQAAAAAABOAAAAAABWAAAAAABUAAAAAAACgAAAtABYAAAAAAACgAAAwABcAAAAAABaA` +
-	`AAAAABiAAAAAABgAAAAAAACgAAAzABkAAAAAAACgAAA2ABoAAAAAABmAAAAAABuAAAAAAByAAAAAAB2AAAAAAB6AAAAAAB+AAAAAACCAAAAAACGAAAAAACKAAAAAACOAAAAAACSAAAAAABsAAAAAAA` +
-	`CgAAA5ABwAAAAAAACgAAA8AB0AAAAAAACgAAA/AB4AAAAAAACgAABCAB8AAAAAAACgAABFACAAAAAAAACgAABIACEAAAAAAACgAABLACIAAAAAAACgAABOACMAAAAAAACgAABRACQAAAAAAACgAABU` +
-	`ACUAAAAAAACgAABXACYAAAAAACWAAAAAACeAAAAAACcAAAAAAACgAABaACgAAAAAAACgAABdACkAAAAAACiAAAAAACqAAAAAACuAAAAAACyAAAAAACoAAAAAAACgAABgACsAAAAAAACgAABjACwAAA` +
-	`AAAACgAABmAC0AAAAAAACgAABpAC4AAAAAAC2AAAAAAC+AAAAAADCAAAAAADGAAAAAADKAAAAAADOAAAAAADSAAAAAADWAAAAAAC8AAAAAAACgAABsADAAAAAAAACgAABvADEAAAAAAACgAAByADIA` +
-	`AAAAAACgAAB1ADMAAAAAAACgAAB4ADQAAAAAAACgAAB7ADUAAAAAAACgAAB+ADYAAAAAAACgAACBADcAAAAAADaAAAAAADiAAAAAADmAAAAAADqAAAAAADuAAAAAADyAAAAAAD2AAAAAAD6AAAAAAD` +
-	`+AAAAAADgAAAAAAACgAACEADkAAAAAAACgAACHADoAAAAAAACgAACKADsAAAAAAACgAACNADwAAAAAAACgAACQAD0AAAAAAACgAACTAD4AAAAAAACgAACWAD8AAAAAAACgAACZAEAAAAAAAACgAACc` +
-	`AEEAAAAAAECAAAAAAEKAAAAAAEIAAAAAAACgAACfAEMAAAAAAACgAACiAAKgAACiAEQAAAAAAEOAAAAAAEWAAAAAAEaAAAAAAEeAAAAAAEiAAAAAAEUAAAAAAACgAAClAEYAAAAAAACgAACoAEcAAA` +
-	`AAAACgAACrAEgAAAAAAACgAACuAEkAAAAAAACgAACxAEoAAAAAAEmAAAAAAEuAAAAAAEyAAAAAAEsAAAAAAACgAAC0AEwAAAAAAACgAAC3AE0AAAAAAACgAAC6AE4AAAAAAE2AAAAAAE+AAAAAAE8A` +
-	`AAAAAACgAAC9AFAAAAAAAAKgAADAAFEAAAAAAFCAAAAAAFKAAAAAAFOAAAAAAFSAAAAAAFWAAAAAAFaAAAAAAFeAAAAAAFiAAAAAAFmAAAAAAFqAAAAAAFuAAAAAAFIAAAAAAAKgAADDAFMAAAAAAA` +
-	`KgAADGAFQAAAAAAAKgAADJAACgAADJAFUAAAAAAAKgAADMAACgAADMAFYAAAAAAACgAADPAFcAAAAAAACgAADSAFgAAAAAAACgAADVAFkAAAAAAAKgAADYAFoAAAAAAAKgAADbAFsAAAAAAAKgAADe` +
-	`AFwAAAAAAAKgAADhAF0AAAAAAFyAAAAAAF6AAAAAAF4AAAAAAAKgAADkAF8AAAAAAAKgAADnAGAAAAAAAF+AAAAAAGGAAAAAAGKAAAAAAGOAAAAAAGEAAAAAAAKgAADqAGIAAAAAAAKgAADtAGMAAA` +
-	`AAAAKgAADwAGQAAAAAAACgAADzAGUAAAAAAGSAAAAAAGaAAAAAAGeAAAAAAGiAAAAAAGmAAAAAAGqAAAAAAGuAAAAAAGyAAAAAAGYAAAAAAAKgAAD2AGcAAAAAAAKgAAD5AGgAAAAAAACgAAD8AAKg` +
-	`AAD8AGkAAAAAAACgAAD/AGoAAAAAAAKgAAECAGsAAAAAAACgAAEFAAKgAAEFAGwAAAAAAAKgAAEIP//+igAAAQeAAAJcAAABCwAAAQiAAAJfAAABDgAAA4iAAATgAAADiwAAA4qAAATjAAADjgAAA4` +
-	`yAAATmAAADlAAAA46AAATpAAADlgAAAfKAAATvAAADkwAAA56AAATwAAADoAAAA5eAAATzAAADpAAABiGAAAdyAAAGJQAABiOAAAd2AAAGHgAABiWAAAd5AAAGJwAABiKAAAd8AAAGLQAABIaAAAd/` +
-	`AAAGMgAABi+AAAd+AAAGNAAABi+AAAeBAAAEjgAABjWAAAeBAAAGNwAABJKAAAeEAAAEYwAABj+AAAeEAAAEZgAABkGAAAeHAAAGRAAACMGAAAoIAAAIxAAACp2AAAvlAAAKogAACquAAAv0AAAKrQ` +
-	`AACqyAAAv3AAAKsAAACq6AAAv6AAAKswAACqyAAAv9AAAKuAAACuqAAA3ZAAAMlgAADTqAABBaAAANPAAADJqAABBdAAANcAAADxeAABBdAAAPGQAADXSAABBgAAAPGgAADyCAABBiAAANeQAADx6A` +
-	`ABBlAAAPIAAADx2AABBoAAAPIwAADYCAABBrAAANUQAADy2AABBsAAANVAAADy+AABBvAAAPMQAAEQqAABJMAAARDwAAEROAABJWAAARGQAAER2AABJjAAAPSwAAD32AABJmAAARKQAAEc2AABTnAA` +
-	`ARzwAAES2AABTqAAAToQAAE62AABThAAATsAAAFi2AABdiAAAWMQAAFi6AABdlAAAWNAAAGK6AABnmAAAYsQAAGLCAABnpAAAYtAAAGLKAABnsAAAYugAAGLSAABnvAAAYvQAAGLOAABnyAAAYvwAA` +
-	`FxuAABn4AAAYvAAAGMeAABn5AAAYyQAAGMCAABn8AAAYzQAAG0qAABx7AAAbTgAAG0yAABx/AAAbRwAAG06AAByCAAAbUAAAG0uAAByFAAAbVgAAGa+AAByIAAAbWwAAG1iAAByHAAAbXQAAG1mAAB` +
-	`yKAAAbYAAAG1uAAByNAAAZugAAG2GAAByNAAAbYwAAGb6AAByQAAAZjwAAG2uAAByQAAAZkgAAG22AAByTAAAbcAAAHe2AAB8UAAAd8AAAH8mAACDxAAAfzgAAH9eAACEAAAAf2QAAH9iAACEDAAAf` +
-	`3AAAH9qAACEGAAAf3wAAH9iAACEJAAAf5AAAIBaAACLlAAAhwgAAImaAACVmAAAiaAAAIcaAACVpAAAinAAAJEOAACVpAAAkRQAAIqCAACVsAAAkRgAAJEyAACVuAAAipQAAJEqAACVxAAAkTAAAJE` +
-	`mAACV0AAAkTwAAIqyAACV3AAAifQAAJFmAACV4AAAigAAAJFuAACV7AAAkXQAAJjaAACdYAAAmOwAAJj+AACdiAAAmRQAAJkmAACdvAAAkdwAAJKmAACdyAAAmVQAAJvmAACnzAAAm+wAAJlmAACn2` +
-	`AAAAAQAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAAABQAAAAEAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAADAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAYAAAAAAAsAAAAAAAwAAAAAAA` +
-	`0AAAAAAAAAAQAAAA4AAAAAABAAAAAAABEAAAABAAAAAgADAAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABQAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAYAAAASAAAAAQAAABkAAAAAABsAAAAAABwAAAAAAB0AAAAAAB4AAAAAAB8AAAAAAC` +
-	`AAAAAAACEAAAAAACIAAAAAACMAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAkAAAAAAAAAAEAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`MAAAApAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAAAAtAAAAAAAwAAAAAAAxAAAAAAAyAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAMwAAAAEAAAAC` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAADUAAAAAADoAAAAAADsAAAAAADwAAAAAAD0AAAAAAD4AAAAAAD8AAAAAAEAAAAAAAEEAAAAAAEIAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAEMAAAAAAAAAAAAAAAAAAAABAAAARQAAAAEAAAAAAAIAAAAAAAAAAAAAAAMAAABHAAAAAABLAAAAAQAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAEAAAATAAAAAAAUQAAAAAAUgAAAAEAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAFMAAAAAAFgAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAQAAABZAAAAAABeAAAAAABfAAAAAABgAAAAAABhAAAAAAAAAAEAAABiAAAAAABkAAAAAABlAAAAAQAAAAIAAwAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGAAAAZgAAAAEAAABtAA` +
-	`AAAABvAAAAAABwAAAAAABxAAAAAAByAAAAAABzAAAAAAB0AAAAAAB1AAAAAAB2AAAAAAB3AAAAAAB4AAAAAQAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAAAeQAAAAAAAAABAA` +
-	`AAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAAAfgAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAACAAAAggAAAAAAhQAAAAAAhgAAAAAAhwAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAIgAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAACKAAAAAACPAAAAAACQAAAAAACRAAAAAACSAAAAAACTAAAAAACU` +
-	`AAAAAACVAAAAAACWAAAAAACXAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAACYAAAAAAAAAAAAAAAAAAAAAQAAAJoAAAABAAAAAAACAAAAAAAAAAAAAA` +
-	`ADAAAAnAAAAAAAoAAAAAEAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAKEAAAAAAKYC0QLPAtMC1QQMA9EC2QLXAtsC3QQOAgsCCQIbAtUC3QI7AjsC5QLjAucC/wMBAukEEg` +
-	`JHAkkCTwJPAukD1AJhAmsCawOtA6sC8QLvAvMC9QQYAn8CKQKBA68CgwKFAhUCLQInAhUC9QOxA60DqwOvA7EEGgQaAo8CjwKXApsCmQKbA7UDswKrA7cD1wKtAgcCAwIBAgUDuQO1A7MDtwO5BB4E` +
-	`HgPTAtIC0ALUAtYEDQPSAtoC2ALcAt4EDwIMAgoCHAPTAtYC3gI8AjwC5gLkAugDuwO8AuoEEwJIAkoCUAJQAuoD1QPWAmICbAJsA64DrALyAvAC9AL2BBkCgAIqAoIDsAKEAoYCFgIuAigCFgL2A7` +
-	`IDrgOsA7ADsgQbBBsCkAKQApgCnAKaApwDtgO0AqwDuAPYAq4CCAIEAgICBgO6A7YDtAO4A7oEHwQfAAEJ9CAAAAIAAAABAAAAfgAAABwAAAISAABjhgAAapoAAG2iAAEGcAAEAAYAKQDAAAUANgAg` +
-	`ACABAgAzACQBBAA9ADUBJABTAEQBNgBdAFUBVgByAHIBaACOAI4BagCRAJABbAChAKABcAFyAXIBdAGEAXYBdgGHAYcBlAGMAYkBlgGOAY4BngGSAZIBoAGXAZcBogGbAZsBpAGfAZ8BpgGhAaEBqA` +
-	`GkAaQBqgGpAakBrAHBAcEBrgHEAcQBsAHJAcYBsgHLAcsBugHPAc8BvAHUAdQBvgHYAdgBwAHcAdwBwgHeAd4BxAMnAyABxgMwAzAB1gMyAzIB2ANMA0kB2gNRA1EB4gP0A/QB5AUnBScB5gV+BX0B` +
-	`6AWvBa8B7AZbBlgB7v////8B9gAEAAYAFwAZABsAHQAfACAAIQAjACQAJQAmACcAKAApACwALQAuADAAMQAzADQANQA2ADcAOAA5ADoAOwA8AD0APgA/AEAAQQBCAEMARABFAEYARwBIAEkASgBLAE` +
-	`wATQBOAE8AUABRAFIAUwBUAFUAVgAvAAcACAAJAAoACwAMAA0ADgAPACoAEAAaABEABQBXAFgAWQBaAFsAXABdAF4AXwBgAGEAYgBjAGQAZQBmAGcAaABpAGoAawBsAG0AbgBvAHAAEgAyABMAHAAi` +
-	`AB4AGABxABQAFQByAHMAdAB1ACsAFgB2AHcAeAB5AHoAewB8AH0AAAAAAAAAAAACAAAAAwAAAAAAAAAAAAAAAAAAADsAAAAAAAAAAAAAAAAAAAAAAAQAAAAFAAAABgAAAAcAAAAIAAkACgAAAAsADA` +
-	`ANAA4ADwAQABEAAAAAABIAEwAUAAAAFQAWAAAAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAPAA9AD4APwBAAEEA` +
-	`QgBDAEQARQBGAEcASABJAEoASwBMAE0ATgBPAFAAUQBSAFMAVABVAFYAVwBYAFkAWgBbAFwAXQBeAF8AYABhAGIAAAAAAAAAAAACAAAAAwAAAAAAAAAAAAAAAAAAADsAAAAAAAAAAAAAAAAAAAAAAA` +
-	`QAAAAFAAAABgAAAAcAAAAIAAkACgAAAAsADAANAA4ADwAQABEAAAAAABIAEwAUAAAAFQAW`
-
-const MonacoFontData6 = `AAAAFwAYABkAGgAbABwAHQAeAB8AIAAhACIAIwAkACUAJgAnACgAKQAqACsALAAtAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAPAA9AD4APwBAAEEAQgBDAEQARQBGAE` +
-	`cASABJAEoASwBMAE0ATgBPAFAAUQBSAFMAVABVAFYAVwBYAFkAWgBbAFwAXQBeAF8AYABhAGIAAQABAGMAAQABAGQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGUAAQABAAEAAQBmAGYAZgBmAGYAZgBmAGYA` +
-	`ZgBmAGYAZgBmAGYAZgBmAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AGcAAQABAAEAAQABAAEAAQABAAEAAQBoAAEAAQABAAEAaAABAAEAAQABAAEAaAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGkAAQABAAEAAQABAGoAagABAAEAAQBqAAEAAQBqAAEAAQABAAEAAQABAAEAAQABAGoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGsAAQABAAEAAQABAAEAAQABAAEAAQBsAAEAAQBsAAEAbAABAAEAAQABAAEAbAABAGwAAQBsAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAG0AAQABAAEAAQBuAG4AbgBuAG4AbgBuAG4AAQBuAG` +
-	`4AbgABAG4AbgBuAAEAAQABAG4AAQBuAAEAbgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAG8AAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQBwAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAHEAAQABAAEAAQABAHIAcgABAHIAcgByAAEAAQByAAEAAQABAAEAAQABAAEAAQABAHIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHMAAQABAAEAAQABAAEAdAABAAEAAQB0AHQAAQB0AAEAdAABAAEAAQABAAEAAQABAHQAAQABAAEAAQABAAEA` +
-	`AQB0AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHUAAQABAAEAAQB2AHYAdgB2AHYAdgB2AHYAAQB2AHYAdgAB` +
-	`AHYAdgB2AAEAAQABAAEAAQABAAEAdgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHcAAQABAAEA` +
-	`AQABAAEAeAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAHkAAQABAAEAAQABAHoAAQABAAEAAQABAAEAAQB6AAEAegABAAEAAQABAAEAegABAHoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHsAAQABAAEAAQABAHwAAQABAAEAAQABAAEAAQB8AAEAfAABAAEAAQABAAEAfAABAHwAAQB8AAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAH0AAQABAAEAAQABAH4AAQABAAEAAQB+AAEAAQABAAEAfgABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAH8AAQABAAEAAQCAAI` +
-	`AAAQCAAAEAAQCAAAEAAQCAAAEAgAABAAEAAQABAAEAgAABAIAAAQCAAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAIEAAQABAAEAAQCCAIIAggCCAIIAggCCAIIAAQCCAIIAggABAIIAggCCAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAggCCAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIMAAQABAAEAAQABAIQAAQABAAEAAQCEAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIUAAQABAAEAAQABAIYAAQABAAEAAQCGAAEAAQCGAAEAhgABAIYAhgABAAEA` +
-	`hgABAIYAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIcAAQABAAEAAQABAIgAiAAB` +
-	`AAEAAQCIAAEAAQCIAAEAiAABAAEAAQABAAEAAQABAIgAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIgAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAIkAAQABAAEAAQABAAEAAQABAAEAAQCKAAEAAQCKAAEAigABAAEAAQABAAEAigABAIoAAQCKAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIoAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIsAAQABAAEAAQCMAIwAjACMAIwAjAABAIwAjACMAIwAjAABAIwAjACMAAEAAQABAAEAAQCMAAEAjAABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAjACMAAEAAQABAAEAAQABAIwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAI0AAQABAAEAAQABAAEAAQCOAAEAAQABAAEAAQABAAEAjgABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAI8AAQABAAEAAQCQAJAAkAABAAEAAQ` +
-	`CQAJAAAQABAAEAkAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAJEAAQABAAEAAQABAAEAAQABAAEAAQCSAJIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJMAAQABAAEAAQCUAJQAlACUAJQAAQCUAJQAAQABAAEAlAABAAEAAQCUAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJUAAQABAAEAAQABAJYAlgABAAEAAQCWAAEAAQCWAAEAlgABAAEAAQABAAEAlgABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJcAAQABAAEAAQCYAJgAmACYAJgAmACYAJgA` +
-	`mACYAJgAmACYAJgAmACYAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AJkAAQABAAEAAQABAAEAAQABAAEAAQCaAAEAAQABAAEAmgABAAEAAQABAAEAmgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJsAAQABAAEAAQABAJwAnAABAAEAAQCcAAEAAQCcAAEAAQABAAEAAQABAAEAAQABAJwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJ0AAQABAAEAAQABAAEAAQABAAEAAQCeAAEAAQCeAAEAngABAAEAAQABAAEAngABAJ4AAQCeAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJ8AAQABAAEAAQCgAKAAoACgAKAAoACgAKAAAQCgAK` +
-	`AAoAABAKAAoACgAAEAAQABAKAAAQCgAAEAoAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQCiAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAKMAAQABAAEAAQABAKQApAABAKQApACkAAEAAQCkAAEAAQABAAEAAQABAAEAAQABAKQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKUAAQABAAEAAQABAAEApgABAAEAAQCmAKYAAQCmAAEApgABAAEAAQABAAEApgABAKYAAQABAAEAAQABAAEA` +
-	`AQCmAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKcAAQABAAEAAQCoAKgAqACoAKgAqAABAKgAAQCoAKgAqQAB` +
-	`AKgAqACpAAEAAQABAAEAAQABAAEAqAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKoAAQABAAEA` +
-	`AQABAAEAqwABAAEAAQABAAEAAQCrAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAKwAAQABAAEAAQABAK0AAQABAAEAAQABAAEAAQCtAAEArQABAAEAAQABAAEArQABAK0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAK4AAQABAAEAAQABAK8AAQABAAEAAQABAAEAAQCvAAEArwABAAEAAQABAAEArwABAK8AAQCvAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABALAAAQABAAEAAQABALEAAQABAAEAAQCxAAEAAQABAAEAsgABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABALMAAQABAAEAAQC0AL` +
-	`QAAQC1AAEAAQC1AAEAAQC0AAEAtQABAAEAAQABAAEAtQABALQAAQC1AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABALYAAQABAAEAAQC3ALcAtwC3ALgAuAC4ALcAAQC4ALgAtwABALgAuAC3AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAuAC3AAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABALkAAQABAAEAAQABALoAAQABAAEAAQC6AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABALsAAQABAAEAAQABALwAAQABAAEAAQC9AAEAAQC8AAEAvQABALwAvAABAAEA` +
-	`vQABALwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAL4AAQABAAEAAQABAL8AvwAB` +
-	`AAEAAQDAAAEAAQC/AAEAwAABAAEAAQABAAEAAQABAL8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAL8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAMEAAQABAAEAAQABAAEAAQABAAEAAQDDAMMAAQDCAAEAwwABAAEAAQABAAEAwwABAMIAAQDDAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMIAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMQAAQABAAEAAQDFAMUAxQDFAMUAxQABAMUAxQDFAMYAxgABAMUAxQDGAAEAAQABAAEAAQDGAAEAxgABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAxQDGAAEAAQABAAEAAQABAMYAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMcAAQABAAEAAQABAAEAAQDIAAEAAQABAAEAAQABAAEAyAABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMkAAQABAAEAAQDKAMoAygABAAEAAQ` +
-	`DKAMoAygABAAEAygABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAMsAAQABAAEAAQABAAEAAQABAAEAAQDMAMwAAQABAAEAzQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAM4AAQABAAEAAQDPAM8AzwDPAM8AAQDPAM8AzwABAAEAzwABAAEAAQDPAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANAAAQABAAEAAQABANEA0QABAAEAAQDRAAEAAQDRAAEA0QABAAEAAQABAAEA0QABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANIAAQABAAEAAQDTAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`ANQAAQABAAEAAQABANUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANYAAQABAAEAAQABANcAAQABANcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANgAAQABAAEAAQABANkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANoAAQABAAEAAQABANsAAQABANsAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANwAAQ` +
-	`ABAAEAAQABAN0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAN4AAQABAAEAAQABAN8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOAAAQABAAEAAQABAAEAAQABAAEA4QABAOEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOIAAQABAAEAAQABAOMAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOQAAQABAAEA` +
-	`AQDlAAEAAQABAAEA5QABAOUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAOYAAQABAAEAAQABAAEAAQABAAEA5wABAOcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOgAAQABAAEAAQABAAEAAQABAAEAAQABAOkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOoAAQABAAEAAQDrAAEAAQABAOsA6wABAOsAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAOwAAQABAAEAAQABAO` +
-	`0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAO4AAQABAAEAAQABAAEAAQABAAEAAQABAO8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPAAAQABAAEAAQABAAEAAQABAPEA8QABAPEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEA8QABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPIAAQABAAEAAQABAAEAAQABAAEAAQABAPMAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPQAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAPUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAPYAAQABAAEAAQABAAEAAQABAAEAAQABAPcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPgAAQABAAEAAQABAAEAAQABAAEAAQABAPkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPoAAQABAAEAAQABAAEAAQABAAEAAQABAPsAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAPwAAQABAAEAAQABAAEAAQABAAEA/Q` +
-	`ABAP0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAP4AAQABAAEAAQABAP8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQAAAQABAAEAAQEBAAEAAQABAAEBAQABAQEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQIAAQABAAEAAQABAAEAAQABAAEBAwABAQMAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQQAAQABAAEAAQABAAEAAQABAAEAAQABAQUA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AQYAAQABAAEAAQEHAAEAAQABAQcBBwABAQcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQgAAQABAAEAAQABAQkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQoAAQABAAEAAQABAAEAAQABAAEAAQABAQsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQwAAQABAAEAAQABAAEAAQABAQ0BDQABAQ0AAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEBDQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAQ4AAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAQ8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABARAAAQABAAEAAQABAAEAAQABAAEAAQABAREAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARIAAQABAAEAAQABAAEAAQABAAEAAQABARMAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARQAAQABAAEAAQABAAEAAQABAAEAAQEVAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARYAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABARcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABARgAAQABAAEAAQABAAEAAQABAAEAAQABARkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARoAAQABAAEAAQABAAEAAQABAAEAAQABARsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABARwAAQABAAEAAQABAAEAAQABAAEAAQABAR0AAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAR4AAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQEfAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABASAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASMAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASQAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQElAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASYAAQABAScAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABASgAAQABASkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASoAAQABASsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABASwAAQABAS0AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAAAAAAAAAEAAAAAAAoAAAAAAA4AAAAAABI` +
-	`AAAAAABYAAAAAABoAAAAAAB4AAAAAACIAAAAAACYAAAAAACoAAAAAAC4AAAAAADIAAAAAADYAAAAAADoAAAAAAD4AAAAAAEIAAAAAAEYAAAAAAEoAAAAAAE4AAAAAAFIAAAAAAFYAAAAAAFoAAAAAA` +
-	`F4AAAAAAGIAAAAAAGYAAAAAAGoAAAAAAG4AAAAAAHIAAAAAAHYAAAAAAHoAAAAAAH4AAAAAAIIAAAAAAIYAAAAAAIoAAAAAAI4AAAAAAJIAAAAAAJYAAAAAAJoAAAAAAJ4AAAAAAKIAAAAAAKYAAAA` +
-	`AAKoAAAAAAK4AAAAAALIAAAAAALYAAAAAALoAAAAAAL4AAAAAAMIAAAAAAMYAAAAAAMoAAAAAAM4AAAAAANIAAAAAANYAAAAAANoAAAAAAN4AAAAAAOIAAAAAAOYAAAAAAOoAAAAAAO4AAAAAAPIAA` +
-	`AAAAPYAAAAAAPoAAAAAAP4AAAAAAQIAAAAAAQYAAAAAAQoAAAAAAQ4AAAAAARIAAAAAARYAAAAAARoAAAAAAR4AAAAAASIAAAAAASYAAAAAASoAAAAAAS4AAAAAATIAAAAAATYAAAAAAToAAAAAAT4` +
-	`AAAAAAUIAAAAAAUYAAAAAAUoAAAAAAU4AAAAAAVIAAAAAAVYAAAAAAVoAAAAAAV4AAAAAAWIAAAAAAWYAAAAAAWoAAAAAAW4AAAAAAXIAAAAAAXYAAAAAAXoAAAAAAX4AAAAAAYIAAAAAAYYAAAAAA` +
-	`YoAAAAAAAgAAAAAAAKAAAAAAAwAAAAAAAKAAAAIABAAAAAAAAKAAAAQABQAAAAAAAKAAAAYABgAAAAAAAKAAAAgABwAAAAAAAKAAAAoACAAAAAAAAKAAAAwACQAAAAAAAKAAAA4ACgAAAAAAAKAAAB` +
-	`AACwAAAAAAAKAAABIADAAAAAAAAKAAABQADQAAAAAAAKAAABYADgAAAAAAAKAAABgADwAAAAAAAKAAABoAEAAAAAAAAKAAABwAEQAAAAAAAKAAAB4AEgAAAAAAAKAAACAAEwAAAAAAAKAAACIAFAAA` +
-	`AAAAAKAAACQAFQAAAAAAAKAAACYAFgAAAAAAAKAAACgAFwAAAAAAAKAAACoAGAAAAAAAAKAAACwAGQAAAAAAAKAAAC4AGgAAAAAAAKAAADAAGwAAAAAAAKAAADIAHAAAAAAAAKAAADQAHQAAAAAAAK` +
-	`AAADYAHgAAAAAAAKAAADgAHwAAAAAAAKAAADoAIAAAAAAAAKAAADwAIQAAAAAAAKAAAD4AIgAAAAAAAKAAAEAAIwAAAAAAAKAAAEIAJAAAAAAAAKAAAEQAAqAAAEQAJQAAAAAAAKAAAEYAJgAAAAAA` +
-	`AKAAAEgAJwAAAAAAAKAAAEoAKAAAAAAAAKAAAEwAAqAAAEwAKQAAAAAAAKAAAE4AAqAAAE4AKgAAAAAAAqAAAFAAAKAAAFAAKwAAAAAAAqAAAFIALAAAAAAAAKAAAFQAAqAAAFQALQAAAAAAAKAAAF` +
-	`YAAqAAAFYALgAAAAAAAKAAAFgAAqAAAFgALwAAAAAAAKAAAFoAAqAAAFoAMAAAAAAAAqAAAFwAMQAAAAAAAqAAAF4AMgAAAAAAAqAAAGAAAKAAAGAAMwAAAAAAAqAAAGIANAAAAAAAAqAAAGQANQAA` +
-	`AAAAAKAAAGYANgAAAAAAAqAAAGgANwAAAAAAAqAAAGoAOAAAAAAAAqAAAGwAOQAAAAAAAqAAAG4AOgAAAAAAAqAAAHAAOwAAAAAAAKAAAHIAPAAAAAAAAKAAAHQAPQAAAAAAAKAAAHYAPgAAAAAAAK` +
-	`AAAHgAPwAAAAAAAKAAAHoAQAAAAAAAAKAAAHwAQQAAAAAAAKAAAH4AQgAAAAAAAKAAAIAAQwAAAAAAAKAAAIIARAAAAAAAAKAAAIQARQAAAAAAAKAAAIYARgAAAAAAAKAAAIgARwAAAAAAAKAAAIoA` +
-	`SAAAAAAAAKAAAIwASQAAAAAAAqAAAI4ASgAAAAAAAKAAAJAASwAAAAAAAKAAAJIATAAAAAAAAKAAAJQATQAAAAAAAKAAAJYATgAAAAAAAKAAAJgATwAAAAAAAKAAAJoAUAAAAAAAAKAAAJwAUQAAAA` +
-	`AAAKAAAJ4AUgAAAAAAAKAAAKAAUwAAAAAAAKAAAKIAVAAAAAAAAKAAAKQAVQAAAAAAAKAAAKYAVgAAAAAAAqAAAKgAVwAAAAAAAqAAAKoAWAAAAAAAAqAAAKwAWQAAAAAAAqAAAK4AWgAAAAAAAqAA` +
-	`ALAAWwAAAAAAAqAAALIAXAAAAAAAAqAAALQAXQAAAAAAAqAAALYAXgAAAAAAAKAAALgAXwAAAAAAAKAAALoAYAAAAAAAAKAAALwAYQAAAAAAAKAAAL4AYgAAAAAAAKAAAMA///59v///4T///oyAAA` +
-	`JdAAABBoAABAgAAAK3gAAEEwAAAr6AAAW+AAAEcIAACD0AAAbqgAAIPgAABvGAAAhJAAAG/IAACfYAAAisgAAMdQAACyqAAAx2AAALLYAADiYAAAzegAAP1gAADo+AABGAAAAQO4AAEzEAABHtgAAV` +
-	`sAAAFGyAABW2AAAUc4AAF3EAABY1gAAZIAAAF+WAABrVAAAZl4AAHVQAABwUgAAe/AAAHcCAACCnAAAfZoAAIKkAAB9vgAAjKAAAIe6AACTYAAAjoIAAJ1EAACYagAAo/AAAJ8uAACkHAAAn0oAAKr` +
-	`IAACmEgAAtMQAAK/6AAC0yAAAsBYAALT0AACwQgAAu7AAALcKAADFrAAAwQIAAMXQAADBLgAAzJAAAMfyAADTUAAAzrYAANn4AADVZgAA4LwAANwuAADquAAA5ioAAOrQAADmRgAA8bwAAO1OAAD4e` +
-	`AAA9A4AAP9MAAD61gABCUgAAQTKAAEP6AABC3oAARaUAAESEgABHSgAARjCAAEnJAABIr4AAS3kAAEphgABLZgAASmKAAEtMAABKZIAAS08AAEppgABLUAAASmuAAEtGAABKcIAAS0cAAEpygABKbQ` +
-	`AASnCAAEpqAABKeIAASmkAAEp7gABKcAAASn+AAEpzAABKgYAASnQAAEqKgABKfAAASpKAAEp8AABKjoAASnoAAEqTgABKfAAASpeAAEp6AABKmYAASngAAEqbgABKeAAASp2AAEp3AABKn4AASnQA` +
-	`AEqjgABKYAAASquAAEpfAABKroAASmYAAEqygABKaQAASrSAAEpqAABKvYAASnIAAErFgABKcgAASsGAAEpwAABKxoAASnIAAErKgABKcAAASsyAAEpuAABKzoAASm4AAErRgABJJwAAStKAAEkHAA` +
-	`BK1IAASQgAAErWgABJCQAAStiAAEkKAABK14AARzEAAEkpgABG3QAASSuAAEbeAABK3YAARq8AAErcgABGCAAASt6AAEYJAABK4IAARgoAAErigABGCwAAAAAAAAABAAIAAwAEAAUABgAHAAgAAAAJ` +
-	`AAAACgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACwAAAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANAAAADgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAA8AAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAACABEAAAABAAAAAAAAAAIAAAAAAAAAAwAEAB` +
-	`QAAAAAAAAAAAABAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAABAAAAAAABQAZAAAAAQACAAMABAAFAAYABwAAAAAACAAJAAoAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAsAAAAAAAwAAAANAAAAAAAAAAAAAAAAAAAAAAAAAAAADgAAAA8AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAB8A` +
-	`AAAwAAAAAQAAAAIAAwAEAAAAAAAAAAUABgAxAAAAAAAAAAAAAQACAAAAAAADAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABQAAAAAAAAAGADgAAAABAAIAAwAEAAUA` +
-	`BgAHAAAAAAAIAAAACQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACgAAAAAAAAAAAAsAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAADQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA4APwAAAE4AAAAAAAAAAAAAAAAAAAAAAAAAAQACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAQATw` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAABAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAABAAAAAAABQBUAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAFoAAAABAAAAAgAAAAAAAwAAAAAAAAAEAAUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABgAAAAAABwAAAAAACABd` +
-	`AAAAAQACAAMABAAFAAYABwAAAAgACQAAAAoAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA`
-
-const MonacoFontData7 = `AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAsAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADAAAAA0AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAADgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAPAGYAAAAAAAAAAAAAAAEAdgAAAAAAAAAAAAAAAQAAAAAAAAACAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAABQAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAGAAAABwB4AAAAAAAAAAAAAAABAAIAAAAAAAAAAwAAAAAAAAAEAAUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABgCAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAA` +
-	`AAACAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAABQAAAAAABgCHAAAAAQACAAMABAAFAAAABgAHAAgACQAAAAoAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAsADAAAAA0AAAAOAAAAAAAAAAAAAAAAAAAAAAAAAAAADwAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`EQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAASAI4AAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQChAAAAAQACAAAAAAAAAAMABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAUAowAAAAEAqQAAAAEAAgADAAQAAAAFAAYAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAHAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACACrAAAAAQAAAAAAAAACAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAEAAAAAAAAAAAAAAAFALQAAAABAAIAAwAEAAUABgAHAAgAAAAJAAAACgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACwAAAAwAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAANAAAADgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA8AugAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAABAAAAAAAAAAAAAAACAMoAAAABAAAAAAAAAAIAAAAAAAAAAwAEAM0AAAAAAAAAAAABAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAABAAAAAAA` +
-	`BQDSAAAAAQACAAMABAAFAAYABwAAAAAACAAJAAoAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAsAAAAAAAwAAAANAAAAAAAAAAAAAAAAAAAAAAAAAAAADgAAAA8AAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQANgAAADpAAAAAQAAAAIAAwAEAAAAAAAAAAUABgDqAAAAAAAAAAAAAQACAAAAAAADAAQAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAABQAAAAAAAAAGAAAABwDxAAAAAQACAAMABAAFAAAABgAAAAAABwAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAkAAAAAAAAAAA` +
-	`AKAAAAAAAAAAAAAAAAAAAAAAAAAAAACwAAAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAANAPkAAAAAAAAAAAAAAAAAAAAAAAEBBwAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAABAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAAAAAAAAAABAEJAAAAAAAAAAAAAAAAAAAAAAAAAAEAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAADAAAAAAAEAAAAAAAFAQ4AAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIBFAAAAAEAAAACAAAAAAAD` +
-	`AAAAAAAAAAQABQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGAAAAAAAHAAAAAAAIARcAAAABAAIAAwAEAAUABgAHAAAACAAJAAAACgAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAACwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAADQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAOAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA8BIAAAAA` +
-	`AAAAAAAAAAAQEwAAAAAAAAAAAAAAABAAAAAAAAAAIAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAFAAAAAAAAAAAAAAAAAAAAAAAAAAYAAAAHAT` +
-	`IAAAAAAAAAAAAAAAEAAgAAAAAAAAADAAAAAAAAAAQABQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAGAToAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAIAAAAAAAMABAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAFAAAAAAAGAAAAAAAHAUEAAAABAAIAAwAEAAUAAAAGAAcACAAJAAAACgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAACwAMAAAADQAAAA4AAAAAAAAAAAAAAAAAAAAAAAAAAAAPAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAARAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABIBSQAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAVwAAAABAAIAAAAAAAAAAwAEAAUAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABg` +
-	`FeAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIBZQAAAAEAAgADAAQAAAAFAAYABwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACQFoAAAAAQAAAAAAAAAC` +
-	`AAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAFAXIAAAF4AAABeQAAAAAAAAABAXoAAAF8AAAAAAAAAAEBfQAAAX8AAAGAAAAAAAAB` +
-	`AYEAAAGDAAAAAAAAAAAAAAABAAAAAgGEAAAAAAABAYcAAAGJAAAAAAAAAAAAAQACAAAAAwGKAAABjgAAAY8AAAABAAAAAgAAAAMBkAAAAZQAAAGVAAABlgAAAZcAAAGYAAAAAAABAZkAAAGbAAAAAA` +
-	`AAAAAAAAABAAAAAgGcAAAAAAABAZ8AAAGhAAAAAAAAAAAAAQACAAAAAwGiAAABpgAAAacAAAABAAAAAgAAAAMBqAAAAawAAAGtAAABrgAAAa8AAAGwAAABsQAAAbIAAAGzAAABtAAAAbUAAAG2AAAB` +
-	`twAAAbgAAAG5AAABugAAAbsB5ACtAMkAxwCuAQIBBAIjAGIAYwH5AQYCzQIzA5IDlAQKAjUCNwI5AP0BCAEKAP8AZAI9AQwCQwI/AkUCQQDLAGUAyALhAQ4BEAESAMoBFgIlARQC3wJLAk0DlgOYBB` +
-	`ACUQIYARgCUwD4ARoCDwFoAR8CVQJZAiECWwJXAl0AzwDMAM0BIwElAfUA+gDOAfsBJwLrAl8DmgOcBBQBcwJjAhEBbAJlAmcBKwEvAWoCaQJvAm0CcQJzAnUCGQEzAGYCdwE3AW4CeQJ9AnsA0wDQ` +
-	`ANEArwE6ATwCKwBnAT4B/QITAu0DngOgA1QEFgKHAokBQAKLAUQBbwKNApEDogOkAWQBRgFIApMA5AD7ApUBZgKdAUwBSgKfAqMCoQDWANQA1QFQAVIBVABoAVYBWAH/AVoC9wKlAqkCpwOmA6gDUg` +
-	`QcAq8CsQKzArUBXAK5ArcCuwK9Ar8C+QDrAV4C/QIvAsEAuwL7BCABYALDAWIA5gLFAscAagBpAGsAbQEDAQUCJABsAG4B+gEHAs4CNAOTA5UECwI2AjgCOgD+AQkBCwEAAG8CPgENAkQCQAJGAkIA` +
-	`cQBwAHIC4gEPAREBEwBzARcCJgEVAuACTAJOA5cDmQQRAlIBHgEZAlQA+QEbAhABaQEgAlYCWgIiAlwCWAJeAskAdQB0AHYBJAEmAfYAdwH8ASgC7AJgA5sDnQQVAfcCFwJkAhIBbQJmAmgBLAEwAW` +
-	`sCagJwAm4CcgJ0AnYCGgE0AHgCeAE4ATkCegJ+AnwAegB5AHsAfQE7AT0CLAB8AT8B/gIUAu4DnwOhA1UEFwKIAooBQQKMAUUBcAKOApIDowOlAWUBRwFJApQA5QD8ApYBZwKeAsoBTQFLAqACpAKi` +
-	`AH8AfgCAAVEBUwFVAIEBVwFZAgABWwL4AqYCqgKoA6cDqQNTBB0CsAKyArQCtgFdAroCuALLArwCvgLAA9kC+gDsAV8C/gIwAsIAugLMAvwEIQFhAsQBYwDnAsYCyALmA88CHQINAh8CHgIOAiADzQ` +
-	`MHAwkBpwO9A8ABvgO/A8MDxAO+A8UBjQPGAbcDEAMUAboDFgMYA8kDygMSAa0BrgMIAwoBqAMEAwsBvwMGAwwDDQMFAw4BygMPAbgDEQMVAbsDFwMZAxoDGwMTA6oDwQPCA8cDyAWyBX8FgAWxBl4G` +
-	`XwZcBl0AAAGEIAAAAgAAAAEAAAAIAAAAHAAAADwAAAC8AAABKAAAAVgAAAFwAAYABAAEABAAAgAAADgABABYAAcBewAFA1EABv////8AAAAAAAAAAAACAAAAAAADAAAAAAAAAAAAAgAAAAAAAwABAA` +
-	`EABAABAAEAAQAFAAEAAQABAAYAAQABAAcACAABAAEAAQAJAAEAAQAKAAEAAQABAAEACwABAAEAAQAMAAEAAQABAA0AAQABAA4ADwABAAEAAQAQAAEAAQARAAEAAQAAAAAAAAAAQAAAAAADgAAAAAAG` +
-	`gAAAAAACAAAAAAAAoAAAAAADAAAAAAACgAAAAAAEgAAAAAAEAAAAAAAAoAAAAwAFAAAAAAAAoAAABgAGAAAAAAAFgAAAAAAHgAAAAAAHAAAAAAAAoAAACT///K8///6Gv///yj///og///yzv///zT` +
-	`///LU///6Mv///sD///o4///y5v///swAAAAAAAAAAAAAAAQAAAAAAAgAAAAAAAwPbA9sD3QPdAAB2JCAAAAIAAAABAAAAVQAAABwAAAFwAAAwlgAANZQAADe8AAB1EAAGAAQAUQEAAAYARAAwAAQA` +
-	`NQAGAFAACABVAAkAYgAKAGMADABkAA4AZwAPAGgAEABrABIAbAAVAG4AFgBvABcAcgAYAHcAGQB7ABoAfAAbAH0AHACBAB4ArwAfAMcAIADIACEAzgAiANEAIwDkACQA5QAmAQIAJwEDACgBBAApAQ` +
-	`UAKgEHACsBDgAsAQ8ALQElAC4BJgAvAToAMAE7ADEBRgAyAUcAMwFQADQBUQA1AVIANgFTADcBVAA4AVUAOgF2AAUBdwANAXgASgF5ABMBegALAXsABwF8ACUBfQAdAYAAEQITADsCFAA8AiMAPQIk` +
-	`AD4CJQA/AiYAQAIrAEECLABCAmkAQwJqAEQCjQBFAo4ARgKVAEcClgBIAs0ASQLOAEsC3wBMAuAATQLtAE4C7gBPAyAAUQNRADkDUgBQA1MAUgNUAFMDVQBUA/QAFP////8AAAAAAAAAAAACAAAAAw` +
-	`AAAAQABQAGAAAABwAAAAgACQAKAAAACwAAAAAADAANAA4ADwAQABEAEgATAAAAFAAVABYAFwAYABkAGgAAABsAHAAdAB4AHwAgACEAIgAjACQAJQAmACcAKAApACoAKwAsAC0AAAAuAC8AMAAxADIA` +
-	`MwA0ADUANgA3ADgAOQA6ADsAPAA9AAAAPgA/AEAAQQBCAEMAAABEAEUARgAAAAAAAAAAAAIAAAADAAAABAAFAAYAAAAHAAAACAAJAAoAAAALAAAAAAAMAA0ADgAPABAAEQASABMAAAAUABUAFgAXAB` +
-	`gAGQAaAAAAGwAcAB0AHgAfACAAIQAiACMAJAAlACYAJwAoACkAKgArACwALQAAAC4ALwAwADEAMgAzADQANQA2ADcAOAA5ADoAOwA8AD0AAAA+AD8AQABBAEIAQwAAAEQARQBGAAEAAQBHAAEAAQBI` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAEkAAQABAAEAAQBKAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEASwABAA` +
-	`EATAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBNAAEAAQABAAEATgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAE8A` +
-	`AQABAAEAAQABAAEAAQABAFAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAUQABAAEAAQABAAEAAQABAAEAAQABAFIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`BTAAEAAQABAAEAAQABAAEAAQABAAEAVAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAFUAAQABAAEAAQABAAEAAQABAFYAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAVwABAAEAWAABAAEAAQABAAEAWAABAFgAAQABAAEAWAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBZAAEAAQBaAAEAAQABAAEAAQABAAEAWgABAAEAAQABAAEAWgBaAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAFsAAQABAAEAAQABAAEAAQABAFwAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAXQABAAEAAQABAAEAAQABAAEAAQABAF4AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQBfAAEAAQABAAEAAQABAAEAAQABAAEAYAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAGEAAQABAGIAAQABAAEAAQABAGMAAQBiAAEAAQABAGMAAQBiAGIAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAZAABAAEAAQABAAEAAQABAAEAAQABAGUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBmAAEAAQBnAAEAAQABAAEAAQABAAEAZwABAAEAAQABAAEAZwBnAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAGgAAQABAAEAAQABAAEAAQABAGkAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAagABAAEAAQABAAEAAQABAAEAbAABAGsAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAawABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQBtAAEAAQBuAAEAAQABAAEAAQBuAAEAbgABAAEAAQBuAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAG8AAQABAAEAAQABAAEAAQABAHAAAQBwAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAHAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAcQABAAEAcgABAAEAAQABAAEAAQABAHIAAQABAAEAAQABAHIAcgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQBzAAEAAQB0AAEAAQABAAEAAQB0AAEAdAABAAEAAQABAAEAdAB0` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAHUAAQABAAEAAQABAAEAAQABAAEAAQB2AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAdwABAAEAeAABAAEAAQABAAEAAQABAHgAAQABAAEAAQABAH` +
-	`gAeAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQB5AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAegABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAHsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQB8AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAfQABAAEAfgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQB/AAEAAQCAAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIEAAQABAIIAAQABAAEAAQABAAEAAQCCAAEAAQABAAEAAQCCAIIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAgwABAAEAhAABAAEAAQABAAEAAQABAIQAAQAB` +
-	`AAEAAQABAIQAhAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCFAAEAAQABAAEAAQABAAEAAQABAAEAhgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAIcAAQABAIgAAQABAAEAAQABAAEAAQCIAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAiQABAAEAigABAAEAAQABAAEAAQABAIoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCLAAEAAQCMAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAI0AAQABAI4AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAjwABAAEAkAABAAEAAQABAAEAAQ` +
-	`ABAJAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCRAAEAAQCSAAEAAQABAAEAAQABAAEAkgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJMAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCUAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAlQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAJYA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCXAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAmAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB`
-
-const MonacoFontData8 = `AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAJkAAQABAAEAAQABAAEAAQABAAEAAQCaAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAmwABAAEAnAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAnAABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQCdAAEAAQCeAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCfAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKAAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAoQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAogABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQCjAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCkAAEAAQABAAEAAQABAAEAAQClAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAKYAAQABAAEAAQABAAEAAQABAKcAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAqAABAAEAAQABAAEAAQABAAEAqQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQCqAAEAAQABAAEAAQABAAEAAQCrAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAKwAAQABAAEAAQCtAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEArgABAAEAAQABAK8AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQCwAAEAAQABAAEAAQABAAEAAQCxAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABALIAAQABAAEAAQABAAEAAQABALMAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAtAABAAEAAQABAAEAAQABAAEAtQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQC2AAEAAQABAAEAAQABAAEAAQC3AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABALgAAQABAAEAAQABAAEAAQABALkAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAugABAAEAAQABAAEAAQABAAEAuwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQC8AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAvQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAL4AAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQC/AAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAwAABAAEAAQABAMEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAwQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDCAAEAAQABAAEAwwABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDDAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMQAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAMUAAQABAAEAAQABAAEAAQABAAEAAQABAAEAxgABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAxwABAAEAAQABAAEAAQABAAEAAQABAAEAAQDIAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQDJAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMoAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQAB` +
-	`AAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAMsAAQABAAEAAQABAAEAAQABAAEAAQABAAEAzAABAAEAzQABAM0AAQABAAEAAQ` +
-	`ABAM0AAQABAAEAAQABAM0AzQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDNAAEAAQABAAEAAQDOAAEAAQDPAAEAzwABAAEAAQABAAEA0AABAAEAAQABAAEA0ADQAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAA` +
-	`EAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABANAAAQABAAEAAQABANEAAQABANIAAQABAAEAAQAB` +
-	`AAEAAQDSAAEAAQABAAEAAQDSANIAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQ` +
-	`ABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA0gABAAEAAQABAAEA0wABAAEA1AABAAEAAQABAAEAAQABANQAAQABAAEAAQABANQA1AABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEA` +
-	`AQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQABAAEAAQDUAAEAAQABAAAAAAAAAABAAAAAAAKAAAAAAA` +
-	`OAAAAAAASAAAAAAAWAAAAAAAaAAAAAAAeAAAAAAAiAAAAAAAmAAAAAAAqAAAAAAAuAAAAAAAyAAAAAAA2AAAAAAA6AAAAAAA+AAAAAABCAAAAAABGAAAAAABKAAAAAABOAAAAAABSAAAAAABWAAAAA` +
-	`ABaAAAAAABeAAAAAABiAAAAAABmAAAAAABqAAAAAABuAAAAAAByAAAAAAB2AAAAAAB6AAAAAAB+AAAAAACCAAAAAACGAAAAAACKAAAAAACOAAAAAACSAAAAAACWAAAAAACaAAAAAACeAAAAAACiAAA` +
-	`AAACmAAAAAACqAAAAAACuAAAAAACyAAAAAAC2AAAAAAC6AAAAAAC+AAAAAADCAAAAAADGAAAAAADKAAAAAADOAAAAAADSAAAAAADWAAAAAADaAAAAAADeAAAAAADiAAAAAADmAAAAAADqAAAAAADuA` +
-	`AAAAADyAAAAAAD2AAAAAAD6AAAAAAD+AAAAAAECAAAAAAEGAAAAAAEKAAAAAAEOAAAAAAESAAAAAAEWAAAAAAEaAAAAAAAIAAAAAAACgAAAAAAMAAAAAAACgAAACAAQAAAAAAACgAAAEAAUAAAAAAA` +
-	`CgAAAGAAYAAAAAAACgAAAIAAcAAAAAAACgAAAKAAgAAAAAAACgAAAMAAkAAAAAAACgAAAOAAoAAAAAAACgAAAQAAsAAAAAAACgAAASAAwAAAAAAACgAAAUAA0AAAAAAACgAAAWAA4AAAAAAACgAAAY` +
-	`AA8AAAAAAACgAAAaAAKgAAAaABAAAAAAAACgAAAcABEAAAAAAAKgAAAeABIAAAAAAACgAAAgABMAAAAAAAKgAAAiAACgAAAiABQAAAAAAACgAAAkABUAAAAAAACgAAAmABYAAAAAAACgAAAoABcAAA` +
-	`AAAACgAAAqABgAAAAAAACgAAAsABkAAAAAAACgAAAuABoAAAAAAACgAAAwABsAAAAAAAKgAAAyABwAAAAAAACgAAA0AB0AAAAAAACgAAA2AB4AAAAAAACgAAA4AB8AAAAAAACgAAA6ACAAAAAAAACg` +
-	`AAA8ACEAAAAAAACgAAA+ACIAAAAAAACgAABAACMAAAAAAACgAABCACQAAAAAAACgAABEACUAAAAAAACgAABGACYAAAAAAAKgAABIACcAAAAAAACgAABKACgAAAAAAAKgAABMACkAAAAAAACgAABOAC` +
-	`oAAAAAAAKgAABQACsAAAAAAACgAABSACwAAAAAAACgAABUAAKgAABUAC0AAAAAAACgAABWAC4AAAAAAACgAABYAC8AAAAAAACgAABaADAAAAAAAACgAABcADEAAAAAAACgAABeADIAAAAAAACgAABg` +
-	`ADMAAAAAAACgAABiADQAAAAAAACgAABkADUAAAAAAACgAABmADYAAAAAAACgAABoADcAAAAAAACgAABqADgAAAAAAACgAABsADkAAAAAAACgAABuADoAAAAAAAKgAABwADsAAAAAAACgAAByADwAAA` +
-	`AAAAKgAAB0AD0AAAAAAACgAAB2AD4AAAAAAACgAAB4AD8AAAAAAACgAAB6AEAAAAAAAACgAAB8AEEAAAAAAACgAAB+AEIAAAAAAAKgAACAAEMAAAAAAACgAACCAEQAAAAAAACgAACEAAKgAACEAEUA` +
-	`AAAAAACgAACGAEYAAAAAAAKgAACIP//+ir///9E///6Hv///zj///o6///+1P//+i7///7I///6Ov///pz///pO///+oP//+lb///6k///6Uv///qD///pq///+zP//+poAAAjAAAAEigAACMQAAAS` +
-	`eAAAIxAAABKYAAAjIAAAEsgAAErwAAA6uAAASsAAADroAABygAAAYqgAAHKQAABi+AAAcwAAAGOIAABzgAAAZDgAAHEgAABkyAAAl6AAAIzIAAC/kAAAtLgAAL9QAAC06AAA5yAAANyIAADmEAAA3K` +
-	`gAAOYgAADdKAAA5HAAAN1IAADkgAAA3WgAAQxwAAEFaAABNGAAAS1YAAE0YAABLYgAATQgAAEtuAABNEAAAS3oAAEzAAABLggAATMQAAEuKAABMgAAAS5YAAEyIAABLigAATGQAAEuSAABMaAAAS64` +
-	`AAExMAABLtgAATFAAAEvCAABMcAAAS+YAAEyQAABEngAATJQAAESmAABMmAAATAoAAEmoAABMEgAASawAAEwaAABJeAAATCIAAEl8AABMJgAASYAAAEwuAABJhAAATDoAAEl4AABMQgAASXwAAExKA` +
-	`ABIkAAATFIAAEiUAABMWgAASBAAAExiAABIFAAATGIAAEgAAABMagAASAQAAEyCAABHPAAATJYAAEdMAABMqgAARxAAAEyyAABHFAAATLoAAEboAABMwgAARuwAAEzSAABPXAAAVtIAAFlYAABg0gA` +
-	`AY1QAAGrSAABtUAAAAAAAAAAEAAAACAAAAAwAAAAQAAAAFAAAABgAAAAcAAAABAAAAAAACAAAAAAAAAAAAAAADAAgAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMADAAAAB` +
-	`AAAAARAAAAEgAAAAEAAAACAAMAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABQATAAAAGQAAAAEAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAaAAAAHgAAAAAAAAABAAAA` +
-	`AAACAB8AAAABAAAAAAACAAAAAAAAAAAAAAADACIAAAAAAAAAAQAAAAAAAgAmAAAAAQAAAAIAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAADACkAAAABAAAAAgADAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAQALQAAADIAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAMwAAADcAAAA4AAAAOQAAADoAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAMAOwAAAAEAAAACAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwA/AAAAQwAAAAEARAAAAAEARgAAAEgAAABJAAAAAQBKAAAAAQBMAAAATgAAAE8AAABQAAAAUQAAAAAAAAAA` +
-	`AAAAAAAAAAEAUgAAAAAAAAAAAAAAAAAAAAEAVAAAAFYAAABXAAAAWAAAAFkAAABaAAAAWwAAAFwAAABdAAAAXgAAAF8AAABgAAAAYQAAAGIAAABjAAAAZAAAAGUAAAAAAAAAAQBmAAAAAAAAAAEAaA` +
-	`AAAGoAAABrAAAAbAAAAG0AAAABAAAAAgAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAUAbgAAAAEAAAACAAAAAwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABQB0AAAAAQAAAAIAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAMAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAEAHoAAAABAAAAAgAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAwAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA` +
-	`AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAfwMeA9oDHwPcAgkCGwI7AicCBwIDAgECBQLSAtAC1AQNAgoCHAI8Au` +
-	`YC5ALoA7sDAgQTAmIC8gLwAvQEGQIoAoACKgKCAggCBAICAgYCfwIpAoEC0QLPAtMEDALlAuMC5wL/BBICYQLxAu8C8wQYApkCmgPRA9IC2QLXAtsEDgLaAtgC3AQPA9MCRwJJAkgCSgPUA9UCgwKF` +
-	`AoQChgKXApgCqwKsA9cCrQPYAq4D2wPdAhUCFgILAgwCTwJQAi0CLgJrAmwCjwKQApsCnALVAt0C1gLeAukC6gL1AvYDtQOzA7cD2wO5BB4DtgO0A7gD3QO6BB8DrQOrA68DsQQaA64DrAOwA7IEGw` +
-	`AAAKIAAAACAAAAAgAAAAcAAAAcAAAAOAAAAGIAAACAAAAAiAAAAJIABgAEAAMACAABAAQASQAEAEwABQBPAAb/////AAAAAAAAAAAAAgAAAAAAAAAAAAAAAAACAAAAAAABAAEAAwABAAEABAAEAAAA` +
-	`AAAAAABAAAAAAAKAAAAAAAIAAAAAAACgAAAAP///tL///7sAAAAAAAAAAQAAAMAAwQAAAM4AAAACAAAABAAAAAgAAAAcAAAAPAAAAHwAAACmAAAAsgAAAL4ABgAEAAQAEAACAAAASQAEAEwABgBPAA` +
-	`cB8gAF/////wAAAAAAAAAAAAIAAAAAAAAAAAAAAAAAAAACAAAAAAAAAAEAAQADAAEAAQABAAQABAABAAEABQABAAEABgABAAEAAAAAAAAAAEAAAAAAA4AAAAAAAgAAAAAAAKAAAAAAAwAAAAAAAoAA` +
-	`AAA///+0P//+Er///7wAAAAAAAAAAQAAAAAAwADBAAABRCAAAAIAAAABAAAACQAAABwAAABAAAAArAAAAQAAAAEgAAABMAAGAAQABQAQAAIABAZ8AAQGfQAGBn4ABwZ/AAgGjQAF/////wAAAAAAAA` +
-	`AAAAIAAAADAAQABQAAAAAAAAAAAAIAAAADAAQABQABAAEABgABAAEABwABAAEAAQABAAEACAABAAEACQABAAEAAQABAAEACgABAAEACwABAAEAAQABAAEADAABAAEADQABAAEAAQAAAAAAAAAAQAAA` +
-	`AAACgAAAAAADgAAAAAAEgAAAAAAFgAAAAAACAAAAAAAAoAAAAAADAAAAAAAAoAAAAgAEAAAAAAAAoAAABAAFAAAAAAAAoAAABj//+XO///mFP//5db//+YY///l3v//5hz//+Xm///mIAAAAAAAAAA` +
-	`EAAAACAAAAAwZ6BngGdgZ0AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIAkwAAA20GEAADAAcARUAJBwYdAQQFHQMCuAGjQBIAAAEKBAcdAwAaCQUGHQIBGQi6ASwBAQAYK04Q9DxN/TxO` +
-	`EPY8Tf08AD88EP08/TwQ/TwxMCkBESEHIREhA239JgLalv5SAa4GEJb7HAAAAAACAdf/0QL2BhAAAwAPAFFAEC8AIAMCHwAQAwIPAAADAgO4//+3AAEBAgIDbwq4AcNAEgQLAzxHAkwNwAcAPEcBTA` +
-	`cREC/E1vR25BgQ/fR25BgAP/3mPzwBODgxMAFxcXEBAzMLASImNTQ2MzIWFRQGAhEu+C9FO1VVOzpVVgG6BFb7qv4XVTs7VFM8PFQAAAACAREEHwO8Bk4AAwAHADRADAUBRAYDAgRRBwVRB7gB3bYG` +
-	`AFEDAVEDuAHdsgIJCC/M3O3kEOTe7eQQ5BgAPzz9PDEwASMDMwEjAzMB0YBA+QF2fzn0BB8CL/3RAi8AAAACAEcAAASFBhAAGwAfAPdAbQIBEhoHAwQPGgcGBQ4aBwkFDhkICgUOFgsNBQ4VDBAEDx` +
-	`UMEQESFQwUABMVDBcAExYLGAATGQgbABMaBxwBEhkIHQESFgseBA8WCx8EDxkIBAUaABITIQ4LFhYdEhUVDAgZGR0SGhoHARIdABO4AYO1FQ8EHQ4FuAGDQCUHDAsLCAgHAhoZGRYWFQoPDjwVX78M` +
-	`AQzOGgEAPAdfGhkghK4YKxlOEPQYTeQZ9DwYEP1d5Bn0PAAYPzwQPBA8PzwQPBA8EPQ8/TwQ9Dz9PIcFLit9EMSHLhgrfRDEARESOTkREjk5Dw8PDw8PDw8PDw8PDw8PDzEwEzUzEyE1IRMzAyETMw` +
-	`MzFSMDIRUhAyMTIQMjEzchEyFHxG7+zgFVdZJ1ARt6knioz20BPP6dcpR0/ululG+4ARhx/uIBo4wBm4sBu/5FAbv+RYv+ZYz+XQGj/l0Bo4wBmwADAKH/ZQRTBqsAJAArADIAh0AOLQosJRwbCwUX` +
-	`BiYWGga4Ab20WgV2AS28AQkACgEeAAEBCbQjDBPiGrgBHkALFBQRBAEMFkcfUjC4AWdACxMaLAMkQxIKJgMAuAFnsilSDrgBCbUFGTNZlBgrThD0TfT99hc8/Rc8/P12xBgAPz88EO3kP+T95BD0Gu` +
-	`0REjkREhc5EjkxMAU1Ii4BJzUeAhcRJyQ1NDY3NTMVMhcVJyYnERceARUUDgEHFQMRDgEVFBYBET4BNTQmAkRDkH5SQn+NVWv+0MrRjHahI5ddcJ51WaaEjGVtXQEBbU1Mm50OHSXAIjAcBgItOqPn` +
-	`e+kSpqYprQoqCf3gQFuwY1mneBeqBDsBzhdqR0N7/pD+NCdxRkRhAAAAAAUAAv/RBMwGPgALABcAGwAnADMA50A0ySEBaAgaGRkiEhgYGyghHPcuISIIGBkJEiEG9wwhAAIaGwMYFQ8aMSsbTB8rJS` +
-	`UxJSX2H7gBwEAPGUwJFSUD9g8lCRk0NDYYK04Q9E3t/e0ZEOQY/e3tEO0ZEOQREjkREjkAGD88P+397T88P+397YcFLit9EMQxMBhDeUBSATMTBRUeAREHDx4AFwEVHgENCw8eAC8hMR4BLSMrHgAz` +
-	`HTEeASknKx4AFAQSHgAQCBIeABYCDB4BDgoMHgEwIC4eACwkLh4AMh4oHgEqJigeAQArKysrKysrKwErKysrKysrK4EBXQEyFhUUBiMiJjU0NhciBhUUFjMyNjU0JgMjATMBMhYVFAYjIiY1NDYXIg` +
-	`YVFBYzMjY1NCYBOpGlpZGRpqaRUUpKUVBLS+SkBCWl/sSLrriDgLKoj09MXD5CWksGENisrdfXrazYfZtsbZubbWyb+j4GbfzK0KS91823rtZ8mHaDf4qCZp4AAAADAAL/0QTMBj4AIgAwADkAx0Ay` +
-	`TAgVFiAjLy8hADEyCCIiIS8vKBIICCIyNyMpHBwhKSUPAiIhCjclAwscYxsbO0YSbia4ATNADCxuC900bgYZOjQ2GCtOEPRN7fT99v12ETkvGO0AP+0/PD/tEjkvEjkSOYcOLiuHBX3Ehw7ExMSHEA` +
-	`7EPDw8MTAYQ3lANjU2Jy4JEQQFDSY2BDQeACgQJh4BKg4sHgAuCSweAjUFNx4AJxEpHgErDCkeAS0KLx4ALi8JCAAQPBA8KysrKwErKysrK4GBgYElDgEjIiQ1ECUuATU0PgEzMhYVFAYHAT4CPQEz` +
-	`FRQGBxMjAT4BNTQmIyIGFRQWFxYJAQYVFBYzMjYDcWPBcNb++wESUlNirGiKunGyAWIPCQa6HEbu7v3HZXZTWExgGE4HAXT+a76ufEKGgmhJ8rwBB+Rns01dsGCUimXKh/5MMkCqFDEvQveL/twD4E` +
-	`epREFdcFEtXWkK/QoB8qS+gqAuAAAAAAEBvAPhAxEGTgADABhACwH2AgIAAQNeAgUEL9TW/Tk5AD/tMTABIwMhAquGaQFVA+ECbQABALz+jAQwBm0AEQBAQCoGCgYLBg8GEBUKFRBFC0UPWQJZBgoA` +
-	`ERMJJQgQESUAEgh6DVMEGRI4fBgrThD0Te3tAD/tP+0BEjk5MTABXQEkAAI1NBIAJRUGBAIVFBIEFwQw/uv+hOPjAXwBFdH+06ysAS3R/owRARcB2u7uAdoBFxKcE/P+iNfX/ojyFAAAAAABAJ3+jA` +
-	`QRBm0AEQBEQC4JCgkLCQ8JEBoKGhBKC0oPVgJWBlgLWA8MCAkNESUAEAklCBINUwR6ABkSbGEYK04Q9E397QA/7T/tARI5OTEwAV0TBAASFRQCAAU1NiQSNTQCJCedARUBfOPj/oT+69EBLa2t/tPR` +
-	`Bm0S/un+Ju7u/ib+6RGbFPIBeNfXAXjzEwABAKQCkwQoBj4AEQCnQEYQAQAKBwQEBQgNDBAKEQkBBwAIDw4GBigSBQUPCwwCAigSAwMLDw4DAgQEEQwLBgUECA0AEWUNBGUJCAALDw4RBgIAAw4MuA` +
-	`E1tQkJESgIALgBNbYFAxkSbHwYK04Q9DxN9Dz9PBD0PBESOTkREjk5AD889Dz8PBESFzkREhc5hw4uK4cOfcSHDi4YK4cOfcQBERI5ORESOTkSORESOQASOTkROTkBEwUnLQE3BQMzAyUXDQEHJRMC` +
-	`BxX+5V0BM/7NXQEbFbwaASFe/swBNF7+3xoCkwFbvqKWmJ+9AVz+pL2fmJaivv6lAAEARwAABIUEPgALACZAFAplAAcdAQZlBAoAZQoDHQkEZQYMENb0PP085AA/9Dz9POQxMAEVIREjESE1IREzEQ` +
-	`SF/i+c/i8B0ZwCbZz+LwHRnAHR/i8AAAABAaj+TgMkAScAEQAnQBUBIQBfC0kFCwFMCAVjDnIIGRIySxgrThD0Tf3tEOQAP+307TEwATU+AjUiJjU0NjMyFhUUDgEBqEhLLV1YYkFUemCl/k58E0dt` +
-	`TWk8RV+Rfm7DigAAAQDjAicD6wLCAAMAGkAMAR0AAhoFABkEvawYK04Q5BDmAC9N/TEwEzUhFeMDCAInm5sAAAEBw//RAwkBFwALABlADAZwAAsJXgMZDDJLGCtOEPRN7QA/7TEwBSImNTQ2MzIWFR` +
-	`QGAmZDYGFCQmFgL2BDRF9fRENgAAAAAAEAIf/RBK0GPgADAD9AGgIBAR0SAAADAAIB0wMDAgAAAQIEAxoFAhkEuAFPsZgYKxlOEOQQ5hESOTkAGD88TRD9ETmHBS4rfRDEMTAXIwEz2LcD27EvBm0A` +
-	`AAAAAwBH/9EEhQY+AAsAFQAeARRAqBkNGQ4WFxYYJAIsBywIMAEwAj0HPQhEAUACSgdOCFQBUQJbB18IYwFhAmoHbghpDGgWcgFyAnkHfQiTApwEnAiTCqYOsxzDHNQI2grVDN0S1RzvCOUK9Aj0HC` +
-	`0ZDRkOFhcWGCUBJQJqDGcNZw68DrMRsxi8G8USDkMIDQwMQxIXDBQXFhcWDQwEGhBCAAUaQgYNFxYNDAQTHVIDGiATUn8JAQkZH7TXGCtOEPRdTe1OEPZN7REXOQA/7T/tERc5hwguKw59EMQxMBhD` +
-	`eUAwGBwBEhsFHWABGQcXQAAYFw8BDUABDg0RCxNgABwEGmAAGAgaQAAOAhBAARIKEGABACsrKysBKxA8KxA8KyuBgQFdAF0BIAAREAAhIAAREAADAS4BIyICERQWCQEeATMyEhE0AmYBCQEW/ur+9/` +
-	`74/ukBFycCGTN3QKeuCAJ6/esrcEW+oAY+/kP+h/6G/kMBvQF6AXkBvft+A0ldQf6N/uNBlgIQ/LJJSQGpAQOgAAEAvAAABGcGEAANAIJAPwoBCgIbARsCGwMbBDgBOAJGBVYFeQGFAZcBlwKmAaYC` +
-	`9gURBgEEBQYGAwEEBEMSBQUHBQQBAwcECg1DCwwMCrgBfbMICWINuAF9swEHAgC4AX21AAQBBBkOugEgAQMAGCtOEPRdTfYXPOT9POQAPzz9PD8XOYcuK30QxDEwAHFdJREOAQc1NjczESEVITUCOF` +
-	`SeituhugF1/FWbBK5DXjGoXZT6i5ubAAABAJUAAARABj4AIwC7QFMpCSkcqRgDJgUmBiYHJghGE0khVxNZIWocah1qHmofbCF1BXcGdwd5G3wcfB16HnofeSGYGJkcpx7GG+kJGxkIIBwSCQUFIhMW` +
-	`DiAJBQMiHBoLDrgBvLMWBSMiuAG7QAsAAQwLUhqBABolIrgBIbcTgQEZJFmUGCtOEPRN5O1OEPZN9O0APzz9PD/tARESORIXOQAREjkSFzkxMEN5QBAXGQwNGCUNFwtAAQwZDkABKwErK4GBAF0BXS` +
-	`kBNTQ+AT8BPgI1NCYjIg4BBzU+ATMyHgEVFAYPAg4BByEEQPxVNphVaiDYRaBkOY1qd379UnnLY3+wcmBbcAYC6qtCl7xXbCHwgD5kbRojNqU8LU+mXmncrXFeXKx3AAEAkf/RBEsGPgAmANFAc0oR` +
-	`Vx9WImoRyQTNDckj1wTaDdQj7g36DQwLCB0IAicONAU6BzQOSwdFFVoUawBkFXsAewd1FXUZigCEFYQZmgCaB5UVqwCkFaQZuAjFAMUByRXbBdsV6gXqBx4GAQYVCR0WFRkdBQEAJB0JFRQXDB0PCw` +
-	`y4Aby0CQkkRxK4AbyyFwUDuAG8QAwkDQ9SGnYGUiEaKAu4AV+3FYEAGSdZlBgrThD0TfTkThD2Te307QA/7T/tdhI5LxjtARESOQAREjk5EjkROTkxMABxXQFxXTc1FjMyNjU0JiEjNTMyNjU0JiMi` +
-	`BzU2MyAWFRQGBx4CFRQAISImkeWomsnd/vdnj8OujpOlvr6eARvXcpmHikf+vf8ASq8cxHS7k5S6m69rYYVdsUffj2q6SSh0nmLa/uQWAAAAAAIAMAAABJ0GEAAKAA0AZkAf3wwBmQzeDALUDQGZBJ` +
-	`QNAgwNDWISAwMEAwcCDUMIArgBgkAXAAwEBA0AAgcHDwoMBAIAYgUKDwMCDw4vxNY8ENQ8/Rc8EBE5LxESOQA/P/Q8/Rc8BwUQKwR9ENQxMAFycQBycSERITUBMxEhFSERAxEBAsP9bQKTuwEf/uG7` +
-	`/jMBspwDwvw+nP5OAk4CqP1YAAAAAQCd/9EETwYQABoAikA0OQs7FXACcAOKBwUaGCUVLBhGEFYQaw96D3oYig+bD6YIqw+mFrYHxhX6DxALDwgZAhATGrgBvbQFBQ0DBLgBu7MCAQQTuAG8QBcNDQ` +
-	`IDA0cXUgkaHAQFYgEAgQ8ZG1mUGCtOEPRN9Dz9PE4Q9k3tdjkvGDwAP+0/PP08Ejkv7RE5MTAAcV0BXRMRIRUhETMgABUUBgQjIic1HgEzMj4BNTQkIbwDZf1VIAFPAWqS/vaWlupV0llZql7+//64` +
-	`AycC6bP+hP7o7Zbuh0nLLjtWpVaRyQAAAgBm/9EEhQY+ABgAJQDFQEo7FzYbOx5LDk4XSx5LImQAZAF1AHUBlQCVAaYIxQbFCNAM4wwSGw8rCysbKx85G1QWXB9pD2YlhQTMC9sC2wTdD+QlDwAgGR` +
-	`MVA7gBvEAJbyCvIAIgIAoVuAG8shAFGbgBvEAjCg0THaIQByAHMAcDBxonACMQIyAjMCNCI1IjZCOAIwgjRxi4ASFAChANIA0wDQMNGSa4ARex1xgrThD0XU39dsxdGE4Q9l1N/TkAP+0/7RI5L139` +
-	`ETkREjkxMAFdAF0BPgEzMh4BFRQAIyAAERAAITIXFSYjIgIRATI+ATU0JiMiBhUUFgEkSNWAedly/uXn/vD+8wFWASSdlKaT0OQBbUuYV5OIjbWxAthrh3bTjN3+uQHHAV8BhAHDLrxP/oL+2/1sWb` +
-	`ltjrbTl5jBAAAAAQCkAAAEfQYQABgApUBFCAM4AzgVSwlqCXwGfAd8CHwJfBCJBpkGmQeZCJgQxgPaBtoH6gbqBxQGCQkFEhMQDgoADhoMExAQYhIJCQUTEAUDAAkKuAG7QCUMCwQADBMQCQUEFwAM` +
-	`EAwCDBoaF1J/AQEB4gAKEAoCChkZWdcYK04Q9F1N9F3tThDmXREXOQA/PzxN/TwRFzmHDi4rfRDEARESOQAREjkHDjyHEA7EMTABXSE1NDYSPwI2NyE1IRUUBgcGDwEOAh0BAT9KujiiOkIw/NsD2T` +
-	`uVJCNoZGAxi6PeARBK1ktZdbt8Sn7OMTGSjai2lIsAAAADAIX/0QRWBj4AGgAnADMBCECqOAA4ATgCSQBaAFcrWi9kBqkizRfIG8gc3RflLPkA+QH5AvsGEgcJBQoJGCcRJRomGyYnOQNEG0YcRh1G` +
-	`JUYmRCdGK0YvVBtUHFQmVCdVK1UvZRtlHGofaiNlJmUnayhtKWQrZC9sMW0yazN0CnEbcRxxJnEneih8KXwxfDJ8M4QKijGbD6cNuQC5AbkCuRrZENgy2DPlHOUd5THlMvYr9i8+GwAoDwQtISG4Ab` +
-	`yyBwUtuAG8QCAWDQAoAioeGw8CMCQeogt2RzBSExo1JKIEdkcqUhkZNLgBG7GUGCtOEPRN7Xb0GO1OEPZN7Xb0GO0REhc5ERIXOQA/7T/tERIXOTEwAF0BXQEnLgE1NDYzMh4BFRQOAQcXHgEVFAYj` +
-	`IiY1NAE+ATU0JiMiBhUUFhcHBBUUFjMyNjU0JicByyJHiPS8c7hkLluHVJNm9PT19AIFjUeEaHB1YmwE/viud22s0SkDRRkyw3Wb21OiYEJ7eW5DeK5sqPfnq/8BQmuQPkt7hkhGfE3ky7FpkIpnZd` +
-	`AgAAACAEf/0QRmBj4AGAAlAMlAVyYeNRdDHlIeagBqAXoAegGcAJwBqgipE7sGtRfcIuok+QD5AfskEwUiARIPGyUlCCULJRs2CDQbNR9mC3UaxgjFCcMLxRvSAtoJ0A/tCeolExMDFQAZA7gBvEAJ` +
-	`YCCgIAIgIBAZuAG8sgoFFbgBvLIQDRi4ASFAIysjOyNNI10jayN7I4sjlCOkIwkjBw0aJxMTHaKPBwEHGSa0uQEDABgrThD0XU3tOS9OEPYROV1N7QA/7T/tEjkvXe0SORESOTEwAV0AcV0BDgEjIi` +
-	`4BNTQAMyAAERAAISInNRYzMhIRASIOARUUFjMyNjU0JgOoSNWAedlyARvnARABDf6q/tydlKaT0OT+k0uYV5OIjbWxAzhrh3XUjNwBR/45/qH+fP49L7tOAX4BJQKTWbhujbbTlpjBAAAAAgHD/9ED` +
-	`CQR8AAsAFwAoQBUMcBIHBnAACxVeDwlwAwMPGRgySxgrThD0PE0Q7RDtAD/tP+0xMAUiJjU0NjMyFhUUBgMiJjU0NjMyFhUUBgJmQ2BhQkJhYENDYGFCQmFgL2BDRF9fRENgA2VgQ0RfX0RDYAACAa` +
-	`j+TgMkBHwAEQAdADhAHwEhAF8LSQUScBgHBQsbcBUBTAgFYw5kCAgVGR4ySxgrThD0PE0Q/e0Q5BDtAD8/7RDt9O0xMAE1PgI1IiY1NDYzMhYVFA4BEyImNTQ2MzIWFRQGAahISy1dWGJBVHpgpTdD` +
-	`YGFCQmFg/k58E0dtTWk8RV+Rfm7DigTZYENEX19EQ2AAAQBHAAAEhQQ+AAUATkAXAgMDHRIAAwQAAQQDAx0SAAMCAAUByAC4AdW3BQoDAAECBAW4AeWyAAcGL8TW9Dw8PBE5AD8Z9OUYhwguKwR9EM` +
-	`SHCC4YKwR9EMQxMBMBFQkBFUcEPv0aAuYCHwIfrf6P/ourAAACAEcBHwSFAxcAAwAHACq5AAMBv7ICbwe4Ab9ADAYFABoJBwIZCCAsGCtOEPQ8EPY8AC9N/fbtMTABFSE1ARUhNQSF+8IEPvvCAxeb` +
-	`m/6jm5sAAAABAEcAAASFBD4ABQBOQBcEAwMdEgADAgAFAgMDHRIAAwQAAQXIALgB1bcBCgMABQQCALgB5bIBBwYvxNbkPDw8ETkAPxn05RiHCC4rBH0QxIcILhgrBH0QxDEwCQE1CQE1BIX7wgLn/R` +
-	`kCH/3hrQFxAXWrAAIAz//RBB0GPgAgACwAi0AmOQg5CasVA2UEZQV5DHkNBBFjEgkIBgUZGhscCCASDiUUAgAgbye4AcNAHCELBQYICQQLABwbGhkEIAtTF/kSEhFtJCBuACq4AcJACh8AAQ8AAQA8` +
-	`RyS4ATWzLYphGCsQ9HbkcXEY7RDtEPY8EP3tEhc5ERIXOQA//fY8P+05ERc5EO0xMABdAV0BNTQ+AT8BNj8BNjU0JiMiBgc1NjMyFhUUDwMOAR0BAyImNTQ2MzIWFRQGAZEcT08+EiJCVHiHV7V5uc` +
-	`rg65hCTkcnPFQ6VVU6O1VWAbojU293VEITK1NoTEZsL0S0WreSkJtEUUkscmYu/hdVOztUUzw8VAAAAgAC/9EEzAY+ADUAQQETQDGOCAEEABg0NiIVAzg/FTYiASA/JRgYEiUk9gQ44B9fLCELADQh` +
-	`BAs3Nh0fkCC/IAIguAHBQBdDAW8oIQ4aQzwlnxsBG9wwIQgZQjQ2GCtOEPRN/fZd7U4Q9k395nb2XRg8/TwAP+0/7fTtEP39PBDtARESORI5ABESFzkREjkSOTEwQ3lAajlBBTMyJgYlECYmJSImKi` +
-	`YuJTolMwUwTwAlESgeASMTIB4AFBUXQBUeAUE2FhU+GTweACsMKE8BLQowTwA5HTweADEHNE8AJw8kHgAhFCQeAhZBGB4CPRo/HgApDSxPAS8JLE8BOxw4HgErKysrKysrKwErKysrEDwQPCsQPCsr` +
-	`KysrKysrKysrgYElFQ4BIyIkAhEQACEgABEUDgEjIiYnDgEjIiY1NBI7AREUHgEzMj4BNTQCJiMiBgIVFBIWMzIDESMiDgEVFBYzMjYDmVSCS9D+950BTgEcARkBR1SKYkxgGBx0Y2eHwfy/DSkYJ0` +
-	`8pevCDi9OHhuSMn0skdmg8MCo7e8idNyPLAWsBBgFsAcX+cP66kLltQ3lBe7uIpwEe/kpXSSZLiVCnATuGp/6yysv+pqAC4wENSp5fTVSJAAIAIQAABK0GEAAHAAwBNkCYBgAJBQIvC48KjwuPDJ8K` +
-	`nwufDL8Kvwu/DAooAykELwomDGcAZwFoAmcDagSIBoUHhAiJCYoKlQCaBZUImgmZCpYMqwKlA8gGxgfWANkF5QDqBfUA9wH5BPgFIAcIAwMABgkLCwAFBQIHCAwLAAYJCgsLBQcIAgAGCQWmCwIDIA` +
-	`MLBQUkEgQEAwILAAApEgEBAgsIAwICBge4AbBAPQkfCAEI7gEFBAQBAQAIAAIBAwULBDsEawR7BKsEuwQGBPELCzsLawt7C6sLuwsGC/HPAe8BAgEZRw07lhgrGU4QdvRdGE39Xe1dGDk5Ejk5AD88` +
-	`EDwQPBD9XTz9PD88EjmHBS4rh33Ehy4YK4d9xCsROTkREjk5hxAOxAU8PIcOxAXExIcQCMQFPMSHEMTEMTABXQBdAXEzIwEzASMDITchAycH48IB3s0B4dVq/d4wAcW7LCcGEPnwAVWbAk2cnAAAAw` +
-	`CsAAAEOAYQABEAGwAlAPBAb4MFlAWWFKQFpBTGCsYUxhXWCtYU1hXnCuUU5RX7CPsJEAsICwkCgwO+FNke6x77IgVSCCIdCiUUGxMiFBMKBBccHUgAG0gBJUgPEwEfE78T3xPvEwQTEwABAgAIFzMG` +
-	`aiAzDZUnGxwkAAABAD0nJi/E/V39PBD95PX0AD8/EjkvXXHtEO0Q7QEREhc5ABESORI5ETkxMEN5QDoDIwgmFSUEJQ8mIiYUCRc+AxkDFz4BHhAgPgEjCyA+AxYHEz4ACQoUExgFGj4BHw4dPgAhDC` +
-	`Q+AQsKEDwrKysQPBA8KwErKysrKysrKyuBAV0AcV0zESEgHgEVFA4BBx4BFRQOASMDMz4CNTQmKwERMzI2NTQuASsBrAEvARG5XDZqZbmDb8jcr9RnXy+Bu42/oJlUsLw4BhBTomhSk3k2SthsZ8Jo` +
-	`A1UrX3VGbmz7J5dqToNNAAABAHb/0QRABj8AGQCNQDz1BQEDBQwHAnIAcQxxDXIZtQS1CM0J7gPuCQkuCA3jDAwPOQoDGeMAABc5AgkNDAwZABobEzMGGRo9kxgrThD0Te1OEPY8PBA8AD9N7TkQ7T` +
-	`/tORDtMTBDeUAgEBYDCRUmBCURJQgmFgMTKwAQCRMrABQFFysAEgcPKwErKwErKysrKyuBgQFdAHFdJQYjIiQCNTQSJDMyFxUmIyIGAhUUEhYzMjcEQK6Ktv7OqqgBMriKrqGfeMd6fsR3nqISQcMB` +
-	`gfPxAYDGQbhdpP7Dur3+wJ9dAAACAJsAAAR8BhAACgAVAHNAL8MP3gXRB+8F4wfjDu8SBwMEAYsOixICKwgM4wAVSAECAAgQMwaVFxULJAEAPRcWL8T1PP08EPXtAD8/7RDtMTBDeUAeAxMIJg4lBC` +
-	`USJg0JECsBEwMQKwEPBwwrABEFFCsBKysBKysrKysrgQFdAHFdMxEhMhYSFRQCBiMnMzI2EjU0LgErAZsBcP73fIPw/qaHza9LWKS7lwYQsf6a9ej+ob2rkgED5N/wgQAAAAABAMsAAAQRBhAACwBk` +
-	`tlANkA0CCAe4AbC2CgoJCQIFBrgBsLQEAwIAC7gBsEAaAQIIkAmgCbAJwAkECWoFPwAaDQYLJAMCGQy4ASOxkhgrThD0PE39PE4Q9k305F0APzz9PD88/TwSOS88EP08MTABXSUVIREhFSERIRUhEQ` +
-	`QR/LoDNv2UAi790qurBhCc/fGb/eEAAQDjAAAEOAYQAAkAR0AoBgUnCAgHBwADBCcCAQIACJAHoAewB8AHBAdqAhoLBAkkAQAZCryTGCtOEPQ8Tf08ThD2TeRdAD8/PP08EjkvPBD9PDEwMxEhFSER` +
-	`IRUhEeMDVf11Ak39swYQnP3xm/02AAEAdv/RBEAGPwAcAKBASbYEtgjPCdwJ6wPuCQYGFQHcEgErCBxITxoBPxoBLxoBHxoBGhoADeMMDA85CgMYPxY5AgkNDD8AG50ZGCQcABoeEzMGGR09kxgrTh` +
-	`D0Te1OEPY8Tf085BD0PAA//eQ/7TkQ7RI5L11dXV3tMTBDeUAeEBUDCQQlESUIJhUDEysAEAkTKwAUBRYrABIHDysBKysBKysrKyuBgQBdAXFdJQYjIiQCNTQSJDMyFxUmIyIGAhUUEjMyNxEjNSEE` +
-	`QKSJwv7RrKgBMreKrqGfeMd55vggMZsBZQEwwAGD9PEBgMZBuF2k/sO8/P5iBQH0mwAAAAABAH4AAARPBhAACwA/QB8EAycJCkcKBQICCwgIBQgkBwcGGg0CCyQBABkMPX0YK04Q9DxN/TxOEPY8TR` +
-	`D9PAA/PD88OXYvGDz9PDEwMxEzESERMxEjESERfsoCPsnJ/cIGEP1kApz58ALZ/ScAAAAAAQCsAAAEIAYQAAsASEAnBAUCAAoLDQYHBCcGBQIKAScLAAgG6ggJJAMC6gAAvwACABkMW5IYK04Q9F1N` +
-	`9Dz9POQAPzz9PD88/TwBERI5ORESOTkxMDM1IREhNSEVIREhFawBVf6rA3T+qwFVmwTZnJz7J5sAAAAAAQBu/9EDvAYQABEAXUAe6xH6EQIAGQgAEAEDCAknCwoCAzkQCQgHJAwLRxMKuAEwsgATEi` +
-	`/E1uQQdtYYPP08AD/tPzz9PBE5EjkxMEN5QBAEDw4mBSUEDwc+AQYNAz4AKwErKyuBAF0BXTc1FjMyPgE1ESE1IREUDgEjIm7ak01/S/6jAidxzJ+yIr5zPI3nA1ec/Aj42nUAAAAAAQCdAAAEzAYQ` +
-	`AAoAqUAh6gb5BgI9BD0FhweHCJYIqQS5B9sE6AT2CAoKBwEEBQYGuAG0QEYSAwYHAwQIJBIHBwYGJBIJCQgJCQMEBQQEAgIKCAgHCAgGBAMJBYMABxAHMAdgB3AHBQcaDAMJJAEAABAAMAADABkLWq` +
-	`8YK04Q9F08Tf08GU4Q9l0YTeQSFzkAPzwQPD88EDwZERI5L4cFLhgrhxAFK4cILhgrhwV9xDEwAXFdAF0zETMRATMJASMBEZ3KAkHW/dMCe/39mAYQ/QcC+f0f/NEDF/zpAAEA2wAABCEGEAAFAC6z` +
-	`AwIABbgBsEAQAQIIABoHBAUkAwIZBrySGCtOEPQ8Tf08ThDmAD88Tf08PzEwJRUhETMRBCH8usqrqwYQ+psAAAAAAQA4AAAElQYQABIBGEBSBQMKBRYDGQQaBSUDKQQqBTgEOAV5DokOmwSRBZgMnQ` +
-	`2bDqsEpQWsDasOuwS0BbwNvA7EA8sNyw4chA2LDgIZDgEPBB8ELQR+BI4EiA4GABAQC7j/8EAOAxAODikSRQQEAwULDQ24AbRAEhJEBAQFBA0OCwoQEewFAwIIDrgBMEAaAAgFDQgDDgAEjwefBwIH` +
-	`KUYIC9gKP4AIAQi4AcRACoAEkASgBLAEBAS4AcRAEABHENgRP48AnwCvAL8ABAC4Aee2RwEZE2t/GCtOEPRNdv1dGPTmdhkQ9F30XRj05hB27V0YERI5ORI5OQA/5Dw/PP08PDwROTmHBS52KxiHfc` +
-	`SHLhh2KxiHfcQBODgxMABxXQFycV0zIxEzCQEzESMRNyMHAyMDJyMX26PLAWYBZsa6BQUs8MrzJwUFBhD8AgP++fAD3YiI/VkCp4iIAAAAAAEAfgAABE8GEAAOAIdAKYwMlwSaDKMEBJUEqQOlBKsN` +
-	`ug0FDCkSAwMEBCkSCwsMBAwCCAAIAwIGuAF8QCQLCwAIBAwCCAALB4AIAQgpRwoJGhADDo8AAQApRwIBGQ89fRgrThD0PE12/V0YPMROEPY8TXb9XTzEERIXOQA/PBDtPzwREhc5BwUuK4cEECsxMA` +
-	`FdAF0hIxEzARczJxEzESMBJxcBOLq2AiU8BQW6uv3qSwQGEPv3mpoECfnwA/ijoQAAAAACAEf/0QSFBj4ACwAXAKlAWaUNpRGqE6oX6wHrBeQH5Av6AfoF9Qf1C/cT9xcOhA6LEIsUhBaSDp0QnRSS` +
-	`FsMOyxDLFMMW0gLcBNIO3BDcFNIWEjcIEjkGAww5AAkPMwkaGRUzAxkYa5UYK04Q9E3tThD2Te0AP+0/7TEwQ3lAKgEXDQsPKwEXARUrABEHDysBEwUVKwAOCgwrABYCDCsAEAgSKwEUBBIrASsrKy` +
-	`sBKysrK4EAXQFdBSAAERAAISAAERAAJTISERACIyICERASAmb++P7pARcBCAEJARb+6v73ksPDkpLDwy8BvQF6AXkBvf5D/of+hv5DnAFLAVABUAFL/rX+sP6w/rUAAgCwAAAEbgYQAAwAFgBpQCi6` +
-	`C9wU7BQDAgUBKAgWSAMMSA4OAgMCAggSMyAIwAgCCJUYFgEkAj0XEPT9PBD2Xe0APz8SOS/tEO0xMEN5QBwFFAomECUGJQ8LEisBFAUSPgERCQ4rABMHFT4BKysBKysrKyuBAXFdAREjESEyHgEVFA` +
-	`IEIyczMj4BNTQmISMBesoBgu7adJz+6e9SV7u2Yq/+/nkCNv3KBhBrzn+V/v6Lm2G2aoacAAIAR/5OBMsGPgAYACQBV0CpBAADAgQYA40bgx2DIY0jlg2WGJ4bkh2SIZ4jqgCmDaMOpheqGKseqyDE` +
-	`Ds4bwh3CIc4j0w7bENsU3BvTHdMh3CPsAOUO5RDlFO8W7BfsGPQO+hv0HScFDgwbBR0GIQwjBQMEAwUDBgMHCggKCRAEEAUQBhAHZAFjAmQDlACUAZQJlAqUC6sapSSzCdcK7AzsDe8R4BP2APsR8x` +
-	`MdXggACyIcBQYmFQc5BLgBaUAfCx85ABk5EgMACQsJIjMgFQEVGiYcMyAPAQ8ZJWuVGCtOEPRdTe1OEPZdTe0APz8/7RDtEPbtARESOTkREjk5MTBDeUBDASQCJgklDSUXJgMIAD4AAQAKJBMiKwEa` +
-	`ERwrAB4MHCsAIBgiKwEBCgQ+ACMUGSsBGxAZKwEdDh8rAAwLIRYfKwAYAAAQPCsQPCsrKysBKysrKzwQPCsrKysrgQFdAHFdAXEFHgI7ARUjIi4BJy4BAjUQACEgABEUAgYBIgIREBIzMhIREAIC6k` +
-	`ZusGsSL8GwnEC31XwBDgERAQgBF0mz/t2Sw8OSksPDHXRaLJtQpo8UwAGS5wFSAcz+RP6FtP63+QWS/rX+sP6w/rUBSwFQAVABSwACAJUAAAS0BhAADwAZAPRAe4YMpgrECcQKxAzEE9UJ1QrVE+QJ` +
-	`5ArkE+UUDQkMAbkNtw7mD+oSBAQFCQsJDAkNDRcFMQgMGRkNEhkQERIMDg8PJBIMDA0MEAAZSAMPACcREBABAwIODQ0BCAwPAQ4VM18IAQidjw0BUA0BDRobGQEkAwACAQIZGlqWGCtOEPRdPE39PB` +
-	`lOEPZdXU30XRj9ORE5OQA/PBA8PxI5Lzz9PBDtERI5hwUuK30QxAESOTkAERI5hxAOxDEwGEN5QCAFFwomEyUGJRILFSsBFwUVPgEUCRErAAsMEhEWBxg+AQArEDwQPCsBKysrKyuBAXFdAHFdAREj` +
-	`ESEyHgEVFA4BBwEjASchPgI1NCYrAQFfygGfy8BpRnt0AcHw/mXKARtfXTCf0ZcCk/1tBhBTqGFato9A/SsCk5w0bHo9bYEAAAABAJ3/0QRPBj4AJgDPQINMDUwYWhhlD3oNdB9wIIcMpQjIJuomCw` +
-	`gPCBAHIgcjJhslIEsEWQVaDWYbZiC9ELIj2xAOCwUFFwkjAwsMDAodHh8cIAoDDRYcHx8kEgoKDB8cDAoEFgMU40ATahIWOREDAeNAAGoSAzkkCR8cDAoEBxkUgwczIRooGTMOPwAZJ1p9GCtOEPRN` +
-	`9O1OEPZN7eQREhc5AD/tS1JYsAAaWfwa7T/tS1JYsAAaWfwa7RESFzmHDi4rfRDEABE5ERI5Bw48PAcQDjwxMAFxXQBdNzUWMzI+ATU0Ji8CJDU0NiEyFxUmIyIGFRQWHwMWFRQAISImnfDEUpZNap` +
-	`JmZ/7w8wECppurooGfel9OYVL//vr+31ynIb5yP3ZFUY1VOzuh76/vMK1CiGBffjUvOzCc7LT+/h8AAAABADAAAASdBhAABwA8sQUCuAGwtQQDAgAIBLgBO7QGByQBALgBO0ANbwLvAv8CAwIZCDt/` +
-	`GCtOEPRdTfQ8/TzkAD8/PP08MTABIREhNSEVIRECAf4vBG3+LgVlq6v6mwABAH7/0QRPBhAAFQBwQCfgDuUU9gj2CfUOBSsICwECBjkRCQsKKQ0NDBoXAQIkFRUAGRY9fRgrThD0PE0Q/TxOEPY8TR` +
-	`D9PAA/7T88MTBDeUAeAxQPJgglBCYTJQcQCj4BBRICPgAJDgY+AAMUBj4AKysBKysrKysrgQEAXRMzERQeATMyPgE1ETMRFA4BIyIuARF+ykOLVVqNQ7qB0J6S3nIGEPwFyZNNU7H0A6z8C9n1fGzY` +
-	`ARoAAQAhAAAErQYQAAgAxkAkswfTB+cIAygAJwIqAycFJwYoCHkAdwUIBwcABQUCCAcABgcFuP9aQGAHAwIgAAECAikSBwcABQQDAyQSBwcFBwMFBAQBAQACAwIIAwUEAAILASsBOwF7AasBuwHrAQ` +
-	`cB8QsHKwc7B3sHqwe7B+sHBwfxBAQQBCQENAR0BKQEtATkBAgEGQk7lhgrGU4Q9F0YTf1d7V05ORI5OQA/PD88EDwQPBI5hwUuK4d9xIcuGCuHfcQrhw7Ehw7EhxAIxDEwAV0AXQEzASMBMwEXNwPr` +
-	`wv4izf4f1QFRLCcGEPnwBhD7xJ6dAAEAHAAABLEGEAAVAYNAsIUDAUkARgNMB0UIRglJEIsHiAwIFAgVCRwMWghcCQUQERASEBMQFHsEewUGGgcZERYUKAcqESYUORE2FEYHTAhJEEkRRhRGFVkIWh` +
-	`BZEVUVaQZoB34AfQN7BHsFeQdzDHEPgACBBo8Jjw+PEIAVlgOVCaYDrwavB6YJrwyvDb8GvAe1CL8MvQ3MBswHxQjECcwMzA3VA9sG2gfVCdwM2g3nA+oG5QjnCfoG+gxACAkJuAG0QAsSRxAQEQcG` +
-	`HwYBBrgBs7cSRhUVFA0MDLgBtEApEkYPDw4CAwMpEkcAAAEODQgHAgECFRAPAAgRFAgHDAkPEAYDAAgHFRC6AdgADwHZQBUQDkAOUA6PDp8Orw6/Ds8OCA4aFxW6AdgAAAHZQAnPAQEBGRY7lhgrTh` +
-	`D0XfTtThD2XU307RE5ORI5ORESOTkREjk5AD88PDw/PDw8PDyHDi52KwV9EMSHDi4YdisFfRDEhw4udisFcn0QxIcOLnYrBX0QxDEwAV0AXQFycXEzAzMTFzM3EzMTFzM3EzMDIwMnIwcD0LS4ag4F` +
-	`FKO4uhMFDHWevL/IFQUTtAYQ/E+dnQOx/CmWlwPW+fAEHJ6e++QAAAEAMAAABJ0GEAALALlAgzcFgwWaC6YFxwUFBgUBFwk2AzYENwVGA0YESwlLCmgGaAeIBccCxwXaBuYI9gL4CRECAQYDCgUBBg` +
-	`QJCAAHBAkLAAcDCgYHAAApEgEBBgMKCiQSCQkEBwYGBAQDAgoJCQEBAAgKBwYEAwAGAR8JXwmPCZ8JrwkFCRoNEAEBARkMO38YKxlOEORdEOZdERc5ABg/PBA8EDw/PBA8EDyHBU0uK30QxIcuGCuH` +
-	`fcQPDw8PMTABXQBxXSEjCQEzCQEzCQEjAQEC0gHG/mblASkBMNP+ZgHK6f6rAysC5f3pAhf9KvzGAmsAAAAAAQA0AAAEmQYQAAgAq0BWBASEBJMEpQTaAdoH6QHqBwgAAgADDwUPBoACgAOPBY8GkA` +
-	`KQA58FnwamAqkGDgMEBCQSAQQFAQIFBAQpEgcEAwcGBwQBAwYFBQMDAgIACAMCBAAFCAa4AUFACQEgAQAkCEAIB7gBQbYgAhkJO38YKxlOEPQaTf08GhgQ/TwaGRDtETkSORE5ABg/PzwQPBA8FzmH` +
-	`CC4rBX0QxIcILhgrBX0QxDEwAV0AXSERATMJATMBEQIC/jLlAVkBWM/+MwLOA0L9mAJo/L79MgAAAAEAegAABFMGEAAJAGNAF0gCWAJoAngCBAIGBwckEgEBAgEHBgIDuAGwtAUEAggHuAGwQBUJAA` +
-	`gBAAIHBAY/CBoLBD8AGQo9fRgrThD0TeROEPZN5BE5ORI5AD88/Tw/PP08ORE5hwUuK4d9xDEwAV0zNQEhNSEVASEVegLh/TYDs/0gAu+rBMmcnPs3qwAAAAEBY/6MBIoGbQAHAD9AEQYHCQIDBB0C` +
-	`ARAGBR0HABICuAGQQAwABAUoAAABGQhxLBgrThD0PE0Q/TwQ7QA/PP08Pzz9PAEREjk5MTABESEVIREhFQFjAyf9kwJt/owH4Zz5VpsAAAAAAQAh/9EErQY+AAMAN0AXAQAAHRIDAwIAA9MCAQAAAw` +
-	`IaBQEZBFW5AVUAGCsZThDkEPY5OQAYPzxN/TmHBS4rfRDEMTAFATMBA/b8K7ED2y8GbfmTAAAAAAEAQ/6MA2oGbQAHADdAHAIDBAUGHQAHEAQDHQECEgUEKAEBAMsGGQgg/xgrThD0Tf08EP08AD88` +
-	`/Tw/PP08ARE5OTEwAREhNSERITUDavzZAm39kwZt+B+bBqqcAAAAAAEARwHRBIUGEAAFAC1AFQMEBQAFAgQAAgMAAAEFAh0BBB0FBhDW7dz9ERI5PS88GAA/3Dw8BxA8MTAJASMJASMCZgIfq/6M/o` +
-	`yrBhD7wQLr/RUAAAEAR/9lBIUAAAADAB9ADwMAHQIBCgIaBQEZBCAsGCtOEOQQ5gA/PE39PDEwFzUhFUcEPpubmwAAAQFABQgDQAZOAAMAQUAcFAAUAyQAJAM0ADQDRABEA2QAZAN7AXsChAINAroB` +
-	`xgABAfBACgOLAu9AAeMhAAUQ1j0a7Rga/P0AP/wxMAFdASMBMwNAqv6q2AUIAUYAAAAAAgBm/+EEXgRtABQAIADjQFzGFeUH9AcDAIkEqRq+CdofBA0JAR9AHgMlCBUWGB4LFhsAAAEQEhIfEhMTAA` +
-	`AQGB4PRRgjCwoHExIKHjoDCwATFhIiEhNpFh8QD3VwEgESGiIbLXAGAQYZIU5+GCtOEPRdTe1OEPZdTfQ8/eQREjkREjkAP+0/PD887eQREjk5hwUuK4cIfcQBERI5ABESOTkxMBhDeUAaGR0ECQgm` +
-	`HQQbMQAZCRsqABwFHjEAGgcYKgErKwErKyuBgQBJVHlAEh8gAQIgAR5KBB8CFUoBIBUBAAEQPBA8KwArgYEBcV0AcV0lDgEjIiY1NBI2MzIXFhczERQXIyYDESYjIgIVFBYzMjYDeVXvZIzflPi3NW` +
-	`sQILsqwhYNY1Ws5X1QV9L7jI7y9sIBMbELAgP868p+XAF8Ad8a/vblpqmwAAACAI3/8ARb`
-
-const MonacoFontData9 = `Bk4AEQAdAL1AS4kFrBfWF+oF7Qf5BfoHBwCzCcQJAgQJAR9AAxslCBMSAAMbFQsYExAAGzoDBw8KFSMLCgsYLXAGAQYaHxETHxAvD6APAg8ZHi5+GCtOEPRdPE39PE4Q9l` +
-	`1N7QA/PO0/P+0/ARESOQAREhc5MTBDeUAaFhoECQgmFgkYKgEaBBgxARcHFSoAGQUbMQErKwErKyuBgQBJVHlAEhwdAQIdARtKBRwCEkoAHRIBAAEQPBA8KwArgYEBcV0AcV0BPgEzMhYVFAIGIyIn` +
-	`JicjETMZARYzMhI1NCYjIgYBR1buZIzglfi3NGwQILq6ZVWp535QV9EDYoyO8vbC/s+xCwIDBk78N/4hGgEJ5qeosAAAAAABAKT/4QRHBHwAGACdQE5kC3QLlBirAKULuxgGtgSyCLIQthO0FMwDzA` +
-	`jbA9sICQUIASgIDBgOFQyhCwsOIwkHGKEAABU6AgsLDHAAAQAaGhItLwa/BgIGGRkuUBgrThD0XU3tThD2XTk5AD9N7TkQ7T/9ORDtERI5OTEwQ3lAHA8UAwgEJRAlFAMSKgAPCBIqABMFFSoAEQcO` +
-	`KgErKwErKysrgYEBcV0AXSUGIyIkAjU0ADMyFxUmIyIOARUUEjMyNjcER7upqP77kgFL6q3Bw5pmtGK9yj12nyA/mwEJqf4BUEGzWWXWcZf+7hE2AAACAHL/4QRABk4AEgAeALVAQuQH9gcCigS6Cc` +
-	`sJ2R3uCQUfQBwDJQgUEwADFhwTFBESDkUKDwAWIwoHEgocOgMLDxIfEBEaIBktfwYBBhkfRzVQGCt2ThD0XRhN7U4Q9jxN/TwAP+0/P+0/EOQBERI5OQAREhc5MTBDeUAaFxsECQgmGwQZMQAXCRkq` +
-	`ABoFHDEAGAcWKgErKwErKyuBgQBJVHlAEh0eAQIeARxKBB0CE0oBHhMBAAEQPBA8KwArgYEBXQBdJQ4BIyImNTQSNjMyFxYXETMRIxkBJiMiAhUUFjMyNgOFVe9kjN+U+Lc1axAgu7tjVazlfVBX0v` +
-	`uMjvL2wgExsQsCAwHx+bIB2AHfGv725aapsAAAAgCC/+AETAR8ABUAHQC3QE9GFVUVugwDhx2kBKQIqQy1BLUItRHKFtsLCQUEATkIAAIVExYYDw4fDBcWRw4PDwIbIwoHEyMCCxihFUVwDAEMGh8P` +
-	`oS8GPwYCBhkeNX4YK04Q9F1N7U4Q9l1N5O0AP+0/7RI5Lzz9PAEREjkREjkAEjkROTEwQ3lAKRkdAxIRJgQlCCYSAw8qABoLGDEBHAkWKgAdEAUTKgAZDBsxAR0HGyoBKysrATwrKysrKyuBgQFxXQ` +
-	`BdJQYjIiYCNTQSNjMyEhEVIRQeATMyNwEhNTQmIyIGBDzfx5fuj4j0lsfx/P5hnmW02v0ZAi2GcHGlSmqWARefmQEanf7k/tgodMBgeAGnG4mipQAAAAABAKcAAASIBm0AFwBlQB0JEAEQCxAMIAsg` +
-	`DDYQRhBVELUIxAgJBgcBDA4UA7gBuEAeFQICAA4MoQsLDiMJAQAKBAEfFhMLExQCFFAZAi4YEPQQ/BESOcwvPP08AD8/7TkQ7RESOS88/TwSOTEwAXFdAHEhESM1MzU0PgEzMhcVJiMiDgEdASEVIR` +
-	`EBn/j4ZNGsgoaRcFmITQG7/kUDZZs03dSIG7EwQpWySJv8mwAAAAIAcv4vBEAEbQAiAC4A+UAmpijkB+Ye9QcEiQS9CbIXzAnZLe8J6x7rH+sgCQwJAR9ALANFCBO4//pAMxgWGQMcJCMAAyYsCyQp` +
-	`D0UmIwsKByw6AwscIxYPIiQfE2kPGjAZRSktfwYBBhkvRzVQGCt2ThD0XRhN7eROEPZN5P08AD/tP+0/PO3kARESOQAREhc5ERI5EjkxMAE4Q3lAMicrHSEUFQQJICEfIR4hAwYIJisEKTEAHRUiKg` +
-	`EUEycJKSoAKgUsMQAhFBwqACgHJioBACsrKwErEDwrKysqgYGBgQBJVHlAEi0uAQIuASxKBC0CI0oBLiMBAAEQPBA8KwArgYEBcV0AXSUOASMiJjU0EjYzMhcWFzMREAYHBgQjIic1HgEzMjY3PgE1` +
-	`GQEmIyICFRQWMzI2A4VV72SM35T4tzVrECC7DxEo/uPKy9BP52xVmC8vImNVrOV9UFfS+4yO8vbCATGxCwID/Or+5n1AlaxovDZTNjMzh3ABewHfGv725aapsAAAAQCbAAAEPgZOABcAlEAwxAcBH0` +
-	`AGEx8IAxYXAQATOgYHFw4KDw4fDA0NcAuQCwILGhkCFx8BLwABABkYLlAYK04Q9F08Tf08ThD2XTwQPE39PAA/PD/tPxE5OTEwQ3lAFgcSCgsJCwgLAwYRJhIHDzEBEAsTMQErASsrKoEASVR5QBIU` +
-	`FQQFFQQTSgUUBRZKABUWBAMBEDwQPCsAK4GBAV0zETMRPgEzMhYXHgEVESMRNC4BIyIGBxGbulDicV+dHxkSuh9TMWLhSQZO/RuBkmFFNXe6/ZACh6FnQrqM/XUAAAAAAgCeAAADxQZtAAcAEwA+QB` +
-	`MR5QsBAQImBAMGBgUmBwAKCOUOuAHxQAwG6AUfAugAGRRlyRgrThD0TeT95PT9AD88/Tw/PP08P+0xMAEhESE1IREhFQE0NjMyFhUUBiMiJgHU/soB8AE3/fBWJidWVicmVgPCm/w+mwXwPz4+Pz4+` +
-	`PgAAAgCs/i4DtAZtABEAHQB7QBUKDgHFD+gF6A4DGQgBCQMb5RUBCAm4AbhACgsKBgMjEA8S5Ri4AfG2Bx8ACxofAbgBE7Z/CQEJGR6/uQFXABgrThD0XU3mThD2XU3t9O0AP+0/PP08P+0REjkxME` +
-	`N5QBAEDw4mBSUEDwcqAQYNAyoAKwErKyuBAV1xEzUWMzI+ATURITUhERAOASMiATQ2MzIWFRQGIyImrJxrXYJJ/iYClIa+pXcBh1UnJ1VVJydV/kquLkGavwNem/wq/uTdYAfCPz4+Pz4+PgAAAAEA` +
-	`zQAABKYGTgAKALZAgAcGWAZ2BQOFBZcFlwanBacG2gXqBfgGCNcG1AgCyAYBmAWZBgLXBucGAooEigWrB6sIuwa9B8sH6wf8BwkIBgoHAhUFFAaUBgMGAwQHBx8SCAgJBgMEBQXjEgQDBAkDAwgEBQ` +
-	`QGAgEACggHAwAKBAgCCgwCAwkDCh8BAAABAAwLL8zeXTz9FzwREBc5AD8XPD88PzwREjkvxQcQBSsQCTwHEAUrEAg8MTBycV0AcXJdAV1xcjMRMxEBMwkBIQERzboCGfj96AIm/wD94QZO/AoCBf37` +
-	`/agCWP2oAAAAAQB5AAAD3gZOAAcAQ0AhAAECJgQDAAYFJgcACgboBQPoAAQFHwFPAG8A3wADABkIuAE6sdYYK04Q9F08Tf08EOwQ7AA/PP08Pzz9PDEwAXEhESE1IREhFQHH/rICCAFdBbKc+k2bAA` +
-	`ABAEcAAASFBHwAJQDjQHAbAxsEGyIbIywDLAQsIiwjZAdkCGQPZBBrGmsbayNrJHQHdAh0D3QQfBp8G3wjfCSEB4QIhA+EEJMHkwiTD5MQpQelCKwarCO/Gr8jzRrdGusa+hr6IyskGwoDBCUhOgYY` +
-	`Og4OBgcBBiUdHRwcEwoTuAG1QAxIESASAUASAW8SARK4AdqyHEgduAG1QA1ICRsgHAFAHAFvHAEcuAHaskgCJbgBtbVIAQAZJk25AVkAGCtOEPQ8TXb9PHb2XXFxPDx2/XYQ9l1xcTx2/RgAPzwQPB` +
-	`A8Pz88EO0Q7REXOTEwAF0zETMRPgEzMhYdAT4CMzIWFREjETQuASMiBgcRIxE0JiMiBgcRR6NNhURRYyFdZEFIZqMMJBU0dz6cOhczdTUEXf8Ao3x/dTxmf0t6tvy0AvteRSSfuP2VAvqUNLKL/XsA` +
-	`AAAAAQCbAAAEPgR8ABUAj0AwxAcBH0AGERkIAxQVEToGBwEGFQwKDQwfCgsLcAmQCQIJGhcCFR8BLwABABkWLlAYK04Q9F08Tf08ThD2XTwQPE39PAA/PD8/7RE5OTEwQ3lAEAcQCCUPJhAHDTEBDg` +
-	`kRMQErASsrK4EASVR5QBISEwQFEwQRSgUSBRRKABMUBAMBEDwQPCsAK4GBAV0AMxEzFT4BMzIeARURIxE0LgEjIgYHEZu6UOJxY6JBuh9TMWLhSQRd9IGSaar5/ZACh6FnQrqM/XUAAAAAAgBX/+EE` +
-	`dgR8AAsAFwCpQFeWApoEmgiWCgTGAcYCxgTGBckHyQjJCskLyQ3JDskQyRHGE8YUxhbGF+0B7QXkB+QL/AH8BfMH8wsYNwgSIwYHDCMACw8tcAkBCRoZFS1wAwEDGRhOZxgrThD0XU3tThD2XU3tAD` +
-	`/tP+0xMEN5QCoBFw0LDyoBFwEVKgARBw8qARMFFSoADgoMKgAWAgwqABAIEioBFAQSKgErKysrASsrKyuBAV0AXQUiADU0ADMyABUUACcyNjU0JiMiBhUUFgJn8P7gASDw7wEg/uDvlbCwlZawsB8B` +
-	`T///AU7+sv///rGb6MvK6OjKy+gAAgCN/k4EWwR8ABIAHgDEQFG0CcAJ0QLUHQSKBYEYrBjSGOsF7AfmF/sHCB9AAxwlCBQTAAMcFhMUGQ8ORQocOgMHEQYWIwoLEA4ZLXAGAQYaIBIPHxAQLxGgEQ` +
-	`IRGR8ufhgrThD0XTxNEP08ThD2XU3tAD8/7T8/7RDkARESOTkAERIXOTEwQ3lAGhcbBAkIJhcJGSoBGwQZMQEYBxYqABoFHDEBKysBKysrgYEASVR5QBIdHgECHgEcSgUdAhNKAB4TAQABEDwQPCsA` +
-	`K4GBAF0BXQE+ATMyFhUUAgYjIicmJxEjETMZARYzMhI1NCYjIgYBR1buZIzglfi3NGwQILq6ZVWp535QV9EDYoyO8vbC/s+xCwID/k4GD/4o/iEaAQnmp6iwAAACAHL+TgRABG0AEQAdALhARZcHpR` +
-	`flB/YHBIoEvQnLCdkcBAsJAR9AGwMlCBMSAAMVGwsRGA9FFSMLCgcbOgMLEQ4TER8QEA8aHxgtfwYBBhkeRzVQGCt2ThD0XRhN7U4Q9jxNEP08AD8/7T887eQBERI5ABESFzkxMEN5QBoWGgQJCCYa` +
-	`BBgxABYJGCoAGQUbMQAXBxUqASsrASsrK4GBAElUeUASHB0BAh0BG0oEHAISSgEdEgEAARA8EDwrACuBgQFxXQBdJQ4BIyImNTQSNjMyFxYXMxEjGQEmIyICFRQWMzI2A4VV72SM35T4tzVrECC7u2` +
-	`NVrOV9UFfS+4yO8vbCATGxCwID+fEDigHfGv725aapsAAAAQD6AAAEQAR8ABEAc0ArH0AGDRAAAwsNCggGAgoKAAINOgYHAgYACgsKHwkJCBoTAhEfAQAZEr9QGCtOEPQ8Tf08ThD2PE0Q/TwAPz8/` +
-	`7RESOS8REjkREjk5EjkxMABJVHlAEg4PBAUPBA1KBQ4FEEoADxAEAwEQPBA8KwArgYEzETMVPgEzMhcRIzUmIyIGBxH6ulfhimVlsy8baMhfBF30iokf/m3/CKSk/XcAAAABAKj/4QQ0BHwAKQFYQL` +
-	`ECIi8ALwEvAi8pOwk7CjYeNh9kImQjZCR4D3gQcyFzInMjcySTE5onqye6ErgTtCnUCdQK5QnlCvQJ9AoeAwkDCgkPCRAJHQkeBgEBDxYPFxoAKwAoFigXPABLAEUgRSFbAFQgVCFsAGkLaQ1pDmkP` +
-	`ZR9lIHoAeQt5DHkNeQ55F3kYdR91IIoAmgCsALkguSHGDdYM1g0mSggiHw4KBBkEDhEKHxwiBxeeXxZ1GSMUBwG4ARxACgQABCMoCxe1Rwe4Aba2cCUBJRorHLgBtkALLxEBEWkAGSourRgrThD0Tf` +
-	`Rd7U4Q9l1N7XbkGAA/7TkQ5D/t/BrtARE5Ejk5ETkAERIXOTEwQ3lANBonBRMaExwwAAUnBzABCSMHMAEeDxwwABsSGTABBiYEMAAIJAowASMiCQodEB8wAB4fDw4QPBA8KxA8EDwrKysBKysrK4GB` +
-	`AF0BcV03NR4BMzI2NTQnJi8CJicmNTQ2MzIXFSYjIgYVFBcWHwEWFxYVFAYjIqhw+leNfB0dc0CB0DY31e+zoLiidoU6OYF+rUZG3/XWO8VJO1tELSAgLxozUUVEYoqyNKlCUz48JCQvLj9KSm+Ruw` +
-	`AAAAABAGD/4QRBBcIAGAB8QBOKAIcFmgCVBaoABRkIAAMYCRYNuAE5tQoQCSYPCrgBl0AKFiMDCwBpIBABELgBhkAPDRIfDAfoHwkBCRkZTlAYK04Q9F1N9Dz9PPRd5AA/7f08/TwQ5BESORI5MTBD` +
-	`eUAQBBUUJgUlFQQSMQATBhYxACsBKysrgQEAXSUOASMiLgE1ESE1IREzESEVIREUHgEzMjcEQYN2N3XCY/7pARe6AfH+D0BrSYeVLTcVWrC4AcKbAcL+Ppv+QYdvNFUAAAAAAQCP/+EEMgRdABUAkk` +
-	`A0ygfZEwIfQBEGGQgDFBUMBgIKEToGCxUCHwEBvwABABoXDA0fCwoKLwl/CZ8JAwkZFi6tGCtOEPRdPBA8Tf08ThD2XTxNEP08AD/tPz88OTkxMEN5QBAHEA8mCCUQBw0xAA4JETEAKwErKyuBAElU` +
-	`eUASEhMEBRMEEUoEEgUUSgETFAQDARA8EDwrACuBgQFdAREjNQ4BIyIuATURMxEUHgEzMjY3EQQyulrcbmKjQLofUzBi4UoEXfuj9YqKaav4AnD9eaFnQrmNAosAAAEAIQAABK0EXQAGAKRAKYADkA` +
-	`OgA+oD+gMFRwJXAmgBZwWoA+gA5gX4APYFCQgFCQYCAwMCBAQAuP+MQCgDAAYgAgEAAB8SAwMCBAUGBh8SAwMEAwYFBAQCAgEGBgAKAAIBBAYFugFAAAMBQEAPAAEQAUABsAEEARkHgJcYKxlOEPRd` +
-	`GE397Tk5Ejk5AD88PzwQPBA8EjmHBS4rh33Ehy4YK4d9xCsHEAg8MTABcV0AXSEBMwkBMwECBv4bzAF/AYe6/hcEXfyMA3T7owAAAAABABQAAAS4BF0AEwDPQJ0ADwAQABEAEgQHAAkECQ8JEhkKGQ` +
-	`0bEiYJKQ0oDjkNSg1aDWYBYwJsC2gMdQFyAnsLeQyFAIUBmAaWD8UP6gXqBuYO5g/5BfkG9w73DyIIDggPRgJGA9YK1wvaDAcEEQkQBA0MAgYHCwwFAQYTDg0DAAoQCQQQEQYHCQ0OBAATbwx/DAIM` +
-	`5A4fDXAHHwZwJAwBEx9HbwB/AAIA5AEUENb0XXbtXfz9/e0Y/V0REjkREjkREjk5GS8vPS8YAD8XPD8XPBESFzkxMAFxXQBdMwMzExc3EzMTFzcTMwMjAycjBwPKtq1qHBuyp8kjF2uPta7WIgUfug` +
-	`Rd/ViengKo/ViengKo+6MCz56d/TAAAAEAOAAABJ0EXQALAMZAjQAAAAEDCCgFKQYpByoIOQdmAmcDZwRzA3MEmAKpAqkFqgaqB7gFuwa5B8kF2AXnC/cH9gsaIwU1BWcCZgWGAoQFpgXXAugICQIB` +
-	`BgMKBQEGBAkIAAcECQsABwMKBgcAAB8SAQEGAwoKHxIJCQQHBgYEBAMGCgkJAQEACgoHBgQDAAYBCRoNrwEBARkMTbkBWQAYKxlOEORdEOYRFzkAGD88EDwQPD88EDwQPIcFTS4rfRDEhy4YK4d9xA` +
-	`8PDw8xMABdAV0hIwkBMwkBMwkBIwEBD9cBt/5m7gEjARvT/nwBze/+rAJJAhT+iQF3/f79pQG9AAAAAQAg/i4ErQRdABIA1UCQiQCZAKoA1wMEAEkAWQCJAIoDjASJBYkSmACYA5cGlweqA6wEqgW4` +
-	`A7kEuQXJBMkF2wTbBRUJAwEDAwIEBAAAAAEDAwISEgQMEg4CAQAAHxIDABADAgYSBAQfEgUFBgYDAAMSBQQEAgIBBhIKDiMJDxIGBAMCAAYBPwVPBV8FbwWPBQUFGhQMAAEBARkTTZcYKxlOEORdOR` +
-	`DmXREXOQAYP03tPz88EDwQPBIXOYcFLiuHDn3EhwguGCuHBX3EABESOYcQCMQIPIcQCMQxMAFxXQBxXSUBMwkBMwECBiMiJzUWMzI+ATcCNv38zQGbAVi7/k+J98RVQ01GWox0GC0EMPy6A0b78v63` +
-	`2A+kGEayQQAAAQCFAAAEPwRdAAkAX0ATqQLIAgICBgcHHxIBAQIBBwYCA7gBuLQFBAYIB7gBuEAVCQAKAQACBwQGRQgaCwRFABkKNVAYK04Q9E3kThD2TeQROTkSOQA/PP08Pzz9PDkROYcFLiuHfc` +
-	`QxMAFdMzUBITUhFQEhFYUCrf1jA5P9TgLJqwMXm5v86asAAAABANP+jARPBm0AOgCGQEItxTQuiC0OxQcNiA4YEwcFBA4AHTY0KCUELR4AJSkHNDcZHYceHh8fDiwtEA8OEh0ewikOLcopN24iIgRu` +
-	`GVEpKRK4AeCzO4quGCsQ9jwQ9O08EO0Q/TwQ9DwAPzw/PBI5LzwQ7QEREjk5Ejk5ABESFzkSOREXORDtARD1ABDtARD1MTABHgIVFA8BBhUUFjsBFSMgJjU0PwM2NTQmKwE1MzI2NTQvAyY1NDYhMx` +
-	`UjIgYVFB8BFhUUDgECI11XKxQZFF+Um53+7p4PEhEMB32eWVmcfwcMERIPngESnZuTYBQZFCtXAnwxXmNAOVxyXS09VZuwbztEUFc1JSNdhJuCYSQiNFdQRTtusZxVPixdclw5QGNeAAAAAAECFf/R` +
-	`ArgGPgADAB9ADwPTAQACAx0AAAEZBIzUGCtOEPQ8TRD9PAA/7TEwBREzEQIVoy8GbfmTAAAAAAEAfv6MA/oGbQA6AIVARy3FNC6ILQ7FBw2IDjY0KCUELQAeGBMHBQQOHQc0BAAlEhkehx0dHBwtDw` +
-	`4QLC0SHh3CEjduIiIEbhlRKSkSLRLKDhk7hKwYK04Q9E3tPBA8EPTtPBDtEPQ8AD88PzwSOS88EO0BERI5ORI5OQAREhc5EjkRFzkQ7QEQ9QAQ7QEQ9TEwAS4CNTQ/ATY1NCYrATUzIBYVFA8DBhUU` +
-	`FjsBFSMiBhUUHwMWFRQGISM1MzI2NTQvASY1ND4BAqpdVyoTGRRflJudARKfEBIRDAd9nllZnH8HDBESEJ/+7p2blF8UGRMqVwJ8MV5jQDlccl0tPVWcsW48RFBXNCYjXISbg2EjIjVXUEU7b6+bVT` +
-	`4sXXJcOUBjXgABADgBdASVAukAHgBKQA8WCQcDBBQWFQkHBAEQRgS4ATa3AEYUD+AQEBG4ARG1IB7gAAABuAERsx8gLBgrEPY8EO0Q9jwQ7QAv5P3kAREXOQAREhc5MTATNTQ2MzIeAR8BFjMyNj0B` +
-	`MxUUBiMiLwEmIyIOAR0BOJuDIT9QUHlvRkpHgL1qY6NmdEcoRSoBkxKDwQ0fLUM9az8QEKefXTtCKlwjEgAAAP//ACEAAAStB7ICIgAkAAAAAwCOAAABsgADACEAAAStB2UAEgAXACMAuEA2xgABAx` +
-	`QVFgIEExcWFgUEEwoFAxQCEAcWBQUpEgYGBwAWAgIkEgEBABYHAAMbEw22IaAbAwMEuAGwQCQUE+4GBgUFAgIBCAUGBxgKAB4CARC2HoMWCrYYgwHxFvEGJSQvxBnWGP3tGfQY7RkQ9Bj9ETkRORES` +
-	`ORI5AD88EDwQPBD9PP08P/btERIXOYcFLiuHDn3EhwUuGCuHDn3EARESOTkREjk5hxAOxAXExIcOxAU8PDEwAV0JASMDIQMjAS4BNTQ2MzIWFRQGASEDJwcDFBYzMjY1NCYjIgYCzAHh1Wr93mnCAd` +
-	`4qKm1NTW0p/oYBxbssJys3JiY3NyYmNwYQ+fABVf6rBhAfTS9Lb25MLk77wQJNnJwCbiY3NyYmNzgAAAEAdv5NBEAGPwArAMNAHkEIGhgbHRIRIiMiEgMbERUgAeMAaioN4w5qERi2HbgBHUAQIp0L` +
-	`SBEDOSoDAAIjCREJG7gBZbUgDgAghRW4ASZACwAaLQczJxksPZMYK04Q9E3tThD2TfTtEDwQ5gA/Pz8/7RDt9PbtEPTtEPTtARESORIXOQAREjkSORE5MTBDeUAuJCkeHxYXBAoFJQkmJSUEKQcrAB` +
-	`4XIHcBCiQHKwAGKAMrAR8WHXcACCYLKwAkIxA8KysrASsrKysrK4GBgYEBFSYjIgYCFRQSFjMyNxUOASMHHgEVFAYjIic1FjMyNjU0JzcuAQI1EAAhMgRAoZ94x3p+xHeeooCAUC1rQlheU0w6PSM4` +
-	`uljC52wBdwEbigX+uF2k/sO6vf7An123LhVSFVkwN1scWR8qF0QToDHnATPMAZMBuv//AMsAAAQRCAACIgAoAAAAAwCNAC8Bsv//AH4AAARPB7oCIgAxAAAAAwDZAAkBsv//AEf/0QSFB7ICIgAyAA` +
-	`AAAwCOAAABsv//AH7/0QRPB7ICIgA4AAAAAwCOAAABsv//AGb/4QReBk4CIgBEAAAAAwCNAJ8AAP//AGb/4QReBk4CIgBEAAAAAgBDQgAAAP//AGb/4QReBk4CIgBEAAAAAgDYWQAAAP//AGb/4QRe` +
-	`BgACIgBEAAAAAgCOOgAAAP//AGb/4QReBggCIgBEAAAAAgDZKwAAAP//AGb/4QReBnwCIgBEAAAAAgDdKwAAAAABAKT+TQRHBHwAKgC9QC4+CAkLACoQERAAAwkqAw4nKCwboRq1GCehKLUqJJ4REB` +
-	`AIKh0jGAcRCyoLCA4LuAEUsgYOGrgBJ7IDmw66AWYACQFhtyEtFRkrLlAYK04Q9E399vb95AA/7T8/Pz/tERI5LxDtEPTtEPTtARI5ORESORIXOQAREjkSOTEwQ3lALB4jEhcMDQQFHyUTJQwFDjAB` +
-	`HhchKgAjEiEqAA0ECzAAIBYdKgEiFCQqABIREDwrKysBKysrKyuBgYGBBR4BFRQGIyInNRYzMjY1NCc3LgI1EAAzMhcVJiMiDgEVFBIzMjY3FQYHAr5sQlldU0w6PCQ3uV2l1m0BUuOtwcOaZrRivc` +
-	`o9dp+ctoMVWTA3WxxZHyoXRBOpHKjhmgEQAUlBs1ll1nGX/u4RNrM4CgD//wCC/+AETAZOAiIASAAAAAIAjXwAAAD//wCC/+AETAZOAiIASAAAAAIAQ04AAAD//wCC/+AETAZOAiIASAAAAAIA2CcA` +
-	`AAD//wCC/+AETAYAAiIASAAAAAIAjjYAAAD//wCdAAADxAZOAiIA1wAAAAIAjfgAAAD//wCdAAADxAZOAiIA1wAAAAIAQ/gAAAD//wCdAAADxAZOAiIA1wAAAAIA2NIAAAD//wCdAAADxAYAAiIA1w` +
-	`AAAAIAjtIAAAD//wCdAAAEQAYIAiIAUQIAAAIA2RAAAAD//wBX/+EEdgZOAiIAUgAAAAIAjScAAAD//wBX/+EEdgZOAiIAUgAAAAIAQycAAAD//wBX/+EEdgZOAiIAUgAAAAIA2AAAAAD//wBX/+EE` +
-	`dgYAAiIAUgAAAAIAjgAAAAD//wBX/+EEdgYIAiIAUgAAAAIA2QAAAAD//wCP/+EEMgZOAiIAWAAAAAIAjVcAAAD//wCP/+EEMgZOAiIAWAAAAAIAQygAAAD//wCP/+EEMgZOAiIAWAAAAAIA2AIAAA` +
-	`D//wCP/+EEMgYAAiIAWAAAAAIAjgIAAAAAAQC0/owEGQYQAAsAg0AVAQsCRh0KLwM/AwIDpARACAVGHQkEuwHTAAYACgEzQBUACwhGHQcgADAAAgCkAUACBUYdBgG4ATO1AxkMOGEYK04Q9E32PEtS` +
-	`WLAAGln8PBoQ/XE8S1JYsAAaWfw8EOYAL/Y8S1JYsAAaG7BAGln8PBoQ/XE8S1JYsAAaWfw8LzEwASMTBTUFAzMDJRUlAtniIv6bAWUi4iIBYv6e/owFURvWIAGY/mgg1hsAAAACAVcEHwN2Bj4ACw` +
-	`AXAGS0NwgMVgC4AdW2ElYGAA9WCbgB1bcVVgMZGHGQGCtOEPRN7fXtAD/t9e0xMEN5QCoBFw0LDx4BFwEVHgARBw8eARMFFR4ADgoMHgAWAgweABAIEh4BFAQSHgErKysrASsrKyuBASImNTQ2MzIW` +
-	`FRQGJzI2NTQmIyIGFRQWAmdynqFvb6CecUtnaUlKaWcEH6FucZ+fcW6hXWxGSWpqSUZsAAIAdv9lBBkGqwAdACQAc0AcARocGR4UHw8OEwkLDB4fABMUHRkaJg8dxBSeHLgBnkAYC8QMnhO1DwYLHS` +
-	`YKAAAlDxomIi0FGSU1uQECABgrThD0Te1OEOYSOS88Tf08AD/0/eT97eQBERI5ORI5ORE5OQAREjkSORE5Ejk5ETk5MTAFES4CNTQ+ATcRMxEWFxUuAicRPgE3NjcVBgcRAxEOARUUFgJfldV/dtGi` +
-	`m6Z5JFhiQVBWVwwWlYqbmoV4mwFaEpz1nJzyoB0BYv6eEyWzFSAaBvyeCRgoBgq0MQb+pgH0A1Iw6pR+9wAAAQCNAAAEVwY+ACEAi0AUHAgDBwERCBAOEwgHAwMAHxwFGQi4Ab9AChoHBwATJQ4AIA` +
-	`G4Ab9AFiEAChqLEVEgGiMXHCgKBYsAGSJsrhgrThD0TfQ8/TxOEPZN5OQAPzz9PD/tEjkvPP08ARESORIXOQAREjkSORESOTEwQ3lAEhQWCw0VJQwmFA0XHgAWCxMeASsBKysrgYEzNT4CPQEjNTM1` +
-	`ED4BMzIXFSYjIg4BHQEhFSEVFAYHIRWNXVct4eFmvIN8ip5+OWkzAUb+uixqAsWrFVOfe9ObRQEK5HA6tFNLmtRPm3W7w2KrAAACAJX+jARPBj4ANgBEAWFAbCQ3Mys1Q0M3Q0NDRFQ3VENURGArYj` +
-	`dgQmJDYkR/EnsTcCtxL3EwcDdwQnBDcEQXLiA1QjVDAnEIJicoKCQ9Pj48Ny4NCgQ8BBEkKA0RQRQKNwchLhs5KDw+PqMSJCQoPjwoAyQEAWMAUQQlNbgBokArJBtjGlEeJRgAJAc+PCgkBCEHUzFM` +
-	`G905biwaRgFzDyFTFExBbg8ZRTiuGCtOEPRN7fTtEOZOEPZN7eT07REXOQA/P+307RD97fTtERIXOYcOLiuHDn3EARESORESOTkREjk5ABESORESFzkHEA48hxAOxMQxMBhDeUBOOjsvNCkrHyMSFw` +
-	`UKFiYzJh8XIR4ABTQHHgEJLwceAyMSIR4COyo5HgEgFR4eAQYyBB4ACDAKHgEvLgkKIhMkHgAjJBIROis8HgEqKTs8ABA8EDwrEDwQPCsQPBA8KysrASsrKysrKyuBgYGBgYEBXTgAXRM1HgEzMjY1` +
-	`NCcmLwEuATU0Ny4BNTQ+ATMyFxUuASMiBhUUFhcWHwIWFxYVFAceARUUDgEjIgE2NTQmLwIOARUUFheVZulelZ8nKIOTxKGRQEFvyp2UvT3PToh8IyNFOT+VwTk4kFwlheCg3AHiZIFUXrg6Gm7G/t` +
-	`PJNz56RjosK0pTbsZto4Uwe0thpmM5siAwbEIjPhw3IiNYclZXaKSFTHguXrtdArNQakiJMDVpQlElUX1yAAABAGIA+ARqBQIADwAPuAAyKwC4AAgvuAAALzAxJSIuATU0PgEzMh4BFRQOAQJnie2P` +
-	`ivKJh/KKj+34hfKOivCLi/CKjvKFAAABAFf+jASdBhAAEAAwsQoPugG/AAgBpEASDQAKeAwdDW0QhwBlBBkRIJgYK04Q9E30/fb95AAvPP39PDEwAREuATU0PgEzIRUjESMRIxECKOvmcMn0Ahmjm5` +
-	`v+jAQfGP2kcM5unPkYBuj5GAABAIL/4QRzBm0APwBVQA86ngUQPx8KJCMbCx8gdQ+4AbazMDctCLsBCABHACgBtkAQGDAwGEE+Px8BABlARzVnGCt2ThD0GDxN/TwROTkvL/129RjtEO30PAA/7T88` +
-	`P+0xMDMRND4BMzIWFRQGDwEOARUUFh8CFhcWFRQGIyIuASc1HgIzMj4BNTQnJi8BLgE1NDY/AT4BNTQmIyIOARURgnPPkr+rRmdEFFAYO0B+akdG4rAmajs3PFRUHDJuOTAxcnhcaDtlOzBCfDxFdU` +
-	`gEYsbTcrNiPndZOhFNKBwoKy9XSFVVc5K9BwgQuRoVDiRRKj48PExQPndKN2pdNyxXL0E9Q4mj+54AAAAABAAC/9EEzAY+AA8AHwAtADYBbUDUpRGlEqUTqhWqFqoXqhmqGqobpR2lHqUfuQG5ArYG` +
-	`tge2CbYKuQ65D8Yd0xPbFdsb0x3mEuYT6hXqFuoa6hvkHeYe+QL2BvYK+Q4lChIKFgUaBR4AJgArAiwaARoHFQkVDzkSORY2GjYeMSsxLEwRTBdEGUQfRStFLFsRWxdUGVQfVStVLGoBagJqBmoHZg` +
-	`lmCmYOZg+bEZsXlRmVH7ErsCzJEckXxRnFH8IrwCzaF9Uf0yvQLOkB6QfmCeYP4yvgLPkB+Qf1CfUP8SvwLEEtKyosKi24ARa1Li4kIissvgH1ADYBFgAjAfQAGAHmsggAELgB5kAOAAksnyoAK0ew` +
-	`MsAyAjK4AfNAES8nfycCzyffJ+8n/ycEJ0AUuAHmQAkMODa/Ic8hAiG4AfOzUCIBIroBaQAcAeaxBDcQ1v32Xf1dPBDW/Rga3F1x7V123F0zGP0AP/0//fbt/Dw8ETkv/TkQBwU8MTABXQBdBSIkAj` +
-	`U0EiQzMgQSFRQCBCcyNhI1NAImIyIGAhUUEhYTESMRMzIWFRQGBxMjAyczPgE1NCYrAQJnwv7+oZUBB8nCAQOgof7+woDgiYrgf4LjhIzeBovcsH1UWuymxFJ0QD9Tcy0vygF88egBdNrM/obw8f6E` +
-	`yn2hAVjBwAFaoKf+qbzD/qSbAoD+hQOSgVJLmTP+WAF7byRdOjtEAAAAAAMAAv/RBMwGPgAPAB8AOQEnQLCmEaYSphOpFaoWqhqpG6Ydph6mH7oBugK2BrYHtgm2CroOug/GHdQT2xXbG9Qd2TTWNu` +
-	`QT6xXrG+Qd+QL2BvYK+Q4hCRIJFgYaGQEZBxYJFg86EjoWNho2HksRSxdGGUYfWhFaF1QZVB9bKVQzUzdqAmoGZgpmDmkkaSVqKWoqmxGbF5QZlB+nN8kRyRfGGcYf2RfVH+sB6wflCeUP5iX6AfoH` +
-	`8wnzDzI4hQ8jHyMCI7gB9kAPMoUfKy8rPysDnyuvKwIrugHkABgB5rIIABC4AeZAJQAJIAAuEC4gLjAuQC5QLmAugC4ILjWFHycvJz8nTyfwJwUnBBS4AeayDDscuAHmsQQ6ENbtENbtENxd7dxdPA` +
-	`A/7T/9/l1y7fxd7TEwAV0AXQUiJAI1NBIkMzIEEhUUAgQnMjYSNTQCJiMiBgIVFBIWARUGIyIuATU0PgEzMhcVLgIjIgYVFBYzMgJnwv7+oZUBB8nCAQOgof7+woDgiYrgf4LjhIzeAZZafIPLd3O7` +
-	`iGZ/JlpFF3+Vr39SL8oBfPHoAXTazP6G8PH+hMp9oQFYwcABWqCn/qm8w/6kmwFjmCGN75OS9oggnwwQCc6qu8YAAAAAAgACAwgEzAYQAAcAFACpQDlQClALAhMKEwskCiQLNAo0CwYLDQsTCxRME0` +
-	`wUbQ1vE28Uew1/E38UCwAUEwINDREPCQcCEfcPBQK4AfJADwsMDg8EAwQNDQwOAhEJELgB8rMRRAgJuAEYtkAKAW8KAQq4ARGzBYtGB7gB8rUAi0YCFhUvxNZ29Bj9dvT2GF1x/Tx21BjtERIXOT05` +
-	`LxgAPxc8/TwQ/Rc8ERI9OS8YFzkxMABxXQFxchMRIzUhFSMRAREjETMbATMRIxEDI+HfAj/gAYdqfaqse3l5dwMIApdxcf1pAd7+IgMI/jAB0Pz4Ad3+uAAAAAABAUAFCANABk4AAwA/QBoUAhQDJA` +
-	`IkAzQCNANEAkQDawBrAXQCdAMMAroBxgADAfBACgGLAu9AA+MhAAQQ1j0a7Rga/P0AP/wxMAFdCQEzAQFAASjY/qoFCAFG/roAAAACAREFCAO8BgAACwAXAClAFBKlDAwGpQAPpRUaGQmlAxkYXJEY` +
-	`K04Q9E3tThD2Te0AL+08EO0xMAEiJjU0NjMyFhUUBiEiJjU0NjMyFhUUBgGNM0lKMjJKSQGAM0pLMjJKSgUISDQ0SEg0NEhINDRISDQ0SAAAAAABAEcAZQSFA9EAEwCpQE45BDkFOgg6CTYONg8GAg` +
-	`YBAw4FBgEEDQgHAAQNCQoRBA0MCxAEDQ8LEAMOEgoRAw4TBwADDgoLBAYQERUADQ4DAx0SBAQNDg27EAsdEQq4AcFACgAHHQEGuwQDAwS4ARKyBg0OuAESQAkAGhUGGRQgLBgrThDkEPZN9DIQ9DIA` +
-	`Lzz0PP089jz9PPQ8hwUuK4d9xAEREjk5ERI5OQ8PDw8PDw8PMTABXQEVIQcjNyM1ITchNSE3MwczFSEHBIX9cpzIm+cBVrf98wKBls2Y8v6ZuAGrjLq6jOGLurqL4QAAAAACAAIAAASdBhAADwASAK` +
-	`RAEQIQEhIDAhAAAw4PFAYFEgMDuAG0QBASBAQFEggJERAnAgIB7goJuAGwtgwMCwsABwi4AbC0BgUCDg24AbBAHg8ABAMDAAgDBAUSACkIDw0BDeoLPwYaFAQZE2h/GCsZThDkGBD2TfT0XTz9PDkR` +
-	`OQA/PBA8EDz9PD88/TwSOS88EP08/TwQ/TwREjmHBS4rh33EARESOTkREjk5BxA8PDEwASERIQMjASEVIREhFSERIRUBIRECfv7GmKoCfAIf/psBVv6qAWX84AEBAXT+jAYQnP3pm/3pqwIAAm4AAA` +
-	`ADAEf/kwSFBnwAGwAmADEA/0BLLAgCDRwdDg4BEBsnKA8PAA0QAhsEGAkdJxwoBCsgDRACGwQUBh0nHCgEIy4bGCkoJx0cEAIHKw0JIAIAKSgnHRwbDQcjEC4ODwAAuAGzQCcSAQEOAAABahgOD2og` +
-	`OQkDKzkYCS4zDg8PFBozAAEBIzMGGTJrlRgrThD0Te08ETNOEPY8ETNN7QA/7T/t9DwQ9Dw5hwUuK4d9xAERORIXORE5ABESORIXORE5ARESFzkREhc5ABESFzkREhc5MTCHEA7ExDzEhxAOPMQ8PD` +
-	`EwGEN5QB4sLSEiFRcHCBYmLBcuKwEhCCMrAC0VKysAIgcgKwEAKysBKysrgYGBgRcjEy4CNRAAMzIeARc3MwMeAhUUAgYjIiYnEwEuASMiAhEUHgEJAR4BMzISETQuAdGKlD82HwEn8zZzWj9dhY49` +
-	`NB2F48FSe10PAg0+czeauwYRAmf99zVvN66sBg9tARRks9p5AY4BnxcmMaz+72ek73T5/oq9Jk4BIwPJSSn+o/7PMMVmAvH8NkIyAXEBKzSsbQADAEcAyQSFA5QAHQArADoAUUAoCAyIAYoDiQwE2A` +
-	`PYKwIxJRopJQRMGjclEkwjJQuNGi8hHQ4EJhYhNLgBkbcmIQcZOyAsGCtOEPRN/fbtEhc5AC/97fTtEPTtEO0xMAFycQEHDgEjIiY1ND4BMzIWHwE+ATMyHgEVFA4BIyImLwQmIyIGFRQWMzI2Nx8C` +
-	`FjMyNjU0JiMiDgECZxpRbUV1jkFrRECBTSIuiV9KekRCbEE/ek19LSMhPS8qRUM+M1XqHygsPC0nSUo+ITs5AcAsgkjSilisanNbKmiPWrZVTLpfY2CWNyUjQG9UWIBbmygsMUNmWGaALVcAAAACAE` +
-	`cAAASFBXQACwAPADtAIAplAAcdAQZlBG0MDx0NDgoADWUKAx0JBGUPBhkQICwYK04Q9DxN9Dz9PPQ8AD88/Tz29Dz9POQxMAEVIREjESE1IREzEQEVITUEhf4vnP4vAdGcAdH7wgOjm/4uAdKbAdH+` +
-	`L/z4m5sAAAAAAgBHAAAEhQV0AAUACQB0QBcDBAMCBB0SBQUAAwIDBAIdEgEBAAHIALgB1bMFbQYJuAG/QA4HCAoDBQABAgQFBQYACbgBmbIGBgi6AZkABwERswsgLBgrEPbtPBD9PBA8EDw8PBESOQ` +
-	`A/PP089hn05RiHLisIfRDEhwQuGCsIfRDEMTATARUJAR0CITVHBD79GgLm+8IDVQIfrf6Q/oqrm5ubAAACAEcAAASFBXQABQAJAHZAFwMCAwQCHRIBAQADBAMCBB0SBQUABcgAuAHVtAFtBgYJuAG/` +
-	`QA4HCAoDAAEFBAIBAAYBBrgBmbIJCQe6AZkACAERswogLBgrEPbtPBDtPBA8EDw8PBESOQA/PP08EPYZ9OUYhy4rCH0QxIcELhgrCH0QxDEwCQE1CQE1ARUhNQSF+8IC5/0ZBD77wgNV/eGuAXABda` +
-	`v7J5ubAAAAAQA0AAAEmQYQABYAlEA2CgsLKBIICwwICQwLCx0SDgsKDg0LCQoMDQIIDg8HHRAGbxMDHRQC5gAKCgkMDQsLAA1lFhAUuAHGQAoSDhYoBAhMAAYCuAHGtwBlCRkXICwYKxlOEPRN9Bj0` +
-	`PBDkPP08PPQ8GRDkEjkREjkSOQAYP/Q8/Tz2PP08PDw/PDw8OYcILisFfRDEhwguGCsFfRDEMTAhNSE1ITUhNSEBMwkBMwEhFSEVIRUhFQIK/roBRv66AT3+M80BbwFwuf4rAT7+wgE+/sL4jMKLAz` +
-	`/9fgKC/MGLwoz4AAAAAAEAev6MBDQEXQAWAIZAKB9AEgYbQBIGCgIDFQ4SFg4GAgoMxBIjBgsWAh8AGhgOCx8MGRc1rRgrThD0Tf08ThD2Tf08AD/t9D8/PBESOTkSOTEwAElUeUAQBxEIJRAJEukE` +
-	`EQcP6QAJCgEQPCsAKyuBAElUeUASExQEBRQEEkoEEwUVSgEUFQQDARA8EDwrACuBgQERIzUOASMiLgEnESMRMxEUFjMyNjcRBDS6TMZhIks4Lrq6dlhYpXsEXfuj8o6DEBkl/l0F0f13vZSDxAKTAA` +
-	`AAAgBA/+EEjgZOABcAJQCdQBxTCAAWHwAcBQMlBgYFAB8lFgYYJQ4LHFMKGicFuAERtyNTEhkmICwYK04Q9E395k4Q9k3tAD/tP+0/PBDtARESOQAREjkxMEN5QDwgJRkbARUIJQwmGiUQJSElFCYC` +
-	`BwBPAQEAGQ0cTwElDyMeACAVI08AAQkDTwEbCxhPACQRGB4AIhMfTwErKysrASsrKxA8KysrKysrK4GBgQEmJCsBNTMgBBIVFAIEIyIuATU0EiQzMgEyNhI1NCYjIg4BFRQWA4wn/uPkHBUBAQFsxL` +
-	`T+2q6E1W23ASOnYf7ubM6Cm3Nw0HyZBCbDyZzO/mvt3/6IxnHPda4BUr/8J44BH5V/fZL9foipAAEAW/5OBGMGEAALAHRAIQQEBQcHAwkKCiQSBAoLBAUKCwoJCykSAwMEAwsKBAUJCLwBsAAGAbIA` +
-	`AAGwQBQCCwoJBAQFAWoHGg0DPwUZDD2TGCtOEPRN5E4Q9k3kEhc5AC/t/e0SORE5EjmHBS4rCH0QxIcILhgrBX0QxIcQCMQxMAUVITUJATUhFSEJAQRj+/gB0v5FA8r9NAGb/iL4uroDWAMUnJz9Fv` +
-	`x+AAABACH+TgStBhAACwBAtQcICwUKArgBv0AKA/0ACQijBge7BLgB1EAMCgujAQC7AhkMVZgYK04Q9E30PP089PQ8/TwAL/39PDwROTkxMBMRIzUhFSMRIxEhEdu6BIy7yf58/k4HJpyc+NoHJvja` +
-	`AAAAAQAwAAAEfgRdABUAdUAXABULBBQSEh8SEREPDxQEEgahB3UMDQS4AbhAEwwLBhIREQEKEhQRDBUUHw4P5Ay4AdC0AAEfAwK4AWu1BhkWTWcYK04Q9E32PP089PQ8/TwSORI5AD88EDw/PP08EP` +
-	`TtERI5OYcFLisOfRDEABESOTkxMAERIxEjIgc1PgIzIRUjERQXIyY1EQHbuxlkcyxBYBcDartKv0UDuvxGA7pDvRAOC6P9vdegn9MCSAABAJ3+jAQwBj4ALQCwQC9YCAACCCgXGREAASslFw4UKHgC` +
-	`ISL9EUEZJQsAJVMrbQYOUxRtHR4oBwYZLox8GCtOEPQ8Tf089u0Q9u0AP+3t/f3kARESORESOTkAERI5EjkROTEwQ3lAPCwtGiQVFgMNGgocHgAbHAkIAyEFHgEgHwQFDBYOHgAtIyseABsJGR4BBC` +
-	`ACHgANFQseARYXLCQAHgAtABA8KxA8KysrASsrEDwQPCsQPBA8K4GBgYEFFjMyNzYZARA3NjMyFhUUBiMiJjU0NjcmIyIOARkBEAcGIyImNTQ2MzIWFRQGAVUOD00mJW1sjVFvSyMiQQwMEgwoRShp` +
-	`aZhWZ0ItJjwM5wlnZwFAAdYCEpycY0lGPDAsESAQBUeq/rP+EP5KoaFgSzNIMiURIQAAAAACANMDfAQCBj4AFAAgALJANyRAHgMmCBYVEAMYHgkWGwATDhUAeB4hE0wDjQkYIQoKCQAMAA4AFRYdDw` +
-	`48EhoiGyUGGSGKrBgrThD0Te1OEPZN9Dz9PAA/Pz88EO0Q/X3kGO3kARESOTkREjkAERIXOTEwQ3lAGhkdBAkdBBseABkIGx4AHAUeHgAaBxgeAQgJEDwrKwErK4GBAElUebYfIAECIAEeuAFsswQf` +
-	`AhW4AWy0ASAVAQABEDwQPCsAK4GBAQ4BIyImNTQ+ATMyHwEzERQWFyMmLwEmIyIGFRQWMzI2A0g6wldwsnXFlS1UJIwLJJsUDAFORYi9ZERIrgQkTFyTl3a3awUD/iQxW0I68v8LkIFZWGgAAgC0A3` +
-	`QEGQY+AAsAFwBjQBc3CAwhAI0SIQYADyUJGhkVJQMZGDhhGCtOEPRN7U4Q9k3tAD/t/e0xMEN5QCoBFw0LDx4BFwEVHgARBw8eARMFFR4ADgoMHgAWAgweABAIEh4BFAQSHgErKysrASsrKyuBASIm` +
-	`NTQ2MzIWFRQGJzI2NTQmIyIGFRQWAmba2Nja29jY23ycnHx7nJwDdNaPkNXVkI/WfHhxcXh4cXF4AAABACEAAAStBj4AIQBvQBwhIBQTBBoCAyAhHRQTDwwXGjkJAAEAAwICEBAPuAGwQAoREhIACh` +
-	`BqDDMXuAEoshOgIbgBKEAKHTMGagEZIjuWGCtOEPRN9P309vT95AA/PBA8/TwQPBA8EDw/7QEREjkSORESOTkAERIXOTEwKQE1MyYCNRAAISAAERQCBzMVITU+AjUQAiMiAhEUHgEXAhH+EPF9TQEW` +
-	`AQkBCQEWTX3x/g9ZaD+yo6SyP2hZso4BHa0BdgG+/kL+iq3+446ysiiV96MBOAFi/pz+y6P3ligAAAMAQP/hBI4EfAAjADAAOQEIQCx1CB4bAyQdAAMWLyU2MQ4KKAAOFyQdHjsVMjFHFRYWAygjCj` +
-	`YjEREKBy9FLrgBt0AVAxsjICADCzEWJSShFhYrMqEVdTsruAG2swYZOk25AVkAGCtOEPRN7RD07RE5L/08EDwAPzwQ7RD95D88EO0Q7RI5Lzz9PAEREjk5ERI5OQAREjkREjkREhc5ERI5MTBDeUBU` +
-	`NDghLgQaGSYiJQwlCCYtBCsqABohFzEAIwAnCyUwAQ0OJiUpCSsqADUSMzABNxA5MAAPDiwFLioALS4YIxsxAiYNKDADKgcoKgE0EzYwATgPNjADKysrKysQPCsBEDwrKysQPBA8KxA8KysrKysrgY` +
-	`GBJQ4BIyICNTQSNjMyHgEXPgEzMhYRFSEVFB4BMzI3FQYjIi4BAxEuASMiAhUUFxY3NgEzNTQmIyIGFQJQNIhAcKRvuWojTz8wSVAff6T+bC1UNGV6jXFKfFsvGC4WdXUhITNpASLZNjQ3OOKCfwEg` +
-	`4sgBK6YPFyE2EeP+sDJTlHk7T6pAM2YBXAHpERH+tsh2VVUDBwHiILB2k5MAAAAAAwBX/6MEdgS6ABYAHgAmANFAN5YDlAubFgMpCAILFxgMDAEOFh8gDQ0AAhYLDgQTBxcgGB8EIhoCFgsOBBAEFy` +
-	`AYHwQlHQwNAAC4AbpAJRIBAQwAAXUTDA11GiMHByIjEwsMDQ0lLRDPAAEBHS0EGSdOZxgrThD0Te08ETP97TwRMwA/7T/t9DwQ9DyHBS4rh33EARESFzkREhc5ABESFzkREhc5BxAOPDw8PIcQDjzE` +
-	`PMQxMBhDeUAcIyQbHBESBQYjEiUqARsGHSoAJBEiKgAcBRoqAQArKwErK4GBgYEAXRcjNyY1NAAhMh4BFzczBxYVFAAhIiYnNwEmIyIGFRQJARYzMjY1NOyVlpYBDgEAMmRPOF6WlZX++P74P3liCQ` +
-	`HQWWWfpwJY/i9gVbeYXd299/MBVQ4aIYfXwfPt/p8VNuwClzLnxpYBr/1kNfW5oAAAAAIAsP4PA/4EdAAgACwAb0AREWMSCAUaGwQgEg4lFA4gbyG4AcNAFicHBQgLABsaIAtTF/kSEhFtKiBuACS4` +
-	`AcJAChAAAQAAAQA8Ryq8AdMALgFPAVUAGCsQ9nbkcXEY7RDtEPY8EP3tEjk5ERI5OQA//eY/7TkRFzkQ7TEwAQEVFA4BDwEGDwEGFRQWMzI2NxUGIyImNTQ/Az4BPQE3IiY1NDYzMhYVFAYDPBxQTz` +
-	`4RI0JTeIZXtnm6yuDql0NNSCc7VDtUVDs6VlYCkyNSb3dUQxIrU2hMRmwuRLNbuJGQnERQSS1xZi7CVTs7VFM8PFQAAAACAdf+PgL2BHwAAwAPAFFAECAALwMCEAAfAwIAAA8DAgO4//+3AAEBAg4D` +
-	`bwq4AcNAEgQHAzxHAkwNwAcAPEcBTAcREC/E1vR25BgQ/fR25BgAP/3mPzwBODgxMAFxcXEBEyMbATIWFRQGIyImNTQ2Arwu+C9GOFdXODlXVwKT+6sEVQHpVjk6VlY6OVYAAAABAEcAmwSFAm0ABQ` +
-	`AmuQAAATxADwIDAh0BAQAaBwQZBiAsGCtOEOQQ9jxNEP08AC/tMTABESMRITUEhZv8XQJt/i4BNpwAAAABAAn+jATLBm0ACgB/QCcGBgUHBwEEAwMECgEDAgQKAAAdEgYGBwIBAR0SBgYFBgUCAAQK` +
-	`AQe4AaVAFwAFAgEDBAoHAAMIBgYLCBoMBBkLNDYYK04Q5BDmGRI5LxIXORIXOQAYL03tERIXOYcFLisOfRDEhwUuGCt9EMQBERI5ABESOTkQyYcQCMQxMAEjAQcnJQkBIRUjAo6b/tiPMwEiAQkBhA` +
-	`ETm/6MA4s2lm382QawnAABAI3+jATLBm0AGgCyQDIBBAUFABYZGhoVAQIYAAQZAhgaFg4NDhEVAxoAFgUYFQ0OHBcFAAAfEhoaFQACGgUVGrgBobIDGAJBCQG4ABcAAwETABUAAwHeAA0BCEAYESMJ` +
-	`ABUGABoCBRUXBAQbFxocAhkbLtYYK04Q5BDmEjkvEjk5Ejk5AD8/Te3l5BD2PP08EO0SORESOYcFLisOfRDEARESOTkSORE5ERI5ABESORDJDw8HEAU8PIcQxDwxMBsBIzczNz4CMzIXFhcHJyYjIg` +
-	`4BDwEhByEDjfv7IP0HG474zDJTDRsgKFE4XJZuKA8BiyD+dv3+jATZmzSw75oLAgOhBw48jMJHm/snAAAAAAIAOACyBJUDqwAeAD0AYkAK5g3mLAIZhxBGBLgBNkAPC4cARhRzL0YjH0YzOIcjuAE2` +
-	`tCqHMw8uuwHmABAALwFTsh8ePbsB5gAAAB8BD7M+ICwYKxD2PP08EP08/TwAL+397RDkEOT25O395O0xMAFdEzU0NjMyHgEfARYzMjY9ATMVFAYjIi8BJiMiDgEdAQM1NDYzMh4BHwEWMzI2PQEzFR` +
-	`QGIyIvASYjIg4BHQE4m4MhP1BQeW9GSkeAvWpjo2Z0RyhFKnibgyE/UFB5b0ZKR4C9amOjZnRHKEUqAlUSg8ENHy1DPmw/EBCnn107QipcIxL+fBKDwQ0fLEQ9az8QEKefXjpCKlwjEgACACEAAASt` +
-	`BhAABQAIAHdAGggHAgMHoxJKBQQFCAYEBQYdEkcCAgMIBgMEuAFUQB8HAgcFAwYoQAABCgIBAwEEBAgFABoKCEQgARkJVZgYK04Q9BoZTe0YThD2PBE5PS8YEjkQPAA/PBpN/Rc8EP08ETkHBRB2Kx` +
-	`gIEDwHBRB2KxgIEDwxMCkBNQEzASkBAQSt+3QB280B5PwcAwv+fboFVvqqBFEAAAACAJkAPgRjBB8ABgANAF5AHAoD3QkCpwsEpwYMBd0NBgIG4AUgBQPCBGNAAQC4AclAEgsJDeAMIAwKwgtjCAdt` +
-	`DjTUGCsQ9jz99DwaGRD9PBgQ9jwa/fQ8GhkQ/TwAGC88GfQ8EP08/Tz0PDEwATUBFwkBBwE1ARcJAQcCcgGAcf7IAThx/KcBgHD+yQE3cAHhmwGjbf59/nFiAaObAaNt/n3+cWIAAAACAGoAPgQ0BB` +
-	`8ABgANAGRAHQUM3Q0NBqcLBKcCCgPdCQIGAuADIAMFwgRjQAABuAHJQA8LCQ3gDCAMCsILYwcIeA+6AVIBVQAYKxD0PP30PBoZEP08GBD2PBr99DwaGRD9PAAYLzwZ9DwQ/Tz9PBD0PDEwARUBJwkB` +
-	`NwEVAScJATcCWv6BcQE4/shxA1n+gHEBOP7IcQJ8m/5dbQGEAY5i/l2b/l1tAYQBjmIAAwA9/9EEjwDwAAsAFwAjAEy/AAYBwwAAABIBwwAMAB4Bw7YYGAwMAAshuAHCshuaFbsBwgAPAAMBwkAJCZ` +
-	`oPDyUkICwYKxESOS/27RD99u0APzwQPBDtEO0Q7TEwFyImNTQ2MzIWFRQGISImNTQ2MzIWFRQGISImNTQ2MzIWFRQGzTlXVzk4V1cBYTlWVjk5V1cBYTlXVzk5VlYvVjo6VVU6OlZWOjpVVTo6VlY6` +
-	`OlVVOjpWAAAA//8AIQAABK0IAAIiACQAAAADAEMAJAGy//8AIQAABK0HugIiACQAAAADANkACQGy//8AR//RBIUHugIiADIAAAADANkAAAGyAAIAJP/gBKAGLwAaACcAqEANKAgCJQEQER8bHAEaGb` +
-	`gBsLQAAQgTFLgBsLQSEQIWFbgBsEATGBgXFwUfOQ0DJTkFCRQZKQEBEbgBiEAPFz8TPwAaKSIzCRkoO38YK04Q9E3tThD2TfTk/TwQ/TwAP/0//RI5LzwQ/Tw/PP08Pzz9PAESOTkAERI5ERI5MTBD` +
-	`eUAcICQGDAclCyYkBiIrACAMIisAIwglKwAhCh8rASsrASsrKyuBgSkBNQ4BIyImAjU0EjYzMhYXNSEVIREhFSERIQERECYjIgIRFBIzMjYEoP3ZREcSc9Vwe85yIUYzAhj+owFN/rMBbP3ZS0iDdW` +
-	`+NRkknPAu8AY/w/AF1oxQyJ5z96Zv96QFeAfUBCY3+j/7p+P5ojQAAAwBD/+EEiQR8ACEAMQA6ARNAQHsIGAAcAxUvDSc3MicsAA0iGBYVAzQ6HB08FDMyRxQVFR8pIygjCjcjEBAKBx0aHy8jAxoj` +
-	`Hx8DCzqhIiI7PDS9AbYAFAEPADwALAG2swcZO025AVkAGCtOEPRN7RD27RESOS/tAD88EO0Q7RESOT88EO0Q7e0SOS88/TwBERI5ORESFzkSOTkROQAREjk5ERIXOTEwQ3lAXCA5ARkFJRgmJSQmJA` +
-	`IGMAIiMQEBLgQsMQAZIBYxACEAJwsjMQEMKgksMQA2ETQwATgPOjAADg0xAS8xAi0GLzEAFyEaMQIkDCgxAycoKwgpMQE1EjcwATkONzADKysrEDwrKysrARA8KysrPCsQPCsrPCsqKyuBgSUOASMi` +
-	`JgI1NBIzMhYXPgEzMhYRFSEVFB4BMzI3FQYjIiYDNTQmJy4BBw4BFRQWMzI2EzM1NCYjIgYVAnE7gzZYjlSZtlB2Jyx2Snen/mwxVTJndY1uVY1xDRISRSlVUk9cQ1i62jwvMD+Hbzd+ASO42QFpU1` +
-	`NTU+H+uj49snU3Tq08QQHLZn9mMzJHAwX4xKb+rAFzIblsi5kAAAEARwIQBIUCqwADABpADAEdAAIaBQAZBCAsGCtOEOQQ5gAvTf0xMBM1IRVHBD4CEJubAAABAEcCEASFAowAAwAaQAwBIgACGgUA` +
-	`GQQgLBgrThDkEOYAL039MTATNSEVRwQ+AhB8fAAAAgC0A5MEGQZtABEAIwA9QCEBE+BHEgsdSQUXXwASEABzCAVjCGQOmhJzGhdjGmQgJSQvzNbt7RDm9u3tEOYAPzz9PP08EHb9PBgxMAEVDgIVMh` +
-	`YVFAYjIiY1ND4BJRUOAhUyFhUUBiMiJjU0PgEEGUhLLVxZYkFVeWCl/o5ISy1dWGJBVHpgpQZtfRNGbU5pO0ZfkX5vwooQfRNGbU5pO0ZfkX5vwooAAgC0A5MEGQZtABEAIwA3QB4TAeBHEgBfFwVJ` +
-	`HQsQAHMIZAVjDpoScxpkF2MgJSQv1Nbt/eb27f3mAD88/Tz9PHb9PBgxMBM1PgI1IiY1NDYzMhYVFA4BBTU+AjUiJjU0NjMyFhUUDgG0SEstXVhiQVR6YKUBckdMLV1YYUFVemClA5N9E0ZtTmk7Rl` +
-	`+Rfm/CihB9E0ZtTmk7Rl+Rfm/CigAAAAABAagDkwMkBm0AEQAjQBILSQVfAeBHABAAcwhkBWMOExIv1Nbt/fYAP3btGP39MTABFQ4CFTIWFRQGIyImNTQ+AQMkSEstXVhiQVR6YKUGbX0TRm1OaTtG` +
-	`X5F+b8KKAAABAagDkwMkBm0AEQAjQBIB4EcAXwVJCxAAcwhkBWMOExIv1Nbt/eYAP/30du0YMTABNT4CNSImNTQ2MzIWFRQOAQGoSEstXVhiQVR6YKUDk30TRm1OaTtGX5F+b8KKAAADAEcAFwSFBC` +
-	`cACwAPABsAP7kABgHDswBtDA+4Ab+zDQ5tFrgBw7UQCgxfCRm4AcJACQMTXw4ZHCAsGCtOEPRN9Dz9POQAP/32PP089u0xMAEiJjU0NjMyFhUUBgUVITUBIiY1NDYzMhYVFAYCZjlWVjk5V1cB5vvC` +
-	`Ah85VlY5OVdXAwhWOTpWVjo5VpucnP2qVjo6VVU6OlYAAAACAEcAAASFBhAAAwAHAKVANgUEBQYEHRIABAcAAwYFBgcFHRIDBQQDAgcEBwYEHRIABAUAAQYHBgUHHRIBBwQBAgQBAAYFArgBj7MDBQ` +
-	`cBuAGPQAkgAAoHBAEFBgO4AdWzAgAGALgB1bUBGQggLBgrThD0GU30PDw85BE5ERI5ABg/Ghn+PDw87hE5ERI5hwguGCsIfRDEhwguGCsIfRDEhwguGCsIfRDEhwguGCsIfRDEMTAhCQYCZv3hAh8C` +
-	`H/3hAWX+m/6bAwgDCPz4/gACAAHw/hAAAP//ADH+LgS+BgACIgBcEQAAAgCOMAAAAP//ADQAAASZB7ICIgA8AAAAAwCOAAABsgABAAL/0QTMBj4AAwA7QBgCAQEiEgAAAwABCQMCAwABAgQDGgUCGQ` +
-	`S4AVKxNhgrGU4Q5BDmERI5OQAYPzw/PIcFTS4rfRDEMTAXIwEzpqQEJaUvBm0AAAAAA/+yAAAE1AUoAAMABwAdAHRBKQAFAfgABgH4AAEB+AACABAAEgH4ABMAFQH4ABAACAAdABsB+AAKAAQB+QAH` +
-	`AfkAAAH5AAMAHQASAfkAEwAdABgAAgAGAfkAAQAFABgB+AANAB4Q1u3dPPU8EN089RDV9f31AC/91cUv/dXtEN399f0xMAEhNyEDITchEwYjIAAREAAhIBcHJiMiABUUADMyNwQn+4swBHOM++kwBB` +
-	`errf/+6P6EAXwBGAEYxDC39eb+wAFA5vqyAstu/rZu/jiVAXwBGAEYAXzEb8X+wObm/sDJAAAAAAEBSwAAA7AEXQAGAGBAMgsABAEbABMBKwAkATsANAFMAEQBWwBUAWsAZAEOAdsA2wRAA+ACRARE` +
-	`BeAgBgYC3QUDuAGDQAkEYwEAGQdxkRgrThD0PE399DwZ9DwAGC8aGe39/e0aGBDt7TEwAF0BNQEXCQEHAUsB9XD+UwGtcAHkoAHZdv5K/kJzAAAAAQEdAAADggRdAAYAY0AyAwAMARIAHAEiACsBNA` +
-	`A7AUQASwFUAFsBZABrAQ4B2wDbBEAF4AZEBEQD4CACBgLdBQO4AYOzBGMAAbwBMwAIAe0B7gAYKxD2PP30PBn0PAAYLxoZ7f397RoYEO3tMTAAXQEVAScJATcDgv4LcAGt/lNwAnmg/id2AbYBvnMA` +
-	`AAAAAQAZAAAEVwZtABkAXLMMDhQDuAG4QCEXAgIWDgyhCwsOIwkBGRYKDLUUFxYfFRUUGhsFAB8ZGRK4ATi1AhkagH4YK04Q9E39PBD9PE4Q9jxNEP08EOQAPzw/7TkQ7RESOS88/TwROTEwIREjNT` +
-	`M1ND4BMzIXFSYjIg4BHQEhESMRIREBEfj4ZNGsgoaRcFmITQKMuv4uA2WbNN3UiBuxMEKVskj8AANl/JsAAAEAAgAABH4GbQAcAGG3EBMDDA4PGQO4AbhADhoCAg8TIwkBDAAcDwoauAFgQAwQDx8N` +
-	`DhoeFxwfBQC4ASeyAhkduAEGsWcYK04Q9E30PP08ThD2PE39POYAPzw/P/0ROS88/TwBERI5ABESOTEwMxEjNTM1ND4BMzIWFzMRIxEuASMiDgEdASEVIRHb2dlk0apDhUK6ukiBOFmITQGc/mQDZZ` +
-	`s03dSIDxD5sgWhGBhClbJIm/ybAAABALT+jAQZBhAAEwCvQA8QBUYdEQSkA0ATAkYdEgO4ATVAEAAPBkYdDgekCEAMCUYdDQi4AdOyCg4SuAEzQBIAEw8MRh0LAKQBQAIGCUYdCgG4ATO2BwMZFDhh` +
-	`GCtOEPQ8TfY8S1JYsAAaWfw8PBoQ/TxLUliwABpZ/Dw8EPY8AC/2PEtSWLAAGhuwQBpZ/DwaEP08S1JYsAAaWfw8L/Q8S1JYsAAaG7BAGln8PBoQ/TxLUliwABpZ/DwxMAEjEwU1BREFNQUDMwMlFS` +
-	`URJRUlAtjgIf6bAWX+mwFlIeAkAWX+mwFl/pv+jAGZJeAdAxob1iABmP5oINYb/OYd4CUAAQHDAXwDCQLCAAsAGEALAHAGA14JGQwySxgrThD0Te0AL+0xMAEyFhUUBiMiJjU0NgJmRl1dRkZdXQLC` +
-	`ZT4+ZWU+PmUAAAAAAQGo/k4DJAEnABEAJ0AVASEAXwtJBQsFYw4BTA5yCBkSMksYK04Q9E3t5BD9AD/t9O0xMAE1PgI3IiY1NDYzMhYVFA4BAahISycGXVhiQVR6YKX+TnwTR15caTxFX5F+bsOKAA` +
-	`IAtP5OBBkBJwARACMASkAPEwHgRxIAXwUdC0kXBQsAuAE2tAhkBWMOugHSABIBNrYXYxpkIBkkugFSAVUAGCtOEPRN7e3t9O3t/QA/PP08EPQ8dv08GDEwEzU+AjciJjU0NjMyFhUUDgEFNT4CNyIm` +
-	`NTQ2MzIWFRQOAbRISycGXVhiQVR6YKUBckdMJwZdWGFBVXpgpf5OfBNHXlxpPEVfkX5uw4oPfBNHXlxpPEVfkX5uw4oAAAAGAAIAAATMBhAACwAXABsAMwA/AEsAwkAnpxOnF6c7pz+nR6dLxjMHBR` +
-	`vAHAIaGRkiEhgYGygcGBlARhobDBIMuAFesgCNErgBXrIGmkC6AV4ANAFeszEfjUa6AV4AOgFeQDUrJQoYGQ8JGhtNIjchAC5ALgIuRBwoQyEAPUA9Aj1ESSEiGk0VIQ8DTwMCA0QPIQkZTDQ2GCtO` +
-	`EPRN7f1d7U4Q9k3t/V39OTn9Xe0REjk5ERI5OQA/PO3t/Tzt7fbt/e0REjk5ERI5OTk5hy4rfRDEMTAAXQFdATIWFRQGIyImNTQ2FyIGFRQWMzI2NTQmATUBFQE+ATMyFhUUBiMiJicOASMiJjU0Nj` +
-	`MyFgciBhUUFjMyNjU0JiEiBhUUFjMyNjU0JgEZeZ+feXmennlVRkZVVUZG/pQEyv4SMmo5e56iczFzNSZsRnefmoJCabBWRUVWVUZGAVZWRUVWVUZGBhC3rq63uK2tuG2dW1ydm15dm/wmfwMmg/1u` +
-	`RC24rbKzJ0s5Obavqbw4NZxcXJyaXl6anFxcnJpeXpr//wAhAAAErQgAAiIAJAAAAAMA2AAAAbL//wDLAAAEEQgAAiIAKAAAAAMA2AAAAbL//wAhAAAErQgAAiIAJAAAAAMAjQAkAbL//wDLAAAEEQ` +
-	`eyAiIAKAAAAAMAjgAIAbL//wDLAAAEEQgAAiIAKAAAAAMAQwAvAbL//wCsAAAEIAgAAiIALAAAAAMAjQAnAbL//wCsAAAEIAgAAiIALAAAAAMA2AAAAbL//wCsAAAEIAeyAiIALAAAAAMAjgAAAbL/` +
-	`/wCsAAAEIAgAAiIALAAAAAMAQwAnAbL//wBH/9EEhQgAAiIAMgAAAAMAjQAnAbL//wBH/9EEhQgAAiIAMgAAAAMA2AAAAbIAAgBVAAAEhQTbACsAOQBlQDYAByQRLCofFhIDGyM0LCklEAwFLjIABw` +
-	`M5MiQ8Kh4RPBcLCjJMLqU0NAcbA7sHGjsbGTogLBgrThDkEPZN9BESOS/t5AA/POTdPOTFzQEREjkREhc5EjkRFzkAETMREjk5MTABDgEVFB4BFw4CIyIvAiYjIg8CBiMiLgE1NDYzMh8CFjMyPwI2` +
-	`MzIDFhUUDgEjJjU0PgE3NgRkUjsjQEsdemMtJD0THyEoKTMYHiQkTK5Zt505MhcYIBMcHCAXOEShnAJIdEYDP2xEBgNCOXNDNVhPJ1WxShcHDQoSCQwO1fV9odYVCQoNCwwJFQEdFgw+hEkYCzV1Ug` +
-	`sB//8AR//RBIUIAAIiADIAAAADAEMAJwGy//8Afv/RBE8IAAIiADgAAAADAI0AJwGy//8Afv/RBE8IAAIiADgAAAADANgAAAGy//8Afv/RBE8IAAIiADgAAAADAEMAJwGyAAEAnQAAA8QEXQAHADpA` +
-	`DQECJgQDBgYFJgcACga4ATK0BAUfAQC4ATKzAhkILrkBAAAYK04Q9E30PP085AA/PP08Pzz9PDEwIREhNSERIRUB0/7KAfABNwPCm/w+mwAAAAEBQAUIA44GTgAGAGVAOxYCGQW5A7YEyQPGBAYpAy` +
-	`cEAgECAwMdEgAAAQYFBAQdEgAABgUCAQAEAwZJBAQDAAEDAgQGBcEAwQIHGU4Q1BhN/e05ORI5OQA/PBD9ERc5hwUuK4d9xIcuGCuHfcQxMAFxXQEHIxMzEyMCZ4yb6XzpnAWwqAFG/roAAAAAAQEh` +
-	`BQcDrQYIABgATkAvCxILEwsUGxIbExsVBhkUGRYZFwMGEwQQExEGBQQMAQwEpYABTBAMGhoBGRlckRgrThDkEOYAL03kGv08ARESFzkAERI5OTEwAF0BXQEjNDYzMh8BFjMyNTMOAiMiLwImIyIGAZ` +
-	`59Z04+QCpTITx/BipXNEFMLBgiHxYsBQiEfCgZNHVmXj0xHBEXLgAAAQFPBQgDfgWEAAMAGkAMASIAAhoFABkEcZAYK04Q5BDmAC9N/TEwATUhFQFPAi8FCHx8AAEBQAUIA44GTgAPACpAFQshA7sG` +
-	`BgAABiEHGhEAIQ8ZEF2QGCtOEPRN7U4Q9k3tAD88EPTtMTABHgEzMjY3Mw4CIyIuAScBwAliPT1gCYAMWX5DQ39ZDQZOTXV3S2+JTk6JbwABAewFVgLhBksACwBduAAyK7oACQADADUrQQUA2gADAO` +
-	`oAAwACXUEbAAkAAwAZAAMAKQADADkAAwBJAAMAWQADAGkAAwB5AAMAiQADAJkAAwCpAAMAuQADAMkAAwANXQC4AAYvuAAALzAxASImNTQ2MzIWFRQGAmczSEkyMUlIBVZHMzNISDMzRwAAAAIBrAUI` +
-	`AyAGfAALABcAMUAYAFZGDHIGVkYSAQNWRhVyCVZGDxkYMksYK04Q9E127Rj9du0YAD927Rj9du0YMTABMjY1NCYjIgYVFBYXIiY1NDYzMhYVFAYCZic2NicmNzcmTmxtTU5sbAVlNyYmNzcmJjddcE` +
-	`pLb29LSnAAAAAAAQG8/k0DEQAAABIAXkAdFggJBwoMARERCgEPEgdWDG0RYxIKCpoSBCEPdAC4ARi1EhkTydQYK04Q9E39/e0Q5gA//fbtARESORI5ABI5ETkSOTEwQ3lADg0OBQYNBg8eAQ4FDB4A` +
-	`KwErgYEhBx4BFRQGIyInNRYzMjY1NCc3Aq1Ja0JYXlNMOj0jOLptgxVZMDdbHFkfKhdEE8UAAgGdBQgDvAZOAAMABwBHQBEABAIBBUkHBwYGAwMCAAbgB7gBbrcE4AVtAALgA7gBbrcA4AEZCMaRGC` +
-	`tOEPRN7f3tEPbt/e0APzwQPBA8EP08ETk5MTABIxMzEyMTMwIRdI57fXaRfgUIAUb+ugFGAAEBvP5OAxEAAAAQAFJACxYICQsIEAYICRIAuwGDAAsAAAEiQAsQDiUDcxAZEclLGCtOEPRN9u0Q7QAv` +
-	`9AEROTkAERI5EjkxMEN5QA4MDQQFBQwDHgAEDQYeACsBK4GBIQ4BFRQWMzI3FQYjIiY1NDcDEVpvOEEjLUI9XXmUKpYwHzgTYR1kTXmIAAAAAQFABQgDjgZOAAYAZUA3tgO5BMYDyQQEBgUEBB0SAA` +
-	`AGAQIDAx0SAAABAAMBBEkGBgUFAgIBAAQGBQEDAsEAwQUZB12QGCsZThD0GE397Tk5Ejk5AD88EDwQPBD9ETk5hwUuK4d9xIcuGCuHfcQxMAFdATczAyMDMwJni5zpfOmbBaao/roBRgAAAAABAAIA` +
-	`AAQhBhAADQCQQBoDAgcECwYCBwUKDAQLCA0JBQoIDQkKCwoFBbgBtLUSBAQLBQS4//O2AgoLDQIFBLsBzAALAAoBy7MHAgANuAGwtwECCAUCBAoAugGBAAsBKLUIDSQCAge4ASa1BBkOaJIYK04Q9E` +
-	`30PBD9PPTkORESOQA/PP08P/Qy/TIXOBc4hy4rh33EABE5Dw8PDzEwJRUhEQc1NxEzETcVBxEEIfy62dnK4eGrqwK5TqBRArT9l0+kT/2oAAAAAAEAdgAAA9sGTgAPAJBAIAEABQILBAAFAwoJAwoI` +
-	`DQwCCwgNBgcAAg4PEQoLCgMDuAG0tRICAgsDAr0BPgALAAoBzwAGAbiyBwANuAG4tgAKDsQNCwq4Ac5ACQgNHwUGxAADAroBzgAAAc2zEDXWGCsQ9vY8EOQ8/Tz2PBDkAD/tP+0Z9DIY/TKHLiuHfc` +
-	`QBERI5ORESOTkPDw8PMTAhEQU1JREhNSERJRUFESEVAcT+sgFO/rICCAFd/qMBXQK3gaiBAlOc/VmFqIb9nZsAAP//AJ3/0QRPCAACIgA2AAAAAwDhAAABsv//AKj/4QQ0Bk4CIgBWAAAAAgDhBwAA` +
-	`AP//AHoAAARTCAACIgA9AAAAAwDhAAABsv//AIUAAAQ/Bk4CIgBdAAAAAgDhAAAAAAACAhX/0QK4Bj4AAwAHADFAGQONAm8HjQUAAAECAwMGBx0FBAQBGQiM1BgrThD0PBA8Tf08PBA8EDwAP/327T` +
-	`EwBREzEQMRMxECFaOjoy8C2v0mA5QC2f0nAAAAAAIAAgAABH4GEAAOAB0Ad0AoKwgQ4wAZSAUbAyccAgIABQIACBwcHh8UMwoaHxkPJAUAnQIZHmiVGCtOEPRN9Dz9PE4Q9k3tERI5LwA/PxI5Lzz9` +
-	`PBDtEO0xMEN5QB4HFwwmEiUIJRYmEQ0UKwEXBxQrARMLECsAFQkYKwErKwErKysrKyuBMxEjNTMRISAWEhUUAgYjJzMyNhI1NC4BKwERMxUjnZubAXABEdqGhtrn0IfbmVNTkdOX+PgCypsCq5r+g/` +
-	`Xo/pSwq38BFuTf/XT98ZsAAgBX/+EEdgbQACEALQEIQCSEAgE1CAYODw8FAxEQBAAfKAMGBREJDQoOEQ0DAAQlKxAPBQW4AblACxIEBBAGBA4KCQUEuAEPsigjH7gBD7cECZ4LCwoQD7gBHEAQCgAE` +
-	`ByIjGAsJKwoGDg8EBbgByrIKEA+6AXMAJQG2sxUaLwq6AV4AKwG2tRsZLk5nGCtOEPRN/e1OEPZN7f0yEPQyETk5ERI5AD/tPz/0MhA8EO0Q9u3+MhESORI5hw4uK4cOfcQBERIXOQAREjkSORESOR` +
-	`ESOQcOPDwHEA48PDEwGEN5QCYpLSMkFh4dJiMXJSoBLRkrKgApHisqACQWIioALBoiKgAqHCgqAQArKysBKysrK4GBgQFdAS4BJwcnNyYrATUzMhYXNxcHHgESFRAAIyIANTQ+ATMyFgMyNjU0JiMi` +
-	`BhUUFgN2JnV54We1gIoZIne4ZddnspafU/7s/u3+4IT0hjh+pJO6upOUuroD32GmWsx1oSicFivDdKB5/f69nv7a/qIBPfqn9okb/FnjsbDj47Cx4wD//wA0AAAEmQgAAiIAPAAAAAMAjQAnAbL//w` +
-	`Ax/i4EvgZOAiIAXBEAAAMAjQCFAAAAAgCwAAAEbgYQAA4AGABxQAkpCA8YFAEOSBC4AYRAFgIGSBjsAwICCBQzChoaBAEkAwIZGVu5AQQAGCtOEPQ8Tf08ThD2Te0APz/97RD97QEREjk5MTBDeUAc` +
-	`BxYMJhIlCCURDRQrARYHFD4BEwsQKwAVCRc+AQArKwErKysrK4EBESMRMxEzMh4BFRQCBCMnMzI+ATU0JiEjAXrKyrju2nSc/unvUle7tmKv/v55AS/+0QYQ/vhrzn6W/v6Km2C3aYadAAIAif5OBF` +
-	`cGEAATAB8AqEAUH0ADHSkIFRQAAx0XFBUaAA9FCxO4AYBADR06AwcXIwsLEQ4GLRq4AYlADQASER8TEBAAGSDDfhgrThD0PBA8Tf08EPTtAD8/7T/t5BDkARESOTkAERIXOTEwQ3lAHhgcBAoIBwkH` +
-	`AgYYChoqARwEGjEBGQcXKgAbBR0xASsrASsrKoGBAElUeUASHh8BAh8BHUoFHgIUSgAfFAEAARA8EDwrACuBgQE+ATMyFhUQBw4BIyInJicRIxEzGQEWMzISNTQmIyIGAUNW7mSM4KZOwo40bBAgur` +
-	`plVannflBX0QNijI7y9v7exlxgCwID/k4Hwvx1/iEaAQnmp6iwAAAAAQBHAdEEhQJtAAMAGkAMAB0CABoFAhkEICwYK04Q5BDmAC9N7TEwARUhNQSF+8ICbZycAAEARwAABIUEPgALAJ5APQEFAAIJ` +
-	`BAUAAwgHAwgGCwoCCQYLCwAFBR0SBgYLCQgDAx0SAgIJCwAFBR0SBgYLCQgDAx0SAgIJBQYGCQi4AZlAFwIDAgILAAoLAAAJCPkFBgYDAhkMOGEYKxlOEPQyPBEzGE39MjwRMwA/MzwRMxD9MjwRM4` +
-	`cOLiuHDn3Ehw4uGCuHDn3Ehw4uGCuHDn3Ehw4uGCuHDn3EDw8PDyEJAScJATcJARcJAQQU/lP+UXEBs/5NcQGvAa1x/lABsAG0/kxwAa0Btmv+UgGua/5K/lMAAQENAoQD/QYnAAwAQ7cD4AR4BgQJ` +
-	`DLgB5kAKCxQOFxcaCcIGCLgB87cMwgPCARkNybkB4AAYK04Q9E3k5P055EVlROZNAD/9PD8Z9BjtMTABEQYHNTY3MxEhFSE1AkORpbaEiAEu/RADAAKXUSl+Nlb82Xx8AAAAAQDuAoQD3gY+ACAAYb` +
-	`QWCAJ0ILgB5rMBABQQuAHmshFRDbgB5kAJEwUATAqHFkwAuAFEtx+HEUYBGSG9uQEBABgrThD0TeTt/fTt5AA/7fTtPzz95TEwQ3lADhQVCwwMFAoeAQsVDR4BKwErgYEBITU0Nj8BNjc2NTQmIyIG` +
-	`BzU2MzIWFRQHBg8CDgEHIQPe/RBihlSRPTyLRjWJmLG9sqIxMYxbTEhcBwJPAoR0O4dkPm02NSsyMQ4rfTh1WUJBQmVBNjVdNQABAZACbQSQBj4AJgDIQAlHCB8MCR8PCwm4Aea0DAwSAwG4Aea0QA` +
-	`BGEQO4AeayJRUUuAHmtEAVRhESuAHmQBYXBQ8lHFFGBiUiGigL3RVGABknxtUYK04Q9E305E4Q9k3tdvQY7QA/7UtSWLAAGln8Gu0/7UtSWLAAGln8Gu0REjkv7QEREjkAERI5MTBDeUA1GCQEERob` +
-	`GRsCBhEYDx4BBCQGHgEIIAYeAw0eDx4DEBsSHgEFIwMeAAchCR4BIA4dDB4AHh8QPCs8KysrASsrKysqgYEBNRYzMjY1NCYrATUzMjY1NCYjIgc1NjMyFx4BFRQGBx4BFRQEIyIBkLONgqPHx1Nymp` +
-	`d7dISdnn/UYSgxTF1veP7/06AClY87ZUdVVHxgOjA+K4QjQxtVKjV0KB19WImoAAADAAL/0QTMBj4ACgAOAC8BC0AnuRT2HvYfA7AUuygCKwgGBQUiEgQEAwsMDQ0dEg4OCxEuDx8hHAEJuAHmQBUA` +
-	`CvcDhwcEhwV4BgYHAg0OAwsMDRy4Aea0IfcPLy64AeZAFBAQDwgEAAYIAg2HDjwkLocREFEeuAEztBklJCQPuAFJQBUCC4cMDAAK3QcIHQMCiwUAGTA0mBgrThD0PE30PP085BA8EO0Q/TwQ/fb0PO` +
-	`0Q9O0REjkSOQA/PBD9PBD97T88Pzw/PBkQ9BjtEO39PP08ERI5ERI5hwUuK4d9xIcELhgrBX0QxDEwGEN5QBwiJxYbGyIZHgEXJhkeARojHB4BGCUWHgAmJxcWABA8EDwrKwErK4GBAV0AXRM1MxEH` +
-	`NTczETMVASMBMwMhNTQ2PwE2NzY1NCYjIgc1NjMyFhUUBwYPAQYHDgEHIQLp6emjdP6goAQiqC/92WJ4PFYQEFIyUnCGX3eFNTVbLTckEhkMAYQDCHwB8pubmv10fPzJBm35wnwef1UqPRoZITMoPI` +
-	`I2dVpJOjs7HiQiESEmAAQAAv/RBMwGPgAKAA4AGQAcAQNAOhccNxM1HAMPCQEIBF8EAhoTJhMCBgMEBCISBQUGDA0NHRIODgsbHBwiEhMTFBMbFRwbYxQV9xAaFxy4AeZAEhgSeBAKGBJ4DwgLDA0N` +
-	`DgMJAbgB5kArCgD3BwSHBXgGA4cHAgEFBAMABggCHBMTEl8bEB0PDYcODhh4DxRtFRUWD7gBx0ATCguHDArdCB0DAosFDAAZHTQ2GCtOEPQ8PE30PP3kEO0Q9Dw8EOYQ9DwQ7RD9PPQ8EjkREjkSFz` +
-	`kAP+08GfQY7RD9PP08Pzw/PD/0PD/0PP08PBD9PO0REjk5hwUuKwR9EMSHBS4YK30QxIcELhgrhwV9xDEwAHFdAV1xEzUzEQc1NzMRMxUBIwEzAyM1ITUBMxEzFSMnEQMC6enpo3T+pKQEIamUm/5l` +
-	`AZublJSb9QMIfAHym5ua/XR8/MkGbfnCm3wB6f4XfHwBJf7bAAQAAv/RBMwGPgAkACgAMwA2APFAJHobASYnJx0SKCglNTY2IhItLS4bHhkcJB4KAgsIDS01LzYTJLgB5kALDwJPAl8CAwICDR64Ae` +
-	`ayGfcIuAHmQBANAicoAyUmDTVjLi/3KjE2uAHmQBoyLHgqChMFEDYtLF81Kh0oeDJ4KS5tLy8zKbgBTUANGwUlEEYhJRanGyWHJrgBEkAOLwE/AQIBPAsbGTcgNhgrThD0PE3kXfTtEP3t9O0Q/Tw8` +
-	`EOYQ5OT9PPQ8ORESOQA/9Dz9PBD9PO0/PD88P+397RI5L3H9ORESOTkREjkRORESORESOYcFLisEfRDEhwUuGCt9EMQxMABxEzUzMjY1NCYjIgc1NjMyFhUUBgceARUUBiMiJzUWMzI2NTQmIwMjAT` +
-	`MDIzUhNQEzETMVIycRA35Rij07REWChG2Cbjo7RkaWlGdnj0k2T1mNIaQEIamUm/5lAZublJSb9QROfEkhIT4oeit+RTdbJBxVOlSQIYovNSouPfuDBm35wpt8Aen+F3x8ASX+2wAAAQAJAAAEOAYQ` +
-	`ABEAXLcMDxEHCB0GBbgBk7QLDB0KCbgBRkAeAA4DHQ8CiwAKDw8SEwtRBhoTCBEoBQCLAhkSNHwYK04Q9E30PP08ThD2TeQREjkvAD/0PP08EP08/Tz9PP08ARESOTEwMzUjNTMRIRUhESEVIREhFS` +
-	`EV4tnZA1b9ZAJd/aMBJ/7Z2ZsEnJz98Zv+qpvZAP//AHb/0QRACAACIgAqAAAAAwDbAI8Bsv//AHb+LwREBk4CIgBKBAAAAgDbQwAAAP//AKwAAAQgB5kCIgAsAAAAAwDc//kBTgABAJ3+TQRPBj4A` +
-	`OAEQQDtCCA4PDw0gISIfMC4xMygAOCMNBhAZHzgoAAMxJys2HyIiJBINDQ8iHw8NBBkGBOMDagAX4xZqFC62M7gBHUAlOJ0ABkgnGTkUAxYCJwkACQ8fMQ02IgorhTY2OToXgwozJBo6MbgBC0AKHD` +
-	`MRPwMZOVp9GCtOEPRN9P3mThD2Tf3kERI5L/0RORE5Ejk5AD8/Pz/tEO0Q9PbtEPTtEPTtERIXOYcOLit9EMQBERI5Ehc5ABESORESORESORI5ETkHDjw8BxAOPDEwGEN5QC40NSwtJSYaGxITBwkI` +
-	`JRoTHD4ANC02dwEHJgo+ARsSGT4BNSwzdwAJJQY+ACYnABA8KysrASsrKyuBgYGBgYEFIiYnNRYzMj4BNTQmLwIkNTQ2ITIXFSYjIgYVFBYfAxYVFAYPAR4BFRQGIyInNRYzMjY1NCcCGDmomvDEUp` +
-	`ZNapJmZ/7w8wECppurooGXgFFOYVL/2fAwa0JYXlNMOj0jOLovEj6+cj92RVGNVTs5nvSv7zCtQohgW4cwLzswnO+m8RlXFVkwN1scWR8qF0QTAAABAKj+TQQ0BHwAPgEbQEJYCA8QEA4hIiMjIDc5` +
-	`LgA+Pi4AAzctMTwOEBAfEiAgIyMgEA4EGwc+PjQABKEDtQAZnhi1GyMWBxgGB54tsAALNjm4ARRAETQOEB4gNw4jMZs8PD9AGbUKuAG2sycaQDe6AQ8AHgG2txNpAxk/Lq0YK04Q9E30/eZOEPZN7e` +
-	`QREjkv7Tk5EjkROQA/7Tk/9u0/P+307RD07RESOS8REhc5hw4uK30QxAEREjkSFzkAERI5EjmHEA7ExAcQDjwxMBhDeUBAOjsyMyQsHB0UFQgNKSgqKCsoAwYcFR4wADozPDABCCwKMAEMJQowAR0U` +
-	`GzABOzI5MAAJKAcwAAsmDTABJSQMDQAQPBA8KysrKwErKysrKoGBgYGBgQUiJic1HgEzMjY1NCcmLwIuATU0NjMyFxUmIyIGFRQfAxYXFhUUBgcOAQ8BHgEVFAYjIic1FjMyNjU0JwI2UuJacPpXjX` +
-	`wdHXPATX101e+zoLiie4BeWD5+sUREQzcvbnk6bEJZXVNMOjwkN7keMifFSTtbRC0gIC9OHTKIZIqyNKlCVzlOJyUaLkJJSW5OgichKApmFVkwN1scWR8qF0QTAAD//wB2/9EEQAgAAiIAJgAAAAMA` +
-	`jQDdAbL//wCk/+EERwZOAiIARgAAAAMAjQCuAAD//wB2/9EEQAgAAiIAJgAAAAMA4QCHAbL//wCk/+EERwZOAiIARgAAAAMA4QCHAAAAAgB2/+EE/gZOABoAJgC0QBcfQCQDJQgcGwADHiQcGw4DGR` +
-	`oWEUcXELgBGUAkDkUKEwAeIwoHGgokOgMLEOQaF+QZExofFBkaKCEtBhknNVAYK04Q9E3tThD2PE39PBDkEOQAP+0/P+0/EOT2PP08ARESFzkAERIXOTEwQ3lAGh8jBAkIJiMEITEAHwkhKgAiBSQx` +
-	`ACAHHioBKysBKysrgYEASVR5QBIlJgECJgEkSgQlAhtKASYbAQABEDwQPCsAK4GBJQ4BIyImNTQSNjMyFxYXNSM1MzUzFTMVIxEjGQEmIyICFRQWMzI2A4lV72SM35T4tzVrECC6uru6urtjVazlfV` +
-	`BX0vuMjvL2wgExsQsCA4yL2tqL+xcB2AHfGv725aapsAAAAP//ACEAAAStBvICJgAkAAAABwDaAAEBbv//AGb/4QReBVcCJgBEAAAABgDaANMAAP//ACEAAAStB7wCJgAkAAAABwDbAAABbv//AGb/` +
-	`4QReBiECJgBEAAAABgDbANMAAP//ACH+WAStBhACJgAkAAAABwDgAZwACv//AGb+VwReBG0CJgBEAAAABwDgAU0ACf//AHb/0QRAB+sCJgAmAAAABwDYAGIBnf//AKT/4QRHBjACJgBGAAAABgDYQO` +
-	`IAAP//AHb/0QRAB+gCJgAmAAAABwDcAFkBnf//AKT/4QRHBi0CJgBGAAAABgDcQeIAAP//AJsAAAR8B7wCJgAnAAAABwDh//oBbv//AHL/4QRABxYCJgBHAAAABwDh/58AyP//AMsAAAQRB1YCJgAo` +
-	`AAAABwDaAAgB0v//AIL/4ARMBWYCJgBIAAAABgDaKeIAAP//AMsAAAQRB7wCJgAoAAAABwDbAAcBbv//AIL/4ARMBjACJgBIAAAABgDbMuIAAP//AMsAAAQRB7kCJgAoAAAABwDcAAgBbv//AIL/4A` +
-	`RMBi0CJgBIAAAABgDcM+IAAP//AMv+WAQRBhACJgAoAAAABgDgCAoAAP//AIL+TgRMBHwCJgBIAAAABgDgEgAAAP//AMsAAAQRB7wCJgAoAAAABwDhAAcBbv//AIL/4ARMBjACJgBIAAAABgDhMuIA` +
-	`AP//AHb/0QRAB+sCJgAqAAAABwDYAFgBnf//AHL+LwRABiECJgBKAAAABgDY+tMAAP//AHb/0QRAB+gCJgAqAAAABwDcAFkBnf//AHL+LwRABh4CJgBKAAAABgDcOdMAAP//AHb+TQRABj8CJgAqAA` +
-	`AABwDeAIEAAP//AHL8ogRABG0CJgBKAAAABwDe/+f+Vf//AHL+LwRABiECJgBKAAAABwCNAIz/0///AH4AAARPB7wCJgArAAAABwDYAAABbv//AJsAAAQ+B/oCJgBLAAAABwDY/7gBrAACAEcAAASF` +
-	`BhAAEwAXAJe4ADIruAAYL7gAGS+4ABgQuAAA0LgAAC+4AATQuAAAELgAE9y4AAbQuAAZELgAC9y4AAjcuAALELgADtC4AAgQuAAQ0LgACBC4ABTQuAATELgAFtAAuAAFL7gACS+4AAAvuAAPL7oABA` +
-	`ABADUrugAUABEANSu4AAQQuAAH0LgABBC4AAvQuAABELgADdC4AAEQuAAV0DAxMxEjNTMRMxEhETMRMxUjESMRIREBNSEVfjc3ygI+yTY2yf3CAj79wgQYmwFd/qMBXf6jm/voAtn9JwN0pKQAAAAA` +
-	`AQAqAAAEPgZOAB8Af7gAMiu4ACAvuAAhL7gAIBC4AADQuAAAL7gABNC4AAAQuAAf3LgABtC4AB8QuAAK0LgAIRC4ABXcugALAAAAFRESObgAFtwAuAAFL7gAAC+4ABUvugAEAAEANSu6AA4AGwA1K7` +
-	`gABBC4AAfQuAABELgACdC6AAsAAAAFERI5MDEzESM1MxEzESEVIRE+ATMyFhceARURIxE0LgEjIgYHEZtxcboBBP78UOJxX50fGRK6H1MxYuFJBNB8AQL+/nz+mYGSYUU1d7r9kAKHoWdCuoz9df//` +
-	`AKwAAAQgB3cCJgAsAAAABwDZAAABb///AJ0AAAPEBcwCJgDXAAAABgDZ0cQAAP//AKwAAAQgB1YCJgAsAAAABwDaAAAB0v//AJ0AAAPEBUcCJgDXAAAABgDa0sMAAP//AKz+WAQgBhACJgAsAAAABg` +
-	`DgAAoAAP//AJ7+WAPFBm0CJgBMAAAABgDg0woAAP//AHP+VwTMBhACJgAuAAAABwDe/rcACv//AJn+VwSmBk4CJgBOAAAABwDe/t0ACv//ANsAAAQhB7wCJgAvAAAABwCN/6MBbv//AHkAAAPeB/oC` +
-	`JgBPAAAABwCN//MBrP//ANv+VwQhBhACJgAvAAAABgDeGAoAAP//AHn+VwPeBk4CJgBPAAAABgDezQoAAP//ANsAAASXBhACJgAvAAAARwAPAo0FTSmaKZ3//wB5AAAExAZOAiYATwAAAEcADwK6BY` +
-	`spmimd//8A2wAABCEGEAImAC8AAAAHANwA5f0D//8AeQAABIYGTgImAE8AAAAHANwBpf2G//8AfgAABE8HvAImADEAAAAHAI0AJgFu//8AmwAABD4GMAImAFEAAAAGAI0s4gAA//8ASv5XBE8GEAIm` +
-	`ADEAAAAHAN7+jgAK//8AXf5XBD4EfAImAFEAAAAHAN7+oQAK//8AfgAABE8HvAImADEAAAAHAOEAAAFu//8AmwAABD4GMAImAFEAAAAGAOEF4gAA//8Am/2lBD4EfAImAFEAAAAPAA8A3v7AKZoAAP` +
-	`//AEf/0QSFByACJgAyAAAABwDaAAABnP//AFf/4QR2BWYCJgBSAAAABgDaAOIAAP//AEf/0QSFB+oCJgAyAAAABwDbAAABnP//AFf/4QR2BjACJgBSAAAABgDbAOIAAP//AEf/0QSFB+oCJgAyAAAA` +
-	`BwDfAA8BnP//AFf/4QR2BjACJgBSAAAABgDfCOIAAP//AJUAAAS0B7wCJgA1AAAABwCNAGQBbv//APoAAARABjACJgBVAAAABgCNXeIAAP//AHX+VwS0BhACJgA1AAAABwDe/rkACv//AND+VwRABH` +
-	`wCJgBVAAAABwDe/xQACv//AJUAAAS0B7wCJgA1AAAABwDh/9EBbv//APoAAARABjACJgBVAAAABgDhNuIAAP//AJ3/0QRPB+oCJgA2AAAABwCNADYBnP//AKj/4QQ0BjACJgBWAAAABgCNLuIAAP//` +
-	`AJ3/0QRPB+oCJgA2AAAABwDYAA8BnP//AKj/4QQ0BjACJgBWAAAABgDYB+IAAP//ADD+VwSdBhACJgA3AAAABgDeAAoAAP//AGD+TQRBBcICJgBXAAAABgDebAAAAP//ADAAAASdB7wCJgA3AAAABw` +
-	`DhAAABbv//AGD/4QTLBu0CJgBXAAAARwC3AnACGzAAMAv//wAwAAAEnQYQAiYANwAAAAcA2gAA/cr//wBg/+EEQQXCAiYAVwAAAAcA2v9o/M3//wB+/9EETwd3AiYAOAAAAAcA2QAAAW///wCP/+EE` +
-	`MgXMAiYAWAAAAAYA2QDEAAD//wB+/9EETwdWAiYAOAAAAAcA2gAAAdL//wCP/+EEMgVHAiYAWAAAAAYA2gDDAAD//wB+/9EETwe8AiYAOAAAAAcA2wAAAW7//wCP/+EEMgYRAiYAWAAAAAYA2wDDAA` +
-	`D//wB+/9EETwfqAiYAOAAAAAcA3QAAAW7//wCP/+EEMgY/AiYAWAAAAAYA3QDDAAD//wB+/9EETwe8AiYAOAAAAAcA3wAmAW7//wCP/+EEMgYRAiYAWAAAAAYA3xXDAAD//wB+/k4ETwYQAiYAOAAA` +
-	`AAYA4PQAAAD//wCP/mEEPARdAiYAWAAAAAcA4AErABP//wAcAAAEsQe8AiYAOgAAAAcA2AAAAW7//wAUAAAEuAYRAiYAWgAAAAYA2ADDAAD//wA0AAAEmQe8AiYAPAAAAAcA2AAAAW7//wAg/i4ErQ` +
-	`YRAiYAXAAAAAYA2ADDAAD//wB6AAAEUwe8AiYAPQAAAAcAjQAmAW7//wCFAAAEPwYRAiYAXQAAAAYAjSLDAAD//wB6AAAEUwe5AiYAPQAAAAcA3AAAAW7//wCFAAAEPwYOAiYAXQAAAAYA3ADDAAD/` +
-	`/wCd/TEETwY+AiYANgAAAA8ADwE8/kwpmQAA//8AqP1BBDQEfAImAFYAAAAPAA8BNP5cKZkAAP//ADD9YASdBhACJgA3AAAADwAPASz+eymZAAD//wBg/UEEQQXCAiYAVwAAAA8ADwEY/lwpmQAA//` +
-	`8Adv0xBEAGPwImACoAAAAPAA8Bj/5MKZkAAP//AHL+LwRAB1sCJgBKAAAADwC2AMgCijAAAAD//wDb/WAEIQYQAiYALwAAAA8ADwEs/nspmQAA//8Aef1gA94GTgImAE8AAAAPAA8BLP57KZkAAP//` +
-	`AJ39YATMBhACJgAuAAAADwAPAXr+eymZAAD//wDN/WAEpgZOAiYATgAAAA8ADwEs/nspmQAA//8Afv1gBE8GEAImADEAAAAPAA8BLP57KZkAAP//AJX9YAS0BhACJgA1AAAADwAPASz+eymZAAD//w` +
-	`D6/WAEQAR8AiYAVQAAAA8ADwFj/nspmQAA//8AAgAABH4GEAIGAOkAAP///QP9nP4z/+MADwAP+7D++DMz//8Abv/RA7wHvAImAC0AAAAHANgALgFuAAEArP4uA5UEXQARACu4ADIrugAMAAcANSu4` +
-	`AAwQALoAAwAQADUruAADELoACwAIADUruAALEDAxEzUWMzI+ATURITUhERAOASMirJxrXYJJ/iYClIa+pXf+Sq4uQZq/A16b/Cr+5N1gAAAAAAIARwCrBIUFZQAjADEA27gAMiu4ADIvuAAzL7gABd` +
-	`y4ADIQuAAX0LgAFy+4AAUQuAAn3EEFANoAJwDqACcAAl1BGwAJACcAGQAnACkAJwA5ACcASQAnAFkAJwBpACcAeQAnAIkAJwCZACcAqQAnALkAJwDJACcADV24ABcQuAAv3EEbAAYALwAWAC8AJgAv` +
-	`ADYALwBGAC8AVgAvAGYALwB2AC8AhgAvAJYALwCmAC8AtgAvAMYALwANXUEFANUALwDlAC8AAl0AuAAKL7gAEi+4AAAvuAAcL7oAJAAOADUrugAgACsANSswMQEXBx4BFRQGBxcHJw4BIyImJwcnNy` +
-	`4BNTQ2Nyc3Fz4BMzIWFwMyNjU0LgEjIg4BFRQWBBJzuEkgIEm4c7lbZzExZ1u5c7hJICBJuHO5WG8sLG9Y83e9U5ZLS5ZTvQVlZdRmfUNEf2bTX84wExMwzl/TZn9EQ31m1GXTNhERNv0/rYRWlVFR` +
-	`lVaErQAAAP///JsFCP6bBk4ABwBD+1sAAAAA///8mwUI/psGTgAHAI37WwAAAAD///x0BQj+wgZOAAcA2Ps0AAAAAP///FUFB/7hBggABwDZ+zQAAAAA///8hAUI/rMFhAAHANr7NQAAAAD///x0BQ` +
-	`j+wgZOAAcA2/s0AAAAAP///SEFVv4WBksABwDc+zUAAAAA///8RgUI/vEGAAAHAI77NQAAAAD///zhBQj+VQZ8AAcA3fs1AAAAAP///IwFCP6rBk4ABwDf+u8AAAAA///8dAUI/sIGTgAHAOH7NAAA` +
-	`AAD///zx/k3+RgAAAAcA3vs1AAAAAAAB/O/+Tv5EAAAAEABvuAAyK7oAAwAOADUrQRsABgADABYAAwAmAAMANgADAEYAAwBWAAMAZgADAHYAAwCGAAMAlgADAKYAAwC2AAMAxgADAA1dQQUA1QADAO` +
-	`UAAwACXQC6ABAACwA1K7gACxC4AAbcugAIAAsAEBESOTAxIQ4BFRQWMzI3FQYjIiY1NDf+RFpvOEEjLUI9XXmUKpYwHzgTYR1kTXmIAAAB+1P/0f/fBj4AAwAPuAAyKwC4AAIvuAAALzAxBSMBM/wK` +
-	`twPbsS8GbQAAAAIAIQAABK0GEAAHAAsAG7gAMisAuAAAL7gABC+4AAIvugAJAAYANSswMTMjATMBIwMhNyEDB+PCAd7NAeHQa/3aMAHF5ycGEPnwAVWbAumcAAAAAAIAsAAABFoGEAAKABoAibgAMi` +
-	`u4ABsvuAAcL7gAFNy4AADcQQUA2gAAAOoAAAACXUEbAAkAAAAZAAAAKQAAADkAAABJAAAAWQAAAGkAAAB5AAAAiQAAAJkAAACpAAAAuQAAAMkAAAANXbgAGxC4ABnQuAAZL7gABty4AA3QALoABwAY` +
-	`ADUrugALAAwANSu6AA8ABAA1KzAxATQnJisBETMyNzYTFSURMzIXHgEVEAcGIyERA5C9W7tDZcVjiXv9bz7vjIif4W3u/pIBvuxlMP1bMUQFAZwB/mVFRf+Z/uxuNgYQAAMArAAABDgGEAAPABgAIw` +
-	`DxuAAyK7oAGQAAADUrugAGABMANStBBQDaABMA6gATAAJdQRsACQATABkAEwApABMAOQATAEkAEwBZABMAaQATAHkAEwCJABMAmQATAKkAEwC5ABMAyQATAA1dugAeABMABhESObgAHi9BBQDaAB4A` +
-	`6gAeAAJdQRsACQAeABkAHgApAB4AOQAeAEkAHgBZAB4AaQAeAHkAHgCJAB4AmQAeAKkAHgC5AB4AyQAeAA1duAAK3LoACAAAAAoREjm4ABkQuAAQ0LgAChC4ACXcALoAGQAPADUrugACABcANSu6AB` +
-	`AAIwA1K7oACAAjABAREjkwMTMRISAXFhUQBQQRFAYHBiMDMzY1NCcmKwERMzI3NjU0JyYrAawBLwERXbj++wE8c2Bk3K/U9WNElY2/hlRfrFi8OAYQKVPh/viMfv7wbL8yNANVZt+IMiL7JTxEgaxM` +
-	`JgAAAQEbAAAEcAYQAAUAG7gAMiu6AAAAAQA1KwC4AAAvugADAAQANSswMSEjESEVIQHlygNV/XUGEJwAAAAAAgAY/k4EswYQAA4AFgBbuAAyK7oABAAFADUrugASAAoANSu6AA0ADwA1K7oAAQAPAA` +
-	`0REjm4AAEvuAAA3LoAFgAKABIREjkAuAAAL7gABC+6AAwAEAA1K7oADQACADUruAANELgAD9AwMQEjESERIxE2NzY1ESERMyERIREUBwYHBLPA/OXAokcxAuKf/pj+piowU/5OAbL+TgJML9+a0QL9` +
-	`+ooE2/1gsJqvQgAAAAABAMsAAAQRBhAACwAvuAAyK7oACwACADUruAALELgABtAAugAAAAEANSu6AAQABQA1K7oACAAJADUrMDElFSERIRUhESEVIREEEfy6Azb9lAIu/dKamgYQnP3xm/3QAAAB//` +
-	`4AAATPBhAAEQBfuAAyK7oAAwAEADUruAAEELgAC9C4AAMQuAAN0AC4AAAvuAADL7gABi+4AAkvuAAML7gADy+6AAIAAAAJERI5ugAFAAAACRESOboACwAAAAkREjm6AA4AAAAJERI5MDEhIwERIxEB` +
-	`IwkBMwERMxEBMwEEz9X+0cn+0dUBOP7awgEwyQEwwv7aAxf86QMX/OkDLwLh/QcC+f0HAvn9HwAAAQB9/9EESwY+ACYAqbgAMiu4ACcvuAAJ0LgACS+4AAAQuAAA3LgACRC4AADcQQMAMAAAAAFdQQ` +
-	`MAcAAAAAFdQQMA8AAAAAFdQQUAAAAAABAAAAACXUEDALAAAAABXUEDANAAAAABXUEDAJAAAAABXUEDAFAAAAABXbgAFty4AAkQuAAW3LgAABC4ABrcuAAo3AC6ACMAHgA1K7oAEgANADUrugAFAAIA` +
-	`NSu6ABgAAgAFERI5MDEBECEjNTMyNzY1NCcmIyIHNTYzMhcWFRQFBBEUBwYjIic1FjMyNzYDgf4aSXuVYXFZTXunxsmd1X+e/vUBWKuh7bfe4r+YZGcBugFOm0BMjnU9NGGxS0tdxu5/Zv7K54yDUL` +
-	`5zWl4AAAAAAQB+AAAETwYQAAoAX7gAMiu4AAsvuAAML7gAANy4AAHcuAAD0LgACxC4AAXQuAAFL7gABNy4AAfQuAAHL7gAARC4AAnQALgAAC+4AAQvuAAGL7gACS+6AAMAAAAGERI5ugAIAAAABhES` +
-	`OTAxISMTIwEjETMDATMET7oFBf2ftroEAmG6BLf7SQYQ+1EErwAAAgB+AAAETwe+AAoAHABzuAAyK7gAHS+4AB4vuAAA3LgAAdy4AAPQuAAdELgABdC4AAUvuAAE3LgAB9C4AAcvuAABELgACdC4AA` +
-	`EQuAAU0LgAFC+4AAQQuAAc0LgAHC8AuAAAL7gABC+6ABMAGAA1K7gAExC4AAvQuAAYELgAD9wwMSEjEyMBIxEzAwEzARYXFjMyNzY3MwYHBiMiJyYnBE+6BQX9n7a6BAJhuv1xCCwvRUMwKwiADUtS` +
-	`fH5SSQ8Et/tJBhD7UQSvAa5CLjIyLkJ8UVlZUH0AAQCdAAAEzAYQAAoAO7gAMiu6AAoAAAA1K7gAChC4AALQALgAAS+4AAQvuAAAL7gABy+6AAMAAAABERI5ugAJAAAAARESOTAxMxEzEQEzCQEjAR` +
-	`GdygJB1v3TAnv9/ZgGEP0HAvn9H/zRAxf86QAAAAEAAv/hBE8GEAATAB+4ADIrugAAAAEANSsAugANAAgANSu6ABMAAgA1KzAxISMRIQMCBwIhIic1FjMyEzYbASEET8n+yQkWP1z+8UFDMU6HOikQ` +
-	`FALABXX+/f181P7HD60TAQy8AbACDgAAAAEAOAAABJUGEAARAHu4ADIruAASL7gAEy+4ABIQuAAB0LgAAS+4AADcuAATELgAB9y4AAjcuAAF0LgABS+4AAgQuAAK0LgAABC4AA/QugAQAAEAABESOQ` +
-	`C4AAIvuAAFL7gAAC+4AAcvugAEAAAAAhESOboACgAAAAIREjm6ABAAAAACERI5MDEzIxEzCQEzESMTIwcDIwMnIxfbo8sBZgFmxroFBSzwyvMnBQUGEPwCA/758ARliP1ZAqeIiAAAAAEAfgAABE8G` +
-	`EAALAEu4ADIruAAML7gADS+4AAwQuAAA0LgAAC+4AAvcuAAC0LgADRC4AAbcuAAF3LgACNAAuAAAL7gABy+4AAEvuAAFL7oABAAJADUrMDEzETMRIREzESMRIRF+ygI+ycn9wgYQ/WQCnPnwAtn9Jw` +
-	`AAAAACAEf/0QSFBj4ADwAfAMu4ADIruAAgL7gAIS+4ACAQuAAE0LgABC+4ACEQuAAM3LgAFNxBBQDaABQA6gAUAAJdQRsACQAUABkAFAApABQAOQAUAEkAFABZABQAaQAUAHkAFACJABQAmQAUAKkA` +
-	`FAC5ABQAyQAUAA1duAAEELgAHNxBGwAGABwAFgAcACYAHAA2ABwARgAcAFYAHABmABwAdgAcAIYAHACWABwApgAcALYAHADGABwADV1BBQDVABwA5QAcAAJdALoAEAAAADUrugAIABgANSswMQUgAy` +
-	`YREDcSISATFhEQBwIlMjc2NTQnJiMiBwYVFBcWAmb+14tra4wBKAEpi2tqjP7Xw1c7O1fDw1c7O1cvARjVAUoBSdYBF/7p1f62/rXV/umc/6zw8Kz//6zw8Kz/AAAAAQB+AAAETwYQAAcAP7gAMiu4` +
-	`AAgvuAAJL7gACBC4AADQuAAAL7gACRC4AAPcuAAE3LgAABC4AAfcALgAAC+4AAMvugACAAUANSswMTMRIREjESERfgPRyf3CBhD58AV1+osAAAIAsAAABG4GEAAMABcAkbgAMiu4ABgvuAAZL7gAGB` +
-	`C4AALQuAACL7gAAdy4ABkQuAAI3LgAARC4AA3QuAAIELgAEtxBBQDaABIA6gASAAJdQRsACQASABkAEgApABIAOQASAEkAEgBZABIAaQASAHkAEgCJABIAmQASAKkAEgC5ABIAyQASAA1dALgAAS+6` +
-	`AAQAFgA1K7oADQAAADUrMDEBESMRITIXFhEUBgQjJzMyNzY1NCcmKwEBesoBgu5t4Z/+7vFSV7tbvYlhx3kCN/3JBhA1b/7smf+JmjFk7K9ELwAAAAEAdv/RBEAGPwAZAGW4ADIrugATAAYANStBGw` +
-	`AGABMAFgATACYAEwA2ABMARgATAFYAEwBmABMAdgATAIYAEwCWABMApgATALYAEwDGABMADV1BBQDVABMA5QATAAJdALoAFwACADUrugAKAA8ANSswMSUGIyADJhEQNxIhMhcVJiMiBwYVFBcWMzI3` +
-	`BECuiv7CtZ+gtAE+iq6hn9l8ZGV72Z6iEkEBAuQBUQFR5AECQbhd7MDv78DtXQAAAQAwAAAEnQYQAAcAI7gAMiu6AAcAAAA1KwC4AAAvugADAAIANSu4AAIQuAAF0DAxIREhNSEVIRECAf4vBG3+Lg` +
-	`Vlq6v6mwAAAQAo/+EErQYQABEAF7gAMisAuAABL7gABC+6AA8ACgA1KzAxCQEzCQEzAQYHBiMiJzUWMzITAir9/uEBhwFOz/4sWlWO5EFDPULpegHlBCv80AMw+6PWXp4PrRMBKwAAAwBEAAAEiAYQ` +
-	`ABUAHgAnANG4ADIruAAoL7gAC9C4AAsvuAAG3EEDAC8ABgABXUEDAMAABgABXUEDAIAABgABXbgAFtxBAwAvABYAAV1BAwDAABYAAV1BAwCAABYAAV24AADcuAAGELgABdy4AAYQuAAP0LgABRC4AB` +
-	`HQuAAFELgAGtC6ABsABgAWERI5uAAGELgAH9C6ACAACwAAERI5uAALELgAJNy4AAAQuAAp3AC4AAUvuAAQL7oAGgAFABAREjm6ABsABQAQERI5ugAfAAUAEBESOboAIAAFABAREjkwMQEQBwYHFSM1` +
-	`JicmERA3Njc1MxUWFxYDNCcmJxE2NzYBEQYHBhUUFxYEiG543MDceG5vetnA2Xpvx0JHcnpDPv5FckdCPkMDCP7vtsUiWloixbYBEQEMusogWFggyrr+8q6Voyn77C6Wif6zBBQpo5WuuImWAAABAD` +
-	`AAAASdBhAACwAruAAyKwC4AAMvuAAGL7gAAC+4AAkvugAFAAAAAxESOboACwAAAAMREjkw`
-
-const MonacoFontData10 = `MSEjCQEzCQEzCQEjAQEC0gHG/mblASkBMNP+ZgHK6f6rAysC5f3pAhf9KvzGAmsAAAEAbP5OBL4GEAALAE+4ADIrugAGAAMANSu6AAoABwA1K7gAChC4AADcuAAKELgAA` +
-	`dC4AAEvuAAKELgADdwAuAAEL7gACC+4AAAvugAKAAIANSu4AAoQuAAG0DAxASMRIREzESERMxEzBL7A/G7KAgzJs/5OAbIGEPqKBXb6igAAAQB+AAAETwYQABUASbgAMiu4ABYvuAAXL7gAE9y4ABL` +
-	`cuAAA0LgAFhC4AAbQuAAGL7gACdwAuAAUL7gABy+4ABIvugANAAIANSu6AAAAFAAHERI5MDEBBiMiJyY1ETMRFBcWMzI3NjcRMxEjA4bC3LZiUsopNm1mcFtByckDFOKagr4CBP4VeFdyYE5uAhD58` +
-	`AAAAAEAOwAABJMGEAALAEO4ADIrugADAAAANSu6AAcABAA1K7oACwAIADUruAALELgADdwAuAABL7gABS+4AAkvugADAAAANSu4AAMQuAAH0DAxMxEzESERMxEhETMRO7oBFrkBFboGEPqKBXb6igV` +
-	`2+fAAAAAAAQA7/k4E3AYQAA8AWbgAMiu6AAYAAwA1K7oACgAHADUrugAOAAsANSu6AAEACwAOERI5uAABL7gAANwAuAAEL7gACC+4AAwvuAAAL7oACwACADUruAALELgABtC4AAsQuAAO0DAxASMRI` +
-	`REzESERMxEhETMRMwTcwPwfugEMuQELul3+TgGyBhD6igV2+ooFdvqKAAIAAAAABG4GEAANABgAhbgAMiu6AAkACAA1K7oAAAATADUruAAJELgABdy4AAkQuAAO0EEFANoAEwDqABMAAl1BGwAJABM` +
-	`AGQATACkAEwA5ABMASQATAFkAEwBpABMAeQATAIkAEwCZABMAqQATALkAEwDJABMADV0AugAQAAQANSu6AAkABgA1K7oACgAOADUrMDEBEAcGIyERITUhEQQXFiURMzI2NTQnJicmBG7hbe7+7P7iA` +
-	`egBFqrG/XoV/qkwOW9VAbj+7G42BXWb/b4FeIxu/WeWjGdZayohAAAAAwBIAAAEhQYQAAkAEAAUAJW4ADIrugALAAMANSu6AAAADQA1K7oAEgARADUruAALELgABdBBBQDaAA0A6gANAAJdQRsACQA` +
-	`NABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0AmQANAKkADQC5AA0AyQANAA1duAASELgAFtwAuAAEL7gAES+6AAsAAgA1K7oABgAKADUruAACELgAE9AwMQEQISMRMxEyFxYlESQRNCcmA` +
-	`TMRIwNO/eLoxuensv3AAXloZAIJwcEBrP5UBhD9u5KclP1qDAEVqGJfAuv58AAAAAIAsAAABG4GEAAMABQAkbgAMiu4ABUvuAAWL7gAANy4ABUQuAAF0LgABS+4AA/cuAAH0LgAABC4ABPcQQUA2gA` +
-	`TAOoAEwACXUEbAAkAEwAZABMAKQATADkAEwBJABMAWQATAGkAEwB5ABMAiQATAJkAEwCpABMAuQATAMkAEwANXbgAC9C4AAsvALgABi+6ABAABAA1K7oACQANADUrMDEBEAcGIyERMxEzIBcWJSMRM` +
-	`zI2NRAEbuFt7v5+ylwBIqrM/W1hg/6pAbj+7G42BhD9sm+FWv1yloIBdgABAI3/0QRXBj8AIAAzuAAyK7oAAAAPADUruAAPELgAEtC4ABIvALoACwAGADUrugAbABYANSu6ABIADwA1KzAxARQHBgc` +
-	`GIyInNRYzMjc2NyE1ISYnJiMiBzU2MzIXFhcWBFdIVaaZtoquop7NfWUK/cICOhZle7+foa6KuJmlVEgDCN+31mliQbdd2bHjm9Odv124QWNr1LcAAAACAFb/0QSSBj4AFgAmAJG4ADIrugAGAAcAN` +
-	`Su6ACMABAA1K7oAEwAbADUruAAGELgACdC4AAQQuAAL0LgACy9BBQDaABsA6gAbAAJdQRsACQAbABkAGwApABsAOQAbAEkAGwBZABsAaQAbAHkAGwCJABsAmQAbAKkAGwC5ABsAyQAbAA1duAATELg` +
-	`AKNwAugAXAAAANSu6AA8AHwA1KzAxBSInJgMjESMRMxEzEjc2MzIXFhEQBwYnMjc2ERAnJiMiBwYREBcWAwbFYV8GZcDAZg1XYcXGYWVlYcZZNTk5NVlZNTk5NS/V0AFj/ScGEP1kATi+1NTf/n3+f` +
-	`N7VnJylAVoBWqabm6b+pv6mpZwAAAIAGQAABDgGEAAPABcAkbgAMiu4ABgvuAAZL7gAGBC4AAfQuAAHL7gAGRC4AA3cuAAO3LgAENC4AAcQuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGY` +
-	`AFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl0AuAABL7gADS+6AAwAEQA1K7oAFwAAADUrMDEJASMBJicmNTQ+ATMhESMRNREjIgYVFBcCjP598AGnh1JWc8bPAZ/Kl9is+` +
-	`wJr/ZUCqUmEiqBrr1b58AJrnAJti3fiiQAAAAABAKwAAAQgBhAACwAvuAAyK7oACQACADUrALoAAQAAADUrugAFAAQANSu4AAQQuAAH0LgAARC4AAnQMDEzNSERITUhFSERIRWsAVX+qwN0/qsBVZs` +
-	`E2Zyc+yebAAEACP7DBHQGEAAjAFW4ADIruAAkL7gAJS+4AB3cuAAE3LgAJBC4AA3QuAANL7gADNy4ABPQugAUAA0AHRESOQC6AAAAIwA1K7oAEAAPADUrugAYAAcANSu4AA8QuAAS0DAxBTI3NjURN` +
-	`CMiBwYHESMRITUhFSERNjc2MzIXFhURFAcGBwYjAhfCb2KjZl5EJMr+9wPN/gYmUmB7qFVJQkeIfNCXl4fHAU3mcE9f/Z0FdZub/cxgQEt+brz+47KWo2FYAAABAAr+uwSHBk4AKQDDuAAyK7gAKi+` +
-	`4ACsvuAAqELgAENC4ABAvuAAP3LgAANC4ACsQuAAj3LgABtxBBQDaAAYA6gAGAAJdQRsACQAGABkABgApAAYAOQAGAEkABgBZAAYAaQAGAHkABgCJAAYAmQAGAKkABgC5AAYAyQAGAA1duAAQELgAF` +
-	`NC4AA8QuAAW0LgADxC4ABrQugAbABAAIxESOQC4ABUvugACACcANSu6ABQAEQA1K7oAHwAKADUruAAUELgAF9C4ABEQuAAZ0DAxBRYzMjc2NTQnJiMiBwYHESMRIzUzETMRIRUhETY3NjMyFxYVEAc` +
-	`GISInAXNlVcFuYSo4bGl2WUS6r6+6AmP9nUhrenu2Y1OVov7zJauPGpyKyXpceXVYeP6wA8KbAfH+D5v+a3ROWKGHwP7nvs0QAAIBGwAABHAIAAAFAAkAM7gAMiu6AAAAAQA1K7oABgABAAAREjkAu` +
-	`AAHL7gAAC+6AAMABAA1K7oABgAAAAcREjkwMSEjESEVIQMBMwEB5coDVf11EgEo2P6qBhCcAUYBRv66AAACASkAAARgBk4ABQAJADO4ADIrugAAAAEANSu6AAYAAQAAERI5ALgABy+4AAAvugADAAQ` +
-	`ANSu6AAYAAAAHERI5MDEhIxEhFSEDATMBAfPKAzf9kzsBKNj+qgRdnAFHAUb+ugAAAgCeAAADxQZtAAcAEwCLuAAyK7oABAADADUruAAEELgAANy4AAfcugAIAAMABBESObgACC9BBQDaAAgA6gAIA` +
-	`AJdQRsACQAIABkACAApAAgAOQAIAEkACABZAAgAaQAIAHkACACJAAgAmQAIAKkACAC5AAgAyQAIAA1duAAO3AC6AAUAAAA1K7oACwARADUrugAEAAEANSswMSERITUhESEVATQ2MzIWFRQGIyImAdT` +
-	`+ygHwATf98Eg0NElJNDNJA8Kb/D6bBfA0SUk0M0lJAAEAbv/RA7wGEAARACe4ADIrugAMAAcANSu4AAwQuAAT3AC6AAMAEAA1K7oACwAIADUrMDE3NRYzMjc2NREhNSEREAcGISJu2pO3OyX+owInW` +
-	`3P+8rIivnOJVdIDV5z79P78hqkAAAAAAQB2/9EEQAY/ACIAM7gAMiu6ABoACAA1K7gAGhC4ABfQuAAXLwC6ACAAAgA1K7oADgATADUrugAYABkANSswMSUGIyInJicmNTQ3Njc2MzIXFSYjIgcGAyE` +
-	`VIRYXFhcWMzI3BECuiraZplVISFSlmbiKrqGfeGO4IgI6/cIHND5nYneeohJBYmnWt9/et9RrY0G4XVKY/rubmY6jU1BdAAEApP/hBEcEfAAbAB+4ADIrALoAGQACADUrugAJAA4ANSu6ABMAFAA1K` +
-	`zAxJQYjIgARNDc2MzIXFSYjIgcGByEVIRYXFjMyNwRHu6n7/ryfovStwcOak2ReHwIZ/eATZGmmgdEgPwFNAQD2qq5Bs1laV5Wbp2RpRwAAAAADAKwAAAQgB7IACwAXACMAubgAMiu6ABUADwA1K0E` +
-	`bAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl24ABUQuAAC0LgAAi+4ABUQuAAJ3LgAG9C4ABsvuAAI0LgACC+4AAkQuAAh3` +
-	`AC6AAEAAAA1K7oAEgAMADUrugAFAAQANSu4AAQQuAAH0LgAARC4AAnQuAAMELgAGNC4ABIQuAAe0DAxMzUhESE1IRUhESEVASImNTQ2MzIWFRQGISImNTQ2MzIWFRQGrAFV/qsDdP6rAVX9bTRISTM` +
-	`zSUgBfzRJSjMzSUmbBNmcnPsnmwa6SDQzSUkzNEhINDNJSTMzSQAAAAMAnQAAA8QGAAAHABMAHwBluAAyK7oABAADADUrugALAAMABBESObgACy+4ABHcuAAA0LgAERC4AAfcuAAEELgAF9C4AAQQu` +
-	`AAd3AC6AAUAAAA1K7oADgAIADUrugAEAAEANSu4AAgQuAAU0LgADhC4ABrQMDEhESE1IREhFQEiJjU0NjMyFhUUBiEiJjU0NjMyFhUUBgHT/soB8AE3/ZM0SEkzM0lIAX80SUozM0lJA8Kb/D6bBQh` +
-	`INDNJSTM0SEg0M0lJMzNJAAAAAAIABv/hBLAGEAAZAB8AebgAMiu6AAgAEwA1K7oAHgAFADUrugAAABoANSu4AB4QuAAV0EEFANoAGgDqABoAAl1BGwAJABoAGQAaACkAGgA5ABoASQAaAFkAGgBpA` +
-	`BoAeQAaAIkAGgCZABoAqQAaALkAGgDJABoADV0AuAAOL7oAFQAGADUrMDEBFAcGKwERIxEQBwYHBiM1Mjc2GQEhERYXFgc0JicRJASwfXjdtqcUGU9LtIsfFQIfxYCHu5V8AREBkMVnZAV1/dv+c5n` +
-	`ARkOpe1MBiAMw/XMUhIvQjbkR/bIWAAIAB//hBJgEXQAXAB4AjbgAMiu6AAgAEQA1K7oAHQAFADUrugAAABgANSu4AB0QuAAT0EEFANoAGADqABgAAl1BGwAJABgAGQAYACkAGAA5ABgASQAYAFkAG` +
-	`ABpABgAeQAYAIkAGACZABgAqQAYALkAGADJABgADV0AugAdAAwANSu6ABMABgA1K7oAFAAcADUruAAdELgABNwwMQEUBwYrAREjFRAHBiM1Mjc2NREhEQQXFgc0JyYjESAEmJxMqemyM0vnaCkhAhk` +
-	`BI202xBs3sAECATLATSUDwt3+bZbbpYlw9gHo/lQHtFpgQTJm/oUAAAACADgAAASmBhAAEwAZAK24ADIrugACAAMANSu6ABcAAAA1K7oADgAUADUruAACELgABdC4AAAQuAAH0LgAFxC4AAnQQQUA2` +
-	`gAUAOoAFAACXUEbAAkAFAAZABQAKQAUADkAFABJABQAWQAUAGkAFAB5ABQAiQAUAJkAFACpABQAuQAUAMkAFAANXbgADhC4ABvcALgAAi+4ABIvuAAEL7gACC+6AAcAAAA1K7gABxC4AArQuAAAELg` +
-	`AF9AwMQEhESMRMxEhETMRFhcWFRQHBisBATQmJxEkAh7+2sDAASa8xYCHfXjdtgHNlXwBEQLn/RkGEP1zAo39cxSEi9DFZ2QBkI25Ef2yFgACAEIAAASUBF0AEwAaALG4ADIrugAIAAkANSu6ABkAB` +
-	`QA1K7oAAAAUADUruAAIELgAC9C4AAUQuAAN0LgAGRC4AA/QQQUA2gAUAOoAFAACXUEbAAkAFAAZABQAKQAUADkAFABJABQAWQAUAGkAFAB5ABQAiQAUAJkAFACpABQAuQAUAMkAFAANXQC4AAovuAA` +
-	`OL7oAGQAEADUrugANAAYANSu4AAQQuAAI0LgADRC4ABDQuAAGELgAGNC4ABgvMDEBFAcGKwERIREjETMRIREzEQQXFgc0JyYjESAElJxMqen+3LS0ASS0ASNtNsQbN7ABAgEywE0lAhb96gRd/lQBr` +
-	`P5UB7RaYEEyZv6FAAAAAAIArP4uA7QGbQATAB8Ah7gAMiu6ABoAFAA1K0EFANoAFADqABQAAl1BGwAJABQAGQAUACkAFAA5ABQASQAUAFkAFABpABQAeQAUAIkAFACZABQAqQAUALkAFADJABQADV2` +
-	`6AAcAFAAaERI5uAAHL7gADNy4ABoQuAAh3AC6AAMAEgA1K7oAFwAdADUrugALAAgANSswMRM1FjMyNzY1ESE1IREQBwYHBiMiATQ2MzIWFRQGIyImrJxrwEQk/iYClENIalyYdwGHSDQ0SEg0NEj+S` +
-	`q4ujk2/A16b/Cr+5G92LykHwjRJSTQzSUkAAQCd/9EETwY+ACUAzbgAMiu4ACYvuAAnL7gAINy4AAfcQQUA2gAHAOoABwACXUEbAAkABwAZAAcAKQAHADkABwBJAAcAWQAHAGkABwB5AAcAiQAHAJk` +
-	`ABwCpAAcAuQAHAMkABwANXbgAJhC4AAzQuAAML7oAEwAgAAcREjm4ABncQRsABgAZABYAGQAmABkANgAZAEYAGQBWABkAZgAZAHYAGQCGABkAlgAZAKYAGQC2ABkAxgAZAA1dQQUA1QAZAOUAGQACX` +
-	`QC6AAMAJAA1K7oAEAAVADUrMDE3NRYzMjc2NTQvASQ1NDc2MzIXFSYjIgcGFRQXFhcFFhUUBwYjIp3wxHtXY/zN/vCWhdqmm6uiclJcRCprAQH/qI/cwSG+cj1Gd6KRdp3z0mxgMK1COkFtXkctQJq` +
-	`Z7990YwAAAAABAAgAAAR0BhAAGQBVuAAyK7gAGi+4ABsvuAAA3LgAAdy4ABoQuAAK0LgACi+4AAncuAAQ0LoAEQAKAAAREjkAuAAAL7gACS+6AA0ADAA1K7oAFQAEADUruAAMELgAD9AwMSEjETQjI` +
-	`gcGBxEjESE1IRUhETY3NjMyFxYVBHTKo2ZeRCTK/vcDzf4GJlJge6hVSQKb5nBPX/2dBXWbm/3MYEBLfm68AAABAAoAAARcBk4AIQCLuAAyK7gAIi+4ACMvuAAiELgAGtC4ABovuAAZ3LgAAtC4ACM` +
-	`QuAAN3LoAAwAaAA0REjm4AArQuAAKL7gADRC4AA7cuAAaELgAHtC4ABkQuAAg0AC4AA0vuAAZL7gAHy+6AAAAAQA1K7oABwAUADUrugADAA0AHxESObgAARC4ABvQuAAAELgAHdAwMQEVIRE2NzYzM` +
-	`hYXFhURIxE0JicmIyIHBgcRIxEjNTMRMxED1v2dSWd3fICqEwm6HycqM3B6Yz+6r6+6BMGb/g11SlSZfTy6/sYBUaFmISJxXHn+qwQmmwGN/nMAAgCdAAAEzAgAAAoADgBLuAAyK7oACgAAADUruAA` +
-	`KELgAAtAAuAAAL7gABy+4AAwvugADAAAADBESOboABQAAAAwREjm6AAkAAAAMERI5ugALAAAADBESOTAxMxEzEQEzCQEjARETATMBncoCQdb90wJ7/f2YCAEo2P6qBhD9BwL5/R/80QMX/OkGugFG/` +
-	`roAAgDNAAAEpgZOAAoADgBLuAAyK7oACgAAADUruAAKELgAAtAAuAAAL7gABy+4AAwvugADAAAADBESOboABQAAAAwREjm6AAkAAAAMERI5ugALAAAADBESOTAxMxEzEQEzCQEhARETATMBzboCGfj` +
-	`96AIm/wD94QkBKNj+qgRd/fECD/3x/bICTv2yBQgBRv66AAAAAAEAqP/hBDQEfAApAM24ADIruAAqL7gAKy+4ACTcuAAF3EEFANoABQDqAAUAAl1BGwAJAAUAGQAFACkABQA5AAUASQAFAFkABQBpA` +
-	`AUAeQAFAIkABQCZAAUAqQAFALkABQDJAAUADV24ACoQuAAO0LgADi+6ABUAJAAFERI5uAAb3EEbAAYAGwAWABsAJgAbADYAGwBGABsAVgAbAGYAGwB2ABsAhgAbAJYAGwCmABsAtgAbAMYAGwANXUE` +
-	`FANUAGwDlABsAAl0AugADACgANSu6ABIAFwA1KzAxNzUWMyA1NCcmLwEmJyY1NDc2MzIXFSYjIgcGFRQXFh8BFhcWFRQHBiMiqMv2AQk8HVTBnzpklHO9s6C4olpEXV0hdn6TRGKYeMTWO8WEnz0pF` +
-	`CJNQC1PgK1QPzSpQhwnTkMzEisuNjlTgLRVQwACACj/4QStB74AEQAjACe4ADIrALoAHAAXADUrugARAAQANSu4ABEQuAAI0LgABBC4AA3cMDEBBgcGIyInJiczFhcWMzI3NjcJAQYHBiMiJzUWMzI` +
-	`TNwEzCQEDtg1LUnx+UkkPgAgsL0VDMCsIAXf+LFpVjuRBQz1C6XoU/f7hAYcBTge+fFFZWVB9Qi4yMi5C/lL7o9Zeng+tEwErMAQr/NADMAAAAAACACD+LgStBhAAEQAlACe4ADIrALoAIQAcADUru` +
-	`gAIAA0ANSu4AAgQuAAA0LgADRC4AATcMDEBFhcWMzI3NjczBgcGIyInJicTATMJATMBBgcGIyInNRYzMjc2NwHxCCwvRUMwKwiADUtSfH5SSQ/F/fzNAZsBWLv+OmRXjuZVQ01GilxHPwYQQi4yMi5` +
-	`CfFFZWVB9+h0EMPy6A0b7we9inw+kGFlEkgABAH7+TgRPBhAACwBDuAAyK7oABwAEADUrugABAAIANSu6AAsACAA1K7gACxC4AA3cALgABS+4AAkvuAABL7oACAAAADUruAAAELgAA9AwMSERIxEhE` +
-	`TMRIREzEQLHwP53ygI+yf5OAbIGEPqbBWX58AAAAAEAm/6yBDIEXQALAEO4ADIrugAHAAQANSu6AAEAAgA1K7oACwAIADUruAALELgADdwAuAAFL7gACS+6AAgAAQA1K7gACBC4AADcuAAD0DAxIRE` +
-	`jESERMxEhETMRAsG2/pC6AiO6/rIBTgRd/D0Dw/ujAAAAAwDLAAAEEQeyAAsAFwAjALu4ADIrugALAAIANSu6ACEAGwA1K7gACxC4AAbQuAAhELgACNC4AAgvugAPAAIACxESObgADy+4ABXcQQUA2` +
-	`gAbAOoAGwACXUEbAAkAGwAZABsAKQAbADkAGwBJABsAWQAbAGkAGwB5ABsAiQAbAJkAGwCpABsAuQAbAMkAGwANXbgAIRC4ACXcALoAAAABADUrugASAAwANSu6AAQABQA1K7oACAAJADUruAAMELg` +
-	`AGNC4ABIQuAAe0DAxJRUhESEVIREhFSEZASImNTQ2MzIWFRQGISImNTQ2MzIWFRQGBBH8ugM2/ZQCLv3SNEhJMzNJSAF/NElKMzNJSaurBhCc/fGb/eEGD0g0M0lJMzRISDQzSUkzM0kAAAAABACC/` +
-	`+AETAYAAAsAFwAuADgA0bgAMiu6ACgAHgA1K7oAFQAPADUruAAoELgAA9C4AAMvuAAoELgACdxBBQDaAA8A6gAPAAJdQRsACQAPABkADwApAA8AOQAPAEkADwBZAA8AaQAPAHkADwCJAA8AmQAPAKk` +
-	`ADwC5AA8AyQAPAA1dugAwAA8AFRESObgAMC+4ACbcugAuADAAJhESObgAKBC4AC/QuAAvL7gAJhC4ADrcALoALAAaADUrugAGAAAANSu6ACIANQA1K7oAMAAnADUruAAAELgADNC4AAYQuAAS0DAxA` +
-	`SImNTQ2MzIWFRQGISImNTQ2MzIWFRQGEwYjIicmNTQ3NjMyFxYdASEUFxYzMjcBITU0JyYjIgcGAcM0SEkzM0lIAX80SUozM0lJk9/H7ZiPkJjq6nFd/P5dZKO02v0VAjE8Q3qCVkgFCEg0M0lJMzR` +
-	`ISDQzSUkzM0n7Qmq0qe/xq7S1lfooqXJ5eAGnG35RXGZXAAIAVgAABDIEXQAPABYApbgAMiu4ABcvuAAYL7gAFxC4AAfQuAAHL7gAGBC4AA3cugADAAcADRESObgADty4ABDQuAAHELgAFNxBGwAGA` +
-	`BQAFgAUACYAFAA2ABQARgAUAFYAFABmABQAdgAUAIYAFACWABQApgAUALYAFADGABQADV1BBQDVABQA5QAUAAJdALgAAS+4AA0vugAMABEANSu6ABYAAAA1K7oAAwAAABYREjkwMQkBIwEmJyY1NDc` +
-	`2MyERIxE1ESEiFRQXApj+s/UBeHpKT2pZkAIkuv7y5ZcBu/5FAdsuYWiBi0U6+6MBu5oBbpGHVgAAAgBk/+EEXARtABQAIwCfuAAyK7gAJC+4ACUvuAAQ3LgAANy4ACQQuAAI0LgACC+4AAAQuAAO0` +
-	`LgADi+4AAAQuAAV0LgACBC4ABzcQRsABgAcABYAHAAmABwANgAcAEYAHABWABwAZgAcAHYAHACGABwAlgAcAKYAHAC2ABwAxgAcAA1dQQUA1QAcAOUAHAACXQC6ACAABAA1K7oADAAYADUrugASAAQ` +
-	`AIBESOTAxJQYHBiMiJyY1EDc2ITIXMxEUFyMmAxEmIyIHBhUUFxYzMjc2A3hHbHt7tWNTlaABDiWruyrCFA5jVsJuYSo3bGp2Wvt0Tlihh8ABGb7NEPzryn5WAYIB3xqbisp5XXl1WQACAGb/4QRxB` +
-	`k0AHQAtAOu4ADIruAAuL7gALy+4AADcuAAuELgACNC4AAgvuAAAELgAHtxBBQDaAB4A6gAeAAJdQRsACQAeABkAHgApAB4AOQAeAEkAHgBZAB4AaQAeAHkAHgCJAB4AmQAeAKkAHgC5AB4AyQAeAA1` +
-	`dugAQAAAAHhESObgACBC4ACbcQRsABgAmABYAJgAmACYANgAmAEYAJgBWACYAZgAmAHYAJgCGACYAlgAmAKYAJgC2ACYAxgAmAA1dQQUA1QAmAOUAJgACXboAGQAIACYREjkAuAAQL7oAKgAEADUru` +
-	`gAMABMANSu6ABsAIgA1KzAxARQHBiMgJyYREDcSJTY3NjcVBgcGBw4BAzMSITIABzQnJiMiBwYVFBcWMzI3NgRxiJDt/ueEaTVRAa+/G35KWHZnZtm1GQaCAQfXARDKR1WfoFVHR1Wgn1VHAhvxoKn` +
-	`gswEpAQ7gAVIcDAQSMrwqDggHEtr+6AEO/r/4p3GGhnGnqHGGhnEAAAAAAwCuAAAELwRdABAAGQAiAKW4ADIrugAVAAAANSu6AAYAGgA1K0EFANoAGgDqABoAAl1BGwAJABoAGQAaACkAGgA5ABoAS` +
-	`QAaAFkAGgBpABoAeQAaAIkAGgCZABoAqQAaALkAGgDJABoADV26AAgAGgAGERI5uAAGELgAEdy4AAvcuAAVELgAH9C4AAYQuAAk3AC6ABUAAAA1K7oAAgAeADUrugAhABMANSu6AAgAEwAhERI5MDE` +
-	`zESEyFxYVFAceARUUBgcGIxM0ISMRITI3NgM0JyYjIREhNq4BzsRDhbxlflNFSJ60/u/yATVWOT8iPSxn/u8BQKEEXR47orBkH6JtTYokJQFEvP6VKzACXE0bE/7EPQAAAAEBKQAABGAEXQAFABu4A` +
-	`DIrugAAAAEANSsAuAAAL7oAAwAEADUrMDEhIxEhFSEB88oDN/2TBF2bAAAAAAIALP6yBJ8EXQAOABYAa7gAMiu6AAQABQA1K7oAEgAKADUrugANAA8ANSu6AAEADwANERI5uAABL7gAANy6ABYACgA` +
-	`SERI5ALoADgAAADUrugAMABAANSu4AA4QuAAC3LgAABC4AATQuAAOELgAD9C4AA0QuAAW0DAxASMRIREjETY3NjURIREzIREhFRQHBgcEn7b8+baiRzECup/+p/61JSxS/rIBTv6yAegv35rRAUr8P` +
-	`QMo7bWVsEEAAgCC/+AETAR8ABYAIABhuAAyK7gAIS+4ACIvuAAhELgABtC4AAYvuAAiELgADty4AAYQuAAQ3LgADhC4ABjcugAWAA4AGBESObgAEBC4ABfQuAAXLwC6ABQAAgA1K7oACgAdADUrugA` +
-	`YAA8ANSswMSUGIyInJjU0NzYzMhcWHQEhFBcWMzI3ASE1NCcmIyIHBgQ838ftmI+QmOrqcV38/l1ko7Ta/RUCMTxDeoJWSEpqtKnv8au0tZX6KKlyeXgBpxt+UVxmVwAB//4AAATPBF0AEQBfuAAyK` +
-	`7oAAwAEADUruAAEELgAC9C4AAMQuAAN0AC4AAkvuAAML7gADy+4AAAvuAADL7gABi+6AAIAAAAJERI5ugAFAAAACRESOboACwAAAAkREjm6AA4AAAAJERI5MDEhIwERIxEBIwkBMwERMxEBMwEEz+n` +
-	`+5cn+5ekBLv7kzAEmyQEmzP7kAkX9uwJF/bsCXQIA/egCGP3oAhj+AAAAAQCp/98ELwR8ACYA2bgAMiu6ACMAGAA1K0EFANoAGADqABgAAl1BGwAJABgAGQAYACkAGAA5ABgASQAYAFkAGABpABgAe` +
-	`QAYAIkAGACZABgAqQAYALkAGADJABgADV26AA0AGAAjERI5uAANL0EFANoADQDqAA0AAl1BGwAJAA0AGQANACkADQA5AA0ASQANAFkADQBpAA0AeQANAIkADQCZAA0AqQANALkADQDJAA0ADV24AAD` +
-	`cugAlABgAIxESOQC6AAkABAA1K7oAHwAaADUrugAUABEANSu6ACUAEQAUERI5MDEBFAcGIyInNRYzMjc2NTQnJisBNTMyNzY1NCMiBzU2MzIXFhUUBxYEL5aGt9HiyuNoT1dfQ4SKplk5QdnGyNDBp` +
-	`W+MmtIBUaxpXVnFhDc9W3kuIYorMVyPibxoPEuWoVtPAAEAmwAABDIEXQAKAFu4ADIruAALL7gADC+4AAjcuAAA3LgACxC4AALQuAACL7gAAdy4AATQuAABELgABtC4AAYvALgAAy+4AAcvuAABL7g` +
-	`ACS+6AAAAAQADERI5ugAGAAEAAxESOTAxCQEjETMRBwEzESMDfv3XuroHAiq6ugNU/KwEXf1SmwNJ+6MAAgCbAAAEMgYQABEAHABXuAAyK7gAHS+4AB4vuAAa3LgAEty4AB0QuAAU0LgAFC+4ABPcu` +
-	`AAW0LgAExC4ABjQuAAYLwC4ABMvuAAbL7oACAANADUruAAIELgAANC4AA0QuAAE3DAxARYXFjMyNzY3MwYHBiMiJyYnCQEjETMRBwEzESMBwAgsL0VDMCsIgA1LUnx+UkkPAj7917q6BwIquroGEEI` +
-	`uMjIuQnxRWVlQff1E/KwEXf1SmwNJ+6MAAAAAAQDNAAAEpgRdAAoAO7gAMiu6AAoAAAA1K7gAChC4AALQALgAAS+4AAQvuAAAL7gABy+6AAMAAAABERI5ugAJAAAAARESOTAxMxEzEQEzCQEhARHNu` +
-	`gIZ+P3oAib/AP3hBF398QIP/fH9sgJO/bIAAAEABv/hBBkEXQATAB+4ADIrugAAAAEANSsAugANAAgANSu6ABMAAgA1KzAxISMRIQcCBwIhIic1FjMyNzYbASEEGbr+sAMLKk/++ThDMUmELBgICgK` +
-	`/A8J5/jGM/vMPqRO7aQEvAYQAAQA4AAAElQRdABEAe7gAMiu4ABIvuAATL7gAEhC4AAHQuAABL7gAANy4ABMQuAAH3LgACNy4AAXQuAAFL7gACBC4AArQuAAAELgAD9C6ABAAAQAAERI5ALgAAi+4A` +
-	`AUvuAAAL7gABy+6AAQAAAACERI5ugAKAAAAAhESOboAEAAAAAIREjkwMTMjETMJATMRIxMjBwMjAycjF9ujywFmAWbGugUFLPDC8y8FBQRd/OMDHfujAzOI/e8CEYiIAAAAAQCbAAAEMgRdAAsAS7g` +
-	`AMiu4AAwvuAANL7gADBC4AAPQuAADL7gAAty4AAXQuAANELgACty4AAvcuAAH0AC4AAQvuAAIL7gAAi+4AAovugAHAAAANSswMQEhESMRMxEhETMRIwN4/d26ugIjuroCAP4ABF3+PgHC+6MAAAIAV` +
-	`//hBHYEfAAPAB8Ay7gAMiu4ACAvuAAhL7gAIBC4AATQuAAEL7gAIRC4AAzcuAAU3EEFANoAFADqABQAAl1BGwAJABQAGQAUACkAFAA5ABQASQAUAFkAFABpABQAeQAUAIkAFACZABQAqQAUALkAFAD` +
-	`JABQADV24AAQQuAAc3EEbAAYAHAAWABwAJgAcADYAHABGABwAVgAcAGYAHAB2ABwAhgAcAJYAHACmABwAtgAcAMYAHAANXUEFANUAHADlABwAAl0AugAQAAAANSu6AAgAGAA1KzAxBSInJjU0NzYzM` +
-	`hcWFRQHBicyNzY1NCcmIyIHBhUUFxYCZ/aSiImR9vWSiIiS9ahWR0hWp6hWSEhWH6+k+/ujr6+k+vqlr5uMdLOydIyLdLOzdIwAAAABAJsAAAQyBF0ABwA3uAAyK7gACC+4AAkvuAAF3LgAANy4AAg` +
-	`QuAAD0LgAAy+4AALcALgAAi+4AAYvugAFAAAANSswMQEhESMRIREjA3j93boDl7oDwvw+BF37owAAAAACAI3+TgRbBHwAEgAhAJu4ADIruAAiL7gAIy+4AADcuAAiELgACNC4AAgvuAAH3LgACtC6A` +
-	`AsACAAAERI5uAAAELgAE9xBBQDaABMA6gATAAJdQRsACQATABkAEwApABMAOQATAEkAEwBZABMAaQATAHkAEwCJABMAmQATAKkAEwC5ABMAyQATAA1duAAHELgAG9AAuAAHL7oADwAXADUrugAeAAQ` +
-	`ANSswMQEQBwYhIicRIxEzFTY3NjMyFxYHNCcmIyIHBgcRFjMyNzYEW5Oh/vAlq7q6SGt6e7ZjU8oqOGxpdllEZVXBbmEClP7rvtEQ/k4GD/t0Tlihh9l6XHl1WHj+IRqcigABAKT/4QRHBHwAGQBlu` +
-	`AAyK7oAEwAGADUrQRsABgATABYAEwAmABMANgATAEYAEwBWABMAZgATAHYAEwCGABMAlgATAKYAEwC2ABMAxgATAA1dQQUA1QATAOUAEwACXQC6ABcAAgA1K7oACgAPADUrMDElBiMiJyY1NDc2MzI` +
-	`XFSYjIgcGFRQXFjMyNwRHu6n/op6fovStwcOasGpiaGyzgdEgP6qn/PaqrkGzWYJ4srZ2fUcAAAAAAQBYAAAEdQRdAAcAI7gAMiu6AAcAAAA1KwC4AAAvugADAAIANSu4AAIQuAAF0DAxIREhNSEVI` +
-	`RECAf5XBB3+VgPCm5v8PgAAAQAg/i4ErQRdABMAF7gAMisAuAABL7gABC+6AA8ACgA1KzAxJQEzCQEzAQYHBiMiJzUWMzI3NjcCNv38zQGbAVi7/jpkV47mVUNNRopcRz8tBDD8ugNG+8HvYp8PpBh` +
-	`ZRJIAAAMAOf5OBJQGTgAIABEAJwDguAAyK7oADQAWADUrugAAAAkANSu6ACEABQA1K0EFANoABQDqAAUAAl1BFQA5AAUASQAFAFkABQBpAAUAeQAFAIkABQCZAAUAqQAFALkABQDJAAUACl1BBwAJA` +
-	`AUAGQAFACkABQADXUEbAAYADQAWAA0AJgANADYADQBGAA0AVgANAGYADQB2AA0AhgANAJYADQCmAA0AtgANAMYADQANXUEFANUADQDlAA0AAl24AAkQuAAS0LgACRC4ABrQuAAAELgAHNC4AAAQuAA` +
-	`l0LgAIRC4ACncALgAGy+4ACYvMDEBETY3NjU0JyYlBgcGFRQXFhcVJicmNTQ3NjcRMxEWFxYVFAcGBxEjAsCIRjw8R/7Fh0Y8PEaH2oB5eYDatNqBeXmB2rQD3PylGYVxn59whRgZhHCfn3GEGZ4Xq` +
-	`qHp6aCqFwHV/isXqqDp6aGqF/5qAAAAAQA4AAAEnQRdAAsAK7gAMisAuAADL7gABi+4AAAvuAAJL7oABQAAAAMREjm6AAsAAAADERI5MDEhIwkBMwkBMwkBIwEBD9cBt/5m7gEjARvT/nwBze/+rAJ` +
-	`JAhT+iQF3/f79pQG9AAABAJH+sgS0BF0ACwBPuAAyK7oAAwAAADUrugAHAAQANSu4AAcQuAAJ3LgABxC4AArQuAAKL7gABxC4AA3cALgAAS+4AAUvugAIAAkANSu4AAgQuAAD0LgAANwwMTMRMxEhE` +
-	`TMRMxEjEZG6Afy6s7YEXfw9A8P8Pf4YAU4AAQCbAAAEKARdABEATbgAMiu4ABIvuAATL7gAEhC4AAbQuAAGL7gACdy4ABMQuAAQ3LgAEdy4AA3QALgABy+4AA4vuAAQL7oACwACADUrugAAAAIACxE` +
-	`SOTAxAQYjIicmNREzERAzMjcRMxEjA27Hq7hbTrrDr6e6ugIJnX5suAFP/sr+958BoPujAAABADsAAASTBF0ACwBDuAAyK7oAAwAAADUrugAHAAQANSu6AAsACAA1K7gACxC4AA3cALgAAS+4AAUvu` +
-	`AAJL7oAAwAAADUruAADELgAB9AwMTMRMxEhETMRIREzETu6ARa5ARW6BF38PQPD/D0Dw/ujAAAAAAEAO/6yBMgEXQAPAGm4ADIrugAHAAQANSu6AAsACAA1K7oADwAMADUrugACAAwADxESObgAAi+` +
-	`4AAHcuAAR3AC4AAUvuAAJL7gADS+6AAAAAQA1K7oADAADADUruAAAELgAB9C4AAwQuAAI0LgAABC4AAvQMDElESMRIREzESERMxEhETMRBMi2/Cm6AQy5AQu6mv4YAU4EXfw9A8P8PQPD/D0AAgAIA` +
-	`AAEXQRdAA4AFwCFuAAyK7oACQAIADUrugAAAA8ANSu4AAkQuAAF3EEFANoADwDqAA8AAl1BGwAJAA8AGQAPACkADwA5AA8ASQAPAFkADwBpAA8AeQAPAIkADwCZAA8AqQAPALkADwDJAA8ADV24AAk` +
-	`QuAAS0AC6ABQABAA1K7oACQAGADUrugALABEANSswMQEUBwYjIREhNSERMzIXFgc0ISMRMzI3NgRdqFGu/oD+0gHnfdeFlcn+zXKLjD9PAUjOUigDwpv+gGBrxfb+VyApAAAAAwBQAAAEfQRdAAMAE` +
-	`AAZAJW4ADIrugAVAAkANSu6AAQAEQA1K7oAAQAAADUruAAVELgAC9BBBQDaABEA6gARAAJdQRsACQARABkAEQApABEAOQARAEkAEQBZABEAaQARAHkAEQCJABEAmQARAKkAEQC5ABEAyQARAA1duAA` +
-	`BELgAG9wAuAAAL7gACi+6ABYACAA1K7oADQATADUruAAIELgAAtAwMQEzESMDFAcGIyERMxEzMhcWBzQhIxEzMjc2A8O6upCsU7D+zLkx24aYyv7VNU6KPUsEXfujAUvOVCkEXf6BXGrS/v5WICcAA` +
-	`AAAAgDGAAAEPwRdAAwAFQCJuAAyK7gAFi+4ABcvuAAA3LgAFhC4AAXQuAAFL7gAEdy4AAfQuAAAELgADdxBBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0` +
-	`AmQANAKkADQC5AA0AyQANAA1dALgABi+6ABIABAA1K7oACQAPADUrMDEBFAcGIyERMxEzMhcWBzQhIxEzMjc2BD+oUa7+LrnP14WVyf7NxN2MP08BSM5SKARd/oBga8X2/lcgKQABAIb/4QQpBHwAG` +
-	`wAfuAAyKwC6ABgAEwA1K7oADAAHADUrugADAAAANSswMQEhNSEmJyYjIgc1NjMyFxYVEAAjIic1FjMyNzYDXv3gAhkfXmSTmsPBrfSin/68+6m70YGmaWQCAJuVV1pZs0Guqvb/AP6zP7NHaWQAAAA` +
-	`AAgA8/+EEkQR8ABYAJgCRuAAyK7oABgAHADUrugAjAAQANSu6ABMAGwA1K7gABhC4AAnQuAAEELgAC9C4AAsvQQUA2gAbAOoAGwACXUEbAAkAGwAZABsAKQAbADkAGwBJABsAWQAbAGkAGwB5ABsAi` +
-	`QAbAJkAGwCpABsAuQAbAMkAGwANXbgAExC4ACjcALoAFwAAADUrugAPAB8ANSswMQUiJyYnIxEjETMRMzY3NjMyFxYREAcGJzI3NjU0JyYjIgcGFRQXFgL0w2pmCmuwsG8UWGrDwmpxcWrCaTc7Ozd` +
-	`pajc7Ozcfnpfq/gAEXf4+woKdnaf+9/73p56banTV1HRqanTU1XRqAAAAAAQANwAABJ4GEAAOABsAHwArAN24ADIrugATABQANSu6AA8AEAA1K7oAJAAIADUrugAfACAANSu4AB8QuAAA0LgAAC9BB` +
-	`QDaAAgA6gAIAAJdQRsACQAIABkACAApAAgAOQAIAEkACABZAAgAaQAIAHkACACJAAgAmQAIAKkACAC5AAgAyQAIAA1duAATELgAEdC4ABEvuAAQELgAGNC4ABgvuAAQELgAGtC4AAgQuAAd0LgAHS+` +
-	`4AB8QuAAt3AC4ABUvuAAaL7oAHgAdADUrugAoAAQANSu6AAwAIgA1K7gAHRC4AA/QuAAdELgAE9AwMQEUBwYjIicmNTQ3NjMyFgEjARcRIxEzATMnETMBITUhAzQjIhUUFxYzMjc2BJ09QW1pPjs6P` +
-	`mxrfv4Dpv7fBKaiASEFBaYB/v4wAdCcS0sOFCgpFA8CRoxZX1lUh4lTWKP9OQQFofycBhD8EZoDVfnwmwGftL45MkdMNQABARsAAARwB6kABwA7uAAyK7gACC+4AAkvuAAA3LgACBC4AAPQuAADL7g` +
-	`AAty4AAAQuAAF3AC4AAYvuAACL7oABQAAADUrMDEBIREjESERMwRw/XXKApXABXT6jAYQAZkAAAAAAQEpAAAEYAW6AAcAP7gAMiu4AAgvuAAJL7gAANy4AAgQuAAD0LgAAy+4AALcuAAAELgABdwAu` +
-	`AACL7oABwAAADUruAAAELgABdwwMQEhESMRIREzBGD9k8oCgbYDwvw+BF0BXQAAAP//AEgAZQSGA9EARwCPBM0AAMAIQAAAAP//AEcAZQSFA9ECBgCPAAD//wA//+EEjQZOAEcAmATNAADACEAAAAD` +
-	`//wBA/+EEjgZOAgYAmAAA//8Aav5OBHIGEABHAJkEzQAAwAhAAAAA//8AW/5OBGMGEAIGAJkAAP//AJ3+jAQwBj4ARwCcBM0AAMAIQAAAAP//AJ3+jAQwBj4CBgCcAAD//wAC/owExAZtAEcApQTNA` +
-	`ADACEAAAAD//wAJ/owEywZtAgYApQAA//8AOACyBJUDqwBHAKcEzQAAwAhAAAAAAAIAOACyBJUDqwAeAD0AR7gAMiu6ABEADgA1K7gADhC4AC3QuAARELgAL9C4ABEQuAA/3AC6ACoAMwA1K7oABAA` +
-	`ZADUrugAjADgANSu6AAsAFAA1KzAxEzU0NjMyHgEfARYzMjY9ATMVFAYjIi8BJiMiDgEdAQM1NDYzMh4BHwEWMzI2PQEzFRQGIyIvASYjIg4BHQE4m4MhP1BQeW9GSkeAvWpjo2Z0RyhFKnibgyE/U` +
-	`FB5b0ZKR4C9amOjZnRHKEUqAlUSg8ENHy1DPmw/EBCnn107QipcIxL+fBKDwQ0fLEQ9az8QEKefXjpCKlwjEgAAAP//AhX/0QK4Bj4CBgBfAAD//wCdAAAEzAYQAgYALgAA//8AIQAABK0HZQIGAGM` +
-	`AAP//AKwAAAQgB7wCJgAsAAAABwDbAAABbv//AJ0AAAPEBhECJgDXAAAABgDb0cMAAP//AKz+LgO+BokCJgF0AAAABgDYMDsAAP//Adf/0QL2BhACBgAEAAD//wAhAAAErQe8AiYAJAAAAAcA4QAAA` +
-	`W7//wBm/+EEXgYhAiYARAAAAAYA4QDTAAD//wCsAAAEIAe8AiYALAAAAAcA4QAAAW7//wCdAAADxAYRAiYA1wAAAAYA4dHDAAD//wBH/9EEhQfqAiYAMgAAAAcA4QAAAZz//wBX/+EEdgYwAiYAUgA` +
-	`AAAYA4QDiAAD//wB+/9EETwe8AiYAOAAAAAcA4QAAAW7//wCP/+EEMgYRAiYAWAAAAAYA4QDDAAD//wB+/9EETwhQAiYAOAAAACcAjgAAAW4ABwDaAAACzP//AI//4QQyBp0CJgBYAAAAJgCOAMMAB` +
-	`wDaAAABGQAA//8Afv/RBE8JGgImADgAAAAnAI4AAAFuAAcAjQAmAsz//wCP/+EEMgdnAiYAWAAAACYAjgDDAAcAjQAgARkAAP//AH7/0QRPCRoCJgA4AAAAJwCOAAABbgAHAOEAAALM//8Aj//hBDI` +
-	`HZwImAFgAAAAmAI4AwwAHAOEAAAEZAAD//wB+/9EETwkaAiYAOAAAACcAjgAAAW4ABwBDACYCzP//AI//4QQyB2cCJgBYAAAAJgCOAMMABwBDACABGQAA//8AIQAABK0IUAImACQAAAAnAI4AAQFuA` +
-	`AcA2gABAsz//wBm/+EEXgatAiYARAAAACYAjgDTAAcA2gAAASkAAP//ACEAAAStCJ4CJgAkAAAAJwDcAAEBbgAHANoAAQMa//8AZv/hBF4G+wImAEQAAAAmANwA0wAHANoAAAF3AAD//wACAAAEnQb` +
-	`yAiYAkAAAAAcA2v/xAW7//wBA/+EEjgVmAiYAoAAAAAYA2gHiAAD//wB2/9EEQAfrAiYAKgAAAAcA4f/8AZ3//wBy/i8EQAYhAiYASgAAAAYA4frTAAD//wCdAAAEzAe8AiYALgAAAAcA4QBNAW7//` +
-	`wDNAAAEpgf6AiYATgAAAAcA4QBSAaz//wBH/icEhQY+AiYAMgAAAAYA4ADZAAD//wBX/jcEdgR8AiYAUgAAAAYA4ADpAAD//wBH/icEhQcgAiYAMgAAACYA4ADZAAcA2gAAAZwAAP//AFf+NwR2BWY` +
-	`CJgBSAAAAJgDgAOkABgDaAOL//wCs/i4DvgaJAiYBdAAAAAYA4TA7AAD//wB2/9EEQAfrAiYAKgAAAAcAjQAbAZ3//wB+AAAETwe8AiYAMQAAAAcAQwAmAW7//wCbAAAEPgYwAiYAUQAAAAYAQyziA` +
-	`AD//wAhAAAErQmWAiYAJAAAACcA3QABAW4ABwCNACcDSP//AGb/4QReB/MCJgBEAAAAJgDdANMABwCNACIBpQAA//8AAgAABJ0HvAImAJAAAAAHAI0ADwFu//8AQP/hBI4GMAImAKAAAAAGAI0n4gA` +
-	`A//8AR/+TBIUIKAImAJEAAAAHAI0AJgHa//8AV/+jBHYGZgImAKEAAAAGAI0mGAAA//8AfgAABE8HvAImACsAAAAHAOEAAAFu//8AmwAABD4H+gImAEsAAAAHAOEABQGs//8AIQAABK0HuQImACQAA` +
-	`AAHANwAAQFu//8AZv/hBF4GHgImAEQAAAAGANwA0wAA//8Ay/5NBBEGEAImACgAAAAGAN4IAAAA//8Agv41BEwEfAImAEgAAAAGAN4B6AAA//8AR//RBIUIfgImADIAAAAnAI4AAAGcAAcA2gAAAvr` +
-	`//wBX/+EEdga8AiYAUgAAACYAjgDiAAcA2gAAATgAAP//AEf/0QSFCIcCJgAyAAAAJwDZAAABnQAHANoAAAMD//8AV//hBHYGxQImAFIAAAAmANkA4wAHANoAAAFBAAD//wBH/9EEhQfnAiYAMgAAA` +
-	`AcA3AAAAZz//wBX/+EEdgYtAiYAUgAAAAYA3ADiAAD//wBH/9EEhQjMAiYAMgAAACcA3AAAAZwABwDaAAADSP//AFf/4QR2BwoCJgBSAAAAJgDcAOIABwDaAAABhgAA//8ANAAABJkG8gImADwAAAA` +
-	`HANoAAAFu//8AIP4uBK0FRwImAFwAAAAGANoAwwAA//8BIAQCA6sGJQAPALUAmQFUMAD//wCsAAAEIAYQAgYALAAA//8AIf4uBK0GEAImACQAAAAHAN0AAfkm//8AZv4PBF4EbQImAEQAAAAHAN0AA` +
-	`PkH//8ArAAABDgHuQImACUAAAAHANwADAFu//8Ajf/wBFsH9wImAEUAAAAHANwADgGs//8ArP6qBDgGEAImACUAAAAHANwADPlU//8Ajf6aBFsGTgImAEUAAAAHANwADvlE//8ArP8mBDgGEAImACU` +
-	`AAAAHANoADPoe//8Ajf8WBFsGTgImAEUAAAAHANoADvoO//8Adv5NBEAH6wImAGQAAAAHAI0AGwGd//8ApP5NBEcGMAImAG8AAAAGAI014gAA//8AmwAABHwHuQImACcAAAAHANwAJQFu//8Acv/hB` +
-	`EAH9wImAEcAAAAHANz/+wGs//8Am/6qBHwGEAImACcAAAAHANwAJflU//8Acv6LBEAGTgImAEcAAAAHANz/+/k1//8Am/8mBHwGEAImACcAAAAHANoAJfoe//8Acv8HBEAGTgImAEcAAAAHANr/+/n` +
-	`///8Am/1KBHwGEAImACcAAAAPAA8AYf6QMAAAAP//AHL9SgRABk4CJgBHAAAADwAPAMH+kDAAAAD//wCb/lwEfAYQAiYAJwAAAAcA2AAk+VT//wBy/j0EQAZOAiYARwAAAAcA2P/6+TX//wDLAAAEE` +
-	`QieAiYAKAAAACcA2gAIAW4ABwBDAC4CUP//AIL/4ARMBwoCJgBIAAAAJgDaAeIABwBDACcAvAAA//8AywAABBEIngImACgAAAAnANoACAFuAAcAjQAuAlD//wCC/+AETAcKAiYASAAAACYA2gHiAAc` +
-	`AjQAnALwAAP//AMv+XAQRBhACJgAoAAAABwDYAAf5VP//AIL+PARMBHwCJgBIAAAABwDYAAD5NP//AMv+oQQRBhACJgAoAAAABwDZAAf5mv//AIL+gQRMBHwCJgBIAAAABwDZAAD5ev//AMv+TQQRB` +
-	`7wCJgAoAAAAJwDbAAcBbgAGAN4IAAAA//8Agv41BEwGMAImAEgAAAAmANsA4gAGAN4B6P//AOMAAAQ4B7kCJgApAAAABwDcACcBbv//AKcAAASICBYCJgBJAAAABwDcADEBy///AHb/0QRAByECJgA` +
-	`qAAAABwDa//0Bnf//AHL+LwRABVcCJgBKAAAABgDa+9MAAP//AH4AAARPB7kCJgArAAAABwDcAAABbv//AJsAAAQ+B/cCJgBLAAAABwDcAAYBrP//AH7+qgRPBhACJgArAAAABwDcAAD5VP//AJv+q` +
-	`gQ+Bk4CJgBLAAAABwDcAAb5VP//AH4AAARPB24CJgArAAAABwCOAAABbv//AJsAAAQ+B6wCJgBLAAAABwCOAAYBrP//AH7+TQRPBhACJgArAAAABwDe/soAAP//AJv+TQQ+Bk4CJgBLAAAABwDe/uc` +
-	`AAP//AH7+XARPBhACJgArAAAABwDbAAD5VP//AJv+XAQ+Bk4CJgBLAAAABwDbAAX5VP//AKz+oQQgBhACJgAsAAAABwDZAAD5mv//AJ7+oQPFBm0CJgBMAAAABwDZ/9L5mv//AKwAAAQgCRoCJgAsA` +
-	`AAAJwCOAAABbgAHAI0AJgLM//8AnQAAA8QHZwImANcAAAAmAI7SwwAHAI3/+AEZAAD//wCdAAAEzAe8AiYALgAAAAcAjQB0AW7//wDNAAAEpgf6AiYATgAAAAcAjQB5Aaz//wCd/qoEzAYQAiYALgA` +
-	`AAAcA3ABO+VT//wDN/qoEpgZOAiYATgAAAAcA3ABT+VT//wCd/yYEzAYQAiYALgAAAAcA2gBO+h7//wDN/yYEpgZOAiYATgAAAAcA2gBT+h7//wDb/qoEIQYQAiYALwAAAAcA3AAY+VT//wB5/qoD3` +
-	`gZOAiYATwAAAAcA3P/N+VT//wDb/qoEIQbyAiYALwAAACcA3AAY+VQABwDaABgBbv//AHn+qgPeBzACJgBPAAAAJwDc/835VAAHANr/zQGs//8A2/8mBCEGEAImAC8AAAAHANoAGPoe//8Aef8mA94` +
-	`GTgImAE8AAAAHANr/zfoe//8A2/5cBCEGEAImAC8AAAAHANgAF/lU//8Aef5cA94GTgImAE8AAAAHANj/zPlU//8AOAAABJUHvAImADAAAAAHAI0AJgFu//8ARwAABIUGMAImAFAAAAAGAI0m4gAA/` +
-	`/8AOAAABJUHuQImADAAAAAHANwAAAFu//8ARwAABIUGLQImAFAAAAAGANwA4gAA//8AOP6qBJUGEAImADAAAAAHANwAAPlU//8AR/6qBIUEfAImAFAAAAAHANwAAPlU//8AfgAABE8HuQImADEAAAA` +
-	`HANwAAAFu//8AmwAABD4GLQImAFEAAAAGANwG4gAA//8Afv6qBE8GEAImADEAAAAHANwAAPlU//8Am/6qBD4EfAImAFEAAAAHANwABvlU//8Afv8mBE8GEAImADEAAAAHANoAAPoe//8Am/8mBD4Ef` +
-	`AImAFEAAAAHANoABvoe//8Afv5cBE8GEAImADEAAAAHANgAAPlU//8Am/5cBD4EfAImAFEAAAAHANgABflU//8AR//RBIUJUQImADIAAAAnANkAAAGdAAcAjQAmAwP//wBX/+EEdgePAiYAUgAAACY` +
-	`A2QDjAAcAjQAmAUEAAP//AEf/0QSFCQMCJgAyAAAAJwDZAAABnQAHAI4AAAMD//8AV//hBHYHQQImAFIAAAAmANkA4wAHAI4AAAFBAAD//wBH/9EEhQjMAiYAMgAAACcA2gAAAZwABwBDACYCfv//A` +
-	`Ff/4QR2BwoCJgBSAAAAJgDaAOIABwBDACYAvAAA//8AR//RBIUIzAImADIAAAAnANoAAAGcAAcAjQAmAn7//wBX/+EEdgcKAiYAUgAAACYA2gDiAAcAjQAmALwAAP//ALAAAARuB7wCJgAzAAAABwC` +
-	`NAE8Bbv//AI3+TgRbBjACJgBTAAAABgCNNOIAAP//ALAAAARuB7kCJgAzAAAABwDcACkBbv//AI3+TgRbBi0CJgBTAAAABgDcDuIAAP//AJUAAAS0B7kCJgA1AAAABwDcAD4Bbv//APoAAARABi0CJ` +
-	`gBVAAAABgDcN+IAAP//AJX+qgS0BhACJgA1AAAABwDcAD75VP//APr+qgRABHwCJgBVAAAABwDcADf5VP//AJX+qgS0BvICJgA1AAAAJwDaAD4BbgAHANwAPvlU//8A+v6qBEAFZgImAFUAAAAmANo` +
-	`34gAHANwAN/lUAAD//wCV/yYEtAYQAiYANQAAAAcA2gA++h7//wD6/yYEQAR8AiYAVQAAAAcA2gA3+h7//wCd/9EETwfnAiYANgAAAAcA3AAQAZz//wCo/+EENAYtAiYAVgAAAAYA3AjiAAD//wCd/` +
-	`nsETwY+AiYANgAAAAcA3AAQ+SX//wCo/osENAR8AiYAVgAAAAcA3AAI+TX//wCd/9EETwmTAiYANgAAACcAjQA2AZwABwDcABADSP//AKj/4QQ0B9ECJgBWAAAAJgCNLuIABwDcAAgBhgAA//8Anf/` +
-	`RBE8JkwImADYAAAAnAOEADwGcAAcA3AAQA0j//wCo/+EENAfRAiYAVgAAACYA4QfiAAcA3AAIAYYAAP//AJ3+ewRPB+cCJgA2AAAAJwDcABD5JQAHANwAEAGc//8AqP6LBDQGLQImAFYAAAAnANwAC` +
-	`Pk1AAYA3AjiAAD//wAwAAAEnQe5AiYANwAAAAcA3AAAAW7//wBg/+EEQQdrAiYAVwAAAAcA3P/yASD//wAw/qoEnQYQAiYANwAAAAcA3AAA+VT//wBg/osEQQXCAiYAVwAAAAcA3P/y+TX//wAw/yY` +
-	`EnQYQAiYANwAAAAcA2gAA+h7//wBg/wcEQQXCAiYAVwAAAAcA2v/y+f///wAw/lwEnQYQAiYANwAAAAcA2AAA+VT//wBg/j0EQQXCAiYAVwAAAAcA2P/x+TX//wB+/nsETwYQAiYAOAAAAAcAjgAA+` +
-	`XP//wCP/osEMgRdAiYAWAAAAAcAjgAA+YP//wB+/nIETwYQAiYAOAAAAAcA2QAA+Wv//wCP/oIEMgRdAiYAWAAAAAcA2QAA+Xv//wB+/i0ETwYQAiYAOAAAAAcA2AAA+SX//wCP/j0EMgRdAiYAWAA` +
-	`AAAcA2AAA+TX//wB+/9EETwkjAiYAOAAAACcA2QAAAW8ABwCNACYC1f//AI//4QQyB3ACJgBYAAAAJgDZAMQABwCNACABIgAA//8Afv/RBE8IUAImADgAAAAnANoAAAFuAAcAjgAAAlD//wCP/+EEM` +
-	`gadAiYAWAAAACYA2gDDAAcAjgAAAJ0AAP//ACEAAAStB3cCJgA5AAAABwDZAAABb///ACEAAAStBcwCJgBZAAAABgDZAMQAAP//ACH+qgStBhACJgA5AAAABwDcAAH5VP//ACH+qgStBF0CJgBZAAA` +
-	`ABwDcAAH5VP//ABwAAASxB7wCJgA6AAAABwBDACYBbv//ABQAAAS4BhECJgBaAAAABgBDJsMAAP//ABwAAASxB7wCJgA6AAAABwCNACYBbv//ABQAAAS4BhECJgBaAAAABgCNJsMAAP//ABwAAASxB` +
-	`24CJgA6AAAABwCOAAABbv//ABQAAAS4BcMCJgBaAAAABgCOAMMAAP//ABwAAASxB7kCJgA6AAAABwDcAAABbv//ABQAAAS4Bg4CJgBaAAAABgDcAMMAAP//ABz+qgSxBhACJgA6AAAABwDcAAD5VP/` +
-	`/ABT+qgS4BF0CJgBaAAAABwDcAAD5VP//ADAAAASdB7kCJgA7AAAABwDcAAABbv//ADgAAASdBg4CJgBbAAAABgDcBMMAAP//ADAAAASdB24CJgA7AAAABwCOAAABbv//ADgAAASdBcMCJgBbAAAAB` +
-	`gCOBMMAAP//ADQAAASZB7kCJgA8AAAABwDcAAABbv//ACD+LgStBg4CJgBcAAAABgDcAMMAAP//AHoAAARTB7wCJgA9AAAABwDYAAABbv//AIUAAAQ/BhECJgBdAAAABgDYAMMAAP//AHr+qgRTBhA` +
-	`CJgA9AAAABwDcAAD5VP//AIX+qgQ/BF0CJgBdAAAABwDcAAD5VP//AHr/JgRTBhACJgA9AAAABwDaAAD6Hv//AIX/JgQ/BF0CJgBdAAAABwDaAAD6Hv//AJv/JgQ+Bk4CJgBLAAAABwDaAAb6Hv//A` +
-	`GD/4QRBByACJgBXAAAABwCO//IBIP//ABQAAAS4Bj8CJgBaAAAABgDdAMMAAP//ACD+LgStBj8CJgBcAAAABgDdAMMAAP//ACH+qgStBhACJgAkAAAABwDcAAH5VP//AGb+iwReBG0CJgBEAAAABwD` +
-	`cAAD5Nf//ACEAAAUsCIQCJgAkAAAAJwDYAAABbgAHAI0B7AI2//8AZv/hBScG5wImAEQAAAAmANgA0wAHAI0B5wCZAAD//wAhAAAEyAiEAiYAJAAAACcA2AAAAW4ABwBDAYgCNv//AGb/4QTDBucCJ` +
-	`gBEAAAAJgDYANMABwBDAYMAmQAA//8AIQAABK0JIwImACQAAAAnANgAAAFuAAcA2QAAAxv//wBm/+EEXgeAAiYARAAAACYA2ADTAAcA2QAAAXgAAP//ACH+qgStB7wCJgAkAAAAJwDYAAABbgAHANw` +
-	`AAflU//8AZv6LBF4GIQImAEQAAAAmANgA0wAHANwAAPk1AAD//wAhAAAErQloAiYAJAAAACcA2wAAAW4ABwCNACcDGv//AGb/4QReB8UCJgBEAAAAJgDbANMABwCNACIBdwAA//8AIQAABK0JaAImA` +
-	`CQAAAAnANsAAAFuAAcAQwAnAxr//wBm/+EEXgfFAiYARAAAACYA2wDTAAcAQwAiAXcAAP//ACEAAAStCSMCJgAkAAAAJwDbAAABbgAHANkAAAMb//8AZv/hBF4HgAImAEQAAAAmANsA0wAHANkAAAF` +
-	`4AAD//wAh/qoErQe8AiYAJAAAACcA2wAAAW4ABwDcAAH5VP//AGb+iwReBiECJgBEAAAAJgDbANMABwDcAAD5NQAA//8Ay/6qBBEGEAImACgAAAAHANwACPlU//8Agv6KBEwEfAImAEgAAAAHANwAA` +
-	`fk0//8AywAABBEHdwImACgAAAAHANkABwFv//8Agv/gBEwF6wImAEgAAAAGANkA4wAA//8AywAABTMIhAImACgAAAAnANgABwFuAAcAjQHzAjb//wCC/+AFLAb2AiYASAAAACYA2ADiAAcAjQHsAKg` +
-	`AAP//AMsAAATPCIQCJgAoAAAAJwDYAAcBbgAHAEMBjwI2//8Agv/gBMgG9gImAEgAAAAmANgA4gAHAEMBiACoAAD//wDLAAAEEQkjAiYAKAAAACcA2AAHAW4ABwDZAAcDG///AIL/4ARMB48CJgBIA` +
-	`AAAJgDYAOIABwDZAAABhwAA//8Ay/6qBBEHvAImACgAAAAnANgABwFuAAcA3AAI+VT//wCC/ooETAYwAiYASAAAACYA2ADiAAcA3AAB+TQAAP//AKz+qgQgBhACJgAsAAAABwDcAAD5VP//AJ7+qgP` +
-	`FBm0CJgBMAAAABwDc/9P5VP//AEf+ewSFBj4CJgAyAAAABwDcAAD5Jf//AFf+iwR2BHwCJgBSAAAABwDcAAD5Nf//AEf/0QUrCLICJgAyAAAAJwDYAAABnAAHAI0B6wJk//8AV//hBSsG9gImAFIAA` +
-	`AAmANgA4gAHAI0B6wCoAAD//wBH/9EExwiyAiYAMgAAACcA2AAAAZwABwBDAYcCZP//AFf/4QTHBvYCJgBSAAAAJgDYAOIABwBDAYcAqAAA//8AR//RBIUJUQImADIAAAAnANgAAAGcAAcA2QAAA0n` +
-	`//wBX/+EEdgePAiYAUgAAACYA2ADiAAcA2QAAAYcAAP//AEf+ewSFB+oCJgAyAAAAJwDYAAABnAAHANwAAPkl//8AV/6LBHYGMAImAFIAAAAmANgA4gAHANwAAPk1AAD//wB+/nsETwYQAiYAOAAAA` +
-	`AcA3AAA+SX//wCP/osEMgRdAiYAWAAAAAcA3AAA+TX//wA0AAAEmQe8AiYAPAAAAAcAQwAmAW7//wAg/i4ErQYRAiYAXAAAAAYAQybDAAD//wA0/qoEmQYQAiYAPAAAAAcA3AAA+VT//wAg/NgErQR` +
-	`dAiYAXAAAAAcA3AAA94L//wA0AAAEmQd3AiYAPAAAAAcA2QAAAW///wAg/i4ErQXMAiYAXAAAAAYA2QDEAAD//wDLAAAEEQieAiYAKAAAACcA2AAHAW4ABwDaAAgDGv//AIL/4ARMBwoCJgBIAAAAJ` +
-	`gDYAOIABwDaAAEBhgAA//8AywAABBEJaAImACgAAAAnANgABwFuAAcA4QAHAxr//wCC/+AETAfUAiYASAAAACYA2ADiAAcA4QAAAYYAAP//AKwAAAQgB24CJgGkAAAABwCOAAABbv//AIL/4ARMBjA` +
-	`CJgHGAAAABgBDJ+IAAP//AJsAAAQyBhECJgHJAAAABgBDJsMAAP////4AAATPBhECJgHHAAAABgDbAMMAAP//ACEAAAStB7wCJgGEAAAABwDbAAABbv//AGT/4QRcBiECJgHBAAAABgDbANMAAP//A` +
-	`CEAAAStB24CJgGEAAAABwCOAAEBbv//AGT/4QRcBdMCJgHBAAAABgCOANMAAP//AIL/4ARMBjACJgHGAAAABgDbAOIAAP////4AAATPBcMCJgHHAAAABgCOAMMAAP//AKn/3wQvBeICJgHIAAAABgC` +
-	`OBuIAAP//AJsAAAQyBUcCJgHJAAAABgDaAMMAAP//AJsAAAQyBcMCJgHJAAAABgCOAMMAAP//AEf/0QSFB5wCJgGSAAAABwCOAAABnP//AFf/4QR2BeICJgHPAAAABgCOAOIAAP//AI3/0QRXB50CJ` +
-	`gGhAAAABwCOAAwBnf//AIb/4QQpBeICJgHeAAAABgCO+eIAAP//ACj/4QStBvICJgGXAAAABwDaAAQBbv//ACD+LgStBUcCJgHUAAAABgDaAMMAAP//ACj/4QStB24CJgGXAAAABwCOAAQBbv//ACD` +
-	`+LgStBcMCJgHUAAAABgCOAMMAAP//ACj/4QStB7wCJgGXAAAABwDf/8YBbv//ACD+LgStBhECJgHUAAAABgDfwsMAAP//AJsAAAQoBcMCJgHYAAAABgCOAMMAAP//AFAAAAR9BcMCJgHcAAAABgCOA` +
-	`MMAAP//AAIAAASdBhACBgCQAAD//wBA/+EEjgR8AgYAoAAA//8AOAAABJUHvAImADAAAAAHAEMAJgFu//8ARwAABIUGMAImAFAAAAAGAEMm4gAA///9If1e/hb+UwAHANz7NfgIAAD///xG/RD+8f4` +
-	`IAAcAjvs1+AgAAP///OH9EP5V/oQABwDd+zX4CAAA///8dP0Q/sL+VgAHANj7NPgIAAD///x0/RD+wv5WAAcA2/s0+AgAAP///FX9D/7h/hAABwDZ+zT4CAAA///8hP0Q/rP9jAAHANr7NfgIAAAAA` +
-	`QCnAAAEiAZtABMAL7gAMiu6ABIAAwA1K7gAEhC4AADcuAAE0AC4AAAvugAJAA4ANSu6AAQAAQA1KzAxIREjNTM1ND4BMzIXFSYjIg4BFREBn/j4ZNGsgoaRcFmITQNlmzTd1IgbsTBClbL7uAAAAAE` +
-	`AzQAABKYEXQAKADu4ADIrugAKAAAANSu4AAoQuAAC0AC4AAEvuAAEL7gAAC+4AAcvugADAAAAARESOboACQAAAAEREjkwMTMRMxEBMwkBIQERzboCGfj96AIm/wD94QRd/fsCBf37/agCWP2oAAABA` +
-	`H7+LgRPBhAAHwB1uAAyK7gAIC+4ACEvuAAgELgADdC4AA0vuAAhELgAFty6AAEADQAWERI5uAAR3LgACNC4AAgvuAANELgADNy4AArQuAAKL7gADBC4AA/QuAAPL7gAERC4ABPQuAAWELgAGNAAuAA` +
-	`OL7gAFC+6AAMAHgA1KzAxATUWMzI2NzY3AScXESMRMwEXMycRMxEdASMGBw4BIyIBZpxrXYIkGwf97UsEurYCJTwFBboFDTFDvqV3/kquLkFNOXQD86Oh/AYGEPv3mpoECfp3HGuxUm9gAAAAAAEAm` +
-	`/4uBD4EfAAjAFu4ADIruAAkL7gAJS+4ACQQuAAR0LgAES+4ABDcuAAA0LgAJRC4ABzcugABABEAHBESObgAB9y4ABAQuAAT0LoAFAARABwREjkAugADACIANSu6ABcADAA1KzAxATUWMzI+ATURNC4` +
-	`BIyIGBxEjETMVPgEzMh4BFREjBgcOASMiAVWca12CSR9TMWLhSbq6UOJxY6JBBQ0xQ76ld/5Kri5Bmr8CI6FnQrqM/XUEXfSBkmmq+f2QsVJvYAAAAQDLAAAEEQYQAAsAN7gAMiu6AAkAAAA1K7gAA` +
-	`BC4AATQuAAJELgADdwAugAAAAkANSu6AAgABQA1K7oABAABADUrMDElESE1IREhNSERITUDR/3SAi79lAM2/LqrAh+bAg+c+fCrAAACAIL/0QRMBjkAFQAdAFW4ADIruAAeL7gAHy+4AAbcuAAeELg` +
-	`ADdC4AA0vuAAGELgAFty4AA/QuAAPL7gADRC4ABfcugAVAA0AFxESOQC4AAIvugAbAAoANSu6AA8AFgA1KzAxEzYzMhYSFRQCBiMiAhE1ITQCJiMmBwEhFRQWMzI2kt/Hl+6PiPSWx/EC9FOyUbTaA` +
-	`uP9vaBwcb8FpZTR/nzd1P542gGKAZw3ogFWShSn/YIlz7W5AAAAAAIAgv/gBEwEfAAVAB0AMbgAMiu6ABcADQA1K7oAFQANABcREjkAugAbAAoANSu6AAIAEwA1K7oADwAWADUrMDETNjMyFhIVFAI` +
-	`GIyICETUhNC4BIyIHASEVFBYzMjaS38eX7o+I9JbH8QMCYZ5ltNoC5/3ThnBxpQQSapb+6Z+Z/uadARwBKCh0wGB4/lkbiaKlAAACAIL/4ARMBHwAFQAdADG4ADIrugAXAA0ANSu6ABUADQAXERI5A` +
-	`LoAGwAKADUrugACABMANSu6AA8AFgA1KzAxEzYzMhYSFRQCBiMiAhE1ITQuASMiBwEhFRQWMzI2kt/Hl+6PiPSWx/EDAmGeZbTaAuf904ZwcaUEEmqW/umfmf7mnQEcASgodMBgeP5ZG4mipQAAAfs` +
-	`fBQgAFAWEAAMAD7gAMisAugABAAAANSswMQE1IRX7HwT1BQh8fAAAAAAC/IoFCP6pBk4AAwAHABe4ADIrALgAAC+4AAQvuAACL7gABi8wMQEzEyMBMxMj/aB7jnT+VX6RdgZO/roBRv66AAAAAAH7J` +
-	`P0IAA/9hAADAA+4ADIrALoAAQAAADUrMDEBNSEV+yQE6/0IfHwAAAAAAfxyBQj+wAZOAA8AH7gAMisAugAEAAkANSu4AAkQuAAA0LgABBC4AAzcMDEBPgIzMh4BFyMuASMiBgf8cg1Zf0NDflkMgAl` +
-	`gPT1iCQUIb4lOTolvS3d1TQAAAAABAJ3/0QRPBj4AJwDVuAAyK7gAJy+4ACgvuAAnELgABtC4AAYvuAAoELgAGdy4AA7cQQUA2gAOAOoADgACXUEbAAkADgAZAA4AKQAOADkADgBJAA4AWQAOAGkAD` +
-	`gB5AA4AiQAOAJkADgCpAA4AuQAOAMkADgANXbgABhC4ACDcQRsABgAgABYAIAAmACAANgAgAEYAIABWACAAZgAgAHYAIACGACAAlgAgAKYAIAC2ACAAxgAgAA1dQQUA1QAgAOUAIAACXboAEwAGACA` +
-	`REjkAugAkAAMANSu6ABYAEQA1KzAxJQ4BIyAANTQ/Az4BNTQmIyIHNTYzIBYVFAUPAQ4BFRQeATMyNxUET4inXP7f/vr/UmFOX3qfgaKrm6YBAvP+8GdmkmpNllLE8CExHwECtOycMDsvNX5fYIhCr` +
-	`TDvr++hOztVjVFFdj9yvgAAAAABAKj/4QQ0BHwAKgDVuAAyK7gAKi+4ACsvuAAqELgABdC4AAUvuAArELgAGdy4AA7cQQUA2gAOAOoADgACXUEbAAkADgAZAA4AKQAOADkADgBJAA4AWQAOAGkADgB` +
-	`5AA4AiQAOAJkADgCpAA4AuQAOAMkADgANXbgABRC4ACPcQRsABgAjABYAIwAmACMANgAjAEYAIwBWACMAZgAjAHYAIwCGACMAlgAjAKYAIwC2ACMAxgAjAA1dQQUA1QAjAOUAIwACXboAEwAFACMRE` +
-	`jkAugAmAAIANSu6ABYAEQA1KzAxJQYjIiY1NDc2PwE2NzY1NCYjIgc1NjMyFhUUBwYPAgYHBhUUFjMyNjcVBDTi1vXfRkatfoE5OoV2origs+/VNzbQgUBzHR18jVf6cDtau5FvSko/Li8kJDw+U0K` +
-	`pNLKKYkRFUTMaLyAgLURbO0nFAAAAAQARAAAEcAYQAA0AQ7gAMiu6AAcAAgA1K7gABxC4AArQuAACELgADNAAuAALL7oABAAFADUrugABAAAANSu4AAEQuAAH0LgAABC4AAnQMDETNSERIRUhESEVI` +
-	`REjEREBCgNV/XUBNP7MygLHmwKunP3um/05AscAAQAvAAAEYARdAA0AQ7gAMiu6AAcAAgA1K7gABxC4AArQuAACELgADNAAuAALL7oABAAFADUrugABAAAANSu4AAEQuAAH0LgAABC4AAnQMDETNTM` +
-	`RIRUhESEVIREjES/6Azf9kwEI/vjKAcObAf+b/pyb/j0BwwAAAQCd/rIEzAYQAA4AbbgAMiu4AA8vuAAQL7gADxC4AADQuAAAL7gADty4AALQuAAQELgACdy6AAMAAAAJERI5uAAK3LoABQAJAAoRE` +
-	`jkAuAABL7gABC+4AAkvugADAAkAARESOboABwAJAAEREjm6AA0ACQABERI5MDEzETMRATMJASMRIxMjARGdygJB1v3TAnsYtgMy/ZgGEP0HAvn9H/zR/rIBTgMX/OkAAAEAzf6yBLQEXQAOAGu4ADI` +
-	`ruAAPL7gAEC+4AALcuAAA0LgAAC+4AAIQuAAD3LgADxC4AAjQuAAIL7gAB9y4AArQugALAAgAAhESOboADQACAAMREjkAuAAJL7gADC+4AAIvugAGAAIACRESOboACwACAAkREjkwMSEzESMTIwERI` +
-	`xEzEQEzAQSmDrYDW/3huroCGfj96P6yAU4CTv2yBF398QIP/fEAAAEAIwAABMwGGgAUAHG4ADIruAAVL7gAFi+4ABUQuAAA0LgAAC+4ABTcuAAC0LgAFhC4AAfcuAAE3LgABxC4AA/QuAAEELgAEdA` +
-	`AuAAAL7gADC+4AAEvuAAJL7oACAAOADUruAAIELgAA9C6AAoAAAABERI5uAAOELgAEtAwMTMRMxEzETMRMwEzCQEjASMRIxEjESO6UJsyAa7W/jcCF/3+MDebUAYa/UgBOf7HAq79H/zRAsf+zAE0/` +
-	`TkAAQA3AAAEpgRdABQAZ7gAMiu4ABUvuAAWL7gAFRC4AADQuAAAL7gAFNy4AALQuAAWELgAB9y4AATcuAAHELgAD9C4AAQQuAAR0AC4AAEvuAAJL7gAAC+4AAwvugAIAA4ANSu4AAgQuAAD0LgADhC` +
-	`4ABLQMDEzETMRMxEzETMBMwkBIQEjESMRIxE3ulqbRAF2+P5MAcL/AP6AQJtaBF3+PQEH/vkBw/3x/bIB//7+AQL+AQAAAQB+/rIEtAYQAA8AU7gAMiu6AAgACQA1K7oAAAAFADUruAAAELgAAty4A` +
-	`AAQuAAD0LgAAy+4AAgQuAAL0LgABRC4AA3QALgACi+4AA4vugABAAIANSu6AA0ABgA1KzAxJTMRIxMjESERIxEzESERMwQBs7YCyP4QysoB8Mma/hgBTgLZ/ScGEP1kApwAAAAAAQCb/rIEtARdAA8` +
-	`AU7gAMiu6AAgACQA1K7oAAAAFADUruAAAELgAAty4AAAQuAAD0LgAAy+4AAgQuAAL0LgABRC4AA3QALgACi+4AA4vugABAAIANSu6AA0ABgA1KzAxJTMRIxMjESERIxEzESERMwQBs7YCuf4OuroB8` +
-	`rqa/hgBTgIA/gAEXf4+AcIAAAAAAQA0AAAEmQYQAAgAL7gAMiu6AAgAAAA1K7oABAAAAAgREjkAuAACL7gABS+4AAAvugAEAAAAAhESOTAxIREBMwkBMwERAgL+MuUBWQFYz/4zAs4DQv2YAmj8vv0` +
-	`yAAAAAQA0/k4EmQRdAAgAL7gAMiu6AAgAAAA1K7oABAAAAAgREjkAuAACL7gABS+4AAAvugAEAAAAAhESOTAxAREBMwkBMwERAgL+MuUBWQFYz/4z/k4BsgRd/H0Dg/uj/k4AAQA0AAAEmQYQABAAV` +
-	`7gAMiu6ABAAAAA1K7gAABC4AATQugAIAAAAEBESObgAEBC4AAvQALgAAC+4AAYvuAAJL7oABAABADUrugAIAAAABhESObgABBC4AAzQuAABELgADtAwMSERITUhNQEzCQEzARUhFSERAgL+4QEf/jL` +
-	`lAVkBWM/+MwEf/uECJ5sMA0L9mAJo/L4Mm/3ZAAAAAQA0/k4EmQRdABAAV7gAMiu6ABAAAAA1K7gAABC4AATQugAIAAAAEBESObgAEBC4AAvQALgABi+4AAkvuAAAL7oABAABADUrugAIAAAABhESO` +
-	`bgABBC4AAzQuAABELgADtAwMQERITUhNQEzCQEzARUhFSERAgL+4QEf/jLlAVkBWM/+MwEf/uH+TgEJmw4EXfx9A4P7ow6b/vcAAQAw/k4EnQYQAA4AMbgAMisAuAADL7gABi+4AAovugABAAoAAxE` +
-	`SOboABQAKAAMREjm6AA4ACgADERI5MDEhIwkBMwkBMwkBESMTIwEBAtIBxv5m5QEpATDT/mYBysANNv6rAysC5f3pAhf9KvzG/k4BsgJrAAAAAQA4/k4EnQRdAA4AMbgAMisAuAADL7gABi+4AAovu` +
-	`gABAAoAAxESOboABQAKAAMREjm6AA4ACgADERI5MDEhIwkBMwkBMwkBESMTIwEBD9cBt/5m7gEjARvT/nwBzcANPP6sAkkCFP6JAXf9/v2l/k4BsgG9AAAAAgB+/rIEtAYQABUAGQBTuAAyK7oACQA` +
-	`GADUrugATABIANSu4ABIQuAAA0LgAExC4ABncuAAY3LgAExC4ABvcALgABy+4ABIvugAXABgANSu6ABYAFAA1K7oADQACADUrMDEBBiMiJyY1ETMRFBcWMzI3NjcRMxEjNzMRIwOGwty2YlLKKTZtZ` +
-	`nBbQcnJe7O2AxTimoK+AgT+FXhXcmBObgIQ+fCa/hgAAgCb/rIEtARdABEAFQBduAAyK7oACQAGADUrugAQABEANSu4ABEQuAAN0LgAEBC4ABXcuAAU3LgAEBC4ABfcALgABy+4AA4vugATABQANSu` +
-	`6ABIAEAA1K7oACwACADUrugAAAAIACxESOTAxAQYjIicmNREzERAzMjcRMxEjNzMRIwNux6u4W066w6+nurqTs7YCCZ1+bLgBT/7K/vefAaD7o5r+GAAAAQB+AAAETwYQAB0AabgAMiu6ABkAFgA1K` +
-	`7oAAQAAADUrugAIAAcANSu4AAcQuAAK0LoACwAWAAgREjm4AAEQuAAN0LgAABC4AA/QuAAIELgAH9wAuAAJL7gABy+4ABcvugACAAkABxESOboACwAJAAcREjkwMQEzETY3NjcRMxEjEQYHESM1BiM` +
-	`iJyY1ETMRFBcWFwIGmyQlW0HJyW53mw8PtmJSyikyYwQh/u4VIE5uAhD58AMUgTf+2/wBmoK+AgT+FXhXawcAAAAAAQCbAAAEKARdABcAabgAMiu6ABUAEgA1K7oAAQAAADUrugAGAAUANSu4AAUQu` +
-	`AAI0LoACQASAAYREjm4AAEQuAAL0LgAABC4AA3QuAAGELgAGdwAuAAFL7gAEy+4AAcvugACAAcABRESOboACQAHAAUREjkwMQEzFTY3ETMRIxEGBxUjNSInJjURMxEUFwH8m21qurpwZ5u4W066pwM` +
-	`x/iVlAaD7owIJWCf9335suAFP/sr1EwAAAAEAfgAABE8GEAAVAE24ADIruAAWL7gAFy+4ABYQuAAT0LgAEy+4ABLcuAAA0LgAFxC4AAfcuAAI3AC4ABQvuAAHL7gAEi+6AAIADQA1K7oAAAAHABQRE` +
-	`jkwMQE2MzIXFhURIxE0JyYjIgcGBxEjETMBR8LctmJSyik2bWZwW0HJyQL84pqCvv38Aet4V3JgTm798AYQAAAAAQCbAAAEKARdABEATbgAMiu4ABIvuAATL7gAEhC4AA/QuAAPL7gADty4AADQuAA` +
-	`TELgAB9y4AAjcALgAEC+4AAcvuAAOL7oAAgALADUrugAAAAsAAhESOTAxATYzMhcWFREjERAjIgcRIxEzAVXHq7hbTrrDr6e6ugJUnX5suP6xATYBCZ/+YARdAAACAIL/0QRMBjkAFQAdAFm4ADIru` +
-	`AAeL7gAHy+4AAbcuAAeELgADdC4AA0vuAAGELgAFty4AA/QuAAPL7gADRC4ABfcugAVAA0AFxESOQC6ABsACgA1K7oAAgATADUrugAPABYANSswMRM2MzIWEhUUAgYjIgIRNSE0AiYjIgcBIRUSFjM` +
-	`yNpLfx5fuj4j0lsfxAyZxsmW02gML/W0KvnBx3QWllNH+fN3U/njaAYoBnDeiAVZep/20Jf7/5+sAAAACAIL/4ARMBHwAFQAdADG4ADIrugAXAA0ANSu6ABUADQAXERI5ALoAGwAKADUrugACABMAN` +
-	`Su6AA8AFgA1KzAxEzYzMhYSFRQCBiMiAhE1ITQuASMiBwEhFRQWMzI2kt/Hl+6PiPSWx/EDAmGeZbTaAuf904ZwcaUEEmqW/umfmf7mnQEcASgodMBgeP5ZG4mipQAAAwBH/9EEhQY+AA8AGAAhAFu` +
-	`4ADIruAAiL7gAIy+4ACIQuAAE0LgABC+4ACMQuAAM3LgABBC4ABbcuAAMELgAF9y4AB3QuAAWELgAHtC4AB4vALoAGQAAADUrugAIABIANSu6ABcAHQA1KzAxBSADJhEQNxIhIBMWERAHAgMmIyIHB` +
-	`gchJgEyNzY3IRYXFgJm/teLa2uMASgBKYtraowPV8PDVzIIAqcH/rTDVzAJ/VoIMVcvARjVAUoBSdYBF/7p1f62/rXV/ukE0///ksPD/Fv/jbu7jf8AAwBX/+EEdgR8AA8AGAAhAB+4ADIrALoAEAA` +
-	`AADUrugAIABsANSu6ACAAFAA1KzAxBSInJjU0NzYzMhcWFRQHBicyNzY3IRYXFgEmIyIHBgchJgJn9pKIiZH29ZKIiJL1qFY3DP18DThWAaVWp6hWOA0ChAwfr6T7+6Ovr6T6+qWvm4xZgIBZjALZj` +
-	`ItagH8AAv/+/rIEzwYQAA4AFACLuAAyK7gAFS+4ABYvuAAC3LgAA9y4AAXQuAAFL7gAFRC4AAjQuAAIL7gAB9y4AArQuAADELgADNC4AAwvuAACELgADdC4AA0vuAAIELgAFNAAuAACL7gACS+4AAw` +
-	`vuAASL7oAAAACAAkREjm6AAYAAgAJERI5ugALAAIACRESOboAEAACAAkREjkwMSEjESMTIwERIxEzEQEzCQEjCQEzAQTPG7YDB/7RyckBMML+2v081QE4/trCATD+sgFOAxf86QYQ/QcC+f0f/NEDL` +
-	`wLh/QcAAAAAAv/+/rIEzwRdAA4AFACDuAAyK7gAFS+4ABYvuAAC3LgAA9y4ABUQuAAI0LgACC+4AAfcuAAK0LgAAxC4AAzQuAAML7gAAhC4AA3QuAANL7gACBC4ABTQALgACS+4AAwvuAASL7gAAi+` +
-	`6AAAAAgAJERI5ugAGAAIACRESOboACwACAAkREjm6ABAAAgAJERI5MDEhIxEjEyMBESMRMxEBMwkBIwkBMwEEzxu2Axv+5cnJASbM/uT9RukBLv7kzAEm/rIBTgJF/bsEXf3oAhj+AP2jAl0CAP3oA` +
-	`AAAAAEAIQAABK0GPgAhALO4ADIrugATABAANSu6AAEAAAA1K7oAAwAAAAEREjm6AB0AAAABERI5uAAdL0EFANoAHQDqAB0AAl1BGwAJAB0AGQAdACkAHQA5AB0ASQAdAFkAHQBpAB0AeQAdAIkAHQC` +
-	`ZAB0AqQAdALkAHQDJAB0ADV24AAbcugAMABAAExESObgADC+4ABfcuAABELgAI9wAugAaAAkANSu6AAEAAgA1K7gAAhC4AA/QuAABELgAEdAwMQEhFSMWEhUQACEgABE0EjcjNSEVDgIVEBIzMhIRN` +
-	`C4BJwK9AfDxfU3+6v73/vf+6k198QHxWWg/sqOksj9oWQY+so7+463+iv5CAb4Bdq0BHY6ysiiV96P+yP6eAWQBNaP3ligAAAAAAQAhAAAErQY+ACEAs7gAMiu6ABMAEAA1K7oAAQAAADUrugADAAA` +
-	`AARESOboAHQAAAAEREjm4AB0vQQUA2gAdAOoAHQACXUEbAAkAHQAZAB0AKQAdADkAHQBJAB0AWQAdAGkAHQB5AB0AiQAdAJkAHQCpAB0AuQAdAMkAHQANXbgABty6AAwAEAATERI5uAAML7gAF9y4A` +
-	`AEQuAAj3AC6ABoACQA1K7oAAQACADUruAACELgAD9C4AAEQuAAR0DAxASEVIxYSFRAAISAAETQSNyM1IRUOAhUQEjMyEhE0LgEnAr0B8PF9Tf7q/vf+9/7qTX3xAfFZaD+yo6SyP2hZBj6yjv7jrf6` +
-	`K/kIBvgF2rQEdjrKyKJX3o/7I/p4BZAE1o/eWKAAAAAAB/HwEy/63BjIADwAAAToBNyY2NzYWFxYGBwYuAfzSRl85HDMtM2MUGUddUa6YBU8XRWESFCwwP4UmIQ0pAAAAAQB+/9EFIAYyACQAO7gAM` +
-	`iu4ACUvuAAmL7gAJRC4AADQuAAAL7gAAdy4ACYQuAAc3LgACty4ABwQuAAM0AC6AAYAIAA1KzAxEzMRFB4BMzI+ATURMxU2NyY2NzYWFxYGBwYvAREUDgEjIi4BEX7KQztVWkdDuiw0HDMtM2MUGUd` +
-	`dUVcbgYqeko5yBhD8BcmTOT+x9AOswQIVRWESFCwwP4UmIQYC/UjZ9Xxs2AEaAAABAI//4QUCBPwAJAA/uAAyK7gAJS+4ACYvuAAQ3LgAANC4ABAQuAAR3LgAJRC4ABnQuAAZL7gAHNy4ABEQuAAj0` +
-	`AC6ACAAFQA1KzAxARU2NyY2NzYWFxYGBwYvAREjNQ4BIyIuATURMxEUHgEzMjY3EQOcKzQcMy0zYxQZR11RVxq6WoxuYl1Auh8XMGKHSgRdRAIVRWESFCwwP4UmIQYC/GP1ioppq/gCcP15oWdCuY0` +
-	`CiwACAEf/0QUqBj4AGgAmANe4ADIruAAnL7gAKC+4ACcQuAAD0LgAAy+4ACgQuAAY3LgAFNC4ABQvuAAYELgAHtxBBQDaAB4A6gAeAAJdQRsACQAeABkAHgApAB4AOQAeAEkAHgBZAB4AaQAeAHkAH` +
-	`gCJAB4AmQAeAKkAHgC5AB4AyQAeAA1duAADELgAJNxBGwAGACQAFgAkACYAJAA2ACQARgAkAFYAJABmACQAdgAkAIYAJACWACQApgAkALYAJADGACQADV1BBQDVACQA5QAkAAJdALoAGwAAADUrugA` +
-	`GACEANSswMQUgABEQACEgHwE2NyY2NzYWFxYGBwYHFhEQACUyEhEQAiMiAhEQEgJm/vj+6QEXAQgBCYsFDhYcMy0zYxQZR10pH0f+6v73ksPDkpLDwy8BvQF6AXkBvd8IBglFYRIULDA/hSYRB77+8` +
-	`v6G/kOcAUsBUAFQAUv+tf6w/rD+tQAAAAACAFf/4QUWBI4AGwAnANe4ADIruAAoL7gAKS+4ACgQuAAD0LgAAy+4ACkQuAAZ3LgAFdC4ABUvuAAZELgAH9xBBQDaAB8A6gAfAAJdQRsACQAfABkAHwA` +
-	`pAB8AOQAfAEkAHwBZAB8AaQAfAHkAHwCJAB8AmQAfAKkAHwC5AB8AyQAfAA1duAADELgAJdxBGwAGACUAFgAlACYAJQA2ACUARgAlAFYAJQBmACUAdgAlAIYAJQCWACUApgAlALYAJQDGACUADV1BB` +
-	`QDVACUA5QAlAAJdALoAHAAAADUrugAGACIANSswMQUiADU0ADMyFxYXNjcmNjc2FhcWBgcGBxYVFAAnMjY1NCYjIgYVFBYCZ/D+4AEg8O+QCwsICxwzLTNjFBlHXRkTMP7g75WwsJWWsLAfAU///wF` +
-	`Opw0OAwVFYRIULDA/hSYKB3WT//6xm+jLyujoysvoAAEA4wInA+sCwgADAA+4ADIrALoAAQAAADUrMDETNSEV4wMIAiebmwABAKT/4QRHBHwAGABtuAAyK7oABgASADUrQQUA2gASAOoAEgACXUEbA` +
-	`AkAEgAZABIAKQASADkAEgBJABIAWQASAGkAEgB5ABIAiQASAJkAEgCpABIAuQASAMkAEgANXbgABhC4ABrcALoADgAJADUrugACABUANSswMRM2MzIEEhUUACMiJzUWMzI+ATU0AiMiBgeku6moAQW` +
-	`S/rXqrcHDmma0Yr3KPXafBD0/m/73qf7+sEGzWWXWcZcBEhE2AAACAIL/4ARMBHwAFQAeADG4ADIrugAdAAgANSu6AAAACAAdERI5ALoAAgATADUrugALABkANSu6ABYABgA1KzAxNxYzMj4BNSE1E` +
-	`BIzMhYSFRQCBiMiJwEuASMiBh0BIZLatGWeYfz+8ceW9IiP7pfH3wLnIaVxcIYCLfR4YMB0KAEoARyd/uaZn/7plmoCUaGlookbAAAAAAIAZv/hBF4EbQAUACAAp7gAMiu4ACEvuAAiL7gAIRC4AA/` +
-	`QuAAPL7gADty4AADQuAAiELgABty4AA4QuAAV0LgABhC4ABvcQQUA2gAbAOoAGwACXUEbAAkAGwAZABsAKQAbADkAGwBJABsAWQAbAGkAGwB5ABsAiQAbAJkAGwCpABsAuQAbAMkAGwANXQC6ABgAC` +
-	`gA1K7oAAwAeADUruAAKELgADNC4AAwvugASAB4AAxESOTAxAT4BMzIWFRQCBiMiJyYnIxE0JzMWExEWMzISNTQmIyIGAUtV72SM35T4tzVrECC7KsIWDWNVrOV9UFfSA1OMjvL2wv7PsQsCAwMVyn5` +
-	`c/oT+IRoBCuWmqbAAAgBm/+EEXgRtABQAIACnuAAyK7gAIS+4ACIvuAAQ3LgAANy4ACEQuAAG0LgABi+4AAAQuAAO0LgAABC4ABXQuAAGELgAG9xBGwAGABsAFgAbACYAGwA2ABsARgAbAFYAGwBmA` +
-	`BsAdgAbAIYAGwCWABsApgAbALYAGwDGABsADV1BBQDVABsA5QAbAAJdALoAHgADADUrugAKABgANSu4AAoQuAAM0LgADC+6ABIAAwAeERI5MDElDgEjIiY1NBI2MzIXFhczERQXIyYDESYjIgIVFBY` +
-	`zMjYDeVXvZIzflPi3NWsQILsqwhYNY1Ws5X1QV9L7jI7y9sIBMbELAgP868p+XAF8Ad8a/vblpqmwAAABAGD/4QRBBcIAGABDuAAyK7oADAANADUruAAMELgAB9C4AA0QuAAR0AC4AAwvugADABYAN` +
-	`Su6AAkACgA1K7gAChC4AA7QuAAJELgAENAwMRM+ATMyHgEVESEVIREjESE1IRE0LgEjIgdgg3Y3dcJjARf+6br+DwHxQGtJh5UFdjcVWrC4/j6b/j4BwpsBv4dvNFUAAQBHAAAEhQR8ACUAqbgAMiu` +
-	`4ACYvuAAR0LgAES+4AArcQQMAHwAKAAFdQQMAwAAKAAFdQQMAcAAKAAFduAAC3EEDAB8AAgABXUEDAMAAAgABXUEDAHAAAgABXbgAAdy4ABEQuAAU3LgADtC4AA4vuAAKELgAG9C4AAoQuAAe3LgAA` +
-	`hC4ACTQuAABELgAJ9wAuAAAL7gAEi+4ABwvugAhAAYANSu4AAYQuAAO0LgAIRC4ABjQMDEBESMRDgEjIiY9AQ4CIyImNREzERQeATMyNjcRMxEUFjMyNjcRBIWjTYVEUWMhXWRBSGajDCQVNHc+nDo` +
-	`XM3U1BHz7owEAo3x/dTxmf0t6tgNM/QVeRSSfuAJr/QaUNLKLAoUAAAABACEAAAStBF0ABgAduAAyKwC4AAAvuAABL7gABC+6AAMAAQAAERI5MDEJASMJASMBAsgB5cz+gf55ugHpBF37owN0/IwEX` +
-	`QABABQAAAS4BF0AEwA5uAAyKwC4AAAvuAANL7gAAS+4AAYvuAALL7oABAABAAAREjm6AAkAAQAAERI5ugARAAEAABESOTAxARMjAycHAyMDJwcDIxMzExczNxMEAratahwbsqfJIxdrj7Wu1iIFH7o` +
-	`EXfujAqienv1YAqienv1YBF39MZ6dAtAAAwBX/+EEdgR8AAsAFAAdAB+4ADIrALoADAAAADUrugAGABcANSu6ABwAEAA1KzAxBSIANTQAMzIAFRQAJzI3NjchFhcWASYjIgcGByEmAmfw/uABIPDvA` +
-	`SD+4O+VWEgN/XoNSVgBg1iVllhEEAKCDx8BT///AU7+sv///rGbdGCbm2B0AvF0dFqNjQADAEf/0QSFBj4ACwAUAB0AU7gAMiu4AB4vuAAfL7gAHhC4AAPQuAADL7gAHxC4AAncuAAQ3LgAAxC4ABH` +
-	`cuAAb0LgAEBC4ABzQALoADAAAADUrugAGABcANSu6ABwAEAA1KzAxBSAAERAAISAAERAAJTI3NhMhEhcWASYjIgcGAyECAmb++P7pARcBCAEJARb+6v73kmFXCf1ZClZiAYVhkpJiVQsCpwovAb0Be` +
-	`gF5Ab3+Q/6H/ob+Q5ylkwEY/uiTpQSQpqaR/uwBFAABAFf+jASdBhAAEAA/uAAyK7gAES+4ABIvuAAQ3LgAANy4ABEQuAAE0LgABC+4AAPcALgAAC+4AAMvugAIAAEANSu4AAEQuAAF0DAxAREjESM` +
-	`RIzUhMh4BFRQGBxECMJubowIZ9Mlw5uv+jAbo+RgG6JxuznCk/Rj74QAAAgA9/9EEjwDwAAsAFwDPuAAyK7gAGC+4ABkvuAAYELgAA9C4AAMvuAAJ3EEbAAYACQAWAAkAJgAJADYACQBGAAkAVgAJA` +
-	`GYACQB2AAkAhgAJAJYACQCmAAkAtgAJAMYACQANXUEFANUACQDlAAkAAl24ABkQuAAV3LgAD9xBBQDaAA8A6gAPAAJdQRsACQAPABkADwApAA8AOQAPAEkADwBZAA8AaQAPAHkADwCJAA8AmQAPAKk` +
-	`ADwC5AA8AyQAPAA1dALoABgAAADUruAAAELgADNC4AAYQuAAS0DAxFyImNTQ2MzIWFRQGISImNTQ2MzIWFRQGzTlXVzk4V1cC+zlXVzk5VlYvVjo6VVU6OlZWOjpVVTo6VgAAAAABAdf/0QL2APAAC` +
-	`wBduAAyK7oACQADADUrQQUA2gADAOoAAwACXUEbAAkAAwAZAAMAKQADADkAAwBJAAMAWQADAGkAAwB5AAMAiQADAJkAAwCpAAMAuQADAMkAAwANXQC6AAYAAAA1KzAxBSImNTQ2MzIWFRQGAmY5VlY` +
-	`5OVdXL1Y6OlVVOjpWAAAAAAIAtAOTBBkGbQASACUA57gAMiu4ACQvuAAlL7gACty4AADQuAAAL7gAChC4AATcQQUA2gAEAOoABAACXUEbAAkABAAZAAQAKQAEADkABABJAAQAWQAEAGkABAB5AAQAi` +
-	`QAEAJkABACpAAQAuQAEAMkABAANXbgAJBC4ABbQuAAWL7gAHNxBGwAGABwAFgAcACYAHAA2ABwARgAcAFYAHABmABwAdgAcAIYAHACWABwApgAcALYAHADGABwADV1BBQDVABwA5QAcAAJduAAS0Lg` +
-	`AEi8AuAAAL7gAEi+6AAcADQA1K7gABxC4ABnQuAANELgAH9AwMQEuAjU0NjMyFhUUBiMUHgEXFSEuAjU0NjMyFhUUBiMUHgEXFQQZd6VgelRBYlhdLUtI/hd3pWB6VUFhWF0tTEcDkxCKwm9+kV9GO` +
-	`2lObUYTfRCKwm9+kV9GO2lObUYTfQAAAQGoA5MDJAZtABIAabgAMiu6AAoABAA1K0EbAAYACgAWAAoAJgAKADYACgBGAAoAVgAKAGYACgB2AAoAhgAKAJYACgCmAAoAtgAKAMYACgANXUEFANUACgD` +
-	`lAAoAAl24AAoQuAAA0LgAAC8AuAAAL7gABy8wMQEuAjU0NjMyFhUUBiMUHgEXFQMkd6VgelRBYlhdLUtIA5MQisJvfpFfRjtpTm1GE30AAgAC/9EEzAY+AAoADgBluAAyK7oACAABADUruAAIELgAA` +
-	`ty6AAMAAQAIERI5uAABELgABNC4AAAQuAAF0LgAARC4AArcALgADS+4AAsvugABAAAANSu6AAMACwANERI5ugAEAAsADRESObgAARC4AAjQMDETNTMRBzU3MxEzFQEjATMC6enpo3T+oKAEIqgDCHw` +
-	`B8pubmv10fPzJBm0AAAEA2wAABCEGEAAFABu4ADIrugAFAAIANSsAuAADL7oAAAABADUrMDElFSERMxEEIfy6yqurBhD6mwAAAAEAdv/RBEAGPwAZAGW4ADIrugATAAYANStBGwAGABMAFgATACYAE` +
-	`wA2ABMARgATAFYAEwBmABMAdgATAIYAEwCWABMApgATALYAEwDGABMADV1BBQDVABMA5QATAAJdALoAFwACADUrugAKAA8ANSswMSUGIyIkAjU0EiQzMhcVJiMiBgIVFBIWMzI3BECuirb+zqqoATK` +
-	`4iq6hn3jHen7Ed56iEkHDAYHz8QGAxkG4XaT+w7q9/sCfXQAAAgCbAAAEfAYQAAoAFQCJuAAyK7gAFi+4ABcvuAAWELgAANC4AAAvuAAXELgABty4AAAQuAAL3LgABhC4ABDcQQUA2gAQAOoAEAACX` +
-	`UEbAAkAEAAZABAAKQAQADkAEABJABAAWQAQAGkAEAB5ABAAiQAQAJkAEACpABAAuQAQAMkAEAANXQC6AAsACgA1K7oAAgAUADUrMDEzESEyFhIVFAIGIyczMjYSNTQuASsBmwFw/vd8g/D+pofNr0t` +
-	`YpLuXBhCx/pr16P6hvauSAQPk3/CBAAABADgAAASVBhAAEgB/uAAyK7gAEy+4ABQvuAATELgAAdC4AAEvuAAA3LgAFBC4AAfcuAAI3LgABdC4AAUvuAAIELgACtC4AAovuAAAELgAENC6ABEAAQAAE` +
-	`RI5ALgAAi+4AAUvuAAAL7gABy+6AAQAAAACERI5ugALAAAAAhESOboAEQAAAAIREjkwMTMjETMJATMRIxE3IwcDIwMnIxfbo8sBZgFmxroFBSzwyvMnBQUGEPwCA/758APdiIj9WQKniIgAAQAwAAA` +
-	`EnQYQAAsAK7gAMisAuAADL7gABi+4AAAvuAAJL7oABQAAAAMREjm6AAsAAAADERI5MDEhIwkBMwkBMwkBIwEBAtIBxv5m5QEpATDT/mYByun+qwMrAuX96QIX/Sr8xgJrAAABACEAAAStBhAACAAdu` +
-	`AAyKwC4AAIvuAAAL7gABC+6AAcAAgAAERI5MDEBMwEjATMBFzcD68L+Is3+H9UBUSwnBhD58AYQ+8SenQAAAQCsAAAEIAYQAAsAL7gAMiu6AAkAAgA1KwC6AAEAAAA1K7oABQAEADUruAAEELgAB9C` +
-	`4AAEQuAAJ0DAxMzUhESE1IRUhESEVrAFV/qsDdP6rAVWbBNmcnPsnmwACAJ4AAAPFBm0ABwATAIe4ADIrugAEAAMANSu4AAQQuAAA3LgAB9y6AAgAAwAEERI5uAAIL0EFANoACADqAAgAAl1BGwAJA` +
-	`AgAGQAIACkACAA5AAgASQAIAFkACABpAAgAeQAIAIkACACZAAgAqQAIALkACADJAAgADV24AA7cALgACy+6AAUAAAA1K7oABAABADUrMDEhESE1IREhFQE0NjMyFhUUBiMiJgHU/soB8AE3/fBWJid` +
-	`WVicmVgPCm/w+mwXwPz4+Pz4+PgABACEAAAStBF0ABgAduAAyKwC4AAEvuAAEL7gAAC+6AAMAAAABERI5MDEhATMJATMBAgb+G8wBfwGHuv4XBF38jAN0+6MAAAABADgAAASdBF0ACwAruAAyKwC4A` +
-	`AMvuAAGL7gAAC+4AAkvugAFAAAAAxESOboACwAAAAMREjkwMSEjCQEzCQEzCQEjAQEP1wG3/mbuASMBG9P+fAHN7/6sAkkCFP6JAXf9/v2lAb0AAAEAeQAAA94GTgAHAB+4ADIrugAFAAAANSsAugA` +
-	`FAAAANSu6AAQAAQA1KzAxIREhNSERIRUBx/6yAggBXQWynPpNmwAAAQCk/+EERwR8ABgAZbgAMiu6ABIABgA1K0EbAAYAEgAWABIAJgASADYAEgBGABIAVgASAGYAEgB2ABIAhgASAJYAEgCmABIAt` +
-	`gASAMYAEgANXUEFANUAEgDlABIAAl0AugAVAAIANSu6AAkADgA1KzAxJQYjIiQCNTQAMzIXFSYjIg4BFRQSMzI2NwRHu6mo/vuSAUvqrcHDmma0Yr3KPXafID+bAQmp/gFQQbNZZdZxl/7uETYAAAI` +
-	`Acv/hBEAGTgASAB4AobgAMiu4AB8vuAAgL7gAHxC4AAbQuAAGL7gAIBC4ABHcuAAS3LgADtC4ABIQuAAT0LgABhC4ABncQRsABgAZABYAGQAmABkANgAZAEYAGQBWABkAZgAZAHYAGQCGABkAlgAZA` +
-	`KYAGQC2ABkAxgAZAA1dQQUA1QAZAOUAGQACXQC4AA8vugAcAAMANSu6AAoAFgA1K7gAChC4AAzQuAAMLzAxJQ4BIyImNTQSNjMyFxYXETMRIxkBJiMiAhUUFjMyNgOFVe9kjN+U+Lc1axAgu7tjVaz` +
-	`lfVBX0vuMjvL2wgExsQsCAwHx+bIB2AHfGv725aapsAAAAQBHAAAEhQR8ACUAtbgAMiu4ACYvuAAA0LgAAC+4ACXcuAAC0LgAABC4AB3cQQMAHwAdAAFdQQMAwAAdAAFdQQMAcAAdAAFdugADAAAAH` +
-	`RESObgAHNy4AAnQuAAdELgAE9xBAwAfABMAAV1BAwDAABMAAV1BAwBwABMAAV26AAoAHQATERI5uAAO0LgADi+4ABMQuAAS3LgAJ9wAuAAAL7gAEi+4ABwvugAOABgANSu4AA4QuAAG0LgAGBC4ACH` +
-	`QMDEzETMRPgEzMhYdAT4CMzIWFREjETQuASMiBgcRIxE0JiMiBgcRR6NNhURRYyFdZEFIZqMMJBU0dz6cOhczdTUEXf8Ao3x/dTxmf0t6tvy0AvteRSSfuP2VAvqUNLKL/XsAAAEAHP4uBLEGPgAcA` +
-	`Be4ADIrALoACQAEADUrugASABcANSswMSUQDgEjIic1FjMyPgE1ERA+ATMyFxUmIyIOARURArp4q5Vre4xgVHVCeKuVa3uMYFR1Qof+5N1gHK4uQZq/A4EBHN1gHK4uQZq//H8AAAAAAQDPAAAEHQY` +
-	`+ACAAmbgAMiu4ACEvuAAiL7gAIRC4AADQuAAAL7gAINy4AAXQuAAiELgAF9y4AAvcQQUA2gALAOoACwACXUEbAAkACwAZAAsAKQALADkACwBJAAsAWQALAGkACwB5AAsAiQALAJkACwCpAAsAuQALA` +
-	`MkACwANXbgAIBC4AA7QuAAOL7gAIBC4ABTQuAAULwC4AAAvugAUAA4ANSswMSERND4BPwE2PwE2NTQmIyIGBzU2MzIWFRQPAw4BFREBkRxPTz4SIkJUeIdXtXm5yuDrmEJORyc8Ad1Tb3dUQhMrU2h` +
-	`MRmwvRLRat5KQm0RRSSxyZv4YAAAAAAEAzwAABB0GPgAgAJ24ADIruAAhL7gAIi+4ACDcuAAA3LgAIRC4AAnQuAAJL7gAABC4AAzQuAAML7gAABC4ABLQuAASL7gACRC4ABXcQRsABgAVABYAFQAmA` +
-	`BUANgAVAEYAFQBWABUAZgAVAHYAFQCGABUAlgAVAKYAFQC2ABUAxgAVAA1dQQUA1QAVAOUAFQACXbgAABC4ABvQALgAAC+6AAwAEgA1KzAxIRE0Ji8DJjU0NjMyFxUuASMiBhUUHwEWHwEeAhURAqE` +
-	`8J0dOQpjr4Mq5ebVXh3hUQiISPk9PHAHoZnIsSVFEm5CSt1q0RC9sRkxoUysTQlR3b1P+IwABAM8AAAQdBj4AIAChuAAyK7gAIS+4ACIvuAAhELgAH9C4AB8vuAAB3LgAIhC4AAncuAABELgADNC4A` +
-	`AwvuAABELgAEtC4ABIvuAAJELgAFdxBBQDaABUA6gAVAAJdQRsACQAVABkAFQApABUAOQAVAEkAFQBZABUAaQAVAHkAFQCJABUAmQAVAKkAFQC5ABUAyQAVAA1duAABELgAG9AAuAAAL7oAEgAMADU` +
-	`rMDEBERQWHwMWFRQGIyInNR4BMzI2NTQvASYvAS4CNRECSzwnR05CmOvgyrl5tVeHeFRCIhI+T08cBj7+GGZyLElRRJuQkrdatEQvbEZMaFMrE0JUd29TAd0AAAABAPr/4QRABF0AEQBNuAAyK7gAE` +
-	`i+4ABMvuAAB3LgAAty4ABIQuAAI0LgACC+4AAvcuAACELgAENAAuAAAL7oACgAGADUrugADAAYAChESObgABhC4AA3cMDEBESM1DgEjIicRMxUWMzI2NxEEQLpX4YplZbMvG2jIXwRd+6P0iokfAZP` +
-	`/CKSkAokA///8VQJT/uEDVAAHANn7NP1MAAD///yEAlT+swLQAAcA2vs1/UwAAAAB+x8CTAAUAsgAAwAPuAAyKwC6AAEAAAA1KzAxATUhFfsfBPUCTHx8AAAAAAH8cv0I/sD+TgAPAB+4ADIrALoAC` +
-	`wAGADUruAAGELgAANC4AAsQuAAD3DAxAS4BIyIGByM+AjMyHgEX/kAJYj09YAmADFl+Q0N/WQ39CE11d0tviU5OiW8AAAD///yb/RD+m/5WAAcAQ/tb+AgAAP///Jv9EP6b/lYABwCN+1v4CAAAAAI` +
-	`BOf/RA5QGPgADAAcAN7gAMiu4AAgvuAAJL7gAA9y4AADcuAAIELgABNC4AAQvuAAH3AC4AAEvuAAFL7gAAC+4AAQvMDEFETMRIREzEQLxo/2loy8GbfmTBm35kwAAAAMAR//RBIUGPgADAAcACwAnu` +
-	`AAyK7oACwAIADUrALgACS+4AAgvugAEAAUANSu6AAAAAQA1KzAxARUhNQEVITUBETMRBIX7`
-
-const MonacoFontData11 = `wgQ++8IBzqMDwZub/qObm/1tBm35kwAAAAEAIQAABK0GPgAhAMm4ADIrugAAAAEANSu6ABEAEgA1K7oAAwABAAAREjm6AAYAAQAAERI5uAAGL7oAFwASABEREjm4ABcvQ` +
-	`QUA2gAXAOoAFwACXUEbAAkAFwAZABcAKQAXADkAFwBJABcAWQAXAGkAFwB5ABcAiQAXAJkAFwCpABcAuQAXAMkAFwANXbgADNy6AA8AEgARERI5uAAGELgAHdy4ABEQuAAj3AC6ACEAAAA1K7oACQA` +
-	`aADUruAAhELgAAtC4ACEQuAAP0LgAABC4ABHQMDEpATUzJgI1EAAhIAARFAIHMxUhNT4CNRACIyICERQeARcCEf4Q8X1NARYBCQEJARZNffH+D1loP7KjpLI/aFmyjgEdrQF2Ab7+Qv6Krf7jjrKyK` +
-	`JX3owE4AWL+nP7Lo/eWKAAAAAABAOMAAAQ4BhAACQAzuAAyK7oAAQAEADUruAAEELgACNC4AAEQuAAL3AC4AAAvugAEAAEANSu6AAgABQA1KzAxAREhNSERITUhEQQ4/KsCi/2zAk0GEPnwnAIPmwL` +
-	`KAAACAGP/4ARZBHwAIgAtAPu4ADIruAAuL7gALy+4AC4QuAAH0LgABy+4AC8QuAAU3LgABxC4ABvcQRsABgAbABYAGwAmABsANgAbAEYAGwBWABsAZgAbAHYAGwCGABsAlgAbAKYAGwC2ABsAxgAbA` +
-	`A1dQQUA1QAbAOUAGwACXbgAGdC4ABkvuAAUELgAKNxBBQDaACgA6gAoAAJdQRsACQAoABkAKAApACgAOQAoAEkAKABZACgAaQAoAHkAKACJACgAmQAoAKkAKAC5ACgAyQAoAA1dugAiABQAKBESObo` +
-	`AIwAHABQREjm4ACXQuAAlLwC6ACAAAgA1K7oADwArADUrugAkABgANSswMSUGIyIuAjU0NzY3Njc2MzIeAhUWBg8BIQYVFB4CMzI3ASE3PgE3NCYjIgYDxfPJXZtwPg8fY2KLi5ZOflkxAQ8MCPz+D` +
-	`CpLaD208/1zAi0GBQUBZltyyEpqPXSnakRGl4+NTk8tWohbMG09KDYzSnBLJngBpxsZLhZhbaQA//8AZAAABMAHCwBnALf/JgI5MAAwCwAHAFEAggAAAAD//wAhAAAErQe8AiYAJAAAAAcDMASQAW7` +
-	`//wBm/+EEXgYiAiYARAAAAAcDMASL/9T//wAhAAAErQe8AiYAJAAAAAcDMgTOAW7//wBm/+EEXgYgAiYARAAAAAcDMgUU/9L//wDLAAAEEQe8AiYAKAAAAAcDMASXAW7//wCC/+AETAYxAiYASAAAA` +
-	`AcDMATW/+P//wDLAAAEEQe8AiYAKAAAAAcDMgTVAW7//wCC/+AETAYvAiYASAAAAAcDMgUU/+H//wCsAAAEIAe8AiYALAAAAAcDMASPAW7//wCdAAADxAaJAiYA1wAAAAcDMARaADv//wCsAAAEIAe` +
-	`8AiYALAAAAAcDMgSwAW7//wCdAAADxAaJAiYA1wAAAAcDMgR/ADv//wBH/9EEhQfqAiYAMgAAAAcDMATVAZz//wBX/+EEdgYxAiYAUgAAAAcDMATV/+P//wBH/9EEhQfqAiYAMgAAAAcDMgSwAZz//` +
-	`wBX/+EEdgYvAiYAUgAAAAcDMgSw/+H//wCPAAAEtAe8AiYANQAAAAcDMAQFAW7//wD6AAAEQAYxAiYAVQAAAAcDMATG/+P//wCVAAAEtAe8AiYANQAAAAcDMgULAW7//wD6AAAEQAYvAiYAVQAAAAc` +
-	`DMgUE/+H//wB+/9EETwe8AiYAOAAAAAcDMASPAW7//wCP/+EEMgYSAiYAWAAAAAcDMAST/8T//wB+/9EETwe8AiYAOAAAAAcDMgTOAW7//wCP/+EEMgYQAiYAWAAAAAcDMgTW/8L//wCnAAAEiAgWA` +
-	`iYDJwAAAAcA3AAxAcv//wBH/9EFKgfqAiYDVAAAAAcAjQB4AZz//wBX/+EFFgZBAiYDVQAAAAYAjXbzAAD//wBH/9EFKgfqAiYDVAAAAAcAQwB4AZz//wBX/+EFFgZBAiYDVQAAAAYAQ3bzAAD//wB` +
-	`H/9EFKgelAiYDVAAAAAcA2QBRAZ3//wBX/+EFFgX8AiYDVQAAAAYA2U/0AAD//wBH/noFKgY+AiYDVAAAAAcA3ABS+ST//wBX/ooFFgSOAiYDVQAAAAcA3ABQ+TT//wB+/9EFIAfeAiYDUgAAAAcAj` +
-	`QCPAZD//wCP/+EFAgaoAiYDUwAAAAcAjQCIAFr//wB+/9EFIAfeAiYDUgAAAAcAQ/9mAZD//wCP/+EFAgaoAiYDUwAAAAcAQ/9fAFr//wB+/9EFIAeZAiYDUgAAAAcA2f+jAZH//wCP/+EFAgZjAiY` +
-	`DUwAAAAYA2ZxbAAD//wB+/nwFIAYyAiYDUgAAAAcA3P+k+Sb//wCP/owFAgT8AiYDUwAAAAcA3P+d+Tb//wCC/+AETAcKAiYASAAAACYA2ADhAAcA2gABAYYAAP//AIL/4ARMB9QCJgBIAAAAJgDYA` +
-	`OEABwDhAAABhgAA//8AywAABBEHvAImAYkAAAAHAEMALgFu//8AfgAABE8HvAImAYwAAAAHAEMAJgFu/////gAABM8HvAImAYoAAAAHANsAAAFu//8AywAABBEHvAImAYkAAAAHANsABwFu//8Agv/` +
-	`RBEwHlwImA0kAAAAHAI4AAQGX//8Agv/gBEwF4QImA0oAAAAGAI4B4QAA/////gAABM8HbgImAYoAAAAHAI4AAAFu//8Aff/RBEsHnAImAYsAAAAHAI4AAAGc//8AfgAABE8G8gImAYwAAAAHANoAA` +
-	`AFu//8AfgAABE8HbgImAYwAAAAHAI4AAAFu//8AR//RBIUHnAImA0sAAAAHAI4AAAGc//8AV//hBHYF4QImA0wAAAAGAI4A4QAA//8AfgAABE8HbgImAZsAAAAHAI4AAAFu//8ASAAABIUHbgImAZ8` +
-	`AAAAHAI4AAAFu///8mwUI/psGTgAHAEP7WwAAAAD///ybBQj+mwZOAAcAjftbAAAAAP///EYFCP7xB6wAJwCO+zUAAAAHAI37WwFeAAD//wGo/k4DJAR8AgYAHgAA//8BEQUIA7wHrAImAI4AAAAHA` +
-	`I0AJgFe//8BwwF8AwkCwgIGAMMAAP//ACEAAAStCJ4CJgAkAAAAJwDaAAEBbgAHAEMAJwJQ//8AZv/hBF4G+wImAEQAAAAmANoA0gAHAEMAIgCtAAD//wBm/dAEXgYgAiYARAAAACYA4ACCAAYAjSL` +
-	`S//8ArAAABCAIngImACwAAAAnANoAAAFuAAcAQwAmAlD//wCdAAADxAbrAiYA1wAAACYA2tHCAAcAQ//3AJ0AAP//AJ4AAAPFCBkCJgBMAAAABwCN//gBy///AH7/0QRPCJ4CJgA4AAAAJwDaAAABb` +
-	`gAHAEMAJgJQ//8Aj//hBDIG6wImAFgAAAAmANoAwgAHAEMAIACdAAD//wA4/qkEnQRdAiYAWwAAAAcA3AAE+VP//wCVAAAEtAe8AiYANQAAAAcA2wAAAW7//wB+/9EFIAfeAiYDUgAAAAcA2//TAZD` +
-	`//wD6AAAEQAYvAiYAVQAAAAYA2zbhAAD//wCP/+EFAgapAiYDUwAAAAYA29RbAAAAAQAw/rIEnQYQAAsAO7gAMiu6AAAABQA1K7gAABC4AALcuAAAELgAA9C4AAMvALoAAQACADUrugAIAAcANSu4A` +
-	`AcQuAAK0DAxJTMRIxMjESE1IRUhAsuztgLJ/i8Ebf4umv4YAU4FZaurAAAAAQBY/rIEdQRdAAsAO7gAMiu6AAAABQA1K7gAABC4AALcuAAAELgAA9C4AAMvALoAAQACADUrugAIAAcANSu4AAcQuAA` +
-	`K0DAxJTMRIxMjESE1IRUhAsuztgLJ/lcEHf5Wmv4YAU4DwpubAAAAAQB2/k4EQAY/ACoA17gAMiu4ACsvuAAsL7gABNy4AA/cQQUA2gAPAOoADwACXUEbAAkADwAZAA8AKQAPADkADwBJAA8AWQAPA` +
-	`GkADwB5AA8AiQAPAJkADwCpAA8AuQAPAMkADwANXboAAgAEAA8REjm4ACsQuAAX0LgAFy+6AAoAFwAEERI5uAAk3EEbAAYAJAAWACQAJgAkADYAJABGACQAVgAkAGYAJAB2ACQAhgAkAJYAJACmACQ` +
-	`AtgAkAMYAJAANXUEFANUAJADlACQAAl0AugAMAAcANSu6ABsAIAA1KzAxJQYHFhUUBiMiJzUWMzI2NTQnJicmJyYREDcSITIXFSYjIgcGFRQXFjMyNwRAh3BoeV09Qi0jQTg4IS30lp+gtAE+iq6hn` +
-	`9l8ZGV72Z6iEjILcGZNZB1hEzgfMEstISTW5AFRAVHkAQJBuF3swO/vwO1dAAABAKT+TgRHBHwAKgDXuAAyK7gAKy+4ACwvuAAE3LgAD9xBBQDaAA8A6gAPAAJdQRsACQAPABkADwApAA8AOQAPAEk` +
-	`ADwBZAA8AaQAPAHkADwCJAA8AmQAPAKkADwC5AA8AyQAPAA1dugACAAQADxESObgAKxC4ABfQuAAXL7oACgAXAAQREjm4ACTcQRsABgAkABYAJAAmACQANgAkAEYAJABWACQAZgAkAHYAJACGACQAl` +
-	`gAkAKYAJAC2ACQAxgAkAA1dQQUA1QAkAOUAJAACXQC6AAwABwA1K7oAGwAgADUrMDElBgcWFRQGIyInNRYzMjY1NCcmJyYnJjU0NzYzMhcVJiMiBwYVFBcWMzI3BEeMgnh5XT1CLSNBODgoOsSFnp+` +
-	`i9K3Bw5qwamJobLOB0SAvDHltTWQdYRM4HzBLNiUZjKf89qquQbNZgniytnZ9RwAAAQB9/k4ESwY+ADcA7bgAMiu6ACAAKwA1K7oAFgAJADUruAAWELgAANxBBQDaAAkA6gAJAAJdQRsACQAJABkAC` +
-	`QApAAkAOQAJAEkACQBZAAkAaQAJAHkACQCJAAkAmQAJAKkACQC5AAkAyQAJAA1dQQUA2gArAOoAKwACXUEbAAkAKwAZACsAKQArADkAKwBJACsAWQArAGkAKwB5ACsAiQArAJkAKwCpACsAuQArAMk` +
-	`AKwANXbgAGty6ABgAKwAaERI5ugAeACsAIBESObgAFhC4ADncALoAKAAjADUrugASAA0ANSu6AAUAAgA1K7oAGAACAAUREjkwMQEQISM1MzI3NjU0JyYjIgc1NjMyFxYVFAUEERQHBgcWFRQGIyInN` +
-	`RYzMjY1NCcmJyYnNRYzMjc2A4H+Gkl7lWFxWU17p8bJndV/nv71AVirgrNqeV09Qi0jQTg4Himas+K/mGRnAboBTptATI51PTRhsUtLXcbuf2b+yueMaRVxZk1kHWETOB8wSyofDUC+c1peAAAAAAE` +
-	`Aqf5OBC8EfAA3AO24ADIrugAGABEANSu6AAAAHgA1K0EFANoAEQDqABEAAl1BGwAJABEAGQARACkAEQA5ABEASQARAFkAEQBpABEAeQARAIkAEQCZABEAqQARALkAEQDJABEADV26AAQAEQAGERI5Q` +
-	`QUA2gAeAOoAHgACXUEbAAkAHgAZAB4AKQAeADkAHgBJAB4AWQAeAGkAHgB5AB4AiQAeAJkAHgCpAB4AuQAeAMkAHgANXbgABhC4ACnQuAApL7gABhC4ADTcugA2AAYANBESOQC6AA4ACQA1K7oAMAA` +
-	`rADUrugAlACIANSu6ADYAIgAlERI5MDEBFAcGBxYVFAYjIic1FjMyNjU0JyYnJic1FjMyNzY1NCcmKwE1MzI3NjU0IyIHNTYzMhcWFRQHFgQvlmeEd3ldPUItI0E4OCY2qbPK42hPV19DhIqmWTlB2` +
-	`cbI0MGlb4ya0gFRrGlHEXhtTWQdYRM4HzBLNCQPRsWENz1beS4hiisxXI+JvGg8S5ahW08AAf/pAAAEzAYQABIAY7gAMiu6ABIAAAA1K7gAABC4AATQuAASELgABtC4ABIQuAAK0AC4AAAvuAAPL7g` +
-	`ABS+4AAwvugAEAAEANSu4AAQQuAAH0LgAARC4AAnQugALAAAABRESOboAEQAAAAUREjkwMTMRIzUzNTMVMxUjEQEzCQEjARGdtLTKwsICQdb90wJ7/f2YBLGbxMSb/mYC+f0f/NEDF/zpAAAAAQAHA` +
-	`AAEpgYQABIAabgAMiu6ABIAAAA1K7gAABC4AATQuAASELgABtC4ABIQuAAK0AC4AAAvuAAPL7gABS+6AAQAAQA1K7gABBC4AAfQuAABELgACdC6AAsAAAAFERI5ugANAAAABRESOboAEQAAAAUREjk` +
-	`wMTMRIzUzNTMVMxUjEQEzCQEhARHNxsa6wMACGfj96AIm/wD94QR/m/b2m/3PAg/98f2yAk79sgAAAAABADkAAATMBhAADAA3uAAyK7oABAADADUruAAEELgAANy4AAQQuAAL0AC4AAAvuAAJL7oAB` +
-	`AABADUruAAEELgABtAwMSERIzUhEQEzCQEjAREBM/oBxAGr1v5pAeX9/i4FdZv9BwL5/R/80QMX/OkAAQA5AAAEpgRdAAwAN7gAMiu6AAQAAwA1K7gABBC4AADcuAAEELgAC9AAuAAAL7gACS+6AAQ` +
-	`AAQA1K7gABBC4AAbQMDEhESM1IREBMwkBIQERATH4AbIBtfj+TAHC/wD+RQPCm/3xAg/98f2yAk79sgAAAAAB/HIFCP7ABk4ADwAfuAAyKwC6AAQACQA1K7gACRC4AADQuAAEELgADNwwMQE+AjMyH` +
-	`gEXIy4BIyIGB/xyDVl/Q0N+WQyACWA9PWIJBQhviU5OiW9Ld3VNAAAA//8B2AQ+AvUGYQAPALYAmgGQMAD//wHYBBYC9QY5AA8AtwCaAWgwAAABAM8C4AP9Bg4ABQAjuAAyKwC4AAEvuAAFL7oAAAA` +
-	`FAAEREjm6AAMABQABERI5MDETARUJARXPAy791AIsBHcBl4H+6/7ogAABAM8C4AP9Bg4ABQAjuAAyKwC4AAEvuAAFL7oAAAABAAUREjm6AAMAAQAFERI5MDEJATUJATUD/fzSAi390wR3/mmCARQBG` +
-	`IAAAAAAAQDPAyED/QZQAAUAHbgAMisAuAAAL7gAAS+4AAQvugADAAEAABESOTAxCQEjCQEjAmYBl4D+6f7pgAZQ/NECMP3QAAABAM8DIQP9BlAABQAduAAyKwC4AAEvuAAEL7gAAC+6AAMAAAABERI` +
-	`5MDEJATMJATMCZv5pgAEXAReAAyEDL/3QAjAA///9DQQ+/ioGYQAPALb7zwGQMAD///0NBBb+KgY5AA8At/vPAWgwAP///Q0EFv4qBjkADwC3+88BaDAAAAH9CwQW/igGOgARAGm4ADIrugAKAAQAN` +
-	`StBGwAGAAoAFgAKACYACgA2AAoARgAKAFYACgBmAAoAdgAKAIYACgCWAAoApgAKALYACgDGAAoADV1BBQDVAAoA5QAKAAJduAAKELgAANC4AAAvALgAAC+4AAcvMDEBLgI1NDYzMhYVFAYjFB4BF/4` +
-	`oWXxIWz8xSkJGIjg2BBYMaJFUXm1HNSxPOlI1DgAA///9DQQW/ioGOQAPALf7zwFoMAAAAfzsBNj+RwZ+ABwAebgAMiu6AA8AGgA1K0EFANoAGgDqABoAAl1BGwAJABoAGQAaACkAGgA5ABoASQAaA` +
-	`FkAGgBpABoAeQAaAIkAGgCZABoAqQAaALkAGgDJABoADV0AuAAVL7oACQADADUruAADELgABdC4AAUvuAAJELgABtC4AAYvMDEBLgEjIgcnPgEzMhYXHgEVFA4CDwEjNT4BNTQm/ZkTJxweNAUcNhk` +
-	`/bCkLEQ8mQC8FbktRGQYDDAsHYwMFKTsQNB4ZNS4jCjdfB0orFikAAAAAAf1bBPf91wZKAAMAF7gAMiu6AAEAAAA1KwC6AAEAAgA1KzAxATMRI/1bfHwGSv6tAAAAAAL8tgT3/nwGSgADAAcAQ7gAM` +
-	`iu4AAgvuAAJL7gACBC4AADQuAAAL7gAAdy4AAkQuAAF3LgABNwAugABAAIANSu4AAEQuAAE0LgAAhC4AAbQMDEBMxEjATMRI/y2fHwBSnx8Bkr+rQFT/q0AAAH8iv3w/qkADwALAD+4ADIrugADAAQ` +
-	`ANSu4AAQQuAAI0LgAAxC4AArQALgAAy+4AAkvugAAAAEANSu4AAEQuAAF0LgAABC4AAfQMDEFFSMVIzUjNTM1MxX+qelO6OhO2k7o6E7p6QAAAfyK/eH+qQAAAAcAI7gAMiu6AAMABAA1KwC4AAMvu` +
-	`gAAAAEANSu4AAEQuAAF0DAxIRUjESMRIzX+qelO6E7+LwHRTgAB/Ir94f6pAAAABwAjuAAyK7oABQACADUrALgAAy+6AAEAAAA1K7gAARC4AAXQMDEBNTMRMxEzFfyK6U7o/eFOAdH+L04AAAAB/Ir` +
-	`94f6pAAAABwAnuAAyK7oAAQAAADUruAABELgABdAAuAAGL7gAAC+6AAMABAA1KzAxITMVIRUhFSP8ik4B0f4vTulO6AAB/Ir94f6pAAAABwAnuAAyK7oAAAABADUruAABELgABdAAuAAAL7gABi+6A` +
-	`AUAAgA1KzAxASM1ITUhNTP+qU7+LwHRTv3h6U7oAAAAAfxy/Qv+wP5RAAYAE7gAMisAuAADL7gAAS+4AAUvMDEBNzMDIwMz/ZmMm+l86Zz9qaj+ugFGAAABAFsAAARjBhAACwAfuAAyKwC6AAAAAQA` +
-	`1K7oABgAFADUruAAFELgACNAwMSUVITUJATUhFSEJAQRj+/gB0v5FA8r9NAGb/iK6uroCfwI7nJz97/1XAAD//wACAAAEfgYQAgYA6QAAAAEAm/6MBD4EfAAVAEW4ADIruAAWL7gAFy+4ABYQuAAA0` +
-	`LgAAC+4ABXcuAAC0LgAFxC4AAvcugADAAAACxESObgADNwAuAALL7oABgARADUrMDEzETMVPgEzMh4BFREjETQuASMiBgcRm7pQ4nFjokG6H1MxYuFJBF30gZJpqvn8HAP7oWdCuoz9dQAAAgAh/9E` +
-	`ErAYQAA8AEwBJuAAyK7gAFC+4ABUvuAAUELgAENC4ABAvuAAVELgACty6AAEAEAAKERI5uAAH3LgAEBC4ABPcALgACC+4ABEvugADAA4ANSswMSU1FjMyPgE1ETMRFA4BIyIlETMRAV7ak01/S8pxz` +
-	`J+y/gPKIr5zPI3nA/P8CPjadS8GEPnwAAAABAA7/i4EkgZtAA8AGwAfACsA+bgAMiu6ACYAIAA1K7oAFgAQADUrugABACAAFhESOUEFANoAEADqABAAAl1BGwAJABAAGQAQACkAEAA5ABAASQAQAFk` +
-	`AEABpABAAeQAQAIkAEACZABAAqQAQALkAEADJABAADV26AAcAEAAWERI5uAAHL7gACtxBGwAGACYAFgAmACYAJgA2ACYARgAmAFYAJgBmACYAdgAmAIYAJgCWACYApgAmALYAJgDGACYADV1BBQDVA` +
-	`CYA5QAmAAJdugAcACAAJhESObgAHC+4AB/cuAAWELgALdwAugADAA4ANSu6ABMAGQA1K7gAExC4ACPQuAAZELgAKdAwMQE1FjMyPgE1ETMREA4BIyIBNDYzMhYVFAYjIiYBETMRAzQ2MzIWFRQGIyI` +
-	`mAYqca12CSbqGvqV3AYdVJydVVScnVfzAutlWJidWVicmVv5Kri5Bmr8D+fwq/uTdYAfCPz4+Pz4+PvpOBF37owXwPz4+Pz4+PgAAAAEA4wInA+sCwgADAA+4ADIrALoAAQAAADUrMDETNSEV4wMIA` +
-	`iebmwABAOMCJwPrAsIAAwAPuAAyKwC6AAEAAAA1KzAxEzUhFeMDCAInm5sAAQBHAhAEhQKrAAMAD7gAMisAugABAAAANSswMRM1IRVHBD4CEJubAAEARwIQBIUCjAADAA+4ADIrALoAAQAAADUrMDE` +
-	`TNSEVRwQ+AhB8fAABAPr+TgRABHwAEQBXuAAyK7gAEi+4ABMvuAASELgAANC4AAAvuAAR3LgAAtC4ABMQuAAJ3LoAAwAAAAkREjm4AArcALgAAC+6AAYADQA1K7gABhC4AArcugADAAYAChESOTAxE` +
-	`xEzFT4BMzIXESM1JiMiBgcR+rpX4YplZbMvG2jIX/5OBg/0iokf/m3/CKSk+8UAAQBH/k4EhQR8ACUAwbgAMiu4ACYvuAAR0LgAES+4AArcQQMAHwAKAAFdQQMAwAAKAAFdQQMAcAAKAAFduAAC3EE` +
-	`DAB8AAgABXUEDAHAAAgABXUEDAMAAAgABXbgAAdy4ABEQuAAU3LgADtC4AA4vuAAKELgAG9C4AAoQuAAe3LgAAhC4ACTQuAABELgAJ9wAuAAAL7gAEi+4ABwvuAABL7oAIQAGADUrugADAAEAABESO` +
-	`boACgABAAAREjm4AAYQuAAO0LgAIRC4ABjQMDEBESMRDgEjIiY9AQ4CIyImNREzERQeATMyNjcRMxEUFjMyNjcRBIWjTYVEUWMhXWRBSGajDCQVNHc+nDoXM3U1BHz50gLRo3x/dTxmf0t6tgNM/QV` +
-	`eRSSfuAJr/QaUNLKLAoUAAAADAEf/0QSFBj4ACwAXACMAhbgAMiu4ACQvuAAD0LgAAy+4ABvcQQMATwAbAAFdQQMAoAAbAAFdQQMAYAAbAAFduAAP3EEDAE8ADwABXUEDAKAADwABXUEDAGAADwABX` +
-	`bgACdy4AAMQuAAV3LgAGxC4ACHcuAAJELgAJdwAugAMAAAANSu6AAYAEgA1K7oAHgAYADUrMDEFIAAREAAhIAAREAAlMhIREAIjIgIREBITIiY1NDYzMhYVFAYCZv74/ukBFwEIAQkBFv7q/veSw8O` +
-	`SksPDkkNgYUJCYWAvAb0BegF5Ab3+Q/6H/ob+Q5wBSwFQAVABS/61/rD+sP61AfdgQ0RfX0RDYAABAPr/4QRABhAAEQBNuAAyK7gAEi+4ABMvuAAB3LgAAty4ABIQuAAI0LgACC+4AAvcuAACELgAE` +
-	`NAAuAAAL7oACgAGADUrugADAAYAChESObgABhC4AA3cMDEBESM1DgEjIicRMxUWMzI2NxEEQLpX4YplZbMvG2jIXwYQ+fD0iokfAZP/CKSkBDwA//8AIQAABK0IHAImACQAAAAHA/QEzwGe//8AZv/` +
-	`hBF4GfQImAEQAAAAHA/QEzP////8AIQAABK0JGAImACQAAAAnANgAAAFuAAcD9AY+Apr//wBm/+EEfwd3AiYARAAAACYA2P/PAAcD9AY4APkAAP//ACEAAAStCcgCJgAkAAAAJwDbAAABbgAHA/QEz` +
-	`gNK//8AZv/hBF4IJQImAEQAAAAmANv/zwAHA/QEywGnAAD//wDLAAAEEQgcAiYAKAAAAAcD9ATVAZ7//wCC/+AETAaIAiYASAAAAAcD9ATOAAr//wDLAAAEjAkYAiYAKAAAACcA2AAHAW4ABwP0BkU` +
-	`Cmv//AIL/4ASFB4YCJgBIAAAAJgDYAN4ABwP0Bj4BCAAA//8ArAAABCAIHAImACwAAAAHA/QEzQGe//8AnQAAA8QGbQImANcAAAAHA/QEmf/v//8AR//RBIUISgImADIAAAAHA/QEzQHM//8AV//hB` +
-	`HYGiAImAFIAAAAHA/QEzQAK//8AR//RBIUJRgImADIAAAAnANgAAAGcAAcD9AY9Asj//wBX/+EEhAeGAiYAUgAAACYA2ADeAAcD9AY9AQgAAP//AEf/0QUqCEoCJgNUAAAABwP0BR8BzP//AFf/4QU` +
-	`WBpoCJgNVAAAABwP0BR0AHP//AH7/0QRPCBwCJgA4AAAABwP0BM0Bnv//AI//4QQyBm0CJgBYAAAABwP0BMn/7///AH7/0QUgCD4CJgNSAAAABwP0BG8BwP//AI//4QUCBwgCJgNTAAAABwP0BGgAi` +
-	`v//ADQAAASZCBwCJgA8AAAABwP0BM0Bnv//ACD+LgStBm0CJgBcAAAABwP0BM3/7wABAaMC7gNKBg0AIACluAAyK7gAIS+4ACIvuAAhELgAANC4AAAvuAAg3LgABdC4ACIQuAAX3LgAC9xBBQDaAAs` +
-	`A6gALAAJdQRsACQALABkACwApAAsAOQALAEkACwBZAAsAaQALAHkACwCJAAsAmQALAKkACwC5AAsAyQALAA1duAAgELgADtC4AA4vuAAgELgAFNC4ABQvuAALELgAGtC4ABovALgAAC+6ABQADgA1K` +
-	`zAxATU0PgE/ATY/ATY1NCYjIgYHNTYzMhYVFA8DDgEdAQIEDicoHwkRISo8RCtbPFxlcHZMISckEx4C7u4qNzwqIQkWKTQmIzYXIlotXElITSIpJBY5M/QAAAAAAQGjAu4DSgYNACAAobgAMiu4ACE` +
-	`vuAAiL7gAINy4AADcuAAhELgACdC4AAkvuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl24AAbQuAAGL7gAABC4A` +
-	`AzQuAAML7gAABC4ABLQuAASL7gAABC4ABvQALgAAC+6AAwAEgA1KzAxATU0Ji8DJjU0NjMyFxUuASMiBhUUHwEWHwEeAh0BAoweFCMnIUx1cGVdPVosQzwqIREJHycoDgLu9DM5FiQpIk1ISVwtWiI` +
-	`XNiMmNCkWCSEqPDcq7gABAdwEGwLwBj4AEQBduAAyK7oAAwAOADUrQQUA2gAOAOoADgACXUEbAAkADgAZAA4AKQAOADkADgBJAA4AWQAOAGkADgB5AA4AiQAOAJkADgCpAA4AuQAOAMkADgANXQC4A` +
-	`AAvuAAGLzAxATIWFRQGByYnJjYXMjY1NCYjAeFvoJ5xAwEBAQRLZ2lJBj6fcW6hBBsWEx4BbEZJagABAKQEFgHBBjoAEgBtuAAyK7oADQAEADUruAAEELgACty4AADQuAAAL0EbAAYADQAWAA0AJgA` +
-	`NADYADQBGAA0AVgANAGYADQB2AA0AhgANAJYADQCmAA0AtgANAMYADQANXUEFANUADQDlAA0AAl0AuAAAL7gABy8wMQEuAjU0NjMyFhUUBiMUHgEXFQHBWXxIWz8xSkJGIjg2BBYMaJFUXm1HNSxPO` +
-	`lI1Dl4AAQHcBBsC8AY+ABEAXbgAMiu6AAMADgA1K0EbAAYAAwAWAAMAJgADADYAAwBGAAMAVgADAGYAAwB2AAMAhgADAJYAAwCmAAMAtgADAMYAAwANXUEFANUAAwDlAAMAAl0AuAARL7gACy8wMQE` +
-	`iBhUUFjM2FgcGBy4BNTQ2MwLrSWlnSwQBAQEDcZ6gbwXhaklGbAEeExYbBKFucZ8AAQIoBPcCpAZKAAMAF7gAMiu6AAEAAAA1KwC6AAEAAgA1KzAxATMRIwIofHwGSv6tAAAA//8BTwUIA34FhAIGA` +
-	`NoAAP//AUAFCANABk4CBgCNAAD//wFABQgDQAZOAgYAQwAA//8BQP9oA0AArgIHAEMAAPpgAAD//wFA/2gDQACuAgcAjQAA+mAAAP//AU//aAN+/+QCBwDaAAD6YAAAAAECKP6tAqQAAAADABe4ADI` +
-	`rugABAAAANSsAugABAAIANSswMSEzESMCKHx8/q0AAAEB3AD7AvADHgARAF24ADIrugADAA4ANStBBQDaAA4A6gAOAAJdQRsACQAOABkADgApAA4AOQAOAEkADgBZAA4AaQAOAHkADgCJAA4AmQAOA` +
-	`KkADgC5AA4AyQAOAA1dALgAAC+4AAYvMDEBMhYVFAYHJicmNhcyNjU0JiMB4W+gnnEDAQEBBEtnaUkDHp9xbqEEGxYTHgFsRklqAAEB3AD7AvADHgARAF24ADIrugADAA4ANStBGwAGAAMAFgADACY` +
-	`AAwA2AAMARgADAFYAAwBmAAMAdgADAIYAAwCWAAMApgADALYAAwDGAAMADV1BBQDVAAMA5QADAAJdALgAES+4AAsvMDEBIgYVFBYzNhYHBgcuATU0NjMC60lpZ0sEAQEBA3GeoG8CwWpJRmwBHhMWG` +
-	`wShbnGfAAEBVwEBA3YDIAAHACO4ADIrugAFAAIANSsAuAADL7oAAQAAADUruAABELgABdAwMQE1MxEzETMVAVfpTugBAU4B0f4vTgAAAAEBVwEBA3YDIAAHACO4ADIrugADAAQANSsAuAADL7oAAAA` +
-	`BADUruAABELgABdAwMQEVIxEjESM1A3bpTugDIE7+LwHRTgAAAAEBVwEQA3YDLwALAD+4ADIrugADAAQANSu4AAQQuAAI0LgAAxC4AArQALgACS+4AAMvugAAAAEANSu4AAEQuAAF0LgAABC4AAfQM` +
-	`DEBFSMVIzUjNTM1MxUDdulO6OhOAkZO6OhO6ekAAQFAACsDjgFxAAYAE7gAMisAuAADL7gAAS+4AAUvMDElNzMDIwMzAmeMm+l86ZzJqP66AUYAAAAB/Q/92/4j//4AEQBduAAyK7oAAwAOADUrQQU` +
-	`A2gAOAOoADgACXUEbAAkADgAZAA4AKQAOADkADgBJAA4AWQAOAGkADgB5AA4AiQAOAJkADgCpAA4AuQAOAMkADgANXQC4AAYvuAAALzAxBTIWFRQGByYnJjYXMjY1NCYj/RRvoJ5xAwEBAQRLZ2lJA` +
-	`p9xbqEEGxYTHgFsRklqAAAB/Q/92/4j//4AEQBduAAyK7oAAwAOADUrQRsABgADABYAAwAmAAMANgADAEYAAwBWAAMAZgADAHYAAwCGAAMAlgADAKYAAwC2AAMAxgADAA1dQQUA1QADAOUAAwACXQC` +
-	`4AAsvuAARLzAxBSIGFRQWMzYWBwYHLgE1NDYz/h5JaWdLBAEBAQNxnqBvX2pJRmwBHhMWGwShbnGfAAAC+x8D3AAUBYQAAwAHABe4ADIrALoABQAEADUrugABAAAANSswMQE1IRUBNSEV+x8E9fsLB` +
-	`PUFCHx8/tR8fAAAAvsk/jQAD//cAAMABwAXuAAyKwC6AAEAAAA1K7oABQAEADUrMDEBNSEVATUhFfskBOv7FQTr/jR8fAEsfHwAAAEBQAPcA0AGTgADAA+4ADIrALgAAS+4AAAvMDEJATMBAUABKNj` +
-	`+qgPcAnL9jgAAAAIAngPcBC4GTgADAAcAF7gAMisAuAABL7gABS+4AAAvuAAELzAxEwEzATMBMwGeASjY/qrmASjY/qoD3AJy/Y4Ccv2OAAAAAAEBQAPcA0AGTgADAA+4ADIrALgAAS+4AAAvMDEJA` +
-	`TMBAUABKNj+qgPcAnL9jgAAAAIAngPcBC4GTgADAAcAF7gAMisAuAABL7gABS+4AAAvuAAELzAxEwEzATMBMwGeASjY/qrmASjY/qoD3AJy/Y4Ccv2OAAAAAAP/1wPcBPcGTgADAAcACwAfuAAyKwC` +
-	`4AAEvuAAFL7gACS+4AAAvuAAEL7gACC8wMQMBMwEzATMBMwEzASkBKNj+quYBKNj+quYBKNj+qgPcAnL9jgJy/Y4Ccv2OAAAAAAEBQAPcA0AGTgADAA+4ADIrALgAAS+4AAAvMDEJATMBApb+qtgBK` +
-	`APcAnL9jgAAAAIAngPcBC4GTgADAAcAF7gAMisAuAABL7gABS+4AAAvuAAELzAxCQEzASEBMwEDhP6q2AEo/cb+qtgBKAPcAnL9jgJy/Y4AAAP/1wPcBPcGTgADAAcACwAfuAAyKwC4AAEvuAAFL7g` +
-	`ACS+4AAAvuAAEL7gACC8wMQkBMwEhATMBIQEzAQRN/qrYASj9xv6q2AEo/cb+qtgBKAPcAnL9jgJy/Y4Ccv2OAAEB1wFhAvYCgAALAF24ADIrugAJAAMANStBBQDaAAMA6gADAAJdQRsACQADABkAA` +
-	`wApAAMAOQADAEkAAwBZAAMAaQADAHkAAwCJAAMAmQADAKkAAwC5AAMAyQADAA1dALoABgAAADUrMDEBIiY1NDYzMhYVFAYCZjlWVjk5V1cBYVY6OlVVOjpWAAAAAQFAA9wDQAZOAAMAD7gAMisAuAA` +
-	`BL7gAAC8wMQkBMwEBQAEo2P6qA9wCcv2OAAAAAwBm/+EEXgcGABQAIAAyAOW4ADIrugAbAAYANSu6ABAAAAA1K7oAJAAvADUruAAAELgAFdBBGwAGABsAFgAbACYAGwA2ABsARgAbAFYAGwBmABsAd` +
-	`gAbAIYAGwCWABsApgAbALYAGwDGABsADV1BBQDVABsA5QAbAAJdQQUA2gAvAOoALwACXUEbAAkALwAZAC8AKQAvADkALwBJAC8AWQAvAGkALwB5AC8AiQAvAJkALwCpAC8AuQAvAMkALwANXbgAEBC` +
-	`4ADTcALgAIS+6AB4AAwA1K7oACgAYADUruAAKELgADNC4AAwvugASAAMAHhESOTAxJQ4BIyImNTQSNjMyFxYXMxEUFyMmAxEmIyICFRQWMzI2AzIWFRQGByYnJjYXMjY1NCYjA3lV72SM35T4tzVrE` +
-	`CC7KsIWDWNVrOV9UFfS4W+gnnEDAQEBBEtnaUn7jI7y9sIBMbELAgP868p+XAF8Ad8a/vblpqmwBcOfcW6hBBsWEx4BbEZJagAAAQB6AAAEUwYQABEAN7gAMisAugANAA4ANSu6AAYABwA1K7oAAQA` +
-	`AADUruAAHELgAA9C4AAEQuAAI0LgAABC4AArQMDETNSEBITUhFQEzFSEBIRUhNQHjAVEBJ/02A7P+2c7+1f6kAu/8JwFdAu+bAeqcnP4Wm/28q6sCRAAAAAABAIUAAAQ/BF0AEQA3uAAyKwC6AA0AD` +
-	`gA1K7oABgAHADUrugABAAAANSu4AAcQuAAD0LgAARC4AAjQuAAAELgACtAwMRM1IQEhNSEVATMVIQEhFSE1AeMBTgEB/WMDk/7+xf6z/tgCyfxGASYB/5sBKJub/tib/qyrqwFUAAAAAAIAsAAABFo` +
-	`GEAAKABoAibgAMiu4ABsvuAAcL7gAFNy4AADcQQUA2gAAAOoAAAACXUEbAAkAAAAZAAAAKQAAADkAAABJAAAAWQAAAGkAAAB5AAAAiQAAAJkAAACpAAAAuQAAAMkAAAANXbgAGxC4ABnQuAAZL7gAB` +
-	`ty4AA3QALoABwAYADUrugALAAwANSu6AA8ABAA1KzAxATQnJisBETMyNzYTFSURMzIXHgEVEAcGIyERA5C9W7tDZcVjiXv9bz7vjIif4W3u/pIBvuxlMP1bMUQFAZwB/mVFRf+Z/uxuNgYQAAIAjf/` +
-	`wBFsGTgATAB8ApbgAMiu4ACAvuAAhL7gAIBC4AA/QuAAPL7gADty4AADQuAAhELgABty4AA4QuAAU0LgABhC4ABrcQQUA2gAaAOoAGgACXUEbAAkAGgAZABoAKQAaADkAGgBJABoAWQAaAGkAGgB5A` +
-	`BoAiQAaAJkAGgCpABoAuQAaAMkAGgANXQC6ABcACgA1K7oAEQASADUrugADAB0ANSu4AAoQuAAM0LgADC8wMQE+ATMyFhUUAgYjIicmJyMRIRUhGQEWMzISNTQmIyIGAUdW7mSM4JX4tzRsECC6AzD` +
-	`9imVVqed+UFfRA2KMjvL2wv7PsQsCAwZOm/zS/iEaAQnmp6iwAAACALAAAARaBhAACgAaAI24ADIruAAbL7gAHC+4ABsQuAAR0LgAES+4AADcQRsABgAAABYAAAAmAAAANgAAAEYAAABWAAAAZgAAA` +
-	`HYAAACGAAAAlgAAAKYAAAC2AAAAxgAAAA1dQQUA1QAAAOUAAAACXbgAHBC4AAzcuAAF3LgAF9AAugAFAA0ANSu6ABoAGQA1K7oAFwAGADUrMDEBFBcWOwERIyIHBgERISInJhE0Njc2OwERBTUBeol` +
-	`jxWVDu1u9AuD+ku5t4Z+IjO8+/W8Bvq9EMQKlMGUDZvnwNm4BFJn/RUUBmwGcAAAAAAIAjf/wBFsGTgATACAAobgAMiu4ACAvuAAhL7gAA9y4AADcuAAF0LgAIBC4AA3QuAANL7gAABC4ABTQuAANE` +
-	`LgAGtxBGwAGABoAFgAaACYAGgA2ABoARgAaAFYAGgBmABoAdgAaAIYAGgCWABoApgAaALYAGgDGABoADV1BBQDVABoA5QAaAAJdALoAHQAJADUrugADAAAANSu6ABAAFwA1K7gACRC4AAfQuAAHLzA` +
-	`xASE1IREjBgcGIyImAjU0NjMyFhcVLgEjIgYVFBIzMjcRA6H9igMwuiAQbDS3+JXgjGTuVlTRV1B+56lVZQWzm/myAwILsQExwvbyjozdlbCop+b+9xoB3wAAAAABAGD+LwRBBcIAGABDuAAyK7oAE` +
-	`gAHADUruAAHELgAC9C4ABIQuAAN0AC4AAwvugAWAAMANSu6AAsACAA1K7gACxC4AA7QuAAIELgAENAwMQEOASMiLgE1ESE1IREzESEVIREUHgEzMjcEQYN2N3XCY/7pARe6AfH+D0BrSYeV/ns3FVq` +
-	`wuAN0mwHC/j6b/I+HbzRVAAAAAAEAp/4vBIgEXQAXAEO4ADIrugAFABIANSu4AAUQuAAA0LgAEhC4ABbQALgAAC+6AA4ACQA1K7oAAgADADUruAADELgAE9C4AAIQuAAV0DAxAREzFSMVFA4BIyInN` +
-	`RYzMj4BPQEhNSERA5D4+GTRrIKGkXBZiE3+RQG7BF382ps03dSIG7EwQpWySJsDJgAAAAEArAAABCAGEAATAFe4ADIrugAJAAIANSu4AAkQuAAM0LgAAhC4ABLQALoADgAPADUrugAFAAQANSu6AAE` +
-	`AAAA1K7gABBC4AAfQuAABELgACdC4AAAQuAAL0LgADhC4ABHQMDETNSERITUhFSERIRUhESEVITUhEeMBHv6rA3T+qwEg/uABVfyMAVUDDZsBzJyc/jSb/Y6bmwJyAAEAeQAAA94GTgAPAEe4ADIru` +
-	`gANAAAANSu4AAAQuAAE0LgADRC4AAjQALoADQAAADUrugAIAAUANSu6AAQAAQA1K7gABBC4AAnQuAABELgAC9AwMSERITUhESE1IREhFSERIRUBx/7gASD+sgIIAS7+0gFdAyGbAfac/W6b/XqbAAA` +
-	`AAAEAdv/RBEAGPwAZAG24ADIrugAGABMANStBBQDaABMA6gATAAJdQRsACQATABkAEwApABMAOQATAEkAEwBZABMAaQATAHkAEwCJABMAmQATAKkAEwC5ABMAyQATAA1duAAGELgAG9wAugAPAAoAN` +
-	`Su6AAIAFwA1KzAxEzYzMgQSFRQCBCMiJzUWMzI2EjU0AiYjIgd2roq2ATKqqP7OuIquoZ94x3p+xHeeogX+QcP+f/Px/oDGQbhdpAE9ur0BQJ9dAAACACEAAAStBhAABQAIABu4ADIrALgAAy+6AAE` +
-	`AAgA1K7gAAhC4AAbQMDETIRUBIwEpAQEhBIz+Jc3+HAPk/PUBgwYQuvqqBVb7rwAAAwB2/p0E/gZOABoAJgAqANG4ADIruAArL7gALC+4ACsQuAAG0LgABi+4ACwQuAAZ3LgAGty4AA7QuAAaELgAE` +
-	`tC4ABkQuAAU0LgAGhC4ABvQuAAGELgAIdxBGwAGACEAFgAhACYAIQA2ACEARgAhAFYAIQBmACEAdgAhAIYAIQCWACEApgAhALYAIQDGACEADV1BBQDVACEA5QAhAAJdALgAEy+6ACgAJwA1K7oAJAA` +
-	`DADUrugASAA8ANSu6AAoAHgA1K7gAChC4AAzQuAAML7gAEhC4ABXQuAAPELgAF9AwMSUOASMiJjU0EjYzMhcWFzUjNTM1MxUzFSMRIxkBJiMiAhUUFjMyNgE1IRUDiVXvZIzflPi3NWsQILq6u7q6u` +
-	`2NVrOV9UFfS/XUDdvuMjvL2wgExsQsCA4yL2tqL+xcB2AHfGv725aapsP1am5sAAQB2/9EElAY/AC0Av7gAMiu6ABMABgA1K7oAKQAYADUrugAhACIANSu4ABgQuAAt3LgADNC6AA0AIgAhERI5QRs` +
-	`ABgATABYAEwAmABMANgATAEYAEwBWABMAZgATAHYAEwCGABMAlgATAKYAEwC2ABMAxgATAA1dQQUA1QATAOUAEwACXbgAKRC4ABrQugAbABgALRESObgAIRC4AC/cALoAKwACADUrugAKAA8ANSu6A` +
-	`B4AIgA1K7oAGwAiAB4REjm4AB4QuAAl3DAxJQYjIiQCNTQSJDMyFxUmIyIGAhUUEhcWFxEzFT4BMzIXESM1JiMiBgcRFjMyNwRAroq2/s6qqAEyuIquoZ94x3p+Yg0OhD2gYkhHfyETSo5DHB6eohJ` +
-	`BwwGB8/EBgMZBuF2k/sO6vf7AUAsJAtatYmEW/uK1BnV0/kAFXQAAAAABAI0AAARXBj4AKgCRuAAyK7oAGgACADUruAACELgABtC4ABoQuAAU0LgAGhC4ABjQuAAaELgAHdC4AB0vugAhAAIAGhESO` +
-	`bgAAhC4ACrQuAAqLwC6ACIAIwA1K7oACwAQADUrugABAAAANSu6AAYAAwA1K7gABhC4ABXQuAADELgAF9C4AAEQuAAa0LgAABC4ABzQuAAiELgAJdAwMRM1MzUjNTM1ED4BMzIXFSYjIg4BHQEhFSE` +
-	`dASEVIQYHBgchFSE1PgE3NjeN4eHhZryDfIqefjlpMwFG/roBRv6yBggWagLF/DZdVxYNBgHKm5ubRQEK5HA6tFNLmtRPm3UmmzUnYWKrqxVTTy07AAAAAAEAGwAABMwGEAATAGG4ADIrugATAAAAN` +
-	`Su4AAAQuAAE0LgAExC4AAbQALgAAC+4AA8vuAAFL7gACS+6AAQAAQA1K7gABBC4AAfQuAAEELgAC9C4AAEQuAAN0LoADgAAAAUREjm4AAEQuAAR0DAxMxEjNTMRMxEzATMBIRUhASMBIxGdgoLKSAH` +
-	`51v4IAcn+QwI6/f3GLgLbmwKa/WYCmv1mm/0lAtv9JQAAAAABADAAAASdBhAAFwBDuAAyK7oABwAIADUruAAHELgAAtC4AAgQuAAM0LgACBC4ABDQuAAHELgAFtAAuAAHL7oAEwASADUruAASELgAF` +
-	`dAwMQEVBRUlFQURIxEFNSU1BTUlESE1IRUhEQQh/qoBVv6qyv6qAVb+qgFW/i8Ebf4uBEObdMJ0m3T+KQGTdJt0wnSbdAHaq6v+agAAAAACACH/OwStBagAIQAqAO+4ADIruAArL7gAA9C4AAMvuAA` +
-	`p3LgABdC4AAMQuAAg3EEDAB8AIAABXUEDAMAAIAABXUEDAHAAIAABXboABgADACAREjm4AB/cuAAM0LgAIBC4ABbcQQMAHwAWAAFdQQMAwAAWAAFdQQMAcAAWAAFdugANACAAFhESObgAFdy6ABAAF` +
-	`gAVERI5uAAgELgAItC4ABUQuAAs3AC4AA4vuAAAL7oACQAlADUrugACAAAADhESOboABgAAAA4REjm6AA0AAAAOERI5ugAQACUACRESObgAJRC4ABvQugAhAAAADhESOboAKQAAAA4REjkwMRcjNyM` +
-	`RMxE+ATMyFh0BATMDFhcWFREjETQuASMiBwMRIxkBNCYjIgYHEQHYt3ZQo02FRFFjAUixtTMnM6MMJBUKCtWcOhczdTUBLsXFBF3/AKN8f3UDAiP+0AsuPbb8tAL7XkUkA/6a/acBUwGnlDSyi/4FA` +
-	`fcAAAAABQAVAAAEtwYQABsAHwAjACYAKgFruAAyK7gAKy+4ACwvuAAH3LgAAtC4AAcQuAAI3LgAKxC4AAzQuAAML7gAC9y4AAwQuAAQ0LgADBC4ABTQuAALELgAFtC4ABYvuAAIELgAGNC4AAcQuAA` +
-	`a0LgACxC4ABzQugAdAAwABxESObgACBC4ACHQugAjAAwABxESObgACxC4ACTQuAAkL7gACBC4ACfQugAoAAcACBESObgACBC4ACnQugAqAAwABxESOQC4AAcvuAALL7gAFS+4ABkvugAEAAUANSu6A` +
-	`AAAAQA1K7gABRC4AAnQuAAFELgADdC4AAQQuAAP0LgAARC4ABHQuAAAELgAE9C4AAAQuAAX0LgABBC4ABzQugAdAAcAFRESObgAARC4AB7QuAAEELgAINC4AAEQuAAi0LoAIwAHABUREjm6ACQABwA` +
-	`VERI5uAAAELgAJdC6ACYABwAVERI5ugAoAAcAFRESObgABRC4ACnQugAqAAcAFRESOTAxARUjFTMVIxEjASERIxEjNTM1IzUzETMBIREzEQEzJyMFMzUjARczATMnIwS3aGhouv7w/rO6aWlpabYBG` +
-	`gFHuvzp/GaWAc+O9f6UBEQCGQUFOwP9m8Kb/fsCBf37AgWbwpsCE/3tAhP97f6jwsLCATme/XCYAAAABwAVAAAEtwYQAB8AIwAnACsAMQA1ADkAybgAMisAuAAHL7gACy+4ABUvuAAZL7gAHS+6AAQ` +
-	`ABQA1K7oAAAABADUruAAFELgACdC4AAUQuAAN0LgABBC4AA/QuAABELgAEdC4AAAQuAAT0LgAABC4ABfQuAAAELgAG9C4AAQQuAAg0LgAARC4ACLQuAAEELgAJNC4AAEQuAAm0LgABBC4ACjQuAABE` +
-	`LgAKtC6AC0ABwAVERI5uAAAELgAL9C6ADMABwAVERI5uAAFELgANNC6ADcABwAVERI5uAAFELgAONAwMQEVIwczFSMDIwMjAyMDIzUzJyM1MwMzEzMTMxMzEzMDATMnIwUzNyMFMzcjASMHFTM1ATM` +
-	`3IwUzNyMEt1YXbYBCv2jkXb1Ae2kWU0E6uDilV7hfuDyePf2RqyVlATtMF4j990IiegE8BRMtAR8FCh/9zwUMGgQbm8Kb/d0CI/3dAiObwpsB9f4LAfX+CwH1/gv+o8LCwsLCATqeAQH9h4BhYQAAA` +
-	`AACAHb/CQRABwYAJgAvARm4ADIrugApAAYANSu6ACYAAAA1K7oAIAAfADUruAAAELgAC9C4ACYQuAAN0LgAIBC4ABLQuAASL7gAIRC4ABPQuAATL7gAJhC4ABfQuAAgELgAHNxBGwAGACkAFgApACY` +
-	`AKQA2ACkARgApAFYAKQBmACkAdgApAIYAKQCWACkApgApALYAKQDGACkADV1BBQDVACkA5QApAAJduAAAELgALdC6AC4ABgAgERI5uAAgELgAMdwAuAAML7gAAC+6ABoAIwA1K7oAEAAVADUrugAfA` +
-	`B4ANSu4ABAQuAAO0LgADi+6ABMAAAAMERI5uAAaELgAHNC4ABwvuAAjELgAJdC4ACUvugAtAAAADBESOboALgAAAAwREjkwMQU1JicmAjU0Ejc2NzUzFTYzMhcVJiMiBxEWMzI3ESM1IREGIyInFQE` +
-	`GFRQXFhcRBgIVMCyXrKiZLTGjJyiKrqGfJSIzOiAxmwFlpIkvLP7LPHMmNVr3+BQcYAGD9PEBgGMdFfjMBUG4XQj63AsFAfSb/QEwBs4FV568/M9FLgR8UQAAAAMAGwAABLMGEAAXABsAIAB5uAAyK` +
-	`wC4AAcvugAOABEANSu6AAYAAwA1K7gADhC4AAHQuAAA3LgABhC4AAnQuAADELgAC9C4AAAQuAAP0LgAABC4ABPQuAARELgAFdC6ABcAEQAOERI5uAAOELgAGNC4AAEQuAAZ0LgAAxC4ABrQuAAGELg` +
-	`AHtAwMRM1MzcjNSETMxMhFSMXMxUjEyMDIQMjEzchJyETBwMzAxueM9EBAePN5AED0zOgcGrVav3eacJp8gHFNf6jqydU/FUBVZulmwLg/SCbpZv+qwFV/qsBVZulAkSc/vMBDQAAAQBH/9EEhQY+A` +
-	`DcBL7gAMiu4ADgvuAA5L7gANdy4ACrcQQUA2gAqAOoAKgACXUEbAAkAKgAZACoAKQAqADkAKgBJACoAWQAqAGkAKgB5ACoAiQAqAJkAKgCpACoAuQAqAMkAKgANXbgAAtC4AAIvuAA4ELgAGtC4ABo` +
-	`vugAIABoANRESObgADdxBGwAGAA0AFgANACYADQA2AA0ARgANAFYADQBmAA0AdgANAIYADQCWAA0ApgANALYADQDGAA0ADV1BBQDVAA0A5QANAAJduAAaELgAHNC4ABwvugAjABoANRESOboALwAaA` +
-	`A0REjm6ADcANQAqERI5ALoAEQAXADUrugAyAC0ANSu6AAkACgA1K7oAAAABADUruAAKELgAHNC4AAkQuAAe0LgAARC4ACPQuAAAELgAJdAwMQEVIQYPAgYHIRUhBhUUHgEzMjcVDgEjIAA1NDcjNTM` +
-	`2PwIhNSE2NzY1NCYjIgc1NjMgFhUUBwSF/vgkKmdmFRMCS/0GJU2WUsTwiKdc/t/++gthrz1pUgj+UQKqKCA9n4Giq5umAQLzNAPfmxsZOzsMDJs9Q0V2P3K+MR8BArQyLptNQDAFmx0hP19giEKtM` +
-	`O+vaFkAAAAAAgB2/x0EQAboABoAJQDJuAAyK7oAHgAGADUrugAaAAAANSu4AAAQuAAL0LgAARC4AAzQuAAaELgADdC4AAAQuAAX3LgAENC4ABoQuAAT0EEbAAYAHgAWAB4AJgAeADYAHgBGAB4AVgA` +
-	`eAGYAHgB2AB4AhgAeAJYAHgCmAB4AtgAeAMYAHgANXUEFANUAHgDlAB4AAl24AAAQuAAj0LgAARC4ACTQALgADC+4AAAvugARAAAADBESOboAFgAAAAwREjm6ACMAAAAMERI5ugAkAAAADBESOTAxB` +
-	`TUmJyYCNTQSNzY3NTMVFhcVJicRNjcVBgcVAQYCFRQSFxYXEQYCq4FxmaqomXGDo26Eenh4eoRu/tZjen5iPkZI47sTR2IBgfPxAYBjSROwrQwxuEYR+tQSRrcxDLgGNFL+w7q9/sBQMhMFIhMAAAH` +
-	`8Jf4u/w4BBgAPABu4ADIrugABAA4ANSsAuAAAL7oACgAFADUrMDEDFRAOASMiJzUWMzI+AT0B8oa+pXeJnGtdgkkBBn/+5N1gHK4uQZq/ogAAAAH8Jf4u/w4BBgAPABu4ADIrugABAA4ANSsAuAAAL` +
-	`7oABQAKADUrMDEBFRQeATMyNxUGIyIuARE1/N9Jgl1rnIl3pb6GAQaiv5pBLq4cYN0BHH8AAAH+tP4uBDgGEAAWAB+4ADIrALoACwAGADUrugARABIANSu6ABQAAAA1KzAxAQMGBw4BIyInNRYzMj4` +
-	`BNREhFSERIRUBrQwWMUO+pXeJnGtdgkkDVf11Ak0Cyv02sVJvYByuLkGavwWsnP3xmwAAAAAB/k/+LgRPBhAAHwB3uAAyK7gAIC+4ACEvuAAgELgAE9C4ABMvuAAC3LgAANC4AAIQuAAE0LgABC+4A` +
-	`AIQuAAV0LgAFS+4ACEQuAAc3LgAHdy4ABfQugAYABwAHRESObgAHRC4ABnQugAfABMAAhESOQC4ABQvuAAaL7oADgAJADUrMDEBER0BIwYHDgEjIic1FjMyNjc2NxEzARczJxEzESMBJwE4BQ0xQ76` +
-	`ld4mca12CJCIDtgIlPAUFurr96ksD+vyNHGuxUm9gHK4uQU1HqQXI+/eamgQJ+fAD+KMAAAL/0//wBFsGTgAZACUA07gAMiu4ACYvuAAnL7gAJhC4AALQuAACL7gABdy4AAjQuAAnELgAD9y6AAkAA` +
-	`gAPERI5uAAFELgAF9C4AAIQuAAY0LgABRC4ABrQuAAPELgAINxBBQDaACAA6gAgAAJdQRsACQAgABkAIAApACAAOQAgAEkAIABZACAAaQAgAHkAIACJACAAmQAgAKkAIAC5ACAAyQAgAA1dALgAAy+` +
-	`6AB0AEwA1K7oAAQAAADUrugAMACMANSu4AAEQuAAF0LgAABC4AAfQuAATELgAFdC4ABUvMDEDNTM1MxUzFSMRPgEzMhYVFAIGIyInJicjERMRFjMyEjU0JiMiBi26urq6Vu5kjOCV+Lc0bBAgurplV` +
-	`annflBX0QTZm9ram/6JjI7y9sL+z7ELAgME2f2s/iEaAQnmp6iwAAMAtP/kBBkDvwALABUAHgDbuAAyK7gAHy+4ACAvuAAD3LgAHxC4AAnQuAAJL7oADAAJAAMREjm4ABPcQRsABgATABYAEwAmABM` +
-	`ANgATAEYAEwBWABMAZgATAHYAEwCGABMAlgATAKYAEwC2ABMAxgATAA1dQQUA1QATAOUAEwACXboAFgAJAAMREjm4AAMQuAAd3EEFANoAHQDqAB0AAl1BGwAJAB0AGQAdACkAHQA5AB0ASQAdAFkAH` +
-	`QBpAB0AeQAdAIkAHQCZAB0AqQAdALkAHQDJAB0ADV0AugAaAAYANSu6AAAAEAA1KzAxATISFRQCIyICNTQSAwEuASMiBhUUFgkBHgEzMjY1NAJm1N/f1NPf3x8BrShgM4WMBwH7/lYiWjeYgAO//vX` +
-	`i4/71AQvj4gEL/UsB+Tgn36snWgE9/gQrLP+bYAABAO4AAAPeA6MADQA9uAAyK7oACAAFADUruAAIELgAANy6AAEABQAIERI5uAAFELgADNAAuAAHL7oACgALADUruAAKELgAANAwMSURDgEHNTY3M` +
-	`xEhFSE1Ah5Dfm+wgJUBK/0QXQLPKDkdZTdZ/LpdXQAAAAEA7wAAA94DvwAjAG24ADIrugAaAAsANStBBQDaAAsA6gALAAJdQRsACQALABkACwApAAsAOQALAEkACwBZAAsAaQALAHkACwCJAAsAmQA` +
-	`LAKkACwC5AAsAyQALAA1duAAaELgAJdwAugAjAAAANSu6ABYADgA1KzAxKQE1ND4BPwE+AjU0JiMiDgEHNT4BMzIeARUUBg8CDgEHIQPe/RErekRVGa03gFAtcVVfZcpCYaJPZY1bTUlaBAJUZydbc` +
-	`TRBE5BNJTxCEBUgYyQbMGM5P4RnRDk3Z0cAAAABAOn/5APkA78AJgDTuAAyK7oAGgAPADUrQQUA2gAPAOoADwACXUEbAAkADwAZAA8AKQAPADkADwBJAA8AWQAPAGkADwB5AA8AiQAPAJkADwCpAA8` +
-	`AuQAPAMkADwANXboABgAPABoREjm4AAYvQQUA2gAGAOoABgACXUEbAAkABgAZAAYAKQAGADkABgBJAAYAWQAGAGkABgB5AAYAiQAGAJkABgCpAAYAuQAGAMkABgANXbgAIdy4ACjcALoAAwAkADUru` +
-	`gAXABIANSu6AAwACQA1K7oAHQAJAAwREjkwMTc1FjMyNjU0JisBNTMyNjU0JiMiBzU2MzIWFRQGBx4CFRQEIyIm6beHe6Gx1FNznItydYSYmH7jrFx6bG45/v7NO4wRdUVwWFlwXWlAOlA4aiuGVj9` +
-	`wLBhFXzuDqg0AAAIAoQAABCwDowAKAA0AP7gAMiu6AAoAAAA1K7gAChC4AAXQuAAAELgAC9AAuAAEL7gAAC+6AAsAAQA1K7gACxC4AAbQuAABELgACNAwMSERITUBMxEzFSMRAxEBArH98AIQlebml` +
-	`f6PAQReAkH9v17+/AFiAZj+aAAAAAABAOz/5APgA6MAGgCJuAAyK7gAGy+4ABwvuAAbELgAANC4AAAvuAAF3LgAHBC4AAncuAAX3EEFANoAFwDqABcAAl1BGwAJABcAGQAXACkAFwA5ABcASQAXAFk` +
-	`AFwBpABcAeQAXAIkAFwCZABcAqQAXALkAFwDJABcADV0AugATAA0ANSu6AAIAAwA1K7oABQAaADUrMDEBESEVIRUzIAQVFA4BIyInNR4BMzI+ATU0JiEBBAK4/d0aAQwBIXTVeHi7RKhHR4hLzf75A` +
-	`eQBv2vkqI5aj1EseRskNGM0V3gAAAIAwP/kBAwDvwAYACUAj7gAMiu6ABgADQA1K7oABwAdADUrQQUA2gAdAOoAHQACXUEbAAkAHQAZAB0AKQAdADkAHQBJAB0AWQAdAGkAHQB5AB0AiQAdAJkAHQC` +
-	`pAB0AuQAdAMkAHQANXboAEwAdAAcREjm4AA0QuAAj3LgABxC4ACfcALoAGQAKADUrugAQABUANSu6AAMAIAA1KzAxAT4BMzIeARUUBiMiAjU0ADMyFxUmIyIGFQEyPgE1NCYjIgYVFBYBWDmrZmGtX` +
-	`OO52dcBEep9d4V2prYBJDx5RnZtcJGNAbVAUUd+VIXEARHS6QEPHHEw5bD+dDVvQlVtf1pbdAAAAQDcAAAD8AOjABgAG7gAMiu6ABgAAAA1KwC4AAAvugAMAAkANSswMSE1ND4BPwI2NyE1IRUUBgc` +
-	`GDwEOAh0BAVg7lS2CLjUm/XwDFC93HRxTUE0nU2KFpCyALTZGcEotS3wdHldVZW1ZUwAAAwDf/+QD7QO/ABoAJwAzATm4ADIrugAqABkANSu6AAsAHgA1K0EFANoAHgDqAB4AAl1BGwAJAB4AGQAeA` +
-	`CkAHgA5AB4ASQAeAFkAHgBpAB4AeQAeAIkAHgCZAB4AqQAeALkAHgDJAB4ADV26ADAAHgALERI5uAAwL0EFANoAMADqADAAAl1BGwAJADAAGQAwACkAMAA5ADAASQAwAFkAMABpADAAeQAwAIkAMAC` +
-	`ZADAAqQAwALkAMADJADAADV24ABPcugAAABkAExESOUEbAAYAKgAWACoAJgAqADYAKgBGACoAVgAqAGYAKgB2ACoAhgAqAJYAKgCmACoAtgAqAMYAKgANXUEFANUAKgDlACoAAl26AAQAGQAqERI5u` +
-	`AAEL7oADwAZABMREjm4ACTcuAATELgANdwAugAtABYANSu6AAcAIQA1KzAxAScuATU0NjMyHgEVFA4BBxceARUUBiMiJjU0JT4BNTQmIyIGFRQWFwcGFRQWMzI2NTQmJwHkGzltxJZck1AlSGxDdVL` +
-	`Dw8TEAZ5xOWpTWl1OVgPTi19YiachAfYPHnVGXYQyYTonSklCKEhoQWWUimeZwUBXJS1KUSsqSi6Jemo/V1M+PXwUAAAAAgDA/+QEDAO/ABgAJQDfuAAyK7oAHQAHADUrugANACMANStBBQDaACMA6` +
-	`gAjAAJdQRsACQAjABkAIwApACMAOQAjAEkAIwBZACMAaQAjAHkAIwCJACMAmQAjAKkAIwC5ACMAyQAjAA1dugAAACMADRESOUEbAAYAHQAWAB0AJgAdADYAHQBGAB0AVgAdAGYAHQB2AB0AhgAdAJY` +
-	`AHQCmAB0AtgAdAMYAHQANXUEFANUAHQDlAB0AAl26ABMABwAdERI5uAANELgAGNy4AA0QuAAn3AC6ABUAEAA1K7oACgAZADUrugAgAAMANSswMQEOASMiLgE1NDYzMhIVFAAjIic1FjMyNjUBIg4BF` +
-	`RQWMzI2NTQmA3Q6qmdgrlviudrX/u7pfnaEdqa3/tw8ekV1bXGRjgHuQFFGgFSExP7v0+n+8hxwL+avAYw2bkJVbX9aW3QAAAEAtAAABBkCjAALAD+4ADIrugADAAQANSu4AAQQuAAI0LgAAxC4AAr` +
-	`QALgACS+4AAMvugAAAAEANSu4AAEQuAAF0LgAABC4AAfQMDEBFSERIxEhNSERMxEEGf6Mff6MAXR9AXVe/ukBF14BF/7pAAABATABSwOcAagAAwAPuAAyKwC6AAEAAAA1KzAxATUhFQEwAmwBS11dA` +
-	`AAAAAIAtACsBBkB2wADAAcAF7gAMisAugAEAAUANSu6AAAAAQA1KzAxARUhNQUVITUEGfybA2X8mwHbXV3SXV0AAAABAQT/IQPIA9sAEQBluAAyK7oADQAEADUrQRsABgANABYADQAmAA0ANgANAEY` +
-	`ADQBWAA0AZgANAHYADQCGAA0AlgANAKYADQC2AA0AxgANAA1dQQUA1QANAOUADQACXQC6ABEAAAA1K7oACAAJADUrMDEFJiQCNTQSJDcVDgIVFB4BFwPI3v7QtrYBMN6o8IqK8KjfCqcBHY+OAR2nC` +
-	`14LkuGBgeKRDAABAQX/IQPIA9sAEQBtuAAyK7oABAANADUrQQUA2gANAOoADQACXUEbAAkADQAZAA0AKQANADkADQBJAA0AWQANAGkADQB5AA0AiQANAJkADQCpAA0AuQANAMkADQANXbgABBC4ABP` +
-	`cALoACQAIADUrugAAABEANSswMQEWBBIVFAIEBzU+AjU0LgEnAQXdATC2tv7Q3afxiorxpwPbC6f+446P/uOnCl0MkeKBgeGSCwAAAAADALQDwgQZB50ACwAVAB4A27gAMiu4AB8vuAAgL7gAA9y4A` +
-	`B8QuAAJ0LgACS+6AAwACQADERI5uAAT3EEbAAYAEwAWABMAJgATADYAEwBGABMAVgATAGYAEwB2ABMAhgATAJYAEwCmABMAtgATAMYAEwANXUEFANUAEwDlABMAAl26ABYACQADERI5uAADELgAHdx` +
-	`BBQDaAB0A6gAdAAJdQRsACQAdABkAHQApAB0AOQAdAEkAHQBZAB0AaQAdAHkAHQCJAB0AmQAdAKkAHQC5AB0AyQAdAA1dALoAGgAGADUrugAAABAANSswMQEyEhUUAiMiAjU0EgMBLgEjIgYVFBYJA` +
-	`R4BMzI2NTQCZtTf39TT398fAa0oYDOFjAcB+/5WIlo3mIAHnf714uP+9QEL4+IBC/1LAfk4J9+rJ1oBPf4EKyz/m2AAAgChA94ELAeBAAoADQA/uAAyK7oACgAAADUruAAKELgABdC4AAAQuAAL0AC` +
-	`4AAQvuAAAL7oACwABADUruAALELgABtC4AAEQuAAI0DAxAREhNQEzETMVIxEDEQECsf3wAhCV5uaV/o8D3gEEXgJB/b9e/vwBYgGY/mgAAAEA7APCA+AHgQAaAIm4ADIruAAbL7gAHC+4ABsQuAAA0` +
-	`LgAAC+4AAXcuAAcELgACdy4ABfcQQUA2gAXAOoAFwACXUEbAAkAFwAZABcAKQAXADkAFwBJABcAWQAXAGkAFwB5ABcAiQAXAJkAFwCpABcAuQAXAMkAFwANXQC6ABMADQA1K7oAAgADADUrugAFABo` +
-	`ANSswMQERIRUhFTMgBBUUDgEjIic1HgEzMj4BNTQmIQEEArj93RoBDAEhdNV4eLtEqEdHiEvN/vkFwgG/a+SojlqPUSx5GyQ0YzRXeAAAAgDAA8IEDAedABgAJQCPuAAyK7oAGAANADUrugAHAB0AN` +
-	`StBBQDaAB0A6gAdAAJdQRsACQAdABkAHQApAB0AOQAdAEkAHQBZAB0AaQAdAHkAHQCJAB0AmQAdAKkAHQC5AB0AyQAdAA1dugATAB0ABxESObgADRC4ACPcuAAHELgAJ9wAugAZAAoANSu6ABAAFQA` +
-	`1K7oAAwAgADUrMDEBPgEzMh4BFRQGIyICNTQAMzIXFSYjIgYVATI+ATU0JiMiBhUUFgFYOatmYa1c47nZ1wER6n13hXamtgEkPHlGdm1wkY0Fk0BRR35UhcQBEdLpAQ8ccTDlsP50NW9CVW1/Wlt0A` +
-	`AABANwD3gPwB4EAGAAbuAAyK7oAGAAAADUrALgAAC+6AAwACQA1KzAxATU0PgE/AjY3ITUhFRQGBwYPAQ4CHQEBWDuVLYIuNSb9fAMUL3cdHFNQTScD3lNihaQsgC02RnBKLUt8HR5XVWVtWVMAAAA` +
-	`AAwDfA8ID7QedABoAJwAzATm4ADIrugAqABkANSu6AAsAHgA1K0EFANoAHgDqAB4AAl1BGwAJAB4AGQAeACkAHgA5AB4ASQAeAFkAHgBpAB4AeQAeAIkAHgCZAB4AqQAeALkAHgDJAB4ADV26ADAAH` +
-	`gALERI5uAAwL0EFANoAMADqADAAAl1BGwAJADAAGQAwACkAMAA5ADAASQAwAFkAMABpADAAeQAwAIkAMACZADAAqQAwALkAMADJADAADV24ABPcugAAABkAExESOUEbAAYAKgAWACoAJgAqADYAKgB` +
-	`GACoAVgAqAGYAKgB2ACoAhgAqAJYAKgCmACoAtgAqAMYAKgANXUEFANUAKgDlACoAAl26AAQAGQAqERI5uAAEL7oADwAZABMREjm4ACTcuAATELgANdwAugAtABYANSu6AAcAIQA1KzAxAScuATU0N` +
-	`jMyHgEVFA4BBxceARUUBiMiJjU0JT4BNTQmIyIGFRQWFwcGFRQWMzI2NTQmJwHkGzltxJZck1AlSGxDdVLDw8TEAZ5xOWpTWl1OVgPTi19YiachBdQPHnVGXYQyYTonSklCKEhoQWWUimeZwUBXJS1` +
-	`KUSsqSi6Jemo/V1M+PXwUAAAAAgDAA8IEDAedABgAJQDfuAAyK7oAHQAHADUrugANACMANStBBQDaACMA6gAjAAJdQRsACQAjABkAIwApACMAOQAjAEkAIwBZACMAaQAjAHkAIwCJACMAmQAjAKkAI` +
-	`wC5ACMAyQAjAA1dugAAACMADRESOUEbAAYAHQAWAB0AJgAdADYAHQBGAB0AVgAdAGYAHQB2AB0AhgAdAJYAHQCmAB0AtgAdAMYAHQANXUEFANUAHQDlAB0AAl26ABMABwAdERI5uAANELgAGNy4AA0` +
-	`QuAAn3AC6ABUAEAA1K7oACgAZADUrugAgAAMANSswMQEOASMiLgE1NDYzMhIVFAAjIic1FjMyNjUBIg4BFRQWMzI2NTQmA3Q6qmdgrlviudrX/u7pfnaEdqa3/tw8ekV1bXGRjgXMQFFGgFSExP7v0` +
-	`+n+8hxwL+avAYw2bkJVbX9aW3QAAAEAtAPeBBkGagALAD+4ADIrugADAAQANSu4AAQQuAAI0LgAAxC4AArQALgACS+4AAMvugAAAAEANSu4AAEQuAAF0LgAABC4AAfQMDEBFSERIxEhNSERMxEEGf6` +
-	`Mff6MAXR9BVNe/ukBF14BF/7pAAABATAFKQOcBYYAAwAPuAAyKwC6AAEAAAA1KzAxATUhFQEwAmwFKV1dAAAAAAIAtASKBBkFuQADAAcAF7gAMisAugAEAAUANSu6AAAAAQA1KzAxARUhNQUVITUEG` +
-	`fybA2X8mwW5XV3SXV0AAAABAQQC/wPIB7kAEQBluAAyK7oADQAEADUrQRsABgANABYADQAmAA0ANgANAEYADQBWAA0AZgANAHYADQCGAA0AlgANAKYADQC2AA0AxgANAA1dQQUA1QANAOUADQACXQC` +
-	`6ABEAAAA1K7oACAAJADUrMDEBJiQCNTQSJDcVDgIVFB4BFwPI3v7QtrYBMN6o8IqK8KgC/wqnAR2PjgEdpwteC5LhgYHikQwAAAAAAQEFAv8DyAe5ABEAbbgAMiu6AAQADQA1K0EFANoADQDqAA0AA` +
-	`l1BGwAJAA0AGQANACkADQA5AA0ASQANAFkADQBpAA0AeQANAIkADQCZAA0AqQANALkADQDJAA0ADV24AAQQuAAT3AC6AAkACAA1K7oAAAARADUrMDEBFgQSFRQCBAc1PgI1NC4BJwEF3QEwtrb+0N2` +
-	`n8YqK8acHuQun/uOOj/7jpwpdDJHigYHhkgsAAAAAAQDyA94D2waPABUASbgAMiu4ABYvuAAXL7gAFhC4AADQuAAAL7gAFdy4AALQuAAXELgAC9y6AAMAAAALERI5uAAM3AC4AAAvuAALL7oABgARA` +
-	`DUrMDETETMVPgEzMh4BFREjETQuASMiBgcR8pVAtVpPgjSVGUInT7Q6A94CnpJNWD9mlv6KAYRhPidvVP55AAAAAAIBIwPeA6kHuQAHABMAI7gAMiu6AAUAAAA1KwC4AAsvugAFAAAANSu6AAQAAQA` +
-	`1KzAxAREjNSERMxUBNDYzMhYVFAYjIiYCG/gBjfn+WkQfH0VFHx9EA94CQV39v10DkCYlJSYlJSUAAf5s/i4EPgR8ACIAQ7gAMiu4ACMvuAAkL7gAIxC4AA/QuAAPL7gAAdy4ABHQuAARL7gAJBC4A` +
-	`BrcuAAb3AC6AAsABgA1K7oAFQAgADUrMDEBAwYHDgEjIic1FjMyPgE1ETMVPgEzMh4BFREjETQuASMiBgFVBQ0xQ76ld4mca12CSbpQ4nFjokG6H1MxYuECi/11sVJvYByuLkGavwP59IGSaar5/ZA` +
-	`Ch6FnQroAAAABAJv+LgZtBHwAJQBVuAAyK7gAJi+4ACcvuAAB3LgAEdy4AA/QuAAPL7gAERC4ABPQuAAmELgAHNC4ABwvuAAb3LgAHtC6AB8AHAABERI5ALoABQAKADUrugAiABcANSswMQERFB4BM` +
-	`zI3FQYjIiYnJicjPQERNC4BIyIGBxEjETMVPgEzMh4BBD5Jgl1rnIl3pb5DMg0EH1MxYuFJurpQ4nFjokECcP30v5pBLq4cYG9SsWscAgChZ0K6jP11BF30gZJpqgAAAAEAef4uBLAGTgAVADO4ADI` +
-	`rugAHAAEANSu4AAEQuAAD0LgAARC4ABXQuAAVLwC6AAsAEAA1K7oABgADADUrMDEhPQERITUhERQeATMyNxUGIyImJyYnAcf+sgIISYJda5yJd6W+QzINaxwFK5z6Fr+aQS6uHGBvUrEAAAABAPr+L` +
-	`gZvBF0AHwBDuAAyK7gAIC+4ACEvuAAB3LgAENy4ACAQuAAW0LgAFi+4ABncuAAQELgAHtAAuAAAL7oABQAKADUrugAbABQANSswMQERFB4BMzI3FQYjIiYnJicjNQ4BIyInETMVFjMyNjcRBEBJgl1` +
-	`rnIl3pb5DNgsCV+GKZWWzLxtoyF8EXfwHv5pBLq4cYG9ZydWKiR8Bk/8IpKQCiQAAAAABAPr+LgRABHwAHwBluAAyK7gAIC+4ACEvuAAgELgAANC4AAAvuAAR3LgAAtC4ACEQuAAJ3LoAAwAAAAkRE` +
-	`jm4AArcugAXAAkAChESOQC6ABUAGgA1K7oABgANADUruAAGELgACty6AAMABgAKERI5MDEzETMVPgEzMhcRIzUmIyIGBxEUHgEzMjcVBiMiJicmJ/q6V+GKZWWzLxtoyF9Jgl1rnIl3pb5DMg0EXfS` +
-	`KiR/+bf8IpKT927+aQS6uHGBvUrEAAQBH/i4EhQR8ADUAw7gAMiu4ADYvuAAA0LgAAC+4ADXcuAAC0LgAABC4AC3cQQMAHwAtAAFdQQMAwAAtAAFdQQMAcAAtAAFdugADAAAALRESObgALNy4AAnQu` +
-	`AAtELgAI9xBAwAfACMAAV1BAwDAACMAAV1BAwBwACMAAV26AAoALQAjERI5uAAO0LgADi+4ACMQuAAT3LgAEdC6ABwAAAAtERI5uAATELgAN9wAugAeABkANSu6AA4AKAA1K7gADhC4AAbQuAAoELg` +
-	`AMdAwMTMRMxE+ATMyFh0BPgIzMhYVERUHBgcOASMiJzUWMzI2NzY3ETQuASMiBgcRIxE0JiMiBgcRR6NNhURRYyFdZEFIZgUNMUO+pXeJnGtdgjAtAwwkFTR3Ppw6FzN1NQRd/wCjfH91PGZ/S3q2/` +
-	`Tsca7FSb2Acri5BTUmwAqpeRSSfuP2VAvqUNLKL/XsAAgBy/i8EQARtACIALgC7uAAyK7gALy+4ADAvuAAQ3LgAANy4AC8QuAAG0LgABi+4AAAQuAAO0LgABhC4ABjQuAAYL7gABhC4ACncQRsABgA` +
-	`pABYAKQAmACkANgApAEYAKQBWACkAZgApAHYAKQCGACkAlgApAKYAKQC2ACkAxgApAA1dQQUA1QApAOUAKQACXboAGQAGACkREjm4AAAQuAAj0AC6ABwAFgA1K7oACgAmADUrugAsAAMANSu4AAoQu` +
-	`AAM0LgADC8wMSUOASMiJjU0EjYzMhcWFzMREAYHBgQjIic1HgEzMjY3PgE1GQEmIyICFRQWMzI2A4VV72SM35T4tzVrECC7DxEo/uPKy9BP52xVmC8vImNVrOV9UFfS+4yO8vbCATGxCwID/Or+5n1` +
-	`AlaxovDZTNjMzh3ABewHfGv725aapsAAAAAABAKwAAAQgBF0ACwAvuAAyK7oACQACADUrALoAAQAAADUrugAFAAQANSu4AAQQuAAH0LgAARC4AAnQMDEzNSERITUhFSERIRWsAVX+qwN0/qsBVZsDJ` +
-	`pyc/NqbAAMAS//hBIEGEAAPABkAQwFHuAAyK7oAAQACADUrugAIABUANSu6ADYAKwA1K7oAPwAhADUrQRsABgAIABYACAAmAAgANgAIAEYACABWAAgAZgAIAHYACACGAAgAlgAIAKYACAC2AAgAxgA` +
-	`IAA1dQQUA1QAIAOUACAACXboADQACAD8REjm4AAEQuAAQ0LoAGwACAD8REjlBBQDaACEA6gAhAAJdQRsACQAhABkAIQApACEAOQAhAEkAIQBZACEAaQAhAHkAIQCJACEAmQAhAKkAIQC5ACEAyQAhA` +
-	`A1dQQUA2gArAOoAKwACXUEbAAkAKwAZACsAKQArADkAKwBJACsAWQArAGkAKwB5ACsAiQArAJkAKwCpACsAuQArAMkAKwANXbgAKxC4ADHcuAA/ELgARdwAugAeAEIANSu6AAQAGAA1K7oALgAzADU` +
-	`rugANAEIAHhESOTAxExEjETMyHgEVFA4BBxMjAyczPgI1NCYrAQE1HgEzMjY1NCcmLwImJyY1NDYzMhcVJiMiBhUUFxYfARYXFhUUBiMisGXPZmA0Iz064HjNZY0wLhhPaUsCCzh9K0c+Dw46IEBoG` +
-	`xxrd1pQXFE7Qx0dQD9XIyNwemsCk/1tBhBTqGFato9A/SsCk5w0bHo9bYH6x8VJO1tELSAgLxozUUVEYoqyNKlCUz48JCQvLj9KSm+RuwAAAQAgAAAErQY/ACUAr7gAMiu4ACYvuAAnL7gAJdy4AAL` +
-	`cuAAmELgACdC4AAkvugAQACUAAhESObgAFtxBGwAGABYAFgAWACYAFgA2ABYARgAWAFYAFgBmABYAdgAWAIYAFgCWABYApgAWALYAFgDGABYADV1BBQDVABYA5QAWAAJduAACELgAHNC4ACUQuAAg0` +
-	`AC6AAAAAQA1K7oADQASADUrugAaAAUANSu6ACIAIwA1K7oAHgAfADUrMDElFSERBiMiJgI1NBI2MzIXFSYjIg4BFRQeATMyNxEhFSERIRUhEQSt/a03MIHaeHfZg2J7cnFVjVdai1Q2NwJI/kgBjP5` +
-	`0eXkBuguKARGtqwERjC6CQnXhhIbjcQ8CIm/+im7+fgAAAAQAFQAABLcGEAAeACUALAAzAJu4ADIrugAOAA8ANSu4AA8QuAAT0LgADxC4ABfQuAAOELgAItC4AA4QuAAp0LgADhC4AC3QALgADi+6A` +
-	`BkAKAA1K7oAAAABADUrugAuAAwANSu6AAYABwA1K7gABxC4ABDQuAAGELgAEtC4AAEQuAAU0LgAABC4ABbQuAABELgAIdC4AAYQuAAj0LgAABC4ACrQuAAHELgAMtAwMQEVIxUUBzMVIwcGBCsBESM` +
-	`RIzUzNSM1MxEhMhYXFhcHNCchFSE2AyYhIxUhJgEzMjc2NyEEt0kLVI8ITv7p71LKm5ubmwGC7to6EAysAf3XAh0NWFf+/nkB9A/+G1e7Wzko/jIFAZsOOTWbDYGL/coDT5t8mwEPa2ceH68KCnwyA` +
-	`QpOcxT9vDAfLwAAAAADACH/bQTfBqIAIwApADEAtbgAMiu6AC0ADQA1K0EbAAYALQAWAC0AJgAtADYALQBGAC0AVgAtAGYALQB2AC0AhgAtAJYALQCmAC0AtgAtAMYALQANXUEFANUALQDlAC0AAl2` +
-	`6AAYADQAtERI5uAAtELgAL9C4AC8vALgAEy+4ABgvuAAAL7gABy+6ABwAIQA1K7oABgAAABMREjm6AB4AAAATERI5ugAnAAAAExESOboALwAAABMREjm6ADAAAAATERI5MDEFIzcmJyYnAyMTJicmN` +
-	`TQSNzY/ATMHFhc3MwEWMzI3FQYjIicTARYXASYFBgIVFBcBBgI2t0YGBkA0bbewAwNVqJlwgSixJ0tVNLH9uzE1nqKuilZPv/54Lj4BvFD+smN6BgEVIJO9BAQpOf7ZAdkHB8Hz8QGAY0gUamkJGoz` +
-	`52Q9dt0EWBbv72WQ+BKcdTFL+w7o8OQLoEgAAAQAw/i4E+gYQABQAJ7gAMiu6AAcAAAA1KwC6AAsAEAA1K7oAAwACADUruAACELgABdAwMSERITUhFSERFB4BMzI3FQYjIiYnJgIB/i8Ebf4uSYJda` +
-	`5yJd6W+QzIFZaur+v+/mkEurhxgb1IAAf1b/q391wAAAAMAF7gAMiu6AAEAAAA1KwC6AAEAAgA1KzAxITMRI/1bfHz+rQAAAfwbAP3/FwUSAAMAD7gAMisAuAACL7gAAC8wMSUjATP80rcCS7H9BBU` +
-	`AAAABADQAAASZBhAACAAvuAAyK7oAAQAHADUrugAEAAcAARESOQC4AAIvuAAFL7gAAC+6AAQAAgAAERI5MDEBEQEjCQEjARECywHO5f6n/qjPAc0GEP0y/L4CaP2YA0ICzgABAHb/0QRABj8AHACpu` +
-	`AAyK7oAGAAAADUrugAGABMANSu4AAAQuAAM0LgADC+4ABwQuAAN0LgADS9BBQDaABMA6gATAAJdQRsACQATABkAEwApABMAOQATAEkAEwBZABMAaQATAHkAEwCJABMAmQATAKkAEwC5ABMAyQATAA1` +
-	`duAAAELgAGty4AAYQuAAe3AC6AA8ACgA1K7oAAgAWADUrugAaABsANSu4ABYQuAAY0LgAGC8wMRM2MzIEEhUUAgQjIic1FjMyNhI1NAIjIgcRMxUhdqSJwgEvrKj+zreKrqGfeMd55vggMZv+mwYPM` +
-	`MD+ffTx/oDGQbhdpAE9vPwBngX+DJsAAAABANsAAAQhBhAABQAjuAAyK7oAAwAEADUruAADELgAB9wAuAADL7oAAQAAADUrMDETNSERIxHbA0bKBWWr+fAFZQAAAAMAAv/RBMwGPgAiADAAOQDVuAA` +
-	`yK7oALAAmADUrugAGADQANSu4ACwQuAAL3EEFANoAJgDqACYAAl1BGwAJACYAGQAmACkAJgA5ACYASQAmAFkAJgBpACYAeQAmAIkAJgCZACYAqQAmALkAJgDJACYADV24ACYQuAAS3EEFANoANADqA` +
-	`DQAAl1BGwAJADQAGQA0ACkANAA5ADQASQA0AFkANABpADQAeQA0AIkANACZADQAqQA0ALkANADJADQADV24AAYQuAA73AC6ACkADwA1K7oAAwA3ADUrugAhADcAAxESOTAxAT4BMzIEFRAFHgEVFA4` +
-	`BIyImNTQ2NwEOAh0BIzU0NjcDMwEOARUUFjMyNjU0JicmCQE2NTQmIyIGAV1jwXDWAQX+7lJTYqxoirpxsv6eDwkGuhxG7u4COWV2U1hMYBhOB/6MAZW+rnxChgWNaEnyvP755GezTV2wYJSKZcqHA` +
-	`bQyQKoUMS9C94sBJPwgR6lEQV1wUS1daQoC9v4OpL6CoC4AAQDbAAAEIQYQAAUAI7gAMiu6AAMAAAA1K7gAAxC4AAfcALgAAS+6AAAAAwA1KzAxJREzESE1A1fK/LqrBWX58KsAAAACADUC8ASXBic` +
-	`AJgA5AQ+4ADIrugAZAA4ANSu6ACEABwA1K7oAJwAoADUrugAuAC8ANSu4AA4QuAAA0LgAAC9BGwAGACEAFgAhACYAIQA2ACEARgAhAFYAIQBmACEAdgAhAIYAIQCWACEApgAhALYAIQDGACEADV1BB` +
-	`QDVACEA5QAhAAJdugAUAAcAIRESOUEbAAYAGQAWABkAJgAZADYAGQBGABkAVgAZAGYAGQB2ABkAhgAZAJYAGQCmABkAtgAZAMYAGQANXUEFANUAGQDlABkAAl26ACsAAAAuERI5uAAvELgAMdC4ADE` +
-	`vuAAnELgAONC4ADgvuAAuELgAO9wAuAARL7oAAwAkADUrugApABYANSu4ACkQuAAs0DAxEzUWMzI+ATU0Ji8CJjU0NjMyFxUmIyIGFRQWHwMWFRQGIyImJSMRMxsBMxEjETcjBwMjAycjFzV4YilLJ` +
-	`jVJMzOIeYFTTlZRQFA9MCcwKYCDkS5TAkFRZbOzY10DAxZ4ZXkUAgIDGV85HzsjKEcqHh1Rd1h3GFYhRDAwPxoYHRhOdlqCEAgDCP4BAf/8+AHvRET+rAFUREQAAAMAKgMIBKIGEAAHABMAGQCPuAA` +
-	`yK7oABwAAADUrugATAAoANSu6ABkAFgA1K7gAExC4AA7QuAAKELgAEdy4ABkQuAAb3AC6ABMACgA1K7oADAANADUrugAQABEANSu4AAoQuAAA0LgADRC4AAHQuAABL7gADBC4AAPQuAANELgABdC4A` +
-	`AUvuAATELgAFNC4AAoQuAAV0LgADBC4ABfQMDETESM1IRUjESUVIREhFSMRMxUjESEVIREzEcOZAXaaAgH+7AEPzbi4Am3+60MDCAKzVVX9TVZWAwhO/vlO/vFWAwj9TgAAAAAEADMDCASZBhAACQA` +
-	`RABYAIgBjuAAyK7oACQAAADUruAAJELgABNC4AAAQuAAH3AC4AAAvuAAKL7gADi+4ABcvuAAgL7oAAgADADUrugATABAANSu6AAYABwA1K7gAAhC4AAzQuAACELgAGtC4AAIQuAAd0DAxExEhFSMRM` +
-	`xUjESEjEzMTIycjNzMDJwcBIxMDMxsBMwMTIwMzARnWwsIBUkCeRJ9HI7QQlT0PDQExRZaHS2JkRoeXTXEDCAMITv75Tv6bAwj8+KtNASdOTv3hAZYBcv71AQv+lf5jATYAAAIAlQAABLQGEAAYACI` +
-	`Aw7gAMiu4ACMvuAAkL7gAIxC4AAvQuAALL7gAJBC4ABHcugAEAAsAERESOboABgALABEREjm4AAsQuAAK3LgAERC4AB7cQQUA2gAeAOoAHgACXUEbAAkAHgAZAB4AKQAeADkAHgBJAB4AWQAeAGkAH` +
-	`gB5AB4AiQAeAJkAHgCpAB4AuQAeAMkAHgANXbgAFtC4ABYvuAAKELgAGdAAuAACL7gABS+4AAovugANACEANSu6ABoACAA1K7oAGAAIABoREjkwMQEHEyMnByMTAyMRIxEhMh4BFRQOAQcXNzMlIT4` +
-	`CNTQmKwED3hLo8HBv19q/ysoBn8vAaUZ7dG1n0/zFARtfXTCf0ZcBkx3+irOzAWABM/1tBhBTqGFato9AsaliNGx6PW2BAAAAAAEAmwAABPEGTgAfADO4ADIrALgAAC+4ABUvugAFAAEANSu6AA4AG` +
-	`wA1K7gAARC4AATcuAAH0LgAARC4AAnQMDEzASM3MxMzAyEHIQM2JDMyFhcWBgcDIxM2LgEjIgQHA5sBBXEbcTe6NwEEG/78TGsBAnFfiBAOByiEuokiCUUxYv74Z4oE0HwBAv7+fP6ZgZJhRTV3uv2` +
-	`QAoehZ0K6jP11AAAAAQCbAAAE8QZOABcAJbgAMisAuAAAL7gADS+4AAEvugAGABMANSu6AAMAAAABERI5MDEzATMDNiQzMhYXFgYHAyMTNi4BIyIEBwObAVe6nmsBAnFfiBAOByiEuokiCUUxYv74Z` +
-	`4oGTv0bgZJhRTV3uv2QAoehZ0K6jP11AAMAz//RBB0GPgAVACAAMgC/uAAyK7oALAAGADUrugAMABsANSu6AAQABgAsERI5QQUA2gAbAOoAGwACXUEbAAkAGwAZABsAKQAbADkAGwBJABsAWQAbAGk` +
-	`AGwB5ABsAiQAbAJkAGwCpABsAuQAbAMkAGwANXbgALBC4AB/QuAAfL7gALBC4ACDQuAAgL7oAJAAGACwREjm4ACQvuAAu3LgADBC4ADTcALgACS+4ACEvugAEACEACRESOboABgAhAAkREjm6ACAAI` +
-	`QAJERI5MDEBNTQ3AwYHNTYzMhYVFA8DDgEdARM2PwE2NTQnJicLASImNTQ3Njc7ARYXFhUUBwYHAZELIU1fucrg65hCTkcnPD4SIkJUPDh3GFk6VSooNgcINigrKyk1AbojSzQDIxo1tFq3kpCbRFF` +
-	`JLHJmLgHyEytTaExGNjID/c78YVU7OyonAwMnKTw8KicDAAQAq//RBCIGEAADAA8AEwAfAOu4ADIruAAgL7gAIS+4ACAQuAAH0LgABy+4AAHQuAABL7gABxC4AA3cQRsABgANABYADQAmAA0ANgANA` +
-	`EYADQBWAA0AZgANAHYADQCGAA0AlgANAKYADQC2AA0AxgANAA1dQQUA1QANAOUADQACXbgAIRC4AB3cuAAX3EEFANoAFwDqABcAAl1BGwAJABcAGQAXACkAFwA5ABcASQAXAFkAFwBpABcAeQAXAIk` +
-	`AFwCZABcAqQAXALkAFwDJABcADV24ABHQuAARLwC4AAEvuAARL7oACgAEADUruAAEELgAFNC4AAoQuAAa0DAxEwMzCwEiJjU0NjMyFhUUBgEDMwsBIiY1NDYzMhYVFAblLvgvRTtVVTs6VVYByS74L` +
-	`0U7VVU7OlVWAboEVvuq/hdVOztUUzw8VAHpBFb7qv4XVTs7VFM8PFQAAQA+AAAEjwRRABcAgbgAMiu6AAEAAwA1K7gAAxC4AA3QuAANL7gAARC4AA/QuAAPLwC4AA4vuAACL7oACgAHADUrugABAAI` +
-	`ADhESOboABAACAA4REjm6AA0AAgAOERI5ugAQAAIADhESObgAChC4ABPQuAATL7gABxC4ABXQuAAVL7oAFgACAA4REjkwMSUBEycTAScBBScFATcBExcTARcBIRcFAQO9/uYBfwP+5VoBH/5tAQGT/` +
-	`uJaARoBfQEBF1v+5gGOA/5tARx1AR7+bQEBkv7iWgEbA4AEASFW/uYBkQP+dAEYVv7jfAT+6AAAAgCqAPMETQRYAAkADQAfuAAyK7oADAAAADUrALoADAAAADUrugACAAoANSswMTcRITIeARUUBgc` +
-	`DIxEzqgF29Mlw5uucm5vzA2VuznCk/RgCyf3TAAAAAAIAqgDzBE0EWAAJAA0AJ7gAMiu6AAkACwA1K7gACRC4AA/cALoACgAAADUrugAIAAwANSswMSUuATU0PgEzIRElMxEjAnvr5nDJ9AF2/sqbm` +
-	`/MY/aRwzm78m5wCLQAAAAMAAv/RBMwGPgALAA8AGwC/uAAyK7gAHC+4AB0vuAAcELgAA9C4AAMvuAAJ3EEbAAYACQAWAAkAJgAJADYACQBGAAkAVgAJAGYACQB2AAkAhgAJAJYACQCmAAkAtgAJAMY` +
-	`ACQANXUEFANUACQDlAAkAAl24AB0QuAAZ3LgAE9xBBQDaABMA6gATAAJdQRsACQATABkAEwApABMAOQATAEkAEwBZABMAaQATAHkAEwCJABMAmQATAKkAEwC5ABMAyQATAA1dALgADi+4AAwvMDETI` +
-	`gYVFBYzMjY1NCYDIwEzAyIGFRQWMzI2NTQm1lFKSlFQS0uApAQlpdVPTFw+QlpLBg+bbG2bm21sm/nCBm370ph2g3+KgmaeAAAAAQFj/owEigZtAAsAL7gAMiu6AAkAAAA1K7gACRC4AATQALoACQA` +
-	`AADUrugACAAMANSu6AAYABwA1KzAxAREhFSERIRUhESEVAWMDJ/2TAm39kwJt/owH4Zz8+Zv8+JsAAQFj/owEigZtAAsAN7gAMiu6AAsAAgA1K7gAAhC4AAbQuAALELgADdwAugABAAAANSu6AAoAB` +
-	`wA1K7oABgADADUrMDEBNSERITUhESE1IREBYwJt/ZMCbf2TAyf+jJsDCJsDB5z4HwABAJUAAARABj4AKwCFuAAyK7oAFgAHADUrQQUA2gAHAOoABwACXUEbAAkABwAZAAcAKQAHADkABwBJAAcAWQA` +
-	`HAGkABwB5AAcAiQAHAJkABwCpAAcAuQAHAMkABwANXbgAFhC4AC3cALoAIwAkADUrugASAAoANSu6AAEAAAA1K7gAARC4ABrQuAAAELgAHNAwMRM1ITY3PgE1NCYjIg4BBzU+ATMyHgEVFAcGBzMVI` +
-	`Q8BDgEHIRUhNTQ2NzY34wFfJkZsRaBkOY1qd379UnnLY0A3i8X+nwNgW3AGAur8VTZMRk8Ci5spTXiAPmRtGiM2pTwtT6ZeaW5fj5sDXlysd6urQpdeV1IAAAAAAQBHAAAEhQYQACUAqbgAMiu4ACY` +
-	`vuAAR0LgAES+4AArcQQMAHwAKAAFdQQMAwAAKAAFdQQMAcAAKAAFduAAC3EEDAB8AAgABXUEDAMAAAgABXUEDAHAAAgABXbgAAdy4ABEQuAAU3LgADtC4AA4vuAAKELgAG9C4AAoQuAAe3LgAAhC4A` +
-	`CTQuAABELgAJ9wAuAAAL7gAEi+4ABwvugAhAAYANSu4AAYQuAAO0LgAIRC4ABjQMDEBESMRDgEjIiY9AQ4CIyImNREzERQeATMyNjcRMxEUFjMyNjcRBIWjTYVEUWMhXWRBSGajDCQVNHc+nDoXM3U` +
-	`1BhD6DwEAo3x/dTxmf0t6tgTg+3FeRSSfuAP/+3KUNLKLBBkAAAAB/IoD7/6pBg4ABQAbuAAyK7oAAQACADUrALgAAS+6AAAAAwA1KzAxAREjESE1/qlO/i8GDv3hAdFOAAAB/Cb+Rf8NAAAABwBHu` +
-	`AAyK7gACC+4AAkvuAAIELgAANC4AAAvuAAJELgAAty4AAPcuAAAELgABdwAugABAAIANSu4AAEQuAAE3LgAAhC4AAbQMDEpAREjESERI/wmAudO/bVO/kUBbf6TAAAB/Cb+Rf8NAAAABwBDuAAyK7g` +
-	`ACC+4AAkvuAAIELgAANC4AAAvuAAB3LgACRC4AAbcuAAD3AC6AAUABgA1K7gABRC4AADQuAAGELgAA9wwMSEzESERMxEh/CZOAktO/Rn+kwFt/kUAAAH8JgRT/w0GDgAHAEe4ADIruAAIL7gACS+4A` +
-	`AgQuAAA0LgAAC+4AAkQuAAC3LgAA9y4AAAQuAAF3AC6AAEAAgA1K7gAARC4AATcuAACELgABtAwMQEhESMRIREj/CYC5079tU4GDv5FAW3+kwAAAP///IT9EP6z/YwABwDa+zX4CAAAAAL8cgUI/sA` +
-	`HdwALABsAcbgAMiu6AAkAAwA1K0EbAAYACQAWAAkAJgAJADYACQBGAAkAVgAJAGYACQB2AAkAhgAJAJYACQCmAAkAtgAJAMYACQANXUEFANUACQDlAAkAAl0AuAAGL7oAEgAXADUruAASELgADNC4A` +
-	`BcQuAAP3DAxASImNTQ2MzIWFRQGBx4BMzI2NzMOAiMiLgEn/ZkzSEkyMUlI2QliPT1gCYAMWX5DQ39ZDQaCRzMzSEgzM0c0TXV3S2+JTk6JbwAAAAH7i/66/6cAAAAeAEG4ADIrALoABgALADUruAA` +
-	`GELgAANC4AAsQuAAD3LoADwALAAYREjm4AAsQuAAT0LgABhC4ABfQuAADELgAG9AwMSEeATMyNjczDgIjIicmJwYHBiMiLgEnMx4BMzI2N/3ZCWI9PWAJgAxZfkNDQDorKjo/Q0N/WQ2ACWI9PWAJT` +
-	`XV3S2+JTickPT0kJ06Jb011d0sAAAAAAf0ZBEL+GgbOABkAM7gAMiu6AAkADgA1K7gACRC4AADQuAAAL7gACRC4ABDcuAAOELgAFdAAuAANL7gAGC8wMQEiBhUUHwIWFRQOAQc1MjU0LwEmNTQ2MxX` +
-	`+GUYuFxEcMT1eZnU0GSh8hAZRLBYfIhgsTEE0VyoGfzwhUypAPk5nfQAAAfxy/dv+wAAAAAkAP7gAMiu6AAYABwA1KwC4AAYvuAABL7oAAAAGAAEREjm6AAMABgABERI5ugAFAAYAARESOboACAAGA` +
-	`AEREjkwMQETMxMjJxEjEQf8cul86ZxOfE3+ugFG/rpe/sMBPF0AAvsk/jQAD//cAAMABwAXuAAyKwC6AAEAAAA1K7oABQAEADUrMDEBNSEVATUhFfskBOv7FQTr/jR8fAEsfHwAAAL8iv3h/qkAAAA` +
-	`DAAcAQ7gAMiu4AAgvuAAJL7gACBC4AADQuAAAL7gACRC4AAPcuAAAELgABdy4AAMQuAAG3AC6AAUAAAA1K7oAAQAEADUrMDEBESERAREhEfyKAh/+LwGD/eECH/3hAdH+fQGDAAAB/Ir94f6pAAAAB` +
-	`QAbuAAyK7oAAQACADUrALgAAS+6AAAAAwA1KzAxIREjESE1/qlO/i/94QHRTgAAAAAB/Nj+ff5bAAAAAwAXuAAyK7oAAQACADUrALoAAAABADUrMDEhESER/lv+ff59AYMAAAAAA/xTA8v+3wc+AAs` +
-	`AFwAwAIm4ADIrugAGAAAANStBBQDaAAAA6gAAAAJdQRsACQAAABkAAAApAAAAOQAAAEkAAABZAAAAaQAAAHkAAACJAAAAmQAAAKkAAAC5AAAAyQAAAA1dALoADwAVADUrugADAAkANSu6ACMAKAA1K` +
-	`7gAKBC4ABjQuAAYL7gAIxC4ABzQuAAoELgAIdwwMQE0NjMyFhUUBiMiJgM0NjMyFhUUBiMiJjcjNDYzMh8BFjMyNTMOAiMiLwImIyIG/YFINDRISDQ0SMhINDRISDQ0SBd9Z04+QCpTITx/BipXNEF` +
-	`MLBgiHxYsBsIzSUoyMkpJ/bgzSksyMkpK84R8KBk0dWZePTEcERcuAAAAAAL8UwPb/t8GCAAYADEAl7gAMiu4ADIvuAAzL7gAMhC4AAHQuAABL7gAANy4ADMQuAAM3LgAC9y4AAAQuAAZ0LgAARC4A` +
-	`BrQuAALELgAJNC4AAwQuAAl0AC4ABkvuAApL7oACwAQADUruAAQELgAANC4AAAvuAALELgABNC4ABAQuAAJ3LgAKRC4ABrQuAAaL7gAKRC4ACTcuAAd0LgAKRC4ACLcMDEBIzQ2MzIfARYzMjUzDgI` +
-	`jIi8CJiMiBhEjNDYzMh8BFjMyNTMOAiMiLwImIyIG/NB9Z04+QCpTITx/BipXNEFMLBgiHxYsfWdOPkAqUyE8fwYqVzRBTCwYIh8WLAUIhHwoGTR1Zl49MRwRFy7+joR8KBk0dWZePTEcERcuAAAB/` +
-	`FMEgf7fBnAAHAAzuAAyKwC6AAsAAQA1K7oAEAAaADUruAAaELgAB9C4AAcvuAAaELgAE9y4AAsQuAAV0DAxASM3JiMiBhUjNDYzMh8BNzMHFjMyNTMOAiMiJ/1ohW4hHhYsfWdOPkAERn9gIhM8fwY` +
-	`qVzQ0OwSB5RYuRoR8KAOTzRB1Zl49HwAAAAEArP/hA5UEXQAPABu4ADIrugABAA4ANSsAuAAAL7oABQAKADUrMDEBERQeATMyNxUGIyIuARkBAWZJgl1rnIl3pb6GBF39ur+aQS6uHGDdARwCIwAAA` +
-	`AAB/N/+Jf5UAAAADwAnuAAyK7oAAQAOADUrALgAAC+6AAUACgA1K7gAChC4AAjQuAAILzAxIRUUHgEzMjcVBiMiLgE9Af08JUEuNk5FO1NfQ79gTSAXWA4xbo6uAAH8igPv/qkGDgALABe4ADIrALg` +
-	`ABi+4AAgvuAAAL7gAAi8wMQEnByc3JzcXNxcHF/5w1tg42dk42NY52NgD79raONfbNdfXNdvXAAAB+8T+uv9uAAAAIgAjuAAyKwC4AAovugAgAAcANSu4AAcQuAAO0LgAIBC4ABjQMDEHBgcOAScmI` +
-	`yIGByMuASMiByYnLgEnPgEzMhcWFzY3NjMyFpIhGxcoBTA9PWIJgAlgPT0xIxwYKQEtf0NDPzoqKzpAQ0N+khALCg0FO3VNS3c6DAoIDQFETickPT0kJ04AAAAAAfuN/cb/pgAVABMASbgAMisAuAA` +
-	`DL7gACi+4AAAvuAANL7oAEgACADUruAACELgABdC4AAUvuAACELgAB9C6AAgAAwAAERI5uAASELgAD9C4AA8vMDElBRUFNTchFSEXFSU1JRUHITUhJ/5gAUb+ul7+9P7EXf66AUZeAQwBPF0V6nzpn` +
-	`E4BTZzqfOmcTgFNAAAAAAH8igPv/qkGDgAFACO4ADIrALgABS+4AAEvugAAAAEABRESOboAAwABAAUREjkwMQkBNS0BNf6p/eEBc/6NBP/+8Fe4u1UAAAH9DwPp/iMGDAARAF24ADIrugADAA4ANSt` +
-	`BGwAGAAMAFgADACYAAwA2AAMARgADAFYAAwBmAAMAdgADAIYAAwCWAAMApgADALYAAwDGAAMADV1BBQDVAAMA5QADAAJdALgAES+4AAsvMDEBIgYVFBYzNhYHBgcuATU0NjP+HklpZ0sEAQEBA3Geo` +
-	`G8Fr2pJRmwBHhMWGwShbnGfAAL8cgUI/sAHdwALABsAbbgAMiu6AAMACQA1K0EFANoACQDqAAkAAl1BGwAJAAkAGQAJACkACQA5AAkASQAJAFkACQBpAAkAeQAJAIkACQCZAAkAqQAJALkACQDJAAk` +
-	`ADV0AuAAGL7oAFwAPADUruAAXELgAEty4AAzQMDEBMhYVFAYjIiY1NDY3LgEjIgYHIz4CMzIeARf9mTNISTIxSUjZCWI9PWAJgAxZfkNDf1kNBf1HMzNISDMzRzRNdXdLb4lOTolvAAAAAfyK/eH+q` +
-	`QAAAAsAF7gAMisAuAAAL7gAAi+4AAYvuAAILzAxAScHJzcnNxc3FwcX/nDW2DjZ2TjY1jnY2P3h2to419s119c129cAAAH8iv3h/qkAAAAFACO4ADIrALgABS+4AAEvugAAAAUAARESOboAAwAFAAE` +
-	`REjkwMQkBFQ0BFfyKAh/+jQFz/vABEFe4u1UAAAH8iv3h/qkAAAAFACO4ADIrALgAAS+4AAUvugAAAAEABRESOboAAwABAAUREjkwMQkBNS0BNf6p/eEBc/6N/vH+8Fe4u1UAAAL7kP3h/6MAAAAFA` +
-	`AsAObgAMisAuAABL7gABC+4AAcvuAAAL7gACy+6AAMAAQAAERI5ugAGAAEAABESOboACQABAAAREjkwMSEBIwsBIxMBNS0BNf6TARBXuLtVK/3hAXP+jf3hAXP+jQEQ/vBXuLtVAAAAAf0PA+n+IwY` +
-	`MABEAXbgAMiu6AA4AAwA1K0EFANoAAwDqAAMAAl1BGwAJAAMAGQADACkAAwA5AAMASQADAFkAAwBpAAMAeQADAIkAAwCZAAMAqQADALkAAwDJAAMADV0AuAALL7gAES8wMQEyNjU0JiMGJjc2Nx4BF` +
-	`RQGI/0USWlnSwQBAQEDcZ6gbwRGaklGbAEeExYbBKFucZ////8GBVb/+wZLAAcA3P0aAAAAAAAB/Lj+K/56AAAAEQBLuAAyKwC4AAAvuAAIL7oAAQAAAAgREjm6AAQAAAAIERI5ugAHAAAACBESObo` +
-	`ACgAAAAgREjm6AA0AAAAIERI5ugAQAAAACBESOTAxATcHJzcnNxcnMwc3FwcXBycX/WoKjS+ami+NCl4NkC+ami+QDf4rrV9RS0xQX66uX1BMS1FfrQAD/FT+jP7fAAAAGQAlADEAlbgAMiu4ADIvu` +
-	`AAK0LgACi+4ACncQQMAjwApAAFdQQMAHwApAAFdQQMAIAApAAFduAAd3EEDAI8AHQABXUEDAB8AHQABXUEDACAAHQABXbgAF9y4ACkQuAAj3LgAChC4AC/cuAAXELgAM9wAugAaAAAANSu6ABQAIAA` +
-	`1K7gAFBC4AA3QuAAaELgAJtC4ACAQuAAs0DAxASInJicGBwYiJjU0NjMyFxYXNzYzMhYVFAYnMjY1NCYjIgYVFBYjMjY1NCYjIgYVFBb+JU42BAQDBDacbG1NTjYEAwg3TU5sbE4nNjYnJjc38Sc2N` +
-	`icmNzf+jDgEBAQEOHBKS283BAQIN29LSnBdNyYmNzcmJjc3JiY3NyYmNwAAAAL8mwPP/pcGFgAUACAAs7gAMiu4ACEvuAAiL7gAENy4AADcuAAhELgABtC4AAYvuAAAELgADtC4AAAQuAAV0LgABhC` +
-	`4ABvcQRsABgAbABYAGwAmABsANgAbAEYAGwBWABsAZgAbAHYAGwCGABsAlgAbAKYAGwC2ABsAxgAbAA1dQQUA1QAbAOUAGwACXQC6AB4AAwA1K7oACgAYADUruAAKELgADNC4AAwvuAAKELgADtC4A` +
-	`A4vugASAAMAHhESOTAxAQ4BIyImNTQ+ATMyFxYXMxEUFyMmJzUmIyIGFRQWMzI2/iUrdzJGcEp8XBo2CBBdFWELBjIqVnM/KCtpBF1GSHp7YZhZBgEB/nVlPy6+8A2Fc1NUWAAC/KcDz/6MBh0AFQA` +
-	`dAGG4ADIruAAeL7gAHy+4AA3cuAAA0LgAAC+4AB4QuAAG0LgABi+4AA/cuAANELgAF9y6ABUADQAXERI5uAAPELgAFtC4ABYvALoAEwACADUrugAKABsANSu6ABcADgA1KzAxAQYjIi4BNTQ+ATMyF` +
-	`h0BIRQeATMyNyUhNTQmIyIG/oRvZEt3SER6S2R4/n8xTzJabf6NARZDODhTBAQ1S4xPTY1OjpQUOmAwPNQNRVFTAAAC/M8D3/5jBxYABwATACO4ADIrugAFAAAANSsAuAALL7oABQAAADUrugAEAAE` +
-	`ANSswMQERIzUzETMVATQ2MzIWFRQGIyIm/Wqb+Jz++CsTEysrExMrA98B4U7+H04C+CAfHyAfHx8AAAL8kgPP/qEGHQALABcAy7gAMiu4ABgvuAAZL7gAGBC4AAPQuAADL7gAGRC4AAncuAAP3EEFA` +
-	`NoADwDqAA8AAl1BGwAJAA8AGQAPACkADwA5AA8ASQAPAFkADwBpAA8AeQAPAIkADwCZAA8A`
-
-const MonacoFontData12 = `qQAPALkADwDJAA8ADV24AAMQuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl0AugAMA` +
-	`AAANSu6AAYAEgA1KzAxASImNTQ2MzIWFRQGJzI2NTQmIyIGFRQW/Zp4kJB4d5CQd0pYWEpLWFgDz6iAf6enf4CoTnRmZXR0ZWZ0AAAB/LEDz/6CBg4AFQA/uAAyK7gAFi+4ABcvuAAB3LgAAty4ABY` +
-	`QuAAK0LgACi+4AA3cuAACELgAFNAAuAAAL7gACy+6ABEABgA1KzAxAREjNQ4BIyIuATURMxEUHgEzMjY3Ef6CXS1uNzFRIF0PKhgxcCUGDv3Re0VGNVZ8ATj+vFA0IV1GAUYAAfywA8/+ggYdABgAc` +
-	`bgAMiu6ABIABgA1K7gABhC4AAzcuAAA0EEbAAYAEgAWABIAJgASADYAEgBGABIAVgASAGYAEgB2ABIAhgASAJYAEgCmABIAtgASAMYAEgANXUEFANUAEgDlABIAAl0AugAVAAIANSu6AAkADgA1KzA` +
-	`xAQYjIi4BNTQ2MzIXFSYjIg4BFRQWMzI2N/6CXlRUg0mmdVZhYk0zWjFfZR47UAPvIE6FVH+oIFotM2s4TIkJGwAAAvymA8/+jQcGABIAHgCtuAAyK7gAHy+4ACAvuAAfELgABtC4AAYvuAAgELgAE` +
-	`dy4ABLcuAAO0LgAEhC4ABPQuAAGELgAGdxBGwAGABkAFgAZACYAGQA2ABkARgAZAFYAGQBmABkAdgAZAIYAGQCWABkApgAZALYAGQDGABkADV1BBQDVABkA5QAZAAJdALgADy+6ABwAAwA1K7oACgA` +
-	`WADUruAAKELgADNC4AAwvuAAKELgADtC4AA4vMDEBDgEjIiY1ND4BMzIXFhc1MxEjPQEmIyIGFRQWMzI2/jArdzJGcEp8XBo2CBBdXTIqVnM/KCtpBF1GSHp7YZhZBgEB+PzZ7PANhXNTVFgAAAH8s` +
-	`QPf/oIHBgAXAFe4ADIruAAYL7gAGS+4ABgQuAAA0LgAAC+4ABfcuAAC0LgAGRC4AA3cugADAAAADRESObgADtwAuAABL7gAAC+4AA0vugAGABMANSu6AAMAAAABERI5MDEBETMRPgEzMhYXHgEVESM` +
-	`RNC4BIyIGBxH8sV0ocTgwThAMCV0PKhgxcSQD3wMn/o5ASTAjGjxd/sgBRFA0IV1G/roAAAH8igPf/qkGHQAlAGe4ADIrugAlAAAANSu6ABwAHQA1K7oAEgATADUruAAlELgAAtC6AAMAAAASERI5u` +
-	`AAcELgACdC6AAoAAAASERI5ALgAAC+4ABIvuAAcL7oADgAYADUruAAOELgABtC4ABgQuAAh0DAxAREzFT4BMzIWHQE+AjMyFhURIxE0LgEjIgYHESMRNCYjIgYHEfyKUSdCIikxES4yISQzUgYSCho` +
-	`8H04dCxo6GwPfAi+AUT4/Ox4zQCU9W/5aAX4vIhJPXP7KAX1KGllF/r0AAAH8yAPf/msGHQARAE24ADIruAASL7gAEy+4ABIQuAAA0LgAAC+4ABHcuAAC0LgAExC4AAncugADAAAACRESObgACty4A` +
-	`AbQuAAGLwC4AAAvugAGAA0ANSswMQERMxU+ATMyFxUjNSYjIgYHEfzIXSxwRTMyWRgNNGQwA98CL3pFRA/KgARSUv67AAAAAfyhA8/+kgbAABgAS7gAMiu6ABIABwA1K7gABxC4AAvQuAASELgADdC` +
-	`4AAcQuAAY3AC4AAwvugAWAAMANSu6AAsACAA1K7gACxC4AA7QuAAIELgAENAwMQEOASMiLgE9ASM1MzUzFTMVIxUUHgEzMjf+kkI7GzthMYyMXfj4IDUlQ0sD9hwLLlhc4U3h4U3gQzgaKwAAAAAB/` +
-	`HYD3/68Bg4ABgAduAAyKwC4AAEvuAAEL7gAAC+6AAMAAAABERI5MDEBAzMbATMD/WjyZr/EXfUD3wIv/kYBuv3RAAH8gAPf/rMGDgALACu4ADIrALgAAy+4AAYvuAAAL7gACS+6AAUAAAADERI5ugA` +
-	`LAAAAAxESOTAxASMTAzMXNzMDEyMn/Oxs3M13kY5pwud4qgPfASUBCry8/v/+0t8AAAEARwHQBIUGDgALABe4ADIrALgAAC+4AAIvuAAGL7gACC8wMQkCJwkBNwkBFwkBBBT+U/5RcQGz/k1xAa8Br` +
-	`XH+UAGwAdABtP5McAGtAbZr/lIBrmv+Sv5TAAAAAQCs/+EDlQRdAA8AG7gAMiu6AA8AAAA1KwC4AAAvugAFAAoANSswMRcRED4BMzIXFSYjIg4BFRGshr6ld4mca12CSR8CIwEc3WAcri5Bmr/9ugA` +
-	`AAQCs/+EDlQRdAA8AG7gAMiu6AA8AAAA1KwC4AAAvugAKAAUANSswMQURNC4BIyIHNTYzMh4BGQEC20mCXWuciXelvoYfAka/mkEurhxg3f7k/d0AAgAk/+kEoAR0ABoAJwDFuAAyK7gAKC+4ACkvu` +
-	`AAZ3LgAAty4ACgQuAAJ0LgACS+4AAIQuAAQ0LgAGRC4ABTQuAACELgAG9C4AAkQuAAi3EEbAAYAIgAWACIAJgAiADYAIgBGACIAVgAiAGYAIgB2ACIAhgAiAJYAIgCmACIAtgAiAMYAIgANXUEFANU` +
-	`AIgDlACIAAl0AuAANL7gABS+6ABoAAAA1K7oAEgATADUrugAWABcANSu6AAIAAAAaERI5ugAQABMAEhESObgAABC4ACXcMDEpATUOASMiJgI1NBI2MzIWFzUhFSERIRUhESElETQmIyICFRQSMzI2B` +
-	`KD92URHEnPVcHvOciFGMwIY/qMBTf6zAWz92UtIg3VvjUZJHCsIhwEgrLYBDHYPJBxw/n9w/n/8AWm/Zf72yLP+2mYAAAAAAQCb/g8EPgRdABcATbgAMiu4ABgvuAAZL7gAAdy4AALcuAAYELgADNC` +
-	`4AAwvuAAP3LgAAhC4ABbQALgAAC+4AA0vuAABL7oAEwAGADUrugADAAEAABESOTAxAREjEQ4BIyImJy4BNREzERQeATMyNjcRBD66UOJxX50fGRK6H1MxYuFJBF35sgLlgZJhRTV3ugJw/XmhZ0K6j` +
-	`AKLAAAAAAEAIAAABK0GLwASABe4ADIrALgAAS+4AAQvugAJAA4ANSswMQkBIwkBIwESNjMyFxUmIyIOAQcClwIEzf5l/qi7AbGJ98RVQ01GWox0GAQw+9ADRvy6BA4BSdgPpBhGskEAAAACAHL+LgZ` +
-	`vBk4AIQAtAKm4ADIruAAuL7gALy+4AAHcuAAR3LgALhC4ABjQuAAYL7gAERC4ACDQuAARELgAItC4ABgQuAAo3EEbAAYAKAAWACgAJgAoADYAKABGACgAVgAoAGYAKAB2ACgAhgAoAJYAKACmACgAt` +
-	`gAoAMYAKAANXUEFANUAKADlACgAAl0AuAAAL7oABgALADUrugArABUANSu6ABwAJQA1K7gAHBC4AB7QuAAeLzAxAREWFx4BMzI3FQYjIiYnJicjNQ4BIyImNTQSNjMyFxYXGQImIyICFRQWMzI2BEA` +
-	`CIiWCXWuciXelvkMyDQVV72SM35T4tzVrECBjVazlfVBX0gZO+fqpR01BLq4cYG9SsfuMjvL2wgExsQsCAwHx+4oB3xr+9uWmqbAAAAIAlQAABLQEXQAPABkAqbgAMiu4ABovuAAbL7gAGhC4AALQu` +
-	`AACL7gAAdy4ABsQuAAI3LoADAACAAgREjm4AAEQuAAQ0LgACBC4ABXcQQUA2gAVAOoAFQACXUEbAAkAFQAZABUAKQAVADkAFQBJABUAWQAVAGkAFQB5ABUAiQAVAJkAFQCpABUAuQAVAMkAFQANXQC` +
-	`4AAEvuAANL7oABAAYADUrugAQAAAANSu6AAwAAAAQERI5MDEBESMRITIeARUUDgEHASMBJyE+AjU0JisBAV/KAZ/LwGlGe3QBwfD+ZcoBG19dMJ/RlwHa/iYEXTt5RkGDZy799gHacSVOWCxOXQAAA` +
-	`gCVAAAEtARdAA8AGQCpuAAyK7gAGi+4ABsvuAAaELgADNC4AAwvuAAbELgAB9y6AAMADAAHERI5uAAMELgAENy4AA7QuAAHELgAFNxBBQDaABQA6gAUAAJdQRsACQAUABkAFAApABQAOQAUAEkAFAB` +
-	`ZABQAaQAUAHkAFACJABQAmQAUAKkAFAC5ABQAyQAUAA1dALgAAS+4AA0vugARAAsANSu6AAAAGAA1K7oAAwAYAAAREjkwMQkBMwEeAhUUDgEjIREzGQEzMjY1NC4BJyECKQGb8P4/dHtGacDL/mHKl` +
-	`9GfMF1f/uUCgwHa/fYuZ4NBRnk7BF3+Jv3tXU4sWE4lAAABAKj+LgQ0BHwAOQD7uAAyK7gAOi+4ADsvuAAl3LgAB9xBBQDaAAcA6gAHAAJdQRsACQAHABkABwApAAcAOQAHAEkABwBZAAcAaQAHAHk` +
-	`ABwCJAAcAmQAHAKkABwC5AAcAyQAHAA1duAA6ELgAEdC4ABEvugAXACUABxESObgAHNxBGwAGABwAFgAcACYAHAA2ABwARgAcAFYAHABmABwAdgAcAIYAHACWABwApgAcALYAHADGABwADV1BBQDVA` +
-	`BwA5QAcAAJdugAqABEAHBESObgALNC4ACwvugAxACUABxESOQC6AC8ANAA1K7oAFAAZADUrugAEACgANSu6ACoAKAAEERI5MDE3NR4BMzI2NTQnJi8CJicmNTQ2MzIXFSYjIgYVFBcWHwEWFxYVFAY` +
-	`jIicWFx4BMzI3FQYjIiYnJieocPpXjXwdHXNAgdA2N9Xvs6C4onaFOjmBfq1GRt/1fH8HGiWCXWuciXelvkM6CDvFSTtbRC0gIC8aM1FFRGKKsjSpQlM+PCQkLy4/SkpvkbsecDdNQS6uHGBvX94AA` +
-	`AEAdv/eBEAEfwAkAK+4ADIruAAlL7gAJi+4AADcuAAlELgACNC4AAgvuAAAELgAENC4ABAvuAAAELgAINy6ABEAAAAgERI5uAAIELgAGtxBGwAGABoAFgAaACYAGgA2ABoARgAaAFYAGgBmABoAdgA` +
-	`aAIYAGgCWABoApgAaALYAGgDGABoADV1BBQDVABoA5QAaAAJdALoAHgACADUrugAOABMANSu6ACMAIgA1K7gAHhC4ACDQuAAgLzAxJQYjIicmJyY1NDc2NzYzMhcVJiMiBwYHDgEHFBcWMzI3ESM1I` +
-	`QRApofCmJhVVlRUmZq2iLCfoXdlYj4eHQFzdPcgMZsBZQEjRUWLjLCtioxGRy+EQzs6czl8RbaUlQMBaHAAAAEAfgAABE8EXQAOAHm4ADIruAAPL7gAEC+4AA8QuAAB0LgAAS+4AADcuAAD0LgAAy+` +
-	`4ABAQuAAK3LgAC9y4AAXQugAGAAoACxESObgACxC4AAfQuAAAELgADdC4AA0vALgAAi+4AAgvuAAAL7gACi+6AAYAAAACERI5ugANAAAAAhESOTAxISMRMwEXMycRMxEjAScXATi6tgIlPAUFurr96` +
-	`ksEBF39GW9vAuf7owLcdXQAAAEAIQAABK0EfwAhAN+4ADIruAAiL7gAIy+4AAbcuAAd3EEFANoAHQDqAB0AAl1BGwAJAB0AGQAdACkAHQA5AB0ASQAdAFkAHQBpAB0AeQAdAIkAHQCZAB0AqQAdALk` +
-	`AHQDJAB0ADV24AAPQuAADL7gAIhC4AAzQuAAML7gAF9xBGwAGABcAFgAXACYAFwA2ABcARgAXAFYAFwBmABcAdgAXAIYAFwCWABcApgAXALYAFwDGABcADV1BBQDVABcA5QAXAAJduAAP0AC6ABoAC` +
-	`QA1K7oAAQACADUruAACELgAD9C4AAEQuAAR0DAxASEVIx4BFRAAISAAETQ2NyM1IRUOAhUUFjMyEjU0LgEnAr0B8PF9Tf7q/vf+9/7qTX3xAfFZaD+yo6SyP2hZBH+BZs19/vP+vwFBAQ19zWaBgRx` +
-	`ssnXh/gEA3naybBwAAQA0AAAEmQRdAAgAG7gAMiu6AAgAAAA1KwC4AAIvuAAFL7gAAC8wMSERATMJATMBEQIC/jLlAVkBWM/+MwIFAlj+RQG7/aj9+wAAAAEAfgAABE8EXQALAEu4ADIruAAML7gAD` +
-	`S+4AAwQuAAA0LgAAC+4AAvcuAAC0LgADRC4AAbcuAAF3LgACNAAuAABL7gABS+4AAAvuAAHL7oABAAJADUrMDEzETMRIREzESMRIRF+ygI+ycn9wgRd/h8B4fujAg398wAAAAABANsAAAQhBF0ABQA` +
-	`buAAyK7oABQACADUrALgAAy+6AAAAAQA1KzAxJRUhETMRBCH8usp7ewRd/B4AAAADAKwAAAQ4BF0AEQAbACUA8bgAMiu6ABwAAAA1K7oABgAXADUrQQUA2gAXAOoAFwACXUEbAAkAFwAZABcAKQAXA` +
-	`DkAFwBJABcAWQAXAGkAFwB5ABcAiQAXAJkAFwCpABcAuQAXAMkAFwANXboAIAAXAAYREjm4ACAvQQUA2gAgAOoAIAACXUEbAAkAIAAZACAAKQAgADkAIABJACAAWQAgAGkAIAB5ACAAiQAgAJkAIAC` +
-	`pACAAuQAgAMkAIAANXbgADdy6AAoAAAANERI5uAAcELgAEtC4AA0QuAAn3AC6ABwAEQA1K7oAAgAaADUrugASACUANSu6AAoAJQASERI5MDEzESEgHgEVFA4BBx4BFRQOASMDMz4CNTQmKwERMzI2N` +
-	`TQuASsBrAEvARG5XDZqZbmDb8jcr9RnXy+Bu42/oJlUsLw4BF07dUs7alcnNZxNSoxLAmYfRVQyT078g2xNOF44AAEBQP0LA47+UQAGABO4ADIrALgAAi+4AAAvuAAELzAxASMTMxMjJwHcnOl86Zu` +
-	`M/QsBRv66qAAAAwAbAAAExwYQABUAHQAkAGO4ADIrugAiAAIANSu4ACIQuAAS0LgAAhC4ABTQuAAiELgAFtAAuAATL7oABAAgADUrugABAAAANSu6ABcAEQA1K7gAARC4AAnQuAAAELgAC9C4AAAQu` +
-	`AAc0LgAARC4ACLQMDETNTMRITIWFxYXMxUjBgcGBCsBESMREzMyNjc2NyEBJiEjFSEmG5UBgu7aOiYNYF4OO07+6e9SyspXu7YxIQv92wHSV/7+eQIeFAQRmwFka2dETpttYoGL/coEEf7AYVs+RgE` +
-	`VTshIAAIAB//hBL0EXQAXACIAlbgAMiu4ACMvuAAkL7gAIxC4AALQuAACL7gABdy4ACQQuAAJ3LgABty4AAkQuAAM0LgABhC4AA7QugAPAAIACRESObgAAhC4ABbQuAAGELgAHtC4AAUQuAAg0AC4A` +
-	`AMvuAAHL7oAGwASADUrugABAAAANSu4AAEQuAAF0LgAARC4AAnQuAAAELgAC9C4AAAQuAAf0DAxEzUzETMRIREzETMVIxEjNQ4BIyIuAT0BEx4BMzI2NzUhFRQHiLoCL7qLi7pa3G5io0DJEFMwYuF` +
-	`K/dECJ5sBm/5lAZv+ZZv92fWKimmr+Dr+2jNCuY1VUaEAAAABABz+LgSxBj4AGwAXuAAyKwC6ABMAGAA1K7oACgAFADUrMDElETQuASMiBzU2MzIeARkBFB4BMzI3FQYjIi4BAhNCdVRgjHtrlat4Q` +
-	`nVUYIx7a5WreIcDgb+aQS6uHGDd/uT8f7+aQS6uHGDdAAAAAQAc/i4EsQY+ACgAN7gAMisAugAKAAUANSu6ABwAIQA1K7oAJgAAADUrugAXABQANSu4AAAQuAAP0LgAJhC4ABHQMDEhBgcOASMiJzU` +
-	`WMzI2NzY3ITUhETUhNSE2Nz4BMzIXFSYjIg4BFREhFQK2DCw8q5Vre4xgVHUhFwf+0wEw/tABNgwqPKuVa3uMYFR1QgExsVJvYByuLkFNN3GbA0oDm6BMb2Acri5Bmr/8k5sAAAAAAQDN/g8EpgRdA` +
-	`AoASbgAMiu6AAEAAgA1K7gAAhC4AAnQuAABELgADNwAuAABL7gAAC+4AAcvugADAAEAABESOboABQABAAAREjm6AAkAAQAAERI5MDEBESMRASMJASEBEQSmuv3n+AIY/doBAAIfBF35sgP2/fsCBQJ` +
-	`Y/agCWAACAJ4AAAPFBm0ADwAbAMO4ADIrugAMAAIANSu4AAwQuAAA3LgABNC4AAIQuAAG0LgADBC4AAjQuAANELgACdC4AAAQuAAP3LgACtC6ABAAAgAMERI5uAAQL0EFANoAEADqABAAAl1BGwAJA` +
-	`BAAGQAQACkAEAA5ABAASQAQAFkAEABpABAAeQAQAIkAEACZABAAqQAQALkAEADJABAADV24ABbcALgAEy+6AA0AAAA1K7oABAABADUrugAIAAUANSu4AAQQuAAJ0LgAARC4AAvQMDEhESE1IREhNSE` +
-	`RIRUhESEVATQ2MzIWFRQGIyImAdT+ygE2/soB8AE3/skBN/3wViYnVlYnJlYB4ZsBRpv+H5v+upsF8D8+Pj8+Pj4AAAABAKz/4QOVBhAADwAbuAAyK7oAAQAOADUrALgAAC+6AAUACgA1KzAxAREUH` +
-	`gEzMjcVBiMiLgEZAQFmSYJda5yJd6W+hgYQ/Ae/mkEurhxg3QEcA9YAAAAAAgCN//AEWwY/AB0AKQCvuAAyK7gAKi+4ACsvuAAqELgAD9C4AA8vuAAO3LgAANC4ACsQuAAG3LoAFwAPAAYREjm4AA4` +
-	`QuAAe0LgABhC4ACTcQQUA2gAkAOoAJAACXUEbAAkAJAAZACQAKQAkADkAJABJACQAWQAkAGkAJAB5ACQAiQAkAJkAJACpACQAuQAkAMkAJAANXQC6ACEACgA1K7oAFAAZADUrugADACcANSu4AAoQu` +
-	`AAM0LgADC8wMQE+ATMyFhUUAgYjIicmJyMRED4BMzIXFSYjIg4BFRkBFjMyEjU0JiMiBgFHVu5kjOCV+Lc0bBAguoa+pXeJnGtdgkllVannflBX0QNijI7y9sL+z7ELAgMD5gEc3WAcri5Bmr/+fP4` +
-	`hGgEJ5qeosAAAAAIAcv/hBm8GPwAgACwAu7gAMiu4AC0vuAAuL7gALRC4AAbQuAAGL7gALhC4AB/cuAAg3LgADtC4ACAQuAAQ0LgAEC+4ACAQuAAh0LgABhC4ACfcQRsABgAnABYAJwAmACcANgAnA` +
-	`EYAJwBWACcAZgAnAHYAJwCGACcAlgAnAKYAJwC2ACcAxgAnAA1dQQUA1QAnAOUAJwACXQC6ACoAAwA1K7oAFQAaADUrugAKACQANSu4AAoQuAAM0LgADC+6ABAAJAAKERI5MDElDgEjIiY1NBI2MzI` +
-	`XFhcVMzY3PgEzMhcVJiMiDgEVESMZASYjIgIVFBYzMjYDhVXvZIzflPi3NWsQIAIJOUO+pXeJnGtdgkm7Y1Ws5X1QV9L7jI7y9sIBMbELAgMh111vYByuLkGav/v3AdgB3xr+9uWmqbAAAQCbAAAEP` +
-	`gY/ACMAY7gAMiu4ACQvuAAlL7gAJBC4AADQuAAAL7gAJRC4ABjcuAAb3LgAB9C6AAgAAAAYERI5uAAAELgAI9y4AA7QugAPAAAAGBESOQC4AAAvuAAZL7oABQAKADUrugASAB8ANSswMTMRED4BMzI` +
-	`XFSYjIg4BHQE+ATMyFhceARURIxE0LgEjIgYHEZuGvqV3iZxrXYJJUOJxX50fGRK6H1MxYuFJA+YBHN1gHK4uQZq/oIGSYUU1d7r9kAKHoWdCuoz9dQAAAAEAm/4uBD4GPwAxAHG4ADIruAAyL7gAM` +
-	`y+4AAHcuAAyELgAGtC4ABovuAAZ3LgACdC6AAoAGgABERI5uAABELgAENy4ACHQugAiABoAARESObgAGRC4ACjQugApABoAARESOQC6AAwABwA1K7oAHwAkADUrugAsABUANSswMQERIwYHDgEjIic` +
-	`1FjMyPgE1ETQuASMiBgcRIxEQPgEzMhcVJiMiDgEdAT4BMzIWFx4BBD4FDTFDvqV3iZxrXYJJH1MxYuFJuoa+pXeJnGtdgklQ4nFfnR8ZEgJw/ZCxUm9gHK4uQZq/AiOhZ0K6jP11A+YBHN1gHK4uQ` +
-	`Zq/oIGSYUU1dwAAAQB/AAAEHQY+ACgA1bgAMiu4ACkvuAAqL7gAKRC4AADQuAAAL7gABNC4AAQvuAAAELgABtC4AAYvuAAAELgAKNy4AAnQuAAqELgAG9y4AA/cQQUA2gAPAOoADwACXUEbAAkADwA` +
-	`ZAA8AKQAPADkADwBJAA8AWQAPAGkADwB5AA8AiQAPAJkADwCpAA8AuQAPAMkADwANXbgAKBC4ABLQuAASL7gAKBC4ABjQuAAYL7gAKBC4ACTQuAAkLwC4AAAvugAYABIANSu6AAQAAQA1K7gABBC4A` +
-	`CTQuAABELgAJtAwMSERITUhNjc+AT8BNj8BNjU0JiMiBgc1NjMyFhUUDwMGBwYHIRUhEQGR/u4BHQIBDk9PPhIiQlR4h1e1ebnK4OuYQk5HJx4KBwEv/sQBw5sFBDh3VEITK1NoTEZsL0S0WreSkJt` +
-	`EUUksORMWm/49AAAAAAEAfwAABB0GPgAoANW4ADIruAApL7gAKi+4ACjcuAAA3LgABNC4AAQvuAApELgADdC4AA0vuAAAELgAENC4ABAvuAAAELgAFtC4ABYvuAANELgAGdxBGwAGABkAFgAZACYAG` +
-	`QA2ABkARgAZAFYAGQBmABkAdgAZAIYAGQCWABkApgAZALYAGQDGABkADV1BBQDVABkA5QAZAAJduAAAELgAH9C4ACgQuAAi0LgAIi+4ACgQuAAk0LgAJC8AuAAAL7oAEAAWADUrugAEAAEANSu4AAQ` +
-	`QuAAk0LgAARC4ACbQMDEhESE1ISYnJi8DJjU0NjMyFxUuASMiBhUUHwEWHwEeARcWFyEVIRECUf7EAS8HCh4nR05CmOvgyrl5tVeHeFRCIhI+T08OAQIBHf7uAcObFhM5LElRRJuQkrdatEQvbEZMa` +
-	`FMrE0JUdzgEBZv+PQAAAAACAHL+LwZvBdsAMAA8AMO4ADIruAA9L7gAPi+4AB7cuAAA3LgAPRC4AAbQuAAGL7gAABC4AA7QuAAGELgAJtC4ACYvuAAGELgAN9xBGwAGADcAFgA3ACYANwA2ADcARgA` +
-	`3AFYANwBmADcAdgA3AIYANwCWADcApgA3ALYANwDGADcADV1BBQDVADcA5QA3AAJdugAnAAYANxESObgAABC4ADHQALoAKgAkADUrugAUABkANSu6ADoAAwA1K7oACgA0ADUruAAKELgADNC4AAwvM` +
-	`DElDgEjIiY1NBI2MzIXFhczNjc+ATMyFxUmIyIOARUREAYHBgQjIic1HgEzMjY3PgE1GQEmIyICFRQWMzI2A4VV72SM35T4tzVrECAOESVDvqV3iZxrXYJJDxEo/uPKy9BP52xVmC8vImNVrOV9UFf` +
-	`S+4yO8vbCATGxCwIDcj1vYByuLkGav/2i/uZ9QJWsaLw2UzYzM4dwAXsB3xr+9uWmqbAAAAAAAQBg/i4EQQXCACcAP7gAMisAuAAcL7oADAAHADUrugAmABMANSu6ABsAGAA1K7gAExC4ABHQuAARL` +
-	`7gAGxC4AB7QuAAYELgAINAwMSUVBwYHDgEjIic1FjMyNjc2NwYjIi4BNREhNSERMxEhFSERFB4BMzIEQQIKN0O+pXeJnGtdgiQYCDo3dcJj/ukBF7oB8f4PQGtJh9GkAdNcb2Acri5BTTJiC1qwuAH` +
-	`CmwHC/j6b/kGHbzQAAAEAYP/hBGAGPwAnAFO4ADIrugAhAAcANSu4AAcQuAAL0LgABxC4AA3QuAANL7gAIRC4ABzQALoAJQADADUrugASABcANSu6AAsACAA1K7gACxC4AB3QuAAIELgAH9AwMSUOA` +
-	`SMiLgE1ESE1ITUzNjc+ATMyFxUmIyIGBwYHFSEVIREUHgEzMjcEQYN2N3XCY/7pARcBCDpDvqV3iZxrXYIlIgIB8f4PQGtJh5UtNxVasLgBwpsy319vYByuLkFNR6klm/5Bh280VQAAAAEAzQAABKY` +
-	`GPwAWACe4ADIrugAWAAAANSu4ABYQuAAO0AC4AAAvuAATL7oABQAKADUrMDEzERA+ATMyFxUmIyIOARURATMJASEBEc2GvqV3iZxrXYJJAhn4/egCJv8A/eED5gEc3WAcri5Bmr/+TwIF/fv9qAJY/` +
-	`agAAAACAI3+TgRbBdsAHwArALO4ADIruAAsL7gALS+4ACwQuAAQ0LgAEC+4AA/cuAAA0LgALRC4AAbcugAYABAABhESObgADxC4ACDQuAAGELgAJtxBBQDaACYA6gAmAAJdQRsACQAmABkAJgApACY` +
-	`AOQAmAEkAJgBZACYAaQAmAHkAJgCJACYAmQAmAKkAJgC5ACYAyQAmAA1dALgADy+6ABUAGgA1K7oAIwAKADUrugADACkANSu4AAoQuAAM0LgADC8wMQE+ATMyFhUUAgYjIicmJxEjERA+ATMyFxUmI` +
-	`yIGBwYHGQEWMzISNTQmIyIGAUdW7mSM4JX4tzRsECC6hr6ld4mca12CJSICZVWp535QV9EDYoyO8vbC/s+xCwID/k4FNAEc3WAcri5BTUep/sT+IRoBCeanqLAAAAAAAgCwAAAEbgYQAAwAFgCJuAA` +
-	`yK7gAFy+4ABgvuAAE3LgAFxC4AAnQuAAJL7gADdy4AAvQuAAEELgAEdxBBQDaABEA6gARAAJdQRsACQARABkAEQApABEAOQARAEkAEQBZABEAaQARAHkAEQCJABEAmQARAKkAEQC5ABEAyQARAA1dA` +
-	`LgACi+6AA4ACAA1K7oAAAAVADUrMDEBMgQSFRQOASMhETMZATMgNjU0LgErAQHM7wEXnHTa7v5+ynkBAq9itrtXA9qL/v6Vf85rBhD9yvzCnIZqtmEAAgDVAAAD+ARdAAwAFgCJuAAyK7gAFy+4ABg` +
-	`vuAAE3LgAFxC4AAnQuAAJL7gADdy4AAvQuAAEELgAEdxBBQDaABEA6gARAAJdQRsACQARABkAEQApABEAOQARAEkAEQBZABEAaQARAHkAEQCJABEAmQARAKkAEQC5ABEAyQARAA1dALgACi+6AA4AC` +
-	`AA1K7oAAAAVADUrMDEBMh4BFRQOASMhETMZATM+ATU0LgErAQHarPt3Ws+r/rHKV7p+R4OGPwLGZLprXJRNBF3+af4ECitgTDgZAAAAAQB2/9EEvQY/ACQAz7gAMiu6AB4AEQA1K7oABwAEADUruAA` +
-	`HELgAAty4AAcQuAAK0LgABxC4ABfQuAAXL7oAGAAEAAcREjlBGwAGAB4AFgAeACYAHgA2AB4ARgAeAFYAHgBmAB4AdgAeAIYAHgCWAB4ApgAeALYAHgDGAB4ADV1BBQDVAB4A5QAeAAJduAACELgAI` +
-	`9C4AAcQuAAm3AC6ACEADQA1K7oAFQAaADUrugABAAAANSu6AAUABAA1K7gAARC4AAfQuAAAELgACdC4ACEQuAAj0LgAIy8wMQE1MzUjNSERMxUjEQYjIiQCNTQSJDMyFxUmIyIGAhUUEjMyNzUCffm` +
-	`bAWV9faSJwv7RrKgBMreKrqGfeMd55vggMQE3m5Ob/tKb/sowwAGD9PEBgMZBuF2k/sO8/P5iBcYAAAACAHL+LwTHBG0AJgA2AOO4ADIruAA3L7gAOC+4ABTcuAAA3LgANxC4AAbQuAAGL7gAABC4A` +
-	`A7QuAAUELgAD9C4AAYQuAAc0LgAHC+4AAYQuAAx3EEbAAYAMQAWADEAJgAxADYAMQBGADEAVgAxAGYAMQB2ADEAhgAxAJYAMQCmADEAtgAxAMYAMQANXUEFANUAMQDlADEAAl26AB0ABgAxERI5uAA` +
-	`AELgAJ9C4AAAQuAAr0AC6ACAAGgA1K7oACgAuADUrugA0AAMANSu6ABEAEgA1K7gAChC4AAzQuAAML7gAEhC4ACjQuAARELgAKtAwMSUOASMiJjU0EjYzMhcWFzMRMxUjFRAGBwYEIyInNR4BMzI2N` +
-	`z4BNRE1IzUzNSYjIgIVFBYzMjYDhVXvZIzflPi3NWsQILuHhw8RKP7jysvQT+dsVZgvLyKammNVrOV9UFfS+4yO8vbCATGxCwID/mWb4P7mfUCVrGi8NlM2MzOHcAF7T5v1Gv725aapsAABAHkAAAP` +
-	`eBk4AHwBXuAAyK7oAHAAHADUruAAcELgAANy4AAzQuAAcELgAENC4AB0QuAAR0AC6AB0AAAA1K7oAEAANADUrugAVABoANSu4ABoQuAAG0LgABi+4ABUQuAAK0DAxIREmIyIGFSM0NjMyFxEhNSERF` +
-	`jMyNTMOAiMiJxEhFQHHFxYWLH1nThsc/rICCBsQPH8GKlc0FRYBXQNADC5GhHwIAeKc/SALdWZePQX9v5sAAAAAAwBX/k4EdgYVABUAHgAnAH+4ADIruAAoL7gABdC4AAUvuAAA3EEDAC8AAAABXUE` +
-	`DAEAAAAABXbgACdC4AAAQuAAV3LgAC9C4AAAQuAAh3EEDAC8AIQABXUEDAEAAIQABXbgAENy4AAUQuAAY3LgAABC4ABzQuAAVELgAJdC4ABAQuAAp3AC4AAovuAAALzAxAREmJyY1NDc2NxEzERYXF` +
-	`hUUBwYHEQEGFRQXFhcRBgE2NTQnJicRNgIVuHaQkHa4o7d3kJB3t/7BWFg+Xl4BnVhYP11d/k4BmBmJqP//p4kZAZ7+YhmJp///qIkZ/mgFH3TKy3RTGANSGP0xdMvKdFIY/K4YAAAAAgBy/k4GbwV` +
-	`3AB8AKwCluAAyK7gALC+4AC0vuAAd3LgAIdy4AADQuAAsELgABtC4AAYvuAAhELgADtC4AAYQuAAm3EEbAAYAJgAWACYAJgAmADYAJgBGACYAVgAmAGYAJgB2ACYAhgAmAJYAJgCmACYAtgAmAMYAJ` +
-	`gANXUEFANUAJgDlACYAAl0AuAAeL7oAFAAZADUrugApAAMANSu6AAoAIwA1K7gAChC4AAzQuAAMLzAxJQ4BIyImNTQSNjMyFxYXMzY3PgEzMhcVJiMiDgEVESMZASYjIgIVFBYzMjYDhVXvZIzflPi` +
-	`3NWsQICMOE0O+pXeJnGtdgkm7Y1Ws5X1QV9L7jI7y9sIBMbELAgMsH29gHK4uQZq/+w0DigHfGv725aapsAACAD//0QRFBhAADgAgAHe4ADIrugAAAAEANSu6AAoACwA1K7oAGwAWADUruAALELgAB` +
-	`dC6AAYACwAKERI5uAALELgAB9C4AAAQuAAN0LgADS+6ABAAAQAbERI5uAAbELgAItwAugASAB8ANSu6ABoAFwA1K7gAGhC4AALQuAAaELgACNAwMTMjETMBFzMnETMRIwEnFwE1FjMyPgE1ESM1IRE` +
-	`UDgEjIpxdWwETHgICXV3+9SYCAgJtSSc/Jq8BFDlmT1kGEPv3mpoECfnwA/ijofwovnM8jecDV5z8CPjadQAAAAADAD/+LgRBBm0ADgAgACwAa7gAMiu6AAAAAQA1K7oACgALADUrugAbABYANSu4A` +
-	`AsQuAAF0LoABgALAAoREjm4AAsQuAAH0LgAABC4AA3QuAANL7oAEAABABsREjm4ABsQuAAu3AC4ACQvugASAB8ANSu6ABoAFwA1KzAxMyMRMwEXMycRMxEjAScXATUWMzI+ATURIzUhERAOASMiEzQ` +
-	`2MzIWFRQGIyImnF1bARMeAgJdXf71JgICIU41L0Ek7QFKQ19SPMQqFBMrKxMUKgYQ+/eamgQJ+fAD+KOh+lCuLkGavwNem/wq/uTdYAfCPz4+Pz4+PgAAAAMATv4uBEEGbQAVACcAMwBjuAAyK7oAF` +
-	`QAAADUrugALAAwANSu6ACIAHQA1K7gAFRC4AALQugADAAAAIhESOboAFwAAACIREjm4ACIQuAA13AC4ACsvugAZACYANSu6AAYAHwA1K7gAHxC4ACHcuAAB0DAxMxEzFT4BMzIeARURIxE0LgEjIgY` +
-	`HEQE1FjMyPgE1ESM1IREQDgEjIhM0NjMyFhUUBiMiJk5dKHE4MlEgXQ8qGDFxJAISTjUvQSTtAUpDX1I8xCoUEysrExQqBF30gZJpqvn9kAKHoWdCuoz9df5Kri5Bmr8DXpv8Kv7k3WAHwj8+Pj8+P` +
-	`j4AAAIAbv/RBEUGEAAFABcAUbgAMiu6AAUAAgA1K7oAEgANADUruAACELgAAdy6AAcAAgASERI5uAASELgAGdwAugAJABYANSu6AAAAAQA1K7oAEQAOADUruAARELgAA9AwMSUVIREzEQU1FjMyPgE` +
-	`1ESM1IREUDgEjIgIR/l1lActtSSc/Jq8BFDlmT1mrqwYQ+puJvnM8jecDV5z8CPjadQADAG7+LgRBBm0ABQAXACMATbgAMiu6AAUAAgA1K7oAEgANADUruAACELgAAdy6AAcAAgASERI5uAASELgAJ` +
-	`dwAuAAbL7oACQAWADUrugAAAAEANSu6ABEADgA1KzAxJRUhETMRATUWMzI+ATURIzUhERAOASMiEzQ2MzIWFRQGIyImAhH+XWUB6k41L0Ek7QFKQ19SPMQqFBMrKxMUKqurBhD6m/2fri5Bmr8DXpv` +
-	`8Kv7k3WAHwj8+Pj8+Pj4AAAADAD3+LgRBBm0ABwAZACUAWbgAMiu6AAQAAwA1K7oAFAAPADUruAAEELgAANy4AAfcugAJAAMAFBESObgAFBC4ACfcALgAHS+6AAsAGAA1K7oABAABADUrugAFAAAAN` +
-	`Su6ABMAEAA1KzAxMxEjNSERMxUTNRYzMj4BNREjNSEREA4BIyITNDYzMhYVFAYjIibkpwEErs5ONS9BJO0BSkNfUjzEKhQTKysTFCoFspz6TZv+Sq4uQZq/A16b/Cr+5N1gB8I/Pj4/Pj4+AAAEAE4` +
-	`AAASQCAAACgAVAB8AJgDvuAAyK7oACwAAADUrugAGABAANSu6AB8AFgA1K0EbAAYABgAWAAYAJgAGADYABgBGAAYAVgAGAGYABgB2AAYAhgAGAJYABgCmAAYAtgAGAMYABgANXUEFANUABgDlAAYAA` +
-	`l26ABgAFgAfERI5uAAWELgAGdC4ABkvuAAfELgAG9C4ABsvugAdABYAHxESOboAIAAWAB8REjm6ACIAFgAfERI5ugAlABYAHxESObgAHxC4ACjcALgAIS+4ACUvugALAAoANSu6AAIAFAA1K7gAChC` +
-	`4ABbQuAAUELgAGNC4AAIQuAAa0LgACxC4AB3QMDEzETMyFhIVFAIGIyczMjYSNTQuASsBATUBITUhFQEhFQM3MwMjAzNOuH97PkF4f1NDZ1cmLFJeSwHxAXD+mwHa/pABd/ZGTnU+dE0GELH+mvXo/` +
-	`qG9q5IBA+Tf8IH6jKsEyZyc+zerB1io/roBRgAAAAAEAE4AAASGBk4ACgAVAB8AJgD5uAAyK7oACwAAADUrugAGABAANSu6AB8AFgA1K0EbAAYABgAWAAYAJgAGADYABgBGAAYAVgAGAGYABgB2AAY` +
-	`AhgAGAJYABgCmAAYAtgAGAMYABgANXUEFANUABgDlAAYAAl26ABgAFgAfERI5uAAWELgAGdC4ABkvuAAfELgAG9C4ABsvugAdABYAHxESOboAIAAWAB8REjm6ACIAFgAfERI5ugAlABYAHxESObgAH` +
-	`xC4ACjcALgAIS+4ACUvugALAAoANSu6AAIAFAA1K7oAGwAcADUruAAKELgAFtC4ABwQuAAY0LgACxC4AB3QugAgABQAAhESOTAxMxEzMhYSFRQCBiMnMzI2EjU0LgErAQE1ASE1IRUBIRUDNzMDIwM` +
-	`zTrh/ez5BeH9TQ2dXJixSXksB9gFX/rEByv6nAWTsRk51PnRNBhCx/pr16P6hvauSAQPk3/CB+oyrAxebm/zpqwWmqP66AUYAAAQAOf/hBIYGTgASAB4AKAAvAQu4ADIrugAZAAYANSu6ABEAEgA1K` +
-	`7oAKAAfADUruAASELgADtC4ABIQuAAT0EEbAAYAGQAWABkAJgAZADYAGQBGABkAVgAZAGYAGQB2ABkAhgAZAJYAGQCmABkAtgAZAMYAGQANXUEFANUAGQDlABkAAl26ACEAHwAoERI5uAAfELgAItC` +
-	`4ACIvuAAoELgAJNC4ACQvugAmAB8AKBESOboAKQAfACgREjm6ACsAHwAoERI5ugAuAB8AKBESObgAKBC4ADHcALgADy+4ACovuAAuL7oAHAADADUrugAgAB8ANSu6ACQAJQA1K7gAJBC4AA7QuAAfE` +
-	`LgAEdC4ACUQuAAh0LgAIBC4ACbQMDElDgEjIiY1NBI2MzIXFhcRMxEjGQEmIyICFRQWMzI2ATUBITUhFQEhFQM3MwMjAzMBwyt3MkZwSnxcGjYIEF1dMipWcz8oK2kBEAFX/rEByv6nAWTsRk51PnR` +
-	`N+4yO8vbCATGxCwIDAfH5sgHYAd8a/vblpqmw/r2rAxebm/zpqwWmqP66AUYAAAADAE4AAASQBhAACgAVAB8AybgAMiu6AAsAAAA1K7oABgAQADUrugAfABYANStBGwAGAAYAFgAGACYABgA2AAYAR` +
-	`gAGAFYABgBmAAYAdgAGAIYABgCWAAYApgAGALYABgDGAAYADV1BBQDVAAYA5QAGAAJdugAYABYAHxESObgAFhC4ABnQuAAZL7gAHxC4ABvQuAAbL7oAHQAWAB8REjm4AB8QuAAh3AC6AAsACgA1K7o` +
-	`AAgAUADUruAAKELgAFtC4ABQQuAAY0LgAAhC4ABrQuAALELgAHdAwMTMRMzIWEhUUAgYjJzMyNhI1NC4BKwEBNQEhNSEVASEVTrh/ez5BeH9TQ2dXJixSXksB8QFw/psB2v6QAXcGELH+mvXo/qG9q` +
-	`5IBA+Tf8IH6jKsEyZyc+zerAAMATgAABIYGEAAKABUAHwDJuAAyK7oACwAAADUrugAGABAANSu6AB8AFgA1K0EbAAYABgAWAAYAJgAGADYABgBGAAYAVgAGAGYABgB2AAYAhgAGAJYABgCmAAYAtgA` +
-	`GAMYABgANXUEFANUABgDlAAYAAl26ABgAFgAfERI5uAAWELgAGdC4ABkvuAAfELgAG9C4ABsvugAdABYAHxESObgAHxC4ACHcALoACwAKADUrugACABQANSu6ABsAHAA1K7gAChC4ABbQuAAcELgAG` +
-	`NC4AAsQuAAd0DAxMxEzMhYSFRQCBiMnMzI2EjU0LgErAQE1ASE1IRUBIRVOuH97PkF4f1NDZ1cmLFJeSwH2AVf+sQHK/qcBZAYQsf6a9ej+ob2rkgED5N/wgfqMqwMXm5v86asAAwA5/+EEhgZOABI` +
-	`AHgAoAOW4ADIrugAZAAYANSu6ABEAEgA1K7oAKAAfADUruAASELgADtC4ABIQuAAT0EEbAAYAGQAWABkAJgAZADYAGQBGABkAVgAZAGYAGQB2ABkAhgAZAJYAGQCmABkAtgAZAMYAGQANXUEFANUAG` +
-	`QDlABkAAl26ACEAHwAoERI5uAAfELgAItC4ACIvuAAoELgAJNC4ACQvugAmAB8AKBESObgAKBC4ACrcALgADy+6ABwAAwA1K7oAIAAfADUrugAkACUANSu4ACQQuAAO0LgAHxC4ABHQuAAlELgAIdC` +
-	`4ACAQuAAm0DAxJQ4BIyImNTQSNjMyFxYXETMRIxkBJiMiAhUUFjMyNgE1ASE1IRUBIRUBwyt3MkZwSnxcGjYIEF1dMipWcz8oK2kBEAFX/rEByv6nAWT7jI7y9sIBMbELAgMB8fmyAdgB3xr+9uWmq` +
-	`bD+vasDF5ub/OmrAAAAAAEAev7QBFsGEAAVAIm4ADIrugATAAMANStBBQDaAAMA6gADAAJdQRsACQADABkAAwApAAMAOQADAEkAAwBZAAMAaQADAHkAAwCJAAMAmQADAKkAAwC5AAMAyQADAA1duAA` +
-	`TELgAENC4ABAvuAATELgAF9wAugAQABUANSu6AA0ADgA1K7gAEBC4AAfcuAAOELgACtAwMQE+ATU0JyYnITUBITUhFQEhFRYVFAcDBlpvHAMD/M0C4f02A7P9IALvCJT+0CqWMB8cAwKrBMmcnPs3p` +
-	`hgceYgAAAEAhf7ZBD8EXQAUACu4ADIrALoADgAUADUrugALAAwANSu4AA4QuAAP3LgABdC4AAwQuAAI0DAxAT4BNTQnITUBITUhFQEhFSMWFRQHAuhabxj87AKt/WMDk/1OAskGBJT+2SqWMB0aqwM` +
-	`Xm5v86asSFHmIAAAAAAEArAAABIsGPgAkAKW4ADIruAAlL7gAJi+4ACUQuAAG0LgABi+4AAHcuAAL0LgAJhC4AB3cuAAR3EEFANoAEQDqABEAAl1BGwAJABEAGQARACkAEQA5ABEASQARAFkAEQBpA` +
-	`BEAeQARAIkAEQCZABEAqQARALkAEQDJABEADV24AAEQuAAU0LgAFC+4AAEQuAAa0LgAGi8AugACAAMANSu6ABoAFAA1K7gAAhC4AAXQMDEBESEVITUhETQ+AT8BNj8BNjU0JiMiBgc1NjMyFhUUDwM` +
-	`OAQK5AWf8jAFTHE9PPhIiQlR4h1e1ebnK4OuYQk5HJzwB6P6zm5sBQlNvd1RCEytTaExGbC9EtFq3kpCbRFFJLHIAAAACATn/0QOUBj4AAwAHADe4ADIruAAIL7gACS+4AAPcuAAA3LgACBC4AATQu` +
-	`AAEL7gAB9wAuAABL7gABS+4AAAvuAAELzAxBREzESERMxEC8aP9paMvBm35kwZt+ZMAAAABAHb+LwRABHwAGQBluAAyK7oAEwAGADUrQRsABgATABYAEwAmABMANgATAEYAEwBWABMAZgATAHYAEwC` +
-	`GABMAlgATAKYAEwC2ABMAxgATAA1dQQUA1QATAOUAEwACXQC6ABcAAgA1K7oACgAPADUrMDEBBiMiJAI1NBIkMzIXFSYjIgYCFRQSFjMyNwRAroq2/s6qqAEyuIquoZ94x3p+xHeeov5wQcMBcPPxA` +
-	`XDGQbhdpP7Tur3+0Z9dAAMAIf/RBK0GPgANABAAFQBTuAAyKwC4AAcvuAAAL7oADgAMADUrugACAAAABxESOboAAwAAAAcREjm6AAYAAAAHERI5ugAKAAAABxESOboAEQAAAAcREjm6ABMAAAAHERI` +
-	`5MDEXIzcjATMbATMJASMDISULARMHAwEn2LccHAHezV7Ssf66AUbVav5RAYJmvz4noAEKFy8vBhD+0AFe/d375QFVmwFB/r8C6Zz9/AG8SAAAAAIAIf/RBK0GPwAgACkAdbgAMiu6ACUABgA1K0EbA` +
-	`AYAJQAWACUAJgAlADYAJQBGACUAVgAlAGYAJQB2ACUAhgAlAJYAJQCmACUAtgAlAMYAJQANXUEFANUAJQDlACUAAl0AuAAKL7gADS+6ABcAHAA1K7gAHBC4AADQuAANELgAIdwwMRcjEyYnJjU0EiQ` +
-	`zMhc3MwcVJicBFhcWMzI3FQYjIicmJwEiBgIVFBcBJti3xg8NVagBMrhmeRWxbQ8P/ZQxQGJ3nqKuiraZPTMBt3jHeiUCJ0ovAUobHsHz8QGAxiQjt0EICPvvVjRPXbdBYSc2BRSk/sO6kIADlxQAA` +
-	`AACAJb/OASPBRIAHgAnAI24ADIrugAjAAYANStBGwAGACMAFgAjACYAIwA2ACMARgAjAFYAIwBmACMAdgAjAIYAIwCWACMApgAjALYAIwDGACMADV1BBQDVACMA5QAjAAJdALgAAC+4AAwvugAWABw` +
-	`ANSu6AAkAHwA1K7oAEQAAAAwREjm6ABkAAAAMERI5uAAfELgAJtC4ACYvMDEFIxMmJyY1NAAzMhc3MwcWFxUmJwEWMzI2NxUGIyInASIOARUUFwEmAU23uDkoSQFL6lJWXbFxFBU/Ov5YVHs9dp+7q` +
-	`Zp6ARtmtGJHAXskyAFIOEiFqf4BUA+lygYHsxwU/QczETazP0EDv2XWcYN5AqMFAAEABwAABCEGEAANAEO4ADIrugAFAAIANSu4AAUQuAAI0LgAAhC4AAzQALgAAy+6AAoACwA1K7oAAQAAADUruAA` +
-	`BELgABdC4AAAQuAAH0DAxEzUzETMRMxUjESEVIREH1MrU1AJ8/LoC75sChv16m/28qwLvAAAAAAIAIf/RBK0GPgAQABMAU7gAMiu6ABAAAAA1K7gAABC4AATQuAAQELgAEdAAuAACL7oACQAOADUru` +
-	`gAHAAYANSu4AA4QuAAF0LgABxC4AAvQuAAOELgAEtC4AAYQuAAT0DAxIREBIwERITUhNzMHMxUjARkBEyMCAf7XtwHg/i8DsByxHAxy/qCvrwHD/g4DIAJ0qy4uq/2w/OsEQQEkAAADACH/4QSsBk4` +
-	`AIAAsADgBBLgAMiu6ADMAFgA1K7oADgAPADUrugAGACcANSu4AA4QuAAA0LgADxC4AB7QuAAOELgAIdBBBQDaACcA6gAnAAJdQQkAmQAnAKkAJwC5ACcAyQAnAARdQRMACQAnABkAJwApACcAOQAnA` +
-	`EkAJwBZACcAaQAnAHkAJwCJACcACV24AA8QuAAt0EEbAAYAMwAWADMAJgAzADYAMwBGADMAVgAzAGYAMwB2ADMAhgAzAJYAMwCmADMAtgAzAMYAMwANXUEFANUAMwDlADMAAl24AAYQuAA63AC4AB8` +
-	`vugAkABMANSu6ABoAMAA1K7gAMBC4ACrQuAAqL7gAJBC4ADbQuAA2LzAxAT4BMzIWFRQCBiMiJyYnIzUOASMiJjU0EjYzMhcWFxEzGQEWMzISNTQmIyIGAxEmIyICFRQWMzI2AqQ5nUJclGKkeSJHC` +
-	`xV7OJ5CXZNipHgjRwsVe0M4b5lTNTqJs0I4cZdSNTmLA2KMjvL2wv7PsQsCA/uMjvL2wgExsQsCAwHx/Df+IRoBCeanqLD+vgHfGv725aapsAAAAAADACH+TgSsBHwAIAAsADgBD7gAMiu6ADMAFwA` +
-	`1K7oADwAQADUrugAGACcANSu4AA8QuAAA0LgADxC4ACHQQQUA2gAnAOoAJwACXUEbAAkAJwAZACcAKQAnADkAJwBJACcAWQAnAGkAJwB5ACcAiQAnAJkAJwCpACcAuQAnAMkAJwANXbgAEBC4AC3QQ` +
-	`RsABgAzABYAMwAmADMANgAzAEYAMwBWADMAZgAzAHYAMwCGADMAlgAzAKYAMwC2ADMAxgAzAA1dQQUA1QAzAOUAMwACXbgABhC4ADrcALgAAy+4AA8vugAbADAANSu6ACQAFAA1K7oAAAAPAAMREjm` +
-	`6ABEADwADERI5uAAwELgAKtC4ACovuAAkELgANtC4ADYvMDEBPgEzMhYVFAIGIyInJicRIxEOASMiJjU0EjYzMhcWFzMZARYzMhI1NCYjIgYDESYjIgIVFBYzMjYCpDmdQlyUYqR5IkcLFXs4nkJdk` +
-	`2KkeCNHCxV7QzhvmVM1OomzQjhxl1I1OYsDYoyO8vbC/s+xCwID/k4CrYyO8vbCATGxCwID/ij+IRoBCeanqLD+vgHfGv725aapsAAAAAABAJH/4QRHBhAAHACBuAAyK7oADAAYADUruAAMELgABdC` +
-	`4AAUvQQUA2gAYAOoAGAACXUEbAAkAGAAZABgAKQAYADkAGABJABgAWQAYAGkAGAB5ABgAiQAYAJkAGACpABgAuQAYAMkAGAANXbgADBC4AB7cALoAFAAPADUrugAFAAYANSu4AAYQuAAC0DAxASMBI` +
-	`TUhFQEWFx4BFRQEIyInNRYzMj4BNTQnJicCv+oBhv02A7P+0A8Pg5L+teqtwcOaZrRiXzEuAugCjJyc/gMICU2lqf7sQbNZZXJxl1cVIgAAAAAEAGj/0QR1Bj4AIAAsAE0AWQCTuAAyK7oAFwARADU` +
-	`rugBEAD4ANSu4ABcQuAAL3LoAJAARABcREjm4ACQvuAAg3LgAJBC4ACrcuABEELgAONy6AFEAPgBEERI5uABRL7gATdy4AFEQuABX3LgARBC4AFvcALoAJwAhADUrugAUAA4ANSu4AA4QuAA70LgAF` +
-	`BC4AEHQuAAhELgATtC4ACcQuABU0DAxEzU0PgE/ATY/ATY1NCYjIgYHNTYzMhYVFA8DDgEdAQMiJjU0NjMyFhUUBgE1ND4BPwE2PwE2NTQmIyIGBzU2MzIWFRQPAw4BHQEDIiY1NDYzMhYVFAbJDic` +
-	`oHwkRISo8RCtbPFxlcHZMISckEx4qHSsrHR0rKwIWDignHwkRISo8QyxaPV1lcHVMIScjFB4qHSoqHR4qKwG6I1Nvd1RCEytTaExGbC9EtFq3kpCbRFFJLHJmLv4XVTs7VFM8PFQB6SNTb3dUQhMrU` +
-	`2hMRmwvRLRat5KQm0RRSSxyZi7+F1U7O1RTPDxUAAAAAQDjAicD6wNdAAMAD7gAMisAugABAAAANSswMRMRIRHjAwgCJwE2/soAAAAHAEMAAASKBhAAJwAzADcAQwBRAF0AbwJZuAAyK7oAOwAxADU` +
-	`rugBVABcANSu6ACsAQQA1K7oARwBbADUrugBmAE0ANSu6AAMAbQA1K7oACgBNAGYREjlBGwAGAEcAFgBHACYARwA2AEcARgBHAFYARwBmAEcAdgBHAIYARwCWAEcApgBHALYARwDGAEcADV1BBQDVA` +
-	`EcA5QBHAAJdugARAFsARxESOboAHQBbAEcREjm6ACQATQBmERI5QRsABgArABYAKwAmACsANgArAEYAKwBWACsAZgArAHYAKwCGACsAlgArAKYAKwC2ACsAxgArAA1dQQUA1QArAOUAKwACXbgAMRC` +
-	`4ADTQuABmELgANtC4ADYvQRsABgA7ABYAOwAmADsANgA7AEYAOwBWADsAZgA7AHYAOwCGADsAlgA7AKYAOwC2ADsAxgA7AA1dQQUA1QA7AOUAOwACXUEbAAYAVQAWAFUAJgBVADYAVQBGAFUAVgBVA` +
-	`GYAVQB2AFUAhgBVAJYAVQCmAFUAtgBVAMYAVQANXUEFANUAVQDlAFUAAl24AGYQuABi0LgAYi+4AGYQuABk0LgAZC9BBQDaAG0A6gBtAAJdQRsACQBtABkAbQApAG0AOQBtAEkAbQBZAG0AaQBtAHk` +
-	`AbQCJAG0AmQBtAKkAbQC5AG0AyQBtAA1duAADELgAcdwAugBqAAYANSu6ACgAOAA1K7oAIABEADUrugA+AC4ANSu4ACAQuAAA0LgABhC4AA7QuAAGELgAFNC4ACAQuAAa0LgAahC4AErQuABKL7gAR` +
-	`BC4AFLQuABqELgAWNC4AFgvuABEELgAXtAwMQEyFhUUBiMiJyYnBgcGIyImJw4BIyImNTQ2MzIWFz4BMzIXFhc2NzYBMhYVFAYjIiY1NDYDNQEVJSIGFRQWMzI2NTQmASIGFRQWMzI2PQEmJyYhIgY` +
-	`VFBYzMjY1NCYhIgcGBxQVFAcWFxYzMjY1NCYD0VBpaVBQNAUFBgY1TCFMIxlHLk9pZlYrRhkhRiZRNAYEBgU0/XpQaWlQT2lpaQMp/Y84Li44OS4uAX44Li44OC8CFRj+rzkuLjk4Li4CADgXEgQBB` +
-	`RIXODguLgLKt66ut1wJCgsLWSdLOTm2r6m8ODlELVwKCgoKXANGt66ut7itrbj7uX8DJoO4nVtcnZteXZv8upxcXJyaXg5WR02cXFycml5emk87QxUWFRRFPU6bXl2bAAEAUAUIBH0FhAADAA+4ADI` +
-	`rALoAAQAAADUrMDETNSEVUAQtBQh8fAABAJj+vwQ1BNEABwAduAAyKwC4AAIvuAAFL7gAAC+6AAQAAgAAERI5MDEJAiMLASMBBDX+DgERm8PDmwMDBNH8FP3aAYr+dgYSAAAAAQCkAAAEKAOrABEAS` +
-	`7gAMisAuAAIL7gAAC+6AAEAAAAIERI5ugAEAAAACBESOboABwAAAAgREjm6AAoAAAAIERI5ugANAAAACBESOboAEAAAAAgREjkwMSETBSctATcFAzMDJRcNAQclEwIHFf7lXQEz/s1dARsVvBoBIV7` +
-	`+zAE0Xv7fGgFbvqKWmJ+9AVz+pL2fmJaivv6lAAAAAgGo/k4DJAR8ABIAHgCFuAAyK7oACgAEADUrQRsABgAKABYACgAmAAoANgAKAEYACgBWAAoAZgAKAHYACgCGAAoAlgAKAKYACgC2AAoAxgAKA` +
-	`A1dQQUA1QAKAOUACgACXbgAChC4AADQuAAAL7gAChC4ABvQuAAR0LgAES+4AAoQuAAV3AC4AAAvugAYABIANSswMQEuAjU0NjMyFhUUBiMUHgEXFQMiJjU0NjMyFhUUBgMkd6VgelRBYlhdLUtIrkN` +
-	`gYUJCYWD+Tg+Kw25+kV9FPGlNbUcTfAToYENEX19EQ2AAAgCk/qsEKAY+ABEAIwCHuAAyKwC4AAgvuAASL7oAAQASAAgREjm6AAQAEgAIERI5ugAHABIACBESOboACgASAAgREjm6AA0AEgAIERI5u` +
-	`gAQABIACBESOboAEwASAAgREjm6ABYAEgAIERI5ugAZABIACBESOboAHAASAAgREjm6AB8AEgAIERI5ugAiABIACBESOTAxARMFJy0BNwUDMwMlFw0BByUTAxMFJy0BNwUDMwMlFw0BByUTAgcV/uV` +
-	`dATP+zV0BGxW8GgEhXv7MATRe/t8avBX+5V0BM/7NXQEbFbwaASFe/swBNF7+3xoCkwFbvqKWmJ+9AVz+pL2fmJaivv6l/BgBW76ilpifvQFc/qS9n5iWor7+pQACAFX+NAR4/9wAAwAHABe4ADIrA` +
-	`LoAAQAAADUrugAFAAQANSswMRM1IRUBNSEVVQQj+90EI/40fHwBLHx8AAAAAQCL/nYEQQQ+AAkAJbgAMisAuAABL7oACAAFADUruAAIELgAANC6AAMABQAIERI5MDEBAyMTBiMiJzUEBEHfhqmRwsb` +
-	`hAf8EPvo4BQ8iIrkZAAAABAAa/9EEsgY+ACAALAAwADwBJbgAMiu6ACoAJAA1K7oAFwALADUrugA6ADQANStBBQDaAAsA6gALAAJdQRsACQALABkACwApAAsAOQALAEkACwBZAAsAaQALAHkACwCJA` +
-	`AsAmQALAKkACwC5AAsAyQALAA1dQRsABgAqABYAKgAmACoANgAqAEYAKgBWACoAZgAqAHYAKgCGACoAlgAqAKYAKgC2ACoAxgAqAA1dQQUA1QAqAOUAKgACXUEFANoANADqADQAAl1BGwAJADQAGQA` +
-	`0ACkANAA5ADQASQA0AFkANABpADQAeQA0AIkANACZADQAqQA0ALkANADJADQADV24ADQQuAAu0LgALi+4ADoQuAA+3AC6ACcAIQA1K7oAFAAOADUruAAhELgAMdC4ACcQuAA30DAxEzU0PgE/ATY/A` +
-	`TY1NCYjIgYHNTYzMhYVFA8DDgEdAQMiJjU0NjMyFhUUBgEDMwsBIiY1NDYzMhYVFAbJGUdHOBAfO0xsek6jbae1ytSJPEZAIzZLNUxMNTVMTQKLKd8rPjVMTDU1TE0BuiNTb3dUQhMrU2hMRmwvRLR` +
-	`at5KQm0RRSSxyZi7+F1U7O1RTPDxUAekEVvuq/hdVOztUUzw8VAAAAAAEACP/0QSqBj4AIAAsADAAPAEvuAAyK7oAOgA0ADUrugAXAAsANSu6ACoAJAA1K0EFANoACwDqAAsAAl1BGwAJAAsAGQALA` +
-	`CkACwA5AAsASQALAFkACwBpAAsAeQALAIkACwCZAAsAqQALALkACwDJAAsADV26ABEANAAXERI5QQUA2gAkAOoAJAACXUEbAAkAJAAZACQAKQAkADkAJABJACQAWQAkAGkAJAB5ACQAiQAkAJkAJAC` +
-	`pACQAuQAkAMkAJAANXbgANBC4AC7QuAAuL0EbAAYAOgAWADoAJgA6ADYAOgBGADoAVgA6AGYAOgB2ADoAhgA6AJYAOgCmADoAtgA6AMYAOgANXUEFANUAOgDlADoAAl24ABcQuAA+3AC6ACcAIQA1K` +
-	`7oAFAAOADUruAAhELgAMdC4ACcQuAA30DAxATU0PgE/ATY/ATY1NCYjIgYHNTYzMhYVFA8DDgEdAQMiJjU0NjMyFhUUBgEDMwsBIiY1NDYzMhYVFAYCXxlHSDcRHjxLbHlPo2ymtsnUiTtGQCM2TDR` +
-	`NTTQ1TU79aCnfKj41TU01NExNAbojU293VEITK1NoTEZsL0S0WreSkJtEUUkscmYu/hdVOztUUzw8VAHpBFb7qv4XVTs7VFM8PFQAAQA4AXQElQLpAB4AF7gAMisAugALABQANSu6AAQAGQA1KzAxE` +
-	`zU0NjMyHgEfARYzMjY9ATMVFAYjIi8BJiMiDgEdATibgyE/UFB5b0ZKR4C9amOjZnRHKEUqAZMSg8ENHy1DPWs/EBCnn107QipcIxIAAAABACIAAASrAfwAEQAXuAAyKwC4AAAvuAAIL7oABAANADU` +
-	`rMDEzPgEkMzIEFhcjLgIjIg4BByIKoAESiYgBEaALWgyK2Xt82IwMn9yBgdugeK5jY654AAAAAQAiAAAEqwH8ABEAF7gAMisAuAAAL7gACC+6AAQADQA1KzAxEx4CMzI+ATczDgEEIyIkJid7DIzYf` +
-	`HvZigxaC6D+74iJ/u6gCgH8eK5jY654oNuBgdyfAAEAIgRDBKsGPwARABe4ADIrALgAAC+4AAgvugAEAA0ANSswMRM+ASQzMgQWFyMuAiMiDgEHIgqgARKJiAERoAtaDIrZe3zYjAwEQ5/cgYHboHi` +
-	`uY2OueAACACIAAASrBj8AEQAjABe4ADIrALoAHwAWADUrugAEAA0ANSswMRM+ASQzMgQWFyMuAiMiDgEHAx4BBDMyJDY3Iw4CIyIuASciCqABEomIARGgC1oMitl7fNiMDFkKoAESiYgBEaALWgyK2` +
-	`Xt82IwMBEOf3IGB26B4rmNjrnj9uZ/cgYHboHiuY2OueAAAAAABAOMCJwPrAsIAAwAPuAAyKwC6AAEAAAA1KzAxEzUhFeMDCAInm5sAAQEcAvcDsQdxABcAV7gAMiu4ABgvuAAZL7gAGBC4AADQuAA` +
-	`AL7gAF9y4AALQuAAZELgADdy6AAMAAAANERI5uAAO3AC4AAAvuAANL7gAAS+6AAYAEwA1K7oAAwAAAAEREjkwMQERMxE+ATMyFhceARURIxE0LgEjIgYHEQEchDmgUUNwFhENhBY7I0WgNAL3BHr98` +
-	`ltoRTElVYT+RQHLc0kvhGT+MgAAAQEcAvcDsQdmACMAY7gAMiu4ACQvuAAlL7gAJBC4AADQuAAAL7gAJRC4ABjcuAAb3LgAB9C6AAgAAAAYERI5uAAAELgAI9y4AA7QugAPAAAAGBESOQC4AAAvuAA` +
-	`ZL7oABQAKADUrugASAB8ANSswMQERND4BMzIXFSYjIg4BHQE+ATMyFhceARURIxE0LgEjIgYHEQEcX4d1VWFvTEJcNDmgUUNwFhENhBY7I0WgNAL3AsXJnUQUeyEvbYhxW2hFMSVVhP5FActzSS+EZ` +
-	`P4yAAIBUwGsA3oHhwARAB0AI7gAMiu6AAwABwA1KwC4ABUvugADABAANSu6AAsACAA1KzAxATUWMzI+ATURITUhERQOASMiATQ2MzIWFRQGIyImAVNvTEJcNP6vAdVfh3VVARY8HBw8PBwcPAHAfCE` +
-	`ubYgCZG79R8qdRAWCLSwsLSwsLAAAAAEBPQL3A48GJgARAFe4ADIruAASL7gAEy+4ABIQuAAA0LgAAC+4ABHcuAAC0LgAExC4AAncugADAAAACRESObgACtwAuAAAL7oABgANADUruAAGELgACty6A` +
-	`AMABgAKERI5MDEBETMVPgEzMhcRIzUmIyIGBxEBPYQ9oGJIR38hE0qOQwL3AxmtYmEW/uK1BnV0/jMAAAAAAQE9AuEDjwYQABEATbgAMiu4ABIvuAATL7gAAdy4AALcuAASELgACNC4AAgvuAAL3Lg` +
-	`AAhC4ABDQALgAAC+6AAoABgA1K7oAAwAGAAoREjm4AAYQuAAN3DAxAREjNQ4BIyInETMVFjMyNjcRA4+EPaBiSEd/IRNKjkMGEPznrWJhFgEetQZ1dAHNAAABAHcBrARWBhAAHwBDuAAyK7gAIC+4A` +
-	`CEvuAAB3LgAENy4ACAQuAAW0LgAFi+4ABncuAAQELgAHtAAuAAAL7oABQAKADUrugAbABQANSswMQERFB4BMzI3FQYjIiYnJicjNQ4BIyInETMVFjMyNjcRAsk0XUJMbmFUdoYwJggCPaBiSEd/IRN` +
-	`KjkMGEP0uiG0uIXwURE8/j5diYRYBHrUGdXQBzQAAAAACAPAC9wPdBhAADwAZAKm4ADIruAAaL7gAGy+4ABoQuAAM0LgADC+4ABsQuAAH3LoAAwAMAAcREjm4AAwQuAAQ3LgADtC4AAcQuAAU3EEFA` +
-	`NoAFADqABQAAl1BGwAJABQAGQAUACkAFAA5ABQASQAUAFkAFABpABQAeQAUAIkAFACZABQAqQAUALkAFADJABQADV0AuAABL7gADS+6ABEACwA1K7oAAAAYADUrugADABgAABESOTAxCQEzAR4CFRQ` +
-	`OASMhETMZATMyNjU0LgEnIwIPASOr/sFSWDFKiJH+2o9rlXEiQkTJBMABUP6NIEldLjJWKgMZ/rD+h0I3Hz83GgAAAAEAwAL3BAwGEAATADm4ADIrALgAAS+4AAYvuAALL7gAAC+4AA0vugAEAAAAA` +
-	`RESOboACQAAAAEREjm6ABEAAAABERI5MDEBAzMTFzcTMxMXNxMzAyMDJyMHAwFBgXtLFBN/do8ZEExmgXuYGAQWhAL3Axn+HXBwAeP+HXBwAeP85wH+cXD+AQABAMkBrAQEBhAAEgAXuAAyKwC4AAE` +
-	`vuAAEL7oADgAJADUrMDEJATMBEzMBDgEjIic1FjMyPgE3AkT+kpEBJPSF/sxhr4s9LzYyQGNTEQMXAvn9rQJT/R/qmQt0ETJ+LgABAHgCTAQdA5IAFQAbuAAyKwC6AAEAFQA1K7gAFRC4AA/QuAAF3` +
-	`DAxEwEzHgEzMjcmHgI1DgEjIiYnJicHeAEo6AliPT0wAScxKSx+Q0N/LRkP9wJMAUZNdTsDDhQPAUROTkQmNOwAAAIAJv/gBKcEvgAuADYAYbgAMiu6ADAAJgA1K7gAJhC4AADQuAAAL7oALgAmADA` +
-	`REjkAugA0ACMANSu6AAIALAA1K7oACwAVADUrugAoAC8ANSu6AAYALAACERI5uAAVELgACNy6ABoALAACERI5MDETNjMyFxYXNzMeATMyNyYeAjUOASMiJicmJwcWFxYVFAIGIyICETUhNC4BIyIHA` +
-	`SEVFBYzMjYyno1rVSEciKUGRissIgEcIx0gWTAvWiASC6UJCDNhrWqOqwIjRXBIgJoCD/51X09RdQQSakseKNNNdTsDDhQPAUROTkQmNN8UF4ufmf7mnQEcASgodMBgeP5ZG4mipQAAAAMASf/hBIM` +
-	`GTgAXACMAJgC1uAAyK7gAJy+4ACgvuAAm3LgAANy4ACcQuAAG0LgABi+4AAAQuAAO0LgAJhC4ABDQuAAAELgAGNC4AAYQuAAe3EEbAAYAHgAWAB4AJgAeADYAHgBGAB4AVgAeAGYAHgB2AB4AhgAeA` +
-	`JYAHgCmAB4AtgAeAMYAHgANXUEFANUAHgDlAB4AAl0AuAAPL7oAIQADADUrugAVABYANSu6ABIAEwA1K7gAEhC4AA7QuAATELgAJNAwMSUOASMiJjU0EjYzMhcWFxEzESEVASEVIRkBJiMiAhUUFjM` +
-	`yNgEhEQIiM5A8VIZZlW4gQAkUcAHj/mIBrP2fPDNniUswNH4B8f6x+4yO8vbCATGxCwIDAfH+D5v86asB2AHfGv725aapsAJ//XoAAgBa/+EEcwXCACkASgDXuAAyK7oAKgAGADUrugAaADEANSu6A` +
-	`CMAPQA1K7gABhC4AArQuAAqELgADNBBBQDaAD0A6gA9AAJdQRsACQA9ABkAPQApAD0AOQA9AEkAPQBZAD0AaQA9AHkAPQCJAD0AmQA9AKkAPQC5AD0AyQA9AA1dugAVAD0AIxESOboANQAxABoREjm` +
-	`4ACMQuABM3AC4AAsvugAuAAIANSu6ABIAFwA1K7oACgAHADUruAAKELgADdC6ABUABwAKERI5uAACELgAJtC4AC4QuAA60LgABxC4AEnQMDEFBiMiLgE1ESM1MxEzESE2NzYzMhcVJiMiBhUUFxYfA` +
-	`RYXFhUUBiMiJwYDFB4BMzI3NRYXNjcVFhcWMzI2NTQnJi8CJicmNTQ3IwI7IyFGdTunp3ABHgkMQY9rYG5hR1AjIk5LaCoqhpNkaDfmJkAsJikjJQoJGRpLNVRLEhFFJ019ICEB9RQLWrC4AcKbAcL` +
-	`+PhIRWTSpQlM+PCQkLy4/Skpvkbs2IwGyh280E3EmGwkJHhAKHVtELSAgLxozUUVEYhMSAAABAH7+LgRPBm0AOwCnuAAyK7oAFAAVADUrugAQABEANSu6ADQABwA1K7gAEBC4AADQugABABUANBESO` +
-	`bgAFRC4ABnQugAhABUANBESObgAFBC4ACfQuAARELgAKdC4ABAQuAAr0LoALAAVADQREjm4ADQQuAA93AC6AAMAOgA1K7oAHgAjADUrugAvAAwANSu6ACkAEgA1K7gAEhC4ABbQuAApELgAGNC6ACw` +
-	`AEgApERI5MDEBNRYzMj4BNRE0LgEjIgYHESMRIxEjESM1MzU0PgEzMhcVJiMiDgEdATM1MxU+ATMyHgEVESMGBw4BIyICkF5AOE4rEjIdO4cscJ1wlZU8fWhOUFdDNlEunXAwiEM8YScDCB0ocmNI/` +
-	`kquLkGavwIjoWdCuoz9dQNl/JsDZZs03dSIG7EwQpWySF30gZJpqvn9kLFSb2AAAAIAf/4uBE0GPgAsADUAl7gAMiu4ADYvuAA3L7gANhC4ABbQuAAWL7gALdy4AAbQuAAGL7oABwAWAC0REjm4ADc` +
-	`QuAAs3LgAHty4AA7QuAAOL7gAFhC4ABrQuAAtELgAHNC4AB4QuAAz0AC6AAkABAA1K7oAIwAoADUrugAxABIANSu6ABoAFwA1K7oADgASADEREjm4ABoQuAAd0LgAFxC4ADTQMDElEA4BIyInNRYzM` +
-	`jY3NjcGBwYjIi4BNREjNTMRMxEhEjc+ATMyFxUmIyIOARUBFB4BMzI3ESEDH0hmWkBKVDoyRhQQAzweIyFGdTunp3ABJQIiJGdZQEpUOjJGKP53JkAsRk3+24f+5N1gHK4uQU0+hicJC1qwuAHCmwH` +
-	`C/j4BBmlvYByuLkGav/2eh280QAKpAAEAqf/hBCMGTgAzAOW4ADIrugAEAAMANSu6ACUAGgA1K7oALgAQADUruAAEELgAANy6AAcAAwAuERI5QQUA2gAQAOoAEAACXUEbAAkAEAAZABAAKQAQADkAE` +
-	`ABJABAAWQAQAGkAEAB5ABAAiQAQAJkAEACpABAAuQAQAMkAEAANXbgAGhC4ACDcQRsABgAlABYAJQAmACUANgAlAEYAJQBWACUAZgAlAHYAJQCGACUAlgAlAKYAJQC2ACUAxgAlAA1dQQUA1QAlAOU` +
-	`AJQACXbgALhC4ADXcALoADQAxADUrugAEAAEANSu6AB0AIgA1KzAxIREjNSERMzUWFzMVFjMyNjU0JyYvAiYnJjU0NjMyFxUmIyIGFRQXFh8BFhcWFRQGIyInAXHIATghQkgmNSlUSxIRRSdNfSAhg` +
-	`I9rYG5hR1AjIk5LaCoqhpNLTgWynPpNZUceDhFbRC0gIC8aM1FFRGKKsjSpQlM+PCQkLy4/SkpvkbsfAAABAEwAAASBBk4ADwA/uAAyK7oABAADADUruAAEELgAANwAugAHAAAANSu6AAQAAQA1K7o` +
-	`ACwAMADUruAAMELgACNC4AAcQuAAN0DAxIREjNSERMzUBITUhFQEhFQEUyAE4wAGb/m8CJf5iAawFspz6TRADF5ub/OmrAAAAAQBH/g8EhQRdACUAYbgAMiu4ACUvuAAmL7gAJRC4AAHQuAABL7gAD` +
-	`9y4ACYQuAAY3LgAF9y4ABrQugAbAAEAGBESObgAARC4ACPQuAAjLwC4ABkvugAKAAUANSu6ABMAHgA1K7gAChC4ABfQMDEBNTQuASMiBzU2MzIeAREVFB4BMzI2NxEzESMRDgEjIiYnJicmJwHfNV9` +
-	`ETnJkV3iKYxY8JEekNYiIOqRTRXIYEQcFAQFxtr+aQS6uHGDd/uQuoWdCuowCi/myAuWBkmFFNTslVQAB/3v+LgVSBF0ANABxuAAyK7gANS+4ADYvuAAB3LgAD9y4ABHQuAA1ELgAHtC4AB4vugASA` +
-	`B4AARESObgAGtC4ABovuAAeELgAHNC4AB4QuAAs3LgADxC4ADPQALoABQAKADUrugAnACIANSu6ADAAFQA1K7gAJxC4AADQMDEBERQeATMyNxUGIyImJyYDPQIOASMiJicmJyYnMTU0LgEjIgc1NjM` +
-	`yHgERFRQeATMyNjcRA7o1XkVNc2RYd4sxLQQ6pFNFcxYSBwUCNV5FTXNkWHeLYhc8JEekNQRd/Ae/mkEurhxgb2gBAgQcbYGSYUU1OyVVtr+aQS6uHGDd/uQuoWdCuowCiwACABQAAAS4BhAAEwAnA` +
-	`Gu4ADIrALgAAC+4AA0vuAAVL7gAGi+4AB8vugABAAAAFRESOboABAAAABUREjm6AAkAAAAVERI5ugAMAAAAFRESOboAEQAAABUREjm6ABgAAAAVERI5ugAdAAAAFRESOboAJQAAABUREjkwMTMDMxM` +
-	`XNxMzExc3EzMDIwMnIwcLAjMTFzcTMxMXNxMzAyMDJyMHA8q2rWocG7KnySMXa4+1rtYiBR+6tbatahwbsqfJIxdrj7Wu1iIFH7oCiP51W1sBi/51W1sBi/14AaFcW/5eA4gCiP51W1sBi/51W1sBi` +
-	`/14AaFcW/5eAAIAagAABGMGFgAHAA8AZ7gAMiu4ABAvuAARL7gAEBC4AADQuAAAL7gAERC4AALcuAAD3LgAABC4AAXcuAAAELgACNC4AAIQuAAJ0LgAAxC4AAvQuAAFELgADdAAuAACL7gABi+6AAk` +
-	`ADAA1K7oAAQAEADUrMDETIREjESERIxEhESMRIREjagP5m/0+nAP5m/0+nAKR/W8B9f4LBhb9bwH1/gsAAgDrBQgD4gaDAAMABwAXuAAyKwC6AAEAAAA1K7oABQAEADUrMDETNSEVJTUhFesC9/0JA` +
-	`vcFCHx8/3x8AAAAAAEBMgL3A5sHcQAHAB+4ADIrugAFAAAANSsAugAFAAAANSu6AAQAAQA1KzAxAREjNSERMxUCH+0BcfgC9wQLb/v0bgAAAQEkAuEDqQYmACkAzbgAMiu4ACovuAArL7gAJdy4AAf` +
-	`cQQUA2gAHAOoABwACXUEbAAkABwAZAAcAKQAHADkABwBJAAcAWQAHAGkABwB5AAcAiQAHAJkABwCpAAcAuQAHAMkABwANXbgAKhC4ABHQuAARL7oAFwAlAAcREjm4ABzcQRsABgAcABYAHAAmABwAN` +
-	`gAcAEYAHABWABwAZgAcAHYAHACGABwAlgAcAKYAHAC2ABwAxgAcAA1dQQUA1QAcAOUAHAACXQC6AAQAKAA1K7oAFAAZADUrMDEBNR4BMzI2NTQnJi8CJicmNTQ2MzIXFSYjIgYVFBcWHwEWFxYVFAY` +
-	`jIgEkULE+ZFgUFVItXJMnJ5ipf3KDc1ReKSlbWnsxMp6umAMhjDQqQTAgFxYiEiQ6MTBGYn4leC87LCsZGiEhLDU0T2eFAAEA1wL3A/YGEAALACu4ADIrALgAAy+4AAYvuAAAL7gACS+6AAUAAAADE` +
-	`RI5ugALAAAAAxESOTAxASMJATMbATMJASMDAW+YATf+3anPyZb+7AFIqvIC9wGfAXr+9gEK/pP+VAE8AAAAAQE6AvcDkwdmACAAobgAMiu4ACEvuAAiL7gAINy4AADcuAAhELgACdC4AAkvuAAV3EE` +
-	`bAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl24AAbQuAAGL7gAABC4AAzQuAAML7gAABC4ABLQuAASL7gAABC4ABvQALgAA` +
-	`C+6AAwAEgA1KzAxARE0Ji8DJjU0NjMyFxUuASMiBhUUHwEWHwEeAhURAoUrGzM3L2ynn4+EVoE+X1Y8LxgNLDg4FAL3AVpJUR80OTFuZmiCQIAwIk0yNko7Hg4uPFVOO/6tAAAAAgGkAAADKQS6ABE` +
-	`AIwAPuAAyKwC4AAgvuAAaLzAxASIGBwMVFBYzITI2PQEDLgEjJyImJwM1NDYzITIWHQEDDgEjAloZFgSDGBcBJxcYgwQWGRkZFgSDGBcBJxcYgwQWGQHtGSb+jwwYGRkYDAFxJhngGSYBcQwYGRkYD` +
-	`P6PJhkAAAAAAQGkAaEDKQOOABEAD7gAMisAuAAIL7gAAC8wMQEiJicDNTQ2MyEyFh0BAw4BIwJaGRYEgxgXAScXGIMEFhkBoRkmAXEMGBkZGAz+jyYZAAABAWIAjQNrBF4AFgAPuAAyKwC4AAMvuAA` +
-	`TLzAxATQ2MzIWFwEWFxYVFAcGBwEHBiMiJjUBYhEPCBIOAY4CBC0sBQL+cgIaDA8RBB8dIgsN/pMCBCgyMSkEAv6NAhckHgABAQwAAAPABKIABQAjuAAyK7oABQAAADUruAAFELgAB9wAuAAAL7oAB` +
-	`AABADUrMDEhESE1IREDR/3FArQELHb7XgAAAAEBDAAAA8AEogAHAC+4ADIrugABAAAANSu4AAAQuAAD0LgAARC4AAncALgAAi+4AAAvugAHAAQANSswMQEzESMRITUhA0d5ef3FAjsEovteAyF2AAE` +
-	`BDAAAA8AEogAHAC+4ADIrugABAAAANSu4AAAQuAAD0LgAARC4AAncALgAAi+4AAAvugAHAAQANSswMQEzESMRITUhA0d5ef3FAjsEovteAhZ2AAEBDAAAA8AEogAHAC+4ADIrugAGAAcANSu4AAcQu` +
-	`AAD0LgABhC4AAncALgABi+4AAQvugADAAAANSswMQEhNSERMxEjA0f9xQI7eXkBC3YDIfteAAEBDAAAA8AEogAFACO4ADIrugABAAQANSu4AAEQuAAH3AC4AAAvugAEAAEANSswMQERITUhEQPA/Uw` +
-	`COwSi+152BCwAAQFYAAADdAOdAAUAG7gAMiu6AAEABAA1KwC4AAAvugACAAMANSswMQERIRUhEQG2Ab795AOd/L9cA50AAQFYAAADdAOdAAcAJ7gAMiu6AAQABQA1K7gABBC4AADQALgABi+4AAQvu` +
-	`gABAAIANSswMQEhFSERIxEzAbYBvv5CXl4B/Vz+XwOdAAEBVwAAA3YCHwAFACO4ADIrALgAAS+4AAUvugAAAAUAARESOboAAwAFAAEREjkwMQkBFQ0BFQFXAh/+jQFzAQ8BEFe4u1UAAAEBVwAAA3Y` +
-	`CHwAFACO4ADIrALgABS+4AAEvugAAAAEABRESOboAAwABAAUREjkwMQkBNS0BNQN2/eEBc/6NARD+8Fe4u1UAAAIBrAAAAyABdAALABcAw7gAMiu4ABgvuAAZL7gAFdy4AAPcQQUA2gADAOoAAwACX` +
-	`UEbAAkAAwAZAAMAKQADADkAAwBJAAMAWQADAGkAAwB5AAMAiQADAJkAAwCpAAMAuQADAMkAAwANXbgAGBC4AA/QuAAPL7gACdxBGwAGAAkAFgAJACYACQA2AAkARgAJAFYACQBmAAkAdgAJAIYACQC` +
-	`WAAkApgAJALYACQDGAAkADV1BBQDVAAkA5QAJAAJdALoAAAAMADUrugASAAYANSswMSUyNjU0JiMiBhUUFhciJjU0NjMyFhUUBgJmJzY2JyY3NyZObG1NTmxsXTcmJjc3JiY3XXBKS29vS0pwAAAAA` +
-	`QFXAAADdgIfAAUAHbgAMisAuAAFL7gAAC+4AAMvugACAAAABRESOTAxISMLASMBA3ZVu7hXARABc/6NAh8AAAEBVwAAA3YCHwAFAB24ADIrALgAAS+4AAQvuAAAL7oAAwAAAAEREjkwMSEBMxsBMwJ` +
-	`n/vBXuLtVAh/+jQFzAAABAGoAAARjApEABwA7uAAyK7gACC+4AAkvuAAIELgAANC4AAAvuAAB3LgACRC4AAbcuAAD3AC4AAAvuAAEL7oAAwAGADUrMDETMxEhETMRIWq6Aoa5/AcCkf4pAdf9bwABA` +
-	`GoAAARjApEABQAbuAAyK7oAAQAAADUrALgAAC+6AAMABAA1KzAxEzMRIRUharoDP/wHApH+KboAAAABAR4DfwOvBhAABQAbuAAyK7oAAwAAADUrALgABC+6AAEAAgA1KzAxASEVIREjAR4Ckf4LnAY` +
-	`QnP4LAAABAR4DfwOvBhAABQAbuAAyK7oAAAABADUrALgAAC+6AAUAAgA1KzAxASMRITUhA6+c/gsCkQN/AfWcAAABAR4AAAOvApEABQAbuAAyK7oAAQAAADUrALgAAC+6AAMABAA1KzAxATMRIRUhA` +
-	`R6cAfX9bwKR/gucAAABAR4AAAOvApEABQAbuAAyK7oAAAADADUrALgABC+6AAMAAAA1KzAxKQE1IREzA6/9bwH1nJwB9QAAAAACAcMBlAMJBj8ACwAXAHW4ADIrugAJAAMANStBGwAGAAkAFgAJACY` +
-	`ACQA2AAkARgAJAFYACQBmAAkAdgAJAIYACQCWAAkApgAJALYACQDGAAkADV1BBQDVAAkA5QAJAAJduAADELgAD9C4AAkQuAAV0AC6AAYAAAA1K7oAEgAMADUrMDEBIiY1NDYzMhYVFAYDIiY1NDYzM` +
-	`hYVFAYCZkNgYUJCYWBDQ2BhQkJhYAGUYENEX19EQ2ADZWBDRF9fRENgAAAAAQDwAAAD3QJPAAkAF7gAMisAuAAJL7gABi+6AAIAAwA1KzAxAQchFSEXFSU1JQI2XQIE/fte/roBRgGzTXxOnOl86gA` +
-	`BASEAAAOtAQEAGAAruAAyKwC4AAAvuAAQL7gAAdC4AAEvuAAQELgAC9y4AATQuAAQELgACdwwMSUjNDYzMh8BFjMyNTMOAiMiLwImIyIGAZ59Z04+QCpTITx/BipXNEFMLBgiHxYsAYR8KBk0dWZeP` +
-	`TEcERcuAAACAZ0CMwO8A3kAAwAHABe4ADIrALgAAC+4AAQvuAACL7gABi8wMQEjEzMTIxMzAhF0jnt9dpF+AjMBRv66AUYAAgGdAjMDvAN5AAMABwAXuAAyKwC4AAAvuAAEL7gAAi+4AAYvMDEBMxM` +
-	`jATMTIwKze450/lV+kXYDef66AUb+ugAAAAABAUACMwNAA3kAAwAPuAAyKwC4AAAvuAACLzAxASMBMwNAqv6q2AIzAUYAAAH90f0OAi/9igADAA+4ADIrALoAAQAAADUrMDEBNSEV/dEEXv0OfHwAA` +
-	`AAAAf2yBQgCTgZOAA8AF7gAMisAuAAAL7gABi+6AAMACwA1KzAxAR4BMzI2NyEOAiMiLgEn/rISxHp6wBIBABiy/IaG/rIaBk5NdXdLb4lOTolvAAAAAf3RBQgCLwWEAAMAD7gAMisAugABAAAANSs` +
-	`wMQE1IRX90QReBQh8fAAAAAAB/bIFCAJOBk4ADwAXuAAyKwC4AAAvuAAIL7oABAAMADUrMDEBPgIzMh4BFyEuASMiBgf9shqy/oaG/LIY/wASwHp6xBIFCG+JTk6Jb0t3dU0AAAAB/XQFBwKMBggAG` +
-	`AAzuAAyKwC4AAAvuAAQL7oABAAWADUruAAQELgAAdC4AAEvuAAQELgACdy4AAQQuAAL0DAxASM0NjMyHwEWMzI1Mw4CIyIvAiYjIgb+bvrOnHyAVKZCeP4MVK5ogphYMEQ+LFgFCIR8KBk0dWZePTE` +
-	`cERcuAAH9sv66Ak4AAAAPABe4ADIrALgAAC+4AAYvugADAAsANSswMSEeATMyNjchDgIjIi4BJ/6yEsR6esASAQAYsvyGhv6yGk11d0tviU5OiW8AAf3R/cYCLwAVAAsAIbgAMisAuAAKL7gABy+6A` +
-	`AMAAAA1K7oABQAKAAcREjkwMQEhNSE0Myc1BRUFNQFG/IsDdAFdAUb+uv6vfAFNnOp86ZwAAAAAAfw4A8T++wdDAAcAF7gAMisAuAAFL7gAAS+6AAAAAwA1KzAxCQEnEyEBFwP++/6UKPv91gFsKPs` +
-	`Fqv4aTQFMAeZN/rQAAAAAAgARAAAEbgYQABQAHAC5uAAyK7gAHS+4AB4vuAAdELgAAtC4AAIvuAAF3LgACNC4AB4QuAAO3LgAG9xBBQDaABsA6gAbAAJdQRsACQAbABkAGwApABsAOQAbAEkAGwBZA` +
-	`BsAaQAbAHkAGwCJABsAmQAbAKkAGwC5ABsAyQAbAA1duAAM0LgADC+4AAIQuAAT0LgABRC4ABbQALgAAy+6ABgAEgA1K7oAAQAAADUrugAKABUANSu4AAEQuAAF0LgAABC4AAfQMDETNTM1MxUzFSM` +
-	`VMzIXFhEQBwYjIREBIxEzMjY1EBHRyuHhXPCqzOFtvP5+ASthg8ypBH+b9vabvW+F/ur+7G42BH/+qf1yloIBdgAAAgARAAAEPwYQABQAHQC1uAAyK7gAHi+4AB8vuAAeELgAAtC4AAIvuAAF3LgAC` +
-	`NC4AB8QuAAO3LgAAhC4ABPQuAAOELgAFdxBBQDaABUA6gAVAAJdQRsACQAVABkAFQApABUAOQAVAEkAFQBZABUAaQAVAHkAFQCJABUAmQAVAKkAFQC5ABUAyQAVAA1duAAFELgAGNAAuAADL7oAGgA` +
-	`SADUrugABAAAANSu6AAoAFwA1K7gAARC4AAXQuAAAELgAB9AwMRM1MzUzFTMVIxEzMhcWFRQHBiMhEQE0ISMRMzI3NhHnudzcz6WFlahRrv5gAn7+/8TdWj9PBH+b9vab/l5ga8rOUigEf/zO9v5XI` +
-	`CkAAAAAAgAhAAAErQYQAAsAEABDuAAyK7oACAAJADUrugAMAAkACBESOQC4AAAvuAAEL7gACC+4AAIvugAPAAYANSu4AAYQuAAK0LoADAAAAAIREjkwMTMjATMBIwMjESMRIxMHAyED48IB3s0B4dW` +
-	`seZuKzSd2AT51BhD58AIn/dkCJwKznf6FAXoAAAIAIQAABK0EXQALAA4AQbgAMiu6AAUABgA1K7oADgAGAAUREjkAuAAAL7oADQAFADUruAAFELgAAdC4AA0QuAAD3LgAB9C4AAUQuAAJ0DAxCQEjA` +
-	`yMRIxEjAyMBAyEDAsgB5cyYnZubm7oB6U8BS6QEXfujAV/+oQFf/qEEXf2dAXoAAAMAR//RBIUGPgALABwALwBhuAAyK7gAMC+4ADEvuAAwELgAA9C4AAMvuAAxELgACdy4AB3cuAAQ0LgAEC+4AAM` +
-	`QuAAa3LgAJNC4ACQvALoADAAAADUrugAGACAANSu6AC0AEgA1K7oAEAASAC0REjkwMQUgABEQACEgABEQACUyNzY3BiMiLwEmIyIHFRASARACIyIHBgc2MzIeAR8BFjMyNwJm/vj+6QEXAQgBCQEW/` +
-	`ur+95JhRhQiI2OjZnRHHRnDAefDkpJiRBUdICE/UFB5b0YfGC8BvQF6AXkBvf5D/of+hv5DnKV3zwhdO0ILF/6w/rUCmwFQAUumdMoGDR8tQz0JAAAAAAMAV//hBHYEfAALAB8AMAC1uAAyK7gAMS+` +
-	`4ADIvuAAxELgAA9C4AAMvuAAyELgACdy4AAzcQQUA2gAMAOoADAACXUEbAAkADAAZAAwAKQAMADkADABJAAwAWQAMAGkADAB5AAwAiQAMAJkADACpAAwAuQAMAMkADAANXboAEwADAAkREjm4AB7Qu` +
-	`AAeL7oAJAADAAkREjm4AAMQuAAu3AC6ACAAAAA1K7oABgAPADUrugAcACYANSu4ACYQuAAk0LgAJC8wMQUiADU0ADMyABUUABM0JiMiBwYHNjMyHgEfARYzMjc2ATI3NjcGIyIvASYjIgcVFBYCZ/D` +
-	`+4AEg8O8BIP7gVrCVllgsFg8QIT9QUHlvRhUSAf67lVgqFhMTY6NmdEcUErAfAU///wFO/rL///6xAk7K6HQ6TgINHy1DPQQO/lx0OEwCXTtCBRjL6AAAAAABAAIAAATKBhAAFwAbuAAyKwC4ABEvu` +
-	`gADAAgANSu4AAMQuAAT0DAxARUSMzIXFSYjIgMGBw4CAgcjATMBFzcDDVTyOj0sR3k9BCEOLUFaO7j+T78BMCcjBN0BATQPrRP+9BB6NKHs/sLSBhD7xJ6dAAAAAAEABwAABMYEgAATAB24ADIrALg` +
-	`ABi+6AA0AEgA1K7oACAASAA0REjkwMQEHDgMHIwEzARMSMzIXFSYjIgOLJQ4qPlM3q/5MtwFZ+FfpOj0sR3kC6F0ja53UjARd/IwCeQEeD60TAAAAAwACAAAEygdhABcAGwAfAE+4ADIrALgAGC+4A` +
-	`BwvuAARL7oAAwAIADUrugAGABEAGBESObgAAxC4ABPQugAWABEAGBESObgAAxC4ABrQuAAaL7gAAxC4AB7QuAAeLzAxARUSMzIXFSYjIgMGBw4CAgcjATMBFzcDMxMjATMTIwMNVPI6PSxHeT0EIQ4` +
-	`tQVo7uP5PvwEwJyNQe450/lV+kXYE3QEBNA+tE/70EHo0oez+wtIGEPvEnp0Fjv66AUb+ugAAAAMABwAABMYGNQATABcAGwBNuAAyKwC4ABQvuAAYL7gABi+6AA0AEgA1K7oACAASAA0REjm6AAoAB` +
-	`gAUERI5ugAQAAYAFBESOboAFgAGABQREjm6ABoABgAUERI5MDEBBw4DByMBMwETEjMyFxUmIyIBMxMjATMTIwOLJQ4qPlM3q/5MtwFZ+FfpOj0sR3n+EXuOdP5VfpF2AuhdI2ud1IwEXfyMAnkBHg+` +
-	`tEwJe/roBRv66AAQAPQArBI8EfgALABcAIwAvATG4ADIrugAhABsANSu6AAYAAAA1K7oALQAnADUrQQUA2gAAAOoAAAACXUEbAAkAAAAZAAAAKQAAADkAAABJAAAAWQAAAGkAAAB5AAAAiQAAAJkAA` +
-	`ACpAAAAuQAAAMkAAAANXbgAABC4AAzQuAAGELgAEtBBGwAGACEAFgAhACYAIQA2ACEARgAhAFYAIQBmACEAdgAhAIYAIQCWACEApgAhALYAIQDGACEADV1BBQDVACEA5QAhAAJdQQUA2gAnAOoAJwA` +
-	`CXUEbAAkAJwAZACcAKQAnADkAJwBJACcAWQAnAGkAJwB5ACcAiQAnAJkAJwCpACcAuQAnAMkAJwANXbgALRC4ADHcALoADwAVADUrugADAAkANSu6AB4AGAA1K7gAGBC4ACTQuAAeELgAKtAwMQE0N` +
-	`jMyFhUUBiMiJhE0NjMyFhUUBiMiJgEiJjU0NjMyFhUUBiEiJjU0NjMyFhUUBgHXVjo6VVU6OlZWOjpVVTo6Vv72OVdXOThXVwL7OVdXOTlWVgPuOVdXOThXV/0EOVdXOTlWVgFEVjo6VVU6OlZWOjp` +
-	`VVTo6VgAAAAAEAD0AKwSPBg4ACwAXACMALwExuAAyK7oAIQAbADUrugAGAAAANSu6AC0AJwA1K0EFANoAAADqAAAAAl1BGwAJAAAAGQAAACkAAAA5AAAASQAAAFkAAABpAAAAeQAAAIkAAACZAAAAq` +
-	`QAAALkAAADJAAAADV24AAAQuAAM0LgABhC4ABLQQRsABgAhABYAIQAmACEANgAhAEYAIQBWACEAZgAhAHYAIQCGACEAlgAhAKYAIQC2ACEAxgAhAA1dQQUA1QAhAOUAIQACXUEFANoAJwDqACcAAl1` +
-	`BGwAJACcAGQAnACkAJwA5ACcASQAnAFkAJwBpACcAeQAnAIkAJwCZACcAqQAnALkAJwDJACcADV24AC0QuAAx3AC6AA8AFQA1K7oAAwAJADUrugAeABgANSu4ABgQuAAk0LgAHhC4ACrQMDEBNDYzM` +
-	`hYVFAYjIiYRNDYzMhYVFAYjIiYBIiY1NDYzMhYVFAYhIiY1NDYzMhYVFAYB11Y6OlVVOjpWVjo6VVU6Olb+9jlXVzk4V1cC+zlXVzk5VlYFfjlXVzk4V1f7dDlXVzk5VlYC1FY6OlVVOjpWVjo6VVU` +
-	`6OlYAAAAAAgHXACsC9gR+AAsAFwB1uAAyK7oABgAAADUrQQUA2gAAAOoAAAACXUEbAAkAAAAZAAAAKQAAADkAAABJAAAAWQAAAGkAAAB5AAAAiQAAAJkAAACpAAAAuQAAAMkAAAANXbgAABC4AAzQu` +
-	`AAGELgAEtAAugAPABUANSu6AAMACQA1KzAxATQ2MzIWFRQGIyImETQ2MzIWFRQGIyImAddWOjpVVTo6VlY6OlVVOjpWA+45V1c5OFdX/QQ5V1c5OVZWAAAAAAUAC//EBMEEegAgACwAOABEAFABMbg` +
-	`AMiu6ADAANgA1K7oAJwAhADUrugBIAE4ANStBBQDaACEA6gAhAAJdQRsACQAhABkAIQApACEAOQAhAEkAIQBZACEAaQAhAHkAIQCJACEAmQAhAKkAIQC5ACEAyQAhAA1dQRsABgAwABYAMAAmADAAN` +
-	`gAwAEYAMABWADAAZgAwAHYAMACGADAAlgAwAKYAMAC2ADAAxgAwAA1dQQUA1QAwAOUAMAACXbgAIRC4ADnQuAAnELgAP9BBBQDaAE4A6gBOAAJdQRsACQBOABkATgApAE4AOQBOAEkATgBZAE4AaQB` +
-	`OAHkATgCJAE4AmQBOAKkATgC5AE4AyQBOAA1duABIELgAUtwAugAkACoANSu6ADwAQgA1K7oALQAzADUruAAtELgARdC4ADMQuABL0DAxIS8BDwEjLwE1AScBNyc/ARU3CQEXNx8BBxcJAQczDwEnJ` +
-	`TQ2MzIWFRQGIyImATIWFRQGIyImNTQ2ATQ2MzIWFRQGIyImATIWFRQGIyImNTQ2BBTF69LaATg4AbNs/rkDA2EPAQGvAa0DAzU2AwP+UgGuAQEQWwP9wFY6OlVVOjpW/sM5V1c5OVZWAXZWOjpVVTo` +
-	`6VgJaOVdXOThXV8jo1Nw4OAEBrGwBRAMDWxABAf5SAa4DAzgzAwP+Uf5TAQ9hA1A5V1c5OVZWApRWOjpVVTo6VgE8OVdXOThXV/78Vjo6VVU6OlYAAAAABQAZ//wEtgScACAALAA4AEQAUACvuAAyK` +
-	`7oAGwAAADUruAAAELgAAtC4AAAQuAAJ0LgACS+4AAAQuAAL0LgAABC4AA3QuAANL7gAGxC4ABHQuAAAELgAH9C4AB8vALgAAC+4ABsvuAAdL7gAHy+4AAsvuAANL7gADy+4ABEvugASABoANSu4ABo` +
-	`QuAAC0LgAAi+4ABoQuAAE0LgABC+4ABIQuAAI0LgACC+4ABIQuAAU0LgAFC+4ABoQuAAX0LgAFy8wMSE3AwUHJz0BNwU1AzM1FzcHMxMlFTMHFyMVBRMjFycHNQE+ARcWBgcOAScmNBMeAQcGJicuA` +
-	`Tc2MgU+ARcWBgcOAScmNhMeAQcGJicuATc2FgIWAgL+1tIBAQH+AgSFFgECAQH7BAICBP4DAQIBFoX+oih6KSkBKCl5KSnzKAEpKXooKAEpKXoB5yl6KSkBKSd6KSkB8igBKSl6KCgBKSl6tQFKAQI` +
-	`BT08BBZkBaAQEAQH+AwEETUsEAf4FAQEEBAFsKAEpKXooKAEpKXoCsih6KSkBKCl5KSkpKAEpKXooKAEpKXr9nyl6KSkBKSd6KSkBAAAEAAcD3ATGBk4AAwAHAAsADwAnuAAyKwC4AAEvuAAFL7gAC` +
-	`S+4AA0vuAAAL7gABC+4AAgvuAAMLzAxGwEzAzMTMwMzEzMDMxMzAwfSmfKj0pnyo9KZ8qPSmfID3AJy/Y4Ccv2OAnL9jgJy/Y4AAAAAAwCmACsEJwR+AAsAFwAjANe4ADIruAAkL7gAJS+4AAbcuAA` +
-	`A3EEFANoAAADqAAAAAl1BGwAJAAAAGQAAACkAAAA5AAAASQAAAFkAAABpAAAAeQAAAIkAAACZAAAAqQAAALkAAADJAAAADV24AAzQuAAGELgAEtC4ACQQuAAb0LgAGy+4ACHcQRsABgAhABYAIQAmA` +
-	`CEANgAhAEYAIQBWACEAZgAhAHYAIQCGACEAlgAhAKYAIQC2ACEAxgAhAA1dQQUA1QAhAOUAIQACXQC6AA8AFQA1K7oAAwAJADUrugAeABgANSswMQE0NjMyFhUUBiMiJhE0NjMyFhUUBiMiJgEiJjU` +
-	`0NjMyFhUUBgMIVjo6VVU6OlZWOjpVVTo6Vv4uOVdXOThXVwPuOVdXOThXV/0EOVdXOTlWVgFEVjo6VVU6OlYABQBSAD8EewRpAAsAFwAjAC8AOwAAAT4BFxYGBw4BJyY2ATYyFxYGBw4BJyY0Ey4BN` +
-	`zYWFx4BBwYmAS4BNzYWFxYUBwYiAT4BFxYGBw4BJyY2A4YpeikpASkneikpAf0cKHopKQEoKXkpKSkoASkpeigoASkpegLjKAEpKXooKSkpef5SKXopKQIoJ3opKQEEPygBKSl5KScCKSl7/RspKSl` +
-	`5KSgBKSl6AmkpeikpAigneikpAf0cKHspKQIoKHkpKQJ5KAEpKXooKAEpKXoAAAMB1wAAAvYEUwALABcAIwCNuAAyK7oABgAAADUrQQUA2gAAAOoAAAACXUEbAAkAAAAZAAAAKQAAADkAAABJAAAAW` +
-	`QAAAGkAAAB5AAAAiQAAAJkAAACpAAAAuQAAAMkAAAANXbgAABC4AAzQuAAGELgAEtC4AAAQuAAY0LgABhC4AB7QALoAGwAhADUrugADAAkANSu6AA8AFQA1KzAxATQ2MzIWFRQGIyImETQ2MzIWFRQ` +
-	`GIyImETQ2MzIWFRQGIyImAddWOjpVVTo6VlY6OlVVOjpWVjo6VVU6OlYDwzlXVzk4V1f+njpWVjk5WFf+nzlXVzk5VlYABAHXAAAC9gXsAAsAFwAjAC8ApbgAMiu6AAYAAAA1K0EFANoAAADqAAAAA` +
-	`l1BGwAJAAAAGQAAACkAAAA5AAAASQAAAFkAAABpAAAAeQAAAIkAAACZAAAAqQAAALkAAADJAAAADV24AAAQuAAM0LgABhC4ABLQuAAAELgAGNC4AAYQuAAe0LgAABC4ACTQuAAGELgAKtAAugAbACE` +
-	`ANSu6ACcALQA1K7oADwAVADUrugADAAkANSswMQE0NjMyFhUUBiMiJhE0NjMyFhUUBiMiJhE0NjMyFhUUBiMiJhE0NjMyFhUUBiMiJgHXVjo6VVU6OlZWOjpVVTo6VlY6OlVVOjpWVjo6VVU6OlYDw` +
-	`zlXVzk4V1f+njpWVjk5WFf+nzlXVzk5VlYFBjlXVzk4V1cAAAMAUQBiBHwERQARACMANQDTuAAyKwC4ACcvuAApL7gADC+4AA4vuAAeL7gAIC+6AAEADAAnERI5ugAEAAwAJxESOboABwAMACcREjm` +
-	`6AAoADAAnERI5ugANAAwAJxESOboAEAAMACcREjm6ABMADAAnERI5ugAWAAwAJxESOboAGQAMACcREjm6ABwADAAnERI5ugAfAAwAJxESOboAIgAMACcREjm6ACUADAAnERI5ugAoAAwAJxESOboAK` +
-	`wAMACcREjm6AC4ADAAnERI5ugAxAAwAJxESOboANAAMACcREjkwMQEXJzcXNxcHNxUnFwcnByc3ByUXJzcXNxcHNxUnFwcnByc3BwEXJzcXNxcHNxUnFwcnByc3BwJ4vmhZU1NYaL+/aFhTU1lovv3` +
-	`Zv2lZVFNYaL+/aFhTVFlpvwEUvmhZU1NYaL+/aFhTU1lovgGOC5szqKgzmwtnDp80qqo0nw5nC5szqKgzmwtnDp80qqo0nw8CXAubM6ioM5sLZw6fNKqqNJ8OAAAAAAQAAf/RBMsGPgAMABYAJgA2A` +
-	`Sm4ADIrugAzABsANSu6AAEAAgA1K7oACAASADUrugAjACsANSu4AAEQuAAN0EEFANoAEgDqABIAAl1BGwAJABIAGQASACkAEgA5ABIASQASAFkAEgBpABIAeQASAIkAEgCZABIAqQASALkAEgDJABI` +
-	`ADV1BBQDaACsA6gArAAJdQRsACQArABkAKwApACsAOQArAEkAKwBZACsAaQArAHkAKwCJACsAmQArAKkAKwC5ACsAyQArAA1dQRsABgAzABYAMwAmADMANgAzAEYAMwBWADMAZgAzAHYAMwCGADMAl` +
-	`gAzAKYAMwC2ADMAxgAzAA1dQQUA1QAzAOUAMwACXbgAIxC4ADjcALoAJwAXADUrugAfAC8ANSu6AAQAFQA1K7oADQAAADUrMDEBESMRMzIeARUUDgEjJzMyPgE1NCYrARMiJAI1NBIkMzIEEhUUAgQ` +
-	`nMjYSNTQCJiMiBgIVFBIWAhBlwXdtOk6LeCksXVsxV4E9VsL+/qGVAQfJwgEDoKH+/sKA4ImK4H+C44SM3gKX/uUDCDVnQEqBRk4wWzVDTvubygF88egBdNrM/obw8f6Eyn2hAVjBwAFaoKf+qbzD/` +
-	`qSbAAACADcABASVBIgAGAAkANO4ADIrugAZABIANSu6AAsAHwA1K7oABgAFADUruAALELgAANC4AAAvQRsABgAZABYAGQAmABkANgAZAEYAGQBWABkAZgAZAHYAGQCGABkAlgAZAKYAGQC2ABkAxgA` +
-	`ZAA1dQQUA1QAZAOUAGQACXUEFANoAHwDqAB8AAl1BGwAJAB8AGQAfACkAHwA5AB8ASQAfAFkAHwBpAB8AeQAfAIkAHwCZAB8AqQAfALkAHwDJAB8ADV24AAYQuAAm3AC4AAUvugAcAA8ANSu6ABUAI` +
-	`gA1KzAxAT4CPQEzFRQOAQcOAiMiAhEQEjMyHgEBFBYzMjY1NCYjIgYDtkAxGFYsXE4Lat1/uv300WO1ifzvtrm5tra5ubYCp0ZusGsSL8GwnEC31XwBDgERAQgBF0mz/t2Sw8OSksPDAAAAAQCs/+E` +
-	`DlQRdAA8AG7gAMiu6AA8AAAA1KwC4AAAvugAKAAUANSswMQURNC4BIyIHNTYzMh4BGQEC20mCXWuciXelvoYfAka/mkEurhxg3f7k/d0ABAAC/9EEzAY+AAMAGAAkAD0BNbgAMiu6AB8ACgA1K7oAF` +
-	`AAEADUrugA3ACsANStBBQDaACsA6gArAAJdQRsACQArABkAKwApACsAOQArAEkAKwBZACsAaQArAHkAKwCJACsAmQArAKkAKwC5ACsAyQArAA1duAArELgAMdy6ABYACgAxERI5uAAEELgAGdBBGwA` +
-	`GAB8AFgAfACYAHwA2AB8ARgAfAFYAHwBmAB8AdgAfAIYAHwCWAB8ApgAfALYAHwDGAB8ADV1BBQDVAB8A5QAfAAJduAAxELgAJdAAuAACL7gAAC+6ADoAJwA1K7oADgAcADUrugAuADMANSu6ACIAB` +
-	`wA1K7oABAAAAAIREjm4AA4QuAAQ0LgAEC+4AA4QuAAS0LgAEi+6ABYABwAiERI5ugAxAAAAAhESOboAPQAAAAIREjkwMRcjATMBDgEjIiY1ND4BMzIXFhczERQXIyYnNSYjIgYVFBYzMjYBBiMiLgE` +
-	`1NDYzMhcVJiMiDgEVFBYzMjY3pqQEIan88St3MkZwSnxcGjYIEF0VYQsGMipWcz8oK2kC6V5UVINJpnVWYWJNM1oxX2UeO1AvBm3+KEZIenthmFkGAQH+dWU/Lr7wDYVzU1RY+4YgToVUf6ggWi0za` +
-	`zhMiQkbAAAABAAC/9EEzAY+AAMAGAAkAE4Bj7gAMiu6AB8ACgA1K7oAFAAEADUrugBBADYANSu6AEoALAA1K7oAFgAKAEoREjm4AAQQuAAZ0EEbAAYAHwAWAB8AJgAfADYAHwBGAB8AVgAfAGYAHwB` +
-	`2AB8AhgAfAJYAHwCmAB8AtgAfAMYAHwANXUEFANUAHwDlAB8AAl26ACYACgBKERI5QQUA2gAsAOoALAACXUEbAAkALAAZACwAKQAsADkALABJACwAWQAsAGkALAB5ACwAiQAsAJkALACpACwAuQAsA` +
-	`MkALAANXUEFANoANgDqADYAAl1BGwAJADYAGQA2ACkANgA5ADYASQA2AFkANgBpADYAeQA2AIkANgCZADYAqQA2ALkANgDJADYADV26ADwALABKERI5uABKELgAUNwAuAACL7gAAC+6ACkATQA1K7o` +
-	`ADgAcADUrugA5AD4ANSu6ACIABwA1K7oABAAAAAIREjm4AA4QuAAQ0LgAEC+4AA4QuAAS0LgAEi+6ABYABwAiERI5ugAmAAAAAhESOboAPAAAAAIREjkwMRcjATMBDgEjIiY1ND4BMzIXFhczERQXI` +
-	`yYnNSYjIgYVFBYzMjYBNR4BMzI2NTQnJi8CJicmNTQ2MzIXFSYjIgYVFBcWHwEWFxYVFAYj`
-
-const MonacoFontData13 = `IqakBCGp/PErdzJGcEp8XBo2CBBdFWELBjIqVnM/KCtpARk4fSxGPg4POSBBaBsbanhZUFxRO0IdHEE/ViMjb3trLwZt/ihGSHp7YZhZBgEB/nVlPy6+8A2Fc1NUWPuUY` +
-	`iQeLiIWEBAYDRkpIiIxRVkaVCEqHx4SEhcXICUlN0leAAAAAAQAAv/RBMwGPgADABwAKAA0AUu4ADIrugAWAAoANSu6ADIAIAA1K7oAJgAsADUruAAKELgAENy4AATQQRsABgAWABYAFgAmABYANgA` +
-	`WAEYAFgBWABYAZgAWAHYAFgCGABYAlgAWAKYAFgC2ABYAxgAWAA1dQQUA1QAWAOUAFgACXboAHAAKACYREjlBBQDaACAA6gAgAAJdQRsACQAgABkAIAApACAAOQAgAEkAIABZACAAaQAgAHkAIACJA` +
-	`CAAmQAgAKkAIAC5ACAAyQAgAA1dQQUA2gAsAOoALAACXUEbAAkALAAZACwAKQAsADkALABJACwAWQAsAGkALAB5ACwAiQAsAJkALACpACwAuQAsAMkALAANXbgAJhC4ADbcALgAAi+4AAAvugApAB0` +
-	`ANSu6AA0AEgA1K7oAIwAvADUrugAZAAYANSu6ABAAAAACERI5ugAcAAAAAhESOTAxFyMBMwEGIyIuATU0NjMyFxUmIyIOARUUFjMyNjcBIiY1NDYzMhYVFAYnMjY1NCYjIgYVFBampAQhqf1YXlRUg` +
-	`0mmdVZhYk0zWjFfZR47UAFoeJCQeHeQkHdKWFhKS1hYLwZt/bogToVUf6ggWi0zazhMiQkb+56ogH+np3+AqE50ZmV0dGVmdAAAAwAC/9EEzAY+AAMAHAAyAMm4ADIrugAWAAoANSu6ACoAJwA1K7o` +
-	`AHgAfADUruAAKELgAENy4AATQQRsABgAWABYAFgAmABYANgAWAEYAFgBWABYAZgAWAHYAFgCGABYAlgAWAKYAFgC2ABYAxgAWAA1dQQUA1QAWAOUAFgACXboAHAAKAB4REjm4AB8QuAAx0LgAHhC4A` +
-	`DTcALgAAi+4AAAvugAuACMANSu6AA0AEgA1K7oAGQAGADUrugAQAAAAAhESOboAHAAAAAIREjm6ACAAAAACERI5MDEXIwEzAQYjIi4BNTQ2MzIXFSYjIg4BFRQWMzI2NwERIzUOASMiLgE1ETMRFB4` +
-	`BMzI2NxGmpAQhqf1YXlRUg0mmdVZhYk0zWjFfZR47UAJXXS1uNzFRIF0PKhgxcCUvBm39uiBOhVR/qCBaLTNrOEyJCRv93f3Re0VGNVZ8ATj+vFA0IV1GAUYAAAADAHn/0QRUBj8ACwAXADEBCbgAM` +
-	`iu6ABUAAwA1K7oACQAPADUrugArAB4ANStBGwAGAAkAFgAJACYACQA2AAkARgAJAFYACQBmAAkAdgAJAIYACQCWAAkApgAJALYACQDGAAkADV1BBQDVAAkA5QAJAAJdQRsABgAVABYAFQAmABUANgA` +
-	`VAEYAFQBWABUAZgAVAHYAFQCGABUAlgAVAKYAFQC2ABUAxgAVAA1dQQUA1QAVAOUAFQACXUEFANoAHgDqAB4AAl1BGwAJAB4AGQAeACkAHgA5AB4ASQAeAFkAHgBpAB4AeQAeAIkAHgCZAB4AqQAeA` +
-	`LkAHgDJAB4ADV0AuAAGL7gAIi+6AC8AGgA1K7gAIhC4ACfcMDEBIiY1NDYzMhYVFAYnMjY1NCYjIgYVFBYBBiMiJgI1NBI2MzIXFSYjIgYCFRQSFjMyNwEBOU9QODdQTzglNDUkJTU0A3lXRVuZVVS` +
-	`ZXEVXUU88ZD0/YjxPUQQfoW5xn59xbqFdbEZJampJRmz7lkHDAYHz8QGAxkG4XaT+w7q9/sCfXQAAAAMAAwAABLYGPgAJABUAIQDfuAAyK7oAHwANADUrugATABkANSu6AAkAAAA1K7gACRC4AATQu` +
-	`AAAELgAB9xBGwAGABMAFgATACYAEwA2ABMARgATAFYAEwBmABMAdgATAIYAEwCWABMApgATALYAEwDGABMADV1BBQDVABMA5QATAAJdQRsABgAfABYAHwAmAB8ANgAfAEYAHwBWAB8AZgAfAHYAHwC` +
-	`GAB8AlgAfAKYAHwC2AB8AxgAfAA1dQQUA1QAfAOUAHwACXQC4AAAvugAQABwANSu6AAIAAwA1K7oAFgAKADUrugAGAAcANSswMSERIRUhESEVIREBIiY1NDYzMhYVFAYnMjY1NCYjIgYVFBYCpwIP/` +
-	`rsBJv7a/aJynqFvb6CecUtnaUlKaWcGEMr+Tcn9NgQfoW5xn59xbqFdbEZJampJRmwAAAAAAQAhAAAErQRdAAYAHbgAMisAuAABL7gABC+4AAAvugADAAAAARESOTAxIQEzCQEzAQIG/hvMAX8Bh7r` +
-	`+FwRd/IwDdPujAAAAAQAhAAAErQRdAAYAHbgAMisAuAAAL7gAAS+4AAQvugADAAEAABESOTAxCQEjCQEjAQLEAem6/nn+gcwB5QRd+6MDdPyMBF0AAgC9AMkEEAOWACkANwB5uAAyK7oAMgALADUrQ` +
-	`RsABgAyABYAMgAmADIANgAyAEYAMgBWADIAZgAyAHYAMgCGADIAlgAyAKYAMgC2ADIAxgAyAA1dQQUA1QAyAOUAMgACXQC4AAAvuAAIL7oADwAvADUruAAPELgAFtC4ABYvuAAIELgANdwwMSUiJi8` +
-	`BBw4BIyImNTQ+ATMyFh8BPgEzNhQdASIOAQcfAhYzMh4BBhUGAS8CJiMiBhUUFjMyNgQMP3pNKRpRbUV1jkFrRECBTSIuiV8BITs5Lx8oLDwtAgEBAQH+ey0jIT0vKkVDPjNVyWNgNCyCSNKKWKxqc` +
-	`1sqaI8DJhhALVdYKCwxQw4YHxAmASk3JSNAb1RYgFsAAAAAAQIV/9ECuAY+AAMAF7gAMiu6AAMAAAA1KwC4AAEvuAAALzAxBREzEQIVoy8GbfmTAAAAAAIARwAABIUFdAALAA8AQ7gAMiu6AAEAAgA` +
-	`1K7gAAhC4AAbQuAABELgACNAAuAABL7oADQAMADUrugAJAAAANSu4AAAQuAAD0LgACRC4AAXQMDEBESMRITUhETMRIRUBNSEVArSc/i8B0ZwB0fvCBD4B0f4vAdGbAdL+LpsDCJubAAAAAAEAIf5OB` +
-	`K0GEAALAEu4ADIruAAML7gADS+4AAwQuAAK0LgACi+4AAHcuAANELgABdy4AALcALgAAC+4AAMvugAGAAcANSu4AAYQuAAB0LgABhC4AAnQMDEBESERMxEzFSE1MxEBpQGEybv7dLoGEPjaByb42py` +
-	`cByYAAAACAEcAAASFBgwACwAXAEO4ADIrugADAAQANSu4AAQQuAAI0LgAAxC4AArQALgACS+6ABIADAA1K7oAAQAAADUruAABELgABdC4AAAQuAAH0DAxATUhESMRIRUhETMRAyImNTQ2MzIWFRQGB` +
-	`IX+L5z+LwHRnE5DYGFCQmFgAdGbAdL+Lpv+LwHRAvVgQ0RfX0RDYAAAAQAh/9EErQY+AAMAD7gAMisAuAACL7gAAC8wMRcjATPYtwPbsS8GbQAAAAABACH/0QStBj4AAwAPuAAyKwC4AAIvuAAALzA` +
-	`xBSMBMwStsfwlty8GbQAAAAIBw//RAwkEfAALABcAdbgAMiu6AAkAAwA1K0EbAAYACQAWAAkAJgAJADYACQBGAAkAVgAJAGYACQB2AAkAhgAJAJYACQCmAAkAtgAJAMYACQANXUEFANUACQDlAAkAA` +
-	`l24AAMQuAAP0LgACRC4ABXQALoABgAAADUrugASAAwANSswMQUiJjU0NjMyFhUUBgMiJjU0NjMyFhUUBgJmQ2BhQkJhYENDYGFCQmFgL2BDRF9fRENgA2VgQ0RfX0RDYAAAAAAEADP/0QSZBHwACwA` +
-	`XACMALwEDuAAyK7gAMC+4ADEvuAAwELgAA9C4AAMvuAAJ3EEbAAYACQAWAAkAJgAJADYACQBGAAkAVgAJAGYACQB2AAkAhgAJAJYACQCmAAkAtgAJAMYACQANXUEFANUACQDlAAkAAl24AAMQuAAP0` +
-	`LgACRC4ABXQuAAxELgAIdy4ABvcQQUA2gAbAOoAGwACXUEbAAkAGwAZABsAKQAbADkAGwBJABsAWQAbAGkAGwB5ABsAiQAbAJkAGwCpABsAuQAbAMkAGwANXbgAJ9C4ACEQuAAt0AC6AAYAAAA1K7o` +
-	`AEgAMADUruAAAELgAGNC4AAYQuAAe0LgADBC4ACTQuAASELgAKtAwMRciJjU0NjMyFhUUBgMiJjU0NjMyFhUUBgEiJjU0NjMyFhUUBgMiJjU0NjMyFhUUBtZDYGFCQmFgQ0NgYUJCYWAC3UNgYUJCY` +
-	`WBDQ2BhQkJhYC9gQ0RfX0RDYANlYENEX19EQ2D8m2BDRF9fRENgA2VgQ0RfX0RDYAAAAQCkAOUEKASQABEAS7gAMisAuAAIL7gAAC+6AAEAAAAIERI5ugAEAAAACBESOboABwAAAAgREjm6AAoAAAA` +
-	`IERI5ugANAAAACBESOboAEAAAAAgREjkwMSUTBSctATcFAzMDJRcNAQclEwIHFf7lXQEz/s1dARsVvBoBIV7+zAE0Xv7fGuUBW76ilpifvQFc/qS9n5iWor7+pQAAAgFXAa4DdgPNAAsAFwDLuAAyK` +
-	`7gAGC+4ABkvuAAYELgAA9C4AAMvuAAZELgACdy4AA/cQQUA2gAPAOoADwACXUEbAAkADwAZAA8AKQAPADkADwBJAA8AWQAPAGkADwB5AA8AiQAPAJkADwCpAA8AuQAPAMkADwANXbgAAxC4ABXcQRs` +
-	`ABgAVABYAFQAmABUANgAVAEYAFQBWABUAZgAVAHYAFQCGABUAlgAVAKYAFQC2ABUAxgAVAA1dQQUA1QAVAOUAFQACXQC6AAwAAAA1K7oABgASADUrMDEBIiY1NDYzMhYVFAYnMjY1NCYjIgYVFBYCZ` +
-	`3KeoW9voJ5xS2dpSUppZwGuoW5xn59xbqFdbEZJampJRmwAAAEBVwGuA3YDzQALAA+4ADIrALgABi+4AAAvMDEBIiY1NDYzMhYVFAYCZ3KeoW9voJ4BrqFucZ+fcW6hAAL/o/6MBSoGPgAtAFsAa7g` +
-	`AMisAugACACIANSu6AAsAGQA1K7gACxC4ABHcugAXAAsAERESObgAIhC4ACjcuAACELgAMNC4AAsQuAA50LgAERC4AD/QugBFAAsAERESObgAGRC4AEfQuAAiELgAUNC4ACgQuABW0DAxFxYzMjc2G` +
-	`QEQNzYzMhYVFAYjIiY1NDY3JiMiDgEZARAHBiMiJjU0NjMyFhUUBgUWMzI3NhkBEDc2MzIWFRQGIyImNTQ2NyYjIg4BGQEQBwYjIiY1NDYzMhYVFAZbDg9NJiVtbI1Rb0sjIkEMDBIMKEUoaWmYVmd` +
-	`CLSY8DAHnDg9NJiVtbI1Rb0sjIkEMDBIMKEUoaWmYVmdCLSY8DOcJZ2cBQAHWAhKcnGNJRjwwLBEgEAVHqv6z/hD+SqGhYEszSDIlESEQCWdnAUAB1gISnJxjSUY8MCwRIBAFR6r+s/4Q/kqhoWBLM` +
-	`0gyJREhAAEAOAF0BJUC6QAeABe4ADIrALoACwAUADUrugAEABkANSswMRM1NDYzMh4BHwEWMzI2PQEzFRQGIyIvASYjIg4BHQE4m4MhP1BQeW9GSkeAvWpjo2Z0RyhFKgGTEoPBDR8tQz1rPxAQp59` +
-	`dO0IqXCMSAAAAAQA4AXQElQLpAB4AF7gAMisAugAFABoANSu6AAoAEwA1KzAxExUUHgEzMj8BNjMyFh0BIzU0JiMiDwEOAiMiJj0BsCpFKEd0ZqNjar2AR0pGb3lQUD8hg5sCyhIjXCpCO12fpxAQP` +
-	`2s9Qy0fDcGDEgAAAAADAD0AlASQBHkACwAXACMAp7gAMiu4ACQvuAAV0LgAFS+4ABjcQQMAPwAYAAFdQQMAfwAYAAFdQQMA4AAYAAFdQQUAkAAYAKAAGAACXbgACdxBAwB/AAkAAV1BAwA/AAkAAV1` +
-	`BBQCQAAkAoAAJAAJdQQMA4AAJAAFduAAD3LgAFRC4AA/cuAAYELgAHty4AAMQuAAl3AC6AAAABgA1K7oAGwAhADUruAAAELgADNC4AAYQuAAS0DAxATIWFRQGIyImNTQ2ITIWFRQGIyImNTQ2ATQ2M` +
-	`zIWFRQGIyImBAA5V1c5OFdX/QQ5V1c5OVZWAURWOjpVVTo6VgGzVjo6VVU6OlZWOjpVVTo6VgI2OVdXOThXVwADAD0AlASQBHkACwAXACMAp7gAMiu4ACQvuAAP0LgADy+4ABjcQQMAPwAYAAFdQQM` +
-	`AfwAYAAFdQQMA4AAYAAFdQQUAkAAYAKAAGAACXbgAA9xBAwB/AAMAAV1BAwA/AAMAAV1BBQCQAAMAoAADAAJdQQMA4AADAAFduAAJ3LgADxC4ABXcuAAYELgAHty4AAkQuAAl3AC6ABsAIQA1K7oAB` +
-	`gAAADUruAAAELgADNC4AAYQuAAS0DAxASImNTQ2MzIWFRQGISImNTQ2MzIWFRQGEzQ2MzIWFRQGIyImBAA4V1c4OVdX/JM5VlY5OVdX0lY6OlVVOjpWA1pWOjpVVTo6VlY6OlVVOjpW/co4V1c4OVd` +
-	`XAAABAawAAAMhBF0AHgDPuAAyK7gAHy+4ACAvuAAfELgABNC4AAQvuAAZ3EEbAAYAGQAWABkAJgAZADYAGQBGABkAVgAZAGYAGQB2ABkAhgAZAJYAGQCmABkAtgAZAMYAGQANXUEFANUAGQDlABkAA` +
-	`l24AAnQuAAJL7gAIBC4ABTcuAAL3EEFANoACwDqAAsAAl1BGwAJAAsAGQALACkACwA5AAsASQALAFkACwBpAAsAeQALAIkACwCZAAsAqQALALkACwDJAAsADV24ABfQuAAXLwC4ABAvuAAALzAxISM` +
-	`iJjU0PgE/ATY1NCYrATUzMhYVFA8BBhUUHgE7AQMCEoPBDR8tQz1rPxAQp59dO0IqXCMSm4MhP1BQeW9GSkeAvWpjo2Z0RyhFKgAAAAADAEcAcASFA8UAAwAHAAsAH7gAMisAugAIAAkANSu6AAAAA` +
-	`QA1K7oABAAFADUrMDEBFSE1ARUhNQEVITUEhfvCBD77wgQ++8IDxZub/qObm/6jm5sAAAAAAgA4AR8ElQOrAAMAIgAfuAAyKwC6AAAAAQA1K7oACAAdADUrugAPABgANSswMQEVITUnNTQ2MzIeAR8` +
-	`BFjMyNj0BMxUUBiMiLwEmIyIOAR0BBIX7wg+bgyE/UFB5b0ZKR4C9amOjZnRHKEUqAbqbm5sSg8ENHy1DPmw/EBCnn107QipcIxIAAAIAOAEfBJUDqwADACIAH7gAMisAugAdAAgANSu6AAEAAAA1K` +
-	`7oAGAAPADUrMDETNSEVFxUUBiMiLgEvASYjIgYdASM1NDYzMh8BFjMyPgE9AUgEPg+bgyE/UFB5b0ZKR4C9amOjZnRHKEUqAxCbm5sSg8ENHy1DPmw/EBCnn107QipcIxIAAAADADgAVwSVBHMAAwA` +
-	`HACYAJ7gAMisAugAEAAUANSu6AAwAIQA1K7oAAAABADUrugATABwANSswMQEVITUBFSE1AzU0NjMyHgEfARYzMjY9ATMVFAYjIi8BJiMiDgEdAQSF+8IEPvvCD5uDIT9QUHlvRkpHgL1qY6NmdEcoR` +
-	`SoCT5ub/qObmwIrEoPBDR8tQz5sPxAQp59dO0IqXCMSAAAAAQCR/+EERwYQABwAebgAMiu6AAQAEAA1K0EbAAYABAAWAAQAJgAEADYABABGAAQAVgAEAGYABAB2AAQAhgAEAJYABACmAAQAtgAEAMY` +
-	`ABAANXUEFANUABADlAAQAAl24ABAQuAAW0LgAFi8AugAIAA0ANSu6ABcAFgA1K7gAFhC4ABnQMDEBBgcGFRQeATMyNxUGIyIkNTQ2NzY3ATUhFSEBIwIZLjFfYrRmmsPBrer+tZKDDw/+0AOz/TYBh` +
-	`uoC6SIVV5dxcmVZs0Hs/qmlTQkIAf2cnP10AAAAAAEAm/6MBD4GLwAVAEW4ADIruAAWL7gAFy+4ABYQuAAA0LgAAC+4ABXcuAAC0LgAFxC4AAvcugADAAAACxESObgADNwAuAALL7oABgARADUrMDE` +
-	`zETMVPgEzMh4BFREjETQuASMiBgcRm7pQ4nFjokG6H1MxYuFJBhD0gZJpqvn6aQWuoWdCuoz7wgAAAQCR/i4ERwRdABwAgbgAMiu6AAwAGAA1K7gADBC4AAXQuAAFL0EFANoAGADqABgAAl1BGwAJA` +
-	`BgAGQAYACkAGAA5ABgASQAYAFkAGABpABgAeQAYAIkAGACZABgAqQAYALkAGADJABgADV24AAwQuAAe3AC6ABQADwA1K7oABQAGADUruAAGELgAAtAwMQEjASE1IRUBFhceARUUBCMiJzUWMzI+ATU` +
-	`0JyYnAr/qAYb9NgOz/tAPD4OS/rXqrcHDmma0Yl8xLgE1AoycnP4DCAlNpan+7EGzWWVycZdXFSIAAAAAAQCR/i4ERwRdABwAebgAMiu6AAQAEAA1K0EbAAYABAAWAAQAJgAEADYABABGAAQAVgAEA` +
-	`GYABAB2AAQAhgAEAJYABACmAAQAtgAEAMYABAANXUEFANUABADlAAQAAl24ABAQuAAW0LgAFi8AugAIAA0ANSu6ABcAFgA1K7gAFhC4ABnQMDEBBgcGFRQeATMyNxUGIyIkNTQ2NzY3ATUhFSEBIwI` +
-	`ZLjFfYrRmmsPBrer+tZKDDw/+0AOz/TYBhuoBNiIVV5dxcmVZs0Hs/qmlTQkIAf2cnP10AAAAAAIAkf4uBEcGTgAcACMAk7gAMiu6AAwAGAA1K7gADBC4AAXQuAAFL0EFANoAGADqABgAAl1BGwAJA` +
-	`BgAGQAYACkAGAA5ABgASQAYAFkAGABpABgAeQAYAIkAGACZABgAqQAYALkAGADJABgADV26AB8AGAAMERI5uAAMELgAJdwAuAAeL7gAIi+6ABQADwA1K7oABQAGADUruAAGELgAAtAwMQEjASE1IRU` +
-	`BFhceARUUBCMiJzUWMzI+ATU0JyYnAzczAyMDMwK/6gGG/TYDs/7QDw+Dkv616q3Bw5pmtGJfMS5Yi5zpfOmbATUCjJyc/gMICU2lqf7sQbNZZXJxl1cVIgRwqP66AUYAAAACAJH/4QRHCBAAHAAjA` +
-	`JO4ADIrugAMABgANSu4AAwQuAAF0LgABS9BBQDaABgA6gAYAAJdQRsACQAYABkAGAApABgAOQAYAEkAGABZABgAaQAYAHkAGACJABgAmQAYAKkAGAC5ABgAyQAYAA1dugAfABgADBESObgADBC4ACX` +
-	`cALgAHi+4ACIvugAUAA8ANSu6AAUABgA1K7gABhC4AALQMDEBIwEhNSEVARYXHgEVFAQjIic1FjMyPgE1NCcmJwM3MwMjAzMCv+oBhv02A7P+0A8Pg5L+teqtwcOaZrRiXzEuWIuc6XzpmwLoAoycn` +
-	`P4DCAlNpan+7EGzWWVycZdXFSIEf6j+ugFGAAAAAQCX/i4ENQZOACQAy7gAMiu6AAsACgA1K7oAFAAgADUruAALELgAB9y4AAbcugABAAcABhESOboAAgAKABQREjm4AAsQuAAD0LgAFBC4AA3QuAA` +
-	`NL7oAGgAKAAsREjlBBQDaACAA6gAgAAJdQRsACQAgABkAIAApACAAOQAgAEkAIABZACAAaQAgAHkAIACJACAAmQAgAKkAIAC5ACAAyQAgAA1duAAUELgAJtwAugAcABcANSu6AAsACAA1K7oABQAGA` +
-	`DUrugANAA4ANSu4AA4QuAAC0DAxASMBIREzFSERIzUhESEVAxYXHgEVFAYjIic1FjMyPgE1NCcmJwMymgEB/lXm/p/cAVcCRckKClZh25pygIFmQ3dBPyEeATUCjPzamwWynP4PnP4DCAlNpan+7EG` +
-	`zWWVycZdXFSIAAAACACEAAAStBF0ABwAMACW4ADIrALgAAi+4AAAvuAAEL7oACQAGADUrugALAAAAAhESOTAxMyMBMwEjJyE3IQMnB+PCAd7NAeHVav3eMAHFuywnBF37o/ZvAahxcQAAAAACAAIAA` +
-	`ASdBF0ADwASAEe4ADIrugANAAAANSu4AA0QuAAI0LgAABC4ABHQALoADQAAADUrugAGAAcANSu6ABEAAQA1K7oACgALADUruAAAELgAA9AwMSERIQMjASEVIREhFSERIRUBIRECfv7GmKoCfAIf/ps` +
-	`BVv6qAWX84AEBAQz+9ARdcP5/cP5/ewFxAb8AAAAAAwBA/+EEjgR8ACMAMAA5AL+4ADIrugAyABQANSu6ACQAFwA1K7oABgArADUrugAAABcAJBESObgAFBC4AB3QQQUA2gArAOoAKwACXUEbAAkAK` +
-	`wAZACsAKQArADkAKwBJACsAWQArAGkAKwB5ACsAiQArAJkAKwCpACsAuQArAMkAKwANXbgAFxC4ADHQuAAGELgAO9wAugAoAAoANSu6ACAAGwA1K7oAFgAxADUruAAgELgAA9C4AAoQuAAR0LgAAxC` +
-	`4AC/cuAAoELgANtAwMQE+ATMyEhUUAgYjIi4BJw4BIyImETUhNTQuASMiBzU2MzIeARMRHgEzMhI1NCcmBwYBIxUUFjMyNjUCfjSIQHCkb7lqI08/MElQH3+kAZQtVDRleo1xSnxbLxguFnV1ISEza` +
-	`f7e2TY0NzgDe4J//uDiyP7Vpg8XITYR4wFQMlOUeTtPqkAzZv6k/hcREQFKyHZVVQMH/h4gsHaTkwAAAAABAHb/3gRABH8AGQBluAAyK7oAEwAGADUrQRsABgATABYAEwAmABMANgATAEYAEwBWABM` +
-	`AZgATAHYAEwCGABMAlgATAKYAEwC2ABMAxgATAA1dQQUA1QATAOUAEwACXQC6ABcAAgA1K7oACgAPADUrMDElBiMiJAI1NBIkMzIXFSYjIg4BFRQeATMyNwRAroq2/s6qqAEyuIquoZ94x3p+xHeeo` +
-	`g0vjQEVr60BFY4vhEN25IaI53JDAAAAAAIAmwAABHwEXQAKABUAibgAMiu4ABYvuAAXL7gAFhC4AADQuAAAL7gAFxC4AAbcuAAAELgAC9y4AAYQuAAQ3EEFANoAEADqABAAAl1BGwAJABAAGQAQACk` +
-	`AEAA5ABAASQAQAFkAEABpABAAeQAQAIkAEACZABAAqQAQALkAEADJABAADV0AugALAAoANSu6AAIAFAA1KzAxMxEhMhYSFRQOASMnMzI+ATU0LgErAZsBcP73fIPw/qaHza9LWKS7lwRdf/7+sKf9i` +
-	`Htpu6SgrV0AAAAAAgACAAAEfgRdAA4AHQCtuAAyK7gAHi+4AB8vuAAeELgAANC4AAAvuAAE0LgAHxC4AArcuAAAELgAD9y4AAoQuAAU3EEFANoAFADqABQAAl1BGwAJABQAGQAUACkAFAA5ABQASQA` +
-	`UAFkAFABpABQAeQAUAIkAFACZABQAqQAUALkAFADJABQADV24AA8QuAAZ0AC6AA8AAAA1K7oABgAYADUrugAEAAEANSu4AAQQuAAa0LgAARC4ABzQMDEzESM1MxEhIBYSFRQCBiMnMzI+ATU0LgErA` +
-	`REzFSOdm5sBcAER2oaG2ufQh9uZU1OR05f4+AICcAHrbv7tsKf++n97XMikoLdT/oVwAAAAAAEAywAABBEEXQALAC+4ADIrugALAAIANSu4AAsQuAAG0AC6AAAAAQA1K7oABAAFADUrugAIAAkANSs` +
-	`wMSUVIREhFSERIRUhEQQR/LoDNv2UAi790nt7BF1w/oVw/nkAAAIAnv3wA8UEXQAHABMAh7gAMiu6AAAABwA1K7gAABC4AATcuAAD3LoADgAHAAAREjm4AA4vQQUA2gAOAOoADgACXUEbAAkADgAZA` +
-	`A4AKQAOADkADgBJAA4AWQAOAGkADgB5AA4AiQAOAJkADgCpAA4AuQAOAMkADgANXbgACNwAuAALL7oAAAAFADUrugACAAMANSswMQERIRUhESE1ARQGIyImNTQ2MzIWAo8BNv4Q/skCEFYmJ1ZWJyZ` +
-	`WBF38PpsDwpv6ED8+Pj8+Pj4AAAABAG7/3gO8BF0AEQAnuAAyK7oADAAHADUruAAMELgAE9wAugADABAANSu6AAsACAA1KzAxNzUWMzI+ATURITUhERQOASMibtqTTX9L/qMCJ3HMn7IYiVMsZacCZ` +
-	`3D9JbOdVAAAAQCdAAAEzARdAAoAO7gAMiu6AAoAAAA1K7gAChC4AALQALgAAS+4AAQvuAAAL7gABy+6AAMAAAABERI5ugAJAAAAARESOTAxMxEzEQEzCQEjARGdygJB1v3TAnv9/ZgEXf3dAiP97v2` +
-	`1Ajr9xgAAAAEAAgAABCEEXQANACu4ADIrugANAAIANSu4AAIQuAAG0LgADRC4AAjQALgABy+6AAAAAQA1KzAxJRUhEQc1NxEzETcVBxEEIfy62dnK4eF7ewH2OHM6AfL+RDl2Of5QAAEAOAAABJUEX` +
-	`QASAH+4ADIruAATL7gAFC+4ABMQuAAB0LgAAS+4AADcuAAUELgAB9y4AAjcuAAF0LgABS+4AAgQuAAK0LgACi+4AAAQuAAQ0LoAEQABAAAREjkAuAACL7gABS+4AAAvuAAHL7oABAAAAAIREjm6AAs` +
-	`AAAACERI5ugARAAAAAhESOTAxMyMRMwkBMxEjETcjBwMjAycjF9ujywFmAWbGugUFLPDK8ycFBQRd/SEC3/ujAshiYv4XAeliYgABAH4AAARPBF0ADgBbuAAyK7gADy+4ABAvuAAM3LgAANy4AA8Qu` +
-	`AAE0LgABC+4AAPcuAAG0LgAAxC4AAjQuAAILwC4AAUvuAALL7gAAy+4AA0vugABAAMABRESOboACQADAAUREjkwMQE3BwEjETMRBzM3ATMRIwOVBEv96rq6BQU8AiW2ugLddHX9JARd/RlvbwLn+6M` +
-	`AAAIAR//eBIUEfwALABcAy7gAMiu4ABgvuAAZL7gAGBC4AAPQuAADL7gAGRC4AAncuAAP3EEFANoADwDqAA8AAl1BGwAJAA8AGQAPACkADwA5AA8ASQAPAFkADwBpAA8AeQAPAIkADwCZAA8AqQAPA` +
-	`LkADwDJAA8ADV24AAMQuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl0AugAMAAAANSu6AAYAEgA1KzAxBSAAERA` +
-	`AISAAERAAJTI2NTQmIyIGFRQWAmb++P7pARcBCAEJARb+6v73ksPDkpLDwyIBQQEQAQ8BQf6//vH+8P6/cO/y8u7u8vLvAAAAAwBD/+EEiQR8ACEAMQA6AMm4ADIrugAzABMANSu6ACIAFgA1K7oAB` +
-	`wAsADUrugAAABYAIhESOboADQAWACIREjm4ABMQuAAc0EEFANoALADqACwAAl1BGwAJACwAGQAsACkALAA5ACwASQAsAFkALABpACwAeQAsAIkALACZACwAqQAsALkALADJACwADV24ABYQuAAy0Lg` +
-	`ABxC4ADzcALoAKQAKADUrugAfABoANSu6ABUAMgA1K7gAHxC4AAPQuAAKELgAENC4ABoQuAAv0LgAKRC4ADfQMDEBPgEzMhYSFRQCIyImJw4BIyImETUhNTQuASMiBzU2MzIWExUUFhceATc+ATU0J` +
-	`iMiBgMjFRQWMzI2NQJbO4M2WI5UmbZQdicsdkp3pwGUMVUyZ3WNblWNcQ0SEkUpVVJPXENYuto8LzA/A9ZvN37+3bjZ/pdTU1NT4QFGPj2ydTdOrTxB/jVmf2YzMkcDBfjEpv6s/o0huWyLmQAAAAI` +
-	`AsAAABG4EXQAMABYAkbgAMiu4ABcvuAAYL7gAFxC4AALQuAACL7gAAdy4ABgQuAAI3LgAARC4AA3QuAAIELgAEtxBBQDaABIA6gASAAJdQRsACQASABkAEgApABIAOQASAEkAEgBZABIAaQASAHkAE` +
-	`gCJABIAmQASAKkAEgC5ABIAyQASAA1dALgAAS+6AAQAFQA1K7oADQAAADUrMDEBESMRITIeARUUBgQjJzMyPgE1NCYhIwF6ygGC7tp0nP7p71JXu7Zir/7+eQGY/mgEXU2UW2y5ZG9Gg0xhcAAAAgC` +
-	`VAAAEtARdAA8AGQCluAAyK7gAGi+4ABsvuAAaELgAB9C4AAcvuAAbELgADdy6AAMABwANERI5uAAO3LgAENC4AAcQuAAU3EEbAAYAFAAWABQAJgAUADYAFABGABQAVgAUAGYAFAB2ABQAhgAUAJYAF` +
-	`ACmABQAtgAUAMYAFAANXUEFANUAFADlABQAAl0AuAABL7gADS+6AAwAEAA1K7oAGAAAADUrugADAAAAGBESOTAxCQEjAS4CNTQ+ATMhESMZASMiBhUUHgEXIQMg/mXwAcF0e0ZpwMsBn8qX0Z8wXV8` +
-	`BGwHa/iYCCi5ng0FGeTv7owHaAhNdTixYTiUAAAIAlQAABLQEXQAPABkApbgAMiu4ABovuAAbL7gAAty4AAHcuAAaELgACNC4AAgvugAMAAgAAhESObgAARC4ABDQuAAIELgAFdxBGwAGABUAFgAVA` +
-	`CYAFQA2ABUARgAVAFYAFQBmABUAdgAVAIYAFQCWABUApgAVALYAFQDGABUADV1BBQDVABUA5QAVAAJdALgAAS+4AA0vugAZAAMANSu6AAAAEAA1K7oADAAQAAAREjkwMQERMxEhIi4BNTQ+ATcBMwE` +
-	`XIQ4CFRQWOwED6sr+YcvAaUZ7dP4/8AGbyv7lX10wn9GXAoMB2vujO3lGQYNnLgIK/iZxJU5YLE5dAAABAHb/3gRABH8AGQBtuAAyK7oABgATADUrQQUA2gATAOoAEwACXUEbAAkAEwAZABMAKQATA` +
-	`DkAEwBJABMAWQATAGkAEwB5ABMAiQATAJkAEwCpABMAuQATAMkAEwANXbgABhC4ABvcALoADwAKADUrugACABcANSswMRM2MzIEEhUUAgQjIic1FjMyPgE1NC4BIyIHdq6KtgEyqqj+zriKrqGfeMd` +
-	`6fsR3nqIEUC+N/uuvrf7rji+EQ3bkhojnckMAAAAAAQAwAAAEnQRdAAcAI7gAMiu6AAcAAAA1KwC4AAAvugADAAIANSu4AAIQuAAF0DAxIREhNSEVIRECAf4vBG3+LgPie3v8HgAAAQB+/94ETwRdA` +
-	`BUAO7gAMiu4ABYvuAAXL7gAFhC4AADQuAAAL7gAAdy4ABcQuAAN3LgACtwAuAAAL7gACy+6AAYAEQA1KzAxEzMRFB4BMzI+ATURMxEUDgEjIi4BNX7KQ4tVWo1DuoHQnpLecgRd/SORajc8f7ACpP0` +
-	`nnLFZTpvLAAAAAQAhAAAErQRdAAgAHbgAMisAuAAAL7gABC+4AAIvugAHAAIAABESOTAxATMBIwEzARc3A+vC/iLN/h/VAVEsJwRd+6MEXfz0cnEAAAEAHAAABLEEXQAVADm4ADIrALgAAS+4AAcvu` +
-	`AANL7gAAC+4AA8vugAFAAAAARESOboACwAAAAEREjm6ABMAAAABERI5MDEzAzMTFzM3EzMTFzM3EzMDIwMnIwcD0LS4ag4FFKO4uhMFDHWevL/IFQUTtARd/VhxcQKo/T1sbALD+6MC9XJy/QsAAAA` +
-	`AAQB6AAAEUwRdAAkAJ7gAMisAugABAAAANSu6AAUABgA1K7gABhC4AALQuAABELgAB9AwMTM1ASE1IRUBIRV6AuH9NgOz/SAC73sDcnBw/I57AAAAAQCR/+oERwRdABwAgbgAMiu6AAwAGAA1K7gAD` +
-	`BC4AAXQuAAFL0EFANoAGADqABgAAl1BGwAJABgAGQAYACkAGAA5ABgASQAYAFkAGABpABgAeQAYAIkAGACZABgAqQAYALkAGADJABgADV24AAwQuAAd3AC6ABQADwA1K7oABQAGADUruAAGELgAAtA` +
-	`wMQEjASE1IRUBFhceARUUBCMiJzUWMzI+ATU0JyYnAr/qAYb9NgOz/tAPD4OS/rXqrcHDmma0Yl8xLgIYAdVwcP6SBgc3d3q2qi6BQElSUW0/DxgAAAAAAQAhAAAErQRdAAgAHbgAMisAuAACL7gAA` +
-	`C+4AAQvugAHAAAAAhESOTAxMyMBMwEjAScH48IB3s0B4dX+rywnBF37owMMcnEAAQDbAAAEIQRdAAUAG7gAMiu6AAEAAgA1KwC4AAEvugADAAAANSswMQERIxEhFQGlygNGA+L8HgRdewAAAgCwAAA` +
-	`EbgRdAAwAFgCRuAAyK7gAFy+4ABgvuAAXELgAAtC4AAIvuAAB3LgAGBC4AAjcuAABELgADdC4AAgQuAAS3EEFANoAEgDqABIAAl1BGwAJABIAGQASACkAEgA5ABIASQASAFkAEgBpABIAeQASAIkAE` +
-	`gCZABIAqQASALkAEgDJABIADV0AuAABL7oABAAVADUrugANAAAANSswMQERIxEhMh4BFRQGBCMnMzI+ATU0JiEjAXrKAYLu2nSc/unvUle7tmKv/v55AZj+aARdTZRbbLlkb0aDTGFwAAACACEBswS` +
-	`tBhAABwAMACW4ADIrALgAAi+4AAAvuAAEL7oACQAGADUrugALAAAAAhESOTAxEyMBMwEjJyE3IQMnB+PCAd7NAeHVav3eMAHFuywnAbMEXfuj9m8BqHFxAAACAAIBswSdBhAADwASAEe4ADIrugANA` +
-	`AAANSu4AA0QuAAI0LgAABC4ABHQALoADQAAADUrugAGAAcANSu6ABEAAQA1K7oACgALADUruAAAELgAA9AwMQERIQMjASEVIREhFSERIRUBIRECfv7GmKoCfAIf/psBVv6qAWX84AEBAbMBDP70BF1` +
-	`w/n9w/n97AXEBvwAAAwAbAAAETwRdABoAJQAvARm4ADIrugAbAAAANSu6AAoAKwA1K7gAABC4AATQQQUA2gArAOoAKwACXUEbAAkAKwAZACsAKQArADkAKwBJACsAWQArAGkAKwB5ACsAiQArAJkAK` +
-	`wCpACsAuQArAMkAKwANXboADwArAAoREjm6ABIAKwAKERI5ugAfACsAChESObgAHy9BBQDaAB8A6gAfAAJdQRsACQAfABkAHwApAB8AOQAfAEkAHwBZAB8AaQAfAHkAHwCJAB8AmQAfAKkAHwC5AB8` +
-	`AyQAfAA1duAAW3LgAGxC4ACbQuAAWELgAMdwAugAbABoANSu6AAYALgA1K7oABAABADUruAAEELgAD9C4AAEQuAAR0LgAARC4ACTQuAAEELgAJtAwMTMRIzUzESEgHgEVFAYHBgczFSMWFxYVFA4BI` +
-	`yczMjY1NCYnJicjNTM+AjU0JisBrJGRAS8BEblcNjUbKPyhLhpCb8jcr7+gmVRYUqdT1GdfL4G7jQH3bwH3O3VLO2osFhVvHB9OTUqMS3BsTTheGxoDbx9FVDJPTgAAAwAbAbMETwYQABoAJQAvARm` +
-	`4ADIrugAbAAAANSu6AAoAKwA1K7gAABC4AATQQQUA2gArAOoAKwACXUEbAAkAKwAZACsAKQArADkAKwBJACsAWQArAGkAKwB5ACsAiQArAJkAKwCpACsAuQArAMkAKwANXboADwArAAoREjm6ABIAK` +
-	`wAKERI5ugAfACsAChESObgAHy9BBQDaAB8A6gAfAAJdQRsACQAfABkAHwApAB8AOQAfAEkAHwBZAB8AaQAfAHkAHwCJAB8AmQAfAKkAHwC5AB8AyQAfAA1duAAW3LgAGxC4ACbQuAAWELgAMdwAugA` +
-	`bABoANSu6AAYALgA1K7oABAABADUruAAEELgAD9C4AAEQuAAR0LgAARC4ACTQuAAEELgAJtAwMRMRIzUzESEgHgEVFAYHBgczFSMWFxYVFA4BIyczMjY1NCYnJicjNTM+AjU0JisBrJGRAS8BEblcN` +
-	`jUbKPyhLhpCb8jcr7+gmVRYUqdT1GdfL4G7jQGzAfdvAfc7dUs7aiwWFW8cH05NSoxLcGxNOF4bGgNvH0VUMk9OAAAAAAIAmwGzBHwGEAAKABUAibgAMiu4ABYvuAAXL7gAFhC4AADQuAAAL7gAFxC` +
-	`4AAbcuAAAELgAC9y4AAYQuAAQ3EEFANoAEADqABAAAl1BGwAJABAAGQAQACkAEAA5ABAASQAQAFkAEABpABAAeQAQAIkAEACZABAAqQAQALkAEADJABAADV0AugALAAoANSu6AAIAFAA1KzAxExEhM` +
-	`hYSFRQOASMnMzI+ATU0LgErAZsBcP73fIPw/qaHza9LWKS7lwGzBF1//v6wp/2Ie2m7pKCtXQAAAQDLAbMEEQYQAAsAL7gAMiu6AAsAAgA1K7gACxC4AAbQALoAAAABADUrugAEAAUANSu6AAgACQA` +
-	`1KzAxARUhESEVIREhFSERBBH8ugM2/ZQCLv3SAi57BF1w/oVw/nkAAQDLAbMEEQYQAAsAN7gAMiu6AAMABgA1K7gABhC4AArQuAADELgADdwAugAGAAMANSu6AAEAAAA1K7oACgAHADUrMDETNSERI` +
-	`TUhESE1IRHLA0b8ygJs/dICLgWVe/ujcAF7cAGHAAADAKwBswQ4BhAAEQAbACUA8bgAMiu6ABwAAAA1K7oABgAXADUrQQUA2gAXAOoAFwACXUEbAAkAFwAZABcAKQAXADkAFwBJABcAWQAXAGkAFwB` +
-	`5ABcAiQAXAJkAFwCpABcAuQAXAMkAFwANXboAIAAXAAYREjm4ACAvQQUA2gAgAOoAIAACXUEbAAkAIAAZACAAKQAgADkAIABJACAAWQAgAGkAIAB5ACAAiQAgAJkAIACpACAAuQAgAMkAIAANXbgAD` +
-	`dy6AAoAAAANERI5uAAcELgAEtC4AA0QuAAn3AC6ABwAEQA1K7oAAgAaADUrugASACUANSu6AAoAJQASERI5MDETESEgHgEVFA4BBx4BFRQOASMDMz4CNTQmKwERMzI2NTQuASsBrAEvARG5XDZqZbm` +
-	`Db8jcr9RnXy+Bu42/oJlUsLw4AbMEXTt1SztqVyc1nE1KjEsCZh9FVDJPTvyDbE04XjgAAAABAHYBkQRABjIAJACvuAAyK7gAJS+4ACYvuAAA3LgAJRC4AAjQuAAIL7gAABC4ABDQuAAQL7gAABC4A` +
-	`CDcugARAAAAIBESObgACBC4ABrcQRsABgAaABYAGgAmABoANgAaAEYAGgBWABoAZgAaAHYAGgCGABoAlgAaAKYAGgC2ABoAxgAaAA1dQQUA1QAaAOUAGgACXQC6AB4AAgA1K7oADgATADUrugAjACI` +
-	`ANSu4AB4QuAAg0LgAIC8wMQEGIyInJicmNTQ3Njc2MzIXFSYjIgcGBw4BBxQXFjMyNxEjNSEEQKaHwpiYVVZUVJmatoiwn6F3ZWI+Hh0Bc3T3IDGbAWUBtCNFRYuMsK2KjEZHL4RDOzpzOXxFtpSVA` +
-	`wFocAABAH4BswRPBhAACwBLuAAyK7gADC+4AA0vuAAMELgAANC4AAAvuAAL3LgAAtC4AA0QuAAG3LgABdy4AAjQALgAAS+4AAUvuAAAL7gABy+6AAQACQA1KzAxExEzESERMxEjESERfsoCPsnJ/cI` +
-	`BswRd/h8B4fujAg398wAAAQCsAbMEIAYQAAsAL7gAMiu6AAkAAgA1KwC6AAEAAAA1K7oABQAEADUruAAEELgAB9C4AAEQuAAJ0DAxEzUhESE1IRUhESEVrAFV/qsDdP6rAVUBs3ADfXBw/INwAAAAA` +
-	`QBuAZEDvAYQABEAJ7gAMiu6AAwABwA1K7gADBC4ABPcALoAAwAQADUrugALAAgANSswMRM1FjMyPgE1ESE1IREUDgEjIm7ak01/S/6jAidxzJ+yAcuJUyxlpwJncP0ls51UAAEAnQGzBMwGEAAKADu` +
-	`4ADIrugAKAAAANSu4AAoQuAAC0AC4AAEvuAAEL7gAAC+4AAcvugADAAAAARESOboACQAAAAEREjkwMRMRMxEBMwkBIwERncoCQdb90wJ7/f2YAbMEXf3dAiP97v21Ajr9xgABANsBswQhBhAABQAbu` +
-	`AAyK7oABQACADUrALgAAy+6AAAAAQA1KzAxARUhETMRBCH8usoCLnsEXfweAAABADgBswSVBhAAEgB/uAAyK7gAEy+4ABQvuAATELgAAdC4AAEvuAAA3LgAFBC4AAfcuAAI3LgABdC4AAUvuAAIELg` +
-	`ACtC4AAovuAAAELgAENC6ABEAAQAAERI5ALgAAi+4AAUvuAAAL7gABy+6AAQAAAACERI5ugALAAAAAhESOboAEQAAAAIREjkwMRMjETMJATMRIxE3IwcDIwMnIxfbo8sBZgFmxroFBSzwyvMnBQUBs` +
-	`wRd/SEC3/ujAshiYv4XAeliYgAAAAEAfgGzBE8GEAAOAHm4ADIruAAPL7gAEC+4AA8QuAAB0LgAAS+4AADcuAAD0LgAAy+4ABAQuAAK3LgAC9y4AAXQugAGAAoACxESObgACxC4AAfQuAAAELgADdC` +
-	`4AA0vALgAAi+4AAgvuAAAL7gACi+6AAYAAAACERI5ugANAAAAAhESOTAxASMRMwEXMycRMxEjAScXATi6tgIlPAUFurr96ksEAbMEXf0Zb28C5/ujAtx1dAAAAAABAH4BswRPBhAADgBbuAAyK7gAD` +
-	`y+4ABAvuAAM3LgAANy4AA8QuAAE0LgABC+4AAPcuAAG0LgAAxC4AAjQuAAILwC4AAUvuAALL7gAAy+4AA0vugABAAMABRESOboACQADAAUREjkwMQE3BwEjETMRBzM3ATMRIwOVBEv96rq6BQU8AiW` +
-	`2ugSQdHX9JARd/RlvbwLn+6MAAAIARwGRBIUGMgALABcAy7gAMiu4ABgvuAAZL7gAGBC4AAPQuAADL7gAGRC4AAncuAAP3EEFANoADwDqAA8AAl1BGwAJAA8AGQAPACkADwA5AA8ASQAPAFkADwBpA` +
-	`A8AeQAPAIkADwCZAA8AqQAPALkADwDJAA8ADV24AAMQuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUEFANUAFQDlABUAAl0AugAMAAA` +
-	`ANSu6AAYAEgA1KzAxASAAERAAISAAERAAJTI2NTQmIyIGFRQWAmb++P7pARcBCAEJARb+6v73ksPDkpLDwwGRAUEBEAEPAUH+v/7x/vD+v3Dv8vLu7vLy7wAAAgCwAbMEbgYQAAwAFgCRuAAyK7gAF` +
-	`y+4ABgvuAAXELgAAtC4AAIvuAAB3LgAGBC4AAjcuAABELgADdC4AAgQuAAS3EEFANoAEgDqABIAAl1BGwAJABIAGQASACkAEgA5ABIASQASAFkAEgBpABIAeQASAIkAEgCZABIAqQASALkAEgDJABI` +
-	`ADV0AuAABL7oABAAVADUrugANAAAANSswMQERIxEhMh4BFRQGBCMnMzI+ATU0JiEjAXrKAYLu2nSc/unvUle7tmKv/v55A0v+aARdTZRbbLlkb0aDTGFwAAACAJUBswS0BhAADwAZAKm4ADIruAAaL` +
-	`7gAGy+4ABoQuAAC0LgAAi+4AAHcuAAbELgACNy6AAwAAgAIERI5uAABELgAENC4AAgQuAAV3EEFANoAFQDqABUAAl1BGwAJABUAGQAVACkAFQA5ABUASQAVAFkAFQBpABUAeQAVAIkAFQCZABUAqQA` +
-	`VALkAFQDJABUADV0AuAABL7gADS+6AAQAGAA1K7oAEAAAADUrugAMAAAAEBESOTAxAREjESEyHgEVFA4BBwEjASchPgI1NCYrAQFfygGfy8BpRnt0AcHw/mXKARtfXTCf0ZcDjf4mBF07eUZBg2cu/` +
-	`fYB2nElTlgsTl0AAAEAMAGzBJ0GEAAHACO4ADIrugAHAAAANSsAuAAAL7oAAwACADUruAACELgABdAwMQERITUhFSERAgH+LwRt/i4BswPie3v8HgAAAAABAH4BkQRPBhAAFQA7uAAyK7gAFi+4ABc` +
-	`vuAAWELgAANC4AAAvuAAB3LgAFxC4AA3cuAAK3AC4AAAvuAALL7oABgARADUrMDETMxEUHgEzMj4BNREzERQOASMiLgE1fspDi1VajUO6gdCekt5yBhD9I5FqNzx/sAKk/SecsVlOm8sAAAABABwBs` +
-	`wSxBhAAFQA5uAAyKwC4AAEvuAAHL7gADS+4AAAvuAAPL7oABQAAAAEREjm6AAsAAAABERI5ugATAAAAARESOTAxEwMzExczNxMzExczNxMzAyMDJyMHA9C0uGoOBRSjuLoTBQx1nry/yBUFE7QBswR` +
-	`d/VhxcQKo/T1sbALD+6MC9XJy/QsAAAEAEf/RBK8GTgA/AQm4ADIrugA3AAYANSu6ABgAEgA1K7oAIwAkADUrugAEAAYANxESObgABC+4AALQuAACL7gABBC4AAjQuAAEELgAD9y4AArQuAAPELgAE` +
-	`dC4ABEvuAAYELgAFNC6ABUABgAjERI5uAAYELgALNC4ABIQuAAu0LoALwAGACMREjm6AD8ABgA3ERI5uAAjELgAQdwAuAATL7gAAC+6ADUAOwA1K7oAHAApADUrugAIAAUANSu4AAgQuAAL0LgABRC` +
-	`4AA3QugARAAAAExESOboAFQApABwREjm6ABcAAAATERI5ugAZAAUACBESOboALwAAABMREjm6ADcAAAATERI5ugA/ADsANRESOTAxFyMTJjURIzUzETMRMxUjERQXAREzEQEzARU+ATMyFhceARURI` +
-	`xE0LgEjIgYHESMRARYXHgEzMjcVDgEjIicmJ2xbtAmMjF34+AEBml0BRVn+YihxOS9PDw0JXRApGTFwJV3+cwEBEDUlQ0tCOxs7MSkYLwEPTnUBwpsBwv4+m/5BJx8CaQKF/gcB6f2PZIGSYUU1d7r` +
-	`9kAKHoWdCuoz9dQNB/asDAjc0VaQ3FS0mRgAAAAIAL//gBJ0EfAAsADQAl7gAMiu6ABMAEAA1K7oABwAIADUrugAkAC4ANSu4ACQQuAAA0LgAAC+4AAgQuAAa0LgABxC4ABzQugAdABAAJBESObgAB` +
-	`xC4ACbQuAAmL7oALAAuACQREjm4ACQQuAA23AC4AAIvuAAML7oAIQAyADUrugAuACUANSu4AAwQuAAq3LoABgAMACoREjm6AB0AMgAhERI5MDElBiMiJyYnFSM1DgEjIi4BNREzERQeATMyNjcRMxU` +
-	`2NzYzMhIRFSEUHgEzMjcBITU0JiMiBgSSk4NkTxsYezuSSEFrK3sVNiBBlDF7FhtQY4Sf/gNAaUJ3kP4WAW9YSkttSmpLGiNo9YqKaav4AnD9eaFnQrmNAotqIRlP/uT+2Ch0wGB4AacbiaKlAAAAA` +
-	`v+l//AEWwZOADEAQgDXuAAyK7oAAAABADUrugALACsANSu6ACAAOgA1K7gAKxC4AAjQuAALELgAF9C6ABgAAQAgERI5uAALELgAMtBBBQDaADoA6gA6AAJdQRsACQA6ABkAOgApADoAOQA6AEkAOgB` +
-	`ZADoAaQA6AHkAOgCJADoAmQA6AKkAOgC5ADoAyQA6AA1duAAgELgARNwAuAAmL7gACS+6ADUAKQA1K7oADwAVADUrugAcAD8ANSu4ABUQuAAA0LgAAC+4AA8QuAAG0LoACAAVAA8REjm6ABgAJgAJE` +
-	`RI5MDETIzQ+AjMyFzUzFRYzMjUzBgcOASMiJxE2NzYzMhcWFRQHBgcGIyImJyMRJiMiDgIBERYzMj4CNTQuAiMiBwYifRsxQicbGLodEjx/BhUVVzQXGFZ3d2SMcHBLSnx8tyBlS7oUFQoXFA0BJWZ` +
-	`UVJJsPiM5SydYaGgEuEJhPx4Hnf4NdWcuMDwG/qWMR0d5efbCmZlYWAgIBSIKCRku/an+IRpFgbdyVH5TKlhZAAACAHL/4QUkBk4AKgA2AOW4ADIrugAxAB0ANSu6ABQAAQA1K7gAFBC4ACbcuAAG0` +
-	`LgAFBC4AAjQuAAmELgAFtC6ABcAAQAUERI5uAAmELgAK9BBGwAGADEAFgAxACYAMQA2ADEARgAxAFYAMQBmADEAdgAxAIYAMQCWADEApgAxALYAMQDGADEADV1BBQDVADEA5QAxAAJduAAUELgAONw` +
-	`AuAAHL7oANAAaADUrugANABIANSu6ACEALgA1K7gAEhC4AADQuAAAL7gADRC4AATQugAGABIADRESObgAEhC4ABTQuAAUL7gAIRC4ACPQuAAjLzAxASM0NjMyFzUzFRYzMjUzDgIjIicRIzUOASMiJ` +
-	`jU0EjYzMhcWFzUmIyIGExEmIyICFRQWMzI2AxV9Z04cHLsaDzx/BipXNBQVu1XvZIzflPi3NWsQIBgWFixwY1Ws5X1QV9IE1oR8CIDiC3VmXj0E+yf7jI7y9sIBMbELAgPhDC78vAHfGv725aapsAA` +
-	`BAKcAAASIBm0ALwCVuAAyK7oALgAHADUruAAuELgAANy4AAzQuAAAELgAENC4AC4QuAAe0LgALxC4AB/QuAAuELgAItC4AC8QuAAj0AC4AAAvugAVABoANSu6ACcALAA1K7oAEAANADUruAAsELgAB` +
-	`tC4AAYvuAAnELgACtC4ABAQuAAf0LgADRC4ACHQuAAsELgAJdy6AC4ALAAnERI5MDEhESYjIgYVIzQ2MzIXESM1MzU0PgEzMhcVJiMiDgEdASEVIREWMzI1Mw4CIyInEQGfEhEWLH1nThYX+Phk0ay` +
-	`ChpFwWYhNAbv+RSITPH8GKlc0GhsBvwcuRoR8BQEYmzTd1IgbsTBClbJIm/6IEHVmXj0H/qgAAQATAAAEQAR8ACkAj7gAMiu6ACgABwA1K7oAFQAWADUruAAoELgAANy4AAzQuAAoELgADtC4ACkQu` +
-	`AAP0LgAKBC4ABzQuAApELgAHdC4ABUQuAAr3AC4AAAvugASABkANSu6ACEAJgA1K7gAJhC4AAbQuAAGL7gAIRC4AArQugAMACYAIRESObgAEhC4ABbcugAPABIAFhESOTAxMxEmIyIGFSM0NjMyFxE` +
-	`zFT4BMzIXESM1JiMiBgcVFjMyNTMOAiMiJxH6FRMWLH1nThkZulfhimVlsy8baMhfHxE8fwYqVzQXGQGLCS5GhHwHAkT0iokf/m3/CKSk0A51Zl49Bv7bAAABAH3/4QRPBF0AJwBXuAAyK7oAHwAAA` +
-	`DUruAAfELgAIdy4AAXQuAAFL7gAHxC4ABPQuAAgELgAFNAAuAAgL7oACgAPADUrugAYAB0ANSu4AB0QuAAA0LgAAC+4ABgQuAAD0DAxEzQ2MzIXNjc+ATMyFxUmIyIOAR0BFjMyNTMOAiMiJxEjESY` +
-	`jIgYVfWdOGxwMNEO+pXeJnGtdgkkdETx/BipXNBcXuhYUFiwBhIR8CL1Vb2Acri5Bmr8LDXVmXj0F/lkCDQouRgAAAAABAGD/4QRBBcIAMACFuAAyK7oAHAABADUruAAcELgALNy4AAbQuAAsELgAC` +
-	`tC4ABwQuAAM0LgAERC4AA3QuAAcELgAENAAuAALL7oAIQAnADUrugAVABoANSu6AAoABwA1K7gAGhC4AADQuAAAL7gAFRC4AATQugAGABoAFRESObgAChC4AA3QuAAHELgAD9AwMQEjNDYzMhc1ITU` +
-	`hETMRIRUhERYzMjUzDgIjIicWFx4BMzI3FQ4BIyIuAT0BJiMiBgEIfWdOGxz+6QEXugHx/g8bEDx/BipXNBUWAx0ga0mHlYN2N3XCYxcWFiwBhIR8COmbAcL+Ppv+tQt1Zl49BXAxNzRVpDcVWrC4S` +
-	`QwuAAEAOAAABJUEXQApAC+4ADIrALoAHgAfADUrugANAA4ANSu6ABEAGgA1K7oABAAkADUruAAOELgACtAwMRM1NDYzMhYXFhcTITUhFQEWMzI2PQEzFRQGIyInAyEVITUBJiMiDgEdATibgyE/KCA` +
-	`5+/1jA5P+tGZCSkeAvWpgnfsCyfxGAUlRNihFKgGTEoPBDRAMHwEhm5v+hDZrPxAQp59X/uCrqwF8JypcIxIAAAAAAgBy/i8EQARtACIALgC3uAAyK7gALy+4ADAvuAAvELgAD9C4AA8vuAAO3LgAA` +
-	`NC4ADAQuAAG3LgAGNC4ABgvuAAGELgAKdxBBQDaACkA6gApAAJdQRsACQApABkAKQApACkAOQApAEkAKQBZACkAaQApAHkAKQCJACkAmQApAKkAKQC5ACkAyQApAA1dugAZAAYAKRESObgADhC4ACP` +
-	`QALoAJgAKADUrugAWABwANSu6AAMALAA1K7gAChC4AAzQuAAMLzAxAT4BMzIWFRQCBiMiJyYnIxEQNjc2JDMyFxUuASMiBgcOARUZARYzMhI1NCYjIgYBLVXvZIzflPi3NWsQILsPESgBHcrL0E/nb` +
-	`FWYLy8iY1Ws5X1QV9IBoYyO8vbC/s+xCwIDAxYBGn1AlaxovDZTNjMzh3D+hf4hGgEK5aapsAAAAAIAIQAABK0GEAAHAAwAJbgAMisAuAAAL7gABC+4AAIvugAJAAYANSu6AAsAAAACERI5MDEzIwE` +
-	`zASMDITchAycH48IB3s0B4dVq/d4wAcW7LCcGEPnwAVWbAk2cnAAAAAMArAAABDgGEAARABsAJQDxuAAyK7oAHAAAADUrugAGABcANStBBQDaABcA6gAXAAJdQRsACQAXABkAFwApABcAOQAXAEkAF` +
-	`wBZABcAaQAXAHkAFwCJABcAmQAXAKkAFwC5ABcAyQAXAA1dugAgABcABhESObgAIC9BBQDaACAA6gAgAAJdQRsACQAgABkAIAApACAAOQAgAEkAIABZACAAaQAgAHkAIACJACAAmQAgAKkAIAC5ACA` +
-	`AyQAgAA1duAAN3LoACgAAAA0REjm4ABwQuAAS0LgADRC4ACfcALoAHAARADUrugACABoANSu6ABIAJQA1K7oACgAlABIREjkwMTMRISAeARUUDgEHHgEVFA4BIwMzPgI1NCYrAREzMjY1NC4BKwGsA` +
-	`S8BEblcNmpluYNvyNyv1GdfL4G7jb+gmVSwvDgGEFOiaFKTeTZK2GxnwmgDVStfdUZubPsnl2pOg00AAQDbAAAEIQYQAAUAG7gAMiu6AAEAAgA1KwC4AAEvugADAAAANSswMQERIxEhFQGlygNGBWX` +
-	`6mwYQqwAAAgAhAAAErQYQAAMACAATuAAyKwC4AAAvugAFAAIANSswMQEzASElIQEnBwICzQHe+3QBBQKV/t8nLAYQ+fCbA6KdngABAMsAAAQRBhAACwAvuAAyK7oACwACADUruAALELgABtAAugAAA` +
-	`AEANSu6AAQABQA1K7oACAAJADUrMDElFSERIRUhESEVIREEEfy6Azb9lAIu/dKrqwYQnP3xm/3hAAABAHoAAARTBhAACQAnuAAyKwC6AAEAAAA1K7oABQAGADUruAAGELgAAtC4AAEQuAAH0DAxMzU` +
-	`BITUhFQEhFXoC4f02A7P9IALvqwTJnJz7N6sAAAABAH4AAARPBhAACwBLuAAyK7gADC+4AA0vuAAMELgAANC4AAAvuAAL3LgAAtC4AA0QuAAG3LgABdy4AAjQALgAAC+4AAcvuAABL7gABS+6AAQAC` +
-	`QA1KzAxMxEzESERMxEjESERfsoCPsnJ/cIGEP1kApz58ALZ/ScAAAAAAwBH/9EEhQY+AAsAFwAbANO4ADIruAAcL7gAHS+4ABwQuAAD0LgAAy+4AB0QuAAJ3LgAD9xBBQDaAA8A6gAPAAJdQRsACQA` +
-	`PABkADwApAA8AOQAPAEkADwBZAA8AaQAPAHkADwCJAA8AmQAPAKkADwC5AA8AyQAPAA1duAADELgAFdxBGwAGABUAFgAVACYAFQA2ABUARgAVAFYAFQBmABUAdgAVAIYAFQCWABUApgAVALYAFQDGA` +
-	`BUADV1BBQDVABUA5QAVAAJdALoADAAAADUrugAGABIANSu6ABkAGAA1KzAxBSAAERAAISAAERAAJTISERACIyICERASAzUhFQJm/vj+6QEXAQgBCQEW/ur+95LDw5KSw8NbAdwvAb0BegF5Ab3+Q/6` +
-	`H/ob+Q5wBSwFQAVABS/61/rD+sP61AkubmwAAAQCsAAAEIAYQAAsAL7gAMiu6AAkAAgA1KwC6AAEAAAA1K7oABQAEADUruAAEELgAB9C4AAEQuAAJ0DAxMzUhESE1IRUhESEVrAFV/qsDdP6rAVWbB` +
-	`NmcnPsnmwABAJ0AAATMBhAACgA7uAAyK7oACgAAADUruAAKELgAAtAAuAABL7gABC+4AAAvuAAHL7oAAwAAAAEREjm6AAkAAAABERI5MDEzETMRATMJASMBEZ3KAkHW/dMCe/39mAYQ/QcC+f0f/NE` +
-	`DF/zpAAAAAQAhAAAErQYQAAgAHbgAMisAuAAFL7gAAy+4AAcvugABAAMABRESOTAxAScHASMBMwEjAponLP6v1QHhzQHewgQ9nZ77xAYQ+fAAAAEAOAAABJUGEAASAH+4ADIruAATL7gAFC+4ABMQu` +
-	`AAB0LgAAS+4AADcuAAUELgAB9y4AAjcuAAF0LgABS+4AAgQuAAK0LgACi+4AAAQuAAQ0LoAEQABAAAREjkAuAACL7gABS+4AAAvuAAHL7oABAAAAAIREjm6AAsAAAACERI5ugARAAAAAhESOTAxMyM` +
-	`RMwkBMxEjETcjBwMjAycjF9ujywFmAWbGugUFLPDK8ycFBQYQ/AID/vnwA92IiP1ZAqeIiAABAH4AAARPBhAADgB5uAAyK7gADy+4ABAvuAAPELgAAdC4AAEvuAAA3LgAA9C4AAMvuAAQELgACty4A` +
-	`AvcuAAF0LoABgAKAAsREjm4AAsQuAAH0LgAABC4AA3QuAANLwC4AAIvuAAIL7gAAC+4AAovugAGAAAAAhESOboADQAAAAIREjkwMSEjETMBFzMnETMRIwEnFwE4urYCJTwFBbq6/epLBAYQ+/eamgQ` +
-	`J+fAD+KOhAAADAE0AAASBBhAAAwAHAAsAH7gAMisAugAFAAQANSu6AAkACAA1K7oAAQAAADUrMDETNSEVATUhFQE1IRXjAwj8YgQ0+8wENAK6m5v9RpubBXWbmwACAEf/0QSFBj4ACwAXAMu4ADIru` +
-	`AAYL7gAGS+4ABgQuAAD0LgAAy+4ABkQuAAJ3LgAD9xBBQDaAA8A6gAPAAJdQRsACQAPABkADwApAA8AOQAPAEkADwBZAA8AaQAPAHkADwCJAA8AmQAPAKkADwC5AA8AyQAPAA1duAADELgAFdxBGwA` +
-	`GABUAFgAVACYAFQA2ABUARgAVAFYAFQBmABUAdgAVAIYAFQCWABUApgAVALYAFQDGABUADV1BBQDVABUA5QAVAAJdALoADAAAADUrugAGABIANSswMQUgABEQACEgABEQACUyEhEQAiMiAhEQEgJm/` +
-	`vj+6QEXAQgBCQEW/ur+95LDw5KSw8MvAb0BegF5Ab3+Q/6H/ob+Q5wBSwFQAVABS/61/rD+sP61AAAAAQAhAAAErQYQAAsAT7gAMiu4AAwvuAANL7gADBC4AADQuAAAL7gADRC4AAfcuAAI3LgAABC` +
-	`4AAvcALgAAC+4AAcvugADAAIANSu4AAIQuAAF0LgAAhC4AAnQMDEzESM1IRUjESMRIRHbugSMu8n+fAV0nJz6jAV0+owAAAIAsAAABG4GEAAMABYAkbgAMiu4ABcvuAAYL7gAFxC4AALQuAACL7gAA` +
-	`dy4ABgQuAAI3LgAARC4AA3QuAAIELgAEtxBBQDaABIA6gASAAJdQRsACQASABkAEgApABIAOQASAEkAEgBZABIAaQASAHkAEgCJABIAmQASAKkAEgC5ABIAyQASAA1dALgAAS+6AAQAFQA1K7oADQA` +
-	`AADUrMDEBESMRITIeARUUAgQjJzMyPgE1NCYhIwF6ygGC7tp0nP7p71JXu7Zir/7+eQI2/coGEGvOf5X+/oubYbZqhpwAAQBbAAAEYwYQAAsAH7gAMisAugAAAAEANSu6AAYABQA1K7gABRC4AAjQM` +
-	`DElFSE1CQE1IRUhCQEEY/v4AdL+RQPK/TQBm/4iurq6An8CO5yc/e/9VwAAAAEAMAAABJ0GEAAHACO4ADIrugAHAAAANSsAuAAAL7oAAwACADUruAACELgABdAwMSERITUhFSERAgH+LwRt/i4FZau` +
-	`r+psAAAEANAAABJkGEAAIAC+4ADIrugAIAAAANSu6AAQAAAAIERI5ALgAAi+4AAUvuAAAL7oABAAAAAIREjkwMSERATMJATMBEQIC/jLlAVkBWM/+MwLOA0L9mAJo/L79MgAAAAMAVwAABHYGEAAVA` +
-	`B4AJwB/uAAyK7gAKC+4AAXQuAAFL7gAANxBAwAvAAAAAV1BAwBAAAAAAV24AAnQuAAAELgAFdy4AAvQuAAAELgAIdxBAwAvACEAAV1BAwBAACEAAV24ABDcuAAFELgAGNy4AAAQuAAc0LgAFRC4ACX` +
-	`QuAAQELgAKdwAuAAKL7gAAC8wMSE1JicmNTQ3Njc1MxUWFxYVFAcGBxUBBhUUFxYXEQYBNjU0JyYnETYCFbh2kJB2uKO3d5CQd7f+wVhYPl5eAZ1YWD9dXb8Yiqj//6eJGcDAGYmn//+oihi/BEZ0y` +
-	`st0UxgDUhj9MXTLynRSGPyuGAABADAAAASdBhAACwAruAAyKwC4AAMvuAAGL7gAAC+4AAkvugAFAAAAAxESOboACwAAAAMREjkwMSEjCQEzCQEzCQEjAQEC0gHG/mblASkBMNP+ZgHK6f6rAysC5f3` +
-	`pAhf9KvzGAmsAAAEAfgAABE8GEAAfAFG4ADIrugAJAAYANSu6AB8AAAA1K7oAGQAWADUruAAAELgADtC4AB8QuAAQ0LgAGRC4ACHcALgABy+4AA8vuAAXL7gAAC+6AA4AAAAHERI5MDEhESYnLgERN` +
-	`TMRFBYXFhcRMxE2Nz4BPQEzERQGBwYHEQIVZVFvcspDRSEkoywnR0O6gWhKZAK9Cyg22AEa+P7uyZMnEgoCsf1NChcqsfTD/vTZ9T4sDf1BAAADADgAAASVBHwALQA7AEoBO7gAMiu4AEsvuAAA0Lg` +
-	`AAC+4AAXQuAAAELgALdy4AAfQuAAAELgAJNxBAwAfACQAAV1BAwDAACQAAV1BAwBwACQAAV26AAgAAAAkERI5uAAj3LgADtC4ACQQuAAd3EEDAB8AHQABXUEDAMAAHQABXUEDAHAAHQABXboADwAkA` +
-	`B0REjm4ABPQuAATL7gAHRC4ABzcuAAW0LoAIgAkAB0REjm4AB0QuAAu0LgAIxC4ADXQuAAkELgAPNC4AC0QuABC0LoAQwAAACQREjm6AEoAAAAcERI5uAAcELgATNwAuAAAL7gAHC+4ACMvugATADI` +
-	`ANSu6ADkAIAA1K7oARQAoADUruAATELgAC9C6AB4AIAA5ERI5ugAiACAAORESObgAMhC4AD/QugBDACgARRESOboASgAoAEUREjkwMTMRIzU0NxEzET4BMzIWHQE+AjMyFh0BMxUUBxEjEQYjIicRI` +
-	`xEnJiMiBwYHEQE0LgEjIgYHFRcWMzI3ATQmIyIGBxU2MzIWFxYXRw8Po02FRFFjIV1kQUhmEBCjODxLb5wWdEcoIwoIAvgMJBU0dz4bb0Y7I/42OhczdTUwPCE/KBYkAS8SOjICsP8Ao3x/dTxmf0t` +
-	`6tuYQRTb+JQEpGTX+uwGbDUIVBgj+OQL7XkUkn7hzDz0iASyUNLKLFBQNEAkSAAACADgAAASVBHwAIQAyAHG4ADIrugAgAAMANSu6ABcAGAA1K7gAIBC4AADcuAAH0LgAIBC4AAnQuAAhELgACtC4A` +
-	`BcQuAAS0LgAEi+4ABgQuAAU3LgAGBC4ACLQugAqAAMAFBESObgAFxC4ADTcALgAAC+4ABcvugANACYANSswMTMRIzU0NzY3ETMVPgEzMhYXFhczFRQHESMRIiMiLwEmJxEBNC4BIyIHBgcWFx4BHwE` +
-	`WM5tjTQsLulDicWOiIBoGWFe6Cwtjo2ZpRAIvH1MxYnFKOQYFIFBQeW9GAZMSg2ANCwG99IGSaVVFrxCgUP42AXRdOzsG/bMCh6FnQl0+UgEBBh8tQz0AAAAAAv+g/k4EWwR8ACoANgDfuAAyK7oAI` +
-	`wABADUrugAPADEANSu4ACMQuAAG3LgAIxC4AAjQuAAjELgAF9C4AAnQuAAGELgAJdC4ACMQuAAr0LgAFxC4ACzQQQUA2gAxAOoAMQACXUEbAAkAMQAZADEAKQAxADkAMQBJADEAWQAxAGkAMQB5ADE` +
-	`AiQAxAJkAMQCpADEAuQAxAMkAMQANXbgADxC4ADjcALgAJC+6ABwAIQA1K7oADAA0ADUrugAuABMANSu4ACEQuAAA0LgAAC+4ABwQuAAE0LoABgAhABwREjm4ABMQuAAV0LgAFS8wMRMjNDYzMhcRM` +
-	`xU+ATMyFhUUAgYjIicmJxUWMzI1Mw4CIyInFSM1JiMiBgERFjMyEjU0JiMiBh19Z04cHLpW7mSM4JX4tzRsECAaEDx/BipXNBUVuhgWFiwBKmVVqed+UFfR/qqEfAgEu/uMjvL2wv7PsQsCA78MdWZ` +
-	`ePQVgxAwuA5X+IRoBCeanqLAAAAABAH4AAARPBF0AHwBHuAAyK7oACQAGADUrugAfAAAANSu6ABkAFgA1K7gAABC4AA7QuAAfELgAENC4ABkQuAAh3AC4AAcvuAAPL7gAFy+4AAAvMDEhESYnLgE9A` +
-	`TMVFBYXFhcRMxE2Nz4BPQEzFRQGBwYHEQIVZVFvcspDRSEkoywnR0O6gWhKZAH5CBwnnMuyxZFpHA0IAfD+DwcRHn+wjMGcsC0fCv4GAAAAAQAhAAAErQRdAAsAT7gAMiu4AAwvuAANL7gADBC4AAD` +
-	`QuAAAL7gADRC4AAfcuAAI3LgAABC4AAvcALgAAC+4AAcvugADAAIANSu4AAIQuAAF0LgAAhC4AAnQMDEzESM1IRUjESMRIRHbugSMu8n+fAPtcHD8EwPt/BMAAAEAKAAfBKQEXQAlAJW4ADIrugAOA` +
-	`BgANSu4AA4QuAAG0EEFANoAGADqABgAAl1BGwAJABgAGQAYACkAGAA5ABgASQAYAFkAGABpABgAeQAYAIkAGACZABgAqQAYALkAGADJABgADV24ABgQuAAh0LgADhC4ACfcALoAFAARADUrugABAAI` +
-	`ANSu6AB4ACgA1K7gAChC4ABvQuAACELgAJNAwMRMhFSEeARUUBisBHgIVFAYjITUhMj4BNTQmJyE1ITI2NTQmJyEoBF3/AKN8f3U8Zn9Lerb8tAL7XkUkn7j9lQL6lDSyi/17BF2jTYVEUWMhXWRBS` +
-	`GajDCQVNHc+nDoXM3U1AAAAAAEAIwBOBJ8D8QAVAHW4ADIrugAGABEANStBBQDaABEA6gARAAJdQRsACQARABkAEQApABEAOQARAEkAEQBZABEAaQARAHkAEQCJABEAmQARAKkAEQC5ABEAyQARAA1` +
-	`duAAGELgAF9wAugANAAoANSu6AAEAAgA1K7gAAhC4ABTQMDETIRUjHgEVFA4BIyE1ITI+ATU0JichIwRd9YqKaav4/ZACh6FnQrmN/XUD8bpa3G5io0C6H1MwYuFKAAAAAAEAAv/qBE8EXQATACu4A` +
-	`DIrugAAAAEANSsAugANAAgANSu6ABMAAgA1K7gACBC4AArQuAAKLzAxISMRIQcCBwYhIic1FjMyNzYbASEET8n+yQkWP1z+8UFDMU6HOikQFALAA+67/jGZ4Qp9DsGIATcBegAAAgAZAB8EtAQ+AAs` +
-	`AFwDLuAAyK7gAGC+4ABkvuAAA3LgAGBC4AAbQuAAGL7gAABC4AAzcQQUA2gAMAOoADAACXUEbAAkADAAZAAwAKQAMADkADABJAAwAWQAMAGkADAB5AAwAiQAMAJkADACpAAwAuQAMAMkADAANXbgAB` +
-	`hC4ABLcQRsABgASABYAEgAmABIANgASAEYAEgBWABIAZgASAHYAEgCGABIAlgASAKYAEgC2ABIAxgASAA1dQQUA1QASAOUAEgACXQC6ABUAAwA1K7oACQAPADUrMDEBFAAjIgA1NAAzMgAHNCYjIgY` +
-	`VFBYzMjYEtP6x///+sgFO//8BT5voy8ro6MrL6AIv8P7gASDw7wEg/uDvlbCwlZawsAAAAQAoAF0EwwQAABgA37gAMiu4ABkvuAAaL7gAGRC4AALQuAACL7gAGhC4AAncugAMAAIACRESObgADtxBB` +
-	`QDaAA4A6gAOAAJdQRsACQAOABkADgApAA4AOQAOAEkADgBZAA4AaQAOAHkADgCJAA4AmQAOAKkADgC5AA4AyQAOAA1duAACELgAFdxBGwAGABUAFgAVACYAFQA2ABUARgAVAFYAFQBmABUAdgAVAIY` +
-	`AFQCWABUApgAVALYAFQDGABUADV1BBQDVABUA5QAVAAJdugAYAAIACRESOQC4AAAvuAALL7oABgASADUrMDE3JjU0EiQzMgAVFAcjNjU0LgEjIgQVFBYXZz+bAQmp/gFQQbNZZdZxl/7uETZdu6moA` +
-	`QWS/rXqrcHDmma0Yr3KPXafAAMAHAAfBLAEPgAWAB4AJgDnuAAyK7gAJy+4ACgvuAAT3LgAItxBBQDaACIA6gAiAAJdQRsACQAiABkAIgApACIAOQAiAEkAIgBZACIAaQAiAHkAIgCJACIAmQAiAKk` +
-	`AIgC5ACIAyQAiAA1duAAC0LgAAi+4ACcQuAAH0LgABy+4ABrcQRsABgAaABYAGgAmABoANgAaAEYAGgBWABoAZgAaAHYAGgCGABoAlgAaAKYAGgC2ABoAxgAaAA1dQQUA1QAaAOUAGgACXbgADtC4A` +
-	`A4vugAXAAcAExESOboAHwAHABMREjkAugAdAAQANSu6ABAAJQA1KzAxJRUnBiMiABE0PgE3JzUXNjMyABEUBgcnAQYVFBYzMgkBNjU0JiMiBLDGq97b/s4MGB15wa7b1QE+EzHU/ast0LKH/nwCWTD` +
-	`cp5C0lZaWAQ4BADJkTzhelpWV/vj++D95YgkB0Flln6cCWP4vYFW3mAAAAAACAFf/4QR2BhAAJQA2AMe4ADIrugA0AB0ANSu6AA8AKQA1K0EbAAYANAAWADQAJgA0ADYANABGADQAVgA0AGYANAB2A` +
-	`DQAhgA0AJYANACmADQAtgA0AMYANAANXUEFANUANADlADQAAl26AAAAHQA0ERI5uAAAL7gAAdy4ACkQuAAX3LoAEwAdABcREjm6ACEAAAABERI5uAAPELgAONwAuAAAL7gADS+6ACYAGgA1K7oABgA` +
-	`tADUrugATAC0ABhESOboAIQAtAAYREjm4AC0QuAAv0DAxEzMVFB4BOwE2Nz4BPQEzFRQHBgcWFxYVFAQjIiQ1NDc2NyYnJhEBMjY1NCcmJysCBgcGFRQWfspDi1UIVUNHQ7pBMkotJ5D+4O/w/uCQI` +
-	`CRJKzkB6ZWwWFaPCAcIjFNYsAYQs8mTTQInKrH0ZK3Ze187Fx91///r6///dRoUMlJsARr7BYTLykJAAgQ+QsrLhAACAFf/6gR2BF0AJQA2AMe4ADIrugA0AB0ANSu6AA8AKQA1K0EbAAYANAAWADQ` +
-	`AJgA0ADYANABGADQAVgA0AGYANAB2ADQAhgA0AJYANACmADQAtgA0AMYANAANXUEFANUANADlADQAAl26AAAAHQA0ERI5uAAAL7gAAdy4ACkQuAAX3LoAEwAdABcREjm6ACEAAAABERI5uAAPELgAO` +
-	`NwAuAAAL7gADS+6ACYAGgA1K7oABgAtADUrugATAC0ABhESOboAIQAtAAYREjm4AC0QuAAv0DAxEzMVFB4BOwE2Nz4BPQEzFRQHBgcWFxYVFAQjIiQ1NDc2NyYnJjUBMjY1NCcmJysCBgcGFRQWfsp` +
-	`Di1UIVUNHQ7pBMkotJ5D+4O/w/uCQICRJKzkB6ZWwWFaPCAcIjFNYsARdgJFqNwEcHoCvSHycWUQrEBdUuLepqbe4VBMOJDtOy/xqX5KSLy8BAy0vkpJfAAACAFcBnQR2BhAAJQA2AMe4ADIrugA0A` +
-	`B0ANSu6AA8AKQA1K0EbAAYANAAWADQAJgA0ADYANABGADQAVgA0AGYANAB2ADQAhgA0AJYANACmADQAtgA0AMYANAANXUEFANUANADlADQAAl26AAAAHQA0ERI5uAAAL7gAAdy4ACkQuAAX3LoAEwA` +
-	`dABcREjm6ACEAAAABERI5uAAPELgAONwAuAAAL7gADS+6ACYAGgA1K7oABgAtADUrugATAC0ABhESOboAIQAtAAYREjm4AC0QuAAv0DAxEzMVFB4BOwE2Nz4BPQEzFRQHBgcWFxYVFAQjIiQ1NDc2N` +
-	`yYnJjUBMjY1NCcmJysCBgcGFRQWfspDi1UIVUNHQ7pBMkotJ5D+4O/w/uCQICRJKzkB6ZWwWFaPCAcIjFNYsAYQgJFqNwEcHoCvSHycWUQrEBdUuLepqbe4VBMOJDtOy/xqX5KSLy8BAy0vkpJfAAA` +
-	`DADEBIASbBMMAFQAhAC0A8bgAMiu4AC4vuAAvL7gALhC4ABzQuAAcL7gALxC4AAbcugADABwABhESObgAEdxBBQDaABEA6gARAAJdQRsACQARABkAEQApABEAOQARAEkAEQBZABEAaQARAHkAEQCJA` +
-	`BEAmQARAKkAEQC5ABEAyQARAA1duAAcELgAFtxBGwAGABYAFgAWACYAFgA2ABYARgAWAFYAFgBmABYAdgAWAIYAFgCWABYApgAWALYAFgDGABYADV1BBQDVABYA5QAWAAJduAAi0LgAHBC4ACjQALo` +
-	`ADQAKADUrugAfABkANSu6AAEAAgA1K7gAAhC4ABTQMDEBIRUjHgEVFA4BIyE1ITI+ATU0JichAxQGIyImNTQ2MzIWERQGIyImNTQ2MzIWAWEDJLBjY0x6s/4/AdJzSy+FZf4rfTQmJDU1JCY0NCYkN` +
-	`TUkJjQEw7pa3G5io0C6H1MwYuFK/hUzSUoyMkpJAYAzSksyMkpKAAAAAAEAVwIuBHYEfAAZABe4ADIrugAPABMANSsAugAWAAwANSswMQEqAgYVBjwBNjUmIyIGFSMiJgc0ADMyABUEEhMkHRIBAbC` +
-	`VlrBiJT8EASDw7wEgAjEBAQErQEcZ6OjKAQH/AU7+sv8AAQBX/+EEdgI1ABMAebgAMiu4ABQvuAAVL7gAANy4ABQQuAAG0LgABi+4AAfcuAAAELgADdxBBQDaAA0A6gANAAJdQRsACQANABkADQApA` +
-	`A0AOQANAEkADQBZAA0AaQANAHkADQCJAA0AmQANAKkADQC5AA0AyQANAA1dALoACgADADUrMDEBFAAjIgA1MxQWMzI2NTQ+ATIXFgR2/uDv8P7gyrCWlbASHSQTLAIv//6xAU//y+joywIDAQECAAA` +
-	`AAgDQA0wD/AYHABQAIACzuAAyK7gAIS+4ACIvuAAQ3LgAANy4ACEQuAAG0LgABi+4AAAQuAAO0LgAABC4ABXQuAAGELgAG9xBGwAGABsAFgAbACYAGwA2ABsARgAbAFYAGwBmABsAdgAbAIYAGwCWA` +
-	`BsApgAbALYAGwDGABsADV1BBQDVABsA5QAbAAJdALoAHgADADUrugAKABgANSu4AAoQuAAM0LgADC+4AAoQuAAO0LgADi+6ABIAAwAeERI5MDEBDgEjIiY1ND4BMzIXFhczERQXIyYnESYjIgYVFBY` +
-	`zMjYDRUS/UHCydsaTKlYNGZYhmxELT0SKt2RARqgD9lRWkpN1t2oHAQL+J3lMN+QBIA+fimNmagAAAAADAK0DTAQfBhAAIwAwADkAt7gAMiu6ACQAFQA1K7oABgArADUrugAAABUAJBESObgAJBC4A` +
-	`BfcuAAVELgAHdBBBQDaACsA6gArAAJdQRsACQArABkAKwApACsAOQArAEkAKwBZACsAaQArAHkAKwCJACsAmQArAKkAKwC5ACsAyQArAA1duAAXELgAMdC4AAYQuAA73AC6ACgACgA1K7oAIAAbADU` +
-	`rugAWADEANSu4ACAQuAAD0LgAChC4ABHQuAAoELgANtAwMQE+ATMyFhUUDgEjIi4BJw4BIyImPQEhNTQuASMiBzU2MzIeARcRHgEzMjY1NCcmBwYDIxUUFjMyNjUCeCptM1qDWZRVHD8yJzpAGWaDA` +
-	`UMkQylRYnFaPGNJJRMlEl1eGhspVOitKyosLAV2TkytiHizZAkOFCALickeMllIJDBmJx890f7bCgvGeEczMwIE/t8TakdZWAAAAgDhA1UD7AcnABEAHQCtuAAyK7gAHi+4AB8vuAAeELgAD9C4AA8` +
-	`vuAAO3LgAANC4AB8QuAAG3LgADhC4ABLQuAAGELgAGNxBBQDaABgA6gAYAAJdQRsACQAYABkAGAApABgAOQAYAEkAGABZABgAaQAYAHkAGACJABgAmQAYAKkAGAC5ABgAyQAYAA1dALgAEC+6ABUAC` +
-	`gA1K7oAAwAbADUruAAKELgADNC4AAwvuAAKELgADtC4AA4vMDEBPgEzMhYVFA4BIyInJicjETMZARYzMjY1NCYjIgYBdkS/UHCzd8eSKlYNGZWVUESIuGRARqcFZ1RVkZR0t2sHAQIDyP27/uEQn4p` +
-	`kZWoAAAIA4QNMA+wHJwASAB4ArbgAMiu4AB8vuAAgL7gAHxC4AAbQuAAGL7gAIBC4ABHcuAAS3LgADtC4ABIQuAAT0LgABhC4ABncQRsABgAZABYAGQAmABkANgAZAEYAGQBWABkAZgAZAHYAGQCGA` +
-	`BkAlgAZAKYAGQC2ABkAxgAZAA1dQQUA1QAZAOUAGQACXQC4AA8vugAcAAMANSu6AAoAFgA1K7gAChC4AAzQuAAML7gAChC4AA7QuAAOLzAxAQ4BIyImNTQ+ATMyFxYXETMRIxkBJiMiBhUUFjMyNgN` +
-	`XRL9QcLN3xpIrVQ0alZVPRIq3ZEBFqAP2VFaSk3W3agcBAgEq/DgBGwEgD5+KY2ZqAAAAAgDiA0wD6gYQABUAHQBhuAAyK7gAHi+4AB8vuAAN3LgAANC4AAAvuAAeELgABtC4AAYvuAAP3LgADRC4A` +
-	`BfcugAVAA0AFxESObgADxC4ABbQuAAWLwC6ABMAAgA1K7oACgAbADUrugAXAA4ANSswMQEGIyIuATU0PgEzMhYdASEUHgEzMjclITU0JiMiBgPdsp95v3Jtw3ifwf2YTn5RkK79rgG9a1lbhAOLP1q` +
-	`nYFuqXquxGEZzOkj+EFNhYwAAAgDiA0wD6gYQABUAHQA9uAAyK7oAFwANADUruAANELgAANC4AAAvugAVAA0AFxESOQC6ABsACgA1K7oAAgATADUrugAPABYANSswMRM2MzIeARUUDgEjIiY9ASE0L` +
-	`gEjIgcFIRUUFjMyNu+yn3m/cm3DeJ/BAmhOflGQrgJS/kNrWVuEBdBAWqhfXKleqrIYRXQ5SP4QUmFjAAAAAgDhAkgD7AYHACIALgDHuAAyK7gALy+4ADAvuAAQ3LgAANy4AC8QuAAG0LgABi+4AAA` +
-	`QuAAO0LgABhC4ABjQuAAYL7gABhC4ACncQRsABgApABYAKQAmACkANgApAEYAKQBWACkAZgApAHYAKQCGACkAlgApAKYAKQC2ACkAxgApAA1dQQUA1QApAOUAKQACXboAGQAGACkREjm4AAAQuAAj0` +
-	`AC6ABwAFgA1K7oACgAmADUrugAsAAMANSu4AAoQuAAM0LgADC+4AAoQuAAO0LgADi8wMQEOASMiJjU0PgEzMhcWFzMRFAYHDgEjIic1HgEzMjY3PgE9AREmIyIGFRQWMzI2A1dEv1Bws3fGkitVDRq` +
-	`VDA0g5KKip0C4V0R5JiYbT0SKt2RARagD9lRWkpN1t2oHAQL+JqlLJlpnPnEgMiAfH1FD4wEgD5+KY2ZqAAAAAAIBIwIiA6kF/QAHABMAI7gAMiu6AAEABAA1KwC4AAsvugAAAAUANSu6AAIAAwA1K` +
-	`zAxAREzFSERIzUBFAYjIiY1NDYzMhYCsfj+c/kBp0UeIEREIB5FBf39v10CQV38cCYlJSYlJiYAAQDcA18D8AcnAAoAS7gAMiu6AAoAAAA1K7gAChC4AALQALgAAS+4AAAvuAAHL7oAAwAAAAEREjm` +
-	`6AAUAAAABERI5ugAGAAAAARESOboACQAAAAEREjkwMRMRMxEBMwkBIwER3JUBrcf+UwG4zf5OA18DyP2gATb+yv6YAWj+mAABALQDXwQZBhAAJQBvuAAyK7oAJQAAADUrugAcAB0ANSu6ABIAEwA1K` +
-	`7gAJRC4AALQugADAAAAEhESObgAHBC4AAnQugAKAAAAEhESObgAEhC4ACfcALgAAC+4ABIvuAAcL7oADgAYADUruAAOELgABtC4ABgQuAAh0DAxExEzFT4BMzIWHQE+AjMyFhURIxE0LgEjIgYHESM` +
-	`RNCYjIgYHEbSCPmo3QFAaSlA0OlKDCR0RKl8xfS8SKV0rA18CnplhS0xHJD5MLUlu/gYByjgqFV9v/o0ByVkfa1P+fQAAAAEA8gJHA9sGEAAjAFG4ADIruAAkL7gAJS+4ACQQuAAR0LgAES+4ABDcu` +
-	`AAA0LgAJRC4ABzcuAAH3LgAEBC4ABPQugAUABEAHBESOQC6AAMAIgA1K7oAFwAMADUrMDEBNRYzMj4BNRE0LgEjIgYHESMRMxU+ATMyHgEVESMGBw4BIyIBh31VS2g6GUInT7Q6lZVAtVpPgjQECyc` +
-	`1mIRgAlhpHCdccwFIYT4nb1T+eQKekk1YP2aW/opqMUM6AAAAAAIAwANMBAwGEAALABcAy7gAMiu4ABgvuAAZL7gAGBC4AAPQuAADL7gAGRC4AAncuAAP3EEFANoADwDqAA8AAl1BGwAJAA8AGQAPA` +
-	`CkADwA5AA8ASQAPAFkADwBpAA8AeQAPAIkADwCZAA8AqQAPALkADwDJAA8ADV24AAMQuAAV3EEbAAYAFQAWABUAJgAVADYAFQBGABUAVgAVAGYAFQB2ABUAhgAVAJYAFQCmABUAtgAVAMYAFQANXUE` +
-	`FANUAFQDlABUAAl0AugAMAAAANSu6AAYAEgA1KzAxASImNTQ2MzIWFRQGJzI2NTQmIyIGFRQWAmbA5ubAv+fnv3eNjXd4jY0DTMmZmcnJmZnJXYx5eouLenmMAAABAPIDTAPbBhAAGABtuAAyK7oAB` +
-	`gASADUrQQUA2gASAOoAEgACXUEbAAkAEgAZABIAKQASADkAEgBJABIAWQASAGkAEgB5ABIAiQASAJkAEgCpABIAuQASAMkAEgANXbgABhC4ABrcALoADgAJADUrugACABUANSswMRM2MzIeARUUBCM` +
-	`iJzUWMzI+ATU0JiMiBgfyloeG0XX+97uKm5x7UpBOl6ExX38F6iZdn2aYyidsNj2ARFukCiAAAAEAwAStBAwGEAAYADO4ADIrALoAFgAFADUruAAFELgAANC4AAUQuAAC0LgAAi+4ABYQuAAM3LgAB` +
-	`RC4AA/QMDEBJiMiJgcGPAE2NSYjIgYVIyImBzQ2MzIWBAwtIx4zAQEBjXd4jU4dMwPmwL/nBK4BAQIBGyYrD4uLegEBmcnJAAAAAAEAwANMBAwEsgARADO4ADIrALoADQADADUruAANELgAANC4AA0` +
-	`QuAAG0LgAAxC4AArcuAANELgAENC4ABAvMDEBFAYjIiY1MxQWMzI2NT4BFxYEDOe/wOahjXh3jQEzHiMErpnJyZl5jIx5AwEBAQACAOECWwPsBhAAEgAeAK24ADIruAAfL7gAIC+4AB8QuAAQ0LgAE` +
-	`C+4AA/cuAAA0LgAIBC4AAbcuAAPELgAE9C4AAYQuAAZ3EEFANoAGQDqABkAAl1BGwAJABkAGQAZACkAGQA5ABkASQAZAFkAGQBpABkAeQAZAIkAGQCZABkAqQAZALkAGQDJABkADV0AuAAPL7oAAwA` +
-	`cADUrugAWAAoANSu4AAoQuAAM0LgADC+4AAoQuAAO0LgADi8wMQE+ATMyFhUUDgEjIicmJxEjETMZARYzMjY1NCYjIgYBdkS/UHCzd8eSKlYNGZWVUESIuGRARqcFZ1RVkZR0t2sHAQL+/AOi/uX+4` +
-	`RCfimRlagAAAAEA2QNMA/MG0wAYAEO4ADIrugASAAcANSu4AAcQuAAL0LgAEhC4AA3QALgADC+6ABYAAwA1K7oACwAIADUruAALELgADtC4AAgQuAAQ0DAxAQ4BIyIuATURIzUzETMRIRUhERQeATM` +
-	`yNwPzaV4sXptP39+VAY3+czNWOmx3A3ohDTZqbgEOXQEO/vJd/vRRQiAzAAABAPIDTAPbBf0AFQA/uAAyK7gAFi+4ABcvuAAB3LgAAty4ABYQuAAK0LgACi+4AA3cuAACELgAFNAAuAAAL7gACy+6A` +
-	`BEABgA1KzAxAREjNQ4BIyIuATURMxEUHgEzMjY3EQPblUiwWE6CNJUZQidOtDsF/f1ik1NTP2eVAXb+fGE9KG9VAYYAAQCbA44EMQW8ABUAdbgAMiu6AAYAEQA1K0EFANoAEQDqABEAAl1BGwAJABE` +
-	`AGQARACkAEQA5ABEASQARAFkAEQBpABEAeQARAIkAEQCZABEAqQARALkAEQDJABEADV24AAYQuAAX3AC6AA0ACgA1K7oAAQACADUruAACELgAFNAwMRMhFSMeARUUDgEjITUhMj4BNTQmJyGbA37Eb` +
-	`m5UiMf+DQIGgFM1lHH99wW8bzaEQjtiJm8TMh06hy0AAAAAAQC0A18EGQYQACUAW7gAMiu6ABQAEQA1K7oAHgAKADUrugABAAIANSu4AAoQuAAb0LgAAhC4ACTQuAABELgAJ9wAuAAAL7gAEi+4ABw` +
-	`vugAhAAYANSu4AAYQuAAO0LgAIRC4ABjQMDEBESM1DgEjIiY9AQ4CIyImNREzERQeATMyNjcRMxEUFjMyNjcRBBmDPWs2QU8bSlA0OlGCCh0QKl8yfS4SKV4qBhD9YpliSkxGJD1MLUltAfv+NjgqF` +
-	`V9uAXT+N1kfalQBgwAAAQCUA18EOAX9AAYAHbgAMisAuAABL7gABC+4AAAvugADAAAAARESOTAxCQEzCQEzAQIY/nykATIBOZX+eANfAp797gIS/WIAAgEjAAADqQPbAAcAEwAjuAAyK7oABQAAADU` +
-	`rALgACy+6AAUAAAA1K7oABAABADUrMDEhESM1IREzFQE0NjMyFhUUBiMiJgIb+AGN+f5aRB8fRUUfH0QCQV39v10DkCYlJSYlJSUAAAABARcAAAO1ArEAEQBjuAAyK7gAEi+4ABMvuAASELgAANC4A` +
-	`AAvuAAR3LgAAtC4ABMQuAAJ3LoAAwAAAAkREjm4AArcALgAAC+6AAYADQA1K7gABhC4AArcugADAAYAChESObgADRC4AAvQuAALLzAxIREzFT4BMzIXFSM1JiMiBgcRAReVRbRvUVCPJRZToEwCnpJ` +
-	`TUhPymQViY/57AAAAAQDy/+0D2wKeABUAP7gAMiu4ABYvuAAXL7gAAdy4AALcuAAWELgACtC4AAovuAAN3LgAAhC4ABTQALgAAC+4AAsvugARAAYANSswMQERIzUOASMiLgE1ETMRFB4BMzI2NxED2` +
-	`5VIsFhOgjSVGUInTrQ7Ap79YpNTUz9nlQF2/nxhPShvVQGGAAEAlAAABDgCngAGAB24ADIrALgAAS+4AAQvuAAAL7oAAwAAAAEREjkwMSEBMwkBMwECGP58pAEyATmV/ngCnv3uAhL9YgAAAAEAOP/` +
-	`hBJUEfABDABe4ADIrALoALwApADUrugAKAA8ANSswMRM1NDc2NyY1NDYzMhcVJiMiBhUUFxYfARYXNjc2PQEzFRQHBgcWFRQGIyInNR4BMzI2NTQnJicmLwImJyYjIg4BHQE4TSYyFtXvs6C4onaFO` +
-	`jmBfnNFJxckgF8PEB3f9dbicPpXjXwdBwtagT8lXz8RDihFKgGTEoNgLxgzPoqyNKlCUz48JCQvLiovDCM2PxAQp1ANCzdHkbtaxUk7W0QtIAcIEEokDyUiAypcIxIAAQD3A18D1gX9AAsAS7gAMiu` +
-	`4AAwvuAANL7gADBC4AAPQuAADL7gAAty4AAXQuAANELgACty4AAvcuAAH0AC4AAIvuAAKL7gABC+4AAgvugAHAAAANSswMQEhESMRMxEhETMRIwNB/kuVlQG1lZUEkv7NAp7+8gEO/WIAAAIAG//eB` +
-	`LMEXQAWACIAg7gAMiu4ACMvuAAkL7gAIxC4AALQuAACL7gABdy4ACQQuAAJ3LgABty4AAkQuAAM0LgAAhC4ABXQuAAGELgAHtC4AAUQuAAg0AC4AAMvuAAHL7oAGgARADUrugABAAAANSu4AAEQuAA` +
-	`F0LgAARC4AAnQuAAAELgAC9C4AAAQuAAf0DAxEzUzETMRIREzETMVIxUUDgEjIi4BPQETHgEzMj4BPQEhFRQbxaEB2JTGxmemf3SyW7wbb0RIcTb+KAH1mwHN/jMBzf4zm3GcsVlOm8tj/sU1Nzx/s` +
-	`Dx1kQAAAgAbAAAEswR/ACgAMQCzuAAyK7gAMi+4ADMvuAAyELgAKNC4ACgvuAAC0LgAAi+4ACgQuAAw3LgABtC4AAYvuAAwELgAD9C4AA8vuAAzELgAINy4AC/cuAAQ0LgAEC+4AC8QuAAZ0LgAGS+` +
-	`4ACAQuAAd0LgAHS8AugArACQANSu6AAgABwA1K7oAAQAAADUruAABELgAD9C4AAgQuAAW0LgABxC4ABjQuAABELgAHdC4AAAQuAAf0LgAABC4AC/QMDETNTM2NzY3IzUhFQ4BBwYHISYnLgEnNSEVI` +
-	`xYXFhczFSMGBwYjIicmJxMWMzI3NjchFhubBhYfZMEBjkdTGhIFAh8GEhlTRwGNwWQeFgaenw9cb9TUb1wQ5UiCg0c5DP3jDAH1m1dKZ2aBgRxsWUBNTj9ZbByBgWZnSleb0IWgoIXQ/vp/gGWgogA` +
-	`BAKwAAAQgBF0AEwBXuAAyK7oACQACADUruAAJELgADNC4AAIQuAAS0AC6AA4ADwA1K7oABQAEADUrugABAAAANSu4AAQQuAAH0LgAARC4AAnQuAAAELgAC9C4AA4QuAAR0DAxEzUhESE1IRUhESEVI` +
-	`REhFSE1IRHjAR7+qwN0/qsBIP7gAVX8jAFVAfWbATGcnP7Pm/6mm5sBWgABAGj/4QRkBF0AFwBHuAAyK7oABQACADUruAAFELgACNC4AAIQuAAX0LgAFy8AuAADL7oADQASADUrugABAAAANSu4AAE` +
-	`QuAAF0LgAABC4AAfQMDETNSERMxEhFSEWFx4BMzI3FQYjIiYnJidoARO6ATv+xQMhJYJda5yJd6W+QzsHAfWbAc3+M5ukRk1BLq4cYG5h5QAAAAMALf5OBKEEfAAbACMALACxuAAyK7gALS+4AC4vu` +
-	`AAtELgAG9C4ABsvuAAC0LgAGxC4ACHcuAAE0LgALhC4AAzcugAFABsADBESObgAD9C4AA8vuAAhELgAGNC4AAwQuAAk3LgAINC4ACAvuAAhELgALNC4ACwvALgAGS+6AAgAKAA1K7oAHAAUADUrugA` +
-	`BAAAANSu4AAEQuAAM0LgAABC4AA7QuAAUELgAFtC4ABYvuAAAELgAINC4AAEQuAAk0DAxEzUzETMVPgEzMhYdATMVIwYHDgEjIicmJxEjEQEyNzY3IREWASYnJiMiBwYHLb+VRL9QcLOqtQ8iO8eSK` +
-	`lYNGZUBKYhcQBT+NFABhAMvMkBGVFBCAfWbAc37jI7y9gSbZVeYsQsCA/5OA6f+l4Rcif6xGgIEl09UWFWNAAAAAQB+AAAETwYQAB8AUbgAMiu6AAkABgA1K7oAHwAAADUrugAZABYANSu4AAAQuAA` +
-	`O0LgAHxC4ABDQuAAZELgAIdwAuAAHL7gADy+4ABcvuAAAL7oADgAAAAcREjkwMSERJicuARE1MxEUFhcWFxEzETY3PgE9ATMRFAYHBgcRAhVlUW9yykNFISSjLCdHQ7qBaEpkAr0LKDbYARr4/u7Jk` +
-	`ycSCgKx/U0KFyqx9MP+9Nn1PiwN/UEAAAEAfv5OBE8EWwAfAFG4ADIrugAJAAYANSu6AB8AAAA1K7oAGQAWADUruAAAELgADtC4AB8QuAAQ0LgAGRC4ACHcALgABy+4AA8vuAAXL7gAAC+6AA4AAAA` +
-	`HERI5MDEBESYnLgERNTMRFBYXFhcRMxE2Nz4BPQEzERQGBwYHEQIVZVFvcspDRSEkoywnR0O6gWhKZP5OAroLKDbYARr4/u7JkycSCgKx/U0KFyqx9MP+9Nn1PiwN/UQAAAAAAQA4AAAEqwYQAA0AU` +
-	`7gAMiu4AA4vuAAPL7gAANy4AAHcuAAOELgABdC4AAUvuAAE3LgAB9C4AAEQuAAJ0AC4AAAvuAAEL7oACwAMADUrugAJAAIANSu4AAsQuAAG0DAxISMRIREjETMRIREhFSEC6Mr+2sDAASYCjf49Auf` +
-	`9GQYQ/XMCjZwAAAEAQgAABIkEXQANAFO4ADIruAAOL7gADy+4AADcuAAB3LgADhC4AAXQuAAFL7gABNy4AAfQuAABELgACdAAuAAAL7gABC+6AAsADAA1K7oACQACADUruAALELgABtAwMSEjESERI` +
-	`xEzESERIRUhAuTK/ty0tAEkAm/+WwIW/eoEXf5UAaybAAABAAL+TgSoBhAAFwAruAAyK7oAEQAAADUruAARELgAGdwAuAAUL7oAEQAAADUrugALAAYANSswMQEjAwIHAiEiJzUWMzITNhsBIREzAyM` +
-	`TIwMi0wkWP1z+8UFDMU6HOikQFAJcvWTAZMYFdf79/XzU/scPrRMBDLwBsAIO+or9tAGyAAABAAb+TgSoBF0AFwAruAAyK7oAEQAAADUruAARELgAGdwAuAAUL7oAEQAAADUrugALAAYANSswMQEhB` +
-	`wIHAiEiJzUWMzI3NhsBIREzAyMTIwM3/tgDCypP/vk4QzFJhCwYCAoCl7dkwGSxA8J5/jGM/vMPqRO7aQEvAYT8Pf20AbIAAAABAH7+sgS0BhAADwBfuAAyK7gAEC+4ABEvuAAA3LgABdy6AAMAAAA` +
-	`FERI5uAAQELgACdC4AAkvuAAI3LgAC9C4AAUQuAAN0AC4AAovuAAOL7oAAAACADUrugANAAYANSu6AAQAAgAAERI5MDElMwMjEyMRIREjETMRIREzBAGzZLZmyP4QysoB8Mma/hgBTgLZ/ScGEP1kA` +
-	`pwAAAABAJv+sgS0BF0ADwBfuAAyK7gAEC+4ABEvuAAA3LgABdy6AAMAAAAFERI5uAAQELgACdC4AAkvuAAI3LgAC9C4AAUQuAAN0AC4AAovuAAOL7oAAAACADUrugANAAYANSu6AAQAAgAAERI5MDE` +
-	`lMwMjEyMRIREjETMRIREzBAGzZLZmuf4OuroB8rqa/hgBTgIA/gAEXf4+AcIAAAACACEAAAStBhAAFwAaAFm4ADIrugAEAAUANSu6ABUAAAA1K7oAEAARADUrugAYAAAAFRESOboAGQAFABAREjm6A` +
-	`BoABQAQERI5uAAQELgAHNwAuAAEL7gAEC+4ABYvugALABkANSswMQEGBwYVIxA3NjcBIQEWFxYRIzQnJicRIxMBIQIaiUU7ymthrf5hBIz+Xa9ha8o7RYabUgEp/bMCkCrKrPABSdbCOwL0/Q07w9X` +
-	`+tvCsyCv9cQOHAe4AAAACAMMAAAQJBF0AFwAaAFm4ADIrugAEAAUANSu6ABUAAAA1K7oAEAARADUrugAYAAAAFRESOboAGQAFABAREjm6ABoABQAQERI5uAAQELgAHNwAuAAEL7gAEC+4ABYvugALA` +
-	`BkANSswMQEmBwYVIzQ3NjcBIQEWFxYVIzQnJgcRIxsBIQIPQzIVp01Gff7VA0b+0n5GTaYWMkukWqz+rAGuDKZnre2ajCoCIP3hK4yZ7q1npQv+UgK0ARAAAAEAff/RBEsGPgAmAIm4ADIrugAAAA0` +
-	`ANStBGwAGAAAAFgAAACYAAAA2AAAARgAAAFYAAABmAAAAdgAAAIYAAACWAAAApgAAALYAAADGAAAADV1BBQDVAAAA5QAAAAJdugARAA0AABESObgAES+4AB7cALoABAAJADUrugAVABoANSu6ACMAJ` +
-	`AA1K7oADwAkACMREjkwMQEUFxYzMjcVBiMiJyY1ECUkNTQ3NjMyFxUmIyIHBhUUFxY7ARUjIAFHZ2SYv+Let+2hqwFY/vWef9Wdycane01ZcWGVe0n+GgG6ll5ac75Qg4znATZmf+7GXUtLsWE0PXW` +
-	`OTECbAAEAqf/fBC8EfAAmANm4ADIrugAjABgANStBBQDaABgA6gAYAAJdQRsACQAYABkAGAApABgAOQAYAEkAGABZABgAaQAYAHkAGACJABgAmQAYAKkAGAC5ABgAyQAYAA1dugANABgAIxESObgAD` +
-	`S9BBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0AmQANAKkADQC5AA0AyQANAA1duAAA3LoAJQAYACMREjkAugAJAAQANSu6AB8AGgA1K7oAFAARADUrugA` +
-	`lABEAFBESOTAxARQHBiMiJzUWMzI3NjU0JyYrATUzMjc2NTQjIgc1NjMyFxYVFAcWBC+WhrfR4srjaE9XX0OEiqZZOUHZxsjQwaVvjJrSAVGsaV1ZxYQ3PVt5LiGKKzFcj4m8aDxLlqFbTwABAKn/3` +
-	`wQvBHwAJgCTuAAyK7oAGgAAADUrQRsABgAaABYAGgAmABoANgAaAEYAGgBWABoAZgAaAHYAGgCGABoAlgAaAKYAGgC2ABoAxgAaAA1dQQUA1QAaAOUAGgACXboABAAAABoREjm4AAQvuAAP3LoAAgA` +
-	`EAA8REjkAugAeACMANSu6AAgADQA1K7oAFAAVADUrugACABUAFBESOTAxEzQ3JjU0NzYzMhcVJiMiFRQXFjsBFSMiBwYVFBcWMzI3FQYjIicmqdKajG+lwdDIxtlBOVmmioRDX1dPaOPK4tG3hpYBU` +
-	`cNPW6GWSzxovImPXDEriiEueVs9N4TFWV1pAAAAAAEAQv/fBIsEfAA7AK+4ADIrugAAAA0ANStBBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0AmQANAKk` +
-	`ADQC5AA0AyQANAA1dugAjAA0AABESOQC6AAkABAA1K7oAHwAaADUrugAoADIANSu6ABQAEQA1K7gAMhC4ACXcugAcADIAJRESOboAIwAyACUREjm6ADcAMgAlERI5ugA6ABEAFBESOTAxARQHBiMiJ` +
-	`zUWMzI3NjU0JyYrATUzMjc2NTQjIgc1NjMyFxYXNzMeATMyNyYeAjUOASMiJicmJwcGBxYCy2xghJeikaRKOT9EMV9jd0EpLpyOkJWLd1BEFiinB0YsLCMBHCMeIFoxMFshEguFG0OXAVGsaV1ZxYQ` +
-	`3PVt5LiGKKzFcj4m8aDwzVT5NdTsDDhQPAUROTkQmNLJQN08AAQEMAAADwASiAAUAG7gAMiu6AAUAAAA1KwC4AAAvugACAAMANSswMSERIRUhEQEMArT9xQSidvvUAAAAAQEMAAADwASiAAcAJ7gAM` +
-	`iu6AAQABQA1K7gABBC4AADQALgABi+4AAQvugABAAIANSswMQEhFSERIxEzAYUCO/3FeXkDl3b83wSiAAEBDAAAA8AEogAHACe4ADIrugAEAAUANSu4AAQQuAAA0AC4AAYvuAAEL7oAAQACADUrMDE` +
-	`BIRUhESMRMwGFAjv9xXl5Aox2/eoEogABAQwAAAPABKIABwAnuAAyK7oAAAABADUruAAAELgAA9AAuAACL7gAAC+6AAUABgA1KzAxISMRMxEhFSEBhXl5Ajv9xQSi/N92AAAAAQEMAAADwASiAAUAG` +
-	`7gAMiu6AAEABAA1KwC4AAAvugACAAMANSswMQERIRUhEQGFAjv9TASi+9R2BKIAAgE8AAADkQSiAAMADwBpuAAyK7oADQAHADUrugADAAAANStBGwAGAA0AFgANACYADQA2AA0ARgANAFYADQBmAA0` +
-	`AdgANAIYADQCWAA0ApgANALYADQDGAA0ADV1BBQDVAA0A5QANAAJdALgAAC+4AAEvuAAKLzAxIREzEQEiJjU0NjMyFhUUBgMYef4mM0hJMjFJSASi+14DrUczM0hIMzNHAAACATwAAAORBKIAAwAPA` +
-	`GW4ADIrugANAAcANSu6AAMAAAA1K0EbAAYADQAWAA0AJgANADYADQBGAA0AVgANAGYADQB2`
-
-const MonacoFontData14 = `AA0AhgANAJYADQCmAA0AtgANAMYADQANXUEFANUADQDlAA0AAl0AuAABL7gAAC8wMSERMxEBIiY1NDYzMhYVFAYDGHn+JjNISTIxSUgEovteAddHMzNISDMzRwAAAgE8A` +
-	`AADkQSiAAMADwBluAAyK7oADQAHADUrugADAAAANStBGwAGAA0AFgANACYADQA2AA0ARgANAFYADQBmAA0AdgANAIYADQCWAA0ApgANALYADQDGAA0ADV1BBQDVAA0A5QANAAJdALgAAS+4AAAvMDE` +
-	`hETMRASImNTQ2MzIWFRQGAxh5/iYzSEkyMUlIBKL7XgLCRzMzSEgzM0cAAAIBPAAAA5EEogADAA8AZbgAMiu6AA0ABwA1K7oAAQACADUrQRsABgANABYADQAmAA0ANgANAEYADQBWAA0AZgANAHYAD` +
-	`QCGAA0AlgANAKYADQC2AA0AxgANAA1dQQUA1QANAOUADQACXQC4AAEvuAAALzAxAREjEQEiBhUUFjMyNjU0JgORef6fM0hJMjFJSASi+14Eov0+RzMzSEgzM0cAAAAAAgE8AAADkQSiAAMADwBpuAA` +
-	`yK7oADQAHADUrugABAAIANStBGwAGAA0AFgANACYADQA2AA0ARgANAFYADQBmAA0AdgANAIYADQCWAA0ApgANALYADQDGAA0ADV1BBQDVAA0A5QANAAJdALgAAC+4AAEvuAAKLzAxAREjEQEiBhUUF` +
-	`jMyNjU0JgORef6fM0hJMjFJSASi+14EovxTRzMzSEgzM0cAAAAAAgE8AAADkQSiAAMADwBpuAAyK7oAAwAAADUrugAHAA0ANStBBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0` +
-	`AaQANAHkADQCJAA0AmQANAKkADQC5AA0AyQANAA1dALgAAC+4AAEvuAAKLzAxIREzEQEyNjU0JiMiBhUUFgE8eQFhM0hJMjFJSASi+14DrUczM0hIMzNHAAACATwAAAORBKIAAwAPAGW4ADIrugADA` +
-	`AAANSu6AAcADQA1K0EFANoADQDqAA0AAl1BGwAJAA0AGQANACkADQA5AA0ASQANAFkADQBpAA0AeQANAIkADQCZAA0AqQANALkADQDJAA0ADV0AuAABL7gAAC8wMSERMxEBMjY1NCYjIgYVFBYBPHk` +
-	`BYTNISTIxSUgEovteAsJHMzNISDMzRwAAAgE8AAADkQSiAAMADwBluAAyK7oAAwAAADUrugAHAA0ANStBBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0Am` +
-	`QANAKkADQC5AA0AyQANAA1dALgAAS+4AAAvMDEhETMRATI2NTQmIyIGFRQWATx5AWEzSEkyMUlIBKL7XgHXRzMzSEgzM0cAAAIBPAAAA5EEogADAA8AZbgAMiu6AAEAAgA1K7oABwANADUrQQUA2gA` +
-	`NAOoADQACXUEbAAkADQAZAA0AKQANADkADQBJAA0AWQANAGkADQB5AA0AiQANAJkADQCpAA0AuQANAMkADQANXQC4AAEvuAAALzAxAREjEQEyFhUUBiMiJjU0NgG1eQHaM0hJMjFJSASi+14Eov0+R` +
-	`zMzSEgzM0cAAAAAAgE8AAADkQSiAAMADwBpuAAyK7oAAQACADUrugAHAA0ANStBBQDaAA0A6gANAAJdQRsACQANABkADQApAA0AOQANAEkADQBZAA0AaQANAHkADQCJAA0AmQANAKkADQC5AA0AyQA` +
-	`NAA1dALgAAC+4AAEvuAAKLzAxAREjEQEyFhUUBiMiJjU0NgG1eQHaM0hJMjFJSASi+14EovxTRzMzSEgzM0cAAAAAAQB+/9EETwRdABUAO7gAMiu4ABYvuAAXL7gAFhC4AADQuAAAL7gAAdy4ABcQu` +
-	`AAN3LgACtwAuAAAL7gACy+6AAYAEQA1KzAxEzMRFB4BMzI+ATURMxEUDgEjIi4BEX7KQ4tVWo1DuoHQnpLecgRd/bjJk01TsfQB+f2+2fV8bNgBGgAAAQB+/9EETwRdABUAP7gAMiu4ABYvuAAXL7g` +
-	`AFhC4AADQuAAAL7gAFxC4AAncuAAK3LgAABC4ABPcALgACS+4ABQvugAEAA8ANSswMRMQPgEzMh4BFREjETQuASMiDgEVESN+ct6SntCBukONWlWLQ8oB/wEa2Gx89dn9vgH59LFTTZPJ/bgAAAEAh` +
-	`AB+BEgETwAVAGW4ADIrugAPAAQANStBGwAGAA8AFgAPACYADwA2AA8ARgAPAFYADwBmAA8AdgAPAIYADwCWAA8ApgAPALYADwDGAA8ADV1BBQDVAA8A5QAPAAJdALoAEwAAADUrugAJAAoANSswMSU` +
-	`gLgE1ND4BMyEVISIOARUUHgEzIRUC4v7m2Gx89dkBev7P9LFTTZPJAYB+ct6SntCBukONWlWLQ8oAAAAAAQCEAH4ESARPABUAbbgAMiu6ABEABgA1K0EFANoABgDqAAYAAl1BGwAJAAYAGQAGACkAB` +
-	`gA5AAYASQAGAFkABgBpAAYAeQAGAIkABgCZAAYAqQAGALkABgDJAAYADV24ABEQuAAX3AC6AAEAAAA1K7oADQAKADUrMDE3NSEyPgE1NC4BIyE1ITIeARUUDgEhhAGAyZNNU7H0/s8Betn1fGzY/uZ` +
-	`+ykOLVVqNQ7qB0J6S3nIAAgCEAAAESAVJABUAGQB1uAAyK7oADwAEADUrQRsABgAPABYADwAmAA8ANgAPAEYADwBWAA8AZgAPAHYADwCGAA8AlgAPAKYADwC2AA8AxgAPAA1dQQUA1QAPAOUADwACX` +
-	`bgABBC4ABjQALoAFgAXADUrugAJAAoANSu6ABMAAAA1KzAxASAuATU0PgEzIRUhIg4BFRQeATMhHQIhNQLi/ubYbHz12QF6/s/0sVNNk8kBgPw8AXhy3pKe0IG6Q41aVYtDyt2bmwACAIQAAARIBUk` +
-	`AFQAZAH24ADIrugARAAYANStBBQDaAAYA6gAGAAJdQRsACQAGABkABgApAAYAOQAGAEkABgBZAAYAaQAGAHkABgCJAAYAmQAGAKkABgC5AAYAyQAGAA1duAARELgAFtC4ABEQuAAb3AC6ABYAFwA1K` +
-	`7oADQAKADUrugABAAAANSswMRM1ITI+ATU0LgEjITUhMh4BFRQOASEFFSE1hAGAyZNNU7H0/s8Betn1fGzY/uYCXvw8AXjKQ4tVWo1DuoHQnpLect2bmwAAAAIAIf/RBK0GPgAgACoAs7gAMiu6ACk` +
-	`AGQA1K7gAGRC4ABLQQRsABgApABYAKQAmACkANgApAEYAKQBWACkAZgApAHYAKQCGACkAlgApAKYAKQC2ACkAxgApAA1dQQUA1QApAOUAKQACXQC6ABQAEAA1K7oADQAOADUrugAHAAgANSu6AB8AA` +
-	`gA1K7oAAAACAB8REjm4AAIQuAAB3LgABxC4AATQuAAEL7gAFBC4AAzQuAABELgAHdC4AAIQuAAk0DAxATMVIwEWMyEVISInByEVIQcjNzUzEyYnJjU0PgE7ATczARYXAQYHDgEVFAQbLZz+ozVEAYD` +
-	`+mp1njgL4/Kwct2MWrl0xNnz12ZuTsfyYJUQBS9xTWFMFSbr9twTKEe6bL6UlASE4ZW+SntCB9fyMQiECKAIgIY1aVQACACH/0QStBj4AIAAqAMO4ADIrugAHACMANSu4AAcQuAAN0EEFANoAIwDqA` +
-	`CMAAl1BGwAJACMAGQAjACkAIwA5ACMASQAjAFkAIwBpACMAeQAjAIkAIwCZACMAqQAjALkAIwDJACMADV24AAcQuAAs3AC6AA0AEAA1K7oAGgAXADUrugABAB4ANSu4AB4QuAAC3LgADRC4ABHcuAA` +
-	`NELgAE9C4AAwQuAAU0LgAGhC4ABzQuAAcL7gAAhC4AB/QugAgAB4AARESObgAHhC4ACjQMDETMxczMh4BFRQHBgcTMxUXIychNSEnBiMhNSEyNwEjNTMBNjU0JicmJwE2IbGTm9n1fDYxXa4WY7cc/` +
-	`KwC+I5nnf6aAYBENf6jnC0C1iZTWFPcAUtEBj71gdCekm9lOP7fJaUvm+4RygQCSbr9gUZVWo0hIAL92CEAAAIAhP/RBEkFEgAZACUAp7gAMiu6ACQAEgA1K0EbAAYAJAAWACQAJgAkADYAJABGACQ` +
-	`AVgAkAGYAJAB2ACQAhgAkAJYAJACmACQAtgAkAMYAJAANXUEFANUAJADlACQAAl26AA0AEgAkERI5ALgADC+6AAcACAA1K7oAAQACADUruAAHELgABNC4AAQvuAAIELgAC9C4AAsvuAABELgAFtC4A` +
-	`AIQuAAZ3LgAAhC4AB/QuAAfLzAxATMVIwEWMyEVISInByM3Jy4BNTQ+ATsBNzMBFhcWMwEGBw4BFRQD5mLA/tcvOgGA/pqGXl63dQJsbHz12WZksfz8J0kCAgEcv0xYUwRPuv21AsoNuuUBOd6SntC` +
-	`Bw/y+RSIBAiwEHSGNWlUAAAACAIT/0QRJBRIAGQAlALm4ADIrugAHABwANStBBQDaABwA6gAcAAJdQRsACQAcABkAHAApABwAOQAcAEkAHABZABwAaQAcAHkAHACJABwAmQAcAKkAHAC5ABwAyQAcA` +
-	`A1dugAMABwABxESObgABxC4ACfcALgADC+6ABMAEAA1K7oAAgAWADUruAAWELgAAdy4ABAQuAAO0LgADi+4ABMQuAAV0LgAFS+4AAIQuAAY0LoAGQAWAAEREjm4ABYQuAAh0LgAIS8wMRMzFzMyHgE` +
-	`VFAYPARcjJwYjITUhMjcBIzUzATY1NCYnJicBMjc2hLFkZtn1fGxsAnW3Xl6G/poBgDov/tfAYgKhJlNYTL8BHAICSQUSw4HQnpLeOQHlug3KAgJLuv2BRlVajSEdBP3UASIAAAEBdQC8A1cCpQAVA` +
-	`HG4ADIrugAPAAQANSu4AAQQuAAU3LgACdBBGwAGAA8AFgAPACYADwA2AA8ARgAPAFYADwBmAA8AdgAPAIYADwCWAA8ApgAPALYADwDGAA8ADV1BBQDVAA8A5QAPAAJdALoAEwAAADUrugAJAAoANSs` +
-	`wMSUiLgE1ND4BOwEVIyIOARUUHgE7ARUCpI1sNj57bL2YelkpJkpkwLw5b0lPaEFdIkYtK0UiZQAAAAACAXUAAANXAqUAFQAZAIm4ADIrugAPAAQANSu4AAQQuAAU3LgACdBBGwAGAA8AFgAPACYAD` +
-	`wA2AA8ARgAPAFYADwBmAA8AdgAPAIYADwCWAA8ApgAPALYADwDGAA8ADV1BBQDVAA8A5QAPAAJduAAUELgAFtC4AAQQuAAY0AC6ABYAFwA1K7oACQAKADUrugATAAAANSswMSUiLgE1ND4BOwEVIyI` +
-	`OARUUHgE7AR0CITUCpI1sNj57bL2YelkpJkpkwP4evDlvSU9oQV0iRi0rRSJlbk5OAAABAXUEJwNXBhAAFQBxuAAyK7oADwAEADUruAAEELgAFNy4AAnQQRsABgAPABYADwAmAA8ANgAPAEYADwBWA` +
-	`A8AZgAPAHYADwCGAA8AlgAPAKYADwC2AA8AxgAPAA1dQQUA1QAPAOUADwACXQC6ABMAAAA1K7oACQAKADUrMDEBIi4BNTQ+ATsBFSMiDgEVFB4BOwEVAqSNbDY+e2y9mHpZKSZKZMAEJzlvSU9oQV0` +
-	`iRi0rRSJlAAAAAgF1A2sDVwYQABUAGQCJuAAyK7oADwAEADUruAAEELgAFNy4AAnQQRsABgAPABYADwAmAA8ANgAPAEYADwBWAA8AZgAPAHYADwCGAA8AlgAPAKYADwC2AA8AxgAPAA1dQQUA1QAPA` +
-	`OUADwACXbgAFBC4ABbQuAAEELgAGNAAugAWABcANSu6AAkACgA1K7oAEwAAADUrMDEBIi4BNTQ+ATsBFSMiDgEVFB4BOwEdAiE1AqSNbDY+e2y9mHpZKSZKZMD+HgQnOW9JT2hBXSJGLStFImVuTk4` +
-	`AAQF1ALwDVwKlABUAL7gAMiu6ABEAAQA1K7gAERC4AAbcuAABELgAC9AAugABAAAANSu6AA0ACgA1KzAxJTUzMj4BNTQuASsBNTMyHgEVFA4BIwF1wGRKJilZepi9bHs+NmyNvGUiRSstRiJdQWhPS` +
-	`W85AAACAXUAAANXAqUAFQAZAEe4ADIrugAWABkANSu4ABkQuAAA0LgAFhC4AAbcuAAZELgAC9C4ABYQuAAR0AC6ABYAFwA1K7oADQAKADUrugABAAAANSswMSU1MzI+ATU0LgErATUzMh4BFRQOASM` +
-	`FFSE1AXXAZEomKVl6mL1sez42bI0BL/4evGUiRSstRiJdQWhPSW85bk5OAAEBdQQnA1cGEAAVAC+4ADIrugARAAEANSu4ABEQuAAG3LgAARC4AAvQALoAAQAAADUrugANAAoANSswMQE1MzI+ATU0L` +
-	`gErATUzMh4BFRQOASMBdcBkSiYpWXqYvWx7PjZsjQQnZSJFKy1GIl1BaE9JbzkAAgF1A2sDVwYQABUAGQBHuAAyK7oAFgAZADUruAAZELgAANC4ABYQuAAG3LgAGRC4AAvQuAAWELgAEdAAugAWABc` +
-	`ANSu6AA0ACgA1K7oAAQAAADUrMDEBNTMyPgE1NC4BKwE1MzIeARUUDgEjBRUhNQF1wGRKJilZepi9bHs+NmyNAS/+HgQnZSJFKy1GIl1BaE9JbzluTk4AAAAAAgAC/9EEzAY+ACQAKABvuAAyK7oAF` +
-	`gAcADUruAAWELgAANy4ABwQuAAK0LoAEwAcABYREjm4ABYQuAAh3AC4ACcvuAAlL7oADQAIADUrugABAAAANSu6AB4AGQA1K7oACgAlACcREjm6ABMAAAABERI5ugAcACUAJxESOTAxEzUzMjY1NCY` +
-	`jIgc1NjMyFhUUBgceARUUBiMiJzUWMzI2NTQmIwMjATN+UYo9O0RFgoRtgm46O0ZGlpRnZ49JNk9ZjSGkBCGpBE58SSEhPih6K35FN1skHFU6VJAhii81Ki49+4MGbQAAAAIAAv/RBMwGPgAaAB4Ap` +
-	`7gAMiu4AB8vuAAgL7gAHxC4AADQuAAAL7gABdy4ACAQuAAJ3LgAF9xBBQDaABcA6gAXAAJdQRsACQAXABkAFwApABcAOQAXAEkAFwBZABcAaQAXAHkAFwCJABcAmQAXAKkAFwC5ABcAyQAXAA1duAA` +
-	`FELgAG9C4ABsvALgAHS+4ABsvugACAAMANSu6AAUAGgA1K7oAEwANADUrugAQABsAHRESOTAxExEhFSEVMzIWFRQOASMiJzUeATMyPgE1NCYjEyMBMzoBp/7SD4W7UoFJSXIpZisrVCRzoDGkBCGpB` +
-	`I8Be3WbiHRJdEEjeBYXJDwqNmH7QgZtAAIAAv/RBMwGPgAYABwAL7gAMiu6ABgAAAA1KwC4AAsvuAAbL7gAGS+6AAwACQA1K7gADBC4ABzQuAAcLzAxEzU0PgE/AjY3ITUhFRQGBwYPAQ4CHQEDIwE` +
-	`zqiVdHFEYIR3+iwILHUsSEjQyMCKbpAQhqQMHRlFviSVrJS06hmYlP2cZGElHVFtKRvzKBm0AAAEAWgArBIUDqgAIABe4ADIrALgACC+4AAEvugAGAAMANSswMQkBJzchNSEnNwSF/kBz/P0MAvT6c` +
-	`wHq/kFz+qT8cgAAAQC8AAAEHASsAAgAK7gAMiu6AAYAAwA1KwC4AAAvuAAEL7oAAwAAAAQREjm6AAYAAAAEERI5MDEhATcXETMRNxcCav5Sc+em7XMBoHTfA3f8h+F4AAAAAAIAfv+TBJIGEAALABM` +
-	`AZ7gAMiu6AAsAAAA1K7oABgAFADUrugATAAwANSu4AAsQuAAC0LgABRC4AAjQuAAFELgADtC4AA4vuAATELgAFdwAuAABL7gABS+4AAwvugAEAAkANSu6AA8ADgA1K7gADhC4ABHQMDETETMRIREzE` +
-	`SMRIREBESM1IRUjEX6DASaCgv7aAhn1Am3rAr4DUv6SAW78rgFn/pn81QJze3v9jQAAAgBD/+4EVgYQAAUADwBTuAAyK7oABQACADUrugAPAAYANSu4AAIQuAAB3LgADxC4AArQuAAGELgADdwAuAA` +
-	`DL7gABi+6AAwADQA1K7oABwABADUruAAHELgABdC4AAUvMDEBFSERMxEBESEVIRUhFSERAhf+LIMBugHW/q0BMP7QAzt8A1H9K/yzA1Z03H3+dwAAAAIAAv+TBJIGGgAIABAAPbgAMiu6ABAACQA1K` +
-	`7gAEBC4ABLcALgAAC+4AAQvuAAJL7oADAALADUrugAHAAkAABESObgACxC4AA7QMDEBMwEjATMTFzcBESM1IRUjEQJekv7hrv7flMsaGAGH9QJt6wYa/KoDVv3EX177tgJze3v9jQAAAAIA5AACA/w` +
-	`GEAAJABMAU7gAMiu6AAkAAAA1K7oAEwAKADUruAAJELgABNC4ABMQuAAO0LgAChC4ABHcALgACi+6AAIAAwA1K7oAEAARADUrugAGAAcANSu6AAwADQA1KzAxExEhFSEVIRUhERMRIRUhFSEVIRHkA` +
-	`db+rQEw/tC/Adb+rQEw/tACugNWdNx9/nf9SANWdNx9/ncAAAMAM//2BJEF+AAZACkAMwDvuAAyK7oAEwAGADUrugAbABwANSu6ACIALwA1K7oADQAGACIREjlBGwAGABMAFgATACYAEwA2ABMARgA` +
-	`TAFYAEwBmABMAdgATAIYAEwCWABMApgATALYAEwDGABMADV1BBQDVABMA5QATAAJdugAZAAYAIhESObgAGxC4ACrQQQUA2gAvAOoALwACXUEbAAkALwAZAC8AKQAvADkALwBJAC8AWQAvAGkALwB5A` +
-	`C8AiQAvAJkALwCpAC8AuQAvAMkALwANXbgAIhC4ADXcALgAGy+4ACcvugAKAA8ANSu6AB4AMgA1K7oAFwACADUrMDEBBiMiLgE1ND4BMzIXByYjIg4BFRQeATMyNxMRIxEzMh4BFRQOAQcTIwMnMz4` +
-	`CNTQmByMCHlVDWKhTUqhZQ1UJSEg3WDg5WDZHSd6K3mJnMxxKJtqIyE5iLS4XTVI1AvkgX7t2dbpgIIMpSHlSU3tGKf22/sADBTxSLytmQRb+oAFAahEeOx41NQEAAAIATP/8BLEGEAAOABQAS7gAM` +
-	`iu6AAAAAQA1K7oACgAFADUrugAUABEANSu4AAUQuAAH0LgAABC4AA3QuAANL7gAERC4ABDcALgAAi+4AAgvugAPABAANSswMRMjETMTFzMnETMRIwMnFwEVIREzEcN3p/IgAwN4quooAgPu/iyDAsc` +
-	`DSf3QU1MCMPy3AidYV/uJfANR/SsAAQACBWYEzAYgAAMAD7gAMisAugAAAAEANSswMQEVITUEzPs2BiC6ugAAAAABAAIEqATMBWIAAwAPuAAyKwC6AAAAAQA1KzAxARUhNQTM+zYFYrq6AAAAAAEAA` +
-	`gPqBMwEpAADAA+4ADIrALoAAAABADUrMDEBFSE1BMz7NgSkuroAAAAAAQACAywEzAPmAAMAD7gAMisAugAAAAEANSswMQEVITUEzPs2A+a6ugAAAAABAAICbgTMAygAAwAPuAAyKwC6AAAAAQA1KzA` +
-	`xARUhNQTM+zYDKLq6AAAAAAEAAgGuBMwCaAADAA+4ADIrALoAAAABADUrMDEBFSE1BMz7NgJouroAAAAAAQACAPAEzAGqAAMAD7gAMisAugAAAAEANSswMQEVITUEzPs2Aaq6ugAAAAABAAIAMgTMA` +
-	`OwAAwAPuAAyKwC6AAAAAQA1KzAxJRUhNQTM+zbsuroAAQACBWYEzAYgAAMAD7gAMisAugAAAAEANSswMQEVITUEzPs2BiC6ugAAAAABAAID6gTMBKQAAwAPuAAyKwC6AAAAAQA1KzAxARUhNQTM+zY` +
-	`EpLq6AAAAAAEAAgJuBMwDKAADAA+4ADIrALoAAAABADUrMDEBFSE1BMz7NgMouroAAAAAAQACAPAEzAGqAAMAD7gAMisAugAAAAEANSswMQEVITUEzPs2Aaq6ugAAAAABAAL/dATMAC4AAwAPuAAyK` +
-	`wC6AAAAAQA1KzAxJRUhNQTM+zYuuroAAQIU/zsCugYLAAMAF7gAMiu6AAEAAgA1KwC4AAEvuAAALzAxAREjEQK6pgYL+TAG0AAAAAECFP87BNADKAAFABu4ADIrugADAAQANSsAuAADL7oABQACADU` +
-	`rMDEBFQURIxEE0P3qpgMouwH8zwPtAAEAAP87AroDKAAFABu4ADIrugABAAIANSsAuAABL7oAAAADADUrMDEBESMRITUCuqb97AMo/BMDM7oAAAECFAJuBNAGCwAFABu4ADIrugABAAQANSsAuAAAL` +
-	`7oAAgADADUrMDEBESEVIRECugIW/UQGC/0cuQOdAAEAAAJuAroGCwAFABu4ADIrugABAAQANSsAuAAAL7oABAABADUrMDEBESE1IRECuv1GAhQGC/xjugLjAAECEv87BMwGCwAHACe4ADIrugAFAAY` +
-	`ANSu4AAUQuAAA0AC4AAAvuAAFL7oAAgADADUrMDEBESEVIREjEQK4AhT97KYGC/0duvzNBtAAAAAAAQAA/zsCugYLAAcAJ7gAMiu6AAEAAgA1K7gAAhC4AAbQALgAAC+4AAEvugAGAAMANSswMQERI` +
-	`xEhNSERArqm/ewCFAYL+TADM7oC4wAAAAABAAD/OwTQAykABwAjuAAyK7oAAwAEADUrALgAAy+6AAAAAQA1K7gAARC4AAXQMDEBFSERIxEhNQTQ/eqm/ewDKb38zwMxvQABAAACbgTQBgsABwAjuAA` +
-	`yK7oAAQAGADUrALgAAC+6AAIAAwA1K7gAAhC4AAXQMDEBESEVITUhEQK6Ahb7MAIUBgv9Hbq6AuMAAAAAAQAA/zsE0AYLAAsAP7gAMiu6AAUABgA1K7gABRC4AADQuAAGELgACtAAuAAAL7gABS+6A` +
-	`AIAAwA1K7gAAxC4AAfQuAACELgACdAwMQERIRUhESMRJTUhEQK6Ahb96qb97AIUBgv9Hrv8zQMzAboC4gAAAAABAAEAAATMBiEAAwAPuAAyKwC4AAAvuAACLzAxEyERIQEEy/s1BiH53wAAAAEAAQA` +
-	`ABNEGEAADAA+4ADIrALgAAC+4AAIvMDEhCQICZv2bAmUCawMIAwj8+AAAAAwAAf82BAAGEwADAAcACwAPABMAFwAbAB8AIwAnACsALwKTuAAyK7oABAAFADUruAANELgAANC4AAAvuAAEELgACNy4A` +
-	`AHQuAABL7gACBC4AA7cuAAD0LgABBC4AAnQuAAJL7gAARC4AAvQuAALL7gADhC4AAzcuAAOELgAENC4ABAvuAAIELgAEdC4ABEvuAABELgAEtC4ABIvuAANELgAE9C4ABMvuAAEELgAFNC4AAUQuAA` +
-	`V0LgACRC4ABfQuAAXL7gACBC4ABjQuAAEELgAGdC4ABkvuAAJELgAGtC4AAEQuAAb0LgAGy+4AAwQuAAc0LgADhC4AB3QuAAdL7gADRC4AB7QuAAeL7gADhC4ACDQuAAgL7gACBC4ACHQuAAhL7gAA` +
-	`RC4ACLQuAAiL7gADRC4ACPQuAAjL7gABBC4ACTQuAAFELgAJdC4AAkQuAAn0LgAJy+4AAgQuAAo0LgABBC4ACnQuAApL7gACRC4ACrQuAABELgAK9C4ACsvuAAMELgALNC4AA4QuAAt0LgALS+4AA0` +
-	`QuAAu0LgALi8AuAADL7gABi+4ACgvuAAsL7oAAAAMADUruAADELgADty4AAHQuAABL7gADhC4AATQuAAEL7gAABC4AAXQuAAFL7gAAxC4AAfQuAAHL7gADBC4AAjQuAAIL7gADhC4AArQuAAKL7gAA` +
-	`BC4AAvQuAAoELgAK9y4ACDQuAAc3LgAENy4AAwQuAAS0LgAEBC4ABTQuAAUL7gADBC4ABbQuAAWL7gAHBC4ABjQuAAYL7gAEBC4ABrQuAAaL7gAEBC4AB7QuAAcELgAItC4ACsQuAAk0LgAJC+4ACA` +
-	`QuAAl0LgAJS+4ABwQuAAm0LgAJi+4ACAQuAAq0LgAKi+4ACgQuAAt0LgAKxC4AC7QuAAgELgAL9C4AC8vMDEBJxM3ASMRFwEjERcBJxM3AycTNwEjERcBIxEXAScTNwMnEzcBIxEXASMRFwEnEzcDB` +
-	`f0B+/34+/0BBfv8Afz9Afr5/QH7/fj7/QEF+/wB/P0B+vn9Afv9+Pv9AQX7/AH8/QH6BO0BASMC/tsBJQL9uAElAf7bAQEkAf22AQEjAf7cASQB/bkBJAH+3AEBIwH9tgEBJAH+2wElAf24ASQB/ts` +
-	`CASMBAAAA`
-
-const MonacoFontData = MonacoFontData0 + MonacoFontData1 + MonacoFontData2 + MonacoFontData3 + MonacoFontData4 + MonacoFontData5 + MonacoFontData6 + MonacoFontData7 + MonacoFontData8 + MonacoFontData9 + MonacoFontData10 + MonacoFontData11 + MonacoFontData12 + MonacoFontData13 + MonacoFontData14
@@ -0,0 +1,183 @@
+package json2image
+
+import (
+	"image"
+	"log"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// syntheticSlant 合成斜体的倾斜比例（约 11 度）
+const syntheticSlant = 0.2
+
+// syntheticFace 在常规字体上合成粗体或倾斜效果的字体
+type syntheticFace struct {
+	font.Face
+	embolden int     // embolden 字形横向加粗的像素数，0 表示不加粗
+	slant    float64 // slant 字形的倾斜比例，0 表示不倾斜
+}
+
+// newSyntheticFace 创建合成指定样式的字体，加粗程度随字号增大
+func newSyntheticFace(face font.Face, style TextStyle, size float64) font.Face {
+	synthetic := &syntheticFace{Face: face}
+	if style&TextStyleBold != 0 {
+		synthetic.embolden = int(math.Max(1, math.Round(size/16)))
+	}
+	if style&TextStyleItalic != 0 {
+		synthetic.slant = syntheticSlant
+	}
+	return synthetic
+}
+
+// Glyph 将原字形的遮罩横向加粗并按基线倾斜
+func (f *syntheticFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	dr, mask, maskp, advance, ok := f.Face.Glyph(dot, r)
+	if !ok {
+		return dr, mask, maskp, advance, ok
+	}
+	advance += fixed.I(f.embolden)
+	if dr.Empty() {
+		return dr, mask, maskp, advance, ok
+	}
+
+	// 基线以上的像素向右偏移，基线以下的向左偏移
+	baseline := float64(dot.Y) / 64
+	shift := func(y int) float64 {
+		return f.slant * (baseline - float64(y) - 0.5)
+	}
+	minX := dr.Min.X + int(math.Floor(math.Min(shift(dr.Min.Y), shift(dr.Max.Y-1))))
+	maxX := dr.Max.X + f.embolden + int(math.Ceil(math.Max(shift(dr.Min.Y), shift(dr.Max.Y-1)))) + 1
+	dst := image.NewAlpha(image.Rect(minX, dr.Min.Y, maxX, dr.Max.Y))
+
+	row := make([]float64, dr.Dx()+f.embolden)
+	for y := dr.Min.Y; y < dr.Max.Y; y++ {
+		for i := range row {
+			row[i] = 0
+		}
+		for x := 0; x < dr.Dx(); x++ {
+			_, _, _, a := mask.At(maskp.X+x, maskp.Y+y-dr.Min.Y).RGBA()
+			alpha := float64(a >> 8)
+			for k := 0; k <= f.embolden; k++ {
+				row[x+k] = math.Max(row[x+k], alpha)
+			}
+		}
+
+		// 按小数偏移在相邻两个像素间分配覆盖度
+		offset := shift(y)
+		whole := math.Floor(offset)
+		frac := offset - whole
+		for i, alpha := range row {
+			if alpha == 0 {
+				continue
+			}
+			x := dr.Min.X + i + int(whole)
+			addAlpha(dst, x, y, alpha*(1-frac))
+			addAlpha(dst, x+1, y, alpha*frac)
+		}
+	}
+	return dst.Rect, dst, dst.Rect.Min, advance, true
+}
+
+// addAlpha 累加像素的覆盖度
+func addAlpha(dst *image.Alpha, x, y int, alpha float64) {
+	if !(image.Point{X: x, Y: y}).In(dst.Rect) {
+		return
+	}
+	i := dst.PixOffset(x, y)
+	dst.Pix[i] = uint8(math.Min(255, float64(dst.Pix[i])+alpha))
+}
+
+// GlyphBounds 返回合成后字形的边界
+func (f *syntheticFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	bounds, advance, ok := f.Face.GlyphBounds(r)
+	if !ok {
+		return bounds, advance, ok
+	}
+	bounds.Max.X += fixed.I(f.embolden)
+	if f.slant != 0 {
+		bounds.Max.X += fixed.Int26_6(f.slant * float64(-bounds.Min.Y))
+		bounds.Min.X -= fixed.Int26_6(f.slant * float64(bounds.Max.Y))
+	}
+	return bounds, advance + fixed.I(f.embolden), true
+}
+
+// GlyphAdvance 返回合成后字形的步进宽度
+func (f *syntheticFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	advance, ok := f.Face.GlyphAdvance(r)
+	return advance + fixed.I(f.embolden), ok
+}
+
+// fontFamily 按 TextStyle 索引的各样式字体回退链
+type fontFamily [4]fontChain
+
+// chain 返回样式对应的字体回退链
+func (f fontFamily) chain(style TextStyle) fontChain {
+	return f[style&TextStyleBoldItalic]
+}
+
+// synthesize 用合成样式包装回退链中的每个字体
+func (c fontChain) synthesize(style TextStyle, size float64) fontChain {
+	if style == TextStyleRegular {
+		return c
+	}
+	synthetic := make(fontChain, len(c))
+	for i, f := range c {
+		synthetic[i] = fontFace{face: newSyntheticFace(f.face, style, size), covers: f.covers}
+	}
+	return synthetic
+}
+
+// loadFontFamily 加载常规字体及粗体、斜体、粗斜体变体。
+// 未配置或加载失败的变体由已有的变体合成：粗斜体优先在斜体上加粗，其次将粗体倾斜
+func loadFontFamily(config *Config) (fontFamily, error) {
+	regular, err := loadFontChain(config)
+	if err != nil {
+		return fontFamily{}, err
+	}
+
+	var family fontFamily
+	var loaded [4]bool
+	family[TextStyleRegular], loaded[TextStyleRegular] = regular, true
+
+	names := map[TextStyle]string{
+		TextStyleBold:       config.Font.BoldName,
+		TextStyleItalic:     config.Font.ItalicName,
+		TextStyleBoldItalic: config.Font.BoldItalicName,
+	}
+	for _, style := range []TextStyle{TextStyleBold, TextStyleItalic, TextStyleBoldItalic} {
+		if names[style] == "" {
+			continue
+		}
+		data, index, err := lookupFont(names[style])
+		if err == nil {
+			var variant fontFace
+			if variant, err = newFontFace(data, index, config.Font.Size); err == nil {
+				// 变体字体缺少的字符仍由回退字体合成样式后绘制
+				family[style] = append(fontChain{variant}, regular[1:].synthesize(style, config.Font.Size)...)
+				loaded[style] = true
+				continue
+			}
+		}
+		log.Printf("警告: 加载字体变体 %s 失败: %v，使用合成样式", names[style], err)
+	}
+
+	if !loaded[TextStyleBold] {
+		family[TextStyleBold] = regular.synthesize(TextStyleBold, config.Font.Size)
+	}
+	if !loaded[TextStyleItalic] {
+		family[TextStyleItalic] = regular.synthesize(TextStyleItalic, config.Font.Size)
+	}
+	if !loaded[TextStyleBoldItalic] {
+		switch {
+		case loaded[TextStyleItalic]:
+			family[TextStyleBoldItalic] = family[TextStyleItalic].synthesize(TextStyleBold, config.Font.Size)
+		case loaded[TextStyleBold]:
+			family[TextStyleBoldItalic] = family[TextStyleBold].synthesize(TextStyleItalic, config.Font.Size)
+		default:
+			family[TextStyleBoldItalic] = regular.synthesize(TextStyleBoldItalic, config.Font.Size)
+		}
+	}
+	return family, nil
+}
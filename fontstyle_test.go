@@ -0,0 +1,97 @@
+package json2image
+
+import (
+	"image"
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestSyntheticFace(t *testing.T) {
+	regular, err := loadFontFace(DefaultConfig())
+	if err != nil {
+		t.Fatalf("loadFontFace failed: %v", err)
+	}
+
+	// 合成粗体的步进宽度增加，倾斜不改变步进宽度
+	regularAdvance, _ := regular.face.GlyphAdvance('H')
+	bold := newSyntheticFace(regular.face, TextStyleBold, 14)
+	boldAdvance, _ := bold.GlyphAdvance('H')
+	if boldAdvance <= regularAdvance {
+		t.Errorf("Expected bold advance > %v, got %v", regularAdvance, boldAdvance)
+	}
+	italic := newSyntheticFace(regular.face, TextStyleItalic, 14)
+	if italicAdvance, _ := italic.GlyphAdvance('H'); italicAdvance != regularAdvance {
+		t.Errorf("Expected italic advance %v, got %v", regularAdvance, italicAdvance)
+	}
+
+	// 合成粗体的字形覆盖更多像素
+	coverage := func(dr image.Rectangle, mask image.Image, maskp image.Point) int {
+		total := 0
+		for y := 0; y < dr.Dy(); y++ {
+			for x := 0; x < dr.Dx(); x++ {
+				_, _, _, a := mask.At(maskp.X+x, maskp.Y+y).RGBA()
+				total += int(a >> 8)
+			}
+		}
+		return total
+	}
+	dot := fixed.P(10, 20)
+	dr, mask, maskp, _, ok := regular.face.Glyph(dot, 'H')
+	if !ok {
+		t.Fatal("Expected glyph for H")
+	}
+	regularCoverage := coverage(dr, mask, maskp)
+	dr, mask, maskp, _, ok = bold.Glyph(dot, 'H')
+	if !ok {
+		t.Fatal("Expected bold glyph for H")
+	}
+	if boldCoverage := coverage(dr, mask, maskp); boldCoverage <= regularCoverage {
+		t.Errorf("Expected bold coverage > %d, got %d", regularCoverage, boldCoverage)
+	}
+
+	// 倾斜后字形的顶部向右偏移
+	regularBounds, _, _ := regular.face.GlyphBounds('H')
+	italicBounds, _, _ := italic.GlyphBounds('H')
+	if italicBounds.Max.X <= regularBounds.Max.X {
+		t.Errorf("Expected italic bounds to extend right, got %v vs %v", italicBounds, regularBounds)
+	}
+}
+
+func TestLoadFontFamily(t *testing.T) {
+	// 未配置变体时全部由常规字体合成
+	family, err := loadFontFamily(DefaultConfig())
+	if err != nil {
+		t.Fatalf("loadFontFamily failed: %v", err)
+	}
+	if _, ok := family.chain(TextStyleRegular)[0].face.(*syntheticFace); ok {
+		t.Error("Expected regular face not to be synthetic")
+	}
+	for _, style := range []TextStyle{TextStyleBold, TextStyleItalic, TextStyleBoldItalic} {
+		if _, ok := family.chain(style)[0].face.(*syntheticFace); !ok {
+			t.Errorf("Expected synthetic face for style %d", style)
+		}
+	}
+
+	// 已注册的变体字体直接使用，粗斜体在斜体变体上合成，加载失败的变体回退为合成样式
+	config := DefaultConfig().
+		WithFallbackFonts("wrjs").
+		WithFontVariants("missing-bold", "wrjs", "")
+	family, err = loadFontFamily(config)
+	if err != nil {
+		t.Fatalf("loadFontFamily failed: %v", err)
+	}
+	if _, ok := family.chain(TextStyleItalic)[0].face.(*syntheticFace); ok {
+		t.Error("Expected registered italic variant to be used as is")
+	}
+	if len(family.chain(TextStyleItalic)) != 2 {
+		t.Errorf("Expected italic chain to keep the fallback font, got %d faces", len(family.chain(TextStyleItalic)))
+	}
+	if _, ok := family.chain(TextStyleBold)[0].face.(*syntheticFace); !ok {
+		t.Error("Expected synthetic bold when the bold variant is missing")
+	}
+	boldItalic, ok := family.chain(TextStyleBoldItalic)[0].face.(*syntheticFace)
+	if !ok || boldItalic.embolden == 0 || boldItalic.slant != 0 {
+		t.Errorf("Expected bold italic to embolden the italic variant, got %+v", boldItalic)
+	}
+}
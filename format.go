@@ -0,0 +1,238 @@
+package json2image
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsonPrinter JSON格式化器
+type jsonPrinter struct {
+	b           strings.Builder
+	indent      string
+	indentWidth int
+	printWidth  int // printWidth 单行形式的最大字符数，为负时非空的数组和对象全部展开
+}
+
+// skippedItems 裁剪结果中连续省略的数组元素，格式化为 /* … N skipped */ 注释
+type skippedItems struct {
+	count int
+}
+
+// indexedItem 标注原始下标的数组元素，格式化为值之前的 /* [N] */ 注释
+type indexedItem struct {
+	index int
+	value interface{}
+}
+
+// comment 返回标记的注释文本
+func (s skippedItems) comment() string {
+	return "/* … " + strconv.Itoa(s.count) + " skipped */"
+}
+
+// comment 返回下标标注的注释文本
+func (item indexedItem) comment() string {
+	return "/* [" + strconv.Itoa(item.index) + "] */ "
+}
+
+// formatValue 格式化JSON值。紧凑模式下单行形式能放入当前行的数组和对象保持单行，否则逐项展开；
+// 非紧凑模式下全部展开，结果与 json.MarshalIndent 相同
+func formatValue(v interface{}, format FormatConfig) (string, error) {
+	p := &jsonPrinter{
+		indent:      format.indent(),
+		indentWidth: format.indentWidth(),
+		printWidth:  -1,
+	}
+	if format.Compact {
+		p.printWidth = format.printWidth()
+	}
+	if err := p.write(v, 0, 0, 0); err != nil {
+		return "", err
+	}
+	return p.b.String(), nil
+}
+
+// write 写入值。prefix 为值之前本行已占用的字符数，suffix 为值之后需要保留的字符数（如逗号）
+func (p *jsonPrinter) write(v interface{}, depth, prefix, suffix int) error {
+	switch v := v.(type) {
+	case indexedItem:
+		comment := v.comment()
+		p.b.WriteString(comment)
+		return p.write(v.value, depth, prefix+utf8.RuneCountInString(comment), suffix)
+	case skippedItems:
+		p.b.WriteString(v.comment())
+		return nil
+	case map[string]interface{}:
+		if len(v) == 0 {
+			p.b.WriteString("{}")
+			return nil
+		}
+		if inline, err := p.inline(v, prefix, suffix); err != nil || inline {
+			return err
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		p.b.WriteString("{")
+		for i, key := range keys {
+			encodedKey, err := json.Marshal(key)
+			if err != nil {
+				return err
+			}
+			p.newline(depth + 1)
+			p.b.Write(encodedKey)
+			p.b.WriteString(": ")
+			column := (depth+1)*p.indentWidth + utf8.RuneCount(encodedKey) + 2
+			if err := p.writeItem(v[key], depth+1, column, i == len(keys)-1); err != nil {
+				return err
+			}
+		}
+		p.newline(depth)
+		p.b.WriteString("}")
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			p.b.WriteString("[]")
+			return nil
+		}
+		if inline, err := p.inline(v, prefix, suffix); err != nil || inline {
+			return err
+		}
+		p.b.WriteString("[")
+		for i, item := range v {
+			p.newline(depth + 1)
+			if err := p.writeItem(item, depth+1, (depth+1)*p.indentWidth, i == len(v)-1); err != nil {
+				return err
+			}
+		}
+		p.newline(depth)
+		p.b.WriteString("]")
+		return nil
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	p.b.Write(encoded)
+	return nil
+}
+
+// inline 在紧凑模式下尝试以单行形式写入数组或对象，放不下当前行时不写入并返回 false
+func (p *jsonPrinter) inline(v interface{}, prefix, suffix int) (bool, error) {
+	if p.printWidth < 0 {
+		return false, nil
+	}
+	w := &inlineWriter{limit: p.printWidth - prefix - suffix}
+	fits, err := w.write(v)
+	if err != nil || !fits {
+		return false, err
+	}
+	p.b.WriteString(w.b.String())
+	return true, nil
+}
+
+// writeItem 写入数组元素或对象成员的值，除最后一项和省略标记外在其后写入逗号
+func (p *jsonPrinter) writeItem(v interface{}, depth, prefix int, last bool) error {
+	if _, skipped := v.(skippedItems); last || skipped {
+		return p.write(v, depth, prefix, 0)
+	}
+	if err := p.write(v, depth, prefix, 1); err != nil {
+		return err
+	}
+	p.b.WriteString(",")
+	return nil
+}
+
+// newline 换行并写入 depth 层缩进
+func (p *jsonPrinter) newline(depth int) {
+	p.b.WriteString("\n")
+	p.b.WriteString(strings.Repeat(p.indent, depth))
+}
+
+// inlineWriter 生成值的单行形式，如 [1, 2] 和 {"x": 1, "y": 2}。
+// 超过 limit 个字符后立即停止，使每次尝试的开销不超过行宽，而与子树大小无关
+type inlineWriter struct {
+	b     strings.Builder
+	width int
+	limit int
+}
+
+// writeString 追加文本，超过 limit 时返回 false
+func (w *inlineWriter) writeString(s string) bool {
+	w.b.WriteString(s)
+	w.width += utf8.RuneCountInString(s)
+	return w.width <= w.limit
+}
+
+// write 追加值的单行形式，超过 limit 时返回 false
+func (w *inlineWriter) write(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		if !w.writeString("{") {
+			return false, nil
+		}
+		for i, key := range keys {
+			if i > 0 && !w.writeString(", ") {
+				return false, nil
+			}
+			if fits, err := w.write(key); err != nil || !fits {
+				return false, err
+			}
+			if !w.writeString(": ") {
+				return false, nil
+			}
+			if fits, err := w.write(v[key]); err != nil || !fits {
+				return false, err
+			}
+		}
+		return w.writeString("}"), nil
+	case []interface{}:
+		if !w.writeString("[") {
+			return false, nil
+		}
+		for i, item := range v {
+			if fits, err := w.write(item); err != nil || !fits {
+				return false, err
+			}
+			if i < len(v)-1 {
+				separator := ", "
+				if _, skipped := item.(skippedItems); skipped {
+					separator = " "
+				}
+				if !w.writeString(separator) {
+					return false, nil
+				}
+			}
+		}
+		return w.writeString("]"), nil
+	case skippedItems:
+		return w.writeString(v.comment()), nil
+	case indexedItem:
+		if !w.writeString(v.comment()) {
+			return false, nil
+		}
+		return w.write(v.value)
+	case string:
+		// 编码后的字符串不短于原文加两个引号，过长时无需编码
+		if w.width+utf8.RuneCountInString(v)+2 > w.limit {
+			return false, nil
+		}
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+	return w.writeString(string(encoded)), nil
+}
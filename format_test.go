@@ -0,0 +1,167 @@
+package json2image
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFormatCompact(t *testing.T) {
+	jsonData := `{
+		"tags": ["a", "b", "c"],
+		"pos": {"x": 1, "y": 2},
+		"path": [[0, 0], [10, 20], [30, 40]],
+		"users": [
+			{"name": "Alice", "email": "alice@example.com", "roles": ["admin", "editor"]},
+			{"name": "Bob", "email": "bob@example.com", "roles": []}
+		],
+		"empty": {}
+	}`
+
+	formatted, err := formatJSONWith(jsonData, FormatConfig{Compact: true, PrintWidth: 64})
+	if err != nil {
+		t.Fatalf("formatJSONWith failed: %v", err)
+	}
+	expected := `{
+    "empty": {},
+    "path": [[0, 0], [10, 20], [30, 40]],
+    "pos": {"x": 1, "y": 2},
+    "tags": ["a", "b", "c"],
+    "users": [
+        {
+            "email": "alice@example.com",
+            "name": "Alice",
+            "roles": ["admin", "editor"]
+        },
+        {"email": "bob@example.com", "name": "Bob", "roles": []}
+    ]
+}`
+	if formatted != expected {
+		t.Errorf("Unexpected compact output:\n%s\nexpected:\n%s", formatted, expected)
+	}
+	for _, line := range strings.Split(formatted, "\n") {
+		if utf8.RuneCountInString(line) > 64 {
+			t.Errorf("Line exceeds print width: %q", line)
+		}
+	}
+
+	// 单行对象中的键按其所在的深度着色
+	lines := parseJSONWithColor(formatted, defaultIndentWidth)
+	for _, seg := range lines[3].segments {
+		if seg.kind == segmentKey && seg.text != `"pos"` && seg.depth != 2 {
+			t.Errorf("Expected inline key %s at depth 2, got %d", seg.text, seg.depth)
+		}
+	}
+
+	// 整体能放入一行时不换行
+	formatted, err = formatJSONWith(`{"a": [1, 2], "b": "<x>"}`, FormatConfig{Compact: true})
+	if err != nil {
+		t.Fatalf("formatJSONWith failed: %v", err)
+	}
+	if expected := `{"a": [1, 2], "b": "\u003cx\u003e"}`; formatted != expected {
+		t.Errorf("Expected %s, got %s", expected, formatted)
+	}
+
+	// 非紧凑模式与 json.MarshalIndent 的输出一致
+	formatted, err = formatJSON(`{"a": [1, 2]}`)
+	if err != nil {
+		t.Fatalf("formatJSON failed: %v", err)
+	}
+	if expected := "{\n    \"a\": [\n        1,\n        2\n    ]\n}"; formatted != expected {
+		t.Errorf("Expected %q, got %q", expected, formatted)
+	}
+}
+
+func TestFormatValueMarkers(t *testing.T) {
+	value := map[string]interface{}{
+		"items": []interface{}{
+			skippedItems{count: 2},
+			indexedItem{index: 2, value: map[string]interface{}{"id": 3.0}},
+			skippedItems{count: 1},
+		},
+		"tags": []interface{}{indexedItem{index: 4, value: "x"}, skippedItems{count: 5}, 1.0},
+	}
+
+	formatted, err := formatValue(value, FormatConfig{})
+	if err != nil {
+		t.Fatalf("formatValue failed: %v", err)
+	}
+	expected := `{
+    "items": [
+        /* … 2 skipped */
+        /* [2] */ {
+            "id": 3
+        },
+        /* … 1 skipped */
+    ],
+    "tags": [
+        /* [4] */ "x",
+        /* … 5 skipped */
+        1
+    ]
+}`
+	if formatted != expected {
+		t.Errorf("Unexpected output:\n%s\nexpected:\n%s", formatted, expected)
+	}
+
+	formatted, err = formatValue(value, FormatConfig{Compact: true})
+	if err != nil {
+		t.Fatalf("formatValue failed: %v", err)
+	}
+	expected = `{
+    "items": [/* … 2 skipped */ /* [2] */ {"id": 3}, /* … 1 skipped */],
+    "tags": [/* [4] */ "x", /* … 5 skipped */ 1]
+}`
+	if formatted != expected {
+		t.Errorf("Unexpected compact output:\n%s\nexpected:\n%s", formatted, expected)
+	}
+}
+
+func TestJson2ImageWithCompact(t *testing.T) {
+	// 测试紧凑模式
+	jsonData := `{
+		"name": "route",
+		"points": [[121.47, 31.23], [116.40, 39.90], [113.26, 23.13]],
+		"bounds": {"minX": 113.26, "minY": 23.13, "maxX": 121.47, "maxY": 39.90},
+		"stops": [
+			{"city": "Shanghai", "arrival": "08:00", "departure": "08:30", "platform": 3},
+			{"city": "Beijing", "arrival": "13:00"}
+		]
+	}`
+
+	config := DefaultConfig().WithCompact(80)
+
+	_, err := Json2Image(jsonData, config, "output/output_compact.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("紧凑模式图片生成成功：output_compact.png")
+}
+
+func TestFormatValueDeepNesting(t *testing.T) {
+	// 深层嵌套时每层只尝试不超过行宽的单行形式，格式化耗时与文档大小成线性
+	var value interface{} = "leaf"
+	for i := 0; i < 500; i++ {
+		value = []interface{}{map[string]interface{}{"k": value}}
+	}
+
+	formatted, err := formatValue(value, FormatConfig{})
+	if err != nil {
+		t.Fatalf("formatValue failed: %v", err)
+	}
+	expected, _ := json.MarshalIndent(value, "", "    ")
+	if formatted != string(expected) {
+		t.Error("Expected output identical to json.MarshalIndent")
+	}
+
+	formatted, err = formatValue(value, FormatConfig{Compact: true, PrintWidth: 100000})
+	if err != nil {
+		t.Fatalf("formatValue failed: %v", err)
+	}
+	if strings.Contains(formatted, "\n") || !strings.Contains(formatted, `[{"k": [{"k": "leaf"}]}]`) {
+		t.Errorf("Expected the whole value on one line")
+	}
+}
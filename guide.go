@@ -0,0 +1,131 @@
+package json2image
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/fogleman/gg"
+)
+
+// 缩进参考线的线宽
+const (
+	guideLineWidth      = 1.0
+	guideHighlightWidth = 2.0
+)
+
+// indentGuide 从开括号所在行到对应闭合括号所在行的缩进参考线
+type indentGuide struct {
+	startLine int    // startLine 开括号所在行
+	endLine   int    // endLine 闭合括号所在行
+	level     int    // level 括号的嵌套深度
+	path      string // path 括号对应的值的路径，格式与裁剪规则相同，根为空字符串
+	pointer   string // pointer 括号对应的值的 JSON Pointer，根为空字符串
+}
+
+// guideFrame 扫描括号时尚未闭合的容器
+type guideFrame struct {
+	isArray   bool
+	count     int // count 数组中已出现的元素个数
+	path      []pathElem
+	startLine int
+}
+
+// findIndentGuides 匹配跨行的括号对，并记录每对括号对应的值的路径
+func findIndentGuides(lines []ColoredLine) []indentGuide {
+	var guides []indentGuide
+	var stack []guideFrame
+	key := ""
+
+	// childPath 返回当前容器中下一个值的路径
+	childPath := func() []pathElem {
+		if len(stack) == 0 {
+			return nil
+		}
+		parent := &stack[len(stack)-1]
+		node := pathNode{path: parent.path}
+		if parent.isArray {
+			parent.count++
+			return node.child(pathElem{index: parent.count - 1, isIndex: true}, nil).path
+		}
+		return node.child(pathElem{key: key}, nil).path
+	}
+
+	for i, line := range lines {
+		for _, seg := range line.segments {
+			switch seg.kind {
+			case segmentKey:
+				if err := json.Unmarshal([]byte(seg.text), &key); err != nil {
+					key = seg.text
+				}
+			case segmentString, segmentNumber, segmentBoolean, segmentNull:
+				childPath()
+			case segmentBrace:
+				if seg.text == "{" || seg.text == "[" {
+					stack = append(stack, guideFrame{isArray: seg.text == "[", path: childPath(), startLine: i})
+					continue
+				}
+				if len(stack) == 0 {
+					continue
+				}
+				frame := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if i > frame.startLine {
+					guides = append(guides, indentGuide{
+						startLine: frame.startLine,
+						endLine:   i,
+						level:     len(stack),
+						path:      formatPath(frame.path),
+						pointer:   formatPointer(frame.path),
+					})
+				}
+			}
+		}
+	}
+	return guides
+}
+
+// matches 判断参考线是否对应路径，以 / 开头的路径按 JSON Pointer 比较
+func (g indentGuide) matches(path string) bool {
+	if strings.HasPrefix(path, "/") {
+		return g.pointer == path
+	}
+	return g.path == path
+}
+
+// guideX 返回参考线的横坐标：闭合括号所在行第一个字符的中心
+func guideX(dc *gg.Context, line ColoredLine, family fontFamily, config *Config) float64 {
+	x, segments := lineContent(line, config)
+	for _, seg := range segments {
+		width := textWidth(dc, segmentChain(seg, family, config), seg.text)
+		if seg.kind != segmentSpace {
+			x += width / 2
+			break
+		}
+		x += width
+	}
+	// 对齐到像素中心，使细线清晰
+	return math.Floor(config.Image.Padding+x) + 0.5
+}
+
+// drawIndentGuides 使用括号颜色绘制缩进参考线，HighlightPath 对应的参考线使用层级颜色加粗绘制
+func drawIndentGuides(dc *gg.Context, lines []ColoredLine, family fontFamily, metrics lineMetrics, config *Config) {
+	highlight := config.Image.HighlightPath
+	for _, guide := range findIndentGuides(lines) {
+		x := guideX(dc, lines[guide.endLine], family, config)
+		top := config.Image.Padding + float64(guide.startLine+1)*metrics.height
+		bottom := config.Image.Padding + float64(guide.endLine)*metrics.height
+
+		color := config.Color.BraceLevelColors[guide.level%len(config.Color.BraceLevelColors)]
+		width := guideLineWidth
+		if highlight != "" && guide.matches(highlight) {
+			color = config.Color.LevelColors[guide.level%len(config.Color.LevelColors)]
+			width = guideHighlightWidth
+		}
+
+		dc.SetRGB(color[0], color[1], color[2])
+		dc.SetLineWidth(width)
+		dc.DrawLine(x, top, x, bottom)
+		dc.Stroke()
+	}
+}
@@ -0,0 +1,76 @@
+package json2image
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindIndentGuides(t *testing.T) {
+	formatted, err := formatJSON(`{"data": {"items": [{"name": "a"}, 1, {"name": "b", "tags": ["x"]}], "empty": []}, "labels": {"k8s.io": {"v": 1}}}`)
+	if err != nil {
+		t.Fatalf("formatJSON failed: %v", err)
+	}
+	lines := parseJSONWithColor(formatted, defaultIndentWidth)
+	guides := findIndentGuides(lines)
+
+	// 单行的空数组没有参考线
+	expected := map[string]int{
+		"":                   0,
+		"data":               1,
+		"data.items":         2,
+		"data.items[0]":      3,
+		"data.items[2]":      3,
+		"data.items[2].tags": 4,
+		"labels":             1,
+		`labels["k8s.io"]`:   2,
+	}
+	if len(guides) != len(expected) {
+		t.Fatalf("Expected %d guides, got %d: %+v", len(expected), len(guides), guides)
+	}
+	for _, guide := range guides {
+		level, ok := expected[guide.path]
+		if !ok {
+			t.Errorf("Unexpected guide path %q", guide.path)
+			continue
+		}
+		if guide.level != level {
+			t.Errorf("Guide %q: expected level %d, got %d", guide.path, level, guide.level)
+		}
+		if lines[guide.startLine].level != level || lines[guide.endLine].level != level {
+			t.Errorf("Guide %q: expected opener and closer lines at level %d", guide.path, level)
+		}
+	}
+
+	// 突出显示的路径也可以是 JSON Pointer
+	pointers := map[string]string{
+		"data.items[2].tags": "/data/items/2/tags",
+		`labels["k8s.io"]`:   "/labels/k8s.io",
+	}
+	for _, guide := range guides {
+		if pointer, ok := pointers[guide.path]; ok && (guide.pointer != pointer || !guide.matches(pointer) || !guide.matches(guide.path)) {
+			t.Errorf("Guide %q: expected pointer %q, got %q", guide.path, pointer, guide.pointer)
+		}
+	}
+}
+
+func TestJson2ImageWithIndentGuides(t *testing.T) {
+	// 测试缩进参考线及突出显示的路径
+	jsonData := `{
+		"data": {
+			"items": [
+				{"id": 1, "name": "Item 1", "tags": ["a", "b"]},
+				{"id": 2, "name": "Item 2", "tags": ["c"]}
+			],
+			"total": 2
+		}
+	}`
+
+	config := DefaultConfig().WithHighlightPath("data.items[1]")
+
+	_, err := Json2Image(jsonData, config, "output/output_guides.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("缩进参考线图片生成成功：output_guides.png")
+}
@@ -1,13 +1,8 @@
 package json2image
 
 import (
-	"encoding/base64"
 	"encoding/json"
-	"fmt"
-	"os"
-	"path/filepath"
-
-	"github.com/BeCrafter/json2image/fonts"
+	"strings"
 )
 
 // FontType 表示字体类型
@@ -18,27 +13,72 @@ const (
 	FontTypeMsyh                     // FontTypeMsyh 微软雅黑字体
 	FontTypePingFang                 // FontTypePingFang 苹方字体
 	FontTypeCustom                   // FontTypeCustom 自定义字体
+	FontTypeWrjs                     // FontTypeWrjs 王壬金石字体
+	FontTypeSystem                   // FontTypeSystem 系统已安装的字体
+)
+
+// TextStyle 文本样式，可按位组合
+type TextStyle int
+
+const (
+	TextStyleRegular    TextStyle = 0                               // TextStyleRegular 常规
+	TextStyleBold       TextStyle = 1                               // TextStyleBold 粗体
+	TextStyleItalic     TextStyle = 2                               // TextStyleItalic 斜体
+	TextStyleBoldItalic           = TextStyleBold | TextStyleItalic // TextStyleBoldItalic 粗斜体
+)
+
+// TokenClass JSON 词法单元的类别
+type TokenClass int
+
+const (
+	TokenKey         TokenClass = iota // TokenKey 对象的键
+	TokenString                        // TokenString 字符串值
+	TokenNumber                        // TokenNumber 数字
+	TokenBoolean                       // TokenBoolean true/false
+	TokenNull                          // TokenNull null
+	TokenPunctuation                   // TokenPunctuation 括号、冒号和逗号
 )
 
 // Config 配置选项
 type Config struct {
-	Font      FontConfig  // Font 字体配置
-	Image     ImageConfig // Image 图片配置
-	Color     ColorConfig // Color 颜色配置
-	CropRules []string    // CropRules 裁剪规则
+	Font      FontConfig   // Font 字体配置
+	Image     ImageConfig  // Image 图片配置
+	Color     ColorConfig  // Color 颜色配置
+	Style     StyleConfig  // Style 各类词法单元的文本样式
+	Format    FormatConfig // Format JSON格式化配置
+	CropRules []string     // CropRules 裁剪规则
+
+	CropArrayMode        ArrayMode // CropArrayMode 裁剪结果中数组的重建方式
+	UnmatchedRulesFooter bool      // UnmatchedRulesFooter 在裁剪图片底部列出未选中任何节点的规则及原因
 }
 
 // FontConfig 字体配置
 type FontConfig struct {
 	Type       FontType // Type 字体类型
+	Name       string   // Name 字体注册名称，设置后优先于Type（内置字体名称见 fonts 包）
 	CustomPath string   // CustomPath 自定义字体文件路径（当Type为FontTypeCustom时使用）
+	SystemName string   // SystemName 系统字体族名或完整名称（当Type为FontTypeSystem时使用）
+	FaceIndex  int      // FaceIndex 自定义字体为字体集合（.ttc）时使用的字体序号
+	Fallbacks  []string // Fallbacks 回退字体名称，主字体缺少某个字符的字形时依次尝试
 	Size       float64  // Size 字体大小
-	LineHeight float64  // LineHeight 行高
+	LineHeight float64  // LineHeight 行高（像素），与 LineHeightScale 均为0时使用字体自身的行高
+
+	LineHeightScale float64 // LineHeightScale 行高相对字体大小的倍数（如1.4），大于0时优先于LineHeight
+
+	// FallbackToDefault 主字体加载失败（如字体未注册）时记录警告并改用 Monaco，为 false 时返回错误
+	FallbackToDefault bool
+
+	// 粗体、斜体等变体字体的注册名称，为空时由常规字体合成粗体或倾斜效果
+	BoldName       string // BoldName 粗体字体注册名称
+	ItalicName     string // ItalicName 斜体字体注册名称
+	BoldItalicName string // BoldItalicName 粗斜体字体注册名称
 }
 
 // ImageConfig 图片配置
 type ImageConfig struct {
 	Padding         float64    // Padding 内边距
+	IndentGuides    bool       // IndentGuides 绘制从开括号到对应闭合括号的缩进参考线
+	HighlightPath   string     // HighlightPath 突出显示该路径（如 "data.items[0]" 或 "/data/items/0"）对应的参考线
 	BackgroundColor [3]float64 // BackgroundColor 背景色
 }
 
@@ -47,15 +87,102 @@ type ColorConfig struct {
 	LevelColors      [][3]float64 // LevelColors 各层级的颜色
 	BraceLevelColors [][3]float64 // BraceLevelColors 括号的颜色
 	DefaultTextColor [3]float64   // DefaultTextColor 默认文本颜色
+
+	MismatchedBraceColor [3]float64 // MismatchedBraceColor 类型不匹配或无法配对的括号的颜色
+}
+
+const (
+	defaultIndentWidth = 4  // defaultIndentWidth 默认的缩进宽度（空格数）
+	defaultPrintWidth  = 80 // defaultPrintWidth 紧凑模式默认的每行最大字符数
+)
+
+// FormatConfig JSON格式化配置
+type FormatConfig struct {
+	IndentWidth    int  // IndentWidth 每层缩进的空格数，为0时使用4；使用制表符时为制表符的显示宽度
+	IndentWithTabs bool // IndentWithTabs 使用制表符缩进
+
+	// AlignColons 在每个对象内补齐键的宽度，使冒号和值对齐成一列
+	AlignColons bool
+	// AlignMaxKeyLength 参与对齐的键的最大字符数，更长的键不补齐也不影响对齐位置，0表示不限制
+	AlignMaxKeyLength int
+
+	// Compact 紧凑模式：单行形式不超过 PrintWidth 的数组和对象保持单行，其余展开
+	Compact bool
+	// PrintWidth 紧凑模式下每行的最大字符数，为0时使用80
+	PrintWidth int
+
+	// IndentPx 大于0时按像素缩进：每层嵌套偏移固定的像素，行首空白不再按字形绘制，
+	// 适用于比例字体，闭合括号与对应的开括号所在行严格对齐
+	IndentPx float64
+
+	// Raw 原样绘制输入文本，不解析也不重新格式化，可用于展示无效的JSON；
+	// 类型不匹配或无法配对的括号使用 MismatchedBraceColor 标出。裁剪时忽略该选项
+	Raw bool
+}
+
+// indentWidth 返回每层缩进的宽度（空格数）
+func (f FormatConfig) indentWidth() int {
+	if f.IndentWidth <= 0 {
+		return defaultIndentWidth
+	}
+	return f.IndentWidth
+}
+
+// printWidth 返回紧凑模式下每行的最大字符数
+func (f FormatConfig) printWidth() int {
+	if f.PrintWidth <= 0 {
+		return defaultPrintWidth
+	}
+	return f.PrintWidth
+}
+
+// indent 返回每层缩进使用的字符串
+func (f FormatConfig) indent() string {
+	if f.IndentWithTabs {
+		return "\t"
+	}
+	return strings.Repeat(" ", f.indentWidth())
+}
+
+// StyleConfig 各类词法单元的文本样式，默认均为常规样式
+type StyleConfig struct {
+	Key         TextStyle // Key 对象的键
+	String      TextStyle // String 字符串值
+	Number      TextStyle // Number 数字
+	Boolean     TextStyle // Boolean true/false
+	Null        TextStyle // Null null
+	Punctuation TextStyle // Punctuation 括号、冒号和逗号
+}
+
+// forToken 返回词法单元类别对应的样式
+func (s StyleConfig) forToken(token TokenClass) TextStyle {
+	switch token {
+	case TokenKey:
+		return s.Key
+	case TokenString:
+		return s.String
+	case TokenNumber:
+		return s.Number
+	case TokenBoolean:
+		return s.Boolean
+	case TokenNull:
+		return s.Null
+	case TokenPunctuation:
+		return s.Punctuation
+	}
+	return TextStyleRegular
 }
 
 // DefaultConfig 返回默认配置
 func DefaultConfig() *Config {
 	return &Config{
 		Font: FontConfig{
-			Type:       FontTypeMsyh,
-			Size:       14,
-			LineHeight: 20,
+			Type: FontTypeMonaco, // 随库内置，无需额外导入字体子包
+			Size: 14,
+			// 不设置像素行高，行高取字体自身的行高，随字体大小变化
+		},
+		Format: FormatConfig{
+			IndentWidth: defaultIndentWidth,
 		},
 		Image: ImageConfig{
 			Padding:         20,
@@ -86,7 +213,8 @@ func DefaultConfig() *Config {
 				{0.9, 0.7, 0.8}, // 浅粉色
 				{0.7, 0.8, 0.6}, // 浅橄榄绿
 			},
-			DefaultTextColor: [3]float64{0, 0, 0}, // 黑色
+			DefaultTextColor:     [3]float64{0, 0, 0},       // 黑色
+			MismatchedBraceColor: [3]float64{0.9, 0.1, 0.1}, // 红色
 		},
 	}
 }
@@ -94,13 +222,59 @@ func DefaultConfig() *Config {
 // WithFont 设置字体类型
 func (c *Config) WithFont(fontType FontType) *Config {
 	c.Font.Type = fontType
+	c.Font.Name = ""
+	return c
+}
+
+// WithFallbackToDefault 设置主字体加载失败（如字体子包未导入）时是否改用 Monaco 字体，
+// 默认返回 *FontNotRegisteredError 等错误
+func (c *Config) WithFallbackToDefault(enabled bool) *Config {
+	c.Font.FallbackToDefault = enabled
+	return c
+}
+
+// WithFontName 按注册名称设置字体
+func (c *Config) WithFontName(name string) *Config {
+	c.Font.Name = name
+	return c
+}
+
+// WithFallbackFonts 设置回退字体名称，内置字体名称见 fonts 包
+func (c *Config) WithFallbackFonts(names ...string) *Config {
+	c.Font.Fallbacks = names
+	return c
+}
+
+// WithFontVariants 设置粗体、斜体和粗斜体字体的注册名称，传入空字符串表示由常规字体合成
+func (c *Config) WithFontVariants(bold, italic, boldItalic string) *Config {
+	c.Font.BoldName = bold
+	c.Font.ItalicName = italic
+	c.Font.BoldItalicName = boldItalic
 	return c
 }
 
 // WithCustomFont 设置自定义字体
 func (c *Config) WithCustomFont(fontPath string) *Config {
 	c.Font.Type = FontTypeCustom
+	c.Font.Name = ""
 	c.Font.CustomPath = fontPath
+	c.Font.FaceIndex = 0
+	return c
+}
+
+// WithCustomFontCollection 设置自定义字体集合（.ttc）及其中的字体序号
+func (c *Config) WithCustomFontCollection(fontPath string, index int) *Config {
+	c.WithCustomFont(fontPath)
+	c.Font.FaceIndex = index
+	return c
+}
+
+// WithSystemFont 按族名（如 "DejaVu Sans Mono"）或完整名称（如 "DejaVu Sans Mono Bold"）
+// 使用系统已安装的字体，渲染时在标准字体目录中查找
+func (c *Config) WithSystemFont(name string) *Config {
+	c.Font.Type = FontTypeSystem
+	c.Font.Name = ""
+	c.Font.SystemName = name
 	return c
 }
 
@@ -110,9 +284,16 @@ func (c *Config) WithFontSize(size float64) *Config {
 	return c
 }
 
-// WithLineHeight 设置行高
+// WithLineHeight 设置行高（像素）
 func (c *Config) WithLineHeight(lineHeight float64) *Config {
 	c.Font.LineHeight = lineHeight
+	c.Font.LineHeightScale = 0
+	return c
+}
+
+// WithLineHeightScale 以字体大小的倍数设置行高，如 1.4 表示行高为字体大小的 1.4 倍
+func (c *Config) WithLineHeightScale(scale float64) *Config {
+	c.Font.LineHeightScale = scale
 	return c
 }
 
@@ -122,6 +303,20 @@ func (c *Config) WithPadding(padding float64) *Config {
 	return c
 }
 
+// WithIndentGuides 设置是否绘制缩进参考线
+func (c *Config) WithIndentGuides(enabled bool) *Config {
+	c.Image.IndentGuides = enabled
+	return c
+}
+
+// WithHighlightPath 突出显示指定路径的缩进参考线，路径格式与裁剪规则相同，如 "data.items[0]"，
+// 也可以是 JSON Pointer，如 "/data/items/0"。同时开启缩进参考线
+func (c *Config) WithHighlightPath(path string) *Config {
+	c.Image.IndentGuides = true
+	c.Image.HighlightPath = path
+	return c
+}
+
 // WithBackgroundColor 设置背景色
 func (c *Config) WithBackgroundColor(r, g, b float64) *Config {
 	c.Image.BackgroundColor = [3]float64{r, g, b}
@@ -146,14 +341,94 @@ func (c *Config) WithDefaultTextColor(r, g, b float64) *Config {
 	return c
 }
 
+// WithTokenStyle 设置某类词法单元的文本样式，如粗体的键、斜体的 null
+func (c *Config) WithTokenStyle(token TokenClass, style TextStyle) *Config {
+	switch token {
+	case TokenKey:
+		c.Style.Key = style
+	case TokenString:
+		c.Style.String = style
+	case TokenNumber:
+		c.Style.Number = style
+	case TokenBoolean:
+		c.Style.Boolean = style
+	case TokenNull:
+		c.Style.Null = style
+	case TokenPunctuation:
+		c.Style.Punctuation = style
+	}
+	return c
+}
+
+// WithIndentWidth 设置每层缩进的空格数
+func (c *Config) WithIndentWidth(width int) *Config {
+	c.Format.IndentWidth = width
+	return c
+}
+
+// WithIndentWithTabs 设置是否使用制表符缩进
+func (c *Config) WithIndentWithTabs(useTabs bool) *Config {
+	c.Format.IndentWithTabs = useTabs
+	return c
+}
+
+// WithCompact 开启紧凑模式，printWidth 为每行的最大字符数，0表示使用默认值80
+func (c *Config) WithCompact(printWidth int) *Config {
+	c.Format.Compact = true
+	c.Format.PrintWidth = printWidth
+	return c
+}
+
+// WithIndentPx 设置每层嵌套的像素缩进，传入0恢复按空白字符缩进
+func (c *Config) WithIndentPx(px float64) *Config {
+	c.Format.IndentPx = px
+	return c
+}
+
+// WithRawInput 设置是否原样绘制输入文本，不解析也不重新格式化
+func (c *Config) WithRawInput(enabled bool) *Config {
+	c.Format.Raw = enabled
+	return c
+}
+
+// WithMismatchedBraceColor 设置不匹配括号的颜色
+func (c *Config) WithMismatchedBraceColor(r, g, b float64) *Config {
+	c.Color.MismatchedBraceColor = [3]float64{r, g, b}
+	return c
+}
+
+// WithAlignColons 开启冒号对齐，maxKeyLength 为参与对齐的键的最大字符数，0表示不限制
+func (c *Config) WithAlignColons(maxKeyLength int) *Config {
+	c.Format.AlignColons = true
+	c.Format.AlignMaxKeyLength = maxKeyLength
+	return c
+}
+
 // WithCropRules 设置裁剪规则
 func (c *Config) WithCropRules(rules ...string) *Config {
 	c.CropRules = rules
 	return c
 }
 
-// formatJSON 格式化JSON字符串
+// WithCropArrayMode 设置裁剪结果中数组的重建方式
+func (c *Config) WithCropArrayMode(mode ArrayMode) *Config {
+	c.CropArrayMode = mode
+	return c
+}
+
+// WithUnmatchedRulesFooter 设置是否在裁剪图片底部列出未选中任何节点的规则
+func (c *Config) WithUnmatchedRulesFooter(enabled bool) *Config {
+	c.UnmatchedRulesFooter = enabled
+	return c
+}
+
+// formatJSON 以默认的四个空格缩进格式化JSON字符串
 func formatJSON(data string) (string, error) {
+	return formatJSONWith(data, FormatConfig{})
+}
+
+// formatJSONWith 按格式化配置格式化JSON字符串
+func formatJSONWith(data string, format FormatConfig) (string, error) {
 	var jsonObj interface{}
 	if err := json.Unmarshal([]byte(data), &jsonObj); err != nil {
 		return "", err
@@ -163,11 +438,7 @@ func formatJSON(data string) (string, error) {
 	processedObj := processNestedJSON(jsonObj)
 
 	// 重新格式化整个 JSON
-	prettyJSON, err := json.MarshalIndent(processedObj, "", "    ")
-	if err != nil {
-		return "", err
-	}
-	return string(prettyJSON), nil
+	return formatValue(processedObj, format)
 }
 
 // processNestedJSON 递归处理嵌套的 JSON 结构
@@ -199,66 +470,3 @@ func processNestedJSON(v interface{}) interface{} {
 		return v
 	}
 }
-
-// getFontFile 根据配置获取字体文件路径
-func getFontFile(config *Config) (string, error) {
-	var fontData string
-	var err error
-
-	switch config.Font.Type {
-	case FontTypeMonaco:
-		fontData = fonts.MonacoFontData
-	case FontTypeMsyh:
-		fontData = fonts.MsyhFontData
-	case FontTypePingFang:
-		fontData = fonts.PingfangscFontData
-	case FontTypeCustom:
-		if config.Font.CustomPath == "" {
-			return "", fmt.Errorf("自定义字体路径不能为空")
-		}
-
-		// 检查文件是否存在
-		if _, err := os.Stat(config.Font.CustomPath); os.IsNotExist(err) {
-			return "", fmt.Errorf("字体文件不存在: %s", config.Font.CustomPath)
-		}
-
-		// 检查文件扩展名
-		ext := filepath.Ext(config.Font.CustomPath)
-		if ext != ".ttf" && ext != ".otf" {
-			return "", fmt.Errorf("不支持的字体文件格式: %s", ext)
-		}
-
-		return config.Font.CustomPath, nil
-	default:
-		return "", fmt.Errorf("未知的字体类型: %d", config.Font.Type)
-	}
-
-	if fontData == "" {
-		return "", fmt.Errorf("字体数据为空")
-	}
-
-	// 将 base64 字体数据解码为字节
-	decodedData, err := base64.StdEncoding.DecodeString(fontData)
-	if err != nil {
-		return "", fmt.Errorf("解码字体数据失败: %v", err)
-	}
-
-	// 创建临时文件
-	tmpFile, err := os.CreateTemp("", "font-*.ttf")
-	if err != nil {
-		return "", fmt.Errorf("创建临时字体文件失败: %v", err)
-	}
-
-	// 写入字体数据
-	if _, err := tmpFile.Write(decodedData); err != nil {
-		tmpFile.Close()
-		return "", fmt.Errorf("写入字体数据失败: %v", err)
-	}
-
-	// 关闭文件
-	if err := tmpFile.Close(); err != nil {
-		return "", fmt.Errorf("关闭临时文件失败: %v", err)
-	}
-
-	return tmpFile.Name(), nil
-}
@@ -1,69 +1,23 @@
 package json2image
 
 import (
-	"os"
 	"testing"
 )
 
-func TestGetFontFile(t *testing.T) {
-	// 测试默认字体
-	config := DefaultConfig()
-	fontFile, err := getFontFile(config)
-	if err != nil {
-		t.Errorf("Expected no error, got: %v", err)
-	}
-	t.Logf("Font file: %s", fontFile)
-
-	// 清理临时文件
-	os.Remove(fontFile)
-}
-
-func TestGetFontFileWithDifferentTypes(t *testing.T) {
-	// 测试不同的字体类型
-	fontTypes := []FontType{
-		FontTypeMonaco,
-		FontTypeMsyh,
-		FontTypePingFang,
-	}
-
-	for _, fontType := range fontTypes {
-		config := DefaultConfig().WithFont(fontType)
-		fontFile, err := getFontFile(config)
-		if err != nil {
-			t.Errorf("Failed to load font type %v: %v", fontType, err)
-			continue
-		}
-		t.Logf("Font type %v loaded: %s", fontType, fontFile)
-
-		// 清理临时文件
-		os.Remove(fontFile)
-	}
-}
-
-func TestGetFontFileWithCustomFont(t *testing.T) {
-	// 测试自定义字体（使用不存在的路径）
-	config := DefaultConfig().WithCustomFont("/path/to/nonexistent/font.ttf")
-	_, err := getFontFile(config)
-	if err == nil {
-		t.Error("Expected error for nonexistent font file, got nil")
-	}
-	t.Logf("Expected error for nonexistent font: %v", err)
-}
-
 func TestDefaultConfig(t *testing.T) {
 	config := DefaultConfig()
 
 	// 验证默认配置
-	if config.Font.Type != FontTypeMsyh {
-		t.Errorf("Expected default font type to be FontTypeMsyh, got %v", config.Font.Type)
+	if config.Font.Type != FontTypeMonaco {
+		t.Errorf("Expected default font type to be FontTypeMonaco, got %v", config.Font.Type)
 	}
 
 	if config.Font.Size != 14 {
 		t.Errorf("Expected default font size to be 14, got %v", config.Font.Size)
 	}
 
-	if config.Font.LineHeight != 20 {
-		t.Errorf("Expected default line height to be 20, got %v", config.Font.LineHeight)
+	if config.Font.LineHeight != 0 || config.Font.LineHeightScale != 0 {
+		t.Errorf("Expected default line height to follow the font metrics, got %v and scale %v", config.Font.LineHeight, config.Font.LineHeightScale)
 	}
 
 	if config.Image.Padding != 20 {
@@ -101,3 +55,65 @@ func TestConfigChaining(t *testing.T) {
 		t.Errorf("Expected 2 crop rules, got %v", len(config.CropRules))
 	}
 }
+
+func TestFontSelectionOverrides(t *testing.T) {
+	// 测试字体类型与字体名称的覆盖关系
+	config := DefaultConfig().WithFontName("corporate")
+	if config.Font.Name != "corporate" {
+		t.Errorf("Expected font name to be corporate, got %q", config.Font.Name)
+	}
+
+	config.WithFont(FontTypeWrjs)
+	if config.Font.Name != "" || config.Font.Type != FontTypeWrjs {
+		t.Errorf("Expected WithFont to clear font name, got name=%q type=%v", config.Font.Name, config.Font.Type)
+	}
+
+	config.WithFontName("corporate").WithCustomFont("/path/to/font.ttf")
+	if config.Font.Name != "" || config.Font.Type != FontTypeCustom {
+		t.Errorf("Expected WithCustomFont to clear font name, got name=%q type=%v", config.Font.Name, config.Font.Type)
+	}
+}
+
+func TestTokenStyles(t *testing.T) {
+	// 测试按词法单元类别设置文本样式
+	config := DefaultConfig().
+		WithTokenStyle(TokenKey, TextStyleBold).
+		WithTokenStyle(TokenNull, TextStyleItalic).
+		WithFontVariants("corporate-bold", "", "")
+
+	if config.Style.forToken(TokenKey) != TextStyleBold {
+		t.Errorf("Expected bold keys, got %v", config.Style.Key)
+	}
+	if config.Style.forToken(TokenNull) != TextStyleItalic {
+		t.Errorf("Expected italic null, got %v", config.Style.Null)
+	}
+	if config.Style.forToken(TokenString) != TextStyleRegular {
+		t.Errorf("Expected regular strings by default, got %v", config.Style.String)
+	}
+	if config.Font.BoldName != "corporate-bold" || config.Font.ItalicName != "" {
+		t.Errorf("Unexpected font variants: %+v", config.Font)
+	}
+}
+
+func TestFormatIndent(t *testing.T) {
+	// 测试缩进配置
+	config := DefaultConfig()
+	if config.Format.indent() != "    " {
+		t.Errorf("Expected default indent of 4 spaces, got %q", config.Format.indent())
+	}
+
+	config.WithIndentWidth(2)
+	if config.Format.indent() != "  " {
+		t.Errorf("Expected indent of 2 spaces, got %q", config.Format.indent())
+	}
+
+	config.WithIndentWithTabs(true)
+	if config.Format.indent() != "\t" || config.Format.indentWidth() != 2 {
+		t.Errorf("Expected tab indent with width 2, got %q and %d", config.Format.indent(), config.Format.indentWidth())
+	}
+
+	// 未设置缩进宽度时使用默认值
+	if (FormatConfig{}).indent() != "    " {
+		t.Errorf("Expected zero value to use the default indent, got %q", (FormatConfig{}).indent())
+	}
+}
@@ -5,8 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
-	"os"
+	"math"
 	"strings"
 
 	"github.com/fogleman/gg"
@@ -14,107 +13,300 @@ import (
 
 // ColoredLine 带颜色信息的行
 type ColoredLine struct {
-	text      string
-	level     int
-	isKey     bool
-	startPos  int
-	keyLength int
-	hasBrace  bool   // 是否包含括号
-	bracePos  []int  // 括号的位置
-	braceType []rune // 括号的类型
+	text     string
+	level    int
+	segments []segment // 按词法单元拆分的片段
+	keyPad   float64   // keyPad 冒号对齐时键之后补齐的宽度
 }
 
-// measureText 测量文本尺寸
-func measureText(text string, config *Config) (float64, float64) {
-	lines := strings.Split(text, "\n")
-	maxWidth := 0.0
-	dc := gg.NewContext(1, 1)
+// segmentKind 行内片段的类别
+type segmentKind int
+
+const (
+	segmentSpace       segmentKind = iota // 空白
+	segmentKey                            // 对象的键
+	segmentBrace                          // 括号
+	segmentPunctuation                    // 冒号和逗号
+	segmentString                         // 字符串值
+	segmentNumber                         // 数字
+	segmentBoolean                        // true/false
+	segmentNull                           // null
+	segmentNote                           // 注释形式的标注及页脚等附加说明
+)
 
-	fontPath, err := getFontFile(config)
-	if err != nil {
-		log.Printf("警告: 加载字体失败: %v，使用备选字体\n", err)
-		// 使用备选字体（微软雅黑）
-		fallbackConfig := *config
-		fallbackConfig.Font.Type = FontTypeMsyh
-		fontPath, err = getFontFile(&fallbackConfig)
-		if err != nil {
-			log.Printf("警告: 加载备选字体失败: %v\n", err)
-			return 0, 0
-		}
+// segment 使用同一颜色和样式绘制的一段文本
+type segment struct {
+	text       string
+	kind       segmentKind
+	depth      int  // depth 键的嵌套深度，或括号所在括号对的嵌套深度
+	mismatched bool // mismatched 括号类型不匹配或没有对应的括号
+}
+
+// token 返回片段对应的词法单元类别
+func (s segment) token() TokenClass {
+	switch s.kind {
+	case segmentKey:
+		return TokenKey
+	case segmentString:
+		return TokenString
+	case segmentNumber:
+		return TokenNumber
+	case segmentBoolean:
+		return TokenBoolean
+	case segmentNull:
+		return TokenNull
 	}
+	return TokenPunctuation
+}
 
-	// 确保在函数结束时清理临时字体文件
-	defer func() {
-		// 只有当字体不是自定义字体时才删除临时文件
-		if config.Font.Type != FontTypeCustom {
-			if err := os.Remove(fontPath); err != nil {
-				log.Printf("警告: 清理临时字体文件失败: %v", err)
+// splitSegments 将格式化后的一行拆分为键、值、括号和标点片段
+func splitSegments(line string) []segment {
+	var segments []segment
+	for i := 0; i < len(line); {
+		start := i
+		kind := segmentSpace
+		switch c := line[i]; {
+		case c == ' ' || c == '\t':
+			for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+				i++
+			}
+		case c == '{' || c == '}' || c == '[' || c == ']':
+			kind = segmentBrace
+			i++
+		case c == ':' || c == ',':
+			kind = segmentPunctuation
+			i++
+		case strings.HasPrefix(line[i:], "/*"):
+			// 裁剪结果中的省略标记和下标标注
+			kind = segmentNote
+			if end := strings.Index(line[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(line)
+			}
+		case c == '"':
+			kind = segmentString
+			for i++; i < len(line) && line[i] != '"'; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+			// 原样模式下字符串可能以反斜杠结尾或缺少结束引号
+			if i < len(line) {
+				i++
+			} else {
+				i = len(line)
+			}
+			// 其后紧跟冒号的字符串为键
+			if rest := strings.TrimLeft(line[i:], " "); strings.HasPrefix(rest, ":") {
+				kind = segmentKey
+			}
+		default:
+			for i < len(line) && !strings.ContainsRune(" \t,:{}[]\"", rune(line[i])) {
+				i++
+			}
+			switch line[start:i] {
+			case "null":
+				kind = segmentNull
+			case "true", "false":
+				kind = segmentBoolean
+			default:
+				kind = segmentNumber
 			}
 		}
-	}()
+		segments = append(segments, segment{text: line[start:i], kind: kind})
+	}
+	return segments
+}
+
+// segmentColor 返回片段的颜色：键使用其深度的层级颜色，括号使用其括号对深度的括号颜色，其余使用默认文本颜色
+func segmentColor(seg segment, config *Config) [3]float64 {
+	switch seg.kind {
+	case segmentKey:
+		return config.Color.LevelColors[seg.depth%len(config.Color.LevelColors)]
+	case segmentBrace:
+		if seg.mismatched {
+			return config.Color.MismatchedBraceColor
+		}
+		return config.Color.BraceLevelColors[seg.depth%len(config.Color.BraceLevelColors)]
+	}
+	return config.Color.DefaultTextColor
+}
+
+// segmentChain 返回绘制片段使用的字体回退链
+func segmentChain(seg segment, family fontFamily, config *Config) fontChain {
+	if seg.kind == segmentSpace || seg.kind == segmentNote {
+		return family.chain(TextStyleRegular)
+	}
+	return family.chain(config.Style.forToken(seg.token()))
+}
+
+// lineMetrics 行的垂直度量（像素）
+type lineMetrics struct {
+	ascent  float64 // ascent 基线以上的高度
+	descent float64 // descent 基线以下的高度
+	lineGap float64 // lineGap 字体建议的行间距
+	height  float64 // height 行高
+}
+
+// newLineMetrics 取回退链中各字体的最大上伸和下伸高度，并按配置计算行高
+func newLineMetrics(chain fontChain, config *Config) lineMetrics {
+	var m lineMetrics
+	for _, f := range chain {
+		metrics := f.face.Metrics()
+		ascent := float64(metrics.Ascent) / 64
+		descent := float64(metrics.Descent) / 64
+		m.ascent = math.Max(m.ascent, ascent)
+		m.descent = math.Max(m.descent, descent)
+		m.lineGap = math.Max(m.lineGap, float64(metrics.Height)/64-ascent-descent)
+	}
+
+	switch {
+	case config.Font.LineHeightScale > 0:
+		m.height = config.Font.Size * config.Font.LineHeightScale
+	case config.Font.LineHeight > 0:
+		m.height = config.Font.LineHeight
+	default:
+		m.height = m.ascent + m.descent + m.lineGap
+	}
+	return m
+}
+
+// baseline 返回第 i 行的基线位置。行高与字体高度之差平均分配到文字上下，
+// 使首行上方与末行下方的留白相等
+func (m lineMetrics) baseline(i int, padding float64) float64 {
+	leading := m.height - m.ascent - m.descent
+	return padding + float64(i)*m.height + leading/2 + m.ascent
+}
 
-	if err := dc.LoadFontFace(fontPath, config.Font.Size); err != nil {
-		log.Printf("警告: 设置字体失败: %v", err)
-		return 0, 0
+// lineContent 返回行内容相对行首的缩进和需要绘制的片段。
+// 像素缩进模式下缩进为层级乘以 IndentPx，行首空白不绘制
+func lineContent(line ColoredLine, config *Config) (float64, []segment) {
+	if config.Format.IndentPx <= 0 {
+		return 0, line.segments
+	}
+	segments := line.segments
+	if len(segments) > 0 && segments[0].kind == segmentSpace {
+		segments = segments[1:]
 	}
+	return float64(line.level) * config.Format.IndentPx, segments
+}
+
+// measureText 测量文本尺寸
+func measureText(lines []ColoredLine, family fontFamily, metrics lineMetrics, config *Config) (float64, float64) {
+	maxWidth := 0.0
+	dc := gg.NewContext(1, 1)
 
 	for _, line := range lines {
-		w, _ := dc.MeasureString(line)
+		w, segments := lineContent(line, config)
+		for _, seg := range segments {
+			w += textWidth(dc, segmentChain(seg, family, config), seg.text)
+		}
+		w += line.keyPad
 		if w > maxWidth {
 			maxWidth = w
 		}
 	}
 
-	height := float64(len(lines)) * config.Font.LineHeight
+	height := float64(len(lines)) * metrics.height
 	return maxWidth + config.Image.Padding*2, height + config.Image.Padding*2
 }
 
-// parseJSONWithColor 解析JSON并添加颜色信息
-func parseJSONWithColor(text string) []ColoredLine {
+// textWidth 按字体回退链测量文本宽度
+func textWidth(dc *gg.Context, chain fontChain, text string) float64 {
+	width := 0.0
+	for _, run := range chain.runs(text) {
+		dc.SetFontFace(run.face)
+		w, _ := dc.MeasureString(run.text)
+		width += w
+	}
+	return width
+}
+
+// drawText 按字体回退链分段绘制文本，返回绘制宽度
+func drawText(dc *gg.Context, chain fontChain, text string, x, y float64) float64 {
+	width := 0.0
+	for _, run := range chain.runs(text) {
+		dc.SetFontFace(run.face)
+		dc.DrawString(run.text, x+width, y)
+		w, _ := dc.MeasureString(run.text)
+		width += w
+	}
+	return width
+}
+
+// braceRef 尚未闭合的开括号在行和片段中的位置
+type braceRef struct {
+	line, seg int
+}
+
+// closingBrace 返回开括号对应的闭合括号
+func closingBrace(open string) string {
+	if open == "{" {
+		return "}"
+	}
+	return "]"
+}
+
+// parseJSONWithColor 解析JSON并添加颜色信息。层级由括号的嵌套深度决定，
+// 不受缩进方式和字符串内容的影响；缩进中的制表符展开为 tabWidth 个空格。
+// 每个括号记录其括号对的深度，类型不匹配或无法配对的括号被标记
+func parseJSONWithColor(text string, tabWidth int) []ColoredLine {
 	lines := strings.Split(text, "\n")
 	coloredLines := make([]ColoredLine, len(lines))
+	tab := strings.Repeat(" ", tabWidth)
 
+	var stack []braceRef
 	for i, line := range lines {
-		level := (strings.Count(line, "    "))
-
-		// 查找括号位置，同时记录括号类型
-		bracePos := []int{}
-		braceType := []rune{}
-		for pos, char := range line {
-			if char == '{' || char == '}' || char == '[' || char == ']' {
-				bracePos = append(bracePos, pos)
-				braceType = append(braceType, char)
+		segments := splitSegments(line)
+		coloredLines[i] = ColoredLine{text: line, segments: segments}
+
+		// 以闭合括号开头的行与对应的开括号同层
+		level := len(stack)
+		for _, seg := range segments {
+			if seg.kind == segmentSpace {
+				continue
 			}
-		}
-
-		trimmed := strings.TrimSpace(line)
-
-		if strings.Contains(trimmed, ":") {
-			parts := strings.SplitN(trimmed, ":", 2)
-			keyLength := len(strings.Trim(parts[0], `" `))
-			startPos := strings.Index(line, `"`)
-
-			coloredLines[i] = ColoredLine{
-				text:      line,
-				level:     level,
-				isKey:     true,
-				startPos:  startPos,
-				keyLength: keyLength,
-				hasBrace:  len(bracePos) > 0,
-				bracePos:  bracePos,
-				braceType: braceType,
+			if seg.kind == segmentBrace && (seg.text == "}" || seg.text == "]") && level > 0 {
+				level--
 			}
-		} else {
-			coloredLines[i] = ColoredLine{
-				text:      line,
-				level:     level,
-				isKey:     false,
-				hasBrace:  len(bracePos) > 0,
-				bracePos:  bracePos,
-				braceType: braceType,
+			break
+		}
+		coloredLines[i].level = level
+
+		for j, seg := range segments {
+			switch seg.kind {
+			case segmentSpace:
+				segments[j].text = strings.ReplaceAll(seg.text, "\t", tab)
+			case segmentKey:
+				// 单行形式的对象中的键同样按其所在的深度着色
+				segments[j].depth = len(stack)
+			case segmentBrace:
+				if seg.text == "{" || seg.text == "[" {
+					segments[j].depth = len(stack)
+					stack = append(stack, braceRef{line: i, seg: j})
+					continue
+				}
+				if len(stack) == 0 {
+					segments[j].mismatched = true
+					continue
+				}
+				open := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				segments[j].depth = len(stack)
+				opener := &coloredLines[open.line].segments[open.seg]
+				if closingBrace(opener.text) != seg.text {
+					opener.mismatched = true
+					segments[j].mismatched = true
+				}
 			}
 		}
 	}
+
+	// 未闭合的开括号
+	for _, open := range stack {
+		coloredLines[open.line].segments[open.seg].mismatched = true
+	}
 	return coloredLines
 }
 
@@ -127,150 +319,82 @@ func Json2Image(jsonData string, config *Config, outputPath ...string) (string,
 	if config == nil {
 		config = DefaultConfig()
 	}
+	return renderJSON(jsonData, config, nil, outputPath...)
+}
 
-	// 格式化 JSON
-	formattedJSON, err := formatJSON(jsonData)
+// renderJSON 格式化并绘制JSON，原样模式下直接绘制输入文本
+func renderJSON(jsonData string, config *Config, footer []string, outputPath ...string) (string, error) {
+	if config.Format.Raw {
+		text := strings.ReplaceAll(strings.TrimRight(jsonData, "\r\n"), "\r\n", "\n")
+		return renderText(text, config, footer, outputPath...)
+	}
+	formattedJSON, err := formatJSONWith(jsonData, config.Format)
 	if err != nil {
 		return "", fmt.Errorf("格式化 JSON 失败: %v", err)
 	}
+	return renderText(formattedJSON, config, footer, outputPath...)
+}
 
+// renderText 绘制格式化后的JSON文本，footer 非空时在内容下方空一行后逐行绘制
+func renderText(formattedJSON string, config *Config, footer []string, outputPath ...string) (string, error) {
 	// 解析带颜色信息的行
-	coloredLines := parseJSONWithColor(formattedJSON)
+	coloredLines := parseJSONWithColor(formattedJSON, config.Format.indentWidth())
 
-	// 计算图片尺寸
-	width, height := measureText(formattedJSON, config)
+	// 加载各样式的字体
+	family, err := loadFontFamily(config)
+	if err != nil {
+		return "", err
+	}
 
-	// 创建画布
-	dc := gg.NewContext(int(width), int(height))
+	// 对齐冒号
+	if config.Format.AlignColons {
+		alignColons(coloredLines, family, config)
+	}
 
-	// 加载字体
-	fontPath, err := getFontFile(config)
-	if err != nil {
-		log.Printf("警告: 加载字体失败: %v，使用备选字体\n", err)
-		// 使用备选字体（微软雅黑）
-		fallbackConfig := *config
-		fallbackConfig.Font.Type = FontTypeMsyh
-		fontPath, err = getFontFile(&fallbackConfig)
-		if err != nil {
-			return "", fmt.Errorf("加载备选字体失败: %v", err)
+	// 追加页脚
+	if len(footer) > 0 {
+		coloredLines = append(coloredLines, ColoredLine{})
+		for _, text := range footer {
+			coloredLines = append(coloredLines, ColoredLine{text: text, segments: []segment{{text: text, kind: segmentNote}}})
 		}
 	}
 
-	// 确保在函数结束时清理临时字体文件
-	defer func() {
-		// 只有当字体不是自定义字体时才删除临时文件
-		if config.Font.Type != FontTypeCustom {
-			if err := os.Remove(fontPath); err != nil {
-				log.Printf("警告: 清理临时字体文件失败: %v", err)
-			}
-		}
-	}()
+	// 计算图片尺寸
+	metrics := newLineMetrics(family.chain(TextStyleRegular), config)
+	width, height := measureText(coloredLines, family, metrics, config)
 
-	if err := dc.LoadFontFace(fontPath, config.Font.Size); err != nil {
-		return "", fmt.Errorf("设置字体失败: %v", err)
-	}
+	// 创建画布
+	dc := gg.NewContext(int(width), int(height))
 
 	// 设置背景色
 	dc.SetRGB(config.Image.BackgroundColor[0], config.Image.BackgroundColor[1], config.Image.BackgroundColor[2])
 	dc.Clear()
 
-	// 绘制文本
-	y := config.Image.Padding
-	for _, line := range coloredLines {
-		currentX := config.Image.Padding
-
-		if line.isKey {
-			colorIdx := line.level % len(config.Color.LevelColors)
-			color := config.Color.LevelColors[colorIdx]
-			braceColor := config.Color.BraceLevelColors[colorIdx]
-
-			if line.hasBrace {
-				// 绘制前导空格
-				if line.startPos > 0 {
-					text := line.text[:line.startPos]
-					dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-					dc.DrawString(text, currentX, y)
-					width, _ := dc.MeasureString(text)
-					currentX += width
-				}
-
-				// 绘制字段名（使用正常颜色）
-				dc.SetRGB(color[0], color[1], color[2])
-				keyText := line.text[line.startPos : line.startPos+line.keyLength+2]
-				dc.DrawString(keyText, currentX, y)
-				width, _ := dc.MeasureString(keyText)
-				currentX += width
-
-				// 绘制冒号和空格
-				colonPos := line.startPos + line.keyLength + 2
-				text := line.text[colonPos:line.bracePos[0]]
-				dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-				dc.DrawString(text, currentX, y)
-				width, _ = dc.MeasureString(text)
-				currentX += width
-
-				// 绘制括号（使用浅色）
-				dc.SetRGB(braceColor[0], braceColor[1], braceColor[2])
-				braceText := string(line.braceType[0])
-				dc.DrawString(braceText, currentX, y)
-			} else {
-				// 原有的键值绘制逻辑保持不变
-				if line.startPos > 0 {
-					text := line.text[:line.startPos]
-					dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-					dc.DrawString(text, currentX, y)
-					width, _ := dc.MeasureString(text)
-					currentX += width
-				}
-
-				dc.SetRGB(color[0], color[1], color[2])
-				keyText := line.text[line.startPos : line.startPos+line.keyLength+2]
-				dc.DrawString(keyText, currentX, y)
-				width, _ := dc.MeasureString(keyText)
-				currentX += width
+	// 绘制缩进参考线，位于文本下方
+	if config.Image.IndentGuides {
+		drawIndentGuides(dc, coloredLines, family, metrics, config)
+	}
 
-				if line.startPos+line.keyLength+2 < len(line.text) {
-					remainingText := line.text[line.startPos+line.keyLength+2:]
-					dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-					dc.DrawString(remainingText, currentX, y)
-				}
+	// 绘制文本
+	for i, line := range coloredLines {
+		indent, segments := lineContent(line, config)
+		x := config.Image.Padding + indent
+		y := metrics.baseline(i, config.Image.Padding)
+		for _, seg := range segments {
+			color := segmentColor(seg, config)
+			dc.SetRGB(color[0], color[1], color[2])
+			width := drawText(dc, segmentChain(seg, family, config), seg.text, x, y)
+			if seg.mismatched {
+				// 不匹配的括号加下划线标记
+				dc.SetLineWidth(1)
+				dc.DrawLine(x, y+metrics.descent/2, x+width, y+metrics.descent/2)
+				dc.Stroke()
 			}
-		} else {
-			// 处理非键值行（可能包含括号）
-			if line.hasBrace {
-				lastPos := 0
-				for i, pos := range line.bracePos {
-					// 绘制括号前的文本
-					if pos > lastPos {
-						dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-						text := line.text[lastPos:pos]
-						dc.DrawString(text, currentX, y)
-						width, _ := dc.MeasureString(text)
-						currentX += width
-					}
-
-					// 使用浅色绘制括号
-					colorIdx := line.level % len(config.Color.BraceLevelColors)
-					braceColor := config.Color.BraceLevelColors[colorIdx]
-					dc.SetRGB(braceColor[0], braceColor[1], braceColor[2])
-					braceText := string(line.braceType[i])
-					dc.DrawString(braceText, currentX, y)
-					width, _ := dc.MeasureString(braceText)
-					currentX += width
-					lastPos = pos + 1
-				}
-
-				// 绘制最后剩余的文本
-				if lastPos < len(line.text) {
-					dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-					dc.DrawString(line.text[lastPos:], currentX, y)
-				}
-			} else {
-				dc.SetRGB(config.Color.DefaultTextColor[0], config.Color.DefaultTextColor[1], config.Color.DefaultTextColor[2])
-				dc.DrawString(line.text, currentX, y)
+			x += width
+			if seg.kind == segmentKey {
+				x += line.keyPad
 			}
 		}
-		y += config.Font.LineHeight
 	}
 
 	if len(outputPath) > 0 {
@@ -292,7 +416,15 @@ func CropJson2Image(jsonData string, config *Config, outputPath ...string) (stri
 		config = DefaultConfig()
 	}
 
-	var inputData map[string]interface{}
+	if len(config.CropRules) == 0 {
+		return "", fmt.Errorf("裁剪规则不能为空")
+	}
+	program, err := CompileRules(config.CropRules)
+	if err != nil {
+		return "", err
+	}
+
+	var inputData interface{}
 	if str, err := formatJSON(jsonData); err != nil {
 		return "", fmt.Errorf("格式化JSON失败: %v", err)
 	} else {
@@ -301,16 +433,18 @@ func CropJson2Image(jsonData string, config *Config, outputPath ...string) (stri
 		}
 	}
 
-	if len(config.CropRules) == 0 {
-		return "", fmt.Errorf("裁剪规则不能为空")
-	}
-
-	output, err := JsonCrop(inputData, config.CropRules)
+	// 按数组重建方式整理裁剪结果，省略标记和下标标注以注释形式绘制
+	output, report := program.crop(inputData)
+	formatted, err := formatValue(arrangeArrays(output, inputData, config.CropArrayMode, true), config.Format)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("格式化 JSON 失败: %v", err)
 	}
 
-	return Json2Image(string(output), config, outputPath...)
+	var footer []string
+	if config.UnmatchedRulesFooter {
+		footer = report.footer()
+	}
+	return renderText(formatted, config, footer, outputPath...)
 }
 
 // 以下是为了向后兼容而保留的函数，它们使用默认配置
@@ -1,7 +1,9 @@
 package json2image
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -43,7 +45,7 @@ func TestJson2ImageWithCustomConfig(t *testing.T) {
 
 	// 创建自定义配置
 	config := DefaultConfig().
-		WithFont(FontTypePingFang).
+		WithFont(FontTypeWrjs).
 		WithFontSize(16).
 		WithLineHeight(24).
 		WithPadding(30).
@@ -163,6 +165,18 @@ func TestJson2ImageBackwardCompatibility(t *testing.T) {
 	fmt.Println("向后兼容测试成功")
 }
 
+func TestCropJson2ImageInvalidRule(t *testing.T) {
+	config := DefaultConfig().WithCropRules("data.users[0", "data.total")
+	_, err := CropJson2Image(`{"data": {"users": [], "total": 0}}`, config)
+	var ruleErr *RuleError
+	if !errors.As(err, &ruleErr) {
+		t.Fatalf("无效的裁剪规则应返回 *RuleError, 实际为 %v", err)
+	}
+	if ruleErr.Index != 0 || ruleErr.Pos != 12 {
+		t.Errorf("错误为 %+v, 期望第 0 条规则第 13 个字符", ruleErr)
+	}
+}
+
 func TestCropJson2ImageBackwardCompatibility(t *testing.T) {
 	// 测试向后兼容的裁剪函数
 	inputData := `{
@@ -201,6 +215,7 @@ func TestJson2ImageWithDifferentFonts(t *testing.T) {
 		FontTypeMonaco,
 		FontTypeMsyh,
 		FontTypePingFang,
+		FontTypeWrjs,
 	}
 
 	fontNames := []string{
@@ -232,3 +247,331 @@ func TestJson2ImageWithDifferentFonts(t *testing.T) {
 		t.Logf("成功加载了 %d/%d 个字体", successCount, len(fontTypes))
 	}
 }
+
+func TestJson2ImageWithFallbackFonts(t *testing.T) {
+	// 测试Monaco主字体 + 中文回退字体
+	jsonData := `{
+		"name": "测试用户",
+		"city": "Shanghai 上海"
+	}`
+
+	config := DefaultConfig().
+		WithFont(FontTypeMonaco).
+		WithFallbackFonts("wrjs")
+
+	_, err := Json2Image(jsonData, config, "output/output_font_fallback.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("回退字体图片生成成功：output_font_fallback.png")
+}
+
+func TestSplitSegments(t *testing.T) {
+	line := `    "key": "a: {b}", "n": -1.5e3, "t": true, "z": null, [{}]`
+	expected := []segment{
+		{text: "    ", kind: segmentSpace},
+		{text: `"key"`, kind: segmentKey},
+		{text: ":", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: `"a: {b}"`, kind: segmentString},
+		{text: ",", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: `"n"`, kind: segmentKey},
+		{text: ":", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: "-1.5e3", kind: segmentNumber},
+		{text: ",", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: `"t"`, kind: segmentKey},
+		{text: ":", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: "true", kind: segmentBoolean},
+		{text: ",", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: `"z"`, kind: segmentKey},
+		{text: ":", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: "null", kind: segmentNull},
+		{text: ",", kind: segmentPunctuation},
+		{text: " ", kind: segmentSpace},
+		{text: "[", kind: segmentBrace},
+		{text: "{", kind: segmentBrace},
+		{text: "}", kind: segmentBrace},
+		{text: "]", kind: segmentBrace},
+	}
+
+	segments := splitSegments(line)
+	if len(segments) != len(expected) {
+		t.Fatalf("Expected %d segments, got %d: %v", len(expected), len(segments), segments)
+	}
+	for i := range expected {
+		if segments[i] != expected[i] {
+			t.Errorf("Segment %d: expected %v, got %v", i, expected[i], segments[i])
+		}
+	}
+
+	// 含转义引号的字符串
+	segments = splitSegments(`"say \"hi\"": 1`)
+	if segments[0].text != `"say \"hi\""` || segments[0].kind != segmentKey {
+		t.Errorf("Expected escaped key segment, got %v", segments[0])
+	}
+
+	// 注释形式的标注，其中的括号不参与配对
+	segments = splitSegments(`    /* [5] */ {`)
+	if len(segments) != 4 || segments[1] != (segment{text: "/* [5] */", kind: segmentNote}) || segments[3].kind != segmentBrace {
+		t.Errorf("Expected note segment, got %v", segments)
+	}
+}
+
+func TestJson2ImageWithTokenStyles(t *testing.T) {
+	// 测试粗体的键和斜体的 null
+	jsonData := `{
+		"name": "John",
+		"age": 30,
+		"nickname": null,
+		"tags": ["a", true]
+	}`
+
+	config := DefaultConfig().
+		WithTokenStyle(TokenKey, TextStyleBold).
+		WithTokenStyle(TokenNull, TextStyleItalic).
+		WithTokenStyle(TokenBoolean, TextStyleBoldItalic)
+
+	_, err := Json2Image(jsonData, config, "output/output_styles.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("文本样式图片生成成功：output_styles.png")
+}
+
+func TestLineMetrics(t *testing.T) {
+	chain, err := loadFontChain(DefaultConfig())
+	if err != nil {
+		t.Fatalf("loadFontChain failed: %v", err)
+	}
+
+	// 行高为字体大小的倍数
+	config := DefaultConfig().WithFontSize(30).WithLineHeightScale(1.4)
+	metrics := newLineMetrics(chain, config)
+	if metrics.ascent <= 0 || metrics.descent <= 0 {
+		t.Fatalf("Expected positive ascent and descent, got %+v", metrics)
+	}
+	if math.Abs(metrics.height-42) > 1e-9 {
+		t.Errorf("Expected line height 42, got %v", metrics.height)
+	}
+
+	// 首行上方与末行下方的留白相等
+	lines := 3
+	height := float64(lines)*metrics.height + config.Image.Padding*2
+	top := metrics.baseline(0, config.Image.Padding) - metrics.ascent
+	bottom := height - (metrics.baseline(lines-1, config.Image.Padding) + metrics.descent)
+	if math.Abs(top-bottom) > 1e-9 {
+		t.Errorf("Expected equal top and bottom space, got %v and %v", top, bottom)
+	}
+	if top < config.Image.Padding {
+		t.Errorf("Expected ascenders inside the padding, got top space %v", top)
+	}
+
+	// 默认配置未设置行高，使用字体自身的行高
+	config = DefaultConfig()
+	metrics = newLineMetrics(chain, config)
+	if expected := metrics.ascent + metrics.descent + metrics.lineGap; metrics.height != expected {
+		t.Errorf("Expected natural line height %v, got %v", expected, metrics.height)
+	}
+
+	// 默认行高随字体大小变化，增大字号后各行不重叠
+	large := DefaultConfig().WithFontSize(30)
+	largeChain, err := loadFontChain(large)
+	if err != nil {
+		t.Fatalf("loadFontChain failed: %v", err)
+	}
+	largeMetrics := newLineMetrics(largeChain, large)
+	if largeMetrics.height < largeMetrics.ascent+largeMetrics.descent {
+		t.Errorf("Expected line height at least %v for size 30, got %v", largeMetrics.ascent+largeMetrics.descent, largeMetrics.height)
+	}
+	if ratio := largeMetrics.height / metrics.height; math.Abs(ratio-30.0/14) > 0.1 {
+		t.Errorf("Expected line height to scale with font size, got ratio %v", ratio)
+	}
+
+	// 像素行高会清除倍数设置
+	config = DefaultConfig().WithLineHeightScale(1.4).WithLineHeight(24)
+	if metrics = newLineMetrics(chain, config); metrics.height != 24 {
+		t.Errorf("Expected line height 24, got %v", metrics.height)
+	}
+}
+
+func TestParseJSONWithColorLevels(t *testing.T) {
+	// 层级由结构决定，与缩进方式和字符串内容无关
+	jsonData := `{"a": {"text": "with    spaces        inside", "list": [1, {"b": []}]}}`
+	expected := []int{0, 1, 2, 3, 3, 4, 3, 2, 2, 1, 0}
+
+	for _, format := range []FormatConfig{
+		{IndentWidth: 4},
+		{IndentWidth: 2},
+		{IndentWithTabs: true},
+	} {
+		formatted, err := formatJSONWith(jsonData, format)
+		if err != nil {
+			t.Fatalf("formatJSONWith failed: %v", err)
+		}
+		lines := parseJSONWithColor(formatted, format.indentWidth())
+		if len(lines) != len(expected) {
+			t.Fatalf("Expected %d lines, got %d:\n%s", len(expected), len(lines), formatted)
+		}
+		for i, line := range lines {
+			if line.level != expected[i] {
+				t.Errorf("%+v line %d %q: expected level %d, got %d", format, i, line.text, expected[i], line.level)
+			}
+		}
+	}
+
+	// 制表符缩进展开为空格绘制
+	formatted, _ := formatJSONWith(`{"a": 1}`, FormatConfig{IndentWithTabs: true})
+	lines := parseJSONWithColor(formatted, 2)
+	if lines[1].segments[0].text != "  " {
+		t.Errorf("Expected tab expanded to 2 spaces, got %q", lines[1].segments[0].text)
+	}
+}
+
+func TestJson2ImageWithIndent(t *testing.T) {
+	// 测试两个空格缩进和制表符缩进
+	jsonData := `{"user": {"name": "John", "tags": ["a", "b"]}}`
+
+	config := DefaultConfig().WithIndentWidth(2)
+	if _, err := Json2Image(jsonData, config); err != nil {
+		t.Errorf("两个空格缩进生成图片失败: %v", err)
+	}
+
+	config = DefaultConfig().WithIndentWithTabs(true)
+	if _, err := Json2Image(jsonData, config); err != nil {
+		t.Errorf("制表符缩进生成图片失败: %v", err)
+	}
+}
+
+func TestLineContentIndentPx(t *testing.T) {
+	formatted, err := formatJSON(`{"a": {"b": [1]}}`)
+	if err != nil {
+		t.Fatalf("formatJSON failed: %v", err)
+	}
+	lines := parseJSONWithColor(formatted, defaultIndentWidth)
+	config := DefaultConfig().WithIndentPx(18)
+
+	// 闭合括号所在行与开括号所在行的缩进相同，且不绘制行首空白
+	opener, closer := lines[1], lines[len(lines)-2]
+	openerIndent, openerSegments := lineContent(opener, config)
+	closerIndent, closerSegments := lineContent(closer, config)
+	if openerIndent != 18 || closerIndent != openerIndent {
+		t.Errorf("Expected opener and closer indent 18, got %v and %v", openerIndent, closerIndent)
+	}
+	if openerSegments[0].kind == segmentSpace || closerSegments[0].kind == segmentSpace {
+		t.Error("Expected leading whitespace to be skipped")
+	}
+	if indent, _ := lineContent(lines[3], config); indent != 54 {
+		t.Errorf("Expected level 3 indent 54, got %v", indent)
+	}
+
+	// 未设置像素缩进时保留空白
+	if indent, segments := lineContent(opener, DefaultConfig()); indent != 0 || segments[0].kind != segmentSpace {
+		t.Errorf("Expected whitespace indentation by default, got %v %v", indent, segments)
+	}
+}
+
+func TestJson2ImageWithIndentPx(t *testing.T) {
+	// 测试比例字体的像素缩进
+	jsonData := `{
+		"name": "测试用户",
+		"profile": {"city": "上海", "tags": ["a", "b"]}
+	}`
+
+	config := DefaultConfig().
+		WithFont(FontTypeWrjs).
+		WithIndentPx(24)
+
+	_, err := Json2Image(jsonData, config, "output/output_indent_px.png")
+	if err != nil {
+		t.Errorf("生成图片失败: %v\n", err)
+		return
+	}
+	fmt.Println("像素缩进图片生成成功：output_indent_px.png")
+}
+
+func TestParseJSONWithColorBracketPairs(t *testing.T) {
+	// 同一行内的括号对及其后的逗号都会保留，括号使用括号对的深度
+	formatted, err := formatJSON(`{"items": [], "meta": {"tags": {}}}`)
+	if err != nil {
+		t.Fatalf("formatJSON failed: %v", err)
+	}
+	lines := parseJSONWithColor(formatted, defaultIndentWidth)
+
+	braces := func(line ColoredLine) string {
+		var text string
+		for _, seg := range line.segments {
+			switch seg.kind {
+			case segmentPunctuation:
+				text += seg.text
+			case segmentBrace:
+				text += fmt.Sprintf("%s%d", seg.text, seg.depth)
+				if seg.mismatched {
+					text += "!"
+				}
+			}
+		}
+		return text
+	}
+	expected := []string{"{0", ":[1]1,", ":{1", ":{2}2", "}1", "}0"}
+	for i, line := range lines {
+		if got := braces(line); got != expected[i] {
+			t.Errorf("Line %d %q: expected %s, got %s", i, line.text, expected[i], got)
+		}
+	}
+
+	// 类型不匹配、多余和未闭合的括号被标记
+	lines = parseJSONWithColor("{\n    [1, 2}\n]\n}\n[", defaultIndentWidth)
+	expected = []string{"{0!", "[1!,}1!", "]0!", "}0!", "[0!"}
+	for i, line := range lines {
+		if got := braces(line); got != expected[i] {
+			t.Errorf("Line %d %q: expected %s, got %s", i, line.text, expected[i], got)
+		}
+	}
+}
+
+func TestJson2ImageRawInput(t *testing.T) {
+	// 原样模式下无效的JSON同样可以绘制，不匹配的括号使用 MismatchedBraceColor
+	jsonData := "{\r\n  \"items\": [1, 2},\r\n  \"path\": \"C:\\\r\n  \"meta\": {\"ok\": true}\r\n"
+	if _, err := Json2Image(jsonData, DefaultConfig()); err == nil {
+		t.Fatal("Expected error for invalid JSON without raw mode")
+	}
+
+	config := DefaultConfig().WithRawInput(true)
+	if _, err := Json2Image(jsonData, config, "output/output_raw.png"); err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	red, err := Json2Image(jsonData, config)
+	if err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	blue, err := Json2Image(jsonData, DefaultConfig().WithRawInput(true).WithMismatchedBraceColor(0, 0, 1))
+	if err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	if red == "" || red == blue {
+		t.Error("Expected MismatchedBraceColor to change the rendered image")
+	}
+
+	// 已配对的括号不受影响
+	valid := `{"a": [1, 2]}`
+	plain, err := Json2Image(valid, DefaultConfig().WithRawInput(true))
+	if err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	recolored, err := Json2Image(valid, DefaultConfig().WithRawInput(true).WithMismatchedBraceColor(0, 0, 1))
+	if err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	if plain != recolored {
+		t.Error("Expected balanced brackets to ignore MismatchedBraceColor")
+	}
+	fmt.Println("原样模式图片生成成功：output/output_raw.png")
+}
@@ -2,211 +2,663 @@ package json2image
 
 import (
 	"encoding/json"
-	"strconv"
+	"errors"
+	"fmt"
 	"strings"
 )
 
+// JsonCrop 按规则裁剪JSON，只保留规则选中的节点及其所在的结构。
+// 以 $ 开头的规则按 RFC 9535 JSONPath 解析，以 / 开头的规则按 RFC 6901 JSON Pointer 解析，其余按点号路径语法解析。
+// 以 ! 开头的规则为排除规则，从裁剪结果中移除选中的节点；只有排除规则时从完整文档中移除
 func JsonCrop(input interface{}, rules []string) ([]byte, error) {
-	output := make(map[string]interface{})
-	for _, rule := range rules {
-		steps := parseRule(rule)
-		processStep(input, steps, output, nil)
+	program, err := CompileRules(rules)
+	if err != nil {
+		return nil, err
 	}
+	return program.Crop(input)
+}
+
+// JsonCropWithReport 与 JsonCrop 相同，同时返回每条规则选中的节点数、具体路径及未选中任何节点的原因
+func JsonCropWithReport(input interface{}, rules []string) ([]byte, *CropReport, error) {
+	program, err := CompileRules(rules)
+	if err != nil {
+		return nil, nil, err
+	}
+	return program.CropWithReport(input)
+}
+
+// ArrayMode 裁剪结果中数组的重建方式
+type ArrayMode int
+
+const (
+	ArrayModePadded  ArrayMode = iota // 保留原始下标，选中元素之前的空位以空对象填充
+	ArrayModeCompact                  // 只保留选中的元素，按原顺序排列
+	ArrayModeSparse                   // 同 ArrayModeCompact，图片中以 /* … N skipped */ 标注省略的元素个数
+	ArrayModeIndexed                  // 同 ArrayModeCompact，图片中以 /* [N] */ 标注每个元素的原始下标
+)
+
+// CropProgram 编译后的裁剪规则，可重复用于多个文档
+type CropProgram struct {
+	rules     []cropRule
+	arrayMode ArrayMode
+}
+
+// WithArrayMode 设置裁剪结果中数组的重建方式
+func (prog *CropProgram) WithArrayMode(mode ArrayMode) *CropProgram {
+	prog.arrayMode = mode
+	return prog
+}
+
+// cropRule 编译后的单条规则
+type cropRule struct {
+	text    string      // text 规则原文
+	exclude bool        // exclude 是否为排除规则
+	path    *jsonPath   // path 非空时为 JSONPath 规则
+	pointer jsonPointer // pointer 非空时为 JSON Pointer 规则
+	steps   []PathStep  // steps 点号规则的各步
+}
+
+// RuleError 裁剪规则的语法错误
+type RuleError struct {
+	Rule  string // Rule 出错的规则
+	Index int    // Index 规则在规则列表中的下标
+	Pos   int    // Pos 出错位置在规则中的字节偏移
+	Msg   string // Msg 错误描述
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("无效的裁剪规则 %q: 第 %d 个字符: %s", e.Rule, e.Pos+1, e.Msg)
+}
+
+// CompileRules 编译裁剪规则，规则有语法错误时返回第一个错误的 *RuleError
+func CompileRules(rules []string) (*CropProgram, error) {
+	program := &CropProgram{}
+	for i, rule := range rules {
+		text, exclude := rule, strings.HasPrefix(rule, "!")
+		if exclude {
+			text = rule[1:]
+		}
+
+		compiled, err := compileRule(text)
+		if err != nil {
+			ruleErr := &RuleError{Rule: rule, Index: i, Msg: err.Error()}
+			var syntaxErr *pathSyntaxError
+			if errors.As(err, &syntaxErr) {
+				ruleErr.Pos, ruleErr.Msg = syntaxErr.pos, syntaxErr.msg
+			}
+			if exclude {
+				ruleErr.Pos++ // 错误位置相对于包含 ! 的完整规则
+			}
+			return nil, ruleErr
+		}
+		compiled.text, compiled.exclude = rule, exclude
+		program.rules = append(program.rules, compiled)
+	}
+	return program, nil
+}
+
+// compileRule 编译不含 ! 前缀的规则
+func compileRule(rule string) (cropRule, error) {
+	if strings.HasPrefix(rule, "$") {
+		path, err := parseJSONPath(rule)
+		return cropRule{path: path}, err
+	}
+	if strings.HasPrefix(rule, "/") {
+		pointer, err := parseJSONPointer(rule)
+		return cropRule{pointer: pointer}, err
+	}
+	steps, err := parseRule(rule)
+	return cropRule{steps: steps}, err
+}
+
+// selectNodes 对 input 中规则选中的每个节点调用 emit
+func (r cropRule) selectNodes(input interface{}, emit func(path []pathElem, value interface{})) {
+	if r.path != nil {
+		for _, node := range r.path.eval(input) {
+			emit(node.path, node.value)
+		}
+		return
+	}
+	if r.pointer != nil {
+		if node, ok := r.pointer.eval(input); ok {
+			emit(node.path, node.value)
+		}
+		return
+	}
+	processStep(input, input, r.steps, emit, nil)
+}
+
+// Crop 按编译后的规则裁剪 input
+func (prog *CropProgram) Crop(input interface{}) ([]byte, error) {
+	output, _, err := prog.CropWithReport(input)
+	return output, err
+}
 
-	return json.Marshal(output)
+// CropWithReport 按编译后的规则裁剪 input，并返回每条规则的匹配情况
+func (prog *CropProgram) CropWithReport(input interface{}) ([]byte, *CropReport, error) {
+	output, report := prog.crop(input)
+	data, err := json.Marshal(arrangeArrays(output, input, prog.arrayMode, false))
+	return data, report, err
+}
+
+// crop 执行规则，返回保留数组空位和排除标记的裁剪结果，由 arrangeArrays 按数组重建方式整理。
+// 结果的根节点类型由选中节点的路径推断，没有选中任何节点时与输入的根节点类型相同
+func (prog *CropProgram) crop(input interface{}) (interface{}, *CropReport) {
+	report := &CropReport{Rules: make([]RuleReport, len(prog.rules))}
+	hasIncludes, hasExcludes := false, false
+	for i, rule := range prog.rules {
+		report.Rules[i] = RuleReport{Rule: rule.text, Exclude: rule.exclude}
+		hasIncludes = hasIncludes || !rule.exclude
+		hasExcludes = hasExcludes || rule.exclude
+	}
+
+	var output interface{}
+	if !hasIncludes && hasExcludes {
+		output = insertValue(output, nil, input)
+	}
+	for i, rule := range prog.rules {
+		if rule.exclude {
+			continue
+		}
+		rule.selectNodes(input, func(path []pathElem, value interface{}) {
+			report.Rules[i].record(path)
+			output = insertValue(output, path, value)
+		})
+	}
+
+	if hasExcludes {
+		// 输出中的节点与输入共用，复制后再移除，避免修改输入
+		output = cloneJSON(output)
+		for i, rule := range prog.rules {
+			if !rule.exclude {
+				continue
+			}
+			rule.selectNodes(input, func(path []pathElem, _ interface{}) {
+				report.Rules[i].record(path)
+				output = markExcluded(output, path)
+			})
+		}
+	}
+
+	for i, rule := range prog.rules {
+		if report.Rules[i].Matches == 0 {
+			report.Rules[i].Miss = rule.diagnose(input)
+		}
+	}
+	if output == nil {
+		output = emptyLike(input)
+	}
+	return output, report
 }
 
 type PathStep struct {
-	Key     string
-	Indices []int // 将单个 Index 改为 Indices 数组
+	Key     string         // Key 为空时步骤直接作用于当前数组，如根为数组时的 [*].id
+	Literal bool           // Literal 为真时 Key 是引号包围或含转义字符的字段名，按原样匹配，* 和空字符串不作特殊处理
+	Indices []int          // 将单个 Index 改为 Indices 数组
+	filter  filterExpr     // filter 非空时按过滤表达式选择数组元素，如 users[?(@.age > 25)]
+	slice   *sliceSelector // slice 非空时按切片选择数组元素，如 items[0:3]
+}
+
+// isWildcard 报告步骤是否为选择全部成员的 *
+func (s PathStep) isWildcard() bool {
+	return s.Key == "*" && !s.Literal
+}
+
+// isArrayStep 报告步骤是否省略了字段名，直接选择当前数组的元素
+func (s PathStep) isArrayStep() bool {
+	return s.Key == "" && !s.Literal
+}
+
+// at 返回选中该步骤数组中第 index 个元素的步骤
+func (s PathStep) at(index int) PathStep {
+	return PathStep{Key: s.Key, Literal: s.Literal, Indices: []int{index}}
 }
 
-func parseRule(rule string) []PathStep {
-	parts := strings.Split(rule, ".")
+// parseRule 解析点号规则：以 . 分隔的各步为字段名（* 表示全部成员），
+// 其后可跟 [*]、[n]、[n,m]、[start:end:step] 或 [?过滤表达式]。
+// 省略字段名的步骤直接选择当前数组的元素，如根为数组时的 [*].id、[0:3]。
+// 包含特殊字符的字段名可写作 ["k8s.io/name"] 或用反斜杠转义，如 k8s\.io/name
+func parseRule(rule string) ([]PathStep, error) {
+	p := &pathParser{rule: rule}
 	var steps []PathStep
+	for {
+		step, err := p.parseRuleStep()
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+		if p.eof() {
+			return steps, nil
+		}
+		if !p.consume(".") && !p.quotedKeyAhead() {
+			return nil, p.errorf("应为 .，实际为 %q", p.peek())
+		}
+	}
+}
 
-	for _, part := range parts {
-		if idxStart := strings.Index(part, "["); idxStart != -1 && strings.HasSuffix(part, "]") {
-			key := part[:idxStart]
-			indexStr := part[idxStart+1 : len(part)-1]
+// parseRuleStep 解析点号规则中的一步
+func (p *pathParser) parseRuleStep() (PathStep, error) {
+	var step PathStep
+	var err error
+	if p.quotedKeyAhead() {
+		step.Key, err = p.parseQuotedKey()
+		step.Literal = true
+	} else {
+		step.Key, step.Literal, err = p.parseBareKey()
+	}
+	if err != nil {
+		return step, err
+	}
+	if p.quotedKeyAhead() || !p.consume("[") {
+		if step.isArrayStep() {
+			return step, p.errorf("缺少字段名")
+		}
+		return step, nil
+	}
 
-			if indexStr == "*" {
-				steps = append(steps, PathStep{Key: key, Indices: nil})
+	p.skipSpace()
+	switch {
+	case p.consume("*"):
+	case p.consume("?"):
+		filter, err := p.parseFilter()
+		if err != nil {
+			return step, err
+		}
+		step.filter = filter
+	default:
+		for {
+			p.skipSpace()
+			indexStart := p.pos
+			if c := p.peek(); p.eof() || (c != '-' && c != ':' && (c < '0' || c > '9')) {
+				return step, p.errorf("应为下标、* 或过滤表达式")
+			}
+			selector, err := p.parseIndexOrSlice()
+			if err != nil {
+				return step, err
+			}
+			if step.slice != nil || (selector.kind == selectorSlice && len(step.Indices) > 0) {
+				p.pos = indexStart
+				return step, p.errorf("切片不能与其他下标同时使用")
+			}
+			if selector.kind == selectorSlice {
+				step.slice = &selector.slice
+			} else if selector.index < 0 {
+				p.pos = indexStart
+				return step, p.errorf("下标不能为负数")
 			} else {
-				// 处理多个索引值，用逗号分隔
-				indices := []int{}
-				for _, idx := range strings.Split(indexStr, ",") {
-					if index, err := strconv.Atoi(strings.TrimSpace(idx)); err == nil {
-						indices = append(indices, index)
-					}
-				}
-				steps = append(steps, PathStep{Key: key, Indices: indices})
+				step.Indices = append(step.Indices, selector.index)
+			}
+			p.skipSpace()
+			if !p.consume(",") {
+				break
 			}
-		} else {
-			steps = append(steps, PathStep{Key: part})
 		}
 	}
-	return steps
+
+	p.skipSpace()
+	if !p.consume("]") {
+		if p.eof() {
+			return step, p.errorf("缺少 ]")
+		}
+		return step, p.errorf("应为 ]，实际为 %q", p.peek())
+	}
+	return step, nil
+}
+
+// quotedKeyAhead 报告接下来是否为 ["..."] 形式的字段名
+func (p *pathParser) quotedKeyAhead() bool {
+	start := p.pos
+	defer func() { p.pos = start }()
+	if !p.consume("[") {
+		return false
+	}
+	p.skipSpace()
+	return !p.eof() && (p.peek() == '"' || p.peek() == '\'')
+}
+
+// parseQuotedKey 解析 ["..."] 或 ['...'] 形式的字段名，引号内支持与 JSONPath 相同的转义序列
+func (p *pathParser) parseQuotedKey() (string, error) {
+	p.consume("[")
+	p.skipSpace()
+	key, err := p.parseQuoted()
+	if err != nil {
+		return "", err
+	}
+	p.skipSpace()
+	if !p.consume("]") {
+		if p.eof() {
+			return "", p.errorf("缺少 ]")
+		}
+		return "", p.errorf("应为 ]，实际为 %q", p.peek())
+	}
+	return key, nil
+}
+
+// parseBareKey 解析不带引号的字段名，反斜杠使其后的字符按原样作为字段名的一部分。
+// escaped 报告字段名中是否出现过转义
+func (p *pathParser) parseBareKey() (key string, escaped bool, err error) {
+	var b strings.Builder
+	for !p.eof() && strings.IndexByte(".[]", p.peek()) < 0 {
+		if p.peek() == '\\' {
+			p.pos++
+			if p.eof() {
+				return "", false, p.errorf("转义序列不完整")
+			}
+			escaped = true
+		}
+		b.WriteByte(p.peek())
+		p.pos++
+	}
+	return b.String(), escaped, nil
+}
+
+// resolveFilter 将过滤或切片步骤替换为 input 中选中元素的下标，没有选中元素时返回 false。
+// root 为过滤表达式中 $ 引用的文档根
+func resolveFilter(root, input interface{}, step PathStep) (PathStep, bool) {
+	target := input
+	if m, ok := input.(map[string]interface{}); ok && !step.isArrayStep() {
+		target = m[step.Key]
+	}
+	slice, ok := target.([]interface{})
+	if !ok {
+		return step, false
+	}
+
+	resolved := PathStep{Key: step.Key, Literal: step.Literal}
+	if step.slice != nil {
+		resolved.Indices = step.slice.indices(len(slice))
+		return resolved, len(resolved.Indices) > 0
+	}
+	for i, item := range slice {
+		if step.filter.test(filterContext{root: root, current: item}) {
+			resolved.Indices = append(resolved.Indices, i)
+		}
+	}
+	return resolved, len(resolved.Indices) > 0
 }
 
-func processStep(input interface{}, steps []PathStep, output map[string]interface{}, pathSoFar []PathStep) {
+func processStep(root, input interface{}, steps []PathStep, emit func(path []pathElem, value interface{}), pathSoFar []PathStep) {
 	if len(steps) == 0 {
 		return
 	}
 
 	currentStep := steps[0]
 	remainingSteps := steps[1:]
+	if currentStep.filter != nil || currentStep.slice != nil {
+		var ok bool
+		if currentStep, ok = resolveFilter(root, input, currentStep); !ok {
+			return
+		}
+	}
 
-	switch currentStep.Key {
-	case "*":
-		switch input := input.(type) {
-		case map[string]interface{}:
-			for key := range input {
-				newStep := PathStep{Key: key}
-				newPath := append(pathSoFar, newStep)
-				processStep(input[key], remainingSteps, output, newPath)
+	// visit 处理当前步骤选中的节点：最后一步直接输出（包括 null 值），否则继续匹配其余步骤
+	visit := func(step PathStep, value interface{}) {
+		newPath := append(pathSoFar, step)
+		if len(remainingSteps) == 0 {
+			emit(stepElems(newPath), value)
+			return
+		}
+		processStep(root, value, remainingSteps, emit, newPath)
+	}
+
+	if currentStep.isArrayStep() {
+		// 没有字段名的步骤直接选择当前数组的元素，未指定下标时选择全部元素
+		slice, ok := input.([]interface{})
+		if !ok {
+			return
+		}
+		if len(currentStep.Indices) == 0 {
+			for i := range slice {
+				visit(PathStep{Indices: []int{i}}, slice[i])
 			}
-		case []interface{}:
-			for i := range input {
-				newStep := PathStep{Key: currentStep.Key, Indices: []int{i}} // 修复：使用切片包含单个索引
-				newPath := append(pathSoFar, newStep)
-				processStep(input[i], remainingSteps, output, newPath)
+			return
+		}
+		for _, index := range currentStep.Indices {
+			if index < len(slice) {
+				visit(PathStep{Indices: []int{index}}, slice[index])
 			}
 		}
-	default:
-		switch input := input.(type) {
-		case map[string]interface{}:
-			if child, exists := input[currentStep.Key]; exists {
-				newPath := append(pathSoFar, currentStep)
-				if len(currentStep.Indices) > 0 {
-					if slice, ok := child.([]interface{}); ok {
-						// 处理多个索引
-						for _, index := range currentStep.Indices {
-							if index < len(slice) {
-								newStep := PathStep{Key: currentStep.Key, Indices: []int{index}}
-								newPath := append(pathSoFar, newStep)
-								processStep(slice[index], remainingSteps, output, newPath)
-							}
-						}
-					}
-				} else {
-					if slice, ok := child.([]interface{}); ok {
-						// 处理 [*] 的情况
-						for i := range slice {
-							newStep := PathStep{Key: currentStep.Key, Indices: []int{i}}
-							newPath := append(pathSoFar, newStep)
-							processStep(slice[i], remainingSteps, output, newPath)
-						}
-					} else {
-						processStep(child, remainingSteps, output, newPath)
-					}
-				}
+		return
+	}
+
+	switch input := input.(type) {
+	case map[string]interface{}:
+		if currentStep.isWildcard() {
+			for _, key := range sortedKeys(input) {
+				visit(PathStep{Key: key, Literal: true}, input[key])
 			}
-		case []interface{}:
-			if len(currentStep.Indices) > 0 {
+			return
+		}
+
+		child, exists := input[currentStep.Key]
+		if !exists {
+			return
+		}
+		slice, isArray := child.([]interface{})
+		switch {
+		case len(currentStep.Indices) > 0:
+			// 处理多个索引
+			if isArray {
 				for _, index := range currentStep.Indices {
-					if index < len(input) {
-						newStep := PathStep{Key: currentStep.Key, Indices: []int{index}}
-						newPath := append(pathSoFar, newStep)
-						processStep(input[index], remainingSteps, output, newPath)
+					if index < len(slice) {
+						visit(currentStep.at(index), slice[index])
 					}
 				}
 			}
+		case isArray && len(remainingSteps) > 0:
+			// 中间步骤的数组自动展开为全部元素，最后一步的数组整体选中
+			for i := range slice {
+				visit(currentStep.at(i), slice[i])
+			}
+		default:
+			visit(currentStep, child)
+		}
+	case []interface{}:
+		if currentStep.isWildcard() {
+			for i := range input {
+				visit(PathStep{Indices: []int{i}}, input[i])
+			}
+			return
+		}
+		for _, index := range currentStep.Indices {
+			if index < len(input) {
+				visit(currentStep.at(index), input[index])
+			}
+		}
+	}
+}
+
+// stepElems 将点号规则匹配到的步骤转换为具体路径，字段名为空的步骤只包含下标
+func stepElems(path []PathStep) []pathElem {
+	var elems []pathElem
+	for _, step := range path {
+		if !step.isArrayStep() {
+			elems = append(elems, pathElem{key: step.Key})
+		}
+		if len(step.Indices) > 0 {
+			elems = append(elems, pathElem{index: step.Indices[0], isIndex: true}) // 使用第一个索引
+		}
+	}
+	return elems
+}
+
+// insertValue 按具体路径将值写入输出并返回写入后的输出，路径上缺失的对象和数组会被创建，
+// 输出为 nil 时根节点类型由路径推断。空路径表示根节点，对象合并其成员，其他值替换整个输出
+func insertValue(output interface{}, path []pathElem, value interface{}) interface{} {
+	if len(path) == 0 {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return value
 		}
+		merged, ok := output.(map[string]interface{})
+		if !ok {
+			merged = make(map[string]interface{}, len(m))
+		}
+		for key, child := range m {
+			merged[key] = child
+		}
+		return merged
 	}
+	return insertInto(output, path, value)
+}
 
-	if len(remainingSteps) == 0 {
-		var value interface{}
-		switch input := input.(type) {
+// emptyLike 返回没有选中任何节点时的裁剪结果：与输入根节点类型相同的空对象或空数组，标量为 null
+func emptyLike(input interface{}) interface{} {
+	switch input.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	case []interface{}:
+		return []interface{}{}
+	}
+	return nil
+}
+
+// insertInto 将值写入容器中的路径并返回写入后的容器。
+// 容器类型与路径不符时重新创建，数组中目标下标之前的空位以 skippedSlot 占位
+func insertInto(container interface{}, path []pathElem, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+
+	elem := path[0]
+	if elem.isIndex {
+		slice, _ := container.([]interface{})
+		for len(slice) <= elem.index {
+			slice = append(slice, skippedSlot{})
+		}
+		slice[elem.index] = insertInto(slice[elem.index], path[1:], value)
+		return slice
+	}
+
+	m, ok := container.(map[string]interface{})
+	if !ok {
+		m = make(map[string]interface{})
+	}
+	m[elem.key] = insertInto(m[elem.key], path[1:], value)
+	return m
+}
+
+// skippedSlot 数组中位于选中元素之前、未被选中的位置
+type skippedSlot struct{}
+
+// excludedNode 标记排除规则选中的节点，全部排除规则执行后统一移除，
+// 使数组元素的移除不影响其他规则中的下标
+type excludedNode struct{}
+
+// markExcluded 将输出中路径对应的节点标记为排除并返回输出，路径不存在时忽略。
+// 空路径表示排除整个文档，返回 nil
+func markExcluded(output interface{}, path []pathElem) interface{} {
+	if len(path) == 0 {
+		return nil
+	}
+
+	container := output
+	for i, elem := range path {
+		last := i == len(path)-1
+		switch c := container.(type) {
 		case map[string]interface{}:
-			if len(currentStep.Indices) > 0 {
-				if slice, ok := input[currentStep.Key].([]interface{}); ok {
-					for _, index := range currentStep.Indices {
-						if index < len(slice) {
-							value = slice[index]
-							fullPath := append(pathSoFar, PathStep{Key: currentStep.Key, Indices: []int{index}})
-							setValue(output, fullPath, value)
-						}
-					}
-					return
-				}
-			} else {
-				value = input[currentStep.Key]
+			child, exists := c[elem.key]
+			if elem.isIndex || !exists {
+				return output
 			}
+			if last {
+				c[elem.key] = excludedNode{}
+			}
+			container = child
 		case []interface{}:
-			if len(currentStep.Indices) > 0 {
-				for _, index := range currentStep.Indices {
-					if index < len(input) {
-						value = input[index]
-						fullPath := append(pathSoFar, PathStep{Key: currentStep.Key, Indices: []int{index}})
-						setValue(output, fullPath, value)
-					}
-				}
-				return
+			if !elem.isIndex || elem.index >= len(c) {
+				return output
 			}
-		}
-
-		if value != nil {
-			fullPath := append(pathSoFar, currentStep)
-			setValue(output, fullPath, value)
+			if last {
+				c[elem.index] = excludedNode{}
+			}
+			container = c[elem.index]
+		default:
+			return output
 		}
 	}
+	return output
 }
 
-func setValue(output map[string]interface{}, path []PathStep, value interface{}) {
-	current := output
-	for i, step := range path {
-		isLast := i == len(path)-1
-
-		if len(step.Indices) > 0 {
-			key := step.Key
-			index := step.Indices[0] // 使用第一个索引
-
-			var slice []interface{}
-			if existing, ok := current[key].([]interface{}); ok {
-				slice = existing
-				if index >= len(slice) {
-					newSlice := make([]interface{}, index+1)
-					copy(newSlice, slice)
-					for j := len(slice); j <= index; j++ {
-						newSlice[j] = make(map[string]interface{})
-					}
-					slice = newSlice
-					current[key] = slice
-				}
-			} else {
-				slice = make([]interface{}, index+1)
-				for j := 0; j <= index; j++ {
-					slice[j] = make(map[string]interface{})
-				}
-				current[key] = slice
+// arrangeArrays 返回整理后的裁剪结果副本：移除排除的节点，并按数组重建方式处理未选中的数组元素。
+// source 为输入中对应位置的值，用于统计数组末尾省略的元素；annotate 为真时生成图片使用的
+// 省略标记和下标标注，否则只保留合法的JSON值
+func arrangeArrays(value, source interface{}, mode ArrayMode, annotate bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		sourceMap, _ := source.(map[string]interface{})
+		arranged := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			if _, excluded := child.(excludedNode); !excluded {
+				arranged[key] = arrangeArrays(child, sourceMap[key], mode, annotate)
+			}
+		}
+		return arranged
+	case []interface{}:
+		sourceSlice, _ := source.([]interface{})
+		arranged := make([]interface{}, 0, len(v))
+		skipped, padding := 0, 0
+		flush := func() {
+			if skipped > 0 && mode == ArrayModeSparse && annotate {
+				arranged = append(arranged, skippedItems{count: skipped})
 			}
+			// 占位元素只在其后仍有保留的元素时输出，末尾的占位直接丢弃
+			for ; padding > 0; padding-- {
+				arranged = append(arranged, map[string]interface{}{})
+			}
+			skipped = 0
+		}
 
-			if isLast {
-				slice[index] = value
-				return
-			} else {
-				if elem, ok := slice[index].(map[string]interface{}); ok {
-					current = elem
+		for i, item := range v {
+			switch item.(type) {
+			case skippedSlot:
+				if mode == ArrayModePadded {
+					padding++
 				} else {
-					elem := make(map[string]interface{})
-					slice[index] = elem
-					current = elem
+					skipped++
 				}
+				continue
+			case excludedNode:
+				skipped++
+				continue
 			}
-		} else {
-			key := step.Key
-			if isLast {
-				current[key] = value
-			} else {
-				if existing, ok := current[key].(map[string]interface{}); ok {
-					current = existing
-				} else {
-					newMap := make(map[string]interface{})
-					current[key] = newMap
-					current = newMap
-				}
+
+			flush()
+			var sourceItem interface{}
+			if i < len(sourceSlice) {
+				sourceItem = sourceSlice[i]
+			}
+			item = arrangeArrays(item, sourceItem, mode, annotate)
+			if mode == ArrayModeIndexed && annotate {
+				item = indexedItem{index: i, value: item}
 			}
+			arranged = append(arranged, item)
+		}
+		if len(sourceSlice) > len(v) {
+			skipped += len(sourceSlice) - len(v)
+		}
+		padding = 0
+		flush()
+		return arranged
+	}
+	return value
+}
+
+// cloneJSON 深复制由 encoding/json 解码得到的值
+func cloneJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		clone := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			clone[key] = cloneJSON(child)
+		}
+		return clone
+	case []interface{}:
+		clone := make([]interface{}, len(v))
+		for i, item := range v {
+			clone[i] = cloneJSON(item)
 		}
+		return clone
 	}
+	return value
 }
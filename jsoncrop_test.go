@@ -2,6 +2,7 @@ package json2image
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -199,3 +200,361 @@ func TestJsonCropWithWildcards(t *testing.T) {
 	}
 	t.Log("Wildcard crop result:", string(outputJSON))
 }
+
+func TestJsonCropWithExclusions(t *testing.T) {
+	document := `{
+		"users": [
+			{"name": "Alice", "profile": {"email": "a@example.com", "age": 25}},
+			{"name": "Bob", "profile": {"email": "b@example.com", "age": 30}}
+		],
+		"meta": {"debug": {"trace": 1}, "total": 2},
+		"stats": {"debug": true, "count": 5}
+	}`
+	var input map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+	original, _ := json.Marshal(input)
+
+	tests := []struct {
+		rules []string
+		want  string
+	}{
+		// 只有排除规则时从完整文档中移除
+		{[]string{"!users[*].profile.email", "!*.debug"}, `{"meta":{"total":2},"stats":{"count":5},"users":[{"name":"Alice","profile":{"age":25}},{"name":"Bob","profile":{"age":30}}]}`},
+		// 先应用包含规则，再移除
+		{[]string{"users[*].profile", "!users[*].profile.email"}, `{"users":[{"profile":{"age":25}},{"profile":{"age":30}}]}`},
+		{[]string{"meta", "!$..debug"}, `{"meta":{"total":2}}`},
+		// 数组元素被移除，排除规则的顺序不影响下标
+		{[]string{"!users[0]", "!users[1].profile"}, `{"meta":{"debug":{"trace":1},"total":2},"stats":{"count":5,"debug":true},"users":[{"name":"Bob"}]}`},
+		{[]string{"!users[?(@.profile.age > 25)]", "!meta", "!stats"}, `{"users":[{"name":"Alice","profile":{"age":25,"email":"a@example.com"}}]}`},
+		{[]string{"!$"}, `{}`},
+		{[]string{"!missing.field"}, string(original)},
+	}
+	for _, tt := range tests {
+		output, err := JsonCrop(input, tt.rules)
+		if err != nil {
+			t.Errorf("JsonCrop(%q) 失败: %v", tt.rules, err)
+			continue
+		}
+		if string(output) != tt.want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", tt.rules, output, tt.want)
+		}
+	}
+
+	// 排除规则不修改输入
+	if after, _ := json.Marshal(input); string(after) != string(original) {
+		t.Errorf("输入被修改: %s", after)
+	}
+
+	// 排除规则的错误位置包含 !
+	_, err := JsonCrop(input, []string{"!$.users["})
+	var ruleErr *RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Pos != 9 {
+		t.Errorf("JsonCrop(!$.users[) 错误为 %v, 期望位于第 10 个字符", err)
+	}
+}
+
+func TestCompileRules(t *testing.T) {
+	errorTests := []struct {
+		rule string
+		pos  int
+	}{
+		{"", 0},
+		{"a[1", 3},
+		{"a..b", 2},
+		{"a.", 2},
+		{"a[x]", 2},
+		{"a[]", 2},
+		{"a[-1]", 2},
+		{"a[1,]", 4},
+		{"a[1 2]", 4},
+		{"a[0][1]", 4},
+		{"a]b", 1},
+		{"!a[1", 4},
+		{"a[01]", 2},
+		{"a[1::9223372036854775807]", 5},
+	}
+	for _, tt := range errorTests {
+		_, err := CompileRules([]string{"valid.rule", tt.rule})
+		var ruleErr *RuleError
+		if !errors.As(err, &ruleErr) {
+			t.Errorf("CompileRules(%q) 应返回 *RuleError, 实际为 %v", tt.rule, err)
+			continue
+		}
+		if ruleErr.Index != 1 || ruleErr.Rule != tt.rule || ruleErr.Pos != tt.pos {
+			t.Errorf("CompileRules(%q) 错误为 %+v, 期望位于第 %d 个字符", tt.rule, ruleErr, tt.pos+1)
+		}
+	}
+
+	// 编译后的规则可重复用于多个文档
+	program, err := CompileRules([]string{"items[ 1 , 0 ].id", "!items[0].id"})
+	if err != nil {
+		t.Fatalf("CompileRules失败: %v", err)
+	}
+	documents := map[string]string{
+		`{"items": [{"id": 1}, {"id": 2}]}`:                `{"items":[{},{"id":2}]}`,
+		`{"items": [{"id": "a"}, {"id": "b"}, {"id": 3}]}`: `{"items":[{},{"id":"b"}]}`,
+	}
+	for document, want := range documents {
+		var input map[string]interface{}
+		if err := json.Unmarshal([]byte(document), &input); err != nil {
+			t.Fatalf("解析输入JSON失败: %v", err)
+		}
+		output, err := program.Crop(input)
+		if err != nil {
+			t.Fatalf("Crop失败: %v", err)
+		}
+		if string(output) != want {
+			t.Errorf("Crop(%s) = %s, 期望 %s", document, output, want)
+		}
+	}
+}
+
+func TestCropArrayModes(t *testing.T) {
+	var input map[string]interface{}
+	document := `{"items": [{"id": 0}, {"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}, {"id": 5}, {"id": 6}]}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+	rules := []string{"items[2,5].id", "items[4]", "!items[4]"}
+
+	tests := []struct {
+		mode      ArrayMode
+		json      string
+		formatted string
+	}{
+		{
+			ArrayModePadded,
+			`{"items":[{},{},{"id":2},{},{"id":5}]}`,
+			`{"items": [{}, {}, {"id": 2}, {}, {"id": 5}]}`,
+		},
+		{
+			ArrayModeCompact,
+			`{"items":[{"id":2},{"id":5}]}`,
+			`{"items": [{"id": 2}, {"id": 5}]}`,
+		},
+		{
+			ArrayModeSparse,
+			`{"items":[{"id":2},{"id":5}]}`,
+			`{"items": [/* … 2 skipped */ {"id": 2}, /* … 2 skipped */ {"id": 5}, /* … 1 skipped */]}`,
+		},
+		{
+			ArrayModeIndexed,
+			`{"items":[{"id":2},{"id":5}]}`,
+			`{"items": [/* [2] */ {"id": 2}, /* [5] */ {"id": 5}]}`,
+		},
+	}
+	for _, tt := range tests {
+		program, err := CompileRules(rules)
+		if err != nil {
+			t.Fatalf("CompileRules失败: %v", err)
+		}
+		program.WithArrayMode(tt.mode)
+
+		output, err := program.Crop(input)
+		if err != nil {
+			t.Fatalf("Crop失败: %v", err)
+		}
+		if string(output) != tt.json {
+			t.Errorf("模式 %d 输出 %s, 期望 %s", tt.mode, output, tt.json)
+		}
+
+		raw, _ := program.crop(input)
+		formatted, err := formatValue(arrangeArrays(raw, input, tt.mode, true), FormatConfig{Compact: true, PrintWidth: 200})
+		if err != nil {
+			t.Fatalf("formatValue失败: %v", err)
+		}
+		if formatted != tt.formatted {
+			t.Errorf("模式 %d 的图片文本为 %s, 期望 %s", tt.mode, formatted, tt.formatted)
+		}
+	}
+
+	// 整理结果时不修改输入
+	if after, _ := json.Marshal(input); string(after) != `{"items":[{"id":0},{"id":1},{"id":2},{"id":3},{"id":4},{"id":5},{"id":6}]}` {
+		t.Errorf("输入被修改: %s", after)
+	}
+
+	// 填充模式下其后没有保留元素的占位元素被丢弃
+	input = map[string]interface{}{"a": []interface{}{1.0, 2.0, 3.0}}
+	paddedTests := map[string][]string{
+		`{"a":[]}`:     {"a[1]", "!a[1]"},
+		`{"a":[{},2]}`: {"a[1,2]", "!a[2]"},
+		`{"a":[{},3]}`: {"a[1,2]", "!a[1]"},
+		`{"a":[1,3]}`:  {"a[0,2]", "!a[1]"},
+	}
+	for want, rules := range paddedTests {
+		output, err := JsonCrop(input, rules)
+		if err != nil {
+			t.Fatalf("JsonCrop(%q) 失败: %v", rules, err)
+		}
+		if string(output) != want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", rules, output, want)
+		}
+	}
+}
+
+func TestCropJson2ImageArrayModes(t *testing.T) {
+	inputData := `{"orders": [
+		{"id": 1, "status": "ok"}, {"id": 2, "status": "ok"}, {"id": 3, "status": "failed"},
+		{"id": 4, "status": "ok"}, {"id": 5, "status": "ok"}, {"id": 6, "status": "failed"},
+		{"id": 7, "status": "ok"}
+	]}`
+	outputs := map[ArrayMode]string{
+		ArrayModeSparse:  "output/output_crop_sparse.png",
+		ArrayModeIndexed: "output/output_crop_indexed.png",
+	}
+	for mode, path := range outputs {
+		config := DefaultConfig().
+			WithCropRules(`orders[?(@.status == "failed")]`).
+			WithCropArrayMode(mode)
+		if _, err := CropJson2Image(inputData, config, path); err != nil {
+			t.Fatalf("生成图片失败: %v", err)
+		}
+		t.Logf("数组模式图片生成成功：%s", path)
+	}
+}
+
+func TestCropRootTypes(t *testing.T) {
+	tests := []struct {
+		document string
+		rules    []string
+		want     string
+	}{
+		{`[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}]`, []string{"[*].id"}, `[{"id":1},{"id":2}]`},
+		{`[{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}]`, []string{"[0:3]"}, `[{"id":1},{"id":2},{"id":3}]`},
+		{`[{"id": 1}, {"id": 2}, {"id": 3}, {"id": 4}]`, []string{"[::2].id"}, `[{"id":1},{},{"id":3}]`},
+		{`[{"id": 1}, {"id": 2}, {"id": 3}]`, []string{"[1,2]"}, `[{},{"id":2},{"id":3}]`},
+		{`[{"id": 1}, {"id": 2}, {"id": 3}]`, []string{"[?(@.id > 1)].id"}, `[{},{"id":2},{"id":3}]`},
+		{`[{"id": 1, "tags": [1, 2]}]`, []string{"[0].tags[1]"}, `[{"tags":[{},2]}]`},
+		{`[{"id": 1}, {"id": 2}]`, []string{"$[-1]"}, `[{},{"id":2}]`},
+		{`[{"id": 1}, {"id": 2}]`, []string{"!$[0]"}, `[{"id":2}]`},
+		{`[{"id": 1}, {"id": 2}]`, []string{"name"}, `[]`},
+		{`{"items": [{"id": 1}, {"id": 2}]}`, []string{"items[1:]"}, `{"items":[{},{"id":2}]}`},
+		{`{"items": [[1, 2], [3, 4]]}`, []string{"items[1].[0]"}, `{"items":[{},[3]]}`},
+		{`{"items": [1]}`, []string{"[0]"}, `{}`},
+		{`42`, []string{"$"}, `42`},
+		{`"text"`, []string{"name"}, `null`},
+		{`{"a": 1}`, []string{"!$"}, `{}`},
+	}
+	for _, tt := range tests {
+		var input interface{}
+		if err := json.Unmarshal([]byte(tt.document), &input); err != nil {
+			t.Fatalf("解析输入JSON失败: %v", err)
+		}
+		output, err := JsonCrop(input, tt.rules)
+		if err != nil {
+			t.Errorf("JsonCrop(%s, %q) 失败: %v", tt.document, tt.rules, err)
+			continue
+		}
+		if string(output) != tt.want {
+			t.Errorf("JsonCrop(%s, %q) = %s, 期望 %s", tt.document, tt.rules, output, tt.want)
+		}
+	}
+
+	_, report, err := JsonCropWithReport([]interface{}{1.0, 2.0}, []string{"[*].id", "[5]"})
+	if err != nil {
+		t.Fatalf("JsonCropWithReport失败: %v", err)
+	}
+	if miss := report.Rules[0].Miss; miss == nil || miss.Message != "[0] 是数字，不是对象" {
+		t.Errorf("根数组元素类型不符的原因为 %+v", miss)
+	}
+	if miss := report.Rules[1].Miss; miss == nil || miss.Kind != MissIndexOutOfRange {
+		t.Errorf("根数组下标越界的原因为 %+v", miss)
+	}
+
+	for _, rule := range []string{"[0,1:2]", "[1:2,0]", "a[x:1]"} {
+		if _, err := CompileRules([]string{rule}); err == nil {
+			t.Errorf("CompileRules(%q) 应返回错误", rule)
+		}
+	}
+}
+
+func TestCropJson2ImageRootArray(t *testing.T) {
+	inputData := `[{"id": 1, "name": "Alice", "email": "a@example.com"}, {"id": 2, "name": "Bob"}]`
+	config := DefaultConfig().WithCropRules("[*].name")
+	if _, err := CropJson2Image(inputData, config, "output/output_crop_root_array.png"); err != nil {
+		t.Fatalf("CropJson2Image失败: %v", err)
+	}
+	t.Log("根数组裁剪图片生成成功：output/output_crop_root_array.png")
+}
+
+func TestCropQuotedKeys(t *testing.T) {
+	var input map[string]interface{}
+	document := `{
+		"metadata": {
+			"labels": {"app.kubernetes.io/name": "web", "tier": "front"},
+			"annotations": {"a[b]": 1, "say \"hi\"": 2, "*": 3, "": 4, "back\\slash": 5}
+		},
+		"headers": {"Content-Type": "json", "X-Request.Id": "r1"},
+		"items": {"list.v1": [{"id": 1}, {"id": 2}]}
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{`metadata.labels["app.kubernetes.io/name"]`, `{"metadata":{"labels":{"app.kubernetes.io/name":"web"}}}`},
+		{`metadata.labels['app.kubernetes.io/name']`, `{"metadata":{"labels":{"app.kubernetes.io/name":"web"}}}`},
+		{`metadata.labels.app\.kubernetes\.io/name`, `{"metadata":{"labels":{"app.kubernetes.io/name":"web"}}}`},
+		{`metadata["labels"]["app.kubernetes.io/name"]`, `{"metadata":{"labels":{"app.kubernetes.io/name":"web"}}}`},
+		{`metadata.annotations["a[b]"]`, `{"metadata":{"annotations":{"a[b]":1}}}`},
+		{`metadata.annotations.a\[b\]`, `{"metadata":{"annotations":{"a[b]":1}}}`},
+		{`metadata.annotations["say \"hi\""]`, `{"metadata":{"annotations":{"say \"hi\"":2}}}`},
+		{`metadata.annotations["*"]`, `{"metadata":{"annotations":{"*":3}}}`},
+		{`metadata.annotations.\*`, `{"metadata":{"annotations":{"*":3}}}`},
+		{`metadata.annotations[""]`, `{"metadata":{"annotations":{"":4}}}`},
+		{`metadata.annotations.back\\slash`, `{"metadata":{"annotations":{"back\\slash":5}}}`},
+		{`headers.X-Request\.Id`, `{"headers":{"X-Request.Id":"r1"}}`},
+		{`items["list.v1"][1].id`, `{"items":{"list.v1":[{},{"id":2}]}}`},
+		{`items["list.v1"][*].id`, `{"items":{"list.v1":[{"id":1},{"id":2}]}}`},
+		{`!metadata.labels["app.kubernetes.io/name"]`, ``},
+	}
+	for _, tt := range tests {
+		output, report, err := JsonCropWithReport(input, []string{tt.rule})
+		if err != nil {
+			t.Errorf("JsonCrop(%q) 失败: %v", tt.rule, err)
+			continue
+		}
+		if tt.want != "" && string(output) != tt.want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", tt.rule, output, tt.want)
+		}
+		if report.Rules[0].Matches == 0 {
+			t.Errorf("规则 %s 未匹配: %+v", tt.rule, report.Rules[0].Miss)
+			continue
+		}
+
+		// 报告中的路径本身也是合法的规则，且选中同一个节点
+		for _, path := range report.Rules[0].Paths {
+			_, again, err := JsonCropWithReport(input, []string{path})
+			if err != nil || len(again.Rules[0].Paths) != 1 || again.Rules[0].Paths[0] != path {
+				t.Errorf("路径 %s 无法作为规则重新选中节点: %v %+v", path, err, again)
+			}
+		}
+	}
+
+	errorTests := []struct {
+		rule string
+		pos  int
+	}{
+		{`labels["a.b"`, 12},
+		{`labels["a.b]`, 12},
+		{`labels["a.b"x]`, 12},
+		{`labels.a\`, 9},
+		{`labels["\q"]`, 9},
+	}
+	for _, tt := range errorTests {
+		_, err := CompileRules([]string{tt.rule})
+		var ruleErr *RuleError
+		if !errors.As(err, &ruleErr) {
+			t.Errorf("CompileRules(%q) 应返回语法错误, 实际为 %v", tt.rule, err)
+			continue
+		}
+		if ruleErr.Pos != tt.pos {
+			t.Errorf("CompileRules(%q) 错误位置为 %d, 期望 %d: %v", tt.rule, ruleErr.Pos, tt.pos, err)
+		}
+	}
+}
@@ -0,0 +1,534 @@
+package json2image
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// jsonPath 以 $ 开头的 RFC 9535 JSONPath 规则
+type jsonPath struct {
+	segments []pathSegment
+}
+
+// pathSegment JSONPath 的一段：子节点段（.name、[...]）或后代段（..name、..[...]）
+type pathSegment struct {
+	descendant bool
+	selectors  []pathSelector
+}
+
+// selectorKind 选择器的类别
+type selectorKind int
+
+const (
+	selectorName     selectorKind = iota // 对象成员名称
+	selectorWildcard                     // 通配符 *
+	selectorIndex                        // 数组下标，负数从末尾计数
+	selectorSlice                        // 数组切片 start:end:step
+	selectorFilter                       // 过滤表达式 ?expr
+)
+
+// pathSelector JSONPath 选择器
+type pathSelector struct {
+	kind   selectorKind
+	name   string
+	index  int
+	slice  sliceSelector
+	filter filterExpr
+}
+
+// sliceSelector 数组切片，省略的起止位置按步长方向取默认值
+type sliceSelector struct {
+	start, end       int
+	hasStart, hasEnd bool
+	step             int
+}
+
+// pathElem 具体路径中的一步：对象的键或数组下标
+type pathElem struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// pathNode 规则选中的节点及其具体路径
+type pathNode struct {
+	path  []pathElem
+	value interface{}
+}
+
+// child 返回子节点，路径复制后追加，避免与兄弟节点共用底层数组
+func (n pathNode) child(elem pathElem, value interface{}) pathNode {
+	path := make([]pathElem, len(n.path), len(n.path)+1)
+	copy(path, n.path)
+	return pathNode{path: append(path, elem), value: value}
+}
+
+// pathSyntaxError 规则的语法错误，pos 为出错位置的字节偏移
+type pathSyntaxError struct {
+	pos int
+	msg string
+}
+
+func (e *pathSyntaxError) Error() string {
+	return fmt.Sprintf("第 %d 个字符: %s", e.pos+1, e.msg)
+}
+
+// pathParser 规则解析器
+type pathParser struct {
+	rule string
+	pos  int
+}
+
+// errorf 返回当前位置的语法错误
+func (p *pathParser) errorf(format string, args ...interface{}) error {
+	return &pathSyntaxError{pos: p.pos, msg: fmt.Sprintf(format, args...)}
+}
+
+// eof 判断是否已解析到末尾
+func (p *pathParser) eof() bool {
+	return p.pos >= len(p.rule)
+}
+
+// peek 返回当前字符，末尾返回0
+func (p *pathParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.rule[p.pos]
+}
+
+// skipSpace 跳过空白
+func (p *pathParser) skipSpace() {
+	for !p.eof() && strings.IndexByte(" \t\n\r", p.peek()) >= 0 {
+		p.pos++
+	}
+}
+
+// consume 当前位置为 s 时跳过并返回 true
+func (p *pathParser) consume(s string) bool {
+	if strings.HasPrefix(p.rule[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+// parseJSONPath 解析以 $ 开头的 JSONPath 规则
+func parseJSONPath(rule string) (*jsonPath, error) {
+	p := &pathParser{rule: rule}
+	if !p.consume("$") {
+		return nil, p.errorf("JSONPath 必须以 $ 开头")
+	}
+
+	path := &jsonPath{}
+	for !p.eof() {
+		segment, err := p.parseSegment()
+		if err != nil {
+			return nil, err
+		}
+		path.segments = append(path.segments, segment)
+	}
+	return path, nil
+}
+
+// parseSegment 解析 .name、.*、[...]、..name、..* 或 ..[...]
+func (p *pathParser) parseSegment() (pathSegment, error) {
+	switch {
+	case p.consume(".."):
+		segment := pathSegment{descendant: true}
+		if p.peek() == '[' {
+			selectors, err := p.parseBracket()
+			segment.selectors = selectors
+			return segment, err
+		}
+		selector, err := p.parseShorthand()
+		segment.selectors = []pathSelector{selector}
+		return segment, err
+	case p.consume("."):
+		selector, err := p.parseShorthand()
+		return pathSegment{selectors: []pathSelector{selector}}, err
+	case p.peek() == '[':
+		selectors, err := p.parseBracket()
+		return pathSegment{selectors: selectors}, err
+	}
+	return pathSegment{}, p.errorf("应为 .、.. 或 [，实际为 %q", p.peek())
+}
+
+// parseShorthand 解析点号之后的 * 或成员名称
+func (p *pathParser) parseShorthand() (pathSelector, error) {
+	if p.consume("*") {
+		return pathSelector{kind: selectorWildcard}, nil
+	}
+
+	start := p.pos
+	for i, r := range p.rule[p.pos:] {
+		if !isNameChar(r, i == 0) {
+			break
+		}
+		p.pos = start + i + len(string(r))
+	}
+	if p.pos == start {
+		return pathSelector{}, p.errorf("缺少成员名称")
+	}
+	return pathSelector{kind: selectorName, name: p.rule[start:p.pos]}, nil
+}
+
+// isNameChar 判断字符能否出现在点号之后的成员名称中，首字符不能为数字
+func isNameChar(r rune, first bool) bool {
+	switch {
+	case r == '_' || r >= 0x80:
+		return true
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		return true
+	case r >= '0' && r <= '9':
+		return !first
+	}
+	return false
+}
+
+// parseBracket 解析 [selector, selector, ...]
+func (p *pathParser) parseBracket() ([]pathSelector, error) {
+	p.consume("[")
+	var selectors []pathSelector
+	for {
+		p.skipSpace()
+		selector, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+
+		p.skipSpace()
+		switch {
+		case p.consume(","):
+			continue
+		case p.consume("]"):
+			return selectors, nil
+		case p.eof():
+			return nil, p.errorf("缺少 ]")
+		default:
+			return nil, p.errorf("应为 , 或 ]，实际为 %q", p.peek())
+		}
+	}
+}
+
+// parseSelector 解析方括号中的单个选择器：引号名称、*、下标、切片或过滤表达式
+func (p *pathParser) parseSelector() (pathSelector, error) {
+	switch c := p.peek(); {
+	case c == '?':
+		p.pos++
+		filter, err := p.parseFilter()
+		return pathSelector{kind: selectorFilter, filter: filter}, err
+	case c == '\'' || c == '"':
+		name, err := p.parseQuoted()
+		return pathSelector{kind: selectorName, name: name}, err
+	case c == '*':
+		p.pos++
+		return pathSelector{kind: selectorWildcard}, nil
+	case c == '-' || c == ':' || (c >= '0' && c <= '9'):
+		return p.parseIndexOrSlice()
+	case p.eof():
+		return pathSelector{}, p.errorf("缺少选择器")
+	}
+	return pathSelector{}, p.errorf("无效的选择器 %q", p.peek())
+}
+
+// parseQuoted 解析单引号或双引号包围的名称，支持 RFC 9535 的转义序列
+func (p *pathParser) parseQuoted() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("缺少结束引号 %c", quote)
+		}
+		c := p.peek()
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\\':
+			r, err := p.parseEscape()
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+		case c < 0x20:
+			return "", p.errorf("名称中不能包含控制字符")
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// parseEscape 解析反斜杠转义序列
+func (p *pathParser) parseEscape() (rune, error) {
+	p.pos++
+	if p.eof() {
+		return 0, p.errorf("转义序列不完整")
+	}
+	c := p.peek()
+	p.pos++
+	switch c {
+	case 'b':
+		return '\b', nil
+	case 'f':
+		return '\f', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 't':
+		return '\t', nil
+	case '/', '\\', '\'', '"':
+		return rune(c), nil
+	case 'u':
+		r, err := p.parseHex4()
+		if err != nil {
+			return 0, err
+		}
+		// 代理对
+		if utf16.IsSurrogate(r) {
+			if !p.consume(`\u`) {
+				return 0, p.errorf("缺少低位代理")
+			}
+			low, err := p.parseHex4()
+			if err != nil {
+				return 0, err
+			}
+			r = utf16.DecodeRune(r, low)
+			if r == unicode.ReplacementChar {
+				return 0, p.errorf("无效的代理对")
+			}
+		}
+		return r, nil
+	}
+	p.pos--
+	return 0, p.errorf("无效的转义字符 %q", c)
+}
+
+// parseHex4 解析 \u 之后的四位十六进制数
+func (p *pathParser) parseHex4() (rune, error) {
+	if p.pos+4 > len(p.rule) {
+		return 0, p.errorf("\\u 之后应为四位十六进制数")
+	}
+	n, err := strconv.ParseUint(p.rule[p.pos:p.pos+4], 16, 32)
+	if err != nil {
+		return 0, p.errorf("\\u 之后应为四位十六进制数")
+	}
+	p.pos += 4
+	return rune(n), nil
+}
+
+// maxExactInt I-JSON 中可精确表示的最大整数 2^53-1，RFC 9535 要求下标和切片参数不超出 ±maxExactInt
+const maxExactInt = 1<<53 - 1
+
+// parseInt 解析可带负号的整数，返回是否存在。按 RFC 9535 不接受前导零、-0 及超出 ±(2^53-1) 的整数
+func (p *pathParser) parseInt() (int, bool, error) {
+	start := p.pos
+	p.consume("-")
+	for !p.eof() && p.peek() >= '0' && p.peek() <= '9' {
+		p.pos++
+	}
+	text := p.rule[start:p.pos]
+	if text == "" {
+		return 0, false, nil
+	}
+	digits := strings.TrimPrefix(text, "-")
+	n, err := strconv.ParseInt(text, 10, 64)
+	switch {
+	case digits == "" || (len(digits) > 1 && digits[0] == '0') || text == "-0":
+		err = fmt.Errorf("无效的整数 %q", text)
+	case err != nil || n > maxExactInt || n < -maxExactInt:
+		err = fmt.Errorf("整数 %q 超出范围 ±(2^53-1)", text)
+	}
+	if err != nil {
+		p.pos = start
+		return 0, false, p.errorf("%s", err.Error())
+	}
+	return int(n), true, nil
+}
+
+// parseIndexOrSlice 解析下标 n 或切片 start:end:step
+func (p *pathParser) parseIndexOrSlice() (pathSelector, error) {
+	start, hasStart, err := p.parseInt()
+	if err != nil {
+		return pathSelector{}, err
+	}
+	p.skipSpace()
+	if !p.consume(":") {
+		if !hasStart {
+			return pathSelector{}, p.errorf("缺少下标")
+		}
+		return pathSelector{kind: selectorIndex, index: start}, nil
+	}
+
+	slice := sliceSelector{start: start, hasStart: hasStart, step: 1}
+	p.skipSpace()
+	if slice.end, slice.hasEnd, err = p.parseInt(); err != nil {
+		return pathSelector{}, err
+	}
+	p.skipSpace()
+	if p.consume(":") {
+		p.skipSpace()
+		step, hasStep, err := p.parseInt()
+		if err != nil {
+			return pathSelector{}, err
+		}
+		if hasStep {
+			slice.step = step
+		}
+	}
+	return pathSelector{kind: selectorSlice, slice: slice}, nil
+}
+
+// eval 在 root 上执行规则，按文档顺序返回选中的节点
+func (jp *jsonPath) eval(root interface{}) []pathNode {
+	return jp.query(root, root)
+}
+
+// query 从 start 开始执行规则，root 为过滤表达式中 $ 引用的文档根
+func (jp *jsonPath) query(root, start interface{}) []pathNode {
+	nodes := []pathNode{{value: start}}
+	for _, segment := range jp.segments {
+		var next []pathNode
+		for _, node := range nodes {
+			segment.apply(root, node, func(n pathNode) { next = append(next, n) })
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// apply 对节点执行一段，后代段依次作用于节点自身及其所有后代
+func (s pathSegment) apply(root interface{}, node pathNode, emit func(pathNode)) {
+	for _, selector := range s.selectors {
+		selector.apply(root, node, emit)
+	}
+	if !s.descendant {
+		return
+	}
+	forEachChild(node, func(child pathNode) {
+		s.apply(root, child, emit)
+	})
+}
+
+// forEachChild 按文档顺序遍历节点的子节点，对象成员按键排序
+func forEachChild(node pathNode, fn func(pathNode)) {
+	switch value := node.value.(type) {
+	case map[string]interface{}:
+		for _, key := range sortedKeys(value) {
+			fn(node.child(pathElem{key: key}, value[key]))
+		}
+	case []interface{}:
+		for i, item := range value {
+			fn(node.child(pathElem{index: i, isIndex: true}, item))
+		}
+	}
+}
+
+// sortedKeys 返回按字母排序的对象键
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// apply 对节点执行选择器
+func (s pathSelector) apply(root interface{}, node pathNode, emit func(pathNode)) {
+	switch s.kind {
+	case selectorName:
+		if m, ok := node.value.(map[string]interface{}); ok {
+			if value, exists := m[s.name]; exists {
+				emit(node.child(pathElem{key: s.name}, value))
+			}
+		}
+	case selectorWildcard:
+		forEachChild(node, emit)
+	case selectorIndex:
+		if slice, ok := node.value.([]interface{}); ok {
+			index := s.index
+			if index < 0 {
+				index += len(slice)
+			}
+			if index >= 0 && index < len(slice) {
+				emit(node.child(pathElem{index: index, isIndex: true}, slice[index]))
+			}
+		}
+	case selectorSlice:
+		if slice, ok := node.value.([]interface{}); ok {
+			for _, index := range s.slice.indices(len(slice)) {
+				emit(node.child(pathElem{index: index, isIndex: true}, slice[index]))
+			}
+		}
+	case selectorFilter:
+		forEachChild(node, func(child pathNode) {
+			if s.filter.test(filterContext{root: root, current: child.value}) {
+				emit(child)
+			}
+		})
+	}
+}
+
+// indices 按 RFC 9535 的规则返回切片选中的下标
+func (s sliceSelector) indices(length int) []int {
+	if s.step == 0 {
+		return nil
+	}
+	normalize := func(i int) int {
+		if i < 0 {
+			return i + length
+		}
+		return i
+	}
+	clamp := func(i, lower, upper int) int {
+		if i < lower {
+			return lower
+		}
+		if i > upper {
+			return upper
+		}
+		return i
+	}
+
+	var result []int
+	if s.step > 0 {
+		start, end := 0, length
+		if s.hasStart {
+			start = clamp(normalize(s.start), 0, length)
+		}
+		if s.hasEnd {
+			end = clamp(normalize(s.end), 0, length)
+		}
+		for i := start; i < end; i += s.step {
+			result = append(result, i)
+			if i > end-s.step {
+				break // 避免 i += step 溢出
+			}
+		}
+		return result
+	}
+
+	start, end := length-1, -1
+	if s.hasStart {
+		start = clamp(normalize(s.start), -1, length-1)
+	}
+	if s.hasEnd {
+		end = clamp(normalize(s.end), -1, length-1)
+	}
+	for i := start; i > end; i += s.step {
+		result = append(result, i)
+		if i < end-s.step {
+			break // 避免 i += step 溢出
+		}
+	}
+	return result
+}
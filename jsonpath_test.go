@@ -0,0 +1,194 @@
+package json2image
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONPathEval(t *testing.T) {
+	var input interface{}
+	document := `{
+		"store": {
+			"book": [
+				{"title": "A", "price": 8},
+				{"title": "B", "price": 12},
+				{"title": "C", "price": 9},
+				{"title": "D", "price": 23}
+			],
+			"bicycle": {"price": 19}
+		},
+		"a.b": {"it's": 1}
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	tests := []struct {
+		rule string
+		want []string
+	}{
+		{"$", []string{""}},
+		{"$.store.bicycle.price", []string{"store.bicycle.price"}},
+		{"$.store.book[*].title", []string{"store.book[0].title", "store.book[1].title", "store.book[2].title", "store.book[3].title"}},
+		{"$.store.book[-1].title", []string{"store.book[3].title"}},
+		{"$.store.book[1:3].title", []string{"store.book[1].title", "store.book[2].title"}},
+		{"$.store.book[::-2].title", []string{"store.book[3].title", "store.book[1].title"}},
+		{"$.store.book[3, 0].title", []string{"store.book[3].title", "store.book[0].title"}},
+		{"$.store.book[9]", nil},
+		{"$..price", []string{"store.bicycle.price", "store.book[0].price", "store.book[1].price", "store.book[2].price", "store.book[3].price"}},
+		{`$['a.b']["it's"]`, []string{`["a.b"].it's`}},
+		{`$["a.b"]['it\'s']`, []string{`["a.b"].it's`}},
+		{"$.store.*", []string{"store.bicycle", "store.book"}},
+	}
+
+	for _, tt := range tests {
+		path, err := parseJSONPath(tt.rule)
+		if err != nil {
+			t.Errorf("parseJSONPath(%q) 失败: %v", tt.rule, err)
+			continue
+		}
+		var got []string
+		for _, node := range path.eval(input) {
+			got = append(got, formatPath(node.path))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s 选中 %q, 期望 %q", tt.rule, got, tt.want)
+		}
+	}
+}
+
+func TestSliceSelectorIndices(t *testing.T) {
+	tests := []struct {
+		slice sliceSelector
+		want  []int
+	}{
+		{sliceSelector{step: 1}, []int{0, 1, 2, 3, 4}},
+		{sliceSelector{start: 1, hasStart: true, end: 3, hasEnd: true, step: 1}, []int{1, 2}},
+		{sliceSelector{start: -2, hasStart: true, step: 1}, []int{3, 4}},
+		{sliceSelector{start: 5, hasStart: true, end: 1, hasEnd: true, step: -2}, []int{4, 2}},
+		{sliceSelector{end: 100, hasEnd: true, step: 3}, []int{0, 3}},
+		{sliceSelector{step: -1}, []int{4, 3, 2, 1, 0}},
+		{sliceSelector{step: 0}, nil},
+		{sliceSelector{start: 1, hasStart: true, step: math.MaxInt64}, []int{1}},
+		{sliceSelector{start: -2, hasStart: true, step: math.MinInt64 + 1}, []int{3}},
+	}
+	for _, tt := range tests {
+		if got := tt.slice.indices(5); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%+v 选中 %v, 期望 %v", tt.slice, got, tt.want)
+		}
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		rule string
+		pos  int
+	}{
+		{"data", 0},
+		{"$.", 2},
+		{"$.1a", 2},
+		{"$[1", 3},
+		{"$[1 2]", 4},
+		{"$['abc", 6},
+		{`$['\x']`, 4},
+		{"$[?]", 3},
+		{"$store", 1},
+		{"$[01]", 2},
+		{"$[-0]", 2},
+		{"$[9007199254740992]", 2},
+		{"$[1::9223372036854775807]", 5},
+	}
+	for _, tt := range tests {
+		_, err := parseJSONPath(tt.rule)
+		var syntaxErr *pathSyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("parseJSONPath(%q) 应返回语法错误, 实际为 %v", tt.rule, err)
+			continue
+		}
+		if syntaxErr.pos != tt.pos {
+			t.Errorf("parseJSONPath(%q) 错误位置为 %d, 期望 %d: %v", tt.rule, syntaxErr.pos, tt.pos, err)
+		}
+	}
+}
+
+func TestCropLargeSliceStep(t *testing.T) {
+	input := map[string]interface{}{"a": []interface{}{1.0, 2.0, 3.0}}
+	for _, rule := range []string{"$.a[1::9007199254740991]", "a[1::9007199254740991]", "$.a[::-9007199254740991]"} {
+		output, err := JsonCrop(input, []string{rule})
+		if err != nil {
+			t.Fatalf("JsonCrop(%q) 失败: %v", rule, err)
+		}
+		want := `{"a":[{},2]}`
+		if strings.HasPrefix(rule, "$.a[::-") {
+			want = `{"a":[{},{},3]}`
+		}
+		if string(output) != want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", rule, output, want)
+		}
+	}
+}
+
+func TestJsonCropWithJSONPath(t *testing.T) {
+	var input map[string]interface{}
+	document := `{
+		"data": {
+			"users": [
+				{"id": 1, "name": "Alice", "tags": ["a", "b", "c"]},
+				{"id": 2, "name": "Bob", "tags": ["d"]}
+			],
+			"total": 2
+		}
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	// JSONPath 规则与等价的点号规则输出一致
+	equivalents := [][2]string{
+		{"data.users[*].name", "$.data.users[*].name"},
+		{"data.users[1].id", "$.data.users[1].id"},
+		{"data.users[0,1].tags", "$.data.users[0,1].tags"},
+	}
+	for _, pair := range equivalents {
+		legacy, err := JsonCrop(input, []string{pair[0]})
+		if err != nil {
+			t.Fatalf("JsonCrop(%q) 失败: %v", pair[0], err)
+		}
+		path, err := JsonCrop(input, []string{pair[1]})
+		if err != nil {
+			t.Fatalf("JsonCrop(%q) 失败: %v", pair[1], err)
+		}
+		if string(legacy) != string(path) {
+			t.Errorf("%s 输出 %s, 期望与 %s 一致: %s", pair[1], path, pair[0], legacy)
+		}
+	}
+
+	// 负下标和后代段
+	output, err := JsonCrop(input, []string{"$.data.users[-1].tags[-1]", "$..total"})
+	if err != nil {
+		t.Fatalf("JsonCrop失败: %v", err)
+	}
+	want := `{"data":{"total":2,"users":[{},{"tags":["d"]}]}}`
+	if string(output) != want {
+		t.Errorf("输出 %s, 期望 %s", output, want)
+	}
+
+	// 根节点
+	output, err = JsonCrop(input, []string{"$"})
+	if err != nil {
+		t.Fatalf("JsonCrop失败: %v", err)
+	}
+	expected, _ := json.Marshal(input)
+	if string(output) != string(expected) {
+		t.Errorf("$ 输出 %s, 期望 %s", output, expected)
+	}
+
+	// 无效规则返回错误
+	if _, err := JsonCrop(input, []string{"$.data["}); err == nil {
+		t.Error("无效的 JSONPath 规则应返回错误")
+	}
+}
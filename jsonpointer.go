@@ -0,0 +1,121 @@
+package json2image
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPointer 按 RFC 6901 解析的 JSON Pointer，如 /users/0/profile/email，各元素为解码后的引用记号
+type jsonPointer []string
+
+// parseJSONPointer 解析以 / 开头的 JSON Pointer，记号中 ~1 表示 /，~0 表示 ~
+func parseJSONPointer(rule string) (jsonPointer, error) {
+	p := &pathParser{rule: rule}
+	if !p.consume("/") {
+		return nil, p.errorf("JSON Pointer 应以 / 开头")
+	}
+
+	ptr := jsonPointer{}
+	var token strings.Builder
+	for !p.eof() {
+		switch c := p.peek(); c {
+		case '/':
+			ptr = append(ptr, token.String())
+			token.Reset()
+			p.pos++
+		case '~':
+			if p.pos+1 >= len(p.rule) || (p.rule[p.pos+1] != '0' && p.rule[p.pos+1] != '1') {
+				return nil, p.errorf("无效的转义，~ 之后只能是 0 或 1")
+			}
+			if p.rule[p.pos+1] == '0' {
+				token.WriteByte('~')
+			} else {
+				token.WriteByte('/')
+			}
+			p.pos += 2
+		default:
+			token.WriteByte(c)
+			p.pos++
+		}
+	}
+	return append(ptr, token.String()), nil
+}
+
+// arrayIndex 将引用记号解析为数组下标，只接受不带前导零的非负整数
+func arrayIndex(token string) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	for i := 0; i < len(token); i++ {
+		if token[i] < '0' || token[i] > '9' {
+			return 0, false
+		}
+	}
+	index, err := strconv.Atoi(token)
+	return index, err == nil
+}
+
+// eval 返回指针指向的节点，节点不存在时返回 false 及中断处的节点
+func (ptr jsonPointer) eval(root interface{}) (pathNode, bool) {
+	node := pathNode{value: root}
+	for _, token := range ptr {
+		switch v := node.value.(type) {
+		case map[string]interface{}:
+			child, exists := v[token]
+			if !exists {
+				return node, false
+			}
+			node = node.child(pathElem{key: token}, child)
+		case []interface{}:
+			index, ok := arrayIndex(token)
+			if !ok || index >= len(v) {
+				return node, false
+			}
+			node = node.child(pathElem{index: index, isIndex: true}, v[index])
+		default:
+			return node, false
+		}
+	}
+	return node, true
+}
+
+// miss 返回指针没有指向任何节点的原因
+func (ptr jsonPointer) miss(root interface{}) *MissReason {
+	node, ok := ptr.eval(root)
+	if ok {
+		return nil
+	}
+	token := ptr[len(node.path)]
+	switch v := node.value.(type) {
+	case map[string]interface{}:
+		return newMissReason(MissKeyNotFound, node.path,
+			fmt.Sprintf("中不存在键 %q", token),
+			fmt.Sprintf("has no key %q", token))
+	case []interface{}:
+		if index, ok := arrayIndex(token); ok {
+			return newMissReason(MissIndexOutOfRange, node.path,
+				fmt.Sprintf("的长度为 %d，下标 %d 越界", len(v), index),
+				fmt.Sprintf("has length %d, index %d is out of range", len(v), index))
+		}
+		return newMissReason(MissTypeMismatch, node.path,
+			fmt.Sprintf("是数组，%q 不是有效的下标", token),
+			fmt.Sprintf("is an array, %q is not a valid index", token))
+	}
+	kind, kindEn := jsonTypeName(node.value)
+	return newMissReason(MissTypeMismatch, node.path, "是"+kind+"，不是对象或数组", "is "+kindEn+", not an object or array")
+}
+
+// formatPointer 将具体路径格式化为 JSON Pointer，根节点为空字符串
+func formatPointer(path []pathElem) string {
+	var b strings.Builder
+	for _, elem := range path {
+		b.WriteByte('/')
+		if elem.isIndex {
+			b.WriteString(strconv.Itoa(elem.index))
+		} else {
+			b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(elem.key))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package json2image
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestJSONPointer(t *testing.T) {
+	var input interface{}
+	document := `{
+		"users": [
+			{"name": "Alice", "profile": {"email": "a@example.com"}},
+			{"name": "Bob", "profile": {"email": "b@example.com"}}
+		],
+		"k8s.io/name": "web",
+		"a~b": 1,
+		"": {"0": "zero"}
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	tests := []struct {
+		pointer string
+		tokens  []string
+		path    string
+		found   bool
+	}{
+		{"/users/0/profile/email", []string{"users", "0", "profile", "email"}, "users[0].profile.email", true},
+		{"/users/1", []string{"users", "1"}, "users[1]", true},
+		{"/k8s.io~1name", []string{"k8s.io/name"}, `["k8s.io/name"]`, true},
+		{"/a~0b", []string{"a~b"}, "a~b", true},
+		{"//0", []string{"", "0"}, `[""].0`, true},
+		{"/users/2", []string{"users", "2"}, "users", false},
+		{"/users/01", []string{"users", "01"}, "users", false},
+		{"/users/-", []string{"users", "-"}, "users", false},
+		{"/users/0/name/first", []string{"users", "0", "name", "first"}, "users[0].name", false},
+	}
+	for _, tt := range tests {
+		ptr, err := parseJSONPointer(tt.pointer)
+		if err != nil {
+			t.Errorf("parseJSONPointer(%q) 失败: %v", tt.pointer, err)
+			continue
+		}
+		if !reflect.DeepEqual([]string(ptr), tt.tokens) {
+			t.Errorf("parseJSONPointer(%q) = %q, 期望 %q", tt.pointer, ptr, tt.tokens)
+		}
+		node, found := ptr.eval(input)
+		if found != tt.found || formatPath(node.path) != tt.path {
+			t.Errorf("%s 指向 %s (%v), 期望 %s (%v)", tt.pointer, formatPath(node.path), found, tt.path, tt.found)
+		}
+		if found && formatPointer(node.path) != tt.pointer {
+			t.Errorf("formatPointer = %s, 期望 %s", formatPointer(node.path), tt.pointer)
+		}
+	}
+}
+
+func TestJSONPointerCrop(t *testing.T) {
+	var input map[string]interface{}
+	document := `{
+		"users": [
+			{"name": "Alice", "profile": {"email": "a@example.com", "age": 25}},
+			{"name": "Bob", "profile": {"email": "b@example.com", "age": 30}}
+		],
+		"labels": {"app.kubernetes.io/name": "web", "tier": "front"}
+	}`
+	if err := json.Unmarshal([]byte(document), &input); err != nil {
+		t.Fatalf("解析输入JSON失败: %v", err)
+	}
+
+	tests := []struct {
+		rules []string
+		want  string
+	}{
+		{[]string{"/users/1/profile/email"}, `{"users":[{},{"profile":{"email":"b@example.com"}}]}`},
+		{[]string{"/labels/app.kubernetes.io~1name", "users[0].name"}, `{"labels":{"app.kubernetes.io/name":"web"},"users":[{"name":"Alice"}]}`},
+		{[]string{"/users/0/profile", "!/users/0/profile/email"}, `{"users":[{"profile":{"age":25}}]}`},
+		{[]string{"!/users/1", "!/labels"}, `{"users":[{"name":"Alice","profile":{"age":25,"email":"a@example.com"}}]}`},
+	}
+	for _, tt := range tests {
+		output, err := JsonCrop(input, tt.rules)
+		if err != nil {
+			t.Errorf("JsonCrop(%q) 失败: %v", tt.rules, err)
+			continue
+		}
+		if string(output) != tt.want {
+			t.Errorf("JsonCrop(%q) = %s, 期望 %s", tt.rules, output, tt.want)
+		}
+	}
+
+	rules := []string{"/users/5/name", "/users/name", "/labels/missing", "/labels/tier/x"}
+	_, report, err := JsonCropWithReport(input, rules)
+	if err != nil {
+		t.Fatalf("JsonCropWithReport失败: %v", err)
+	}
+	misses := []struct {
+		kind    MissKind
+		message string
+	}{
+		{MissIndexOutOfRange, "users 的长度为 2，下标 5 越界"},
+		{MissTypeMismatch, `users 是数组，"name" 不是有效的下标`},
+		{MissKeyNotFound, `labels 中不存在键 "missing"`},
+		{MissTypeMismatch, "labels.tier 是字符串，不是对象或数组"},
+	}
+	for i, tt := range misses {
+		miss := report.Rules[i].Miss
+		if miss == nil || miss.Kind != tt.kind || miss.Message != tt.message {
+			t.Errorf("规则 %s 的原因为 %+v, 期望 %v %q", rules[i], miss, tt.kind, tt.message)
+		}
+	}
+
+	for _, tt := range []struct {
+		rule string
+		pos  int
+	}{
+		{"/users/~2", 7},
+		{"/users~", 6},
+		{"!/a/~", 4},
+	} {
+		_, err := CompileRules([]string{tt.rule})
+		var ruleErr *RuleError
+		if !errors.As(err, &ruleErr) || ruleErr.Pos != tt.pos {
+			t.Errorf("CompileRules(%q) 应在第 %d 个字节处报错, 实际为 %v", tt.rule, tt.pos, err)
+		}
+	}
+}
+
+func TestJson2ImageHighlightPointer(t *testing.T) {
+	jsonData := `{"data": {"items": [{"id": 1, "tags": ["a", "b"]}, {"id": 2, "tags": ["c"]}]}}`
+	config := DefaultConfig().WithHighlightPath("/data/items/1")
+	if _, err := Json2Image(jsonData, config, "output/output_guides_pointer.png"); err != nil {
+		t.Fatalf("生成图片失败: %v", err)
+	}
+	t.Log("JSON Pointer 突出显示图片生成成功：output/output_guides_pointer.png")
+}
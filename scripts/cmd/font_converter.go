@@ -1,54 +1,107 @@
 package main
 
 import (
-	"bufio"
-	"encoding/base64"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font/sfnt"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("\nUsage: go run font_converter.go <font_name>")
+	subset := flag.String("subset", "", "keep only glyphs of the given charset: comma separated ascii, gb2312 or a UTF-8 text file path")
+	name := flag.String("name", "", "registered font name, defaults to the lower-cased file name")
+	out := flag.String("out", "../fonts", "directory holding the generated font sub-packages")
+	flag.Usage = func() {
+		fmt.Println("\nUsage: go run ./cmd [--subset ascii,gb2312,chars.txt] [--name name] [--out dir] <font_name|font_file>")
+		fmt.Println("       go run ./cmd inspect <font_file>")
+		fmt.Println("       go run ./cmd check <font_file> <sample.json>")
 		fmt.Println("\nAvailable fonts:")
 		showAvailableFonts()
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	fontName := os.Args[1]
+	switch flag.Arg(0) {
+	case "inspect":
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		if err := runInspect(resolveFontFile(flag.Arg(1))); err != nil {
+			fmt.Printf("Error inspecting font: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	case "check":
+		if flag.NArg() != 3 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		if err := runCheck(resolveFontFile(flag.Arg(1)), flag.Arg(2)); err != nil {
+			fmt.Printf("Error checking font: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	// 获取当前工作目录
-	workPath, err := os.Getwd()
-	if err != nil {
-		fmt.Printf("Error getting work path: %v\n", err)
-		os.Exit(1)
+	inputFile := resolveFontFile(flag.Arg(0))
+	fontName := strings.TrimSuffix(filepath.Base(inputFile), filepath.Ext(inputFile))
+
+	var charset map[rune]bool
+	if *subset != "" {
+		var err error
+		charset, err = parseCharset(*subset)
+		if err != nil {
+			fmt.Printf("Error parsing subset charset: %v\n", err)
+			os.Exit(1)
+		}
 	}
-	rootPath := filepath.Dir(workPath)
 
-	// 生成文件名
-	fontNameLower := strings.ToLower(fontName)
-	fontNameFirst := titleCase(fontName)
+	// 生成包名
+	packageName := strings.ToLower(fontName)
+	if *name != "" {
+		packageName = strings.ToLower(*name)
+	}
 
-	inputFile := filepath.Join(workPath, "fonts", fontName+".ttf")
-	outputFile := filepath.Join(rootPath, "fonts", fontNameLower+".go")
+	// 每个字体生成独立的子包，导入后在 init 时注册
+	packageDir := filepath.Join(*out, packageName)
+	if err := os.MkdirAll(packageDir, 0755); err != nil {
+		fmt.Printf("Error creating package dir: %v\n", err)
+		os.Exit(1)
+	}
 
 	// 转换字体文件
-	err = convertFont(inputFile, outputFile, fontNameFirst)
+	embedFile, err := convertFont(inputFile, packageDir, packageName, charset)
 	if err != nil {
 		fmt.Printf("Error converting font: %v\n", err)
 		os.Exit(1)
 	}
 
-	fmt.Printf("Successfully converted %s to %s\n", inputFile, outputFile)
+	// 生成注册文件
+	registerFile := filepath.Join(packageDir, "register.go")
+	err = writeRegisterFile(registerFile, packageName, fontName, embedFile)
+	if err != nil {
+		fmt.Printf("Error writing register file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Successfully converted %s to %s\n", inputFile, packageDir)
 }
 
-func titleCase(s string) string {
-	if len(s) == 0 {
-		return s
+// resolveFontFile 参数为已存在的文件路径时直接使用，否则视为 fonts 目录下的字体名称
+func resolveFontFile(arg string) string {
+	if _, err := os.Stat(arg); err == nil {
+		return arg
 	}
-	return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+	return filepath.Join("fonts", arg+".ttf")
 }
 
 func showAvailableFonts() {
@@ -69,113 +122,88 @@ func showAvailableFonts() {
 	}
 }
 
-func convertFont(inputFile, outputFile, fontName string) error {
+// convertFont 预处理字体并写入子包目录，返回被嵌入的字体文件名
+func convertFont(inputFile, packageDir, packageName string, charset map[rune]bool) (string, error) {
 	// 读取字体文件
 	fontData, err := os.ReadFile(inputFile)
 	if err != nil {
-		return fmt.Errorf("failed to read font file: %v", err)
+		return "", fmt.Errorf("failed to read font file: %v", err)
 	}
 
-	// 创建输出文件
-	output, err := os.Create(outputFile)
+	// 仅含 GB2312/GBK 编码表的字体无法被 freetype 加载，需先改写为 Unicode 编码表
+	fontData, converted, err := normalizeCmap(fontData)
 	if err != nil {
-		return fmt.Errorf("failed to create output file: %v", err)
-	}
-	defer output.Close()
-
-	writer := bufio.NewWriter(output)
-	defer writer.Flush()
-
-	// 编码为base64
-	encoded := base64.StdEncoding.EncodeToString(fontData)
-
-	// 对于大文件，拆分成多个常量避免编译器嵌套深度限制
-	const maxConstSize = 50000 // 每个常量最大50KB
-
-	// 写入包头
-	writer.WriteString("package fonts\n\n")
-
-	if len(encoded) <= maxConstSize {
-		// 小文件，使用单个常量
-		writer.WriteString(fmt.Sprintf("const %sFontData = ", fontName))
-		processEncodedData(writer, encoded, 150, 150-13-len(fontName))
-		writer.WriteString("``\n")
-	} else {
-		// 大文件，拆分成多个常量
-		parts := (len(encoded) + maxConstSize - 1) / maxConstSize
-
-		// 生成各个部分的常量
-		for i := 0; i < parts; i++ {
-			start := i * maxConstSize
-			end := start + maxConstSize
-			if end > len(encoded) {
-				end = len(encoded)
-			}
-
-			fontnameVar := fmt.Sprintf("%sFontData%d", fontName, i)
-			writer.WriteString(fmt.Sprintf("const %s = `", fontnameVar))
-			// 直接写入数据，不使用processEncodedData
-			chunk := encoded[start:end]
-			chunkLen := 150
-			pos := 0
-			for pos < len(chunk) {
-				lineEnd := pos + chunkLen - len(fontnameVar) - 5
-				if pos > 0 {
-					lineEnd = pos + chunkLen
-				}
-				if lineEnd > len(chunk) {
-					lineEnd = len(chunk)
-				}
-				if pos > 0 {
-					writer.WriteString(" +\n `")
-				}
-				writer.WriteString(chunk[pos:lineEnd])
-				writer.WriteString("`")
-				pos = lineEnd
-			}
-			writer.WriteString("\n\n")
-		}
+		return "", fmt.Errorf("failed to normalize cmap: %v", err)
+	}
+	if converted {
+		fmt.Println("Rewrote legacy PRC cmap to a Unicode (3,10) format 12 subtable")
+	}
 
-		// 生成主常量，连接所有部分
-		writer.WriteString(fmt.Sprintf("const %sFontData = ", fontName))
-		for i := 0; i < parts; i++ {
-			if i > 0 {
-				writer.WriteString(" + ")
-			}
-			writer.WriteString(fmt.Sprintf("%sFontData%d", fontName, i))
+	// 子集化，只保留字符集中字符的字形
+	if charset != nil {
+		originalSize := len(fontData)
+		subsetData, oldGlyphs, newGlyphs, err := subsetFont(fontData, charset)
+		if err != nil {
+			return "", fmt.Errorf("failed to subset font: %v", err)
 		}
-		writer.WriteString("\n")
+		fontData = subsetData
+		fmt.Printf("Subset: %d -> %d glyphs, %d -> %d bytes (saved %.1f%%)\n",
+			oldGlyphs, newGlyphs, originalSize, len(fontData),
+			100*(1-float64(len(fontData))/float64(originalSize)))
+	}
+
+	if err := validateFont(fontData); err != nil {
+		return "", err
+	}
+
+	// 写入字体文件，由 register.go 通过 go:embed 嵌入
+	embedFile := packageName + strings.ToLower(filepath.Ext(inputFile))
+	if err := os.WriteFile(filepath.Join(packageDir, embedFile), fontData, 0644); err != nil {
+		return "", fmt.Errorf("failed to write font file: %v", err)
+	}
+	return embedFile, nil
+}
+
+// validateFont 检查字体能被渲染时使用的解析器加载
+func validateFont(data []byte) error {
+	f, err := sfnt.Parse(data)
+	if err != nil {
+		return fmt.Errorf("font does not parse: %v", err)
+	}
+	var buf sfnt.Buffer
+	if index, err := f.GlyphIndex(&buf, '{'); err != nil || index == 0 {
+		return fmt.Errorf("font has no glyph for '{', it cannot render JSON")
 	}
 
+	if _, err := truetype.Parse(data); err != nil {
+		fmt.Printf("Note: freetype cannot parse this font (%v), it will be rendered via sfnt\n", err)
+	}
 	return nil
 }
 
-func processEncodedData(writer *bufio.Writer, encoded string, lineLen, firstLineLen int) {
-	pos := 0
-	dataLen := len(encoded)
-	isFirst := true
-
-	for pos < dataLen {
-		var chunkLen int
-		if isFirst {
-			chunkLen = firstLineLen
-			isFirst = false
-		} else {
-			chunkLen = lineLen
-		}
+// writeRegisterFile 生成通过 go:embed 嵌入字体并在 init 时向 fonts 包注册的文件
+func writeRegisterFile(registerFile, packageName, displayName, embedFile string) error {
+	content := fmt.Sprintf(`// Code generated by font_converter. DO NOT EDIT.
 
-		end := pos + chunkLen
-		if end > dataLen {
-			end = dataLen
-		}
+// Package %s 内置%s字体，导入该包即在 init 时完成注册。
+package %s
 
-		chunk := encoded[pos:end]
-		if end >= dataLen {
-			// 最后一块，不加 +
-			writer.WriteString(fmt.Sprintf(" `%s` ", chunk))
-		} else {
-			writer.WriteString(fmt.Sprintf(" `%s` +\n", chunk))
-		}
-		pos = end
+import (
+	_ "embed"
+
+	"github.com/BeCrafter/json2image/fonts"
+)
+
+//go:embed %s
+var fontData []byte
+
+func init() {
+	fonts.Register(%q, fontData)
+}
+`, packageName, displayName, packageName, embedFile, packageName)
+
+	if err := os.WriteFile(registerFile, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write register file: %v", err)
 	}
+	return nil
 }
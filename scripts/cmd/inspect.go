@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"unicode"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// unicodeBlock 统计覆盖率的 Unicode 区块
+type unicodeBlock struct {
+	name       string
+	start, end rune
+}
+
+// inspectBlocks JSON 渲染中常见的 Unicode 区块
+var inspectBlocks = []unicodeBlock{
+	{"Basic Latin", 0x0020, 0x007E},
+	{"Latin-1 Supplement", 0x00A0, 0x00FF},
+	{"Latin Extended-A", 0x0100, 0x017F},
+	{"Greek and Coptic", 0x0370, 0x03FF},
+	{"Cyrillic", 0x0400, 0x04FF},
+	{"General Punctuation", 0x2000, 0x206F},
+	{"Arrows", 0x2190, 0x21FF},
+	{"Mathematical Operators", 0x2200, 0x22FF},
+	{"Box Drawing", 0x2500, 0x257F},
+	{"Geometric Shapes", 0x25A0, 0x25FF},
+	{"Miscellaneous Symbols", 0x2600, 0x26FF},
+	{"CJK Symbols and Punctuation", 0x3000, 0x303F},
+	{"Hiragana", 0x3040, 0x309F},
+	{"Katakana", 0x30A0, 0x30FF},
+	{"CJK Unified Ideographs", 0x4E00, 0x9FFF},
+	{"Hangul Syllables", 0xAC00, 0xD7AF},
+	{"Halfwidth and Fullwidth Forms", 0xFF00, 0xFFEF},
+	{"Emoji (Misc Symbols and Pictographs)", 0x1F300, 0x1F5FF},
+}
+
+// blockCoverage 区块覆盖情况
+type blockCoverage struct {
+	block   unicodeBlock
+	covered int
+	total   int
+}
+
+// fontReport 字体检查结果
+type fontReport struct {
+	family       string
+	style        string
+	unitsPerEm   int
+	ascent       int
+	descent      int
+	lineGap      int
+	monospace    bool
+	isFixedPitch bool
+	numGlyphs    int
+	coverage     []blockCoverage
+}
+
+// parseFonts 解析字体文件，字体集合（.ttc）返回其中的所有字体
+func parseFonts(data []byte) ([]*sfnt.Font, error) {
+	if !bytes.HasPrefix(data, []byte("ttcf")) {
+		// 与转换时一致，仅含 GB2312/GBK 编码表的字体先改写为 Unicode 编码表
+		data, _, err := normalizeCmap(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize cmap: %v", err)
+		}
+		f, err := sfnt.Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("font does not parse: %v", err)
+		}
+		return []*sfnt.Font{f}, nil
+	}
+
+	collection, err := sfnt.ParseCollection(data)
+	if err != nil {
+		return nil, fmt.Errorf("font collection does not parse: %v", err)
+	}
+	fonts := make([]*sfnt.Font, collection.NumFonts())
+	for i := range fonts {
+		if fonts[i], err = collection.Font(i); err != nil {
+			return nil, fmt.Errorf("font %d in collection does not parse: %v", i, err)
+		}
+	}
+	return fonts, nil
+}
+
+// hasGlyph 判断字体是否包含字符对应的字形
+func hasGlyph(f *sfnt.Font, buf *sfnt.Buffer, r rune) bool {
+	index, err := f.GlyphIndex(buf, r)
+	return err == nil && index != 0
+}
+
+// inspectFont 读取字体的名称、度量和字符覆盖情况
+func inspectFont(f *sfnt.Font) (*fontReport, error) {
+	var buf sfnt.Buffer
+	report := &fontReport{
+		unitsPerEm: int(f.UnitsPerEm()),
+		numGlyphs:  f.NumGlyphs(),
+	}
+	report.family, _ = f.Name(&buf, sfnt.NameIDFamily)
+	report.style, _ = f.Name(&buf, sfnt.NameIDSubfamily)
+	if post := f.PostTable(); post != nil {
+		report.isFixedPitch = post.IsFixedPitch
+	}
+
+	// 以 unitsPerEm 作为字号，得到的度量即为字体设计单位
+	ppem := fixed.I(report.unitsPerEm)
+	metrics, err := f.Metrics(&buf, ppem, font.HintingNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics: %v", err)
+	}
+	report.ascent = metrics.Ascent.Round()
+	report.descent = metrics.Descent.Round()
+	report.lineGap = (metrics.Height - metrics.Ascent - metrics.Descent).Round()
+
+	// 可打印ASCII字符的宽度全部相同即视为等宽
+	report.monospace = true
+	advance := fixed.Int26_6(-1)
+	for r := rune(0x21); r < 0x7F; r++ {
+		index, err := f.GlyphIndex(&buf, r)
+		if err != nil || index == 0 {
+			continue
+		}
+		a, err := f.GlyphAdvance(&buf, index, ppem, font.HintingNone)
+		if err != nil {
+			continue
+		}
+		if advance >= 0 && a != advance {
+			report.monospace = false
+			break
+		}
+		advance = a
+	}
+
+	for _, block := range inspectBlocks {
+		coverage := blockCoverage{block: block}
+		for r := block.start; r <= block.end; r++ {
+			// 只统计已分配的字符
+			if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+				continue
+			}
+			coverage.total++
+			if hasGlyph(f, &buf, r) {
+				coverage.covered++
+			}
+		}
+		report.coverage = append(report.coverage, coverage)
+	}
+	return report, nil
+}
+
+// printFontReport 输出字体检查结果
+func printFontReport(w io.Writer, report *fontReport) {
+	yesNo := map[bool]string{true: "yes", false: "no"}
+	fmt.Fprintf(w, "Family:        %s\n", report.family)
+	fmt.Fprintf(w, "Style:         %s\n", report.style)
+	fmt.Fprintf(w, "Units per em:  %d\n", report.unitsPerEm)
+	fmt.Fprintf(w, "Ascent:        %d\n", report.ascent)
+	fmt.Fprintf(w, "Descent:       %d\n", report.descent)
+	fmt.Fprintf(w, "Line gap:      %d\n", report.lineGap)
+	fmt.Fprintf(w, "Monospace:     %s (post.isFixedPitch: %s)\n", yesNo[report.monospace], yesNo[report.isFixedPitch])
+	fmt.Fprintf(w, "Glyphs:        %d\n", report.numGlyphs)
+	fmt.Fprintf(w, "Coverage:\n")
+	for _, c := range report.coverage {
+		percent := 0.0
+		if c.total > 0 {
+			percent = 100 * float64(c.covered) / float64(c.total)
+		}
+		fmt.Fprintf(w, "    %-38s U+%04X-U+%04X  %5d/%-5d %5.1f%%\n",
+			c.block.name, c.block.start, c.block.end, c.covered, c.total, percent)
+	}
+}
+
+// runInspect 执行 inspect 子命令
+func runInspect(fontFile string) error {
+	data, err := os.ReadFile(fontFile)
+	if err != nil {
+		return fmt.Errorf("failed to read font file: %v", err)
+	}
+	fonts, err := parseFonts(data)
+	if err != nil {
+		return err
+	}
+
+	for i, f := range fonts {
+		if len(fonts) > 1 {
+			fmt.Printf("\nFont #%d\n", i)
+		}
+		report, err := inspectFont(f)
+		if err != nil {
+			return err
+		}
+		printFontReport(os.Stdout, report)
+	}
+	return nil
+}
+
+// missingRune 字体无法渲染的字符及其出现次数
+type missingRune struct {
+	r     rune
+	count int
+}
+
+// missingRunes 按渲染时的格式化结果统计样例JSON中字体缺少字形的字符
+func missingRunes(f *sfnt.Font, sample []byte) ([]missingRune, error) {
+	var value interface{}
+	if err := json.Unmarshal(sample, &value); err != nil {
+		return nil, fmt.Errorf("failed to parse sample JSON: %v", err)
+	}
+
+	var formatted bytes.Buffer
+	encoder := json.NewEncoder(&formatted)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(value); err != nil {
+		return nil, fmt.Errorf("failed to format sample JSON: %v", err)
+	}
+
+	var buf sfnt.Buffer
+	counts := make(map[rune]int)
+	for _, r := range formatted.String() {
+		if r == '\n' || r == '\r' || r == '\t' || hasGlyph(f, &buf, r) {
+			continue
+		}
+		counts[r]++
+	}
+
+	missing := make([]missingRune, 0, len(counts))
+	for r, count := range counts {
+		missing = append(missing, missingRune{r: r, count: count})
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i].r < missing[j].r })
+	return missing, nil
+}
+
+// runCheck 执行 check 子命令，存在无法渲染的字符时返回错误
+func runCheck(fontFile, sampleFile string) error {
+	data, err := os.ReadFile(fontFile)
+	if err != nil {
+		return fmt.Errorf("failed to read font file: %v", err)
+	}
+	fonts, err := parseFonts(data)
+	if err != nil {
+		return err
+	}
+	sample, err := os.ReadFile(sampleFile)
+	if err != nil {
+		return fmt.Errorf("failed to read sample file: %v", err)
+	}
+
+	missing, err := missingRunes(fonts[0], sample)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		fmt.Printf("All characters in %s can be rendered by %s\n", sampleFile, fontFile)
+		return nil
+	}
+
+	fmt.Printf("%d distinct characters in %s cannot be rendered by %s:\n", len(missing), sampleFile, fontFile)
+	for _, m := range missing {
+		fmt.Printf("    U+%04X  %q  x%d\n", m.r, m.r, m.count)
+	}
+	return fmt.Errorf("%d characters missing", len(missing))
+}
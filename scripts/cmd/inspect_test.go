@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"testing"
+)
+
+func TestInspectFont(t *testing.T) {
+	data, err := os.ReadFile("../fonts/Monaco.ttf")
+	if err != nil {
+		t.Fatalf("Failed to read font: %v", err)
+	}
+	fonts, err := parseFonts(data)
+	if err != nil {
+		t.Fatalf("parseFonts failed: %v", err)
+	}
+
+	report, err := inspectFont(fonts[0])
+	if err != nil {
+		t.Fatalf("inspectFont failed: %v", err)
+	}
+	if report.family != "Monaco" {
+		t.Errorf("Expected family Monaco, got %q", report.family)
+	}
+	if !report.monospace {
+		t.Error("Expected Monaco to be monospace")
+	}
+	if report.unitsPerEm == 0 || report.ascent <= 0 || report.numGlyphs == 0 {
+		t.Errorf("Unexpected metrics: %+v", report)
+	}
+
+	basicLatin := report.coverage[0]
+	if basicLatin.covered != basicLatin.total {
+		t.Errorf("Expected full Basic Latin coverage, got %d/%d", basicLatin.covered, basicLatin.total)
+	}
+}
+
+func TestMissingRunes(t *testing.T) {
+	data, err := os.ReadFile("../fonts/Monaco.ttf")
+	if err != nil {
+		t.Fatalf("Failed to read font: %v", err)
+	}
+	fonts, err := parseFonts(data)
+	if err != nil {
+		t.Fatalf("parseFonts failed: %v", err)
+	}
+
+	missing, err := missingRunes(fonts[0], []byte(`{"name": "中文", "list": ["文", "\u4e2d"]}`))
+	if err != nil {
+		t.Fatalf("missingRunes failed: %v", err)
+	}
+	if len(missing) != 2 {
+		t.Fatalf("Expected 2 missing runes, got %v", missing)
+	}
+	// 转义的 中 按渲染后的字符统计
+	if missing[0].r != '中' || missing[0].count != 2 || missing[1].r != '文' || missing[1].count != 2 {
+		t.Errorf("Unexpected missing runes: %v", missing)
+	}
+
+	if _, err := missingRunes(fonts[0], []byte(`{invalid`)); err == nil {
+		t.Error("Expected error for invalid sample JSON")
+	}
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// sfntFont 解析后的字体文件，仅保留表目录和各表的原始数据
+type sfntFont struct {
+	version uint32
+	tables  map[string][]byte
+}
+
+// parseSFNT 解析TrueType/OpenType字体的表目录
+func parseSFNT(data []byte) (*sfntFont, error) {
+	if len(data) < 12 {
+		return nil, fmt.Errorf("font file too short")
+	}
+
+	f := &sfntFont{
+		version: binary.BigEndian.Uint32(data[0:4]),
+		tables:  make(map[string][]byte),
+	}
+	numTables := int(binary.BigEndian.Uint16(data[4:6]))
+	if len(data) < 12+numTables*16 {
+		return nil, fmt.Errorf("truncated table directory")
+	}
+
+	for i := 0; i < numTables; i++ {
+		record := data[12+i*16 : 28+i*16]
+		tag := string(record[0:4])
+		offset := binary.BigEndian.Uint32(record[8:12])
+		length := binary.BigEndian.Uint32(record[12:16])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return nil, fmt.Errorf("table %q out of bounds", tag)
+		}
+		f.tables[tag] = data[offset : offset+length]
+	}
+	return f, nil
+}
+
+// bytes 重新生成字体文件，表按标签排序、4字节对齐，并重新计算校验和
+func (f *sfntFont) bytes() []byte {
+	tags := make([]string, 0, len(f.tables))
+	for tag := range f.tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	numTables := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= numTables {
+		entrySelector++
+	}
+	searchRange := (1 << entrySelector) * 16
+
+	header := make([]byte, 12+numTables*16)
+	binary.BigEndian.PutUint32(header[0:4], f.version)
+	binary.BigEndian.PutUint16(header[4:6], uint16(numTables))
+	binary.BigEndian.PutUint16(header[6:8], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:10], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:12], uint16(numTables*16-searchRange))
+
+	out := header
+	headOffset := -1
+	for i, tag := range tags {
+		table := f.tables[tag]
+		if tag == "head" && len(table) >= 12 {
+			// 计算校验和前需将 checkSumAdjustment 置零
+			table = append([]byte(nil), table...)
+			binary.BigEndian.PutUint32(table[8:12], 0)
+			headOffset = len(out)
+		}
+
+		record := out[12+i*16 : 28+i*16]
+		copy(record[0:4], tag)
+		binary.BigEndian.PutUint32(record[4:8], tableChecksum(table))
+		binary.BigEndian.PutUint32(record[8:12], uint32(len(out)))
+		binary.BigEndian.PutUint32(record[12:16], uint32(len(table)))
+
+		out = append(out, table...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(out[headOffset+8:headOffset+12], 0xB1B0AFBA-tableChecksum(out))
+	}
+	return out
+}
+
+// tableChecksum 按大端uint32累加计算表校验和
+func tableChecksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// cmapSubtable cmap 表中的一个编码子表
+type cmapSubtable struct {
+	platformID uint16
+	encodingID uint16
+	format     uint16
+	data       []byte
+}
+
+// parseCmapSubtables 列出 cmap 表中的所有子表
+func parseCmapSubtables(cmap []byte) ([]cmapSubtable, error) {
+	if len(cmap) < 4 {
+		return nil, fmt.Errorf("cmap table too short")
+	}
+	numTables := int(binary.BigEndian.Uint16(cmap[2:4]))
+	if len(cmap) < 4+numTables*8 {
+		return nil, fmt.Errorf("truncated cmap table")
+	}
+
+	subtables := make([]cmapSubtable, 0, numTables)
+	for i := 0; i < numTables; i++ {
+		record := cmap[4+i*8 : 12+i*8]
+		offset := binary.BigEndian.Uint32(record[4:8])
+		if int(offset)+2 > len(cmap) {
+			return nil, fmt.Errorf("cmap subtable %d out of bounds", i)
+		}
+		subtables = append(subtables, cmapSubtable{
+			platformID: binary.BigEndian.Uint16(record[0:2]),
+			encodingID: binary.BigEndian.Uint16(record[2:4]),
+			format:     binary.BigEndian.Uint16(cmap[offset : offset+2]),
+			data:       cmap[offset:],
+		})
+	}
+	return subtables, nil
+}
+
+// hasUnicodeCmap 判断字体是否已包含 freetype 与 sfnt 都能识别的 Unicode 子表
+func hasUnicodeCmap(subtables []cmapSubtable) bool {
+	for _, st := range subtables {
+		unicode := st.platformID == 0 || (st.platformID == 3 && (st.encodingID == 1 || st.encodingID == 10))
+		if unicode && (st.format == 4 || st.format == 12) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeCmapFormat2 解析 format 2（高字节映射）子表，返回 字符编码 -> 字形 的映射
+func decodeCmapFormat2(data []byte) (map[uint16]uint16, error) {
+	if len(data) < 6+512 {
+		return nil, fmt.Errorf("cmap format 2 subtable too short")
+	}
+
+	readU16 := func(off int) (uint16, error) {
+		if off < 0 || off+2 > len(data) {
+			return 0, fmt.Errorf("cmap format 2 offset %d out of bounds", off)
+		}
+		return binary.BigEndian.Uint16(data[off : off+2]), nil
+	}
+
+	const subHeadersStart = 6 + 512
+	glyphs := make(map[uint16]uint16)
+	for high := 0; high < 256; high++ {
+		key, _ := readU16(6 + high*2)
+		subHeader := subHeadersStart + int(key)
+
+		firstCode, err := readU16(subHeader)
+		if err != nil {
+			return nil, err
+		}
+		entryCount, _ := readU16(subHeader + 2)
+		idDelta, _ := readU16(subHeader + 4)
+		idRangeOffset, _ := readU16(subHeader + 6)
+		// idRangeOffset 相对于其自身所在位置
+		rangeStart := subHeader + 6 + int(idRangeOffset)
+
+		if key == 0 {
+			// 单字节编码，仅使用 subHeader 0 中对应 high 的一项
+			if high < int(firstCode) || high >= int(firstCode)+int(entryCount) {
+				continue
+			}
+			glyph, err := readU16(rangeStart + (high-int(firstCode))*2)
+			if err != nil {
+				return nil, err
+			}
+			if glyph != 0 {
+				glyphs[uint16(high)] = glyph + idDelta
+			}
+			continue
+		}
+
+		for i := 0; i < int(entryCount); i++ {
+			glyph, err := readU16(rangeStart + i*2)
+			if err != nil {
+				return nil, err
+			}
+			if glyph != 0 {
+				glyphs[uint16(high)<<8|(firstCode+uint16(i))] = glyph + idDelta
+			}
+		}
+	}
+	return glyphs, nil
+}
+
+// gbkToUnicode 将 GB2312/GBK 编码的 cmap 映射转换为 Unicode 映射
+func gbkToUnicode(codes map[uint16]uint16) map[rune]uint16 {
+	decoder := simplifiedchinese.GBK.NewDecoder()
+	result := make(map[rune]uint16, len(codes))
+	for code, glyph := range codes {
+		var raw []byte
+		if code < 0x100 {
+			if code >= 0x80 {
+				continue
+			}
+			raw = []byte{byte(code)}
+		} else {
+			raw = []byte{byte(code >> 8), byte(code)}
+		}
+
+		decoded, err := decoder.Bytes(raw)
+		if err != nil {
+			continue
+		}
+		r, size := utf8.DecodeRune(decoded)
+		if r == utf8.RuneError || size != len(decoded) {
+			continue
+		}
+		result[r] = glyph
+	}
+	return result
+}
+
+// buildCmapFormat12 生成只包含 (3,10) format 12 子表的 cmap 表
+func buildCmapFormat12(glyphs map[rune]uint16) []byte {
+	runes := make([]rune, 0, len(glyphs))
+	for r := range glyphs {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+
+	// 合并字符与字形都连续的区间
+	type group struct {
+		start, end rune
+		glyph      uint16
+	}
+	var groups []group
+	for _, r := range runes {
+		glyph := glyphs[r]
+		if n := len(groups); n > 0 {
+			last := &groups[n-1]
+			if r == last.end+1 && uint32(glyph) == uint32(last.glyph)+uint32(r-last.start) {
+				last.end = r
+				continue
+			}
+		}
+		groups = append(groups, group{start: r, end: r, glyph: glyph})
+	}
+
+	subtableLen := 16 + len(groups)*12
+	out := make([]byte, 12+subtableLen)
+	binary.BigEndian.PutUint16(out[0:2], 0) // version
+	binary.BigEndian.PutUint16(out[2:4], 1) // numTables
+	binary.BigEndian.PutUint16(out[4:6], 3) // platformID: Windows
+	binary.BigEndian.PutUint16(out[6:8], 10)
+	binary.BigEndian.PutUint32(out[8:12], 12)
+
+	sub := out[12:]
+	binary.BigEndian.PutUint16(sub[0:2], 12)
+	binary.BigEndian.PutUint32(sub[4:8], uint32(subtableLen))
+	binary.BigEndian.PutUint32(sub[12:16], uint32(len(groups)))
+	for i, g := range groups {
+		rec := sub[16+i*12 : 28+i*12]
+		binary.BigEndian.PutUint32(rec[0:4], uint32(g.start))
+		binary.BigEndian.PutUint32(rec[4:8], uint32(g.end))
+		binary.BigEndian.PutUint32(rec[8:12], uint32(g.glyph))
+	}
+	return out
+}
+
+// normalizeCmap 将仅包含 GB2312/GBK 编码子表的字体改写为 Unicode 子表，
+// 使其能被 freetype 与 sfnt 加载；已有 Unicode 子表的字体原样返回
+func normalizeCmap(data []byte) ([]byte, bool, error) {
+	font, err := parseSFNT(data)
+	if err != nil {
+		return nil, false, err
+	}
+	cmap, ok := font.tables["cmap"]
+	if !ok {
+		return nil, false, fmt.Errorf("missing cmap table")
+	}
+
+	subtables, err := parseCmapSubtables(cmap)
+	if err != nil {
+		return nil, false, err
+	}
+	if hasUnicodeCmap(subtables) {
+		return data, false, nil
+	}
+
+	for _, st := range subtables {
+		// (3,3) 为 Windows PRC 编码，即 GB2312/GBK
+		if st.platformID != 3 || st.encodingID != 3 || st.format != 2 {
+			continue
+		}
+		codes, err := decodeCmapFormat2(st.data)
+		if err != nil {
+			return nil, false, err
+		}
+		glyphs := gbkToUnicode(codes)
+		if len(glyphs) == 0 {
+			return nil, false, fmt.Errorf("no unicode mappings recovered from PRC cmap")
+		}
+		font.tables["cmap"] = buildCmapFormat12(glyphs)
+		return font.bytes(), true, nil
+	}
+
+	return nil, false, fmt.Errorf("no supported cmap subtable found")
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// subsetKeepTables 子集化后保留的表，其余依赖字形序号的表（GSUB、GPOS、kern 等）会被丢弃
+var subsetKeepTables = map[string]bool{
+	"head": true,
+	"hhea": true,
+	"maxp": true,
+	"OS/2": true,
+	"name": true,
+	"cmap": true,
+	"glyf": true,
+	"loca": true,
+	"hmtx": true,
+	"post": true,
+	"cvt ": true,
+	"fpgm": true,
+	"prep": true,
+	"gasp": true,
+}
+
+// 复合字形的组件标志位
+const (
+	compositeArgsAreWords = 0x0001
+	compositeHaveScale    = 0x0008
+	compositeMoreComps    = 0x0020
+	compositeHaveXYScale  = 0x0040
+	compositeHave2x2      = 0x0080
+)
+
+// parseCharset 解析字符集参数，逗号分隔，可选 ascii、gb2312 或字符文本文件路径
+func parseCharset(spec string) (map[rune]bool, error) {
+	charset := make(map[rune]bool)
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		switch strings.ToLower(item) {
+		case "":
+			continue
+		case "ascii":
+			for r := rune(0x20); r < 0x7F; r++ {
+				charset[r] = true
+			}
+		case "gb2312":
+			for r := range gb2312Runes() {
+				charset[r] = true
+			}
+		default:
+			data, err := os.ReadFile(item)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read charset file: %v", err)
+			}
+			if !utf8.Valid(data) {
+				return nil, fmt.Errorf("charset file %s is not valid UTF-8", item)
+			}
+			for _, r := range string(data) {
+				if r != '\n' && r != '\r' {
+					charset[r] = true
+				}
+			}
+		}
+	}
+	if len(charset) == 0 {
+		return nil, fmt.Errorf("empty subset charset")
+	}
+	return charset, nil
+}
+
+// gb2312Runes 返回 GB2312 字符集（区位 0xA1A1-0xF7FE）对应的 Unicode 字符
+func gb2312Runes() map[rune]bool {
+	decoder := simplifiedchinese.GBK.NewDecoder()
+	runes := make(map[rune]bool)
+	for high := 0xA1; high <= 0xF7; high++ {
+		for low := 0xA1; low <= 0xFE; low++ {
+			decoded, err := decoder.Bytes([]byte{byte(high), byte(low)})
+			if err != nil {
+				continue
+			}
+			if r, size := utf8.DecodeRune(decoded); r != utf8.RuneError && size == len(decoded) {
+				runes[r] = true
+			}
+		}
+	}
+	return runes
+}
+
+// subsetFont 只保留字符集中字符的字形，重写 cmap、glyf/loca、hmtx 等表，返回新的字体数据
+func subsetFont(data []byte, charset map[rune]bool) ([]byte, int, int, error) {
+	font, err := parseSFNT(data)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if _, ok := font.tables["CFF "]; ok {
+		return nil, 0, 0, fmt.Errorf("subsetting CFF fonts is not supported")
+	}
+	for _, tag := range []string{"head", "hhea", "maxp", "hmtx", "loca", "glyf"} {
+		if _, ok := font.tables[tag]; !ok {
+			return nil, 0, 0, fmt.Errorf("missing %s table", tag)
+		}
+	}
+
+	head := font.tables["head"]
+	hhea := font.tables["hhea"]
+	maxp := font.tables["maxp"]
+	if len(head) < 54 || len(hhea) < 36 || len(maxp) < 6 {
+		return nil, 0, 0, fmt.Errorf("truncated head, hhea or maxp table")
+	}
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:6]))
+	numHMetrics := int(binary.BigEndian.Uint16(hhea[34:36]))
+	longLoca := binary.BigEndian.Uint16(head[50:52]) == 1
+
+	glyphs, err := splitGlyphs(font.tables["loca"], font.tables["glyf"], numGlyphs, longLoca)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	// 通过 sfnt 查询原字体的 cmap
+	parsed, err := sfnt.Parse(data)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("failed to parse font: %v", err)
+	}
+	var buf sfnt.Buffer
+	oldGlyphs := make(map[rune]int)
+	for r := range charset {
+		index, err := parsed.GlyphIndex(&buf, r)
+		if err == nil && index != 0 {
+			oldGlyphs[r] = int(index)
+		}
+	}
+
+	// 收集需要保留的字形：.notdef、字符集中字符的字形及复合字形引用的组件
+	keep := map[int]bool{0: true}
+	var queue []int
+	for _, index := range oldGlyphs {
+		queue = append(queue, index)
+	}
+	for len(queue) > 0 {
+		index := queue[0]
+		queue = queue[1:]
+		if keep[index] || index >= numGlyphs {
+			continue
+		}
+		keep[index] = true
+		components, err := compositeComponents(glyphs[index])
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("glyph %d: %v", index, err)
+		}
+		queue = append(queue, components...)
+	}
+
+	// 按原顺序重新编号
+	oldIndices := make([]int, 0, len(keep))
+	for index := range keep {
+		oldIndices = append(oldIndices, index)
+	}
+	sort.Ints(oldIndices)
+	newIndex := make(map[int]int, len(oldIndices))
+	for i, old := range oldIndices {
+		newIndex[old] = i
+	}
+
+	// glyf/loca：使用长格式偏移
+	var glyf []byte
+	loca := make([]byte, (len(oldIndices)+1)*4)
+	for i, old := range oldIndices {
+		binary.BigEndian.PutUint32(loca[i*4:], uint32(len(glyf)))
+		glyph, err := remapComponents(glyphs[old], newIndex)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("glyph %d: %v", old, err)
+		}
+		glyf = append(glyf, glyph...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	binary.BigEndian.PutUint32(loca[len(oldIndices)*4:], uint32(len(glyf)))
+
+	// hmtx：每个字形都写入完整的 advanceWidth 和 lsb
+	hmtx := font.tables["hmtx"]
+	newHmtx := make([]byte, len(oldIndices)*4)
+	for i, old := range oldIndices {
+		advance, lsb, err := horizontalMetric(hmtx, numHMetrics, old)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		binary.BigEndian.PutUint16(newHmtx[i*4:], advance)
+		binary.BigEndian.PutUint16(newHmtx[i*4+2:], lsb)
+	}
+
+	newCmap := make(map[rune]uint16, len(oldGlyphs))
+	for r, old := range oldGlyphs {
+		newCmap[r] = uint16(newIndex[old])
+	}
+
+	tables := make(map[string][]byte)
+	for tag, table := range font.tables {
+		if subsetKeepTables[tag] {
+			tables[tag] = table
+		}
+	}
+
+	head = append([]byte(nil), head...)
+	binary.BigEndian.PutUint16(head[50:52], 1)
+	hhea = append([]byte(nil), hhea...)
+	binary.BigEndian.PutUint16(hhea[34:36], uint16(len(oldIndices)))
+	maxp = append([]byte(nil), maxp...)
+	binary.BigEndian.PutUint16(maxp[4:6], uint16(len(oldIndices)))
+
+	tables["head"] = head
+	tables["hhea"] = hhea
+	tables["maxp"] = maxp
+	tables["glyf"] = glyf
+	tables["loca"] = loca
+	tables["hmtx"] = newHmtx
+	tables["cmap"] = buildCmapFormat12(newCmap)
+	if post, ok := tables["post"]; ok && len(post) >= 32 {
+		// post 3.0 不包含字形名称，避免引用旧的字形序号
+		post = append([]byte(nil), post[:32]...)
+		binary.BigEndian.PutUint32(post[0:4], 0x00030000)
+		tables["post"] = post
+	}
+
+	font.tables = tables
+	return font.bytes(), numGlyphs, len(oldIndices), nil
+}
+
+// splitGlyphs 根据 loca 表将 glyf 表拆分为每个字形的数据
+func splitGlyphs(loca, glyf []byte, numGlyphs int, longLoca bool) ([][]byte, error) {
+	offset := func(i int) (int, error) {
+		if longLoca {
+			if (i+1)*4 > len(loca) {
+				return 0, fmt.Errorf("truncated loca table")
+			}
+			return int(binary.BigEndian.Uint32(loca[i*4:])), nil
+		}
+		if (i+1)*2 > len(loca) {
+			return 0, fmt.Errorf("truncated loca table")
+		}
+		return int(binary.BigEndian.Uint16(loca[i*2:])) * 2, nil
+	}
+
+	glyphs := make([][]byte, numGlyphs)
+	for i := 0; i < numGlyphs; i++ {
+		start, err := offset(i)
+		if err != nil {
+			return nil, err
+		}
+		end, err := offset(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		if start > end || end > len(glyf) {
+			return nil, fmt.Errorf("glyph %d out of bounds", i)
+		}
+		glyphs[i] = glyf[start:end]
+	}
+	return glyphs, nil
+}
+
+// forEachComponent 遍历复合字形的组件，回调参数为组件字形序号在字形数据中的偏移
+func forEachComponent(glyph []byte, fn func(indexOffset int)) error {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph[0:2])) >= 0 {
+		return nil
+	}
+
+	pos := 10
+	for {
+		if pos+4 > len(glyph) {
+			return fmt.Errorf("truncated composite glyph")
+		}
+		flags := binary.BigEndian.Uint16(glyph[pos:])
+		fn(pos + 2)
+		pos += 4
+
+		if flags&compositeArgsAreWords != 0 {
+			pos += 4
+		} else {
+			pos += 2
+		}
+		switch {
+		case flags&compositeHaveScale != 0:
+			pos += 2
+		case flags&compositeHaveXYScale != 0:
+			pos += 4
+		case flags&compositeHave2x2 != 0:
+			pos += 8
+		}
+		if flags&compositeMoreComps == 0 {
+			return nil
+		}
+	}
+}
+
+// compositeComponents 返回复合字形引用的组件字形序号，简单字形返回空
+func compositeComponents(glyph []byte) ([]int, error) {
+	var components []int
+	err := forEachComponent(glyph, func(indexOffset int) {
+		components = append(components, int(binary.BigEndian.Uint16(glyph[indexOffset:])))
+	})
+	return components, err
+}
+
+// remapComponents 复制字形数据并将复合字形的组件序号替换为新序号
+func remapComponents(glyph []byte, newIndex map[int]int) ([]byte, error) {
+	out := append([]byte(nil), glyph...)
+	err := forEachComponent(out, func(indexOffset int) {
+		old := int(binary.BigEndian.Uint16(out[indexOffset:]))
+		binary.BigEndian.PutUint16(out[indexOffset:], uint16(newIndex[old]))
+	})
+	return out, err
+}
+
+// horizontalMetric 读取字形的 advanceWidth 和 lsb，超出 numberOfHMetrics 的字形沿用最后一个 advanceWidth
+func horizontalMetric(hmtx []byte, numHMetrics, index int) (uint16, uint16, error) {
+	if numHMetrics == 0 || numHMetrics*4 > len(hmtx) {
+		return 0, 0, fmt.Errorf("invalid hmtx table")
+	}
+	if index < numHMetrics {
+		return binary.BigEndian.Uint16(hmtx[index*4:]), binary.BigEndian.Uint16(hmtx[index*4+2:]), nil
+	}
+
+	advance := binary.BigEndian.Uint16(hmtx[(numHMetrics-1)*4:])
+	lsbOffset := numHMetrics*4 + (index-numHMetrics)*2
+	if lsbOffset+2 > len(hmtx) {
+		return advance, 0, nil
+	}
+	return advance, binary.BigEndian.Uint16(hmtx[lsbOffset:]), nil
+}
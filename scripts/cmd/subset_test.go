@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestSubsetFont(t *testing.T) {
+	data, err := os.ReadFile("../fonts/Monaco.ttf")
+	if err != nil {
+		t.Fatalf("Failed to read font: %v", err)
+	}
+
+	charset, err := parseCharset("ascii")
+	if err != nil {
+		t.Fatalf("parseCharset failed: %v", err)
+	}
+
+	subset, oldGlyphs, newGlyphs, err := subsetFont(data, charset)
+	if err != nil {
+		t.Fatalf("subsetFont failed: %v", err)
+	}
+	if newGlyphs >= oldGlyphs || len(subset) >= len(data) {
+		t.Errorf("Expected subset to shrink: %d -> %d glyphs, %d -> %d bytes", oldGlyphs, newGlyphs, len(data), len(subset))
+	}
+
+	// 子集字体需要能被 sfnt 和 freetype 同时解析
+	parsed, err := sfnt.Parse(subset)
+	if err != nil {
+		t.Fatalf("sfnt failed to parse subset: %v", err)
+	}
+	if parsed.NumGlyphs() != newGlyphs {
+		t.Errorf("Expected %d glyphs, got %d", newGlyphs, parsed.NumGlyphs())
+	}
+	if _, err := truetype.Parse(subset); err != nil {
+		t.Fatalf("freetype failed to parse subset: %v", err)
+	}
+
+	var buf sfnt.Buffer
+	for _, r := range "{}[]\":,azAZ09" {
+		if index, err := parsed.GlyphIndex(&buf, r); err != nil || index == 0 {
+			t.Errorf("Expected glyph for %q in subset", r)
+		}
+	}
+	if index, _ := parsed.GlyphIndex(&buf, 'é'); index != 0 {
+		t.Errorf("Expected no glyph for é in ASCII subset, got %d", index)
+	}
+}
+
+func TestParseCharset(t *testing.T) {
+	charset, err := parseCharset("ascii,gb2312")
+	if err != nil {
+		t.Fatalf("parseCharset failed: %v", err)
+	}
+	for _, r := range "a~中国" {
+		if !charset[r] {
+			t.Errorf("Expected %q in charset", r)
+		}
+	}
+
+	if _, err := parseCharset(" , "); err == nil {
+		t.Error("Expected error for empty charset, got nil")
+	}
+}
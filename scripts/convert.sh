@@ -17,6 +17,6 @@ fi
 
 # 使用Go程序进行转换，性能比bash脚本快10-100倍
 cd ${work_path}
-go run ./cmd/font_converter.go ${font_name} 
+go run ./cmd "$@"
 
-go fmt ${work_path}/../fonts/*
\ No newline at end of file
+gofmt -w ${work_path}/../fonts
\ No newline at end of file
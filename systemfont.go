@@ -0,0 +1,302 @@
+package json2image
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+// name 表中使用的名称ID
+const (
+	nameIDFamily            = 1
+	nameIDSubfamily         = 2
+	nameIDFullName          = 4
+	nameIDTypographicFamily = 16
+	nameIDTypographicStyle  = 17
+)
+
+// regularStyles 视为常规字重的样式名称
+var regularStyles = map[string]bool{
+	"regular": true,
+	"book":    true,
+	"normal":  true,
+	"roman":   true,
+}
+
+// SystemFont 系统中已安装的字体
+type SystemFont struct {
+	Family   string // Family 字体族名
+	Style    string // Style 样式名，如 Regular、Bold
+	FullName string // FullName 完整名称
+	Path     string // Path 字体文件路径
+	Index    int    // Index 字体在字体集合（.ttc）中的序号
+}
+
+var (
+	systemFontsOnce sync.Once
+	systemFonts     []SystemFont
+)
+
+// systemFontDirs 返回Linux标准字体目录
+func systemFontDirs() []string {
+	dirs := []string{"/usr/share/fonts", "/usr/local/share/fonts"}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs,
+			filepath.Join(home, ".local", "share", "fonts"),
+			filepath.Join(home, ".fonts"),
+		)
+	}
+	return dirs
+}
+
+// SystemFonts 返回系统字体索引，首次调用时扫描字体目录并缓存结果
+func SystemFonts() []SystemFont {
+	systemFontsOnce.Do(func() {
+		systemFonts = scanSystemFonts(systemFontDirs())
+	})
+	return systemFonts
+}
+
+// scanSystemFonts 递归扫描目录下的字体文件并读取其名称信息，结果按路径排序
+func scanSystemFonts(dirs []string) []SystemFont {
+	var result []SystemFont
+	for _, dir := range dirs {
+		filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !isFontFileExt(filepath.Ext(path)) {
+				return nil
+			}
+			if found, err := readSystemFonts(path); err == nil {
+				result = append(result, found...)
+			}
+			return nil
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Index < result[j].Index
+	})
+	return result
+}
+
+// readSystemFonts 读取字体文件中每个字体的族名、样式和完整名称，字体集合（.ttc）包含多个字体
+func readSystemFonts(path string) ([]SystemFont, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, err := readFontOffsets(file)
+	if err != nil {
+		return nil, fmt.Errorf("读取字体失败 %s: %v", path, err)
+	}
+
+	var fonts []SystemFont
+	for index, offset := range offsets {
+		names, err := readFontNames(file, info.Size(), offset)
+		if err != nil {
+			return nil, fmt.Errorf("读取字体名称失败 %s: %v", path, err)
+		}
+		font, err := newSystemFont(names, path, index)
+		if err != nil {
+			return nil, err
+		}
+		fonts = append(fonts, font)
+	}
+	return fonts, nil
+}
+
+// readFontOffsets 返回文件中各字体表目录的偏移，普通字体文件只有一个偏移 0
+func readFontOffsets(r io.ReaderAt) ([]int64, error) {
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, err
+	}
+	if string(header[0:4]) != "ttcf" {
+		return []int64{0}, nil
+	}
+
+	numFonts := int(binary.BigEndian.Uint32(header[8:12]))
+	if numFonts <= 0 || numFonts > 1024 {
+		return nil, fmt.Errorf("字体集合中的字体数量无效: %d", numFonts)
+	}
+	raw := make([]byte, numFonts*4)
+	if _, err := r.ReadAt(raw, 12); err != nil {
+		return nil, err
+	}
+
+	offsets := make([]int64, numFonts)
+	for i := range offsets {
+		offsets[i] = int64(binary.BigEndian.Uint32(raw[i*4:]))
+	}
+	return offsets, nil
+}
+
+// newSystemFont 根据 name 表中的名称创建系统字体记录
+func newSystemFont(names map[uint16]string, path string, index int) (SystemFont, error) {
+	font := SystemFont{
+		Family:   names[nameIDTypographicFamily],
+		Style:    names[nameIDTypographicStyle],
+		FullName: names[nameIDFullName],
+		Path:     path,
+		Index:    index,
+	}
+	if font.Family == "" {
+		font.Family = names[nameIDFamily]
+	}
+	if font.Style == "" {
+		font.Style = names[nameIDSubfamily]
+	}
+	if font.Family == "" {
+		return SystemFont{}, fmt.Errorf("字体缺少族名: %s", path)
+	}
+	return font, nil
+}
+
+// readFontNames 只读取位于 offset 的表目录和 name 表，返回 名称ID -> 名称。
+// size 为文件大小，超出文件范围的表直接视为损坏，避免按文件中的长度分配过大的内存
+func readFontNames(r io.ReaderAt, size, offset int64) (map[uint16]string, error) {
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, offset); err != nil {
+		return nil, err
+	}
+	numTables := int(binary.BigEndian.Uint16(header[4:6]))
+
+	records := make([]byte, numTables*16)
+	if _, err := r.ReadAt(records, offset+12); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < numTables; i++ {
+		record := records[i*16 : i*16+16]
+		if string(record[0:4]) != "name" {
+			continue
+		}
+		// 字体集合中的表偏移同样相对于文件开头
+		tableOffset := binary.BigEndian.Uint32(record[8:12])
+		length := binary.BigEndian.Uint32(record[12:16])
+		if int64(tableOffset)+int64(length) > size {
+			return nil, fmt.Errorf("name 表超出文件范围")
+		}
+		table := make([]byte, length)
+		if _, err := r.ReadAt(table, int64(tableOffset)); err != nil {
+			return nil, err
+		}
+		return parseNameTable(table)
+	}
+	return nil, fmt.Errorf("缺少 name 表")
+}
+
+// parseNameTable 解析 name 表，返回 名称ID -> 名称
+func parseNameTable(table []byte) (map[uint16]string, error) {
+	if len(table) < 6 {
+		return nil, fmt.Errorf("name 表长度不足")
+	}
+	count := int(binary.BigEndian.Uint16(table[2:4]))
+	storage := int(binary.BigEndian.Uint16(table[4:6]))
+	if len(table) < 6+count*12 {
+		return nil, fmt.Errorf("name 表记录不完整")
+	}
+
+	// 同一名称ID按优先级选取：Windows 英语（美国） > 其他 Windows 语言 > Unicode 平台 > Macintosh Roman
+	names := make(map[uint16]string)
+	priorities := make(map[uint16]int)
+	for i := 0; i < count; i++ {
+		record := table[6+i*12 : 18+i*12]
+		platformID := binary.BigEndian.Uint16(record[0:2])
+		encodingID := binary.BigEndian.Uint16(record[2:4])
+		languageID := binary.BigEndian.Uint16(record[4:6])
+		nameID := binary.BigEndian.Uint16(record[6:8])
+		length := int(binary.BigEndian.Uint16(record[8:10]))
+		offset := storage + int(binary.BigEndian.Uint16(record[10:12]))
+		if offset+length > len(table) {
+			continue
+		}
+		raw := table[offset : offset+length]
+
+		var name string
+		priority := 0
+		switch {
+		case platformID == 3 && (encodingID == 1 || encodingID == 10):
+			name, priority = decodeUTF16BE(raw), 3
+			if languageID == 0x0409 {
+				priority = 4
+			}
+		case platformID == 0:
+			name, priority = decodeUTF16BE(raw), 2
+		case platformID == 1 && encodingID == 0:
+			// Macintosh Roman，仅 ASCII 部分可直接使用
+			name, priority = string(raw), 1
+		}
+		if priority > priorities[nameID] {
+			names[nameID], priorities[nameID] = name, priority
+		}
+	}
+	return names, nil
+}
+
+// decodeUTF16BE 解码大端UTF-16字符串
+func decodeUTF16BE(raw []byte) string {
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		units[i] = binary.BigEndian.Uint16(raw[i*2:])
+	}
+	return string(utf16.Decode(units))
+}
+
+// normalizeFontName 统一字体名称的大小写和空白，用于比较
+func normalizeFontName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// matchSystemFont 按族名或完整名称查找字体：完整名称 > 族名 + 样式 > 族名的常规样式 > 族名的任意样式
+func matchSystemFont(installed []SystemFont, name string) (SystemFont, bool) {
+	query := normalizeFontName(name)
+	best, bestScore := SystemFont{}, 0
+	for _, font := range installed {
+		family := normalizeFontName(font.Family)
+		style := normalizeFontName(font.Style)
+
+		score := 0
+		switch {
+		case normalizeFontName(font.FullName) == query:
+			score = 4
+		case family+" "+style == query:
+			score = 3
+		case family == query && regularStyles[style]:
+			score = 2
+		case family == query:
+			score = 1
+		}
+		if score > bestScore {
+			best, bestScore = font, score
+		}
+	}
+	return best, bestScore > 0
+}
+
+// findSystemFont 在系统字体索引中查找字体
+func findSystemFont(name string) (SystemFont, error) {
+	if name == "" {
+		return SystemFont{}, fmt.Errorf("系统字体名称不能为空")
+	}
+	font, ok := matchSystemFont(SystemFonts(), name)
+	if !ok {
+		return SystemFont{}, fmt.Errorf("未找到系统字体: %s", name)
+	}
+	return font, nil
+}
@@ -0,0 +1,81 @@
+package json2image
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScanSystemFonts(t *testing.T) {
+	// 测试扫描目录并读取字体名称
+	data, _, err := loadFontData(DefaultConfig().WithFont(FontTypeMonaco))
+	if err != nil {
+		t.Fatalf("Failed to load monaco font: %v", err)
+	}
+
+	dir := t.TempDir()
+	subDir := filepath.Join(dir, "truetype", "monaco")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("Failed to create font dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(subDir, "Monaco.ttf"), data, 0644); err != nil {
+		t.Fatalf("Failed to write font file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(subDir, "broken.ttf"), []byte("not a font"), 0644); err != nil {
+		t.Fatalf("Failed to write broken font file: %v", err)
+	}
+	// name 表长度声明为 0x7FFFFFFF 的损坏文件，不应按该长度分配内存
+	corrupt := make([]byte, 28)
+	binary.BigEndian.PutUint16(corrupt[4:6], 1)
+	copy(corrupt[12:16], "name")
+	binary.BigEndian.PutUint32(corrupt[20:24], 28)
+	binary.BigEndian.PutUint32(corrupt[24:28], 0x7FFFFFFF)
+	if err := os.WriteFile(filepath.Join(subDir, "corrupt.ttf"), corrupt, 0644); err != nil {
+		t.Fatalf("Failed to write corrupt font file: %v", err)
+	}
+	if _, err := readSystemFonts(filepath.Join(subDir, "corrupt.ttf")); err == nil {
+		t.Error("Expected error for name table past end of file")
+	}
+
+	installed := scanSystemFonts([]string{dir, filepath.Join(dir, "nonexistent")})
+	if len(installed) != 1 {
+		t.Fatalf("Expected 1 font, got %d: %+v", len(installed), installed)
+	}
+	if installed[0].Family != "Monaco" {
+		t.Errorf("Expected family Monaco, got %q", installed[0].Family)
+	}
+	t.Logf("Scanned font: %+v", installed[0])
+}
+
+func TestMatchSystemFont(t *testing.T) {
+	// 测试族名、样式与完整名称的匹配优先级
+	installed := []SystemFont{
+		{Family: "DejaVu Sans Mono", Style: "Bold", FullName: "DejaVu Sans Mono Bold", Path: "/fonts/DejaVuSansMono-Bold.ttf"},
+		{Family: "DejaVu Sans Mono", Style: "Book", FullName: "DejaVu Sans Mono", Path: "/fonts/DejaVuSansMono.ttf"},
+		{Family: "Noto Sans", Style: "Italic", FullName: "Noto Sans Italic", Path: "/fonts/NotoSans-Italic.ttf"},
+	}
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"DejaVu Sans Mono", "/fonts/DejaVuSansMono.ttf"},
+		{"dejavu  sans mono bold", "/fonts/DejaVuSansMono-Bold.ttf"},
+		{"Noto Sans", "/fonts/NotoSans-Italic.ttf"},
+	}
+	for _, tt := range tests {
+		font, ok := matchSystemFont(installed, tt.name)
+		if !ok {
+			t.Errorf("Expected %q to match, got no match", tt.name)
+			continue
+		}
+		if font.Path != tt.expected {
+			t.Errorf("Expected %q to match %s, got %s", tt.name, tt.expected, font.Path)
+		}
+	}
+
+	if _, ok := matchSystemFont(installed, "Helvetica"); ok {
+		t.Error("Expected Helvetica not to match")
+	}
+}